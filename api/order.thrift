@@ -20,17 +20,56 @@ enum RefundStatus {
     COMPLETED = 4   // 已完成
 }
 
+enum ReviewStatus {
+    PENDING = 0     // 待审核
+    APPROVED = 1    // 已通过
+    HIDDEN = 2      // 已隐藏
+}
+
 enum TimeoutType {
     ORDER_UNPAID = 0      // 订单未支付
     STOCK_RESERVATION = 1 // 库存预占
 }
 
+enum FlashSaleStatus {
+    ACTIVE = 0      // 进行中（含未开始和已结束待对账）
+    RECONCILED = 1  // 已对账，未售出的库存已退回商品
+}
+
+enum FlashSaleRequestStatus {
+    QUEUED = 0      // 排队中
+    CREATED = 1     // 已下单
+    FAILED = 2      // 下单失败
+    CANCELLED = 3   // 订单已取消
+    REFUNDED = 4    // 订单已退款
+}
+
 struct OrderItem {
     1:i64 productId
     2:string productName
     3:i32 quantity
     4:double price
     5:optional string productImage  // 商品图片
+    6:optional i64 warehouseId      // 发货仓库
+    7:optional double originalPrice // 下单时处于调价期间的原价
+    8:optional i64 id               // 订单项ID，评价时使用
+    9:optional list<OrderItemComponent> components // 套装的组件明细，库存按组件预占、扣减和退回
+    10:optional AppliedPriceTier appliedTier       // 下单时命中的阶梯价，未命中时不返回
+    11:optional i64 flashSaleId                    // 秒杀订单的活动ID
+}
+
+// 下单时命中的阶梯价快照
+struct AppliedPriceTier {
+    1:i32 minQuantity
+    2:double basePrice            // 下单时的商品价格
+    3:optional string userGroup   // 命中的价格组
+}
+
+struct OrderItemComponent {
+    1:i64 productId
+    2:string productName
+    3:i32 quantity                  // 每套包含的数量
+    4:optional i64 warehouseId      // 组件的发货仓库
 }
 
 struct Order {
@@ -47,6 +86,9 @@ struct Order {
     11:optional string receiver     // 收货人姓名
     12:optional string paymentNo    // 支付单号
     13:optional string shippingNo   // 物流单号
+    14:optional string parentOrderNo // 多商家拆单时合并支付的父订单号
+    15:i64 merchantId               // 商家ID，0表示平台自营；父订单为0
+    16:optional list<string> subOrderNos // 父订单下的商家子订单号
 }
 
 struct StockReservation {
@@ -58,6 +100,7 @@ struct StockReservation {
     6:i64 expireTime
     7:i64 createdAt
     8:i64 updatedAt
+    9:optional i64 warehouseId
 }
 
 struct RefundOrder {
@@ -96,11 +139,12 @@ struct CreateOrderReq {
 
 struct CreateOrderResp {
     1:bool success
-    2:i32 code = 0
+    2:i32 code = 0    // 超过商品每人限购数量时为429
     3:string message
     4:string orderNo
     5:double totalAmount
     6:optional string paymentUrl    // 支付链接
+    7:optional list<string> subOrderNos // 涉及多个商家时按商家拆分的子订单号，orderNo为父订单号
 }
 
 // 获取订单详情
@@ -124,6 +168,7 @@ struct ListOrdersReq {
     4:i32 pageSize = 10
     5:optional i64 startTime       // 开始时间
     6:optional i64 endTime         // 结束时间
+    7:optional i64 merchantId      // 查询商家的订单，指定时不按用户过滤
 }
 
 struct ListOrdersResp {
@@ -141,6 +186,7 @@ struct PayOrderReq {
     1:string orderNo
     2:i64 userId
     3:optional string paymentNo    // 支付单号
+    4:optional i64 merchantId      // 商家发货时校验订单归属
 }
 
 struct PayOrderResp {
@@ -188,6 +234,7 @@ struct ProcessRefundReq {
     2:i64 processorId              // 处理人ID
     3:RefundStatus action          // 处理动作：APPROVED/REJECTED
     4:optional string remark       // 处理备注
+    5:optional i64 merchantId      // 商家处理时校验订单归属
 }
 
 struct ProcessRefundResp {
@@ -203,6 +250,7 @@ struct ReserveStockReq {
     2:i64 productId
     3:i32 quantity
     4:i64 expireSeconds = 900      // 默认15分钟
+    5:optional i64 warehouseId     // 指定仓库，不指定时按商品总库存校验
 }
 
 struct ReserveStockResp {
@@ -265,6 +313,228 @@ struct OrderStatsResp {
     6:map<string, i32> statusCounts  // 各状态订单数
 }
 
+// 商品评价
+struct Review {
+    1:i64 id
+    2:string orderNo
+    3:i64 orderItemId
+    4:i64 productId
+    5:i64 userId
+    6:i32 rating                   // 1-5分
+    7:string content
+    8:list<string> images
+    9:ReviewStatus status
+    10:optional string reply       // 商家回复
+    11:optional i64 repliedAt
+    12:i64 createdAt
+    13:i64 updatedAt
+}
+
+struct CreateReviewReq {
+    1:i64 userId
+    2:string orderNo
+    3:i64 orderItemId
+    4:i32 rating
+    5:optional string content
+    6:optional list<string> images
+}
+
+struct CreateReviewResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional Review review
+}
+
+struct ListReviewsReq {
+    1:optional i64 productId
+    2:optional i64 userId
+    3:optional ReviewStatus status
+    4:i32 page = 1
+    5:i32 pageSize = 10
+}
+
+struct ListReviewsResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<Review> reviews
+}
+
+struct ModerateReviewReq {
+    1:i64 id
+    2:ReviewStatus status          // APPROVED / HIDDEN
+    3:i64 operatorId
+}
+
+struct ModerateReviewResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional Review review
+}
+
+struct ReplyReviewReq {
+    1:i64 id
+    2:string reply
+    3:i64 operatorId
+}
+
+struct ReplyReviewResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional Review review
+}
+
+// 秒杀活动，库存在创建时从商品划拨，活动期间不再读写商品库存
+struct FlashSale {
+    1:i64 id
+    2:i64 productId
+    3:string productName
+    4:double flashPrice
+    5:double originalPrice          // 创建活动时的商品价格
+    6:i32 allocatedQuantity         // 划拨的库存
+    7:i32 soldQuantity              // 已下单的数量
+    8:i32 remainingQuantity         // 剩余可抢数量
+    9:i32 perUserLimit              // 每人限购数量
+    10:i64 startAt
+    11:i64 endAt
+    12:FlashSaleStatus status
+    13:i32 returnedQuantity         // 对账时退回商品的库存
+    14:optional i64 reconciledAt
+    15:i64 createdAt
+}
+
+struct CreateFlashSaleReq {
+    1:i64 productId
+    2:double flashPrice
+    3:i32 allocatedQuantity
+    4:i32 perUserLimit
+    5:i64 startAt
+    6:i64 endAt
+}
+
+struct CreateFlashSaleResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional FlashSale flashSale
+}
+
+struct GetFlashSaleReq {
+    1:i64 id
+}
+
+struct GetFlashSaleResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional FlashSale flashSale
+}
+
+struct ListFlashSalesReq {
+    1:optional i64 productId
+    2:optional bool includeEnded     // 是否包含已结束的活动
+    3:i32 page = 1
+    4:i32 pageSize = 20
+}
+
+struct ListFlashSalesResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:list<FlashSale> flashSales
+    5:i32 total
+    6:i32 page
+    7:i32 pageSize
+}
+
+// 秒杀下单：通过库存计数器后进入队列，由后台异步创建订单
+struct SubmitFlashSaleOrderReq {
+    1:i64 flashSaleId
+    2:i64 userId
+    3:i32 quantity
+    4:string address
+    5:string phone
+    6:optional string receiver
+}
+
+struct SubmitFlashSaleOrderResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional FlashSaleRequest request
+}
+
+struct FlashSaleRequest {
+    1:string requestId
+    2:i64 flashSaleId
+    3:i32 quantity
+    4:FlashSaleRequestStatus status
+    5:optional string orderNo         // 下单成功后的订单号
+    6:optional string failReason
+    7:i64 createdAt
+}
+
+struct GetFlashSaleRequestReq {
+    1:string requestId
+    2:i64 userId
+}
+
+struct GetFlashSaleRequestResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional FlashSaleRequest request
+}
+
+struct ReconcileFlashSaleReq {
+    1:i64 id
+}
+
+// 对账结果：已售与划拨库存核对，剩余库存退回商品
+struct FlashSaleReconciliation {
+    1:i64 flashSaleId
+    2:i32 allocatedQuantity
+    3:i32 soldQuantity
+    4:i32 returnedQuantity
+    5:i32 requestedQuantity         // 按下单记录统计的已售数量
+    6:bool consistent               // 活动已售数量与下单记录是否一致
+    7:i64 reconciledAt
+}
+
+struct ReconcileFlashSaleResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:optional FlashSaleReconciliation reconciliation
+}
+
+// 每人限购
+struct PurchaseAllowance {
+    1:i64 productId
+    2:i32 limit       // 每人限购数量
+    3:i32 windowDays  // 限购周期天数，0表示终身限购
+    4:i32 purchased   // 周期内未取消订单的已购数量
+    5:i32 remaining   // 剩余可购数量
+}
+
+struct GetPurchaseAllowancesReq {
+    1:i64 userId
+    2:list<i64> productIds
+}
+
+struct GetPurchaseAllowancesResp {
+    1:bool success
+    2:i32 code = 0
+    3:string message
+    4:list<PurchaseAllowance> allowances  // 只返回限购的商品
+}
+
 service OrderService {
     // 订单生命周期
     CreateOrderResp CreateOrder(1:CreateOrderReq req)
@@ -298,4 +568,21 @@ service OrderService {
     
     // 确认收货
     PayOrderResp ConfirmReceipt(1:PayOrderReq req)
+
+    // 商品评价
+    CreateReviewResp CreateReview(1:CreateReviewReq req)
+    ListReviewsResp ListReviews(1:ListReviewsReq req)
+    ModerateReviewResp ModerateReview(1:ModerateReviewReq req)
+    ReplyReviewResp ReplyReview(1:ReplyReviewReq req)
+
+    // 秒杀
+    CreateFlashSaleResp CreateFlashSale(1:CreateFlashSaleReq req)
+    GetFlashSaleResp GetFlashSale(1:GetFlashSaleReq req)
+    ListFlashSalesResp ListFlashSales(1:ListFlashSalesReq req)
+    SubmitFlashSaleOrderResp SubmitFlashSaleOrder(1:SubmitFlashSaleOrderReq req)
+    GetFlashSaleRequestResp GetFlashSaleRequest(1:GetFlashSaleRequestReq req)
+    ReconcileFlashSaleResp ReconcileFlashSale(1:ReconcileFlashSaleReq req)
+
+    // 每人限购
+    GetPurchaseAllowancesResp GetPurchaseAllowances(1:GetPurchaseAllowancesReq req)
 }
\ No newline at end of file
@@ -5,6 +5,90 @@ enum ProductStatus{
     ONLINE = 1  //上架
     OFFLINE = 2 //下架
     DELETED = 3 //删除
+    SOLD_OUT = 4 //售罄（可见不可购买）
+}
+
+enum SoldOutPolicy{
+    NONE = 0     //库存为0时不处理
+    SOLD_OUT = 1 //标记为售罄
+    OFFLINE = 2  //自动下架
+}
+
+enum StockChangeReason{
+    MANUAL_ADJUST = 0  //人工调整
+    ORDER_DEDUCT = 1   //下单扣减
+    CANCEL_RESTORE = 2 //取消恢复
+    REFUND_RETURN = 3  //退款退回
+    IMPORT = 4         //导入
+    INITIAL = 5        //初始库存
+    FLASH_SALE_ALLOCATE = 6 //划拨秒杀库存
+    FLASH_SALE_RETURN = 7   //秒杀结束退回
+}
+
+enum AttributeType{
+    STRING = 0 //文本
+    NUMBER = 1 //数值
+    ENUM = 2   //枚举
+    BOOL = 3   //布尔
+}
+
+enum BrandStatus{
+    ACTIVE = 0   //启用
+    DISABLED = 1 //停用，不能再关联到商品
+}
+
+enum MerchantStatus{
+    ACTIVE = 0   //正常经营
+    DISABLED = 1 //停用，不能新建商品或上架
+}
+
+enum SuggestionKind{
+    PRODUCT = 0  //商品名称
+    BRAND = 1    //品牌
+    CATEGORY = 2 //类目
+}
+
+enum ApprovalStatus{
+    UNSUBMITTED = 0 //未提交审核
+    PENDING = 1     //待审核
+    APPROVED = 2    //审核通过
+    REJECTED = 3    //已驳回
+}
+
+enum ApprovalKind{
+    LISTING = 0  //新商品上架审核
+    REVISION = 1 //已审核商品的内容修改
+}
+
+enum ProductType{
+    NORMAL = 0 //普通商品
+    BUNDLE = 1 //套装，由其他商品按数量组成，库存由组件库存决定
+}
+
+enum RestockSubscriptionStatus{
+    PENDING = 0  //等待补货
+    NOTIFIED = 1 //已发送到货通知
+}
+
+//阶梯价：购买数量达到minQuantity时的单价
+struct PriceTier{
+    1:i32 minQuantity
+    2:double price
+    3:optional string userGroup  //价格组，为空时对所有用户生效
+}
+
+struct ProductAttribute{
+    1:string code
+    2:string name
+    3:AttributeType type
+    4:string value
+    5:optional string unit
+}
+
+struct BundleComponent{
+    1:i64 productId
+    2:i32 quantity  //每套包含的数量
+    3:optional string productName
 }
 
 struct Product{
@@ -18,6 +102,26 @@ struct Product{
     8:i64 createdAt
     9:i64 updatedAt
     10:optional string brand
+    11:i32 lowStockThreshold        //低库存阈值，0表示不预警
+    12:SoldOutPolicy soldOutPolicy = SoldOutPolicy.NONE
+    13:optional double originalPrice  //定时调价生效期间的原价
+    14:optional string externalCode   //外部编码，批量导入时按此去重
+    15:optional list<ProductAttribute> attributes  //规格属性，仅商品详情返回
+    16:double ratingAvg   //已通过审核评价的平均分
+    17:i32 ratingCount    //已通过审核评价数
+    18:i64 version        //乐观锁版本号，每次编辑递增
+    19:i64 brandId        //品牌ID，0表示未关联品牌
+    20:ApprovalStatus approvalStatus = ApprovalStatus.UNSUBMITTED  //审核通过后才能上架
+    21:i64 merchantId     //所属商家ID，0表示平台自营
+    22:ProductType type = ProductType.NORMAL
+    23:optional list<BundleComponent> components  //套装组件，仅商品详情和批量查询返回
+    24:optional string description    //请求语言的商品描述，没有翻译时不返回
+    25:optional string categoryLabel  //请求语言的分类名称，没有翻译时不返回，使用category
+    26:i32 favoriteCount  //收藏人数，仅商品详情返回
+    27:optional list<PriceTier> priceTiers  //当前用户适用的各档单价，按数量从小到大
+    28:i32 purchaseLimit      //每人限购数量，0表示不限购
+    29:i32 purchaseLimitDays  //限购周期天数，0表示终身限购
+    30:optional i32 remainingPurchaseQuantity  //当前登录用户剩余可购数量，仅限购商品详情返回
 }
 
 struct SimpleProduct{
@@ -29,6 +133,13 @@ struct SimpleProduct{
     6:optional string brand
     7:string name
     8:string avatar
+    9:double ratingAvg
+    10:i32 ratingCount
+    11:i64 brandId
+    12:i64 merchantId
+    13:ProductType type = ProductType.NORMAL
+    14:optional string categoryLabel  //请求语言的分类名称，没有翻译时不返回，使用category
+    15:optional list<PriceTier> priceTiers  //当前用户适用的各档单价，按数量从小到大
 }
 
 struct CreateProductReq{
@@ -39,6 +150,14 @@ struct CreateProductReq{
     5:i32 stock
     6:optional string brand
     7:optional ProductStatus status = ProductStatus.DRAFT
+    8:optional i32 lowStockThreshold
+    9:optional SoldOutPolicy soldOutPolicy
+    10:optional map<string,string> attributes  //属性编码 -> 属性值，按分类属性定义校验
+    11:optional string operator
+    12:optional i64 brandId  //优先于brand；只传brand时按名称或别名匹配，未匹配到则新建品牌
+    13:optional i64 merchantId  //所属商家，创建后不能修改
+    14:optional ProductType type = ProductType.NORMAL  //创建后不能修改
+    15:optional list<BundleComponent> components  //套装必填，组件须为同一商家的普通商品；套装忽略stock
 }
 
 struct CreateProductResp{
@@ -50,6 +169,9 @@ struct CreateProductResp{
 
 struct GetProductReq{
     1:i64 id
+    2:optional bool countView //用户浏览商品详情时为true，计入浏览量
+    3:optional string locale  //展示语言，如en-US，没有对应翻译时使用默认语言
+    4:optional i64 userId     //当前用户，用于计算所在价格组的价格
 }
 
 struct GetProductResp{
@@ -68,6 +190,12 @@ struct UpdateProductReq{
     6:optional i32 stock
     7:optional ProductStatus status
     8:optional string brand
+    9:optional i32 lowStockThreshold
+    10:optional SoldOutPolicy soldOutPolicy
+    11:optional map<string,string> attributes  //传入时整体替换商品属性
+    12:optional i64 version  //期望的商品版本号，必填，与当前版本不一致时返回409
+    13:optional string operator
+    14:optional i64 brandId  //优先于brand，0表示取消关联品牌
 }
 
 struct UpdateProductResp{
@@ -75,10 +203,12 @@ struct UpdateProductResp{
     2:i32 code = 0
     3:optional string message
     4:Product product
+    5:optional ProductApproval revision  //已审核商品修改名称、图片、分类、品牌或属性时生成待审核修改，审核通过后生效
 }
 
 struct DeleteProductReq{
     1:i64 id
+    2:optional string operator
 }
 
 struct DeleteProductResp{
@@ -89,6 +219,8 @@ struct DeleteProductResp{
 
 struct OnlineProductReq{
     1:i64 id
+    2:optional i64 executeAt   //晚于当前时间时创建定时上架计划
+    3:optional string operator
 }
 
 struct OnlineProductResp{
@@ -98,10 +230,13 @@ struct OnlineProductResp{
     4:ProductStatus oldStatus
     5:ProductStatus newStatus
     6:i64 operatedAt
+    7:optional i64 scheduleId  //定时执行时返回计划ID
 }
 
 struct OfflineProductReq{
     1:i64 id
+    2:optional i64 executeAt   //晚于当前时间时创建定时下架计划
+    3:optional string operator
 }
 
 struct OfflineProductResp{
@@ -111,6 +246,13 @@ struct OfflineProductResp{
     4:ProductStatus oldStatus
     5:ProductStatus newStatus
     6:i64 operatedAt
+    7:optional i64 scheduleId  //定时执行时返回计划ID
+}
+
+struct AttributeFilter{
+    1:string code
+    2:string op            //eq / ne / lt / lte / gt / gte / in，比较大小仅支持数值属性
+    3:list<string> values  //数值可带属性单位，如 2kg
 }
 
 struct UserSearchProductsReq{
@@ -120,6 +262,11 @@ struct UserSearchProductsReq{
     4:optional string keyword
     5:i32 page = 1
     6:i32 pageSize = 20
+    7:optional list<AttributeFilter> attributeFilters
+    8:optional string sortBy  //newest(默认) / rating / rating_count / price_asc / price_desc / popularity
+    9:optional list<i64> brandIds
+    10:optional string locale
+    11:optional i64 userId
 }
 
 struct UserSearchProductsResp{
@@ -130,6 +277,7 @@ struct UserSearchProductsResp{
     5:i32 page
     6:i32 pageSize
     7:list<SimpleProduct> products
+    8:list<BrandFacet> brandFacets  //不含品牌筛选条件时各品牌的商品数
 }
 
 struct AdminSearchProductsReq{
@@ -140,6 +288,10 @@ struct AdminSearchProductsReq{
     5:optional string keyword
     6:i32 page = 1
     7:i32 pageSize = 20
+    8:optional list<AttributeFilter> attributeFilters
+    9:optional string sortBy  //同用户搜索
+    10:optional list<i64> brandIds
+    11:optional i64 merchantId  //只查询该商家的商品，0表示平台自营
 }
 
 struct AdminSearchProductsResp{
@@ -150,6 +302,1085 @@ struct AdminSearchProductsResp{
     5:i32 page
     6:i32 pageSize
     7:list<Product> products
+    8:list<BrandFacet> brandFacets
+}
+
+struct BatchGetProductsReq{
+    1:list<i64> ids
+    2:optional string locale
+}
+
+struct BatchGetProductsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<Product> products
+}
+
+struct ProductEvent{
+    1:i64 id
+    2:i64 productId
+    3:string type    //product.updated / product.status_changed / product.stock_changed / product.deleted
+    4:i64 createdAt
+}
+
+struct ListProductEventsReq{
+    1:i64 afterId    //小于0时只返回当前最新游标
+    2:i32 limit = 100
+}
+
+struct ListProductEventsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<ProductEvent> events
+    5:i64 lastId
+}
+
+struct StockMovement{
+    1:i64 id
+    2:i64 productId
+    3:i32 delta
+    4:i32 balance
+    5:StockChangeReason reason
+    6:optional string referenceId  //订单号/预占ID
+    7:string operator
+    8:i64 createdAt
+    9:optional i64 warehouseId
+}
+
+struct AdjustStockReq{
+    1:i64 productId
+    2:i32 delta
+    3:StockChangeReason reason
+    4:optional string referenceId  //同一商品、原因、单号只生效一次
+    5:optional string operator
+    6:optional i64 warehouseId     //指定仓库时同时调整该仓库库存
+}
+
+struct AdjustStockResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 balance
+    5:i64 movementId
+}
+
+struct ListStockMovementsReq{
+    1:optional i64 productId
+    2:optional StockChangeReason reason
+    3:optional string referenceId
+    4:optional i64 startTime
+    5:optional i64 endTime
+    6:i32 page = 1
+    7:i32 pageSize = 20
+}
+
+struct ListStockMovementsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<StockMovement> movements
+}
+
+struct StockDiscrepancy{
+    1:i64 productId
+    2:i32 stock        //商品表库存
+    3:i32 ledgerStock  //流水重算库存
+    4:i32 difference
+}
+
+struct ReconcileStockReq{
+    1:optional i64 productId
+}
+
+struct ReconcileStockResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 checked
+    5:list<StockDiscrepancy> discrepancies
+}
+
+struct Warehouse{
+    1:i64 id
+    2:string code
+    3:string name
+    4:string province
+    5:string city
+    6:string address
+    7:i32 priority      //数值越小越优先
+    8:bool active
+    9:i64 createdAt
+    10:i64 updatedAt
+}
+
+struct WarehouseStock{
+    1:i64 warehouseId
+    2:i64 productId
+    3:i32 stock
+}
+
+struct CreateWarehouseReq{
+    1:string code
+    2:string name
+    3:string province
+    4:optional string city
+    5:optional string address
+    6:i32 priority = 0
+}
+
+struct CreateWarehouseResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:Warehouse warehouse
+}
+
+struct UpdateWarehouseReq{
+    1:i64 id
+    2:optional string name
+    3:optional string province
+    4:optional string city
+    5:optional string address
+    6:optional i32 priority
+    7:optional bool active
+}
+
+struct UpdateWarehouseResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:Warehouse warehouse
+}
+
+struct ListWarehousesReq{
+    1:bool activeOnly = false
+}
+
+struct ListWarehousesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<Warehouse> warehouses
+}
+
+struct GetWarehouseStocksReq{
+    1:list<i64> productIds
+    2:optional i64 warehouseId
+}
+
+struct GetWarehouseStocksResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<WarehouseStock> stocks
+}
+
+struct ListLowStockProductsReq{
+    1:i32 page = 1
+    2:i32 pageSize = 20
+}
+
+struct ListLowStockProductsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<Product> products
+}
+
+struct PriceSchedule{
+    1:i64 id
+    2:i64 productId
+    3:double price
+    4:i64 startAt
+    5:i64 endAt           //0表示不自动恢复
+    6:string status       //pending / active / completed / cancelled
+    7:double originalPrice
+    8:string operator
+    9:i64 createdAt
+    10:i64 updatedAt
+}
+
+struct PriceHistory{
+    1:i64 id
+    2:i64 productId
+    3:double oldPrice
+    4:double newPrice
+    5:string source       //manual / schedule / revert
+    6:optional i64 scheduleId
+    7:string operator
+    8:i64 createdAt
+}
+
+struct CreatePriceScheduleReq{
+    1:i64 productId
+    2:double price
+    3:i64 startAt
+    4:optional i64 endAt
+    5:optional string operator
+}
+
+struct CreatePriceScheduleResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:PriceSchedule schedule
+}
+
+struct ListPriceSchedulesReq{
+    1:optional i64 productId
+    2:optional string status
+    3:i32 page = 1
+    4:i32 pageSize = 20
+}
+
+struct ListPriceSchedulesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<PriceSchedule> schedules
+}
+
+struct CancelPriceScheduleReq{
+    1:i64 id
+    2:optional string operator
+}
+
+struct CancelPriceScheduleResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+struct GetPriceHistoryReq{
+    1:i64 productId
+    2:i32 page = 1
+    3:i32 pageSize = 20
+}
+
+struct GetPriceHistoryResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<PriceHistory> history
+}
+
+struct ProductStatusSchedule{
+    1:i64 id
+    2:i64 productId
+    3:ProductStatus targetStatus  //ONLINE / OFFLINE
+    4:i64 executeAt
+    5:string status               //pending / done / failed / cancelled
+    6:optional string message     //执行失败原因
+    7:string operator
+    8:i64 executedAt
+    9:i64 createdAt
+    10:i64 updatedAt
+}
+
+struct ListProductStatusSchedulesReq{
+    1:optional i64 productId
+    2:optional string status
+    3:i32 page = 1
+    4:i32 pageSize = 20
+}
+
+struct ListProductStatusSchedulesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<ProductStatusSchedule> schedules
+}
+
+struct CancelProductStatusScheduleReq{
+    1:i64 id
+}
+
+struct CancelProductStatusScheduleResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+struct ProductImportRow{
+    1:i32 line                    //CSV行号，用于回报错误
+    2:string externalCode
+    3:string name
+    4:string category
+    5:double price
+    6:i32 stock
+    7:optional string brand
+    8:optional ProductStatus status
+    9:optional string avatar
+}
+
+struct ImportRowError{
+    1:i32 line
+    2:string field
+    3:string message
+}
+
+struct ImportProductsReq{
+    1:list<ProductImportRow> rows
+    2:bool dryRun = false         //只校验不写入
+    3:optional string operator
+}
+
+struct ImportProductsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 created
+    6:i32 updated
+    7:i32 failed
+    8:list<ImportRowError> errors
+    9:bool dryRun
+}
+
+struct AttributeDefinition{
+    1:i64 id
+    2:string category
+    3:string code
+    4:string name
+    5:AttributeType type
+    6:bool required
+    7:optional string unit
+    8:list<string> options  //枚举可选值
+    9:i32 sort
+    10:i64 createdAt
+    11:i64 updatedAt
+}
+
+struct CreateAttributeDefinitionReq{
+    1:string category
+    2:string code
+    3:string name
+    4:AttributeType type
+    5:bool required = false
+    6:optional string unit
+    7:optional list<string> options
+    8:i32 sort = 0
+}
+
+struct CreateAttributeDefinitionResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:AttributeDefinition definition
+}
+
+struct UpdateAttributeDefinitionReq{
+    1:i64 id
+    2:optional string name
+    3:optional bool required
+    4:optional string unit
+    5:optional list<string> options
+    6:optional i32 sort
+}
+
+struct UpdateAttributeDefinitionResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:AttributeDefinition definition
+}
+
+struct ListAttributeDefinitionsReq{
+    1:optional string category
+}
+
+struct ListAttributeDefinitionsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<AttributeDefinition> definitions
+}
+
+struct DeleteAttributeDefinitionReq{
+    1:i64 id
+}
+
+struct DeleteAttributeDefinitionResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+struct UpdateProductRatingReq{
+    1:i64 productId
+    2:double ratingAvg
+    3:i32 ratingCount
+}
+
+struct UpdateProductRatingResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+// 审计日志字段变更
+struct ProductAuditChange{
+    1:string field
+    2:string before
+    3:string after
+}
+// 商品审计日志
+struct ProductAudit{
+    1:i64 id
+    2:i64 productId
+    3:string action     //create / update / online / offline / delete / stock_change / price_change / status_change
+    4:string operator
+    5:string source     //admin_http / rpc / import / scheduler
+    6:list<ProductAuditChange> changes
+    7:optional string remark
+    8:i64 createdAt
+}
+struct ListProductAuditReq{
+    1:optional i64 productId
+    2:optional string operator
+    3:optional i64 startTime  //包含
+    4:optional i64 endTime    //不包含
+    5:i32 page = 1
+    6:i32 pageSize = 20
+}
+struct ListProductAuditResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<ProductAudit> audits
+}
+
+// 共同购买统计，由订单服务按已完成订单汇总后同步
+struct PurchasePair{
+    1:i64 productId
+    2:i64 relatedProductId
+    3:i32 orderCount               // 同时包含两个商品的订单数
+}
+
+struct ProductSales{
+    1:i64 productId
+    2:i32 orderCount
+    3:i32 quantity
+}
+
+struct SyncPurchaseStatsReq{
+    1:i64 generation               // 同步批次号，同一次同步的各批数据相同
+    2:list<PurchasePair> pairs
+    3:list<ProductSales> sales
+    4:bool final                   // 最后一批，写入后清理旧批次数据
+}
+
+struct SyncPurchaseStatsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+struct GetRelatedProductsReq{
+    1:i64 productId
+    2:i32 limit = 10
+    3:optional string locale
+}
+
+struct GetRelatedProductsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<SimpleProduct> products
+}
+
+struct GetFrequentlyBoughtTogetherReq{
+    1:i64 productId
+    2:i32 limit = 5
+    3:optional string locale
+}
+
+struct GetFrequentlyBoughtTogetherResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<SimpleProduct> products
+}
+
+// 品牌
+struct Brand{
+    1:i64 id
+    2:string name
+    3:string logo
+    4:string description
+    5:BrandStatus status
+    6:list<string> aliases   //别名，商品按名称或别名匹配品牌，不区分大小写
+    7:i64 createdAt
+    8:i64 updatedAt
+}
+
+struct BrandFacet{
+    1:i64 brandId
+    2:string name
+    3:i32 count
+}
+
+struct CreateBrandReq{
+    1:string name
+    2:optional string logo
+    3:optional string description
+    4:optional list<string> aliases
+    5:optional BrandStatus status
+}
+
+struct CreateBrandResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional Brand brand
+}
+
+struct UpdateBrandReq{
+    1:i64 id
+    2:optional string name
+    3:optional string logo
+    4:optional string description
+    5:optional list<string> aliases  //传入时整体替换
+    6:optional BrandStatus status
+    7:optional string operator
+}
+
+struct UpdateBrandResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional Brand brand
+}
+
+struct GetBrandReq{
+    1:i64 id
+}
+
+struct GetBrandResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional Brand brand
+}
+
+struct ListBrandsReq{
+    1:optional string keyword
+    2:optional BrandStatus status
+    3:i32 page = 1
+    4:i32 pageSize = 20
+}
+
+struct ListBrandsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<Brand> brands
+}
+
+struct DeleteBrandReq{
+    1:i64 id
+    2:optional i64 mergeIntoId  //有关联商品时必须指定，商品和别名并入该品牌
+    3:optional string operator
+}
+
+struct DeleteBrandResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+// 商品审核记录
+struct ProductApproval{
+    1:i64 id
+    2:i64 productId
+    3:ApprovalKind kind
+    4:ApprovalStatus status
+    5:list<ProductAuditChange> changes  //内容修改的字段变化，上架审核为空
+    6:string submitter
+    7:optional string remark   //提交说明
+    8:optional string reviewer
+    9:optional string comment  //审核意见
+    10:i64 createdAt
+    11:i64 reviewedAt
+}
+
+struct SubmitProductApprovalReq{
+    1:i64 productId
+    2:optional string remark
+    3:optional string operator
+}
+
+struct SubmitProductApprovalResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional ProductApproval approval
+}
+
+struct ReviewProductApprovalReq{
+    1:i64 id
+    2:bool approved
+    3:optional string comment   //驳回时必填
+    4:optional string reviewer  //不能与提交人相同
+}
+
+struct ReviewProductApprovalResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional ProductApproval approval
+    5:optional Product product
+}
+
+struct ListProductApprovalsReq{
+    1:optional i64 productId
+    2:optional ApprovalStatus status
+    3:optional ApprovalKind kind
+    4:i32 page = 1
+    5:i32 pageSize = 20
+}
+
+struct ListProductApprovalsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<ProductApproval> approvals
+}
+
+// 商家
+struct Merchant{
+    1:i64 id
+    2:string name
+    3:string contactName
+    4:string contactPhone
+    5:MerchantStatus status
+    6:i64 createdAt
+    7:i64 updatedAt
+}
+
+struct CreateMerchantReq{
+    1:string name
+    2:optional string contactName
+    3:optional string contactPhone
+}
+
+struct CreateMerchantResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional Merchant merchant
+}
+
+struct UpdateMerchantReq{
+    1:i64 id
+    2:optional string name
+    3:optional string contactName
+    4:optional string contactPhone
+    5:optional MerchantStatus status
+}
+
+struct UpdateMerchantResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional Merchant merchant
+}
+
+struct GetMerchantReq{
+    1:i64 id
+}
+
+struct GetMerchantResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:optional Merchant merchant
+}
+
+struct ListMerchantsReq{
+    1:optional string keyword
+    2:optional MerchantStatus status
+    3:i32 page = 1
+    4:i32 pageSize = 20
+}
+
+struct ListMerchantsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<Merchant> merchants
+}
+
+struct SearchSuggestion{
+    1:string text           //展示的联想词
+    2:SuggestionKind kind
+    3:i64 refId             //商品ID或品牌ID，类目为0
+    4:double weight         //热度权重，越大越靠前
+}
+
+struct SuggestProductsReq{
+    1:string prefix         //支持名称前缀和拼音首字母，如"pg"匹配"苹果"
+    2:i32 limit = 10
+}
+
+struct SuggestProductsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<SearchSuggestion> suggestions
+}
+
+struct SearchQueryStat{
+    1:string query
+    2:i64 searchCount
+    3:i64 zeroResultCount   //无结果的次数
+    4:i32 lastResultCount   //最近一次搜索的结果数
+    5:i64 lastSearchedAt
+}
+
+struct ListSearchQueriesReq{
+    1:optional bool zeroResultOnly //只看出现过无结果的搜索词
+    2:optional string sortBy       //count(默认)、zero_result、recent
+    3:i32 page = 1
+    4:i32 pageSize = 20
+}
+
+struct ListSearchQueriesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<SearchQueryStat> queries
+}
+
+// 商品销量增量，由订单服务在订单支付后上报
+struct ProductSaleDelta{
+    1:i64 productId
+    2:i32 quantity
+}
+
+struct RecordProductSalesReq{
+    1:list<ProductSaleDelta> sales
+}
+
+struct RecordProductSalesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+
+struct GetTopProductsReq{
+    1:string window = "24h"   //24h、7d、30d
+    2:optional string category
+    3:optional string sortBy  //popularity(默认)、views、sales
+    4:i32 limit = 10
+    5:optional string locale
+}
+
+struct TopProduct{
+    1:SimpleProduct product
+    2:i64 viewCount
+    3:i64 salesCount
+    4:double score            //浏览量和销量的加权热度
+}
+
+struct GetTopProductsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:string window
+    5:list<TopProduct> products
+}
+
+struct UpdateBundleComponentsReq{
+    1:i64 bundleId
+    2:list<BundleComponent> components  //整体替换，只能在套装未上架时修改
+    3:optional string operator
+}
+struct UpdateBundleComponentsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:Product product
+}
+
+struct ProductTranslation{
+    1:i64 productId
+    2:string locale
+    3:string name
+    4:optional string description
+    5:i64 updatedAt
+}
+struct CategoryTranslation{
+    1:string category
+    2:string locale
+    3:string label
+    4:i64 updatedAt
+}
+
+struct SetProductTranslationReq{
+    1:i64 productId
+    2:string locale  //不能是默认语言，默认语言的内容直接修改商品
+    3:string name
+    4:optional string description
+    5:optional string operator
+}
+struct SetProductTranslationResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:ProductTranslation translation
+}
+struct DeleteProductTranslationReq{
+    1:i64 productId
+    2:string locale
+    3:optional string operator
+}
+struct DeleteProductTranslationResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+struct ListProductTranslationsReq{
+    1:i64 productId
+}
+struct ListProductTranslationsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<ProductTranslation> translations
+}
+struct SetCategoryTranslationReq{
+    1:string category
+    2:string locale
+    3:string label
+}
+struct SetCategoryTranslationResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:CategoryTranslation translation
+}
+struct DeleteCategoryTranslationReq{
+    1:string category
+    2:string locale
+}
+struct DeleteCategoryTranslationResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+struct ListCategoryTranslationsReq{
+    1:optional string category
+    2:optional string locale
+}
+struct ListCategoryTranslationsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<CategoryTranslation> translations
+}
+
+//收藏
+struct Favorite{
+    1:i64 productId
+    2:double savedPrice  //收藏时的价格
+    3:i64 createdAt
+    4:optional SimpleProduct product  //商品当前的价格、库存和状态
+    5:bool priceDropped  //当前价格低于收藏时的价格
+}
+struct AddFavoriteReq{
+    1:i64 userId
+    2:i64 productId
+}
+struct AddFavoriteResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:Favorite favorite
+}
+struct RemoveFavoriteReq{
+    1:i64 userId
+    2:i64 productId
+}
+struct RemoveFavoriteResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+struct ListFavoritesReq{
+    1:i64 userId
+    2:i32 page = 1
+    3:i32 pageSize = 20
+    4:optional bool priceDroppedOnly  //只返回降价的收藏
+    5:optional string locale
+}
+struct ListFavoritesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<Favorite> favorites
+}
+
+//到货通知订阅
+struct RestockSubscription{
+    1:i64 productId
+    2:RestockSubscriptionStatus status
+    3:i64 createdAt
+    4:i64 notifiedAt  //最近一次发送到货通知的时间，0表示未通知过
+    5:optional SimpleProduct product
+}
+struct SubscribeRestockReq{
+    1:i64 userId
+    2:i64 productId
+}
+struct SubscribeRestockResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:RestockSubscription subscription
+}
+struct UnsubscribeRestockReq{
+    1:i64 userId
+    2:i64 productId
+}
+struct UnsubscribeRestockResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+struct ListRestockSubscriptionsReq{
+    1:i64 userId
+    2:i32 page = 1
+    3:i32 pageSize = 20
+}
+struct ListRestockSubscriptionsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<RestockSubscription> subscriptions
+}
+
+//阶梯价和价格组
+struct SetProductPriceTiersReq{
+    1:i64 productId
+    2:list<PriceTier> tiers  //整体替换，为空时清除
+    3:optional string operator
+}
+struct SetProductPriceTiersResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<PriceTier> tiers
+}
+struct GetProductPriceTiersReq{
+    1:i64 productId
+}
+struct GetProductPriceTiersResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<PriceTier> tiers
+}
+struct UserPriceGroup{
+    1:i64 userId
+    2:string group
+    3:i64 updatedAt
+}
+struct SetUserPriceGroupReq{
+    1:i64 userId
+    2:string group  //为空时移出价格组
+}
+struct SetUserPriceGroupResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+}
+struct ListUserPriceGroupsReq{
+    1:optional string group
+    2:i32 page = 1
+    3:i32 pageSize = 20
+}
+struct ListUserPriceGroupsResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:i32 total
+    5:i32 page
+    6:i32 pageSize
+    7:list<UserPriceGroup> members
+}
+struct PriceQuoteItem{
+    1:i64 productId
+    2:i32 quantity
+}
+struct PriceQuote{
+    1:i64 productId
+    2:i32 quantity
+    3:double basePrice  //商品当前价格
+    4:double unitPrice  //按数量和价格组计算的成交单价
+    5:optional PriceTier appliedTier  //命中的阶梯价，未命中时不返回
+}
+struct QuotePricesReq{
+    1:i64 userId
+    2:list<PriceQuoteItem> items
+}
+struct QuotePricesResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:list<PriceQuote> quotes  //与请求的商品项一一对应
+}
+
+//每人限购
+struct SetProductPurchaseLimitReq{
+    1:i64 productId
+    2:i32 limit       //每人限购数量，0表示取消限购
+    3:i32 windowDays  //限购周期天数，按下单时间往前滚动计算，0表示终身限购
+    4:optional string operator
+}
+struct SetProductPurchaseLimitResp{
+    1:bool success
+    2:i32 code = 0
+    3:optional string message
+    4:Product product
 }
 
 service ProductService{
@@ -161,4 +1392,65 @@ service ProductService{
     DeleteProductResp DeleteProduct(1:DeleteProductReq req)
     UserSearchProductsResp UserSearchProducts(1:UserSearchProductsReq req)
     AdminSearchProductsResp AdminSearchProducts(1:AdminSearchProductsReq req)
+    BatchGetProductsResp BatchGetProducts(1:BatchGetProductsReq req)
+    ListProductEventsResp ListProductEvents(1:ListProductEventsReq req)
+    AdjustStockResp AdjustStock(1:AdjustStockReq req)
+    ListStockMovementsResp ListStockMovements(1:ListStockMovementsReq req)
+    ReconcileStockResp ReconcileStock(1:ReconcileStockReq req)
+    CreateWarehouseResp CreateWarehouse(1:CreateWarehouseReq req)
+    UpdateWarehouseResp UpdateWarehouse(1:UpdateWarehouseReq req)
+    ListWarehousesResp ListWarehouses(1:ListWarehousesReq req)
+    GetWarehouseStocksResp GetWarehouseStocks(1:GetWarehouseStocksReq req)
+    ListLowStockProductsResp ListLowStockProducts(1:ListLowStockProductsReq req)
+    CreatePriceScheduleResp CreatePriceSchedule(1:CreatePriceScheduleReq req)
+    ListPriceSchedulesResp ListPriceSchedules(1:ListPriceSchedulesReq req)
+    CancelPriceScheduleResp CancelPriceSchedule(1:CancelPriceScheduleReq req)
+    GetPriceHistoryResp GetPriceHistory(1:GetPriceHistoryReq req)
+    ListProductStatusSchedulesResp ListProductStatusSchedules(1:ListProductStatusSchedulesReq req)
+    CancelProductStatusScheduleResp CancelProductStatusSchedule(1:CancelProductStatusScheduleReq req)
+    ImportProductsResp ImportProducts(1:ImportProductsReq req)
+    CreateAttributeDefinitionResp CreateAttributeDefinition(1:CreateAttributeDefinitionReq req)
+    UpdateAttributeDefinitionResp UpdateAttributeDefinition(1:UpdateAttributeDefinitionReq req)
+    ListAttributeDefinitionsResp ListAttributeDefinitions(1:ListAttributeDefinitionsReq req)
+    DeleteAttributeDefinitionResp DeleteAttributeDefinition(1:DeleteAttributeDefinitionReq req)
+    UpdateProductRatingResp UpdateProductRating(1:UpdateProductRatingReq req)
+    ListProductAuditResp ListProductAudit(1:ListProductAuditReq req)
+    SyncPurchaseStatsResp SyncPurchaseStats(1:SyncPurchaseStatsReq req)
+    GetRelatedProductsResp GetRelatedProducts(1:GetRelatedProductsReq req)
+    GetFrequentlyBoughtTogetherResp GetFrequentlyBoughtTogether(1:GetFrequentlyBoughtTogetherReq req)
+    CreateBrandResp CreateBrand(1:CreateBrandReq req)
+    UpdateBrandResp UpdateBrand(1:UpdateBrandReq req)
+    GetBrandResp GetBrand(1:GetBrandReq req)
+    ListBrandsResp ListBrands(1:ListBrandsReq req)
+    DeleteBrandResp DeleteBrand(1:DeleteBrandReq req)
+    SubmitProductApprovalResp SubmitProductApproval(1:SubmitProductApprovalReq req)
+    ReviewProductApprovalResp ReviewProductApproval(1:ReviewProductApprovalReq req)
+    ListProductApprovalsResp ListProductApprovals(1:ListProductApprovalsReq req)
+    CreateMerchantResp CreateMerchant(1:CreateMerchantReq req)
+    UpdateMerchantResp UpdateMerchant(1:UpdateMerchantReq req)
+    GetMerchantResp GetMerchant(1:GetMerchantReq req)
+    ListMerchantsResp ListMerchants(1:ListMerchantsReq req)
+    SuggestProductsResp SuggestProducts(1:SuggestProductsReq req)
+    ListSearchQueriesResp ListSearchQueries(1:ListSearchQueriesReq req)
+    RecordProductSalesResp RecordProductSales(1:RecordProductSalesReq req)
+    GetTopProductsResp GetTopProducts(1:GetTopProductsReq req)
+    UpdateBundleComponentsResp UpdateBundleComponents(1:UpdateBundleComponentsReq req)
+    SetProductTranslationResp SetProductTranslation(1:SetProductTranslationReq req)
+    DeleteProductTranslationResp DeleteProductTranslation(1:DeleteProductTranslationReq req)
+    ListProductTranslationsResp ListProductTranslations(1:ListProductTranslationsReq req)
+    SetCategoryTranslationResp SetCategoryTranslation(1:SetCategoryTranslationReq req)
+    DeleteCategoryTranslationResp DeleteCategoryTranslation(1:DeleteCategoryTranslationReq req)
+    ListCategoryTranslationsResp ListCategoryTranslations(1:ListCategoryTranslationsReq req)
+    AddFavoriteResp AddFavorite(1:AddFavoriteReq req)
+    RemoveFavoriteResp RemoveFavorite(1:RemoveFavoriteReq req)
+    ListFavoritesResp ListFavorites(1:ListFavoritesReq req)
+    SubscribeRestockResp SubscribeRestock(1:SubscribeRestockReq req)
+    UnsubscribeRestockResp UnsubscribeRestock(1:UnsubscribeRestockReq req)
+    ListRestockSubscriptionsResp ListRestockSubscriptions(1:ListRestockSubscriptionsReq req)
+    SetProductPriceTiersResp SetProductPriceTiers(1:SetProductPriceTiersReq req)
+    GetProductPriceTiersResp GetProductPriceTiers(1:GetProductPriceTiersReq req)
+    SetUserPriceGroupResp SetUserPriceGroup(1:SetUserPriceGroupReq req)
+    ListUserPriceGroupsResp ListUserPriceGroups(1:ListUserPriceGroupsReq req)
+    QuotePricesResp QuotePrices(1:QuotePricesReq req)
+    SetProductPurchaseLimitResp SetProductPurchaseLimit(1:SetProductPurchaseLimitReq req)
 }
\ No newline at end of file
@@ -79,3 +79,58 @@ func (oc *OrderClient) ShipOrder(ctx context.Context, req *api.PayOrderReq) (*ap
 func (oc *OrderClient) ConfirmReceipt(ctx context.Context, req *api.PayOrderReq) (*api.PayOrderResp, error) {
 	return oc.client.ConfirmReceipt(ctx, req)
 }
+
+// CreateReview 评价订单商品
+func (oc *OrderClient) CreateReview(ctx context.Context, req *api.CreateReviewReq) (*api.CreateReviewResp, error) {
+	return oc.client.CreateReview(ctx, req)
+}
+
+// ListReviews 查询评价列表
+func (oc *OrderClient) ListReviews(ctx context.Context, req *api.ListReviewsReq) (*api.ListReviewsResp, error) {
+	return oc.client.ListReviews(ctx, req)
+}
+
+// ModerateReview 审核评价
+func (oc *OrderClient) ModerateReview(ctx context.Context, req *api.ModerateReviewReq) (*api.ModerateReviewResp, error) {
+	return oc.client.ModerateReview(ctx, req)
+}
+
+// ReplyReview 回复评价
+func (oc *OrderClient) ReplyReview(ctx context.Context, req *api.ReplyReviewReq) (*api.ReplyReviewResp, error) {
+	return oc.client.ReplyReview(ctx, req)
+}
+
+// CreateFlashSale 创建秒杀活动
+func (oc *OrderClient) CreateFlashSale(ctx context.Context, req *api.CreateFlashSaleReq) (*api.CreateFlashSaleResp, error) {
+	return oc.client.CreateFlashSale(ctx, req)
+}
+
+// GetFlashSale 查询秒杀活动
+func (oc *OrderClient) GetFlashSale(ctx context.Context, req *api.GetFlashSaleReq) (*api.GetFlashSaleResp, error) {
+	return oc.client.GetFlashSale(ctx, req)
+}
+
+// ListFlashSales 查询秒杀活动列表
+func (oc *OrderClient) ListFlashSales(ctx context.Context, req *api.ListFlashSalesReq) (*api.ListFlashSalesResp, error) {
+	return oc.client.ListFlashSales(ctx, req)
+}
+
+// SubmitFlashSaleOrder 秒杀下单
+func (oc *OrderClient) SubmitFlashSaleOrder(ctx context.Context, req *api.SubmitFlashSaleOrderReq) (*api.SubmitFlashSaleOrderResp, error) {
+	return oc.client.SubmitFlashSaleOrder(ctx, req)
+}
+
+// GetFlashSaleRequest 查询秒杀请求结果
+func (oc *OrderClient) GetFlashSaleRequest(ctx context.Context, req *api.GetFlashSaleRequestReq) (*api.GetFlashSaleRequestResp, error) {
+	return oc.client.GetFlashSaleRequest(ctx, req)
+}
+
+// ReconcileFlashSale 秒杀活动对账
+func (oc *OrderClient) ReconcileFlashSale(ctx context.Context, req *api.ReconcileFlashSaleReq) (*api.ReconcileFlashSaleResp, error) {
+	return oc.client.ReconcileFlashSale(ctx, req)
+}
+
+// GetPurchaseAllowances 查询限购商品的剩余可购数量
+func (oc *OrderClient) GetPurchaseAllowances(ctx context.Context, req *api.GetPurchaseAllowancesReq) (*api.GetPurchaseAllowancesResp, error) {
+	return oc.client.GetPurchaseAllowances(ctx, req)
+}
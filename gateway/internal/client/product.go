@@ -44,3 +44,283 @@ func (pc *ProductClient) UserSearchProducts(ctx context.Context, req *api.UserSe
 func (pc *ProductClient) AdminSearchProducts(ctx context.Context, req *api.AdminSearchProductsReq) (*api.AdminSearchProductsResp, error) {
 	return pc.client.AdminSearchProducts(ctx, req)
 }
+
+// BatchGetProducts 批量获取商品
+func (pc *ProductClient) BatchGetProducts(ctx context.Context, req *api.BatchGetProductsReq) (*api.BatchGetProductsResp, error) {
+	return pc.client.BatchGetProducts(ctx, req)
+}
+
+// AdjustStock 调整库存（管理员）
+func (pc *ProductClient) AdjustStock(ctx context.Context, req *api.AdjustStockReq) (*api.AdjustStockResp, error) {
+	return pc.client.AdjustStock(ctx, req)
+}
+
+// ListStockMovements 查询库存流水（管理员）
+func (pc *ProductClient) ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq) (*api.ListStockMovementsResp, error) {
+	return pc.client.ListStockMovements(ctx, req)
+}
+
+// ReconcileStock 库存对账（管理员）
+func (pc *ProductClient) ReconcileStock(ctx context.Context, req *api.ReconcileStockReq) (*api.ReconcileStockResp, error) {
+	return pc.client.ReconcileStock(ctx, req)
+}
+
+// CreateWarehouse 创建仓库（管理员）
+func (pc *ProductClient) CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq) (*api.CreateWarehouseResp, error) {
+	return pc.client.CreateWarehouse(ctx, req)
+}
+
+// UpdateWarehouse 更新仓库（管理员）
+func (pc *ProductClient) UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq) (*api.UpdateWarehouseResp, error) {
+	return pc.client.UpdateWarehouse(ctx, req)
+}
+
+// ListWarehouses 仓库列表（管理员）
+func (pc *ProductClient) ListWarehouses(ctx context.Context, req *api.ListWarehousesReq) (*api.ListWarehousesResp, error) {
+	return pc.client.ListWarehouses(ctx, req)
+}
+
+// GetWarehouseStocks 查询商品分仓库存（管理员）
+func (pc *ProductClient) GetWarehouseStocks(ctx context.Context, req *api.GetWarehouseStocksReq) (*api.GetWarehouseStocksResp, error) {
+	return pc.client.GetWarehouseStocks(ctx, req)
+}
+
+// ListLowStockProducts 低库存商品列表（管理员）
+func (pc *ProductClient) ListLowStockProducts(ctx context.Context, req *api.ListLowStockProductsReq) (*api.ListLowStockProductsResp, error) {
+	return pc.client.ListLowStockProducts(ctx, req)
+}
+
+// CreatePriceSchedule 创建调价计划（管理员）
+func (pc *ProductClient) CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq) (*api.CreatePriceScheduleResp, error) {
+	return pc.client.CreatePriceSchedule(ctx, req)
+}
+
+// ListPriceSchedules 查询调价计划（管理员）
+func (pc *ProductClient) ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq) (*api.ListPriceSchedulesResp, error) {
+	return pc.client.ListPriceSchedules(ctx, req)
+}
+
+// CancelPriceSchedule 取消调价计划（管理员）
+func (pc *ProductClient) CancelPriceSchedule(ctx context.Context, req *api.CancelPriceScheduleReq) (*api.CancelPriceScheduleResp, error) {
+	return pc.client.CancelPriceSchedule(ctx, req)
+}
+
+// GetPriceHistory 查询价格历史（管理员）
+func (pc *ProductClient) GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq) (*api.GetPriceHistoryResp, error) {
+	return pc.client.GetPriceHistory(ctx, req)
+}
+
+// ListProductStatusSchedules 查询定时上下架计划（管理员）
+func (pc *ProductClient) ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq) (*api.ListProductStatusSchedulesResp, error) {
+	return pc.client.ListProductStatusSchedules(ctx, req)
+}
+
+// CancelProductStatusSchedule 取消定时上下架计划（管理员）
+func (pc *ProductClient) CancelProductStatusSchedule(ctx context.Context, req *api.CancelProductStatusScheduleReq) (*api.CancelProductStatusScheduleResp, error) {
+	return pc.client.CancelProductStatusSchedule(ctx, req)
+}
+
+// ImportProducts 批量导入商品（管理员）
+func (pc *ProductClient) ImportProducts(ctx context.Context, req *api.ImportProductsReq) (*api.ImportProductsResp, error) {
+	return pc.client.ImportProducts(ctx, req)
+}
+
+// CreateAttributeDefinition 创建属性定义（管理员）
+func (pc *ProductClient) CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq) (*api.CreateAttributeDefinitionResp, error) {
+	return pc.client.CreateAttributeDefinition(ctx, req)
+}
+
+// UpdateAttributeDefinition 更新属性定义（管理员）
+func (pc *ProductClient) UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq) (*api.UpdateAttributeDefinitionResp, error) {
+	return pc.client.UpdateAttributeDefinition(ctx, req)
+}
+
+// ListAttributeDefinitions 查询属性定义
+func (pc *ProductClient) ListAttributeDefinitions(ctx context.Context, req *api.ListAttributeDefinitionsReq) (*api.ListAttributeDefinitionsResp, error) {
+	return pc.client.ListAttributeDefinitions(ctx, req)
+}
+
+// DeleteAttributeDefinition 删除属性定义（管理员）
+func (pc *ProductClient) DeleteAttributeDefinition(ctx context.Context, req *api.DeleteAttributeDefinitionReq) (*api.DeleteAttributeDefinitionResp, error) {
+	return pc.client.DeleteAttributeDefinition(ctx, req)
+}
+
+// ListProductAudit 查询商品审计日志（管理员）
+func (pc *ProductClient) ListProductAudit(ctx context.Context, req *api.ListProductAuditReq) (*api.ListProductAuditResp, error) {
+	return pc.client.ListProductAudit(ctx, req)
+}
+
+// GetRelatedProducts 查询相关商品
+func (pc *ProductClient) GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq) (*api.GetRelatedProductsResp, error) {
+	return pc.client.GetRelatedProducts(ctx, req)
+}
+
+// GetFrequentlyBoughtTogether 查询经常一起购买的商品
+func (pc *ProductClient) GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq) (*api.GetFrequentlyBoughtTogetherResp, error) {
+	return pc.client.GetFrequentlyBoughtTogether(ctx, req)
+}
+
+// CreateBrand 创建品牌（管理员）
+func (pc *ProductClient) CreateBrand(ctx context.Context, req *api.CreateBrandReq) (*api.CreateBrandResp, error) {
+	return pc.client.CreateBrand(ctx, req)
+}
+
+// UpdateBrand 更新品牌（管理员）
+func (pc *ProductClient) UpdateBrand(ctx context.Context, req *api.UpdateBrandReq) (*api.UpdateBrandResp, error) {
+	return pc.client.UpdateBrand(ctx, req)
+}
+
+// GetBrand 查询品牌
+func (pc *ProductClient) GetBrand(ctx context.Context, req *api.GetBrandReq) (*api.GetBrandResp, error) {
+	return pc.client.GetBrand(ctx, req)
+}
+
+// ListBrands 查询品牌列表
+func (pc *ProductClient) ListBrands(ctx context.Context, req *api.ListBrandsReq) (*api.ListBrandsResp, error) {
+	return pc.client.ListBrands(ctx, req)
+}
+
+// DeleteBrand 删除品牌（管理员）
+func (pc *ProductClient) DeleteBrand(ctx context.Context, req *api.DeleteBrandReq) (*api.DeleteBrandResp, error) {
+	return pc.client.DeleteBrand(ctx, req)
+}
+
+// SubmitProductApproval 提交商品上架审核（管理员）
+func (pc *ProductClient) SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq) (*api.SubmitProductApprovalResp, error) {
+	return pc.client.SubmitProductApproval(ctx, req)
+}
+
+// ReviewProductApproval 审核商品（审核员）
+func (pc *ProductClient) ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq) (*api.ReviewProductApprovalResp, error) {
+	return pc.client.ReviewProductApproval(ctx, req)
+}
+
+// ListProductApprovals 查询商品审核记录
+func (pc *ProductClient) ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq) (*api.ListProductApprovalsResp, error) {
+	return pc.client.ListProductApprovals(ctx, req)
+}
+
+// CreateMerchant 创建商家（管理员）
+func (pc *ProductClient) CreateMerchant(ctx context.Context, req *api.CreateMerchantReq) (*api.CreateMerchantResp, error) {
+	return pc.client.CreateMerchant(ctx, req)
+}
+
+// UpdateMerchant 更新商家（管理员）
+func (pc *ProductClient) UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq) (*api.UpdateMerchantResp, error) {
+	return pc.client.UpdateMerchant(ctx, req)
+}
+
+// GetMerchant 查询商家
+func (pc *ProductClient) GetMerchant(ctx context.Context, req *api.GetMerchantReq) (*api.GetMerchantResp, error) {
+	return pc.client.GetMerchant(ctx, req)
+}
+
+// ListMerchants 查询商家列表（管理员）
+func (pc *ProductClient) ListMerchants(ctx context.Context, req *api.ListMerchantsReq) (*api.ListMerchantsResp, error) {
+	return pc.client.ListMerchants(ctx, req)
+}
+
+// SuggestProducts 搜索联想
+func (pc *ProductClient) SuggestProducts(ctx context.Context, req *api.SuggestProductsReq) (*api.SuggestProductsResp, error) {
+	return pc.client.SuggestProducts(ctx, req)
+}
+
+// ListSearchQueries 查询搜索词统计（管理员）
+func (pc *ProductClient) ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq) (*api.ListSearchQueriesResp, error) {
+	return pc.client.ListSearchQueries(ctx, req)
+}
+
+// GetTopProducts 查询热门商品
+func (pc *ProductClient) GetTopProducts(ctx context.Context, req *api.GetTopProductsReq) (*api.GetTopProductsResp, error) {
+	return pc.client.GetTopProducts(ctx, req)
+}
+
+// UpdateBundleComponents 修改套装组件
+func (pc *ProductClient) UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq) (*api.UpdateBundleComponentsResp, error) {
+	return pc.client.UpdateBundleComponents(ctx, req)
+}
+
+// SetProductTranslation 保存商品翻译
+func (pc *ProductClient) SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq) (*api.SetProductTranslationResp, error) {
+	return pc.client.SetProductTranslation(ctx, req)
+}
+
+// DeleteProductTranslation 删除商品翻译
+func (pc *ProductClient) DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq) (*api.DeleteProductTranslationResp, error) {
+	return pc.client.DeleteProductTranslation(ctx, req)
+}
+
+// ListProductTranslations 查询商品翻译
+func (pc *ProductClient) ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq) (*api.ListProductTranslationsResp, error) {
+	return pc.client.ListProductTranslations(ctx, req)
+}
+
+// SetCategoryTranslation 保存分类翻译
+func (pc *ProductClient) SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq) (*api.SetCategoryTranslationResp, error) {
+	return pc.client.SetCategoryTranslation(ctx, req)
+}
+
+// DeleteCategoryTranslation 删除分类翻译
+func (pc *ProductClient) DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq) (*api.DeleteCategoryTranslationResp, error) {
+	return pc.client.DeleteCategoryTranslation(ctx, req)
+}
+
+// ListCategoryTranslations 查询分类翻译
+func (pc *ProductClient) ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq) (*api.ListCategoryTranslationsResp, error) {
+	return pc.client.ListCategoryTranslations(ctx, req)
+}
+
+// AddFavorite 收藏商品
+func (pc *ProductClient) AddFavorite(ctx context.Context, req *api.AddFavoriteReq) (*api.AddFavoriteResp, error) {
+	return pc.client.AddFavorite(ctx, req)
+}
+
+// RemoveFavorite 取消收藏
+func (pc *ProductClient) RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq) (*api.RemoveFavoriteResp, error) {
+	return pc.client.RemoveFavorite(ctx, req)
+}
+
+// ListFavorites 查询用户的收藏
+func (pc *ProductClient) ListFavorites(ctx context.Context, req *api.ListFavoritesReq) (*api.ListFavoritesResp, error) {
+	return pc.client.ListFavorites(ctx, req)
+}
+
+// SubscribeRestock 订阅到货通知
+func (pc *ProductClient) SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq) (*api.SubscribeRestockResp, error) {
+	return pc.client.SubscribeRestock(ctx, req)
+}
+
+// UnsubscribeRestock 取消到货通知订阅
+func (pc *ProductClient) UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq) (*api.UnsubscribeRestockResp, error) {
+	return pc.client.UnsubscribeRestock(ctx, req)
+}
+
+// ListRestockSubscriptions 查询用户的到货通知订阅
+func (pc *ProductClient) ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq) (*api.ListRestockSubscriptionsResp, error) {
+	return pc.client.ListRestockSubscriptions(ctx, req)
+}
+
+// SetProductPurchaseLimit 设置商品每人限购
+func (pc *ProductClient) SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq) (*api.SetProductPurchaseLimitResp, error) {
+	return pc.client.SetProductPurchaseLimit(ctx, req)
+}
+
+// SetProductPriceTiers 设置商品阶梯价
+func (pc *ProductClient) SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq) (*api.SetProductPriceTiersResp, error) {
+	return pc.client.SetProductPriceTiers(ctx, req)
+}
+
+// GetProductPriceTiers 查询商品阶梯价
+func (pc *ProductClient) GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq) (*api.GetProductPriceTiersResp, error) {
+	return pc.client.GetProductPriceTiers(ctx, req)
+}
+
+// SetUserPriceGroup 设置用户价格组
+func (pc *ProductClient) SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq) (*api.SetUserPriceGroupResp, error) {
+	return pc.client.SetUserPriceGroup(ctx, req)
+}
+
+// ListUserPriceGroups 查询价格组成员
+func (pc *ProductClient) ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq) (*api.ListUserPriceGroupsResp, error) {
+	return pc.client.ListUserPriceGroups(ctx, req)
+}
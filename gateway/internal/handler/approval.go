@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// SubmitProductApproval 提交商品上架审核（管理员）
+func SubmitProductApproval(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req api.SubmitProductApprovalReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.ProductId = productID
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+
+		resp, err := clientManager.ProductClient.SubmitProductApproval(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "提交审核失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Approval)
+	}
+}
+
+// ReviewProductApproval 审核通过或驳回商品（审核员），驳回时 comment 必填
+func ReviewProductApproval(clientManager *client.ClientManager, approved bool) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "审核记录ID格式错误")
+			return
+		}
+
+		var req api.ReviewProductApprovalReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.Id = id
+		req.Approved = approved
+		reviewer := getOperatorFromContext(ctx)
+		req.Reviewer = &reviewer
+
+		resp, err := clientManager.ProductClient.ReviewProductApproval(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "审核商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"approval": resp.Approval,
+			"product":  resp.Product,
+		})
+	}
+}
+
+// ListProductApprovals 查询商品审核记录，路径中带商品ID时只查该商品
+func ListProductApprovals(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListProductApprovalsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		productIDStr := ctx.Param("id")
+		if productIDStr == "" {
+			productIDStr = ctx.Query("product_id")
+		}
+		if productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+		if statusStr := ctx.Query("status"); statusStr != "" {
+			status, err := api.ApprovalStatusFromString(strings.ToUpper(statusStr))
+			if err != nil {
+				response.Error(ctx, 400, "审核状态错误")
+				return
+			}
+			req.Status = &status
+		}
+		if kindStr := ctx.Query("kind"); kindStr != "" {
+			kind, err := api.ApprovalKindFromString(strings.ToUpper(kindStr))
+			if err != nil {
+				response.Error(ctx, 400, "审核类型错误")
+				return
+			}
+			req.Kind = &kind
+		}
+
+		resp, err := clientManager.ProductClient.ListProductApprovals(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询审核记录失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Approvals, int64(resp.Total), page, pageSize)
+	}
+}
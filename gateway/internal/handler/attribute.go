@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// CreateAttributeDefinition 创建分类属性定义（管理员）
+func CreateAttributeDefinition(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.CreateAttributeDefinitionReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		resp, err := clientManager.ProductClient.CreateAttributeDefinition(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "创建属性定义失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Definition)
+	}
+}
+
+// UpdateAttributeDefinition 更新分类属性定义（管理员）
+func UpdateAttributeDefinition(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "属性定义ID格式错误")
+			return
+		}
+
+		var req api.UpdateAttributeDefinitionReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.Id = id
+
+		resp, err := clientManager.ProductClient.UpdateAttributeDefinition(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "更新属性定义失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Definition)
+	}
+}
+
+// DeleteAttributeDefinition 删除分类属性定义（管理员）
+func DeleteAttributeDefinition(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "属性定义ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.DeleteAttributeDefinition(c, &api.DeleteAttributeDefinitionReq{Id: id})
+		if err != nil {
+			response.Error(ctx, 500, "删除属性定义失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "属性定义已删除",
+		})
+	}
+}
+
+// ListAttributeDefinitions 查询分类属性定义，路径或查询参数中的 category 为空时返回全部
+func ListAttributeDefinitions(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req := &api.ListAttributeDefinitionsReq{}
+		category := ctx.Param("category")
+		if category == "" {
+			category = ctx.Query("category")
+		}
+		if category != "" {
+			req.Category = &category
+		}
+
+		resp, err := clientManager.ProductClient.ListAttributeDefinitions(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询属性定义失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Definitions)
+	}
+}
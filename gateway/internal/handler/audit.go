@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListProductAudit 查询商品审计日志（管理员）
+func ListProductAudit(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListProductAuditReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		// 商品ID可以来自路径或查询参数
+		productIDStr := ctx.Param("id")
+		if productIDStr == "" {
+			productIDStr = ctx.Query("product_id")
+		}
+		if productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+
+		if operator := ctx.Query("operator"); operator != "" {
+			req.Operator = &operator
+		}
+		if startTime, err := strconv.ParseInt(ctx.Query("start_time"), 10, 64); err == nil {
+			req.StartTime = &startTime
+		}
+		if endTime, err := strconv.ParseInt(ctx.Query("end_time"), 10, 64); err == nil {
+			req.EndTime = &endTime
+		}
+
+		resp, err := clientManager.ProductClient.ListProductAudit(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询审计日志失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Audits, int64(resp.Total), page, pageSize)
+	}
+}
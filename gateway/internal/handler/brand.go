@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// 解析逗号分隔的品牌ID列表
+func parseBrandIDs(raw string) ([]int64, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	parts := strings.Split(raw, ",")
+	ids := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// 带品牌分面的分页响应
+func successWithBrandFacets(ctx *app.RequestContext, data interface{}, total int64, page, pageSize int, facets []*api.BrandFacet) {
+	response.Custom(ctx, 200, map[string]interface{}{
+		"code":         0,
+		"message":      "success",
+		"data":         data,
+		"total":        total,
+		"page":         page,
+		"page_size":    pageSize,
+		"brand_facets": facets,
+		"success":      true,
+	})
+}
+
+// CreateBrand 创建品牌（管理员）
+func CreateBrand(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.CreateBrandReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		resp, err := clientManager.ProductClient.CreateBrand(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "创建品牌失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Brand)
+	}
+}
+
+// UpdateBrand 更新品牌（管理员）
+func UpdateBrand(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "品牌ID格式错误")
+			return
+		}
+
+		var req api.UpdateBrandReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.Id = id
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+
+		resp, err := clientManager.ProductClient.UpdateBrand(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "更新品牌失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Brand)
+	}
+}
+
+// DeleteBrand 删除品牌（管理员），merge_into 指定时商品并入该品牌
+func DeleteBrand(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "品牌ID格式错误")
+			return
+		}
+
+		operator := getOperatorFromContext(ctx)
+		req := &api.DeleteBrandReq{
+			Id:       id,
+			Operator: &operator,
+		}
+		if mergeIntoStr := ctx.Query("merge_into"); mergeIntoStr != "" {
+			mergeInto, err := strconv.ParseInt(mergeIntoStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "合并品牌ID格式错误")
+				return
+			}
+			req.MergeIntoId = &mergeInto
+		}
+
+		resp, err := clientManager.ProductClient.DeleteBrand(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "删除品牌失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "品牌已删除",
+		})
+	}
+}
+
+// GetBrand 查询品牌详情
+func GetBrand(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "品牌ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.GetBrand(c, &api.GetBrandReq{Id: id})
+		if err != nil {
+			response.Error(ctx, 500, "查询品牌失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Brand)
+	}
+}
+
+// ListBrands 查询品牌列表，用户只能看到启用的品牌
+func ListBrands(clientManager *client.ClientManager, admin bool) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListBrandsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+		if keyword := ctx.Query("keyword"); keyword != "" {
+			req.Keyword = &keyword
+		}
+		if !admin {
+			status := api.BrandStatus_ACTIVE
+			req.Status = &status
+		} else if statusStr := ctx.Query("status"); statusStr != "" {
+			status, err := api.BrandStatusFromString(strings.ToUpper(statusStr))
+			if err != nil {
+				response.Error(ctx, 400, "品牌状态错误")
+				return
+			}
+			req.Status = &status
+		}
+
+		resp, err := clientManager.ProductClient.ListBrands(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询品牌列表失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Brands, int64(resp.Total), page, pageSize)
+	}
+}
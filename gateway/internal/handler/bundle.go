@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// UpdateBundleComponents 整体替换套装组件，套装须先下架
+func UpdateBundleComponents(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req api.UpdateBundleComponentsReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.BundleId = productID
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+
+		resp, err := clientManager.ProductClient.UpdateBundleComponents(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "修改套装组件失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Product)
+	}
+}
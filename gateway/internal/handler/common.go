@@ -32,6 +32,7 @@ func ServiceStatus(c context.Context, ctx *app.RequestContext) {
 				"/api/v1/products/:id",
 				"/api/v1/products/category/:category",
 				"/api/v1/products/search",
+				"/api/v1/products/batch",
 			},
 			"protected": []string{
 				"/api/v1/users/profile",
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// AddFavorite 收藏商品，重复收藏保留第一次收藏时的价格
+func AddFavorite(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.AddFavorite(c, &api.AddFavoriteReq{
+			UserId:    userID,
+			ProductId: productID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "收藏商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Favorite)
+	}
+}
+
+// RemoveFavorite 取消收藏
+func RemoveFavorite(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.RemoveFavorite(c, &api.RemoveFavoriteReq{
+			UserId:    userID,
+			ProductId: productID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "取消收藏失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "已取消收藏",
+		})
+	}
+}
+
+// ListMyFavorites 查询当前用户的收藏，price_dropped=true时只返回降价的商品
+func ListMyFavorites(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+
+		page, pageSize := parsePagination(ctx)
+		req := &api.ListFavoritesReq{
+			UserId:   userID,
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+			Locale:   requestLocale(ctx),
+		}
+		if priceDropped, err := strconv.ParseBool(ctx.Query("price_dropped")); err == nil {
+			req.PriceDroppedOnly = &priceDropped
+		}
+
+		resp, err := clientManager.ProductClient.ListFavorites(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询收藏失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Favorites, int64(resp.Total), page, pageSize)
+	}
+}
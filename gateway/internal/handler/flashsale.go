@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/order-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// CreateFlashSale 创建秒杀活动（管理员），从商品库存划拨活动库存
+func CreateFlashSale(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.CreateFlashSaleReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		resp, err := clientManager.OrderClient.CreateFlashSale(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "创建秒杀活动失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.FlashSale)
+	}
+}
+
+// GetFlashSale 查询秒杀活动
+func GetFlashSale(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "秒杀活动ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.OrderClient.GetFlashSale(c, &api.GetFlashSaleReq{Id: id})
+		if err != nil {
+			response.Error(ctx, 500, "查询秒杀活动失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.FlashSale)
+	}
+}
+
+// ListFlashSales 查询秒杀活动列表，includeEnded为true时包含已结束的活动（管理员）
+func ListFlashSales(clientManager *client.ClientManager, includeEnded bool) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, pageSize := parsePagination(ctx)
+		req := &api.ListFlashSalesReq{
+			IncludeEnded: &includeEnded,
+			Page:         int32(page),
+			PageSize:     int32(pageSize),
+		}
+
+		if productIDStr := ctx.Query("product_id"); productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+
+		resp, err := clientManager.OrderClient.ListFlashSales(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询秒杀活动失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.FlashSales, int64(resp.Total), page, pageSize)
+	}
+}
+
+// SubmitFlashSaleOrder 秒杀下单，抢到后返回排队中的请求，通过请求ID查询下单结果
+func SubmitFlashSaleOrder(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "秒杀活动ID格式错误")
+			return
+		}
+
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+
+		var req api.SubmitFlashSaleOrderReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		req.FlashSaleId = id
+		req.UserId = userID
+
+		resp, err := clientManager.OrderClient.SubmitFlashSaleOrder(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "秒杀下单失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.Request)
+	}
+}
+
+// GetFlashSaleRequest 查询当前用户的秒杀请求结果
+func GetFlashSaleRequest(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		requestID := ctx.Param("request_id")
+		if requestID == "" {
+			response.Error(ctx, 400, "请求ID不能为空")
+			return
+		}
+
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+
+		resp, err := clientManager.OrderClient.GetFlashSaleRequest(c, &api.GetFlashSaleRequestReq{
+			RequestId: requestID,
+			UserId:    userID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "查询秒杀请求失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.Request)
+	}
+}
+
+// ReconcileFlashSale 秒杀活动对账（管理员），未售出的库存退回商品
+func ReconcileFlashSale(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "秒杀活动ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.OrderClient.ReconcileFlashSale(c, &api.ReconcileFlashSaleReq{Id: id})
+		if err != nil {
+			response.Error(ctx, 500, "秒杀活动对账失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.Reconciliation)
+	}
+}
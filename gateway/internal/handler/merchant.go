@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// 辅助函数：获取商家账号绑定的商家ID，非商家账号返回false
+func getMerchantIDFromContext(ctx *app.RequestContext) (int64, bool) {
+	merchantID := ctx.GetInt64("merchant_id")
+	if merchantID <= 0 || ctx.GetString("role") != "merchant" {
+		return 0, false
+	}
+	return merchantID, true
+}
+
+// MerchantOwnsProduct 校验路径中的商品属于当前商家，用于商家路由
+func MerchantOwnsProduct(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		merchantID, ok := getMerchantIDFromContext(ctx)
+		if !ok {
+			response.Error(ctx, 403, "需要商家权限")
+			ctx.Abort()
+			return
+		}
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			ctx.Abort()
+			return
+		}
+
+		resp, err := clientManager.ProductClient.GetProduct(c, &api.GetProductReq{Id: productID})
+		if err != nil {
+			response.Error(ctx, 500, "获取商品信息失败: "+err.Error())
+			ctx.Abort()
+			return
+		}
+		if !resp.Success || resp.Product == nil {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			ctx.Abort()
+			return
+		}
+		// 不暴露其他商家的商品是否存在
+		if resp.Product.MerchantId != merchantID {
+			response.Error(ctx, 404, "商品不存在")
+			ctx.Abort()
+			return
+		}
+		ctx.Next(c)
+	}
+}
+
+// CreateMerchant 创建商家（管理员）
+func CreateMerchant(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.CreateMerchantReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		resp, err := clientManager.ProductClient.CreateMerchant(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "创建商家失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Merchant)
+	}
+}
+
+// UpdateMerchant 更新商家（管理员）
+func UpdateMerchant(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商家ID格式错误")
+			return
+		}
+
+		var req api.UpdateMerchantReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.Id = id
+
+		resp, err := clientManager.ProductClient.UpdateMerchant(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "更新商家失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Merchant)
+	}
+}
+
+// GetMerchant 查询商家详情，商家账号只能查询自己
+func GetMerchant(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		id, ok := getMerchantIDFromContext(ctx)
+		if !ok {
+			var err error
+			id, err = strconv.ParseInt(ctx.Param("id"), 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商家ID格式错误")
+				return
+			}
+		}
+
+		resp, err := clientManager.ProductClient.GetMerchant(c, &api.GetMerchantReq{Id: id})
+		if err != nil {
+			response.Error(ctx, 500, "查询商家失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Merchant)
+	}
+}
+
+// ListMerchants 查询商家列表（管理员）
+func ListMerchants(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListMerchantsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+		if keyword := ctx.Query("keyword"); keyword != "" {
+			req.Keyword = &keyword
+		}
+		if statusStr := ctx.Query("status"); statusStr != "" {
+			status, err := api.MerchantStatusFromString(strings.ToUpper(statusStr))
+			if err != nil {
+				response.Error(ctx, 400, "商家状态错误")
+				return
+			}
+			req.Status = &status
+		}
+
+		resp, err := clientManager.ProductClient.ListMerchants(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询商家列表失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Merchants, int64(resp.Total), page, pageSize)
+	}
+}
@@ -47,10 +47,11 @@ func CreateOrder(clientManager *client.ClientManager) app.HandlerFunc {
 		}
 
 		response.Success(ctx, map[string]interface{}{
-			"order_no":     resp.OrderNo,
-			"total_amount": resp.TotalAmount,
-			"message":      resp.Message,
-			"payment_url":  safeString(resp.PaymentUrl),
+			"order_no":      resp.OrderNo,
+			"total_amount":  resp.TotalAmount,
+			"message":       resp.Message,
+			"payment_url":   safeString(resp.PaymentUrl),
+			"sub_order_nos": resp.SubOrderNos,
 		})
 	}
 }
@@ -127,6 +128,49 @@ func ListOrders(clientManager *client.ClientManager) app.HandlerFunc {
 	}
 }
 
+// ListMerchantOrders 查询当前商家的订单（商家）
+func ListMerchantOrders(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		merchantID, ok := getMerchantIDFromContext(ctx)
+		if !ok {
+			response.Error(ctx, 403, "需要商家权限")
+			return
+		}
+
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 10
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListOrdersReq{
+			MerchantId: &merchantID,
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+		}
+
+		resp, err := clientManager.OrderClient.ListOrders(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询订单列表失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Orders, int64(resp.Total), page, pageSize)
+	}
+}
+
 // PayOrder 支付订单
 func PayOrder(clientManager *client.ClientManager) app.HandlerFunc {
 	return func(c context.Context, ctx *app.RequestContext) {
@@ -259,7 +303,7 @@ func ApplyRefund(clientManager *client.ClientManager) app.HandlerFunc {
 	}
 }
 
-// ProcessRefund 处理退款（管理员）
+// ProcessRefund 处理退款（管理员、商家）
 func ProcessRefund(clientManager *client.ClientManager) app.HandlerFunc {
 	return func(c context.Context, ctx *app.RequestContext) {
 		refundNo := ctx.Param("refund_no")
@@ -275,6 +319,10 @@ func ProcessRefund(clientManager *client.ClientManager) app.HandlerFunc {
 		}
 
 		req.RefundNo = refundNo
+		// 商家只能处理自己订单的退款
+		if merchantID, ok := getMerchantIDFromContext(ctx); ok {
+			req.MerchantId = &merchantID
+		}
 
 		resp, err := clientManager.OrderClient.ProcessRefund(c, &req)
 		if err != nil {
@@ -295,7 +343,7 @@ func ProcessRefund(clientManager *client.ClientManager) app.HandlerFunc {
 	}
 }
 
-// ShipOrder 发货订单（管理员）
+// ShipOrder 发货订单（管理员、商家）
 func ShipOrder(clientManager *client.ClientManager) app.HandlerFunc {
 	return func(c context.Context, ctx *app.RequestContext) {
 		orderNo := ctx.Param("order_no")
@@ -304,8 +352,22 @@ func ShipOrder(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		var body struct {
+			ShippingNo string `json:"shipping_no"`
+		}
+		if err := ctx.BindJSON(&body); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		// 发货复用PayOrderReq，物流单号放在PaymentNo中
 		req := &api.PayOrderReq{
-			OrderNo: orderNo,
+			OrderNo:   orderNo,
+			PaymentNo: &body.ShippingNo,
+		}
+		// 商家只能对自己的订单发货
+		if merchantID, ok := getMerchantIDFromContext(ctx); ok {
+			req.MerchantId = &merchantID
 		}
 
 		resp, err := clientManager.OrderClient.ShipOrder(c, req)
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GetTopProducts 查询热门商品，支持24h(默认)、7d、30d窗口和类目筛选
+func GetTopProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req := &api.GetTopProductsReq{
+			Window: ctx.Query("window"),
+			Locale: requestLocale(ctx),
+		}
+		if category := ctx.Query("category"); category != "" {
+			req.Category = &category
+		}
+		if sortBy := ctx.Query("sort_by"); sortBy != "" {
+			req.SortBy = &sortBy
+		}
+		if limit, err := strconv.Atoi(ctx.Query("limit")); err == nil {
+			req.Limit = int32(limit)
+		}
+
+		resp, err := clientManager.ProductClient.GetTopProducts(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询热门商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Products)
+	}
+}
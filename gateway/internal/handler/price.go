@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// CreatePriceScheduleRequest 创建调价计划请求
+type CreatePriceScheduleRequest struct {
+	Price   float64 `json:"price"`
+	StartAt int64   `json:"start_at"`
+	EndAt   int64   `json:"end_at"`
+}
+
+// CreatePriceSchedule 创建调价计划（管理员）
+func CreatePriceSchedule(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req CreatePriceScheduleRequest
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		operator := getOperatorFromContext(ctx)
+		scheduleReq := &api.CreatePriceScheduleReq{
+			ProductId: productID,
+			Price:     req.Price,
+			StartAt:   req.StartAt,
+			Operator:  &operator,
+		}
+		if req.EndAt > 0 {
+			scheduleReq.EndAt = &req.EndAt
+		}
+
+		resp, err := clientManager.ProductClient.CreatePriceSchedule(c, scheduleReq)
+		if err != nil {
+			response.Error(ctx, 500, "创建调价计划失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Schedule)
+	}
+}
+
+// ListPriceSchedules 查询调价计划（管理员）
+func ListPriceSchedules(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListPriceSchedulesReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+		if productIDStr := ctx.Query("product_id"); productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+		if status := ctx.Query("status"); status != "" {
+			req.Status = &status
+		}
+
+		resp, err := clientManager.ProductClient.ListPriceSchedules(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询调价计划失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Schedules, int64(resp.Total), page, pageSize)
+	}
+}
+
+// CancelPriceSchedule 取消调价计划（管理员）
+func CancelPriceSchedule(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		scheduleID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "调价计划ID格式错误")
+			return
+		}
+
+		operator := getOperatorFromContext(ctx)
+		resp, err := clientManager.ProductClient.CancelPriceSchedule(c, &api.CancelPriceScheduleReq{
+			Id:       scheduleID,
+			Operator: &operator,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "取消调价计划失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "调价计划已取消",
+		})
+	}
+}
+
+// GetPriceHistory 查询价格历史（管理员）
+func GetPriceHistory(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		resp, err := clientManager.ProductClient.GetPriceHistory(c, &api.GetPriceHistoryReq{
+			ProductId: productID,
+			Page:      int32(page),
+			PageSize:  int32(pageSize),
+		})
+		if err != nil {
+			response.Error(ctx, 500, "查询价格历史失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.History, int64(resp.Total), page, pageSize)
+	}
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// 辅助函数：登录用户的ID，用于按价格组展示阶梯价；未登录时返回nil
+func priceUserID(ctx *app.RequestContext) *int64 {
+	userID, _ := getUserIDFromContext(ctx)
+	if userID <= 0 {
+		return nil
+	}
+	return &userID
+}
+
+// SetProductPriceTiers 整体替换商品的阶梯价
+func SetProductPriceTiers(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req api.SetProductPriceTiersReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.ProductId = productID
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+
+		resp, err := clientManager.ProductClient.SetProductPriceTiers(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "设置阶梯价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Tiers)
+	}
+}
+
+// GetProductPriceTiers 查询商品设置的全部阶梯价
+func GetProductPriceTiers(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.GetProductPriceTiers(c, &api.GetProductPriceTiersReq{
+			ProductId: productID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "查询阶梯价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Tiers)
+	}
+}
+
+// SetUserPriceGroup 设置用户的价格组，group为空时移出价格组
+func SetUserPriceGroup(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "用户ID格式错误")
+			return
+		}
+
+		var req api.SetUserPriceGroupReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.UserId = userID
+
+		resp, err := clientManager.ProductClient.SetUserPriceGroup(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "设置用户价格组失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": safeString(resp.Message),
+		})
+	}
+}
+
+// ListUserPriceGroups 分页查询价格组成员
+func ListUserPriceGroups(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, pageSize := parsePagination(ctx)
+		req := &api.ListUserPriceGroupsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+		if group := ctx.Query("group"); group != "" {
+			req.Group = &group
+		}
+
+		resp, err := clientManager.ProductClient.ListUserPriceGroups(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询价格组失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Members, int64(resp.Total), page, pageSize)
+	}
+}
@@ -32,6 +32,13 @@ func CreateProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+		// 商家只能为自己创建商品
+		if merchantID, ok := getMerchantIDFromContext(ctx); ok {
+			req.MerchantId = &merchantID
+		}
+
 		resp, err := clientManager.ProductClient.CreateProduct(c, &req)
 		if err != nil {
 			response.Error(ctx, 500, "创建商品失败: "+err.Error())
@@ -63,8 +70,13 @@ func GetProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		countView := true
+		userID := priceUserID(ctx)
 		req := &api.GetProductReq{
-			Id: productID,
+			Id:        productID,
+			CountView: &countView,
+			Locale:    requestLocale(ctx),
+			UserId:    userID,
 		}
 
 		resp, err := clientManager.ProductClient.GetProduct(c, req)
@@ -78,10 +90,40 @@ func GetProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		fillRemainingPurchaseQuantity(c, clientManager, resp.Product, userID)
 		response.Success(ctx, resp.Product)
 	}
 }
 
+// BatchGetProducts 批量获取商品详情
+func BatchGetProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.BatchGetProductsReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		if len(req.Ids) == 0 {
+			response.Error(ctx, 400, "商品ID列表不能为空")
+			return
+		}
+		req.Locale = requestLocale(ctx)
+
+		resp, err := clientManager.ProductClient.BatchGetProducts(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "批量获取商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Products)
+	}
+}
+
 // UpdateProduct 更新商品（管理员）
 func UpdateProduct(clientManager *client.ClientManager) app.HandlerFunc {
 	return func(c context.Context, ctx *app.RequestContext) {
@@ -98,6 +140,8 @@ func UpdateProduct(clientManager *client.ClientManager) app.HandlerFunc {
 		}
 
 		req.Id = productID
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
 
 		resp, err := clientManager.ProductClient.UpdateProduct(c, &req)
 		if err != nil {
@@ -110,9 +154,16 @@ func UpdateProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
-		response.Success(ctx, map[string]interface{}{
+		data := map[string]interface{}{
 			"message": "商品更新成功",
-		})
+			"version": resp.Product.GetVersion(),
+		}
+		// 已审核商品的内容修改需审核后生效
+		if resp.Revision != nil {
+			data["message"] = safeString(resp.Message)
+			data["revision"] = resp.Revision
+		}
+		response.Success(ctx, data)
 	}
 }
 
@@ -123,6 +174,7 @@ func ListProducts(clientManager *client.ClientManager) app.HandlerFunc {
 		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 		keyword := ctx.Query("keyword")
 		category := ctx.Query("category")
+		sortBy := ctx.Query("sort_by")
 
 		if page <= 0 {
 			page = 1
@@ -137,6 +189,7 @@ func ListProducts(clientManager *client.ClientManager) app.HandlerFunc {
 		req := &api.UserSearchProductsReq{
 			Page:     int32(page),
 			PageSize: int32(pageSize),
+			Locale:   requestLocale(ctx),
 		}
 
 		if keyword != "" {
@@ -145,6 +198,16 @@ func ListProducts(clientManager *client.ClientManager) app.HandlerFunc {
 		if category != "" {
 			req.Category = &category
 		}
+		if sortBy != "" {
+			req.SortBy = &sortBy
+		}
+		// brand_ids 为逗号分隔的品牌ID
+		brandIDs, err := parseBrandIDs(ctx.Query("brand_ids"))
+		if err != nil {
+			response.Error(ctx, 400, "品牌ID格式错误")
+			return
+		}
+		req.BrandIds = brandIDs
 
 		resp, err := clientManager.ProductClient.UserSearchProducts(c, req)
 		if err != nil {
@@ -152,7 +215,12 @@ func ListProducts(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
-		response.SuccessWithPagination(ctx, resp.Products, int64(resp.Total), page, pageSize)
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.GetMessage())
+			return
+		}
+
+		successWithBrandFacets(ctx, resp.Products, int64(resp.Total), page, pageSize, resp.BrandFacets)
 	}
 }
 
@@ -179,6 +247,7 @@ func GetProductsByCategory(clientManager *client.ClientManager) app.HandlerFunc
 			Page:     int32(page),
 			PageSize: int32(pageSize),
 			Category: &category,
+			Locale:   requestLocale(ctx),
 		}
 
 		resp, err := clientManager.ProductClient.UserSearchProducts(c, req)
@@ -199,6 +268,8 @@ func SearchProducts(clientManager *client.ClientManager) app.HandlerFunc {
 			response.Error(ctx, 400, "参数错误: "+err.Error())
 			return
 		}
+		// 价格组按登录用户计算，忽略请求中的userId
+		req.UserId = priceUserID(ctx)
 
 		resp, err := clientManager.ProductClient.UserSearchProducts(c, &req)
 		if err != nil {
@@ -206,7 +277,7 @@ func SearchProducts(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
-		response.SuccessWithPagination(ctx, resp.Products, int64(resp.Total), int(req.Page), int(req.PageSize))
+		successWithBrandFacets(ctx, resp.Products, int64(resp.Total), int(req.Page), int(req.PageSize), resp.BrandFacets)
 	}
 }
 
@@ -218,6 +289,10 @@ func AdminSearchProducts(clientManager *client.ClientManager) app.HandlerFunc {
 			response.Error(ctx, 400, "参数错误: "+err.Error())
 			return
 		}
+		// 商家只能搜索自己的商品
+		if merchantID, ok := getMerchantIDFromContext(ctx); ok {
+			req.MerchantId = &merchantID
+		}
 
 		resp, err := clientManager.ProductClient.AdminSearchProducts(c, &req)
 		if err != nil {
@@ -225,7 +300,7 @@ func AdminSearchProducts(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
-		response.SuccessWithPagination(ctx, resp.Products, int64(resp.Total), int(req.Page), int(req.PageSize))
+		successWithBrandFacets(ctx, resp.Products, int64(resp.Total), int(req.Page), int(req.PageSize), resp.BrandFacets)
 	}
 }
 
@@ -241,6 +316,17 @@ func OnlineProduct(clientManager *client.ClientManager) app.HandlerFunc {
 		req := &api.OnlineProductReq{
 			Id: productID,
 		}
+		// execute_at 为未来的时间戳时创建定时上架计划
+		if executeAtStr := ctx.Query("execute_at"); executeAtStr != "" {
+			executeAt, err := strconv.ParseInt(executeAtStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "执行时间格式错误")
+				return
+			}
+			operator := getOperatorFromContext(ctx)
+			req.ExecuteAt = &executeAt
+			req.Operator = &operator
+		}
 
 		resp, err := clientManager.ProductClient.OnlineProduct(c, req)
 		if err != nil {
@@ -253,6 +339,15 @@ func OnlineProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		if resp.ScheduleId != nil {
+			response.Success(ctx, map[string]interface{}{
+				"message":     "定时上架计划已创建",
+				"schedule_id": *resp.ScheduleId,
+				"execute_at":  resp.OperatedAt,
+			})
+			return
+		}
+
 		response.Success(ctx, map[string]interface{}{
 			"message": "商品上架成功",
 		})
@@ -271,6 +366,17 @@ func OfflineProduct(clientManager *client.ClientManager) app.HandlerFunc {
 		req := &api.OfflineProductReq{
 			Id: productID,
 		}
+		// execute_at 为未来的时间戳时创建定时下架计划
+		if executeAtStr := ctx.Query("execute_at"); executeAtStr != "" {
+			executeAt, err := strconv.ParseInt(executeAtStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "执行时间格式错误")
+				return
+			}
+			operator := getOperatorFromContext(ctx)
+			req.ExecuteAt = &executeAt
+			req.Operator = &operator
+		}
 
 		resp, err := clientManager.ProductClient.OfflineProduct(c, req)
 		if err != nil {
@@ -283,6 +389,15 @@ func OfflineProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		if resp.ScheduleId != nil {
+			response.Success(ctx, map[string]interface{}{
+				"message":     "定时下架计划已创建",
+				"schedule_id": *resp.ScheduleId,
+				"execute_at":  resp.OperatedAt,
+			})
+			return
+		}
+
 		response.Success(ctx, map[string]interface{}{
 			"message": "商品下架成功",
 		})
@@ -298,8 +413,10 @@ func DeleteProduct(clientManager *client.ClientManager) app.HandlerFunc {
 			return
 		}
 
+		operator := getOperatorFromContext(ctx)
 		req := &api.DeleteProductReq{
-			Id: productID,
+			Id:       productID,
+			Operator: &operator,
 		}
 
 		resp, err := clientManager.ProductClient.DeleteProduct(c, req)
@@ -0,0 +1,378 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+)
+
+const (
+	// 导出时每次拉取的商品数量
+	exportPageSize = 100
+	// 上传文件大小上限
+	maxImportFileSize = 10 * 1024 * 1024
+)
+
+// UTF-8 BOM，便于表格软件正确识别中文
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// 导出列，导入时按表头名称识别，id 列会被忽略
+var productCSVHeader = []string{"id", "external_code", "name", "category", "price", "stock", "brand", "status", "image_url"}
+
+// 导入必填列
+var requiredImportColumns = []string{"external_code", "name", "category", "price", "stock"}
+
+// 商品状态与CSV文本的对应关系
+var productStatusNames = map[api.ProductStatus]string{
+	api.ProductStatus_DRAFT:    "draft",
+	api.ProductStatus_ONLINE:   "online",
+	api.ProductStatus_OFFLINE:  "offline",
+	api.ProductStatus_DELETED:  "deleted",
+	api.ProductStatus_SOLD_OUT: "sold_out",
+}
+
+// ImportProducts 通过CSV批量导入商品（管理员），dry_run=true 时只校验不写入
+func ImportProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		data, err := readImportFile(ctx)
+		if err != nil {
+			response.Error(ctx, 400, err.Error())
+			return
+		}
+
+		rows, parseErrors, err := parseProductCSV(data)
+		if err != nil {
+			response.Error(ctx, 400, "CSV解析失败: "+err.Error())
+			return
+		}
+
+		dryRun, _ := strconv.ParseBool(ctx.Query("dry_run"))
+		total := int32(len(rows) + countErrorLines(parseErrors))
+		result := &api.ImportProductsResp{
+			Success: true,
+			Total:   total,
+			Errors:  parseErrors,
+			Failed:  int32(countErrorLines(parseErrors)),
+			DryRun:  dryRun,
+		}
+
+		if len(rows) > 0 {
+			operator := getOperatorFromContext(ctx)
+			importResp, err := clientManager.ProductClient.ImportProducts(c, &api.ImportProductsReq{
+				Rows:     rows,
+				DryRun:   dryRun,
+				Operator: &operator,
+			})
+			if err != nil {
+				response.Error(ctx, 500, "导入商品失败: "+err.Error())
+				return
+			}
+			if !importResp.Success {
+				response.Error(ctx, int(importResp.Code), safeString(importResp.Message))
+				return
+			}
+			result.Created = importResp.Created
+			result.Updated = importResp.Updated
+			result.Failed += importResp.Failed
+			result.Errors = append(result.Errors, importResp.Errors...)
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"dry_run": result.DryRun,
+			"total":   result.Total,
+			"created": result.Created,
+			"updated": result.Updated,
+			"failed":  result.Failed,
+			"errors":  result.Errors,
+		})
+	}
+}
+
+// ExportProducts 按管理员搜索条件流式导出商品CSV（管理员）
+func ExportProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req, err := parseExportQuery(ctx)
+		if err != nil {
+			response.Error(ctx, 400, err.Error())
+			return
+		}
+		req.Page = 1
+		req.PageSize = exportPageSize
+
+		// 先取第一页，出错时还能返回普通的错误响应
+		searchResp, err := clientManager.ProductClient.AdminSearchProducts(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "导出商品失败: "+err.Error())
+			return
+		}
+		if !searchResp.Success {
+			response.Error(ctx, int(searchResp.Code), safeString(searchResp.Message))
+			return
+		}
+
+		ctx.SetStatusCode(200)
+		ctx.SetContentType("text/csv; charset=utf-8")
+		ctx.Response.Header.Set("Content-Disposition", `attachment; filename="products.csv"`)
+		ctx.Response.HijackWriter(resp.NewChunkedBodyWriter(&ctx.Response, ctx.GetWriter()))
+
+		ctx.Write(utf8BOM)
+		writer := csv.NewWriter(ctx)
+		writer.Write(productCSVHeader)
+
+		exported := 0
+		for {
+			for _, p := range searchResp.Products {
+				writer.Write(productToCSVRecord(p))
+			}
+			exported += len(searchResp.Products)
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				hlog.CtxErrorf(c, "写入导出数据失败: %v", err)
+				return
+			}
+			if err := ctx.Flush(); err != nil {
+				hlog.CtxErrorf(c, "发送导出数据失败: %v", err)
+				return
+			}
+			if len(searchResp.Products) < exportPageSize || exported >= int(searchResp.Total) {
+				return
+			}
+
+			req.Page++
+			searchResp, err = clientManager.ProductClient.AdminSearchProducts(c, req)
+			if err != nil || !searchResp.Success {
+				// 响应头已发出，只能中断输出
+				hlog.CtxErrorf(c, "导出商品中断: page=%d, err=%v", req.Page, err)
+				return
+			}
+		}
+	}
+}
+
+// 读取上传的CSV，支持 multipart 的 file 字段或直接以请求体上传
+func readImportFile(ctx *app.RequestContext) ([]byte, error) {
+	if fileHeader, err := ctx.FormFile("file"); err == nil {
+		if fileHeader.Size > maxImportFileSize {
+			return nil, errors.New("文件不能超过10MB")
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, errors.New("读取文件失败")
+		}
+		defer file.Close()
+		data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+		if err != nil {
+			return nil, errors.New("读取文件失败")
+		}
+		return data, nil
+	}
+	data := ctx.Request.Body()
+	if len(data) == 0 {
+		return nil, errors.New("请上传CSV文件")
+	}
+	if len(data) > maxImportFileSize {
+		return nil, errors.New("文件不能超过10MB")
+	}
+	return data, nil
+}
+
+// 解析CSV，返回可提交的行和本地解析出错的行
+func parseProductCSV(data []byte) ([]*api.ProductImportRow, []*api.ImportRowError, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("文件为空")
+		}
+		return nil, nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredImportColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("缺少必填列 %s", name)
+		}
+	}
+
+	var rows []*api.ProductImportRow
+	var rowErrors []*api.ImportRowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, err
+			}
+			rowErrors = append(rowErrors, &api.ImportRowError{
+				Line:    int32(parseErr.StartLine),
+				Message: "CSV格式错误: " + parseErr.Err.Error(),
+			})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		if isBlankRecord(record) {
+			continue
+		}
+		row, errs := buildImportRow(int32(line), record, columns)
+		if len(errs) > 0 {
+			rowErrors = append(rowErrors, errs...)
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
+
+// 将一行CSV转换为导入行，数值和状态格式错误在网关直接返回
+func buildImportRow(line int32, record []string, columns map[string]int) (*api.ProductImportRow, []*api.ImportRowError) {
+	field := func(name string) (string, bool) {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return "", false
+		}
+		return strings.TrimSpace(record[i]), true
+	}
+	var errs []*api.ImportRowError
+	addErr := func(name, message string) {
+		errs = append(errs, &api.ImportRowError{Line: line, Field: name, Message: message})
+	}
+
+	row := &api.ProductImportRow{Line: line}
+	row.ExternalCode, _ = field("external_code")
+	row.Name, _ = field("name")
+	row.Category, _ = field("category")
+
+	priceStr, _ := field("price")
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil {
+		addErr("price", "价格格式错误")
+	}
+	row.Price = price
+
+	stockStr, _ := field("stock")
+	stock, err := strconv.ParseInt(stockStr, 10, 32)
+	if err != nil {
+		addErr("stock", "库存格式错误")
+	}
+	row.Stock = int32(stock)
+
+	if brand, ok := field("brand"); ok && brand != "" {
+		row.Brand = &brand
+	}
+	if avatar, ok := field("image_url"); ok && avatar != "" {
+		row.Avatar = &avatar
+	}
+	if statusStr, ok := field("status"); ok && statusStr != "" {
+		status, err := parseProductStatus(statusStr)
+		if err != nil {
+			addErr("status", err.Error())
+		} else {
+			row.Status = &status
+		}
+	}
+	return row, errs
+}
+
+// 解析商品状态，支持数字和英文名称
+func parseProductStatus(value string) (api.ProductStatus, error) {
+	if n, err := strconv.Atoi(value); err == nil {
+		status := api.ProductStatus(n)
+		if _, ok := productStatusNames[status]; ok {
+			return status, nil
+		}
+	}
+	for status, name := range productStatusNames {
+		if strings.EqualFold(value, name) {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("无效的商品状态: %s", value)
+}
+
+// 解析导出的筛选条件，与管理员搜索一致
+func parseExportQuery(ctx *app.RequestContext) (*api.AdminSearchProductsReq, error) {
+	req := &api.AdminSearchProductsReq{}
+	if idStr := ctx.Query("id"); idStr != "" {
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return nil, errors.New("商品ID格式错误")
+		}
+		req.Id = &id
+	}
+	if category := ctx.Query("category"); category != "" {
+		req.Category = &category
+	}
+	if keyword := ctx.Query("keyword"); keyword != "" {
+		req.Keyword = &keyword
+	}
+	if minStr := ctx.Query("min_price"); minStr != "" {
+		minPrice, err := strconv.ParseFloat(minStr, 64)
+		if err != nil {
+			return nil, errors.New("最低价格格式错误")
+		}
+		req.MinPrice = &minPrice
+	}
+	if maxStr := ctx.Query("max_price"); maxStr != "" {
+		maxPrice, err := strconv.ParseFloat(maxStr, 64)
+		if err != nil {
+			return nil, errors.New("最高价格格式错误")
+		}
+		req.MaxPrice = &maxPrice
+	}
+	return req, nil
+}
+
+// 商品转为导出行
+func productToCSVRecord(p *api.Product) []string {
+	return []string{
+		strconv.FormatInt(p.Id, 10),
+		safeString(p.ExternalCode),
+		p.Name,
+		p.Category,
+		strconv.FormatFloat(p.Price, 'f', 2, 64),
+		strconv.FormatInt(int64(p.Stock), 10),
+		safeString(p.Brand),
+		productStatusNames[p.Status],
+		p.Avatar,
+	}
+}
+
+// 空行直接跳过
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// 统计出错的行数，同一行可能有多条错误
+func countErrorLines(errs []*api.ImportRowError) int {
+	lines := make(map[int32]struct{}, len(errs))
+	for _, e := range errs {
+		lines[e.Line] = struct{}{}
+	}
+	return len(lines)
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	orderapi "ecommerce/order-service/kitex_gen/api"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// SetProductPurchaseLimit 设置商品每人限购，limit为0时取消限购
+func SetProductPurchaseLimit(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req api.SetProductPurchaseLimitReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.ProductId = productID
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+
+		resp, err := clientManager.ProductClient.SetProductPurchaseLimit(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "设置每人限购失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Product)
+	}
+}
+
+// 辅助函数：限购商品填充登录用户的剩余可购数量，查询失败时不影响商品详情
+func fillRemainingPurchaseQuantity(c context.Context, clientManager *client.ClientManager, product *api.Product, userID *int64) {
+	if product == nil || product.PurchaseLimit <= 0 || userID == nil {
+		return
+	}
+	resp, err := clientManager.OrderClient.GetPurchaseAllowances(c, &orderapi.GetPurchaseAllowancesReq{
+		UserId:     *userID,
+		ProductIds: []int64{product.Id},
+	})
+	if err != nil {
+		hlog.CtxWarnf(c, "查询剩余可购数量失败: product=%d, err=%v", product.Id, err)
+		return
+	}
+	if !resp.Success {
+		hlog.CtxWarnf(c, "查询剩余可购数量失败: product=%d, err=%s", product.Id, resp.Message)
+		return
+	}
+	for _, allowance := range resp.Allowances {
+		if allowance.ProductId == product.Id {
+			remaining := allowance.Remaining
+			product.RemainingPurchaseQuantity = &remaining
+		}
+	}
+}
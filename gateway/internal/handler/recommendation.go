@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GetRelatedProducts 查询相关商品
+func GetRelatedProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		req := &api.GetRelatedProductsReq{
+			ProductId: productID,
+			Locale:    requestLocale(ctx),
+		}
+		if limit, err := strconv.Atoi(ctx.Query("limit")); err == nil {
+			req.Limit = int32(limit)
+		}
+
+		resp, err := clientManager.ProductClient.GetRelatedProducts(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询相关商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Products)
+	}
+}
+
+// GetFrequentlyBoughtTogether 查询经常一起购买的商品
+func GetFrequentlyBoughtTogether(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		req := &api.GetFrequentlyBoughtTogetherReq{
+			ProductId: productID,
+			Locale:    requestLocale(ctx),
+		}
+		if limit, err := strconv.Atoi(ctx.Query("limit")); err == nil {
+			req.Limit = int32(limit)
+		}
+
+		resp, err := clientManager.ProductClient.GetFrequentlyBoughtTogether(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询一起购买商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Products)
+	}
+}
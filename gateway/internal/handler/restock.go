@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// SubscribeRestock 订阅商品到货通知
+func SubscribeRestock(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.SubscribeRestock(c, &api.SubscribeRestockReq{
+			UserId:    userID,
+			ProductId: productID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "订阅到货通知失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Subscription)
+	}
+}
+
+// UnsubscribeRestock 取消到货通知订阅
+func UnsubscribeRestock(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.UnsubscribeRestock(c, &api.UnsubscribeRestockReq{
+			UserId:    userID,
+			ProductId: productID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "取消到货订阅失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "已取消订阅",
+		})
+	}
+}
+
+// ListMyRestockSubscriptions 查询当前用户的到货通知订阅
+func ListMyRestockSubscriptions(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+
+		page, pageSize := parsePagination(ctx)
+		req := &api.ListRestockSubscriptionsReq{
+			UserId:   userID,
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		resp, err := clientManager.ProductClient.ListRestockSubscriptions(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询到货订阅失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Subscriptions, int64(resp.Total), page, pageSize)
+	}
+}
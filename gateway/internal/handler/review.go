@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/order-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// parseReviewStatus 解析评价状态，支持 pending/approved/hidden
+func parseReviewStatus(status string) (api.ReviewStatus, bool) {
+	reviewStatus, err := api.ReviewStatusFromString(strings.ToUpper(status))
+	if err != nil {
+		return api.ReviewStatus_PENDING, false
+	}
+	return reviewStatus, true
+}
+
+// parsePagination 解析分页参数
+func parsePagination(ctx *app.RequestContext) (int, int) {
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize
+}
+
+// CreateReview 评价已完成订单中的商品
+func CreateReview(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		orderNo := ctx.Param("order_no")
+		if orderNo == "" {
+			response.Error(ctx, 400, "订单号不能为空")
+			return
+		}
+
+		itemID, err := strconv.ParseInt(ctx.Param("item_id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "订单商品ID格式错误")
+			return
+		}
+
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+
+		var req api.CreateReviewReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		req.OrderNo = orderNo
+		req.OrderItemId = itemID
+		req.UserId = userID
+
+		resp, err := clientManager.OrderClient.CreateReview(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "提交评价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.Review)
+	}
+}
+
+// ListMyReviews 查询当前用户的评价
+func ListMyReviews(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		userID, _ := getUserIDFromContext(ctx)
+		if userID == 0 {
+			response.Error(ctx, 401, "用户未登录")
+			return
+		}
+
+		page, pageSize := parsePagination(ctx)
+		req := &api.ListReviewsReq{
+			UserId:   &userID,
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		resp, err := clientManager.OrderClient.ListReviews(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询评价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Reviews, int64(resp.Total), page, pageSize)
+	}
+}
+
+// ListProductReviews 查询商品评价（仅展示审核通过的评价）
+func ListProductReviews(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		page, pageSize := parsePagination(ctx)
+		status := api.ReviewStatus_APPROVED
+		req := &api.ListReviewsReq{
+			ProductId: &productID,
+			Status:    &status,
+			Page:      int32(page),
+			PageSize:  int32(pageSize),
+		}
+
+		resp, err := clientManager.OrderClient.ListReviews(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询评价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Reviews, int64(resp.Total), page, pageSize)
+	}
+}
+
+// ListReviews 查询评价列表（管理员）
+func ListReviews(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, pageSize := parsePagination(ctx)
+		req := &api.ListReviewsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		if productIDStr := ctx.Query("product_id"); productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+		if userIDStr := ctx.Query("user_id"); userIDStr != "" {
+			userID, err := strconv.ParseInt(userIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "用户ID格式错误")
+				return
+			}
+			req.UserId = &userID
+		}
+		if statusStr := ctx.Query("status"); statusStr != "" {
+			status, ok := parseReviewStatus(statusStr)
+			if !ok {
+				response.Error(ctx, 400, "评价状态无效")
+				return
+			}
+			req.Status = &status
+		}
+
+		resp, err := clientManager.OrderClient.ListReviews(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询评价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Reviews, int64(resp.Total), page, pageSize)
+	}
+}
+
+// ModerateReview 审核评价（管理员）
+func ModerateReview(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		reviewID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "评价ID格式错误")
+			return
+		}
+
+		var body struct {
+			Status string `json:"status"`
+		}
+		if err := ctx.BindJSON(&body); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		status, ok := parseReviewStatus(body.Status)
+		if !ok {
+			response.Error(ctx, 400, "评价状态无效")
+			return
+		}
+
+		operatorID, _ := getUserIDFromContext(ctx)
+		req := &api.ModerateReviewReq{
+			Id:         reviewID,
+			Status:     status,
+			OperatorId: operatorID,
+		}
+
+		resp, err := clientManager.OrderClient.ModerateReview(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "审核评价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.Review)
+	}
+}
+
+// ReplyReview 回复评价（管理员）
+func ReplyReview(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		reviewID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "评价ID格式错误")
+			return
+		}
+
+		var req api.ReplyReviewReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		req.Id = reviewID
+		req.OperatorId, _ = getUserIDFromContext(ctx)
+
+		resp, err := clientManager.OrderClient.ReplyReview(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "回复评价失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), resp.Message)
+			return
+		}
+
+		response.Success(ctx, resp.Review)
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListProductStatusSchedules 查询定时上下架计划（管理员）
+func ListProductStatusSchedules(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListProductStatusSchedulesReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+		if productIDStr := ctx.Query("product_id"); productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+		if status := ctx.Query("status"); status != "" {
+			req.Status = &status
+		}
+
+		resp, err := clientManager.ProductClient.ListProductStatusSchedules(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询定时计划失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Schedules, int64(resp.Total), page, pageSize)
+	}
+}
+
+// CancelProductStatusSchedule 取消定时上下架计划（管理员）
+func CancelProductStatusSchedule(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		scheduleID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "定时计划ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.CancelProductStatusSchedule(c, &api.CancelProductStatusScheduleReq{
+			Id: scheduleID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "取消定时计划失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "定时计划已取消",
+		})
+	}
+}
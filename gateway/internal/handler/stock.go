@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// AdjustStockRequest 调整库存请求
+type AdjustStockRequest struct {
+	Delta       int32  `json:"delta"`
+	Reason      string `json:"reason"`
+	ReferenceID string `json:"reference_id"`
+	WarehouseID int64  `json:"warehouse_id"`
+}
+
+// 辅助函数：获取当前操作人，优先使用用户名
+func getOperatorFromContext(ctx *app.RequestContext) string {
+	if username := ctx.GetString("username"); username != "" {
+		return username
+	}
+	if userID, err := getUserIDFromContext(ctx); err == nil {
+		return fmt.Sprintf("管理员-%d", userID)
+	}
+	return ""
+}
+
+// 辅助函数：解析库存变动原因，为空时视为人工调整
+func parseStockChangeReason(reason string) (api.StockChangeReason, error) {
+	if reason == "" {
+		return api.StockChangeReason_MANUAL_ADJUST, nil
+	}
+	return api.StockChangeReasonFromString(reason)
+}
+
+// AdjustStock 调整商品库存（管理员）
+func AdjustStock(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req AdjustStockRequest
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		if req.Delta == 0 {
+			response.Error(ctx, 400, "库存变动数量不能为0")
+			return
+		}
+
+		reason, err := parseStockChangeReason(req.Reason)
+		if err != nil {
+			response.Error(ctx, 400, "无效的库存变动原因")
+			return
+		}
+
+		operator := getOperatorFromContext(ctx)
+		adjustReq := &api.AdjustStockReq{
+			ProductId: productID,
+			Delta:     req.Delta,
+			Reason:    reason,
+			Operator:  &operator,
+		}
+		if req.ReferenceID != "" {
+			adjustReq.ReferenceId = &req.ReferenceID
+		}
+		if req.WarehouseID > 0 {
+			adjustReq.WarehouseId = &req.WarehouseID
+		}
+
+		resp, err := clientManager.ProductClient.AdjustStock(c, adjustReq)
+		if err != nil {
+			response.Error(ctx, 500, "调整库存失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"product_id":  productID,
+			"balance":     resp.Balance,
+			"movement_id": resp.MovementId,
+		})
+	}
+}
+
+// ListStockMovements 查询库存流水（管理员）
+func ListStockMovements(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListStockMovementsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		// 商品ID可以来自路径或查询参数
+		productIDStr := ctx.Param("id")
+		if productIDStr == "" {
+			productIDStr = ctx.Query("product_id")
+		}
+		if productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+
+		if reasonStr := ctx.Query("reason"); reasonStr != "" {
+			reason, err := parseStockChangeReason(reasonStr)
+			if err != nil {
+				response.Error(ctx, 400, "无效的库存变动原因")
+				return
+			}
+			req.Reason = &reason
+		}
+		if referenceID := ctx.Query("reference_id"); referenceID != "" {
+			req.ReferenceId = &referenceID
+		}
+		if startTime, err := strconv.ParseInt(ctx.Query("start_time"), 10, 64); err == nil {
+			req.StartTime = &startTime
+		}
+		if endTime, err := strconv.ParseInt(ctx.Query("end_time"), 10, 64); err == nil {
+			req.EndTime = &endTime
+		}
+
+		resp, err := clientManager.ProductClient.ListStockMovements(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询库存流水失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Movements, int64(resp.Total), page, pageSize)
+	}
+}
+
+// ReconcileStock 库存对账（管理员）
+func ReconcileStock(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req := &api.ReconcileStockReq{}
+		if productIDStr := ctx.Query("product_id"); productIDStr != "" {
+			productID, err := strconv.ParseInt(productIDStr, 10, 64)
+			if err != nil {
+				response.Error(ctx, 400, "商品ID格式错误")
+				return
+			}
+			req.ProductId = &productID
+		}
+
+		resp, err := clientManager.ProductClient.ReconcileStock(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "库存对账失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"checked":       resp.Checked,
+			"discrepancies": resp.Discrepancies,
+			"message":       safeString(resp.Message),
+		})
+	}
+}
+
+// ListLowStockProducts 低库存商品列表（管理员）
+func ListLowStockProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListLowStockProductsReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		resp, err := clientManager.ProductClient.ListLowStockProducts(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询低库存商品失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Products, int64(resp.Total), page, pageSize)
+	}
+}
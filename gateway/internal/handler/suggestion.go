@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// SuggestProducts 搜索框联想，支持名称前缀和拼音首字母
+func SuggestProducts(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		prefix := ctx.Query("prefix")
+		if prefix == "" {
+			response.Error(ctx, 400, "搜索前缀不能为空")
+			return
+		}
+
+		req := &api.SuggestProductsReq{
+			Prefix: prefix,
+		}
+		if limit, err := strconv.Atoi(ctx.Query("limit")); err == nil {
+			req.Limit = int32(limit)
+		}
+
+		resp, err := clientManager.ProductClient.SuggestProducts(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询联想词失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Suggestions)
+	}
+}
+
+// ListSearchQueries 查询热门搜索词和无结果搜索词（管理员）
+func ListSearchQueries(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		page, _ := strconv.Atoi(ctx.Query("page"))
+		pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+		if page <= 0 {
+			page = 1
+		}
+		if pageSize <= 0 {
+			pageSize = 20
+		}
+		if pageSize > 100 {
+			pageSize = 100
+		}
+
+		req := &api.ListSearchQueriesReq{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+		if sortBy := ctx.Query("sort_by"); sortBy != "" {
+			req.SortBy = &sortBy
+		}
+		if zeroResultOnly, err := strconv.ParseBool(ctx.Query("zero_result_only")); err == nil {
+			req.ZeroResultOnly = &zeroResultOnly
+		}
+
+		resp, err := clientManager.ProductClient.ListSearchQueries(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询搜索词统计失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.SuccessWithPagination(ctx, resp.Queries, int64(resp.Total), page, pageSize)
+	}
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"golang.org/x/text/language"
+)
+
+// 辅助函数：取Accept-Language中优先级最高的语言，未提供或无法解析时返回nil，由商品服务使用默认语言
+func requestLocale(ctx *app.RequestContext) *string {
+	header := string(ctx.GetHeader("Accept-Language"))
+	if header == "" {
+		return nil
+	}
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 || tags[0] == language.Und {
+		return nil
+	}
+	locale := tags[0].String()
+	return &locale
+}
+
+// SetProductTranslation 新增或覆盖商品某个语言的名称和描述
+func SetProductTranslation(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		var req api.SetProductTranslationReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.ProductId = productID
+		req.Locale = ctx.Param("locale")
+		operator := getOperatorFromContext(ctx)
+		req.Operator = &operator
+
+		resp, err := clientManager.ProductClient.SetProductTranslation(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "保存商品翻译失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Translation)
+	}
+}
+
+// DeleteProductTranslation 删除商品某个语言的翻译
+func DeleteProductTranslation(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		operator := getOperatorFromContext(ctx)
+		resp, err := clientManager.ProductClient.DeleteProductTranslation(c, &api.DeleteProductTranslationReq{
+			ProductId: productID,
+			Locale:    ctx.Param("locale"),
+			Operator:  &operator,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "删除商品翻译失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "商品翻译已删除",
+		})
+	}
+}
+
+// ListProductTranslations 查询商品的全部翻译
+func ListProductTranslations(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		resp, err := clientManager.ProductClient.ListProductTranslations(c, &api.ListProductTranslationsReq{
+			ProductId: productID,
+		})
+		if err != nil {
+			response.Error(ctx, 500, "查询商品翻译失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Translations)
+	}
+}
+
+// SetCategoryTranslation 新增或覆盖分类某个语言的名称
+func SetCategoryTranslation(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.SetCategoryTranslationReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		resp, err := clientManager.ProductClient.SetCategoryTranslation(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "保存分类翻译失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Translation)
+	}
+}
+
+// DeleteCategoryTranslation 删除分类某个语言的翻译
+func DeleteCategoryTranslation(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		resp, err := clientManager.ProductClient.DeleteCategoryTranslation(c, &api.DeleteCategoryTranslationReq{
+			Category: ctx.Query("category"),
+			Locale:   ctx.Query("locale"),
+		})
+		if err != nil {
+			response.Error(ctx, 500, "删除分类翻译失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, map[string]interface{}{
+			"message": "分类翻译已删除",
+		})
+	}
+}
+
+// ListCategoryTranslations 查询分类翻译，可按分类和语言筛选
+func ListCategoryTranslations(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req := &api.ListCategoryTranslationsReq{}
+		if category := ctx.Query("category"); category != "" {
+			req.Category = &category
+		}
+		if locale := ctx.Query("locale"); locale != "" {
+			req.Locale = &locale
+		}
+
+		resp, err := clientManager.ProductClient.ListCategoryTranslations(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询分类翻译失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Translations)
+	}
+}
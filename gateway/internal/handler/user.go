@@ -16,16 +16,24 @@ import (
 
 // 辅助函数：获取当前用户ID（从上下文中）
 func getUserIDFromContext(ctx *app.RequestContext) (int64, error) {
-	userIDStr := ctx.GetString("user_id")
-	if userIDStr == "" {
+	value, exists := ctx.Get("user_id")
+	if !exists {
 		return 0, errors.New("用户未登录")
 	}
 
-	userID, err := strconv.ParseInt(userIDStr, 10, 64)
-	if err != nil {
+	// JWT中间件写入的是int64，兼容字符串形式
+	switch userID := value.(type) {
+	case int64:
+		return userID, nil
+	case string:
+		id, err := strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			return 0, errors.New("用户ID格式错误")
+		}
+		return id, nil
+	default:
 		return 0, errors.New("用户ID格式错误")
 	}
-	return userID, nil
 }
 
 // 辅助函数：从头部获取Token
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"ecommerce/gateway/internal/client"
+	"ecommerce/gateway/pkg/response"
+	"ecommerce/product-service/kitex_gen/api"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// CreateWarehouse 创建仓库（管理员）
+func CreateWarehouse(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		var req api.CreateWarehouseReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+
+		resp, err := clientManager.ProductClient.CreateWarehouse(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "创建仓库失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Warehouse)
+	}
+}
+
+// UpdateWarehouse 更新仓库（管理员）
+func UpdateWarehouse(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		warehouseID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "仓库ID格式错误")
+			return
+		}
+
+		var req api.UpdateWarehouseReq
+		if err := ctx.BindAndValidate(&req); err != nil {
+			response.Error(ctx, 400, "参数错误: "+err.Error())
+			return
+		}
+		req.Id = warehouseID
+
+		resp, err := clientManager.ProductClient.UpdateWarehouse(c, &req)
+		if err != nil {
+			response.Error(ctx, 500, "更新仓库失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Warehouse)
+	}
+}
+
+// ListWarehouses 仓库列表（管理员）
+func ListWarehouses(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		req := &api.ListWarehousesReq{
+			ActiveOnly: ctx.Query("active_only") == "true",
+		}
+
+		resp, err := clientManager.ProductClient.ListWarehouses(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "获取仓库列表失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Warehouses)
+	}
+}
+
+// GetWarehouseStocks 查询商品分仓库存（管理员）
+func GetWarehouseStocks(clientManager *client.ClientManager) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		productID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+		if err != nil {
+			response.Error(ctx, 400, "商品ID格式错误")
+			return
+		}
+
+		req := &api.GetWarehouseStocksReq{
+			ProductIds: []int64{productID},
+		}
+
+		resp, err := clientManager.ProductClient.GetWarehouseStocks(c, req)
+		if err != nil {
+			response.Error(ctx, 500, "查询仓库库存失败: "+err.Error())
+			return
+		}
+
+		if !resp.Success {
+			response.Error(ctx, int(resp.Code), safeString(resp.Message))
+			return
+		}
+
+		response.Success(ctx, resp.Stocks)
+	}
+}
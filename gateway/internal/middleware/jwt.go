@@ -15,92 +15,94 @@ import (
 // JWT中间件
 func JWTAuth(cfg config.JWTConfig) app.HandlerFunc {
 	return func(c context.Context, ctx *app.RequestContext) {
-		// 从请求头获取token
-		authHeader := string(ctx.GetHeader("Authorization"))
-		if authHeader == "" {
+		claims, msg := parseToken(cfg, string(ctx.GetHeader("Authorization")))
+		if msg != "" {
 			ctx.JSON(401, map[string]interface{}{
 				"code":    401,
-				"message": "未提供认证令牌",
+				"message": msg,
 			})
 			ctx.Abort()
 			return
 		}
+		setClaims(ctx, claims)
+		ctx.Next(c)
+	}
+}
 
-		// 检查Bearer格式
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			ctx.JSON(401, map[string]interface{}{
-				"code":    401,
-				"message": "认证令牌格式错误",
-			})
-			ctx.Abort()
-			return
+// OptionalJWTAuth 可选认证中间件，令牌有效时写入用户信息，未提供或无效时按匿名用户继续处理
+func OptionalJWTAuth(cfg config.JWTConfig) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		authHeader := string(ctx.GetHeader("Authorization"))
+		if authHeader != "" {
+			if claims, msg := parseToken(cfg, authHeader); msg == "" {
+				setClaims(ctx, claims)
+			}
 		}
+		ctx.Next(c)
+	}
+}
 
-		tokenString := parts[1]
+// parseToken 解析并验证Authorization请求头，失败时返回错误提示
+func parseToken(cfg config.JWTConfig, authHeader string) (jwt.MapClaims, string) {
+	if authHeader == "" {
+		return nil, "未提供认证令牌"
+	}
 
-		// 解析和验证token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// 验证签名算法
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return []byte(cfg.Secret), nil
-		})
+	// 检查Bearer格式
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, "认证令牌格式错误"
+	}
 
-		if err != nil {
-			hlog.Errorf("JWT解析失败: %v", err)
-			ctx.JSON(401, map[string]interface{}{
-				"code":    401,
-				"message": "认证令牌无效",
-			})
-			ctx.Abort()
-			return
-		}
+	tokenString := parts[1]
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			// 检查token是否过期
-			if exp, ok := claims["exp"].(float64); ok {
-				if time.Now().Unix() > int64(exp) {
-					ctx.JSON(401, map[string]interface{}{
-						"code":    401,
-						"message": "认证令牌已过期",
-					})
-					ctx.Abort()
-					return
-				}
-			}
+	// 解析和验证token
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// 验证签名算法
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(cfg.Secret), nil
+	})
 
-			// 验证签发者
-			if iss, ok := claims["iss"].(string); ok && iss != cfg.Issuer {
-				ctx.JSON(401, map[string]interface{}{
-					"code":    401,
-					"message": "认证令牌签发者无效",
-				})
-				ctx.Abort()
-				return
-			}
+	if err != nil {
+		hlog.Errorf("JWT解析失败: %v", err)
+		return nil, "认证令牌无效"
+	}
 
-			// 将用户信息存储到上下文中
-			if userID, ok := claims["user_id"].(float64); ok {
-				ctx.Set("user_id", int64(userID))
-			}
-			if username, ok := claims["username"].(string); ok {
-				ctx.Set("username", username)
-			}
-			if role, ok := claims["role"].(string); ok {
-				ctx.Set("role", role)
-			}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, "认证令牌验证失败"
+	}
 
-			ctx.Next(c)
-		} else {
-			ctx.JSON(401, map[string]interface{}{
-				"code":    401,
-				"message": "认证令牌验证失败",
-			})
-			ctx.Abort()
+	// 检查token是否过期
+	if exp, ok := claims["exp"].(float64); ok {
+		if time.Now().Unix() > int64(exp) {
+			return nil, "认证令牌已过期"
 		}
 	}
+
+	// 验证签发者
+	if iss, ok := claims["iss"].(string); ok && iss != cfg.Issuer {
+		return nil, "认证令牌签发者无效"
+	}
+	return claims, ""
+}
+
+// setClaims 将用户信息存储到上下文中
+func setClaims(ctx *app.RequestContext, claims jwt.MapClaims) {
+	if userID, ok := claims["user_id"].(float64); ok {
+		ctx.Set("user_id", int64(userID))
+	}
+	if username, ok := claims["username"].(string); ok {
+		ctx.Set("username", username)
+	}
+	if role, ok := claims["role"].(string); ok {
+		ctx.Set("role", role)
+	}
+	if merchantID, ok := claims["merchant_id"].(float64); ok {
+		ctx.Set("merchant_id", int64(merchantID))
+	}
 }
 
 // AdminOnly 管理员权限中间件
@@ -119,8 +121,41 @@ func AdminOnly() app.HandlerFunc {
 	}
 }
 
-// GenerateJWTToken 生成JWT令牌
-func GenerateJWTToken(cfg config.JWTConfig, userID int64, username, role string) (string, error) {
+// ReviewerOnly 商品审核员权限中间件
+func ReviewerOnly() app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		role, exists := ctx.Get("role")
+		if !exists || role != "reviewer" {
+			ctx.JSON(403, map[string]interface{}{
+				"code":    403,
+				"message": "需要审核员权限",
+			})
+			ctx.Abort()
+			return
+		}
+		ctx.Next(c)
+	}
+}
+
+// MerchantOnly 商家权限中间件，商家账号必须绑定商家ID
+func MerchantOnly() app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		role, exists := ctx.Get("role")
+		merchantID := ctx.GetInt64("merchant_id")
+		if !exists || role != "merchant" || merchantID <= 0 {
+			ctx.JSON(403, map[string]interface{}{
+				"code":    403,
+				"message": "需要商家权限",
+			})
+			ctx.Abort()
+			return
+		}
+		ctx.Next(c)
+	}
+}
+
+// GenerateJWTToken 生成JWT令牌，merchantID大于0时写入商家ID
+func GenerateJWTToken(cfg config.JWTConfig, userID int64, username, role string, merchantID int64) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id":  userID,
 		"username": username,
@@ -129,6 +164,9 @@ func GenerateJWTToken(cfg config.JWTConfig, userID int64, username, role string)
 		"iat":      time.Now().Unix(),
 		"exp":      time.Now().Add(time.Hour * time.Duration(cfg.ExpireHours)).Unix(),
 	}
+	if merchantID > 0 {
+		claims["merchant_id"] = merchantID
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(cfg.Secret))
@@ -21,6 +21,8 @@ func RegisterRoutes(h *server.Hertz, clientManager *client.ClientManager, cfg *c
 
 	// 公共路由（不需要认证）
 	public := v1.Group("")
+	// 登录用户浏览商品时按所在价格组展示阶梯价
+	public.Use(middleware.OptionalJWTAuth(cfg.JWT))
 	registerPublicRoutes(public, clientManager)
 
 	// 受保护路由（需要认证）
@@ -33,6 +35,18 @@ func RegisterRoutes(h *server.Hertz, clientManager *client.ClientManager, cfg *c
 	admin.Use(middleware.JWTAuth(cfg.JWT))
 	admin.Use(middleware.AdminOnly())
 	registerAdminRoutes(admin, clientManager)
+
+	// 审核路由（需要审核员权限）
+	review := v1.Group("/review")
+	review.Use(middleware.JWTAuth(cfg.JWT))
+	review.Use(middleware.ReviewerOnly())
+	registerReviewRoutes(review, clientManager)
+
+	// 商家路由（需要商家权限，只能管理自己的商品和订单）
+	merchant := v1.Group("/merchant")
+	merchant.Use(middleware.JWTAuth(cfg.JWT))
+	merchant.Use(middleware.MerchantOnly())
+	registerMerchantRoutes(merchant, clientManager)
 }
 
 func registerPublicRoutes(group *route.RouterGroup, clientManager *client.ClientManager) {
@@ -50,6 +64,19 @@ func registerPublicRoutes(group *route.RouterGroup, clientManager *client.Client
 	group.GET("/products/:id", handler.GetProduct(clientManager))
 	group.GET("/products/category/:category", handler.GetProductsByCategory(clientManager))
 	group.POST("/products/search", handler.SearchProducts(clientManager))
+	group.GET("/products/suggest", handler.SuggestProducts(clientManager))
+	group.GET("/products/top", handler.GetTopProducts(clientManager))
+	group.POST("/products/batch", handler.BatchGetProducts(clientManager))
+	group.GET("/categories/:category/attributes", handler.ListAttributeDefinitions(clientManager))
+	group.GET("/products/:id/reviews", handler.ListProductReviews(clientManager))
+	group.GET("/products/:id/related", handler.GetRelatedProducts(clientManager))
+	group.GET("/products/:id/bought-together", handler.GetFrequentlyBoughtTogether(clientManager))
+	group.GET("/brands", handler.ListBrands(clientManager, false))
+	group.GET("/brands/:id", handler.GetBrand(clientManager))
+
+	// 秒杀活动
+	group.GET("/flash-sales", handler.ListFlashSales(clientManager, false))
+	group.GET("/flash-sales/:id", handler.GetFlashSale(clientManager))
 
 	// 订单相关（部分公共接口）
 	group.GET("/orders/:order_no", handler.GetOrder(clientManager))
@@ -69,6 +96,24 @@ func registerProtectedRoutes(group *route.RouterGroup, clientManager *client.Cli
 	group.POST("/orders/:order_no/cancel", handler.CancelOrder(clientManager))
 	group.POST("/orders/:order_no/receive", handler.ConfirmReceipt(clientManager))
 	group.POST("/orders/:order_no/refund", handler.ApplyRefund(clientManager))
+
+	// 秒杀下单
+	group.POST("/flash-sales/:id/orders", handler.SubmitFlashSaleOrder(clientManager))
+	group.GET("/flash-sale-requests/:request_id", handler.GetFlashSaleRequest(clientManager))
+
+	// 评价相关
+	group.POST("/orders/:order_no/items/:item_id/review", handler.CreateReview(clientManager))
+	group.GET("/reviews/mine", handler.ListMyReviews(clientManager))
+
+	// 收藏相关
+	group.POST("/products/:id/favorite", handler.AddFavorite(clientManager))
+	group.DELETE("/products/:id/favorite", handler.RemoveFavorite(clientManager))
+	group.GET("/favorites", handler.ListMyFavorites(clientManager))
+
+	// 到货通知
+	group.POST("/products/:id/restock-subscription", handler.SubscribeRestock(clientManager))
+	group.DELETE("/products/:id/restock-subscription", handler.UnsubscribeRestock(clientManager))
+	group.GET("/restock-subscriptions", handler.ListMyRestockSubscriptions(clientManager))
 }
 
 func registerAdminRoutes(group *route.RouterGroup, clientManager *client.ClientManager) {
@@ -84,13 +129,127 @@ func registerAdminRoutes(group *route.RouterGroup, clientManager *client.ClientM
 	group.DELETE("/products/:id", handler.DeleteProduct(clientManager))
 	group.POST("/products/:id/online", handler.OnlineProduct(clientManager))
 	group.POST("/products/:id/offline", handler.OfflineProduct(clientManager))
+	group.PUT("/products/:id/bundle-components", handler.UpdateBundleComponents(clientManager))
+	group.GET("/status-schedules", handler.ListProductStatusSchedules(clientManager))
+	group.POST("/status-schedules/:id/cancel", handler.CancelProductStatusSchedule(clientManager))
 	group.POST("/products/search", handler.AdminSearchProducts(clientManager))
+	group.POST("/products/import", handler.ImportProducts(clientManager))
+	group.GET("/products/export", handler.ExportProducts(clientManager))
+
+	// 多语言内容
+	group.GET("/products/:id/translations", handler.ListProductTranslations(clientManager))
+	group.PUT("/products/:id/translations/:locale", handler.SetProductTranslation(clientManager))
+	group.DELETE("/products/:id/translations/:locale", handler.DeleteProductTranslation(clientManager))
+	group.GET("/category-translations", handler.ListCategoryTranslations(clientManager))
+	group.PUT("/category-translations", handler.SetCategoryTranslation(clientManager))
+	group.DELETE("/category-translations", handler.DeleteCategoryTranslation(clientManager))
+
+	// 阶梯价、价格组和每人限购
+	group.GET("/products/:id/price-tiers", handler.GetProductPriceTiers(clientManager))
+	group.PUT("/products/:id/price-tiers", handler.SetProductPriceTiers(clientManager))
+	group.PUT("/products/:id/purchase-limit", handler.SetProductPurchaseLimit(clientManager))
+	group.GET("/price-groups", handler.ListUserPriceGroups(clientManager))
+	group.PUT("/users/:id/price-group", handler.SetUserPriceGroup(clientManager))
+
+	// 库存管理
+	group.POST("/products/:id/stock/adjust", handler.AdjustStock(clientManager))
+	group.GET("/products/:id/stock/movements", handler.ListStockMovements(clientManager))
+	group.GET("/stock/movements", handler.ListStockMovements(clientManager))
+	group.POST("/stock/reconcile", handler.ReconcileStock(clientManager))
+	group.GET("/products/low-stock", handler.ListLowStockProducts(clientManager))
+
+	// 审计日志
+	group.GET("/products/:id/audits", handler.ListProductAudit(clientManager))
+	group.GET("/product-audits", handler.ListProductAudit(clientManager))
+
+	// 搜索词统计
+	group.GET("/search-queries", handler.ListSearchQueries(clientManager))
+
+	// 商品审核
+	group.POST("/products/:id/approvals", handler.SubmitProductApproval(clientManager))
+	group.GET("/products/:id/approvals", handler.ListProductApprovals(clientManager))
+
+	// 商家管理
+	group.POST("/merchants", handler.CreateMerchant(clientManager))
+	group.PUT("/merchants/:id", handler.UpdateMerchant(clientManager))
+	group.GET("/merchants/:id", handler.GetMerchant(clientManager))
+	group.GET("/merchants", handler.ListMerchants(clientManager))
+
+	// 品牌管理
+	group.POST("/brands", handler.CreateBrand(clientManager))
+	group.PUT("/brands/:id", handler.UpdateBrand(clientManager))
+	group.DELETE("/brands/:id", handler.DeleteBrand(clientManager))
+	group.GET("/brands", handler.ListBrands(clientManager, true))
+
+	// 属性管理
+	group.POST("/attributes", handler.CreateAttributeDefinition(clientManager))
+	group.PUT("/attributes/:id", handler.UpdateAttributeDefinition(clientManager))
+	group.DELETE("/attributes/:id", handler.DeleteAttributeDefinition(clientManager))
+	group.GET("/attributes", handler.ListAttributeDefinitions(clientManager))
+
+	// 价格管理
+	group.POST("/products/:id/price-schedules", handler.CreatePriceSchedule(clientManager))
+	group.GET("/products/:id/price-history", handler.GetPriceHistory(clientManager))
+	group.GET("/price-schedules", handler.ListPriceSchedules(clientManager))
+	group.POST("/price-schedules/:id/cancel", handler.CancelPriceSchedule(clientManager))
+
+	// 仓库管理
+	group.POST("/warehouses", handler.CreateWarehouse(clientManager))
+	group.PUT("/warehouses/:id", handler.UpdateWarehouse(clientManager))
+	group.GET("/warehouses", handler.ListWarehouses(clientManager))
+	group.GET("/products/:id/warehouse-stocks", handler.GetWarehouseStocks(clientManager))
 
 	// 订单管理
 	group.GET("/orders/all", handler.ListAllOrders(clientManager))
 	group.POST("/orders/:order_no/ship", handler.ShipOrder(clientManager))
 	group.POST("/orders/refunds/:refund_no/process", handler.ProcessRefund(clientManager))
 	group.GET("/stats/orders", handler.GetOrderStats(clientManager))
+
+	// 秒杀管理
+	group.POST("/flash-sales", handler.CreateFlashSale(clientManager))
+	group.GET("/flash-sales", handler.ListFlashSales(clientManager, true))
+	group.POST("/flash-sales/:id/reconcile", handler.ReconcileFlashSale(clientManager))
+
+	// 评价管理
+	group.GET("/reviews", handler.ListReviews(clientManager))
+	group.POST("/reviews/:id/moderate", handler.ModerateReview(clientManager))
+	group.POST("/reviews/:id/reply", handler.ReplyReview(clientManager))
+}
+
+func registerReviewRoutes(group *route.RouterGroup, clientManager *client.ClientManager) {
+	// 商品审核
+	group.GET("/product-approvals", handler.ListProductApprovals(clientManager))
+	group.POST("/product-approvals/:id/approve", handler.ReviewProductApproval(clientManager, true))
+	group.POST("/product-approvals/:id/reject", handler.ReviewProductApproval(clientManager, false))
+}
+
+func registerMerchantRoutes(group *route.RouterGroup, clientManager *client.ClientManager) {
+	group.GET("/profile", handler.GetMerchant(clientManager))
+
+	// 商品管理，路径中的商品须属于当前商家
+	owner := handler.MerchantOwnsProduct(clientManager)
+	group.POST("/products", handler.CreateProduct(clientManager))
+	group.POST("/products/search", handler.AdminSearchProducts(clientManager))
+	group.PUT("/products/:id", owner, handler.UpdateProduct(clientManager))
+	group.DELETE("/products/:id", owner, handler.DeleteProduct(clientManager))
+	group.POST("/products/:id/online", owner, handler.OnlineProduct(clientManager))
+	group.POST("/products/:id/offline", owner, handler.OfflineProduct(clientManager))
+	group.PUT("/products/:id/bundle-components", owner, handler.UpdateBundleComponents(clientManager))
+	group.GET("/products/:id/translations", owner, handler.ListProductTranslations(clientManager))
+	group.PUT("/products/:id/translations/:locale", owner, handler.SetProductTranslation(clientManager))
+	group.DELETE("/products/:id/translations/:locale", owner, handler.DeleteProductTranslation(clientManager))
+	group.GET("/products/:id/price-tiers", owner, handler.GetProductPriceTiers(clientManager))
+	group.PUT("/products/:id/price-tiers", owner, handler.SetProductPriceTiers(clientManager))
+	group.PUT("/products/:id/purchase-limit", owner, handler.SetProductPurchaseLimit(clientManager))
+	group.POST("/products/:id/stock/adjust", owner, handler.AdjustStock(clientManager))
+	group.GET("/products/:id/stock/movements", owner, handler.ListStockMovements(clientManager))
+	group.POST("/products/:id/approvals", owner, handler.SubmitProductApproval(clientManager))
+	group.GET("/products/:id/approvals", owner, handler.ListProductApprovals(clientManager))
+
+	// 订单管理，只能操作自己的子订单
+	group.GET("/orders", handler.ListMerchantOrders(clientManager))
+	group.POST("/orders/:order_no/ship", handler.ShipOrder(clientManager))
+	group.POST("/orders/refunds/:refund_no/process", handler.ProcessRefund(clientManager))
 }
 
 func registerDocRoutes(h *server.Hertz) {
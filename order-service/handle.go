@@ -109,3 +109,69 @@ func (h *OrderServiceImpl) ConfirmReceipt(ctx context.Context, req *api.PayOrder
 	klog.Infof("ConfirmReceipt called with orderNo: %s", req.OrderNo)
 	return h.orderService.ConfirmReceipt(ctx, req)
 }
+
+// CreateReview 评价订单商品
+func (h *OrderServiceImpl) CreateReview(ctx context.Context, req *api.CreateReviewReq) (resp *api.CreateReviewResp, err error) {
+	klog.Infof("CreateReview called with orderNo: %s, orderItemId: %d", req.OrderNo, req.OrderItemId)
+	return h.orderService.CreateReview(ctx, req)
+}
+
+// ListReviews 查询评价列表
+func (h *OrderServiceImpl) ListReviews(ctx context.Context, req *api.ListReviewsReq) (resp *api.ListReviewsResp, err error) {
+	klog.Infof("ListReviews called")
+	return h.orderService.ListReviews(ctx, req)
+}
+
+// ModerateReview 审核评价
+func (h *OrderServiceImpl) ModerateReview(ctx context.Context, req *api.ModerateReviewReq) (resp *api.ModerateReviewResp, err error) {
+	klog.Infof("ModerateReview called with id: %d", req.Id)
+	return h.orderService.ModerateReview(ctx, req)
+}
+
+// ReplyReview 回复评价
+func (h *OrderServiceImpl) ReplyReview(ctx context.Context, req *api.ReplyReviewReq) (resp *api.ReplyReviewResp, err error) {
+	klog.Infof("ReplyReview called with id: %d", req.Id)
+	return h.orderService.ReplyReview(ctx, req)
+}
+
+// CreateFlashSale 创建秒杀活动
+func (h *OrderServiceImpl) CreateFlashSale(ctx context.Context, req *api.CreateFlashSaleReq) (resp *api.CreateFlashSaleResp, err error) {
+	klog.Infof("CreateFlashSale called with productId: %d, allocatedQuantity: %d", req.ProductId, req.AllocatedQuantity)
+	return h.orderService.CreateFlashSale(ctx, req)
+}
+
+// GetFlashSale 查询秒杀活动
+func (h *OrderServiceImpl) GetFlashSale(ctx context.Context, req *api.GetFlashSaleReq) (resp *api.GetFlashSaleResp, err error) {
+	klog.Infof("GetFlashSale called with id: %d", req.Id)
+	return h.orderService.GetFlashSale(ctx, req)
+}
+
+// ListFlashSales 查询秒杀活动列表
+func (h *OrderServiceImpl) ListFlashSales(ctx context.Context, req *api.ListFlashSalesReq) (resp *api.ListFlashSalesResp, err error) {
+	klog.Infof("ListFlashSales called")
+	return h.orderService.ListFlashSales(ctx, req)
+}
+
+// SubmitFlashSaleOrder 秒杀下单
+func (h *OrderServiceImpl) SubmitFlashSaleOrder(ctx context.Context, req *api.SubmitFlashSaleOrderReq) (resp *api.SubmitFlashSaleOrderResp, err error) {
+	klog.Infof("SubmitFlashSaleOrder called with flashSaleId: %d, userId: %d", req.FlashSaleId, req.UserId)
+	return h.orderService.SubmitFlashSaleOrder(ctx, req)
+}
+
+// GetFlashSaleRequest 查询秒杀请求结果
+func (h *OrderServiceImpl) GetFlashSaleRequest(ctx context.Context, req *api.GetFlashSaleRequestReq) (resp *api.GetFlashSaleRequestResp, err error) {
+	klog.Infof("GetFlashSaleRequest called with requestId: %s", req.RequestId)
+	return h.orderService.GetFlashSaleRequest(ctx, req)
+}
+
+// ReconcileFlashSale 秒杀活动对账
+func (h *OrderServiceImpl) ReconcileFlashSale(ctx context.Context, req *api.ReconcileFlashSaleReq) (resp *api.ReconcileFlashSaleResp, err error) {
+	klog.Infof("ReconcileFlashSale called with id: %d", req.Id)
+	return h.orderService.ReconcileFlashSale(ctx, req)
+}
+
+// GetPurchaseAllowances 查询限购商品的剩余可购数量
+func (h *OrderServiceImpl) GetPurchaseAllowances(ctx context.Context, req *api.GetPurchaseAllowancesReq) (resp *api.GetPurchaseAllowancesResp, err error) {
+	klog.Infof("GetPurchaseAllowances called with userId: %d, products: %d", req.UserId, len(req.ProductIds))
+	return h.orderService.GetPurchaseAllowances(ctx, req)
+}
@@ -5,13 +5,21 @@ import (
 	"ecommerce/order-service/internal/dao/interfaces"
 	"ecommerce/order-service/kitex_gen/api"
 	"ecommerce/order-service/kitex_gen/api/productservice"
+	"fmt"
 
 	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/klog"
 )
 
+// 订单服务调整库存时记录的操作人
+var stockOperator = "order-service"
+
+// 商品服务批量查询的单次上限，与商品服务保持一致
+const maxBatchGetProducts = 100
+
 type ProductClient struct {
 	client productservice.Client
+	cache  *productCache
 }
 
 func NewProductClient(addr string) (interfaces.IProductClient, error) {
@@ -21,10 +29,23 @@ func NewProductClient(addr string) (interfaces.IProductClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &ProductClient{client: c}, nil
+	pc := &ProductClient{
+		client: c,
+		cache:  newProductCache(productCacheTTL),
+	}
+	go pc.watchProductEvents(context.Background())
+	return pc, nil
 }
 
 func (pc *ProductClient) GetProductInfo(ctx context.Context, productID int64) (*interfaces.ProductInfo, error) {
+	if info, ok := pc.cache.get(productID); ok {
+		return info, nil
+	}
+	return pc.fetchProductInfo(ctx, productID)
+}
+
+// fetchProductInfo 绕过缓存直接查询商品服务，并刷新缓存
+func (pc *ProductClient) fetchProductInfo(ctx context.Context, productID int64) (*interfaces.ProductInfo, error) {
 	req := &api.GetProductReq{
 		Id: productID,
 	}
@@ -39,38 +60,271 @@ func (pc *ProductClient) GetProductInfo(ctx context.Context, productID int64) (*
 		return nil, nil
 	}
 
-	productInfo := &interfaces.ProductInfo{
-		ID:       resp.Product.Id,
-		Name:     resp.Product.Name,
-		Price:    resp.Product.Price,
-		Stock:    resp.Product.Stock,
-		Status:   int32(resp.Product.Status),
-		Category: resp.Product.Category,
-		Avatar:   resp.Product.Avatar,
-	}
-
-	if resp.Product.Brand != nil {
-		productInfo.Brand = *resp.Product.Brand
-	}
-
+	productInfo := convertToProductInfo(resp.Product)
+	pc.cache.set(productInfo)
 	return productInfo, nil
 }
 
+// BatchGetProducts 批量查询商品，未命中缓存的按商品服务单次上限分批查询，不存在的商品不在结果中
 func (pc *ProductClient) BatchGetProducts(ctx context.Context, productIDs []int64) (map[int64]*interfaces.ProductInfo, error) {
 	result := make(map[int64]*interfaces.ProductInfo)
+	seen := make(map[int64]bool, len(productIDs))
+	var missing []int64
 	for _, id := range productIDs {
-		productInfo, err := pc.GetProductInfo(ctx, id)
-		if err == nil && productInfo != nil {
-			result[id] = productInfo
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if info, ok := pc.cache.get(id); ok {
+			result[id] = info
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	for start := 0; start < len(missing); start += maxBatchGetProducts {
+		batch := missing[start:min(start+maxBatchGetProducts, len(missing))]
+		resp, err := pc.client.BatchGetProducts(ctx, &api.BatchGetProductsReq{Ids: batch})
+		if err != nil {
+			klog.Errorf("BatchGetProducts failed: %v", err)
+			return result, err
+		}
+		if !resp.Success {
+			return result, fmt.Errorf("批量查询商品失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+		}
+		for _, p := range resp.Products {
+			productInfo := convertToProductInfo(p)
+			pc.cache.set(productInfo)
+			result[productInfo.ID] = productInfo
 		}
 	}
 	return result, nil
 }
 
 func (pc *ProductClient) CheckStock(ctx context.Context, productID int64, quantity int32) (bool, error) {
-	productInfo, err := pc.GetProductInfo(ctx, productID)
+	// 库存校验必须读取最新数据
+	productInfo, err := pc.fetchProductInfo(ctx, productID)
 	if err != nil {
 		return false, err
 	}
-	return productInfo != nil && productInfo.Stock >= quantity, nil
+	return productInfo != nil &&
+		productInfo.Status == interfaces.ProductStatusOnline &&
+		productInfo.Stock >= quantity, nil
+}
+
+// AdjustStock 调整商品库存，referenceID 相同的重复调用只生效一次
+func (pc *ProductClient) AdjustStock(ctx context.Context, productID, warehouseID int64, delta int32,
+	reason interfaces.StockChangeReason, referenceID string) error {
+	req := &api.AdjustStockReq{
+		ProductId:   productID,
+		Delta:       delta,
+		Reason:      api.StockChangeReason(reason),
+		ReferenceId: &referenceID,
+		Operator:    &stockOperator,
+	}
+	if warehouseID > 0 {
+		req.WarehouseId = &warehouseID
+	}
+	resp, err := pc.client.AdjustStock(ctx, req)
+	if err != nil {
+		klog.Errorf("AdjustStock failed: %v", err)
+		return err
+	}
+	pc.cache.invalidate(productID)
+	if !resp.Success {
+		return fmt.Errorf("调整库存失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	return nil
+}
+
+// ListWarehouses 获取启用中的仓库
+func (pc *ProductClient) ListWarehouses(ctx context.Context) ([]*interfaces.WarehouseInfo, error) {
+	resp, err := pc.client.ListWarehouses(ctx, &api.ListWarehousesReq{ActiveOnly: true})
+	if err != nil {
+		klog.Errorf("ListWarehouses failed: %v", err)
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("查询仓库失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	warehouses := make([]*interfaces.WarehouseInfo, 0, len(resp.Warehouses))
+	for _, w := range resp.Warehouses {
+		warehouses = append(warehouses, &interfaces.WarehouseInfo{
+			ID:       w.Id,
+			Code:     w.Code,
+			Name:     w.Name,
+			Province: w.Province,
+			City:     w.City,
+			Priority: w.Priority,
+		})
+	}
+	return warehouses, nil
+}
+
+// GetWarehouseStocks 获取商品分仓库存，库存校验必须读取最新数据，不走缓存
+func (pc *ProductClient) GetWarehouseStocks(ctx context.Context, productIDs []int64) (map[int64]map[int64]int32, error) {
+	result := make(map[int64]map[int64]int32)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+	resp, err := pc.client.GetWarehouseStocks(ctx, &api.GetWarehouseStocksReq{ProductIds: productIDs})
+	if err != nil {
+		klog.Errorf("GetWarehouseStocks failed: %v", err)
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("查询仓库库存失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	for _, st := range resp.Stocks {
+		if result[st.ProductId] == nil {
+			result[st.ProductId] = make(map[int64]int32)
+		}
+		result[st.ProductId][st.WarehouseId] = st.Stock
+	}
+	return result, nil
+}
+
+// UpdateProductRating 写入商品评分汇总
+func (pc *ProductClient) UpdateProductRating(ctx context.Context, productID int64, ratingAvg float64, ratingCount int32) error {
+	resp, err := pc.client.UpdateProductRating(ctx, &api.UpdateProductRatingReq{
+		ProductId:   productID,
+		RatingAvg:   ratingAvg,
+		RatingCount: ratingCount,
+	})
+	if err != nil {
+		klog.Errorf("UpdateProductRating failed: %v", err)
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("更新商品评分失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	return nil
+}
+
+// SyncPurchaseStats 同步购买统计
+func (pc *ProductClient) SyncPurchaseStats(ctx context.Context, generation int64, pairs []*interfaces.CoPurchaseCount,
+	sales []*interfaces.ProductSalesCount, final bool) error {
+	req := &api.SyncPurchaseStatsReq{
+		Generation: generation,
+		Pairs:      make([]*api.PurchasePair, 0, len(pairs)),
+		Sales:      make([]*api.ProductSales, 0, len(sales)),
+		Final:      final,
+	}
+	for _, p := range pairs {
+		req.Pairs = append(req.Pairs, &api.PurchasePair{
+			ProductId:        p.ProductID,
+			RelatedProductId: p.RelatedProductID,
+			OrderCount:       p.OrderCount,
+		})
+	}
+	for _, s := range sales {
+		req.Sales = append(req.Sales, &api.ProductSales{
+			ProductId:  s.ProductID,
+			OrderCount: s.OrderCount,
+			Quantity:   s.Quantity,
+		})
+	}
+	resp, err := pc.client.SyncPurchaseStats(ctx, req)
+	if err != nil {
+		klog.Errorf("SyncPurchaseStats failed: %v", err)
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("同步购买统计失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	return nil
+}
+
+// RecordProductSales 上报商品销量
+func (pc *ProductClient) RecordProductSales(ctx context.Context, quantities map[int64]int32) error {
+	req := &api.RecordProductSalesReq{
+		Sales: make([]*api.ProductSaleDelta, 0, len(quantities)),
+	}
+	for productID, quantity := range quantities {
+		req.Sales = append(req.Sales, &api.ProductSaleDelta{
+			ProductId: productID,
+			Quantity:  quantity,
+		})
+	}
+	resp, err := pc.client.RecordProductSales(ctx, req)
+	if err != nil {
+		klog.Errorf("RecordProductSales failed: %v", err)
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("上报商品销量失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	return nil
+}
+
+// QuotePrices 计算成交价，阶梯价必须读取最新数据，不走缓存
+func (pc *ProductClient) QuotePrices(ctx context.Context, userID int64, items []*interfaces.PriceQuoteItem) ([]*interfaces.PriceQuote, error) {
+	req := &api.QuotePricesReq{
+		UserId: userID,
+		Items:  make([]*api.PriceQuoteItem, 0, len(items)),
+	}
+	for _, item := range items {
+		req.Items = append(req.Items, &api.PriceQuoteItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+	resp, err := pc.client.QuotePrices(ctx, req)
+	if err != nil {
+		klog.Errorf("QuotePrices failed: %v", err)
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("计算成交价失败: code=%d, message=%s", resp.Code, resp.GetMessage())
+	}
+	if len(resp.Quotes) != len(items) {
+		return nil, fmt.Errorf("计算成交价失败: 返回%d项，请求%d项", len(resp.Quotes), len(items))
+	}
+	quotes := make([]*interfaces.PriceQuote, 0, len(resp.Quotes))
+	for _, q := range resp.Quotes {
+		quote := &interfaces.PriceQuote{
+			ProductID: q.ProductId,
+			Quantity:  q.Quantity,
+			BasePrice: q.BasePrice,
+			UnitPrice: q.UnitPrice,
+		}
+		if q.AppliedTier != nil {
+			quote.TierMinQuantity = q.AppliedTier.MinQuantity
+			quote.PriceGroup = q.AppliedTier.GetUserGroup()
+		}
+		quotes = append(quotes, quote)
+	}
+	return quotes, nil
+}
+
+// convertToProductInfo 将api.Product转换为ProductInfo
+func convertToProductInfo(p *api.Product) *interfaces.ProductInfo {
+	productInfo := &interfaces.ProductInfo{
+		ID:                p.Id,
+		Name:              p.Name,
+		Price:             p.Price,
+		Stock:             p.Stock,
+		Status:            int32(p.Status),
+		Category:          p.Category,
+		Avatar:            p.Avatar,
+		MerchantID:        p.MerchantId,
+		PurchaseLimit:     p.PurchaseLimit,
+		PurchaseLimitDays: p.PurchaseLimitDays,
+	}
+
+	if p.Brand != nil {
+		productInfo.Brand = *p.Brand
+	}
+	if p.OriginalPrice != nil {
+		productInfo.OriginalPrice = *p.OriginalPrice
+	}
+	for _, c := range p.Components {
+		productInfo.Components = append(productInfo.Components, &interfaces.BundleComponentInfo{
+			ProductID: c.ProductId,
+			Name:      c.GetProductName(),
+			Quantity:  c.Quantity,
+		})
+	}
+
+	return productInfo
 }
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/kitex_gen/api"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	// 商品缓存有效期
+	productCacheTTL = 30 * time.Second
+	// 商品事件轮询间隔
+	productEventPollInterval = 2 * time.Second
+	// 每次拉取的事件数量
+	productEventBatchSize = 200
+)
+
+type productCacheEntry struct {
+	info     *interfaces.ProductInfo
+	expireAt time.Time
+}
+
+// productCache 商品信息的短时读穿缓存
+type productCache struct {
+	mu    sync.RWMutex
+	ttl   time.Duration
+	items map[int64]productCacheEntry
+}
+
+func newProductCache(ttl time.Duration) *productCache {
+	return &productCache{
+		ttl:   ttl,
+		items: make(map[int64]productCacheEntry),
+	}
+}
+
+// get 获取未过期的缓存
+func (c *productCache) get(productID int64) (*interfaces.ProductInfo, bool) {
+	c.mu.RLock()
+	entry, ok := c.items[productID]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expireAt) {
+		return nil, false
+	}
+	return entry.info, true
+}
+
+// set 写入缓存
+func (c *productCache) set(info *interfaces.ProductInfo) {
+	if info == nil {
+		return
+	}
+	c.mu.Lock()
+	c.items[info.ID] = productCacheEntry{
+		info:     info,
+		expireAt: time.Now().Add(c.ttl),
+	}
+	c.mu.Unlock()
+}
+
+// invalidate 删除指定商品的缓存
+func (c *productCache) invalidate(productID int64) {
+	c.mu.Lock()
+	delete(c.items, productID)
+	c.mu.Unlock()
+}
+
+// purgeExpired 清理过期条目
+func (c *productCache) purgeExpired() {
+	now := time.Now()
+	c.mu.Lock()
+	for id, entry := range c.items {
+		if now.After(entry.expireAt) {
+			delete(c.items, id)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// watchProductEvents 轮询商品服务的变更事件并使缓存失效
+func (pc *ProductClient) watchProductEvents(ctx context.Context) {
+	lastID := int64(-1)
+	ticker := time.NewTicker(productEventPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if lastID < 0 {
+			// 首次启动只记录当前游标，之前的事件对空缓存没有意义
+			resp, err := pc.client.ListProductEvents(ctx, &api.ListProductEventsReq{AfterId: -1})
+			if err != nil || !resp.Success {
+				continue
+			}
+			lastID = resp.LastId
+			continue
+		}
+
+		for {
+			resp, err := pc.client.ListProductEvents(ctx, &api.ListProductEventsReq{
+				AfterId: lastID,
+				Limit:   productEventBatchSize,
+			})
+			if err != nil {
+				klog.Warnf("拉取商品事件失败: %v", err)
+				break
+			}
+			if !resp.Success {
+				break
+			}
+			for _, event := range resp.Events {
+				pc.cache.invalidate(event.ProductId)
+			}
+			lastID = resp.LastId
+			if len(resp.Events) < productEventBatchSize {
+				break
+			}
+		}
+		pc.cache.purgeExpired()
+	}
+}
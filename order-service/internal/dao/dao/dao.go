@@ -1,10 +1,12 @@
 package dao
 
 import (
+	"ecommerce/order-service/internal/dao/flashSaleDao"
 	"ecommerce/order-service/internal/dao/interfaces"
 	"ecommerce/order-service/internal/dao/orderDao"
 	"ecommerce/order-service/internal/dao/orderItemDao"
 	"ecommerce/order-service/internal/dao/refundDao"
+	"ecommerce/order-service/internal/dao/reviewDao"
 	"ecommerce/order-service/internal/dao/stockReservationDao"
 	"ecommerce/order-service/internal/dao/timeOutTaskDao"
 
@@ -17,6 +19,9 @@ type DaoFactory struct {
 	RefundRepo           interfaces.IRefundRepository
 	StockReservationRepo interfaces.IStockReservationRepository
 	TimeoutTaskRepo      interfaces.ITimeoutTaskRepository
+	ReviewRepo           interfaces.IReviewRepository
+	FlashSaleRepo        interfaces.IFlashSaleRepository
+	FlashSaleRequestRepo interfaces.IFlashSaleRequestRepository
 }
 
 func NewDaoFactory(db *gorm.DB) *DaoFactory {
@@ -26,5 +31,8 @@ func NewDaoFactory(db *gorm.DB) *DaoFactory {
 		RefundRepo:           refundDao.NewRefundRepository(db),
 		StockReservationRepo: stockReservationDao.NewStockReservationRepository(db),
 		TimeoutTaskRepo:      timeOutTaskDao.NewTimeOutTaskRepository(db),
+		ReviewRepo:           reviewDao.NewReviewRepository(db),
+		FlashSaleRepo:        flashSaleDao.NewFlashSaleRepository(db),
+		FlashSaleRequestRepo: flashSaleDao.NewFlashSaleRequestRepository(db),
 	}
 }
@@ -0,0 +1,181 @@
+package flashSaleDao
+
+import (
+	"context"
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type FlashSaleRepository struct {
+	db *gorm.DB
+}
+
+func NewFlashSaleRepository(db *gorm.DB) interfaces.IFlashSaleRepository {
+	return &FlashSaleRepository{db: db}
+}
+
+// 创建秒杀活动
+func (r *FlashSaleRepository) Create(ctx context.Context, sale *model.FlashSale) error {
+	return r.db.WithContext(ctx).Create(sale).Error
+}
+
+// 删除秒杀活动，用于划拨库存失败时撤销
+func (r *FlashSaleRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.FlashSale{}).Error
+}
+
+// 根据ID查询秒杀活动
+func (r *FlashSaleRepository) FindByID(ctx context.Context, id int64) (*model.FlashSale, error) {
+	var sale model.FlashSale
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&sale).Error
+	return &sale, err
+}
+
+// 分页查询秒杀活动
+func (r *FlashSaleRepository) List(ctx context.Context, productID int64, includeEnded bool, now time.Time, page, pageSize int) ([]*model.FlashSale, int64, error) {
+	var sales []*model.FlashSale
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.FlashSale{})
+	if productID > 0 {
+		db = db.Where("product_id = ?", productID)
+	}
+	if !includeEnded {
+		db = db.Where("end_at > ?", now)
+	}
+
+	err := db.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err = db.Offset(offset).Limit(pageSize).
+		Order("start_at DESC, id DESC").
+		Find(&sales).Error
+
+	return sales, total, err
+}
+
+// 查询未对账的活动
+func (r *FlashSaleRepository) ListActive(ctx context.Context) ([]*model.FlashSale, error) {
+	var sales []*model.FlashSale
+	err := r.db.WithContext(ctx).
+		Where("status = ? OR stock_returned = ?", model.FlashSaleStatusActive, false).
+		Order("id ASC").
+		Find(&sales).Error
+	return sales, err
+}
+
+// 扣回已下单数量，对账后不再扣回
+func (r *FlashSaleRepository) DecrementSold(ctx context.Context, id int64, quantity int32) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.FlashSale{}).
+		Where("id = ? AND status = ? AND sold_quantity >= ?", id, model.FlashSaleStatusActive, quantity).
+		Update("sold_quantity", gorm.Expr("sold_quantity - ?", quantity))
+	return result.RowsAffected > 0, result.Error
+}
+
+// 标记已对账，未售出的库存记为退回数量
+func (r *FlashSaleRepository) MarkReconciled(ctx context.Context, id int64, reconciledAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.FlashSale{}).
+		Where("id = ? AND status = ?", id, model.FlashSaleStatusActive).
+		Updates(map[string]interface{}{
+			"status":            model.FlashSaleStatusReconciled,
+			"returned_quantity": gorm.Expr("allocated_quantity - sold_quantity"),
+			"reconciled_at":     reconciledAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 标记剩余库存已退回商品
+func (r *FlashSaleRepository) MarkStockReturned(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Model(&model.FlashSale{}).
+		Where("id = ?", id).
+		Update("stock_returned", true).Error
+}
+
+type FlashSaleRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewFlashSaleRequestRepository(db *gorm.DB) interfaces.IFlashSaleRequestRepository {
+	return &FlashSaleRequestRepository{db: db}
+}
+
+// 创建下单请求
+func (r *FlashSaleRequestRepository) Create(ctx context.Context, request *model.FlashSaleRequest) error {
+	return r.db.WithContext(ctx).Create(request).Error
+}
+
+// 根据请求ID查询
+func (r *FlashSaleRequestRepository) FindByRequestID(ctx context.Context, requestID string) (*model.FlashSaleRequest, error) {
+	var request model.FlashSaleRequest
+	err := r.db.WithContext(ctx).Where("request_id = ?", requestID).First(&request).Error
+	return &request, err
+}
+
+// 根据订单号查询
+func (r *FlashSaleRequestRepository) FindByOrderNo(ctx context.Context, orderNo string) (*model.FlashSaleRequest, error) {
+	var request model.FlashSaleRequest
+	err := r.db.WithContext(ctx).Where("order_no = ?", orderNo).First(&request).Error
+	return &request, err
+}
+
+// 按状态流转更新
+func (r *FlashSaleRequestRepository) UpdateStatus(ctx context.Context, requestID, from, to, orderNo, failReason string) (bool, error) {
+	updates := map[string]interface{}{"status": to}
+	if orderNo != "" {
+		updates["order_no"] = orderNo
+	}
+	if failReason != "" {
+		updates["fail_reason"] = failReason
+	}
+	result := r.db.WithContext(ctx).Model(&model.FlashSaleRequest{}).
+		Where("request_id = ? AND status = ?", requestID, from).
+		Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+// 按状态查询，按创建时间排序
+func (r *FlashSaleRequestRepository) ListByStatus(ctx context.Context, status string, limit int) ([]*model.FlashSaleRequest, error) {
+	var requests []*model.FlashSaleRequest
+	err := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&requests).Error
+	return requests, err
+}
+
+// 按用户汇总购买数量
+func (r *FlashSaleRequestRepository) SumQuantityByUser(ctx context.Context, saleID int64, statuses []string) (map[int64]int32, error) {
+	var rows []struct {
+		UserID   int64
+		Quantity int32
+	}
+	err := r.db.WithContext(ctx).Model(&model.FlashSaleRequest{}).
+		Select("user_id, SUM(quantity) AS quantity").
+		Where("flash_sale_id = ? AND status IN ?", saleID, statuses).
+		Group("user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		result[row.UserID] = row.Quantity
+	}
+	return result, nil
+}
+
+// 统计指定状态的请求数
+func (r *FlashSaleRequestRepository) CountByStatus(ctx context.Context, saleID int64, status string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.FlashSaleRequest{}).
+		Where("flash_sale_id = ? AND status = ?", saleID, status).
+		Count(&count).Error
+	return count, err
+}
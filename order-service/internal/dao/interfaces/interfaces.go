@@ -3,6 +3,7 @@ package interfaces
 import (
 	"context"
 	"ecommerce/order-service/internal/model"
+	"time"
 )
 
 // UserInfo 用户服务返回的信息
@@ -26,8 +27,87 @@ type ProductInfo struct {
 	Category string
 	Avatar   string
 	Brand    string
+	// OriginalPrice 调价生效期间的原价，0表示未在调价
+	OriginalPrice float64
+	// MerchantID 所属商家，0表示平台自营
+	MerchantID int64
+	// Components 套装的组件，普通商品为空
+	Components []*BundleComponentInfo
+	// PurchaseLimit 每人限购数量，0表示不限购
+	PurchaseLimit int32
+	// PurchaseLimitDays 限购周期天数，0表示终身限购
+	PurchaseLimitDays int32
 }
 
+// 套装组件信息
+type BundleComponentInfo struct {
+	ProductID int64
+	Name      string
+	// Quantity 每套包含的数量
+	Quantity int32
+}
+
+// 商品状态，与商品服务保持一致
+const (
+	ProductStatusOnline  int32 = 1 // 上架
+	ProductStatusSoldOut int32 = 4 // 售罄（可见不可购买）
+)
+
+// WarehouseInfo 商品服务返回的仓库信息
+type WarehouseInfo struct {
+	ID       int64
+	Code     string
+	Name     string
+	Province string
+	City     string
+	Priority int32
+}
+
+// CoPurchaseCount 两个商品出现在同一订单中的次数，ProductID < RelatedProductID
+type CoPurchaseCount struct {
+	ProductID        int64
+	RelatedProductID int64
+	OrderCount       int32
+}
+
+// ProductSalesCount 商品销量
+type ProductSalesCount struct {
+	ProductID  int64
+	OrderCount int32
+	Quantity   int32
+}
+
+// PriceQuoteItem 待计价的商品项
+type PriceQuoteItem struct {
+	ProductID int64
+	Quantity  int32
+}
+
+// PriceQuote 商品服务按数量和用户价格组计算的成交价
+type PriceQuote struct {
+	ProductID int64
+	Quantity  int32
+	BasePrice float64
+	UnitPrice float64
+	// 命中的阶梯价起购数量，未命中时为0
+	TierMinQuantity int32
+	// 命中的价格组，通用阶梯价为空
+	PriceGroup string
+}
+
+// StockChangeReason 库存变动原因，与商品服务的库存流水保持一致
+type StockChangeReason int32
+
+const (
+	StockChangeManualAdjust  StockChangeReason = 0 // 人工调整
+	StockChangeOrderDeduct   StockChangeReason = 1 // 下单扣减
+	StockChangeCancelRestore StockChangeReason = 2 // 取消恢复
+	StockChangeRefundReturn  StockChangeReason = 3 // 退款退回
+
+	StockChangeFlashSaleAllocate StockChangeReason = 6 // 划拨秒杀库存
+	StockChangeFlashSaleReturn   StockChangeReason = 7 // 秒杀结束退回
+)
+
 type IOrderRepository interface {
 	// 基础CRUD
 	Create(ctx context.Context, order *model.Order) error
@@ -38,6 +118,7 @@ type IOrderRepository interface {
 
 	// 查询方法
 	FindByOrderNo(ctx context.Context, orderNo string) (*model.Order, error)
+	FindByParentOrderNo(ctx context.Context, parentOrderNo string) ([]*model.Order, error)
 	FindByUserID(ctx context.Context, userID int64, status string, page, pageSize int) ([]*model.Order, int64, error)
 	ListByCondition(ctx context.Context, condition map[string]interface{}, page, pageSize int) ([]*model.Order, int64, error)
 	CountByStatus(ctx context.Context, userID int64, status string) (int64, error)
@@ -48,6 +129,8 @@ type IOrderRepository interface {
 	UpdatePaymentInfo(ctx context.Context, orderNo, paymentNo string) error
 	UpdateShippingInfo(ctx context.Context, orderNo, shippingNo string) error
 	UpdateStatusAndPayment(ctx context.Context, orderNo, status, paymentNo string) error
+	// 支付父订单，同时将待支付的子订单置为已支付
+	PayWithSubOrders(ctx context.Context, parentOrderNo, paymentNo string) error
 	CancelOrder(ctx context.Context, orderNo string, reason string) error
 }
 
@@ -57,6 +140,10 @@ type IOrderItemRepository interface {
 	FindByOrderID(ctx context.Context, orderID int64) ([]*model.OrderItem, error)
 	FindByOrderNo(ctx context.Context, orderNo string) ([]*model.OrderItem, error)
 	DeleteByOrderID(ctx context.Context, orderID int64) error
+	// CountCoPurchases 统计指定时间后下单且已完成订单中的商品对
+	CountCoPurchases(ctx context.Context, since time.Time) ([]*CoPurchaseCount, error)
+	// CountSales 统计指定时间后下单且已完成订单中的商品销量
+	CountSales(ctx context.Context, since time.Time) ([]*ProductSalesCount, error)
 }
 
 // 退款单接口
@@ -79,6 +166,19 @@ type IStockReservationRepository interface {
 	DeleteByReserveID(ctx context.Context, reserveID string) error
 	FindExpiredReservations(ctx context.Context) ([]*model.StockReservation, error)
 	UpdateStatus(ctx context.Context, reserveID, status string) error
+	// SumReservedByWarehouse 统计未过期预占量：商品ID -> 仓库ID -> 数量
+	SumReservedByWarehouse(ctx context.Context, productIDs []int64) (map[int64]map[int64]int32, error)
+}
+
+// 评价接口
+type IReviewRepository interface {
+	Create(ctx context.Context, review *model.Review) error
+	Update(ctx context.Context, review *model.Review) error
+	FindByID(ctx context.Context, id int64) (*model.Review, error)
+	FindByOrderItemID(ctx context.Context, orderItemID int64) (*model.Review, error)
+	ListByCondition(ctx context.Context, condition map[string]interface{}, page, pageSize int) ([]*model.Review, int64, error)
+	// AggregateApproved 统计商品已通过审核评价的平均分和数量
+	AggregateApproved(ctx context.Context, productID int64) (float64, int64, error)
 }
 
 // 超时任务接口
@@ -92,6 +192,35 @@ type ITimeoutTaskRepository interface {
 	IncrementRetryCount(ctx context.Context, taskID string) error
 }
 
+// 秒杀活动接口
+type IFlashSaleRepository interface {
+	Create(ctx context.Context, sale *model.FlashSale) error
+	Delete(ctx context.Context, id int64) error
+	FindByID(ctx context.Context, id int64) (*model.FlashSale, error)
+	// List 按开始时间倒序分页，includeEnded为false时只返回未结束的活动
+	List(ctx context.Context, productID int64, includeEnded bool, now time.Time, page, pageSize int) ([]*model.FlashSale, int64, error)
+	// ListActive 未对账的活动，包括未开始、进行中和已结束待对账的活动
+	ListActive(ctx context.Context) ([]*model.FlashSale, error)
+	// DecrementSold 未对账时扣回已下单数量，返回是否扣回
+	DecrementSold(ctx context.Context, id int64, quantity int32) (bool, error)
+	// MarkReconciled 标记已对账并计算退回数量，返回是否由本次调用完成
+	MarkReconciled(ctx context.Context, id int64, reconciledAt time.Time) (bool, error)
+	MarkStockReturned(ctx context.Context, id int64) error
+}
+
+// 秒杀下单请求接口
+type IFlashSaleRequestRepository interface {
+	Create(ctx context.Context, request *model.FlashSaleRequest) error
+	FindByRequestID(ctx context.Context, requestID string) (*model.FlashSaleRequest, error)
+	FindByOrderNo(ctx context.Context, orderNo string) (*model.FlashSaleRequest, error)
+	// UpdateStatus 仅当当前状态为from时更新，返回是否更新
+	UpdateStatus(ctx context.Context, requestID, from, to, orderNo, failReason string) (bool, error)
+	ListByStatus(ctx context.Context, status string, limit int) ([]*model.FlashSaleRequest, error)
+	// SumQuantityByUser 按用户汇总指定状态的购买数量
+	SumQuantityByUser(ctx context.Context, saleID int64, statuses []string) (map[int64]int32, error)
+	CountByStatus(ctx context.Context, saleID int64, status string) (int64, error)
+}
+
 // 外部服务客户端接口
 type IUserClient interface {
 	GetUserInfo(ctx context.Context, userID int64) (*UserInfo, error)
@@ -103,4 +232,16 @@ type IProductClient interface {
 	GetProductInfo(ctx context.Context, productID int64) (*ProductInfo, error)
 	BatchGetProducts(ctx context.Context, productIDs []int64) (map[int64]*ProductInfo, error)
 	CheckStock(ctx context.Context, productID int64, quantity int32) (bool, error)
+	AdjustStock(ctx context.Context, productID, warehouseID int64, delta int32, reason StockChangeReason, referenceID string) error
+	ListWarehouses(ctx context.Context) ([]*WarehouseInfo, error)
+	// GetWarehouseStocks 返回 商品ID -> 仓库ID -> 库存
+	GetWarehouseStocks(ctx context.Context, productIDs []int64) (map[int64]map[int64]int32, error)
+	// UpdateProductRating 写入商品评分汇总
+	UpdateProductRating(ctx context.Context, productID int64, ratingAvg float64, ratingCount int32) error
+	// SyncPurchaseStats 同步购买统计，同一批次号可分多次调用，最后一批final为true
+	SyncPurchaseStats(ctx context.Context, generation int64, pairs []*CoPurchaseCount, sales []*ProductSalesCount, final bool) error
+	// RecordProductSales 上报已支付订单的商品件数，计入商品热度
+	RecordProductSales(ctx context.Context, quantities map[int64]int32) error
+	// QuotePrices 按数量和用户价格组计算成交价，结果与items一一对应
+	QuotePrices(ctx context.Context, userID int64, items []*PriceQuoteItem) ([]*PriceQuote, error)
 }
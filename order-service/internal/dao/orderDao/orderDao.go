@@ -40,17 +40,24 @@ func (r *OrderRepository) SoftDelete(ctx context.Context, id int64) error {
 // 根据ID查询订单
 func (r *OrderRepository) FindByID(ctx context.Context, id int64) (*model.Order, error) {
 	var order model.Order
-	err := r.db.WithContext(ctx).Preload("Items").Where("id = ?", id).First(&order).Error
+	err := r.db.WithContext(ctx).Preload("Items.Components").Where("id = ?", id).First(&order).Error
 	return &order, err
 }
 
 // 根据订单号查询订单
 func (r *OrderRepository) FindByOrderNo(ctx context.Context, orderNo string) (*model.Order, error) {
 	var order model.Order
-	err := r.db.WithContext(ctx).Preload("Items").Where("order_no = ?", orderNo).First(&order).Error
+	err := r.db.WithContext(ctx).Preload("Items.Components").Where("order_no = ?", orderNo).First(&order).Error
 	return &order, err
 }
 
+// 查询父订单下的子订单
+func (r *OrderRepository) FindByParentOrderNo(ctx context.Context, parentOrderNo string) ([]*model.Order, error) {
+	var orders []*model.Order
+	err := r.db.WithContext(ctx).Preload("Items.Components").Where("parent_order_no = ?", parentOrderNo).Order("id ASC").Find(&orders).Error
+	return orders, err
+}
+
 // 根据用户ID查询订单列表
 func (r *OrderRepository) FindByUserID(ctx context.Context, userID int64, status string, page, pageSize int) ([]*model.Order, int64, error) {
 	var orders []*model.Order
@@ -68,7 +75,7 @@ func (r *OrderRepository) FindByUserID(ctx context.Context, userID int64, status
 	}
 
 	offset := (page - 1) * pageSize
-	err = db.Preload("Items").Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&orders).Error
+	err = db.Preload("Items.Components").Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&orders).Error
 
 	return orders, total, err
 }
@@ -97,7 +104,7 @@ func (r *OrderRepository) ListByCondition(ctx context.Context, condition map[str
 	}
 
 	offset := (page - 1) * pageSize
-	err = db.Preload("Items").Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&orders).Error
+	err = db.Preload("Items.Components").Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&orders).Error
 
 	return orders, total, err
 }
@@ -105,7 +112,8 @@ func (r *OrderRepository) ListByCondition(ctx context.Context, condition map[str
 // 统计用户各状态订单数量
 func (r *OrderRepository) CountByStatus(ctx context.Context, userID int64, status string) (int64, error) {
 	var count int64
-	db := r.db.WithContext(ctx).Model(&model.Order{}).Where("user_id = ?", userID)
+	// 父订单与子订单金额重复，只统计子订单
+	db := r.db.WithContext(ctx).Model(&model.Order{}).Where("user_id = ? AND is_parent = ?", userID, false)
 
 	if status != "" {
 		db = db.Where("status = ?", status)
@@ -237,6 +245,19 @@ func (r *OrderRepository) UpdateStatusAndPayment(ctx context.Context, orderNo, s
 		Updates(updates).Error
 }
 
+// 支付父订单及其子订单
+func (r *OrderRepository) PayWithSubOrders(ctx context.Context, parentOrderNo, paymentNo string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Model(&model.Order{}).
+		Where("(order_no = ? OR parent_order_no = ?) AND status = ?", parentOrderNo, parentOrderNo, model.OrderStatusPending).
+		Updates(map[string]interface{}{
+			"status":     model.OrderStatusPaid,
+			"payment_no": paymentNo,
+			"paid_at":    &now,
+			"updated_at": now,
+		}).Error
+}
+
 // 取消订单
 func (r *OrderRepository) CancelOrder(ctx context.Context, orderNo string, reason string) error {
 	now := time.Now()
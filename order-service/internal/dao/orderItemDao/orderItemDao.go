@@ -4,6 +4,7 @@ import (
 	"context"
 	"ecommerce/order-service/internal/dao/interfaces"
 	"ecommerce/order-service/internal/model"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -31,10 +32,10 @@ func (r *OrderItemRepository) FindByOrderID(ctx context.Context, orderID int64)
 	return items, err
 }
 
-// 根据订单号查询订单项
+// 根据订单号查询订单项，包含套装的组件明细
 func (r *OrderItemRepository) FindByOrderNo(ctx context.Context, orderNo string) ([]*model.OrderItem, error) {
 	var items []*model.OrderItem
-	err := r.db.WithContext(ctx).Where("order_no = ?", orderNo).Find(&items).Error
+	err := r.db.WithContext(ctx).Preload("Components").Where("order_no = ?", orderNo).Find(&items).Error
 	return items, err
 }
 
@@ -42,3 +43,30 @@ func (r *OrderItemRepository) FindByOrderNo(ctx context.Context, orderNo string)
 func (r *OrderItemRepository) DeleteByOrderID(ctx context.Context, orderID int64) error {
 	return r.db.WithContext(ctx).Where("order_id = ?", orderID).Delete(&model.OrderItem{}).Error
 }
+
+// 统计已完成订单中两两同时出现的商品对，每个订单只计一次
+func (r *OrderItemRepository) CountCoPurchases(ctx context.Context, since time.Time) ([]*interfaces.CoPurchaseCount, error) {
+	var counts []*interfaces.CoPurchaseCount
+	err := r.db.WithContext(ctx).
+		Table("order_items AS a").
+		Select("a.product_id AS product_id, b.product_id AS related_product_id, COUNT(DISTINCT a.order_id) AS order_count").
+		Joins("JOIN order_items AS b ON b.order_id = a.order_id AND b.product_id > a.product_id AND b.deleted_at IS NULL").
+		Joins("JOIN orders AS o ON o.id = a.order_id AND o.deleted_at IS NULL").
+		Where("a.deleted_at IS NULL AND o.status = ? AND o.created_at >= ?", model.OrderStatusCompleted, since).
+		Group("a.product_id, b.product_id").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// 统计已完成订单中各商品的订单数和件数
+func (r *OrderItemRepository) CountSales(ctx context.Context, since time.Time) ([]*interfaces.ProductSalesCount, error) {
+	var counts []*interfaces.ProductSalesCount
+	err := r.db.WithContext(ctx).
+		Table("order_items AS i").
+		Select("i.product_id AS product_id, COUNT(DISTINCT i.order_id) AS order_count, SUM(i.quantity) AS quantity").
+		Joins("JOIN orders AS o ON o.id = i.order_id AND o.deleted_at IS NULL").
+		Where("i.deleted_at IS NULL AND o.status = ? AND o.created_at >= ?", model.OrderStatusCompleted, since).
+		Group("i.product_id").
+		Scan(&counts).Error
+	return counts, err
+}
@@ -0,0 +1,77 @@
+package reviewDao
+
+import (
+	"context"
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type ReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) interfaces.IReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+// 创建评价
+func (r *ReviewRepository) Create(ctx context.Context, review *model.Review) error {
+	return r.db.WithContext(ctx).Create(review).Error
+}
+
+// 更新评价
+func (r *ReviewRepository) Update(ctx context.Context, review *model.Review) error {
+	return r.db.WithContext(ctx).Save(review).Error
+}
+
+// 根据ID查询评价
+func (r *ReviewRepository) FindByID(ctx context.Context, id int64) (*model.Review, error) {
+	var review model.Review
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&review).Error
+	return &review, err
+}
+
+// 根据订单项查询评价
+func (r *ReviewRepository) FindByOrderItemID(ctx context.Context, orderItemID int64) (*model.Review, error) {
+	var review model.Review
+	err := r.db.WithContext(ctx).Where("order_item_id = ?", orderItemID).First(&review).Error
+	return &review, err
+}
+
+// 根据条件查询评价列表
+func (r *ReviewRepository) ListByCondition(ctx context.Context, condition map[string]interface{}, page, pageSize int) ([]*model.Review, int64, error) {
+	var reviews []*model.Review
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.Review{})
+	for key, value := range condition {
+		db = db.Where(key+" = ?", value)
+	}
+
+	err := db.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err = db.Offset(offset).Limit(pageSize).
+		Order("created_at DESC").
+		Find(&reviews).Error
+
+	return reviews, total, err
+}
+
+// 统计商品已通过审核评价的平均分和数量
+func (r *ReviewRepository) AggregateApproved(ctx context.Context, productID int64) (float64, int64, error) {
+	var result struct {
+		Avg   float64
+		Count int64
+	}
+	err := r.db.WithContext(ctx).Model(&model.Review{}).
+		Select("COALESCE(AVG(rating), 0) AS avg, COUNT(*) AS count").
+		Where("product_id = ? AND status = ?", productID, model.ReviewStatusApproved).
+		Scan(&result).Error
+	return result.Avg, result.Count, err
+}
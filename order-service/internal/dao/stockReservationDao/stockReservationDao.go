@@ -65,3 +65,31 @@ func (r *StockReservationRepository) UpdateStatus(ctx context.Context, reserveID
 			"updated_at": time.Now(),
 		}).Error
 }
+
+// 按仓库统计未过期的预占数量
+func (r *StockReservationRepository) SumReservedByWarehouse(ctx context.Context, productIDs []int64) (map[int64]map[int64]int32, error) {
+	result := make(map[int64]map[int64]int32)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		ProductID   int64
+		WarehouseID int64
+		Total       int32
+	}
+	err := r.db.WithContext(ctx).Model(&model.StockReservation{}).
+		Select("product_id, warehouse_id, SUM(quantity) AS total").
+		Where("product_id IN ? AND status = ? AND expire_time > ?", productIDs, model.StockStatusReserved, time.Now()).
+		Group("product_id, warehouse_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if result[row.ProductID] == nil {
+			result[row.ProductID] = make(map[int64]int32)
+		}
+		result[row.ProductID][row.WarehouseID] = row.Total
+	}
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package flashsale
+
+import (
+	"context"
+	"sync"
+
+	"ecommerce/order-service/pkg/config"
+)
+
+// 计数器驱动
+const (
+	DriverMemory = "memory"
+	DriverRedis  = "redis"
+)
+
+// AcquireResult 抢购结果
+type AcquireResult int
+
+const (
+	AcquireOK            AcquireResult = iota // 抢购成功，已扣减剩余库存
+	AcquireSoldOut                            // 剩余库存不足
+	AcquireLimitExceeded                      // 超过每人限购数量
+	AcquireNotLoaded                          // 计数器未加载该活动
+)
+
+// StockCounter 秒杀库存计数器，在下单入口原子扣减剩余库存和用户已购数量，拦截的请求不会访问数据库
+type StockCounter interface {
+	// Load 加载活动的剩余库存和各用户已购数量，已加载时不覆盖
+	Load(ctx context.Context, saleID int64, remaining int32, purchased map[int64]int32) error
+	// Acquire 剩余库存足够且用户已购数量加本次不超过limit时扣减，limit<=0表示不限购
+	Acquire(ctx context.Context, saleID, userID int64, quantity, limit int32) (AcquireResult, error)
+	// Release 归还库存和用户已购数量，用于下单失败或订单取消
+	Release(ctx context.Context, saleID, userID int64, quantity int32) error
+	// Remaining 剩余库存，未加载时返回false
+	Remaining(ctx context.Context, saleID int64) (int32, bool, error)
+	// Remove 活动对账后清除计数
+	Remove(ctx context.Context, saleID int64) error
+}
+
+// NewStockCounter 根据配置创建计数器。进程内计数只适用于单实例部署，
+// 配置为Redis时不降级，否则多实例各自计数会超卖和突破每人限购
+func NewStockCounter(cfg *config.FlashSaleConfig, redisCfg *config.RedisConfig) (StockCounter, error) {
+	if cfg == nil || cfg.Driver != DriverRedis {
+		return NewMemoryCounter(), nil
+	}
+	return NewRedisCounter(redisCfg)
+}
+
+// MemoryCounter 进程内计数器
+type MemoryCounter struct {
+	mu    sync.Mutex
+	sales map[int64]*memorySale
+}
+
+type memorySale struct {
+	remaining int32
+	purchased map[int64]int32
+}
+
+func NewMemoryCounter() *MemoryCounter {
+	return &MemoryCounter{sales: make(map[int64]*memorySale)}
+}
+
+func (c *MemoryCounter) Load(ctx context.Context, saleID int64, remaining int32, purchased map[int64]int32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.sales[saleID]; ok {
+		return nil
+	}
+	sale := &memorySale{remaining: remaining, purchased: make(map[int64]int32, len(purchased))}
+	for userID, quantity := range purchased {
+		sale.purchased[userID] = quantity
+	}
+	c.sales[saleID] = sale
+	return nil
+}
+
+func (c *MemoryCounter) Acquire(ctx context.Context, saleID, userID int64, quantity, limit int32) (AcquireResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sale, ok := c.sales[saleID]
+	if !ok {
+		return AcquireNotLoaded, nil
+	}
+	if limit > 0 && sale.purchased[userID]+quantity > limit {
+		return AcquireLimitExceeded, nil
+	}
+	if sale.remaining < quantity {
+		return AcquireSoldOut, nil
+	}
+	sale.remaining -= quantity
+	sale.purchased[userID] += quantity
+	return AcquireOK, nil
+}
+
+func (c *MemoryCounter) Release(ctx context.Context, saleID, userID int64, quantity int32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sale, ok := c.sales[saleID]
+	if !ok {
+		return nil
+	}
+	sale.remaining += quantity
+	sale.purchased[userID] -= quantity
+	if sale.purchased[userID] <= 0 {
+		delete(sale.purchased, userID)
+	}
+	return nil
+}
+
+func (c *MemoryCounter) Remaining(ctx context.Context, saleID int64) (int32, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sale, ok := c.sales[saleID]
+	if !ok {
+		return 0, false, nil
+	}
+	return sale.remaining, true, nil
+}
+
+func (c *MemoryCounter) Remove(ctx context.Context, saleID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sales, saleID)
+	return nil
+}
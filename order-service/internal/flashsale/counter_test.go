@@ -0,0 +1,112 @@
+package flashsale
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryCounterAcquire(t *testing.T) {
+	tests := []struct {
+		name          string
+		remaining     int32
+		purchased     map[int64]int32
+		quantity      int32
+		limit         int32
+		want          AcquireResult
+		wantRemaining int32
+	}{
+		{name: "库存充足", remaining: 10, quantity: 2, limit: 0, want: AcquireOK, wantRemaining: 8},
+		{name: "恰好抢完", remaining: 2, quantity: 2, limit: 0, want: AcquireOK, wantRemaining: 0},
+		{name: "库存不足", remaining: 1, quantity: 2, limit: 0, want: AcquireSoldOut, wantRemaining: 1},
+		{name: "未超过限购", remaining: 10, purchased: map[int64]int32{1: 1}, quantity: 1, limit: 2, want: AcquireOK, wantRemaining: 9},
+		{name: "超过限购", remaining: 10, purchased: map[int64]int32{1: 2}, quantity: 1, limit: 2, want: AcquireLimitExceeded, wantRemaining: 10},
+		{name: "单次超过限购", remaining: 10, quantity: 3, limit: 2, want: AcquireLimitExceeded, wantRemaining: 10},
+		{name: "其他用户已购不影响", remaining: 10, purchased: map[int64]int32{2: 2}, quantity: 2, limit: 2, want: AcquireOK, wantRemaining: 8},
+		{name: "限购优先于售罄", remaining: 0, purchased: map[int64]int32{1: 2}, quantity: 1, limit: 2, want: AcquireLimitExceeded, wantRemaining: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			counter := NewMemoryCounter()
+			if err := counter.Load(ctx, 1, tt.remaining, tt.purchased); err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			got, err := counter.Acquire(ctx, 1, 1, tt.quantity, tt.limit)
+			if err != nil {
+				t.Fatalf("Acquire: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Acquire = %v, want %v", got, tt.want)
+			}
+			remaining, loaded, err := counter.Remaining(ctx, 1)
+			if err != nil || !loaded {
+				t.Fatalf("Remaining = %d, %v, %v", remaining, loaded, err)
+			}
+			if remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", remaining, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestMemoryCounterNotLoaded(t *testing.T) {
+	ctx := context.Background()
+	counter := NewMemoryCounter()
+	got, err := counter.Acquire(ctx, 1, 1, 1, 0)
+	if err != nil || got != AcquireNotLoaded {
+		t.Fatalf("Acquire = %v, %v, want AcquireNotLoaded", got, err)
+	}
+	if _, loaded, _ := counter.Remaining(ctx, 1); loaded {
+		t.Error("未加载的活动不应返回剩余库存")
+	}
+	if err := counter.Release(ctx, 1, 1, 1); err != nil {
+		t.Errorf("未加载的活动归还应忽略: %v", err)
+	}
+}
+
+func TestMemoryCounterLoadKeepsExisting(t *testing.T) {
+	ctx := context.Background()
+	counter := NewMemoryCounter()
+	_ = counter.Load(ctx, 1, 10, nil)
+	if got, _ := counter.Acquire(ctx, 1, 1, 3, 0); got != AcquireOK {
+		t.Fatalf("Acquire = %v", got)
+	}
+	// 已加载时不覆盖，避免按数据库重算时丢掉尚未落库的扣减
+	_ = counter.Load(ctx, 1, 10, nil)
+	if remaining, _, _ := counter.Remaining(ctx, 1); remaining != 7 {
+		t.Errorf("remaining = %d, want 7", remaining)
+	}
+}
+
+func TestMemoryCounterReleaseRestoresLimit(t *testing.T) {
+	ctx := context.Background()
+	counter := NewMemoryCounter()
+	_ = counter.Load(ctx, 1, 10, nil)
+	if got, _ := counter.Acquire(ctx, 1, 1, 2, 2); got != AcquireOK {
+		t.Fatalf("Acquire = %v", got)
+	}
+	if got, _ := counter.Acquire(ctx, 1, 1, 1, 2); got != AcquireLimitExceeded {
+		t.Fatalf("Acquire = %v, want AcquireLimitExceeded", got)
+	}
+	if err := counter.Release(ctx, 1, 1, 1); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if got, _ := counter.Acquire(ctx, 1, 1, 1, 2); got != AcquireOK {
+		t.Errorf("归还后应可再次抢购, got %v", got)
+	}
+	if remaining, _, _ := counter.Remaining(ctx, 1); remaining != 8 {
+		t.Errorf("remaining = %d, want 8", remaining)
+	}
+}
+
+func TestMemoryCounterRemove(t *testing.T) {
+	ctx := context.Background()
+	counter := NewMemoryCounter()
+	_ = counter.Load(ctx, 1, 10, nil)
+	if err := counter.Remove(ctx, 1); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if got, _ := counter.Acquire(ctx, 1, 1, 1, 0); got != AcquireNotLoaded {
+		t.Errorf("Acquire = %v, want AcquireNotLoaded", got)
+	}
+}
@@ -0,0 +1,123 @@
+package flashsale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"ecommerce/order-service/pkg/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 剩余库存key和用户已购数量hash，多实例共享
+const (
+	redisStockKey = "flashsale:%d:stock"
+	redisUsersKey = "flashsale:%d:users"
+)
+
+// 返回值与AcquireResult一致
+var acquireScript = redis.NewScript(`
+local stock = redis.call('GET', KEYS[1])
+if not stock then
+	return 3
+end
+local quantity = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local purchased = tonumber(redis.call('HGET', KEYS[2], ARGV[1]) or '0')
+if limit > 0 and purchased + quantity > limit then
+	return 2
+end
+if tonumber(stock) < quantity then
+	return 1
+end
+redis.call('DECRBY', KEYS[1], quantity)
+redis.call('HINCRBY', KEYS[2], ARGV[1], quantity)
+return 0
+`)
+
+var releaseScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('INCRBY', KEYS[1], ARGV[2])
+local purchased = redis.call('HINCRBY', KEYS[2], ARGV[1], -tonumber(ARGV[2]))
+if purchased <= 0 then
+	redis.call('HDEL', KEYS[2], ARGV[1])
+end
+return 1
+`)
+
+// RedisCounter Redis计数器，扣减和归还通过Lua脚本保证原子性
+type RedisCounter struct {
+	client *redis.Client
+}
+
+// NewRedisCounter 创建Redis计数器并检查连接
+func NewRedisCounter(cfg *config.RedisConfig) (*RedisCounter, error) {
+	if cfg == nil || cfg.Host == "" {
+		return nil, errors.New("未配置Redis地址")
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Password: cfg.Password,
+		DB:       cfg.DB,
+		PoolSize: cfg.PoolSize,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &RedisCounter{client: client}, nil
+}
+
+func (c *RedisCounter) keys(saleID int64) []string {
+	return []string{fmt.Sprintf(redisStockKey, saleID), fmt.Sprintf(redisUsersKey, saleID)}
+}
+
+// Load 其他实例已加载时保留现有计数
+func (c *RedisCounter) Load(ctx context.Context, saleID int64, remaining int32, purchased map[int64]int32) error {
+	keys := c.keys(saleID)
+	exists, err := c.client.Exists(ctx, keys[0]).Result()
+	if err != nil || exists > 0 {
+		return err
+	}
+	pipe := c.client.TxPipeline()
+	for userID, quantity := range purchased {
+		pipe.HSetNX(ctx, keys[1], strconv.FormatInt(userID, 10), quantity)
+	}
+	pipe.SetNX(ctx, keys[0], remaining, 0)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (c *RedisCounter) Acquire(ctx context.Context, saleID, userID int64, quantity, limit int32) (AcquireResult, error) {
+	result, err := acquireScript.Run(ctx, c.client, c.keys(saleID), userID, quantity, limit).Int()
+	if err != nil {
+		return AcquireSoldOut, err
+	}
+	return AcquireResult(result), nil
+}
+
+func (c *RedisCounter) Release(ctx context.Context, saleID, userID int64, quantity int32) error {
+	return releaseScript.Run(ctx, c.client, c.keys(saleID), userID, quantity).Err()
+}
+
+func (c *RedisCounter) Remaining(ctx context.Context, saleID int64) (int32, bool, error) {
+	value, err := c.client.Get(ctx, c.keys(saleID)[0]).Int()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return int32(value), true, nil
+}
+
+func (c *RedisCounter) Remove(ctx context.Context, saleID int64) error {
+	return c.client.Del(ctx, c.keys(saleID)...).Err()
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// FlashSale 秒杀活动，创建时从商品库存划拨AllocatedQuantity，活动期间下单不再读写商品库存
+type FlashSale struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement"`
+	ProductID         int64     `gorm:"index;not null;comment:商品ID"`
+	ProductName       string    `gorm:"size:100;not null;comment:商品名称"`
+	ProductImage      string    `gorm:"size:500;comment:商品图片"`
+	MerchantID        int64     `gorm:"not null;default:0;comment:商家ID"`
+	FlashPrice        float64   `gorm:"type:decimal(10,2);not null;comment:秒杀价"`
+	OriginalPrice     float64   `gorm:"type:decimal(10,2);not null;comment:创建活动时的商品价格"`
+	AllocatedQuantity int32     `gorm:"not null;comment:划拨的库存"`
+	SoldQuantity      int32     `gorm:"not null;default:0;comment:已下单数量，订单取消后扣回"`
+	PerUserLimit      int32     `gorm:"not null;default:0;comment:每人限购数量，0表示不限购"`
+	StartAt           time.Time `gorm:"index;not null;comment:开始时间"`
+	EndAt             time.Time `gorm:"index;not null;comment:结束时间"`
+	Status            string    `gorm:"size:20;index;not null;default:'active';comment:状态"`
+
+	// 对账结果
+	ReturnedQuantity int32      `gorm:"not null;default:0;comment:对账时退回商品的库存"`
+	StockReturned    bool       `gorm:"not null;default:false;comment:剩余库存是否已退回商品"`
+	ReconciledAt     *time.Time `gorm:"comment:对账时间"`
+
+	// 时间字段
+	CreatedAt time.Time      `gorm:"index;autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (FlashSale) TableName() string {
+	return "flash_sales"
+}
+
+// Status 常量
+const (
+	FlashSaleStatusActive     = "active"     // ACTIVE = 0
+	FlashSaleStatusReconciled = "reconciled" // RECONCILED = 1
+)
+
+// FlashSaleRequest 秒杀下单请求，通过库存计数器后写入，由队列异步创建订单
+type FlashSaleRequest struct {
+	RequestID   string `gorm:"size:32;primaryKey;comment:请求ID"`
+	FlashSaleID int64  `gorm:"index;not null;comment:秒杀活动ID"`
+	UserID      int64  `gorm:"index;not null;comment:用户ID"`
+	Quantity    int32  `gorm:"not null;comment:购买数量"`
+	Address     string `gorm:"size:500;not null;comment:收货地址"`
+	Phone       string `gorm:"size:20;not null;comment:联系电话"`
+	Receiver    string `gorm:"size:50;comment:收货人"`
+	Status      string `gorm:"size:20;index;not null;default:'queued';comment:状态"`
+	OrderNo     string `gorm:"size:32;index;comment:订单号"`
+	FailReason  string `gorm:"size:200;comment:失败原因"`
+
+	// 时间字段
+	CreatedAt time.Time      `gorm:"index;autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (FlashSaleRequest) TableName() string {
+	return "flash_sale_requests"
+}
+
+// Status 常量
+const (
+	FlashSaleRequestQueued    = "queued"    // QUEUED = 0
+	FlashSaleRequestCreated   = "created"   // CREATED = 1
+	FlashSaleRequestFailed    = "failed"    // FAILED = 2
+	FlashSaleRequestCancelled = "cancelled" // CANCELLED = 3
+	FlashSaleRequestRefunded  = "refunded"  // REFUNDED = 4
+)
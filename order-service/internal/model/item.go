@@ -7,14 +7,21 @@ import (
 )
 
 type OrderItem struct {
-	ID           int64   `gorm:"primaryKey;autoIncrement"`
-	OrderID      int64   `gorm:"index;not null;comment:订单ID"`
-	OrderNo      string  `gorm:"size:32;index;not null;comment:订单号"`
-	ProductID    int64   `gorm:"index;not null;comment:商品ID"`
-	ProductName  string  `gorm:"size:100;not null;comment:商品名称"`
-	Quantity     int32   `gorm:"not null;default:1;comment:数量"`
-	Price        float64 `gorm:"type:decimal(10,2);not null;comment:单价"`
-	ProductImage string  `gorm:"size:500;comment:商品图片"`
+	ID            int64   `gorm:"primaryKey;autoIncrement"`
+	OrderID       int64   `gorm:"index;not null;comment:订单ID"`
+	OrderNo       string  `gorm:"size:32;index;not null;comment:订单号"`
+	ProductID     int64   `gorm:"index;not null;comment:商品ID"`
+	ProductName   string  `gorm:"size:100;not null;comment:商品名称"`
+	Quantity      int32   `gorm:"not null;default:1;comment:数量"`
+	Price         float64 `gorm:"type:decimal(10,2);not null;comment:单价"`
+	OriginalPrice float64 `gorm:"type:decimal(10,2);not null;default:0;comment:下单时的原价（调价期间）"`
+	ProductImage  string  `gorm:"size:500;comment:商品图片"`
+	WarehouseID   int64   `gorm:"index;not null;default:0;comment:发货仓库ID"`
+	// 阶梯价快照，未命中阶梯价时TierMinQuantity为0
+	BasePrice       float64 `gorm:"type:decimal(10,2);not null;default:0;comment:下单时的商品价格（阶梯价前）"`
+	TierMinQuantity int32   `gorm:"not null;default:0;comment:命中的阶梯价起购数量"`
+	PriceGroup      string  `gorm:"size:50;not null;default:'';comment:命中的价格组"`
+	FlashSaleID     int64   `gorm:"index;not null;default:0;comment:秒杀活动ID"`
 
 	// 时间字段
 	CreatedAt time.Time      `gorm:"index;autoCreateTime"`
@@ -22,9 +29,31 @@ type OrderItem struct {
 	DeletedAt gorm.DeletedAt `gorm:"index"`
 
 	// 关联关系
-	Order Order `gorm:"foreignKey:OrderID"`
+	Order      Order                `gorm:"foreignKey:OrderID"`
+	Components []OrderItemComponent `gorm:"foreignKey:OrderItemID;constraint:OnDelete:CASCADE"`
 }
 
 func (OrderItem) TableName() string {
 	return "order_items"
 }
+
+// OrderItemComponent 套装订单项的组件明细，下单时快照，库存按组件预占和扣减
+type OrderItemComponent struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement"`
+	OrderItemID int64  `gorm:"index;not null;comment:订单项ID"`
+	OrderNo     string `gorm:"size:32;index;not null;comment:订单号"`
+	BundleID    int64  `gorm:"not null;comment:套装商品ID"`
+	ProductID   int64  `gorm:"index;not null;comment:组件商品ID"`
+	ProductName string `gorm:"size:100;not null;comment:组件商品名称"`
+	Quantity    int32  `gorm:"not null;default:1;comment:每套包含的数量"`
+	WarehouseID int64  `gorm:"not null;default:0;comment:发货仓库ID"`
+
+	// 时间字段
+	CreatedAt time.Time      `gorm:"index;autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (OrderItemComponent) TableName() string {
+	return "order_item_components"
+}
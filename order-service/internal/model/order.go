@@ -21,6 +21,11 @@ type Order struct {
 	PaymentNo  string `gorm:"size:100;index;comment:支付单号"`
 	ShippingNo string `gorm:"size:100;index;comment:物流单号"`
 
+	// 多商家拆单：父订单只用于合并支付，不包含订单项；子订单按商家履约
+	MerchantID    int64  `gorm:"index;not null;default:0;comment:商家ID，0为平台自营"`
+	ParentOrderNo string `gorm:"size:32;index;comment:父订单号"`
+	IsParent      bool   `gorm:"not null;default:false;comment:是否为拆单后的父订单"`
+
 	// 时间字段
 	PaidAt      *time.Time     `gorm:"comment:支付时间"`
 	ShippedAt   *time.Time     `gorm:"comment:发货时间"`
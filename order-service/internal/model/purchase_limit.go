@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// PurchaseLimitLock 限购商品的用户下单锁，下单事务内写入同一行，同一用户对同一商品的下单串行校验限购
+type PurchaseLimitLock struct {
+	UserID    int64     `gorm:"primaryKey;autoIncrement:false;comment:用户ID"`
+	ProductID int64     `gorm:"primaryKey;autoIncrement:false;comment:商品ID"`
+	LockedAt  time.Time `gorm:"not null;comment:最近一次下单校验时间"`
+}
+
+func (PurchaseLimitLock) TableName() string {
+	return "purchase_limit_locks"
+}
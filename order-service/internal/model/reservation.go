@@ -7,12 +7,13 @@ import (
 )
 
 type StockReservation struct {
-	ReserveID  string    `gorm:"size:32;primaryKey;comment:预占ID"`
-	OrderNo    string    `gorm:"size:32;index;not null;comment:订单号"`
-	ProductID  int64     `gorm:"index;not null;comment:商品ID"`
-	Quantity   int32     `gorm:"not null;comment:预占数量"`
-	Status     string    `gorm:"size:20;index;not null;default:'reserved';comment:状态"`
-	ExpireTime time.Time `gorm:"index;comment:过期时间"`
+	ReserveID   string    `gorm:"size:32;primaryKey;comment:预占ID"`
+	OrderNo     string    `gorm:"size:32;index;not null;comment:订单号"`
+	ProductID   int64     `gorm:"index;not null;comment:商品ID"`
+	Quantity    int32     `gorm:"not null;comment:预占数量"`
+	WarehouseID int64     `gorm:"index;not null;default:0;comment:仓库ID"`
+	Status      string    `gorm:"size:20;index;not null;default:'reserved';comment:状态"`
+	ExpireTime  time.Time `gorm:"index;comment:过期时间"`
 
 	// 时间字段
 	CreatedAt time.Time      `gorm:"index;autoCreateTime"`
@@ -46,4 +47,5 @@ const (
 	StockStatusConfirmed = "confirmed" // 已确认（扣减）
 	StockStatusReleased  = "released"  // 已释放
 	StockStatusExpired   = "expired"   // 已过期
+	StockStatusReturned  = "returned"  // 已退回（退款后归还库存）
 )
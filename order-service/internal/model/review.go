@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Review struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement"`
+	OrderNo     string `gorm:"size:32;index;not null;comment:订单号"`
+	OrderItemID int64  `gorm:"uniqueIndex;not null;comment:订单项ID，每个订单项只能评价一次"`
+	ProductID   int64  `gorm:"index;not null;comment:商品ID"`
+	UserID      int64  `gorm:"index;not null;comment:用户ID"`
+	Rating      int32  `gorm:"not null;comment:评分1-5"`
+	Content     string `gorm:"size:1000;comment:评价内容"`
+	Images      string `gorm:"type:text;comment:评价图片，JSON数组"`
+	Status      string `gorm:"size:20;index;not null;default:'pending';comment:审核状态"`
+
+	// 商家回复
+	Reply     string     `gorm:"size:500;comment:商家回复"`
+	Replier   string     `gorm:"size:50;comment:回复人"`
+	RepliedAt *time.Time `gorm:"comment:回复时间"`
+
+	// 时间字段
+	CreatedAt time.Time      `gorm:"index;autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"index;autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (Review) TableName() string {
+	return "reviews"
+}
+
+// Status 常量
+const (
+	ReviewStatusPending  = "pending"  // PENDING = 0
+	ReviewStatusApproved = "approved" // APPROVED = 1
+	ReviewStatusHidden   = "hidden"   // HIDDEN = 2
+)
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/model"
+)
+
+// bundleComponents 生成套装订单项的组件快照，普通商品返回nil
+func bundleComponents(productInfo *interfaces.ProductInfo) []model.OrderItemComponent {
+	if len(productInfo.Components) == 0 {
+		return nil
+	}
+	components := make([]model.OrderItemComponent, 0, len(productInfo.Components))
+	for _, c := range productInfo.Components {
+		components = append(components, model.OrderItemComponent{
+			BundleID:    productInfo.ID,
+			ProductID:   c.ProductID,
+			ProductName: c.Name,
+			Quantity:    c.Quantity,
+		})
+	}
+	return components
+}
+
+// stockItems 展开为需要预占库存的商品项：普通订单项原样返回，套装订单项按组件展开，数量为套数乘以每套数量
+func stockItems(items []*model.OrderItem) []*model.OrderItem {
+	result := make([]*model.OrderItem, 0, len(items))
+	for _, item := range items {
+		if len(item.Components) == 0 {
+			result = append(result, item)
+			continue
+		}
+		for _, c := range item.Components {
+			result = append(result, &model.OrderItem{
+				OrderNo:     item.OrderNo,
+				ProductID:   c.ProductID,
+				ProductName: c.ProductName,
+				Quantity:    item.Quantity * c.Quantity,
+				WarehouseID: c.WarehouseID,
+			})
+		}
+	}
+	return result
+}
+
+// assignWarehouses 写入仓库路由结果，套装的组件都从同一仓库发货时订单项也记录该仓库
+func assignWarehouses(items []*model.OrderItem, assignments map[int64]int64) {
+	for _, item := range items {
+		if len(item.Components) == 0 {
+			item.WarehouseID = assignments[item.ProductID]
+			continue
+		}
+		item.WarehouseID = assignments[item.Components[0].ProductID]
+		for i := range item.Components {
+			component := &item.Components[i]
+			component.WarehouseID = assignments[component.ProductID]
+			if component.WarehouseID != item.WarehouseID {
+				item.WarehouseID = 0
+			}
+		}
+	}
+}
@@ -0,0 +1,1000 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/flashsale"
+	"ecommerce/order-service/internal/model"
+	"ecommerce/order-service/kitex_gen/api"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const (
+	// 秒杀订单未支付的超时时间
+	flashSaleUnpaidTimeout = 30 * time.Minute
+	// 启动时恢复排队请求的单次条数上限
+	flashSaleResumeBatchSize = 1000
+	// 单次秒杀下单数量上限
+	maxFlashSaleQuantity = 100
+	// 活动缓存有效期，其他实例对账后最多延迟这么久生效
+	flashSaleCacheTTL = 10 * time.Second
+)
+
+// 计入已抢数量的请求状态：排队中、已下单、已退款（退款的库存归还商品，不回到活动）
+var flashSaleHeldStatuses = []string{
+	model.FlashSaleRequestQueued,
+	model.FlashSaleRequestCreated,
+	model.FlashSaleRequestRefunded,
+}
+
+// flashSaleState 秒杀运行时状态：库存计数器、下单队列和进行中活动的缓存
+type flashSaleState struct {
+	counter flashsale.StockCounter
+	queue   chan *model.FlashSaleRequest
+
+	mu    sync.RWMutex
+	sales map[int64]*cachedFlashSale
+}
+
+// cachedFlashSale 缓存的活动和缓存时间
+type cachedFlashSale struct {
+	sale     *model.FlashSale
+	cachedAt time.Time
+}
+
+// SetFlashSaleCounter 设置秒杀库存计数器
+func (s *OrderService) SetFlashSaleCounter(counter flashsale.StockCounter) {
+	s.flashSale.counter = counter
+}
+
+// StartFlashSale 启动秒杀下单队列和定时对账，返回停止函数；未设置计数器时不启动，秒杀下单返回服务未启动
+func (s *OrderService) StartFlashSale(workers, queueSize int, interval time.Duration) func() {
+	if s.flashSale.counter == nil {
+		klog.Warnf("未设置秒杀库存计数器，秒杀下单不可用")
+		return func() {}
+	}
+	s.flashSale.queue = make(chan *model.FlashSaleRequest, queueSize)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case request := <-s.flashSale.queue:
+					s.processFlashSaleRequest(ctx, request)
+				}
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// 上次退出时未处理的排队请求重新入队
+		s.resumeFlashSaleRequests(ctx)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			s.runFlashSaleMaintenance(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// CreateFlashSale 创建秒杀活动，从商品库存划拨活动库存
+func (s *OrderService) CreateFlashSale(ctx context.Context, req *api.CreateFlashSaleReq) (*api.CreateFlashSaleResp, error) {
+	if req.ProductId <= 0 || req.FlashPrice <= 0 || req.AllocatedQuantity <= 0 || req.PerUserLimit < 0 {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "参数错误",
+		}, nil
+	}
+	if req.EndAt <= req.StartAt || req.EndAt <= time.Now().Unix() {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "活动时间无效",
+		}, nil
+	}
+
+	product, err := s.productClient.GetProductInfo(ctx, req.ProductId)
+	if err != nil {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询商品失败: %v", err),
+		}, nil
+	}
+	if product == nil {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    404,
+			Message: "商品不存在",
+		}, nil
+	}
+	if product.Status != interfaces.ProductStatusOnline {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "商品未上架",
+		}, nil
+	}
+	if len(product.Components) > 0 {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "套装商品不支持秒杀",
+		}, nil
+	}
+	if req.FlashPrice >= product.Price {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "秒杀价必须低于商品价格",
+		}, nil
+	}
+
+	sale := &model.FlashSale{
+		ProductID:         product.ID,
+		ProductName:       product.Name,
+		ProductImage:      product.Avatar,
+		MerchantID:        product.MerchantID,
+		FlashPrice:        req.FlashPrice,
+		OriginalPrice:     product.Price,
+		AllocatedQuantity: req.AllocatedQuantity,
+		PerUserLimit:      req.PerUserLimit,
+		StartAt:           time.Unix(req.StartAt, 0),
+		EndAt:             time.Unix(req.EndAt, 0),
+		Status:            model.FlashSaleStatusActive,
+	}
+	if err := s.daoFactory.FlashSaleRepo.Create(ctx, sale); err != nil {
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("创建秒杀活动失败: %v", err),
+		}, nil
+	}
+
+	//划拨库存，活动期间下单不再访问商品库存
+	err = s.productClient.AdjustStock(ctx, sale.ProductID, 0, -sale.AllocatedQuantity,
+		interfaces.StockChangeFlashSaleAllocate, fmt.Sprintf("flashsale-%d-allocate", sale.ID))
+	if err != nil {
+		klog.Warnf("划拨秒杀库存失败: sale=%d, err=%v", sale.ID, err)
+		if err := s.daoFactory.FlashSaleRepo.Delete(ctx, sale.ID); err != nil {
+			klog.Errorf("删除秒杀活动失败: sale=%d, err=%v", sale.ID, err)
+		}
+		return &api.CreateFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "商品库存不足",
+		}, nil
+	}
+
+	if err := s.registerFlashSale(ctx, sale); err != nil {
+		// 计数器加载失败不影响活动，下单时会再次加载
+		klog.Warnf("加载秒杀库存计数失败: sale=%d, err=%v", sale.ID, err)
+	}
+
+	return &api.CreateFlashSaleResp{
+		Success:   true,
+		Code:      0,
+		Message:   "创建成功",
+		FlashSale: s.convertToAPIFlashSale(ctx, sale),
+	}, nil
+}
+
+// GetFlashSale 查询秒杀活动
+func (s *OrderService) GetFlashSale(ctx context.Context, req *api.GetFlashSaleReq) (*api.GetFlashSaleResp, error) {
+	sale, err := s.daoFactory.FlashSaleRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.GetFlashSaleResp{
+				Success: false,
+				Code:    404,
+				Message: "秒杀活动不存在",
+			}, nil
+		}
+		return &api.GetFlashSaleResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询秒杀活动失败: %v", err),
+		}, nil
+	}
+	return &api.GetFlashSaleResp{
+		Success:   true,
+		Code:      0,
+		Message:   "查询成功",
+		FlashSale: s.convertToAPIFlashSale(ctx, sale),
+	}, nil
+}
+
+// ListFlashSales 查询秒杀活动列表
+func (s *OrderService) ListFlashSales(ctx context.Context, req *api.ListFlashSalesReq) (*api.ListFlashSalesResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	var productID int64
+	if req.ProductId != nil {
+		productID = *req.ProductId
+	}
+	includeEnded := req.IncludeEnded != nil && *req.IncludeEnded
+
+	sales, total, err := s.daoFactory.FlashSaleRepo.List(ctx, productID, includeEnded, time.Now(), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return &api.ListFlashSalesResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询秒杀活动失败: %v", err),
+		}, nil
+	}
+	apiSales := make([]*api.FlashSale, 0, len(sales))
+	for _, sale := range sales {
+		apiSales = append(apiSales, s.convertToAPIFlashSale(ctx, sale))
+	}
+	return &api.ListFlashSalesResp{
+		Success:    true,
+		Code:       0,
+		Message:    "查询成功",
+		FlashSales: apiSales,
+		Total:      int32(total),
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+// SubmitFlashSaleOrder 秒杀下单，通过计数器后进入队列异步创建订单，未抢到的请求不访问数据库
+func (s *OrderService) SubmitFlashSaleOrder(ctx context.Context, req *api.SubmitFlashSaleOrderReq) (*api.SubmitFlashSaleOrderResp, error) {
+	if req.UserId <= 0 || req.Quantity <= 0 || req.Quantity > maxFlashSaleQuantity {
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: "参数错误",
+		}, nil
+	}
+	if req.Address == "" {
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: ErrAddressRequired.Error(),
+		}, nil
+	}
+	if req.Phone == "" {
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: ErrPhoneRequired.Error(),
+		}, nil
+	}
+	if s.flashSale.queue == nil {
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "秒杀服务未启动",
+		}, nil
+	}
+
+	sale, err := s.activeFlashSale(ctx, req.FlashSaleId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.SubmitFlashSaleOrderResp{
+				Success: false,
+				Code:    404,
+				Message: "秒杀活动不存在",
+			}, nil
+		}
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询秒杀活动失败: %v", err),
+		}, nil
+	}
+	now := time.Now()
+	if now.Before(sale.StartAt) {
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: "秒杀活动未开始",
+		}, nil
+	}
+	if !now.Before(sale.EndAt) || sale.Status != model.FlashSaleStatusActive {
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: "秒杀活动已结束",
+		}, nil
+	}
+
+	counter := s.flashSale.counter
+	result, err := counter.Acquire(ctx, sale.ID, req.UserId, req.Quantity, sale.PerUserLimit)
+	if err == nil && result == flashsale.AcquireNotLoaded {
+		if err = s.loadFlashSaleCounter(ctx, sale); err == nil {
+			result, err = counter.Acquire(ctx, sale.ID, req.UserId, req.Quantity, sale.PerUserLimit)
+		}
+	}
+	if err != nil {
+		klog.Errorf("秒杀库存计数失败: sale=%d, err=%v", sale.ID, err)
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "系统繁忙，请稍后重试",
+		}, nil
+	}
+	switch result {
+	case flashsale.AcquireSoldOut:
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: "已抢光",
+		}, nil
+	case flashsale.AcquireLimitExceeded:
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("超过每人限购数量%d件", sale.PerUserLimit),
+		}, nil
+	case flashsale.AcquireNotLoaded:
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "系统繁忙，请稍后重试",
+		}, nil
+	}
+
+	//秒杀购买同样计入商品每人限购，入队前预检，超限时归还计数器
+	token := &model.FlashSaleRequest{FlashSaleID: sale.ID, UserID: req.UserId, Quantity: req.Quantity}
+	product, err := s.productClient.GetProductInfo(ctx, sale.ProductID)
+	if err != nil || product == nil {
+		klog.Errorf("查询秒杀商品失败: sale=%d, err=%v", sale.ID, err)
+		s.releaseFlashSaleCounter(ctx, token)
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "商品服务不可用，请稍后重试",
+		}, nil
+	}
+	msg, err := s.precheckPurchaseLimit(ctx, req.UserId, product, req.Quantity)
+	if err != nil || msg != "" {
+		s.releaseFlashSaleCounter(ctx, token)
+		if err != nil {
+			return &api.SubmitFlashSaleOrderResp{
+				Success: false,
+				Code:    500,
+				Message: fmt.Sprintf("校验限购失败: %v", err),
+			}, nil
+		}
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    CodePurchaseLimitExceeded,
+			Message: msg,
+		}, nil
+	}
+
+	request := &model.FlashSaleRequest{
+		RequestID:   s.generateFlashSaleRequestID(),
+		FlashSaleID: sale.ID,
+		UserID:      req.UserId,
+		Quantity:    req.Quantity,
+		Address:     req.Address,
+		Phone:       req.Phone,
+		Status:      model.FlashSaleRequestQueued,
+	}
+	if req.Receiver != nil {
+		request.Receiver = *req.Receiver
+	}
+	if err := s.daoFactory.FlashSaleRequestRepo.Create(ctx, request); err != nil {
+		s.releaseFlashSaleCounter(ctx, request)
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("提交秒杀请求失败: %v", err),
+		}, nil
+	}
+
+	select {
+	case s.flashSale.queue <- request:
+	default:
+		s.failFlashSaleRequest(ctx, request, "排队人数过多")
+		return &api.SubmitFlashSaleOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "排队人数过多，请稍后重试",
+		}, nil
+	}
+
+	return &api.SubmitFlashSaleOrderResp{
+		Success: true,
+		Code:    0,
+		Message: "排队中",
+		Request: s.convertToAPIFlashSaleRequest(request),
+	}, nil
+}
+
+// GetFlashSaleRequest 查询秒杀请求的处理结果
+func (s *OrderService) GetFlashSaleRequest(ctx context.Context, req *api.GetFlashSaleRequestReq) (*api.GetFlashSaleRequestResp, error) {
+	request, err := s.daoFactory.FlashSaleRequestRepo.FindByRequestID(ctx, req.RequestId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.GetFlashSaleRequestResp{
+				Success: false,
+				Code:    404,
+				Message: "秒杀请求不存在",
+			}, nil
+		}
+		return &api.GetFlashSaleRequestResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询秒杀请求失败: %v", err),
+		}, nil
+	}
+	if request.UserID != req.UserId {
+		return &api.GetFlashSaleRequestResp{
+			Success: false,
+			Code:    404,
+			Message: "秒杀请求不存在",
+		}, nil
+	}
+	return &api.GetFlashSaleRequestResp{
+		Success: true,
+		Code:    0,
+		Message: "查询成功",
+		Request: s.convertToAPIFlashSaleRequest(request),
+	}, nil
+}
+
+// ReconcileFlashSale 秒杀活动对账，将未售出的库存退回商品
+func (s *OrderService) ReconcileFlashSale(ctx context.Context, req *api.ReconcileFlashSaleReq) (*api.ReconcileFlashSaleResp, error) {
+	sale, err := s.daoFactory.FlashSaleRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ReconcileFlashSaleResp{
+				Success: false,
+				Code:    404,
+				Message: "秒杀活动不存在",
+			}, nil
+		}
+		return &api.ReconcileFlashSaleResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询秒杀活动失败: %v", err),
+		}, nil
+	}
+	if sale.Status == model.FlashSaleStatusActive && time.Now().Before(sale.EndAt) {
+		return &api.ReconcileFlashSaleResp{
+			Success: false,
+			Code:    400,
+			Message: "秒杀活动未结束",
+		}, nil
+	}
+
+	reconciliation, err := s.reconcileFlashSale(ctx, sale)
+	if err != nil {
+		if errors.Is(err, errFlashSaleRequestsQueued) {
+			return &api.ReconcileFlashSaleResp{
+				Success: false,
+				Code:    409,
+				Message: err.Error(),
+			}, nil
+		}
+		return &api.ReconcileFlashSaleResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("秒杀活动对账失败: %v", err),
+		}, nil
+	}
+	return &api.ReconcileFlashSaleResp{
+		Success:        true,
+		Code:           0,
+		Message:        "对账完成",
+		Reconciliation: reconciliation,
+	}, nil
+}
+
+var errFlashSaleRequestsQueued = errors.New("仍有排队中的秒杀请求")
+
+// reconcileFlashSale 标记对账并退回剩余库存，已对账的活动重试未完成的库存退回
+func (s *OrderService) reconcileFlashSale(ctx context.Context, sale *model.FlashSale) (*api.FlashSaleReconciliation, error) {
+	requestRepo := s.daoFactory.FlashSaleRequestRepo
+	saleRepo := s.daoFactory.FlashSaleRepo
+
+	if sale.Status == model.FlashSaleStatusActive {
+		queued, err := requestRepo.CountByStatus(ctx, sale.ID, model.FlashSaleRequestQueued)
+		if err != nil {
+			return nil, err
+		}
+		if queued > 0 {
+			return nil, errFlashSaleRequestsQueued
+		}
+		if _, err := saleRepo.MarkReconciled(ctx, sale.ID, time.Now()); err != nil {
+			return nil, err
+		}
+		reloaded, err := saleRepo.FindByID(ctx, sale.ID)
+		if err != nil {
+			return nil, err
+		}
+		sale = reloaded
+	}
+	s.unregisterFlashSale(ctx, sale.ID)
+
+	if !sale.StockReturned {
+		if sale.ReturnedQuantity > 0 {
+			err := s.productClient.AdjustStock(ctx, sale.ProductID, 0, sale.ReturnedQuantity,
+				interfaces.StockChangeFlashSaleReturn, fmt.Sprintf("flashsale-%d-return", sale.ID))
+			if err != nil {
+				return nil, fmt.Errorf("退回秒杀库存失败: %v", err)
+			}
+		}
+		if err := saleRepo.MarkStockReturned(ctx, sale.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	//已下单和已退款的请求数量之和应等于已下单数量
+	held, err := requestRepo.SumQuantityByUser(ctx, sale.ID, []string{model.FlashSaleRequestCreated, model.FlashSaleRequestRefunded})
+	if err != nil {
+		return nil, err
+	}
+	var requested int32
+	for _, quantity := range held {
+		requested += quantity
+	}
+	if requested != sale.SoldQuantity {
+		klog.Warnf("秒杀活动对账不一致: sale=%d, sold=%d, requested=%d", sale.ID, sale.SoldQuantity, requested)
+	}
+
+	reconciliation := &api.FlashSaleReconciliation{
+		FlashSaleId:       sale.ID,
+		AllocatedQuantity: sale.AllocatedQuantity,
+		SoldQuantity:      sale.SoldQuantity,
+		ReturnedQuantity:  sale.ReturnedQuantity,
+		RequestedQuantity: requested,
+		Consistent:        requested == sale.SoldQuantity,
+	}
+	if sale.ReconciledAt != nil {
+		reconciliation.ReconciledAt = sale.ReconciledAt.Unix()
+	}
+	return reconciliation, nil
+}
+
+// runFlashSaleMaintenance 刷新进行中活动的计数器，结束的活动自动对账，panic不影响后续执行
+func (s *OrderService) runFlashSaleMaintenance(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			klog.Errorf("秒杀活动维护panic: %v", r)
+		}
+	}()
+	sales, err := s.daoFactory.FlashSaleRepo.ListActive(ctx)
+	if err != nil {
+		klog.Errorf("查询秒杀活动失败: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, sale := range sales {
+		if sale.Status == model.FlashSaleStatusActive && now.Before(sale.EndAt) {
+			if err := s.registerFlashSale(ctx, sale); err != nil {
+				klog.Warnf("加载秒杀库存计数失败: sale=%d, err=%v", sale.ID, err)
+			}
+			continue
+		}
+		if _, err := s.reconcileFlashSale(ctx, sale); err != nil && !errors.Is(err, errFlashSaleRequestsQueued) {
+			klog.Errorf("秒杀活动自动对账失败: sale=%d, err=%v", sale.ID, err)
+		}
+	}
+}
+
+// resumeFlashSaleRequests 将排队中的请求重新入队
+func (s *OrderService) resumeFlashSaleRequests(ctx context.Context) {
+	requests, err := s.daoFactory.FlashSaleRequestRepo.ListByStatus(ctx, model.FlashSaleRequestQueued, flashSaleResumeBatchSize)
+	if err != nil {
+		klog.Errorf("查询排队中的秒杀请求失败: %v", err)
+		return
+	}
+	for _, request := range requests {
+		select {
+		case <-ctx.Done():
+			return
+		case s.flashSale.queue <- request:
+		}
+	}
+	if len(requests) > 0 {
+		klog.Infof("恢复排队中的秒杀请求: %d", len(requests))
+	}
+}
+
+// processFlashSaleRequest 为排队的请求创建订单，活动已下单数量在同一事务内校验，不会超卖
+func (s *OrderService) processFlashSaleRequest(ctx context.Context, request *model.FlashSaleRequest) {
+	defer func() {
+		if r := recover(); r != nil {
+			klog.Errorf("秒杀下单panic: request=%s, err=%v", request.RequestID, r)
+		}
+	}()
+
+	sale, err := s.activeFlashSale(ctx, request.FlashSaleID)
+	if err != nil {
+		klog.Errorf("查询秒杀活动失败: request=%s, err=%v", request.RequestID, err)
+		s.failFlashSaleRequest(ctx, request, "秒杀活动不存在")
+		return
+	}
+
+	product, err := s.productClient.GetProductInfo(ctx, sale.ProductID)
+	if err != nil || product == nil {
+		klog.Errorf("查询秒杀商品失败: request=%s, err=%v", request.RequestID, err)
+		s.failFlashSaleRequest(ctx, request, "商品服务不可用")
+		return
+	}
+	limited := limitedPurchases([]*model.OrderItem{{ProductID: sale.ProductID, Quantity: request.Quantity}},
+		map[int64]*interfaces.ProductInfo{sale.ProductID: product})
+
+	now := time.Now()
+	orderNo := s.generateOrderNo()
+	order := &model.Order{
+		OrderNo:     orderNo,
+		UserID:      request.UserID,
+		TotalAmount: sale.FlashPrice * float64(request.Quantity),
+		Status:      model.OrderStatusPending,
+		Address:     request.Address,
+		Phone:       request.Phone,
+		Receiver:    request.Receiver,
+		MerchantID:  sale.MerchantID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	var limitMsg string
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.FlashSale{}).
+			Where("id = ? AND status = ? AND sold_quantity + ? <= allocated_quantity", sale.ID, model.FlashSaleStatusActive, request.Quantity).
+			Update("sold_quantity", gorm.Expr("sold_quantity + ?", request.Quantity))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrStockNotEnough
+		}
+		// 上面的更新锁住了活动行，同一活动的请求在此串行，按已下单记录复核每人限购，不依赖计数器
+		if sale.PerUserLimit > 0 {
+			var purchased int64
+			err := tx.Model(&model.FlashSaleRequest{}).
+				Where("flash_sale_id = ? AND user_id = ? AND status IN ?", sale.ID, request.UserID,
+					[]string{model.FlashSaleRequestCreated, model.FlashSaleRequestRefunded}).
+				Select("COALESCE(SUM(quantity), 0)").
+				Scan(&purchased).Error
+			if err != nil {
+				return err
+			}
+			if int32(purchased)+request.Quantity > sale.PerUserLimit {
+				return errFlashSaleLimitExceeded
+			}
+		}
+		// 商品每人限购与普通下单共用同一把锁和已购统计
+		limitMsg, err = s.checkPurchaseLimits(tx, request.UserID, limited, now)
+		if err != nil {
+			return err
+		}
+		if limitMsg != "" {
+			return errProductPurchaseLimitExceeded
+		}
+		if err := tx.Create(order).Error; err != nil {
+			return err
+		}
+		item := &model.OrderItem{
+			OrderID:       order.ID,
+			OrderNo:       orderNo,
+			ProductID:     sale.ProductID,
+			ProductName:   sale.ProductName,
+			Quantity:      request.Quantity,
+			Price:         sale.FlashPrice,
+			OriginalPrice: sale.OriginalPrice,
+			BasePrice:     sale.OriginalPrice,
+			ProductImage:  sale.ProductImage,
+			FlashSaleID:   sale.ID,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := tx.Create(item).Error; err != nil {
+			return err
+		}
+		result = tx.Model(&model.FlashSaleRequest{}).
+			Where("request_id = ? AND status = ?", request.RequestID, model.FlashSaleRequestQueued).
+			Updates(map[string]interface{}{"status": model.FlashSaleRequestCreated, "order_no": orderNo})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errFlashSaleRequestHandled
+		}
+		return nil
+	})
+	if errors.Is(err, errFlashSaleRequestHandled) {
+		// 多实例恢复队列时同一请求可能被重复处理
+		return
+	}
+	if err != nil {
+		klog.Warnf("秒杀下单失败: request=%s, err=%v", request.RequestID, err)
+		reason := "创建订单失败"
+		if errors.Is(err, ErrStockNotEnough) {
+			reason = "已抢光"
+		} else if errors.Is(err, errFlashSaleLimitExceeded) {
+			reason = fmt.Sprintf("超过每人限购数量%d件", sale.PerUserLimit)
+		} else if errors.Is(err, errProductPurchaseLimitExceeded) {
+			reason = limitMsg
+		}
+		s.failFlashSaleRequest(ctx, request, reason)
+		return
+	}
+	klog.Infof("秒杀订单创建成功: request=%s, order=%s", request.RequestID, orderNo)
+
+	if s.daoFactory.TimeoutTaskRepo != nil {
+		timeoutTask := &model.TimeoutTask{
+			TaskID:     s.generateTaskID(),
+			OrderNo:    orderNo,
+			Type:       model.TimeoutTypeOrderUnpaid,
+			Status:     model.TaskStatusPending,
+			ExpireTime: now.Add(flashSaleUnpaidTimeout),
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		if err := s.daoFactory.TimeoutTaskRepo.Create(ctx, timeoutTask); err != nil {
+			klog.Warnf("创建超时任务失败: %v", err)
+		}
+	}
+}
+
+var (
+	errFlashSaleRequestHandled = errors.New("秒杀请求已处理")
+	errFlashSaleLimitExceeded  = errors.New("超过每人限购数量")
+	// 超过商品本身的每人限购，与活动限购分开提示
+	errProductPurchaseLimitExceeded = errors.New("超过商品每人限购")
+)
+
+// failFlashSaleRequest 标记请求失败并归还计数器
+func (s *OrderService) failFlashSaleRequest(ctx context.Context, request *model.FlashSaleRequest, reason string) {
+	updated, err := s.daoFactory.FlashSaleRequestRepo.UpdateStatus(ctx, request.RequestID,
+		model.FlashSaleRequestQueued, model.FlashSaleRequestFailed, "", reason)
+	if err != nil {
+		klog.Errorf("更新秒杀请求状态失败: request=%s, err=%v", request.RequestID, err)
+		return
+	}
+	if updated {
+		s.releaseFlashSaleCounter(ctx, request)
+	}
+}
+
+// releaseFlashSaleStock 秒杀订单取消，活动未对账时库存回到活动，已对账则归还商品
+func (s *OrderService) releaseFlashSaleStock(ctx context.Context, orderNo string) {
+	if s.daoFactory.FlashSaleRequestRepo == nil {
+		return
+	}
+	request, err := s.daoFactory.FlashSaleRequestRepo.FindByOrderNo(ctx, orderNo)
+	if err != nil {
+		return
+	}
+	updated, err := s.daoFactory.FlashSaleRequestRepo.UpdateStatus(ctx, request.RequestID,
+		model.FlashSaleRequestCreated, model.FlashSaleRequestCancelled, orderNo, "")
+	if err != nil || !updated {
+		return
+	}
+
+	returned, err := s.daoFactory.FlashSaleRepo.DecrementSold(ctx, request.FlashSaleID, request.Quantity)
+	if err != nil {
+		klog.Errorf("扣回秒杀已下单数量失败: request=%s, err=%v", request.RequestID, err)
+		return
+	}
+	if returned {
+		s.releaseFlashSaleCounter(ctx, request)
+		return
+	}
+	sale, err := s.daoFactory.FlashSaleRepo.FindByID(ctx, request.FlashSaleID)
+	if err != nil {
+		klog.Errorf("查询秒杀活动失败: request=%s, err=%v", request.RequestID, err)
+		return
+	}
+	err = s.productClient.AdjustStock(ctx, sale.ProductID, 0, request.Quantity,
+		interfaces.StockChangeCancelRestore, fmt.Sprintf("flashsale-%s-cancel", request.RequestID))
+	if err != nil {
+		klog.Errorf("恢复秒杀库存失败: request=%s, err=%v", request.RequestID, err)
+	}
+}
+
+// returnFlashSaleRefund 秒杀订单退款，库存归还商品
+func (s *OrderService) returnFlashSaleRefund(ctx context.Context, orderNo string) {
+	if s.daoFactory.FlashSaleRequestRepo == nil {
+		return
+	}
+	request, err := s.daoFactory.FlashSaleRequestRepo.FindByOrderNo(ctx, orderNo)
+	if err != nil {
+		return
+	}
+	updated, err := s.daoFactory.FlashSaleRequestRepo.UpdateStatus(ctx, request.RequestID,
+		model.FlashSaleRequestCreated, model.FlashSaleRequestRefunded, orderNo, "")
+	if err != nil || !updated {
+		return
+	}
+	sale, err := s.daoFactory.FlashSaleRepo.FindByID(ctx, request.FlashSaleID)
+	if err != nil {
+		klog.Errorf("查询秒杀活动失败: request=%s, err=%v", request.RequestID, err)
+		return
+	}
+	err = s.productClient.AdjustStock(ctx, sale.ProductID, 0, request.Quantity,
+		interfaces.StockChangeRefundReturn, fmt.Sprintf("flashsale-%s-refund", request.RequestID))
+	if err != nil {
+		klog.Errorf("秒杀退款归还库存失败: request=%s, err=%v", request.RequestID, err)
+	}
+}
+
+// releaseFlashSaleCounter 归还计数器中的库存和用户已购数量
+func (s *OrderService) releaseFlashSaleCounter(ctx context.Context, request *model.FlashSaleRequest) {
+	if s.flashSale.counter == nil {
+		return
+	}
+	if err := s.flashSale.counter.Release(ctx, request.FlashSaleID, request.UserID, request.Quantity); err != nil {
+		klog.Errorf("归还秒杀库存计数失败: request=%s, err=%v", request.RequestID, err)
+	}
+}
+
+// activeFlashSale 优先从缓存读取活动，未命中或缓存过期时查库并加载计数器
+func (s *OrderService) activeFlashSale(ctx context.Context, id int64) (*model.FlashSale, error) {
+	s.flashSale.mu.RLock()
+	cached, ok := s.flashSale.sales[id]
+	s.flashSale.mu.RUnlock()
+	if ok && time.Since(cached.cachedAt) < flashSaleCacheTTL {
+		return cached.sale, nil
+	}
+	sale, err := s.daoFactory.FlashSaleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sale.Status == model.FlashSaleStatusActive && time.Now().Before(sale.EndAt) {
+		if err := s.registerFlashSale(ctx, sale); err != nil {
+			klog.Warnf("加载秒杀库存计数失败: sale=%d, err=%v", sale.ID, err)
+		}
+	} else if ok {
+		// 活动已结束或已被其他实例对账，不再缓存
+		s.flashSale.mu.Lock()
+		delete(s.flashSale.sales, id)
+		s.flashSale.mu.Unlock()
+	}
+	return sale, nil
+}
+
+// registerFlashSale 缓存活动并加载计数器
+func (s *OrderService) registerFlashSale(ctx context.Context, sale *model.FlashSale) error {
+	s.flashSale.mu.Lock()
+	if s.flashSale.sales == nil {
+		s.flashSale.sales = make(map[int64]*cachedFlashSale)
+	}
+	s.flashSale.sales[sale.ID] = &cachedFlashSale{sale: sale, cachedAt: time.Now()}
+	s.flashSale.mu.Unlock()
+	return s.loadFlashSaleCounter(ctx, sale)
+}
+
+// unregisterFlashSale 移除活动缓存和计数器
+func (s *OrderService) unregisterFlashSale(ctx context.Context, id int64) {
+	s.flashSale.mu.Lock()
+	delete(s.flashSale.sales, id)
+	s.flashSale.mu.Unlock()
+	if s.flashSale.counter != nil {
+		if err := s.flashSale.counter.Remove(ctx, id); err != nil {
+			klog.Warnf("清除秒杀库存计数失败: sale=%d, err=%v", id, err)
+		}
+	}
+}
+
+// loadFlashSaleCounter 按请求记录计算剩余库存和各用户已购数量，计数器已加载时不覆盖
+func (s *OrderService) loadFlashSaleCounter(ctx context.Context, sale *model.FlashSale) error {
+	if s.flashSale.counter == nil {
+		return nil
+	}
+	if _, loaded, err := s.flashSale.counter.Remaining(ctx, sale.ID); err != nil || loaded {
+		return err
+	}
+	purchased, err := s.daoFactory.FlashSaleRequestRepo.SumQuantityByUser(ctx, sale.ID, flashSaleHeldStatuses)
+	if err != nil {
+		return err
+	}
+	remaining := sale.AllocatedQuantity
+	for _, quantity := range purchased {
+		remaining -= quantity
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return s.flashSale.counter.Load(ctx, sale.ID, remaining, purchased)
+}
+
+// generateFlashSaleRequestID 生成秒杀请求ID
+func (s *OrderService) generateFlashSaleRequestID() string {
+	// 格式: FS + 年月日时分秒 + 6位随机数
+	now := time.Now()
+	timestamp := now.Format("20060102150405")
+	random := rand.Intn(1000000)
+	return fmt.Sprintf("FS%s%06d", timestamp, random)
+}
+
+// convertToAPIFlashSale 转换秒杀活动，剩余库存优先读取计数器
+func (s *OrderService) convertToAPIFlashSale(ctx context.Context, sale *model.FlashSale) *api.FlashSale {
+	apiSale := &api.FlashSale{
+		Id:                sale.ID,
+		ProductId:         sale.ProductID,
+		ProductName:       sale.ProductName,
+		FlashPrice:        sale.FlashPrice,
+		OriginalPrice:     sale.OriginalPrice,
+		AllocatedQuantity: sale.AllocatedQuantity,
+		SoldQuantity:      sale.SoldQuantity,
+		PerUserLimit:      sale.PerUserLimit,
+		StartAt:           sale.StartAt.Unix(),
+		EndAt:             sale.EndAt.Unix(),
+		Status:            api.FlashSaleStatus_ACTIVE,
+		ReturnedQuantity:  sale.ReturnedQuantity,
+		CreatedAt:         sale.CreatedAt.Unix(),
+	}
+	if sale.Status == model.FlashSaleStatusReconciled {
+		apiSale.Status = api.FlashSaleStatus_RECONCILED
+		if sale.ReconciledAt != nil {
+			reconciledAt := sale.ReconciledAt.Unix()
+			apiSale.ReconciledAt = &reconciledAt
+		}
+		return apiSale
+	}
+	apiSale.RemainingQuantity = sale.AllocatedQuantity - sale.SoldQuantity
+	if s.flashSale.counter != nil {
+		if remaining, loaded, err := s.flashSale.counter.Remaining(ctx, sale.ID); err == nil && loaded {
+			apiSale.RemainingQuantity = remaining
+		}
+	}
+	return apiSale
+}
+
+// convertToAPIFlashSaleRequest 转换秒杀请求
+func (s *OrderService) convertToAPIFlashSaleRequest(request *model.FlashSaleRequest) *api.FlashSaleRequest {
+	apiRequest := &api.FlashSaleRequest{
+		RequestId:   request.RequestID,
+		FlashSaleId: request.FlashSaleID,
+		Quantity:    request.Quantity,
+		Status:      api.FlashSaleRequestStatus_QUEUED,
+		CreatedAt:   request.CreatedAt.Unix(),
+	}
+	switch request.Status {
+	case model.FlashSaleRequestCreated:
+		apiRequest.Status = api.FlashSaleRequestStatus_CREATED
+	case model.FlashSaleRequestFailed:
+		apiRequest.Status = api.FlashSaleRequestStatus_FAILED
+	case model.FlashSaleRequestCancelled:
+		apiRequest.Status = api.FlashSaleRequestStatus_CANCELLED
+	case model.FlashSaleRequestRefunded:
+		apiRequest.Status = api.FlashSaleRequestStatus_REFUNDED
+	}
+	if request.OrderNo != "" {
+		orderNo := request.OrderNo
+		apiRequest.OrderNo = &orderNo
+	}
+	if request.FailReason != "" {
+		failReason := request.FailReason
+		apiRequest.FailReason = &failReason
+	}
+	return apiRequest
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce/order-service/internal/model"
+)
+
+// merchantItemGroup 同一商家的订单项，拆单后对应一个子订单
+type merchantItemGroup struct {
+	merchantID  int64
+	items       []*model.OrderItem
+	totalAmount float64
+	order       *model.Order
+}
+
+// splitItemsByMerchant 按商家分组订单项，分组顺序与商家在订单中首次出现的顺序一致
+func splitItemsByMerchant(items []*model.OrderItem, merchants []int64) []*merchantItemGroup {
+	var groups []*merchantItemGroup
+	index := make(map[int64]*merchantItemGroup)
+	for i, item := range items {
+		group, ok := index[merchants[i]]
+		if !ok {
+			group = &merchantItemGroup{merchantID: merchants[i]}
+			index[merchants[i]] = group
+			groups = append(groups, group)
+		}
+		group.items = append(group.items, item)
+		group.totalAmount += item.Price * float64(item.Quantity)
+	}
+	return groups
+}
+
+// loadSubOrders 查询父订单下的子订单，非父订单返回nil
+func (s *OrderService) loadSubOrders(ctx context.Context, order *model.Order) ([]*model.Order, error) {
+	if !order.IsParent {
+		return nil, nil
+	}
+	return s.daoFactory.OrderRepo.FindByParentOrderNo(ctx, order.OrderNo)
+}
+
+// merchantOwnsOrder 校验商家只能操作自己的订单，merchantID为nil时表示平台操作
+func merchantOwnsOrder(order *model.Order, merchantID *int64) bool {
+	return merchantID == nil || (!order.IsParent && order.MerchantID == *merchantID)
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/model"
+	"ecommerce/order-service/kitex_gen/api"
+)
+
+// quoteItemPrices 按购买数量和用户价格组计算各商品项的成交价，按req.Items下标返回；
+// 计价失败时返回错误，不能按商品价格计价，否则成交价与搜索展示的阶梯价不一致
+func (s *OrderService) quoteItemPrices(ctx context.Context, userID int64, items []*api.OrderItem) (map[int]*interfaces.PriceQuote, error) {
+	indexes := make([]int, 0, len(items))
+	quoteItems := make([]*interfaces.PriceQuoteItem, 0, len(items))
+	for i, item := range items {
+		if item == nil || item.Quantity <= 0 {
+			continue
+		}
+		indexes = append(indexes, i)
+		quoteItems = append(quoteItems, &interfaces.PriceQuoteItem{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+	if len(quoteItems) == 0 {
+		return nil, nil
+	}
+	quotes, err := s.productClient.QuotePrices(ctx, userID, quoteItems)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int]*interfaces.PriceQuote, len(quotes))
+	for i, quote := range quotes {
+		result[indexes[i]] = quote
+	}
+	return result, nil
+}
+
+// applyPriceQuote 按成交价设置订单项单价，并快照命中的阶梯价
+func applyPriceQuote(orderItem *model.OrderItem, quote *interfaces.PriceQuote) {
+	if quote == nil || quote.ProductID != orderItem.ProductID {
+		return
+	}
+	orderItem.Price = quote.UnitPrice
+	if quote.TierMinQuantity > 0 {
+		orderItem.BasePrice = quote.BasePrice
+		orderItem.TierMinQuantity = quote.TierMinQuantity
+		orderItem.PriceGroup = quote.PriceGroup
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/model"
+	"ecommerce/order-service/kitex_gen/api"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CodePurchaseLimitExceeded 下单数量超过商品每人限购
+const CodePurchaseLimitExceeded int32 = 429
+
+// 单次查询限购额度的商品数上限
+const maxPurchaseAllowanceProducts = 100
+
+// purchaseLimitItem 订单中限购商品的购买数量
+type purchaseLimitItem struct {
+	product  *interfaces.ProductInfo
+	quantity int32
+}
+
+// limitedPurchases 汇总订单中限购商品的购买数量，按商品ID排序以固定加锁顺序
+func limitedPurchases(items []*model.OrderItem, productInfos map[int64]*interfaces.ProductInfo) []*purchaseLimitItem {
+	byProduct := make(map[int64]*purchaseLimitItem)
+	for _, item := range items {
+		product := productInfos[item.ProductID]
+		if product == nil || product.PurchaseLimit <= 0 {
+			continue
+		}
+		if limited, ok := byProduct[item.ProductID]; ok {
+			limited.quantity += item.Quantity
+			continue
+		}
+		byProduct[item.ProductID] = &purchaseLimitItem{product: product, quantity: item.Quantity}
+	}
+	limited := make([]*purchaseLimitItem, 0, len(byProduct))
+	for _, item := range byProduct {
+		limited = append(limited, item)
+	}
+	sort.Slice(limited, func(i, j int) bool {
+		return limited[i].product.ID < limited[j].product.ID
+	})
+	return limited
+}
+
+// checkPurchaseLimits 在下单事务内锁定用户的限购商品后统计已购数量，超限时返回提示
+func (s *OrderService) checkPurchaseLimits(tx *gorm.DB, userID int64, limited []*purchaseLimitItem, now time.Time) (string, error) {
+	if len(limited) == 0 {
+		return "", nil
+	}
+
+	// 写入同一行获取行锁，同一用户对同一商品的并发下单在此等待前一个事务提交
+	locks := make([]*model.PurchaseLimitLock, 0, len(limited))
+	for _, item := range limited {
+		locks = append(locks, &model.PurchaseLimitLock{UserID: userID, ProductID: item.product.ID, LockedAt: now})
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"locked_at"}),
+	}).Create(&locks).Error
+	if err != nil {
+		return "", err
+	}
+
+	for _, item := range limited {
+		purchased, err := sumPurchasedQuantity(tx, userID, item.product, now)
+		if err != nil {
+			return "", err
+		}
+		if purchased+item.quantity > item.product.PurchaseLimit {
+			return purchaseLimitMessage(item.product, purchased), nil
+		}
+	}
+	return "", nil
+}
+
+// precheckPurchaseLimit 不加锁预检用户本次购买是否超过商品限购，最终以下单事务内的校验为准
+func (s *OrderService) precheckPurchaseLimit(ctx context.Context, userID int64, product *interfaces.ProductInfo, quantity int32) (string, error) {
+	if product.PurchaseLimit <= 0 {
+		return "", nil
+	}
+	purchased, err := sumPurchasedQuantity(s.db.WithContext(ctx), userID, product, time.Now())
+	if err != nil {
+		return "", err
+	}
+	if purchased+quantity > product.PurchaseLimit {
+		return purchaseLimitMessage(product, purchased), nil
+	}
+	return "", nil
+}
+
+// sumPurchasedQuantity 统计用户在限购周期内未取消订单中该商品的数量
+func sumPurchasedQuantity(db *gorm.DB, userID int64, product *interfaces.ProductInfo, now time.Time) (int32, error) {
+	query := db.Model(&model.OrderItem{}).
+		Joins("JOIN orders AS o ON o.id = order_items.order_id AND o.deleted_at IS NULL").
+		Where("o.user_id = ? AND o.status <> ? AND order_items.product_id = ?",
+			userID, model.OrderStatusCancelled, product.ID)
+	if product.PurchaseLimitDays > 0 {
+		query = query.Where("o.created_at >= ?", now.AddDate(0, 0, -int(product.PurchaseLimitDays)))
+	}
+	var total int64
+	if err := query.Select("COALESCE(SUM(order_items.quantity), 0)").Scan(&total).Error; err != nil {
+		return 0, err
+	}
+	return int32(total), nil
+}
+
+// purchaseLimitMessage 超过限购时的提示，附带还可购买的数量
+func purchaseLimitMessage(product *interfaces.ProductInfo, purchased int32) string {
+	remaining := max(product.PurchaseLimit-purchased, 0)
+	return fmt.Sprintf("%s%s，还可购买%d件", product.Name, purchaseLimitText(product), remaining)
+}
+
+// purchaseLimitText 限购说明，如“每人限购2件”或“30天内每人限购2件”
+func purchaseLimitText(product *interfaces.ProductInfo) string {
+	if product.PurchaseLimitDays > 0 {
+		return fmt.Sprintf("%d天内每人限购%d件", product.PurchaseLimitDays, product.PurchaseLimit)
+	}
+	return fmt.Sprintf("每人限购%d件", product.PurchaseLimit)
+}
+
+// GetPurchaseAllowances 查询用户对限购商品的剩余可购数量，不限购的商品不返回
+func (s *OrderService) GetPurchaseAllowances(ctx context.Context, req *api.GetPurchaseAllowancesReq) (*api.GetPurchaseAllowancesResp, error) {
+	if req.UserId <= 0 || len(req.ProductIds) == 0 || len(req.ProductIds) > maxPurchaseAllowanceProducts {
+		return &api.GetPurchaseAllowancesResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("用户ID不能为空，商品数量为1-%d个", maxPurchaseAllowanceProducts),
+		}, nil
+	}
+	products, err := s.productClient.BatchGetProducts(ctx, req.ProductIds)
+	if err != nil {
+		return &api.GetPurchaseAllowancesResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询商品失败: %v", err),
+		}, nil
+	}
+
+	now := time.Now()
+	db := s.db.WithContext(ctx)
+	allowances := make([]*api.PurchaseAllowance, 0)
+	for _, productID := range req.ProductIds {
+		product := products[productID]
+		if product == nil || product.PurchaseLimit <= 0 {
+			continue
+		}
+		purchased, err := sumPurchasedQuantity(db, req.UserId, product, now)
+		if err != nil {
+			return &api.GetPurchaseAllowancesResp{
+				Success: false,
+				Code:    500,
+				Message: fmt.Sprintf("统计已购数量失败: %v", err),
+			}, nil
+		}
+		allowances = append(allowances, &api.PurchaseAllowance{
+			ProductId:  productID,
+			Limit:      product.PurchaseLimit,
+			WindowDays: product.PurchaseLimitDays,
+			Purchased:  purchased,
+			Remaining:  max(product.PurchaseLimit-purchased, 0),
+		})
+	}
+	return &api.GetPurchaseAllowancesResp{
+		Success:    true,
+		Code:       0,
+		Message:    "查询成功",
+		Allowances: allowances,
+	}, nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"ecommerce/order-service/internal/dao/dao"
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/flashsale"
+	"ecommerce/order-service/internal/model"
+	"ecommerce/order-service/kitex_gen/api"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestLimitedPurchases(t *testing.T) {
+	products := map[int64]*interfaces.ProductInfo{
+		1: {ID: 1, Name: "限购商品", PurchaseLimit: 2},
+		2: {ID: 2, Name: "不限购商品"},
+		3: {ID: 3, Name: "周期限购商品", PurchaseLimit: 5, PurchaseLimitDays: 30},
+	}
+	tests := []struct {
+		name  string
+		items []*model.OrderItem
+		want  map[int64]int32
+		order []int64
+	}{
+		{
+			name:  "不限购的商品不参与校验",
+			items: []*model.OrderItem{{ProductID: 2, Quantity: 10}},
+			want:  map[int64]int32{},
+		},
+		{
+			name:  "同一商品多行合并数量",
+			items: []*model.OrderItem{{ProductID: 1, Quantity: 1}, {ProductID: 2, Quantity: 3}, {ProductID: 1, Quantity: 2}},
+			want:  map[int64]int32{1: 3},
+			order: []int64{1},
+		},
+		{
+			name:  "按商品ID排序固定加锁顺序",
+			items: []*model.OrderItem{{ProductID: 3, Quantity: 1}, {ProductID: 1, Quantity: 1}},
+			want:  map[int64]int32{1: 1, 3: 1},
+			order: []int64{1, 3},
+		},
+		{
+			name:  "查不到的商品跳过",
+			items: []*model.OrderItem{{ProductID: 4, Quantity: 1}},
+			want:  map[int64]int32{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := limitedPurchases(tt.items, products)
+			if len(got) != len(tt.want) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.want))
+			}
+			for i, item := range got {
+				if want, ok := tt.want[item.product.ID]; !ok || item.quantity != want {
+					t.Errorf("product %d quantity = %d, want %d", item.product.ID, item.quantity, want)
+				}
+				if tt.order != nil && item.product.ID != tt.order[i] {
+					t.Errorf("got[%d] = product %d, want %d", i, item.product.ID, tt.order[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPurchaseLimitText(t *testing.T) {
+	tests := []struct {
+		product *interfaces.ProductInfo
+		want    string
+	}{
+		{product: &interfaces.ProductInfo{PurchaseLimit: 2}, want: "每人限购2件"},
+		{product: &interfaces.ProductInfo{PurchaseLimit: 2, PurchaseLimitDays: 30}, want: "30天内每人限购2件"},
+	}
+	for _, tt := range tests {
+		if got := purchaseLimitText(tt.product); got != tt.want {
+			t.Errorf("purchaseLimitText = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+// stubProductClient 只实现查询单个商品，其余方法未用到
+type stubProductClient struct {
+	interfaces.IProductClient
+	products map[int64]*interfaces.ProductInfo
+}
+
+func (c *stubProductClient) GetProductInfo(ctx context.Context, productID int64) (*interfaces.ProductInfo, error) {
+	return c.products[productID], nil
+}
+
+func newPurchaseLimitTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_busy_timeout=5000", strings.ReplaceAll(t.Name(), "/", "_"))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("打开数据库失败: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("获取连接池失败: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(4)
+	t.Cleanup(func() { sqlDB.Close() })
+	err = db.AutoMigrate(&model.Order{}, &model.OrderItem{}, &model.PurchaseLimitLock{},
+		&model.FlashSale{}, &model.FlashSaleRequest{}, &model.TimeoutTask{})
+	if err != nil {
+		t.Fatalf("迁移表失败: %v", err)
+	}
+	return db
+}
+
+// createPurchasedOrder 写入一笔用户购买该商品的订单
+func createPurchasedOrder(t *testing.T, db *gorm.DB, orderNo string, userID, productID int64, quantity int32, status string, createdAt time.Time) {
+	t.Helper()
+	order := &model.Order{OrderNo: orderNo, UserID: userID, Status: status, CreatedAt: createdAt, UpdatedAt: createdAt}
+	if err := db.Create(order).Error; err != nil {
+		t.Fatalf("创建订单失败: %v", err)
+	}
+	item := &model.OrderItem{OrderID: order.ID, OrderNo: orderNo, ProductID: productID, ProductName: "商品", Quantity: quantity}
+	if err := db.Create(item).Error; err != nil {
+		t.Fatalf("创建订单项失败: %v", err)
+	}
+}
+
+func TestCheckPurchaseLimits(t *testing.T) {
+	db := newPurchaseLimitTestDB(t)
+	now := time.Now()
+	createPurchasedOrder(t, db, "O1", 1, 10, 1, model.OrderStatusPending, now.Add(-time.Hour))
+	createPurchasedOrder(t, db, "O2", 1, 10, 5, model.OrderStatusCancelled, now.Add(-time.Hour))
+	createPurchasedOrder(t, db, "O3", 1, 20, 2, model.OrderStatusPaid, now.AddDate(0, 0, -40))
+	createPurchasedOrder(t, db, "O4", 1, 20, 1, model.OrderStatusPaid, now.AddDate(0, 0, -10))
+	createPurchasedOrder(t, db, "O5", 2, 10, 2, model.OrderStatusPaid, now.Add(-time.Hour))
+
+	lifetime := &interfaces.ProductInfo{ID: 10, Name: "终身限购商品", PurchaseLimit: 2}
+	window := &interfaces.ProductInfo{ID: 20, Name: "周期限购商品", PurchaseLimit: 2, PurchaseLimitDays: 30}
+	tests := []struct {
+		name    string
+		product *interfaces.ProductInfo
+		userID  int64
+		qty     int32
+		wantMsg string
+	}{
+		{name: "已取消订单不计入", product: lifetime, userID: 1, qty: 1},
+		{name: "超过终身限购", product: lifetime, userID: 1, qty: 2, wantMsg: "终身限购商品每人限购2件，还可购买1件"},
+		{name: "周期外的订单不计入", product: window, userID: 1, qty: 1},
+		{name: "超过周期限购", product: window, userID: 1, qty: 2, wantMsg: "周期限购商品30天内每人限购2件，还可购买1件"},
+		{name: "其他用户已购不影响", product: window, userID: 2, qty: 2},
+		{name: "已达上限", product: lifetime, userID: 2, qty: 1, wantMsg: "终身限购商品每人限购2件，还可购买0件"},
+	}
+	s := &OrderService{db: db}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limited := []*purchaseLimitItem{{product: tt.product, quantity: tt.qty}}
+			var msg string
+			err := db.Transaction(func(tx *gorm.DB) error {
+				var err error
+				msg, err = s.checkPurchaseLimits(tx, tt.userID, limited, now)
+				return err
+			})
+			if err != nil {
+				t.Fatalf("checkPurchaseLimits: %v", err)
+			}
+			if msg != tt.wantMsg {
+				t.Errorf("msg = %q, want %q", msg, tt.wantMsg)
+			}
+		})
+	}
+
+	// 同一用户同一商品只有一行锁记录，重复校验时更新加锁时间
+	var locks []model.PurchaseLimitLock
+	if err := db.Where("user_id = ? AND product_id = ?", 1, 10).Find(&locks).Error; err != nil {
+		t.Fatalf("查询锁记录失败: %v", err)
+	}
+	if len(locks) != 1 {
+		t.Fatalf("lock rows = %d, want 1", len(locks))
+	}
+	later := now.Add(time.Minute)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		_, err := s.checkPurchaseLimits(tx, 1, []*purchaseLimitItem{{product: lifetime, quantity: 1}}, later)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("checkPurchaseLimits: %v", err)
+	}
+	var lock model.PurchaseLimitLock
+	db.Where("user_id = ? AND product_id = ?", 1, 10).First(&lock)
+	if !lock.LockedAt.Equal(later) {
+		t.Errorf("locked_at = %v, want %v", lock.LockedAt, later)
+	}
+	var count int64
+	db.Model(&model.PurchaseLimitLock{}).Count(&count)
+	if count != 4 {
+		t.Errorf("lock rows = %d, want 4", count)
+	}
+}
+
+func TestFlashSaleRespectsPurchaseLimit(t *testing.T) {
+	db := newPurchaseLimitTestDB(t)
+	ctx := context.Background()
+	now := time.Now()
+	product := &interfaces.ProductInfo{ID: 10, Name: "限购商品", PurchaseLimit: 2}
+	createPurchasedOrder(t, db, "O1", 1, product.ID, 1, model.OrderStatusPaid, now.Add(-time.Hour))
+	sale := &model.FlashSale{ProductID: product.ID, ProductName: product.Name, FlashPrice: 1, OriginalPrice: 2,
+		AllocatedQuantity: 10, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour), Status: model.FlashSaleStatusActive}
+	if err := db.Create(sale).Error; err != nil {
+		t.Fatalf("创建秒杀活动失败: %v", err)
+	}
+
+	s := NewOrderService(db, dao.NewDaoFactory(db), nil, &stubProductClient{products: map[int64]*interfaces.ProductInfo{product.ID: product}})
+	counter := flashsale.NewMemoryCounter()
+	s.SetFlashSaleCounter(counter)
+	stop := s.StartFlashSale(1, 10, time.Hour)
+	defer stop()
+
+	// 入队前预检超限，返回限购错误码并归还计数器
+	resp, err := s.SubmitFlashSaleOrder(ctx, &api.SubmitFlashSaleOrderReq{FlashSaleId: sale.ID, UserId: 1, Quantity: 2, Address: "地址", Phone: "13800000000"})
+	if err != nil {
+		t.Fatalf("SubmitFlashSaleOrder: %v", err)
+	}
+	if resp.Code != CodePurchaseLimitExceeded {
+		t.Fatalf("code = %d, message = %s, want %d", resp.Code, resp.Message, CodePurchaseLimitExceeded)
+	}
+	if remaining, _, _ := counter.Remaining(ctx, sale.ID); remaining != 10 {
+		t.Errorf("remaining = %d, want 10", remaining)
+	}
+
+	// 预检之后用户又下了普通订单，下单事务内的校验拒绝秒杀请求并归还计数器
+	if result, _ := counter.Acquire(ctx, sale.ID, 1, 1, 0); result != flashsale.AcquireOK {
+		t.Fatalf("Acquire = %v", result)
+	}
+	request := &model.FlashSaleRequest{RequestID: "FS1", FlashSaleID: sale.ID, UserID: 1, Quantity: 1, Status: model.FlashSaleRequestQueued}
+	if err := db.Create(request).Error; err != nil {
+		t.Fatalf("创建秒杀请求失败: %v", err)
+	}
+	createPurchasedOrder(t, db, "O2", 1, product.ID, 1, model.OrderStatusPending, now)
+	s.processFlashSaleRequest(ctx, request)
+
+	var processed model.FlashSaleRequest
+	db.Where("request_id = ?", "FS1").First(&processed)
+	if processed.Status != model.FlashSaleRequestFailed || processed.FailReason != "限购商品每人限购2件，还可购买0件" {
+		t.Errorf("status = %s, reason = %s", processed.Status, processed.FailReason)
+	}
+	var reloaded model.FlashSale
+	db.First(&reloaded, sale.ID)
+	if reloaded.SoldQuantity != 0 {
+		t.Errorf("sold = %d, want 0", reloaded.SoldQuantity)
+	}
+	if remaining, _, _ := counter.Remaining(ctx, sale.ID); remaining != 10 {
+		t.Errorf("remaining = %d, want 10", remaining)
+	}
+}
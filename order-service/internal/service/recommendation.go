@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce/order-service/internal/model"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 单次同步到商品服务的统计条数上限
+const purchaseStatsBatchSize = 1000
+
+// SyncPurchaseStats 汇总近期已完成订单的共同购买和销量，分批同步到商品服务
+func (s *OrderService) SyncPurchaseStats(ctx context.Context, lookbackDays int) error {
+	if s.productClient == nil {
+		return nil
+	}
+	since := time.Now().AddDate(0, 0, -lookbackDays)
+	pairs, err := s.daoFactory.OrderItemRepo.CountCoPurchases(ctx, since)
+	if err != nil {
+		return fmt.Errorf("统计共同购买失败: %v", err)
+	}
+	sales, err := s.daoFactory.OrderItemRepo.CountSales(ctx, since)
+	if err != nil {
+		return fmt.Errorf("统计商品销量失败: %v", err)
+	}
+
+	// 同一次同步使用相同批次号，商品服务在最后一批写入后清理旧数据
+	generation := time.Now().UnixNano()
+	batches := (len(pairs) + purchaseStatsBatchSize - 1) / purchaseStatsBatchSize
+	if salesBatches := (len(sales) + purchaseStatsBatchSize - 1) / purchaseStatsBatchSize; salesBatches > batches {
+		batches = salesBatches
+	}
+	if batches == 0 {
+		batches = 1
+	}
+	for i := 0; i < batches; i++ {
+		start, end := i*purchaseStatsBatchSize, (i+1)*purchaseStatsBatchSize
+		pairBatch := pairs[min(start, len(pairs)):min(end, len(pairs))]
+		salesBatch := sales[min(start, len(sales)):min(end, len(sales))]
+		if err := s.productClient.SyncPurchaseStats(ctx, generation, pairBatch, salesBatch, i == batches-1); err != nil {
+			return fmt.Errorf("同步购买统计失败: batch=%d, err=%v", i+1, err)
+		}
+	}
+	klog.Infof("购买统计同步完成: 商品对=%d, 商品=%d", len(pairs), len(sales))
+	return nil
+}
+
+// StartPurchaseStatsSync 启动购买统计定时同步，返回停止函数
+func (s *OrderService) StartPurchaseStatsSync(interval time.Duration, lookbackDays int) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			s.runPurchaseStatsSync(ctx, lookbackDays)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// runPurchaseStatsSync 执行一次同步，panic不影响后续同步
+func (s *OrderService) runPurchaseStatsSync(ctx context.Context, lookbackDays int) {
+	defer func() {
+		if r := recover(); r != nil {
+			klog.Errorf("购买统计同步panic: %v", r)
+		}
+	}()
+	if err := s.SyncPurchaseStats(ctx, lookbackDays); err != nil {
+		klog.Errorf("购买统计同步失败: %v", err)
+	}
+}
+
+// reportPaidOrderSales 上报已支付订单的商品件数，父订单的商品挂在子订单下
+func (s *OrderService) reportPaidOrderSales(ctx context.Context, order *model.Order) {
+	if s.productClient == nil {
+		return
+	}
+	orderNos := []string{order.OrderNo}
+	if order.IsParent {
+		subOrders, err := s.loadSubOrders(ctx, order)
+		if err != nil {
+			klog.Warnf("查询子订单失败，未上报销量: order=%s, err=%v", order.OrderNo, err)
+			return
+		}
+		orderNos = orderNos[:0]
+		for _, subOrder := range subOrders {
+			orderNos = append(orderNos, subOrder.OrderNo)
+		}
+	}
+	quantities := make(map[int64]int32)
+	for _, orderNo := range orderNos {
+		items, err := s.daoFactory.OrderItemRepo.FindByOrderNo(ctx, orderNo)
+		if err != nil {
+			klog.Warnf("查询订单项失败，未上报销量: order=%s, err=%v", orderNo, err)
+			return
+		}
+		for _, item := range items {
+			quantities[item.ProductID] += item.Quantity
+		}
+	}
+	if len(quantities) == 0 {
+		return
+	}
+	if err := s.productClient.RecordProductSales(ctx, quantities); err != nil {
+		klog.Warnf("上报商品销量失败: order=%s, err=%v", order.OrderNo, err)
+	}
+}
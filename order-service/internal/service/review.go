@@ -0,0 +1,407 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"ecommerce/order-service/internal/model"
+	"ecommerce/order-service/kitex_gen/api"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const (
+	maxReviewContentLength = 1000 // 评价内容最大字符数
+	maxReviewImages        = 9    // 评价图片最大数量
+	maxReviewImageLength   = 500  // 单个图片地址最大长度
+	maxReviewReplyLength   = 500  // 商家回复最大字符数
+)
+
+// CreateReview 对已完成订单中的商品进行评价
+func (s *OrderService) CreateReview(ctx context.Context, req *api.CreateReviewReq) (*api.CreateReviewResp, error) {
+	//参数验证
+	if req.Rating < 1 || req.Rating > 5 {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    400,
+			Message: "评分必须在1-5之间",
+		}, nil
+	}
+	content := strings.TrimSpace(req.GetContent())
+	if utf8.RuneCountInString(content) > maxReviewContentLength {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("评价内容不能超过%d个字符", maxReviewContentLength),
+		}, nil
+	}
+	if len(req.Images) > maxReviewImages {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("评价图片不能超过%d张", maxReviewImages),
+		}, nil
+	}
+	for _, image := range req.Images {
+		if strings.TrimSpace(image) == "" || len(image) > maxReviewImageLength {
+			return &api.CreateReviewResp{
+				Success: false,
+				Code:    400,
+				Message: "评价图片地址无效",
+			}, nil
+		}
+	}
+
+	//查询订单
+	order, err := s.daoFactory.OrderRepo.FindByOrderNo(ctx, req.OrderNo)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.CreateReviewResp{
+				Success: false,
+				Code:    404,
+				Message: "订单不存在",
+			}, nil
+		}
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询订单失败: %v", err),
+		}, nil
+	}
+
+	//权限检查
+	if order.UserID != req.UserId {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    403,
+			Message: "无权操作此订单",
+		}, nil
+	}
+
+	//只有已完成的订单才能评价
+	if order.Status != model.OrderStatusCompleted {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    400,
+			Message: "订单完成后才能评价",
+		}, nil
+	}
+
+	//确认订单项属于该订单
+	items, err := s.daoFactory.OrderItemRepo.FindByOrderID(ctx, order.ID)
+	if err != nil {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询订单商品失败: %v", err),
+		}, nil
+	}
+	var orderItem *model.OrderItem
+	for _, item := range items {
+		if item.ID == req.OrderItemId {
+			orderItem = item
+			break
+		}
+	}
+	if orderItem == nil {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    404,
+			Message: "订单商品不存在",
+		}, nil
+	}
+
+	//每个订单项只能评价一次
+	reviewRepo := s.daoFactory.ReviewRepo
+	if _, err := reviewRepo.FindByOrderItemID(ctx, orderItem.ID); err == nil {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    409,
+			Message: "该商品已评价",
+		}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询评价失败: %v", err),
+		}, nil
+	}
+
+	images, err := json.Marshal(req.Images)
+	if err != nil {
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("保存评价图片失败: %v", err),
+		}, nil
+	}
+	review := &model.Review{
+		OrderNo:     order.OrderNo,
+		OrderItemID: orderItem.ID,
+		ProductID:   orderItem.ProductID,
+		UserID:      order.UserID,
+		Rating:      req.Rating,
+		Content:     content,
+		Images:      string(images),
+		Status:      model.ReviewStatusPending,
+	}
+	if err := reviewRepo.Create(ctx, review); err != nil {
+		// 并发提交时由唯一索引兜底
+		if _, findErr := reviewRepo.FindByOrderItemID(ctx, orderItem.ID); findErr == nil {
+			return &api.CreateReviewResp{
+				Success: false,
+				Code:    409,
+				Message: "该商品已评价",
+			}, nil
+		}
+		return &api.CreateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("创建评价失败: %v", err),
+		}, nil
+	}
+
+	return &api.CreateReviewResp{
+		Success: true,
+		Code:    0,
+		Message: "评价成功，等待审核",
+		Review:  s.convertToAPIReview(review),
+	}, nil
+}
+
+// ListReviews 查询评价列表
+func (s *OrderService) ListReviews(ctx context.Context, req *api.ListReviewsReq) (*api.ListReviewsResp, error) {
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.ProductId != nil {
+		condition["product_id"] = *req.ProductId
+	}
+	if req.UserId != nil {
+		condition["user_id"] = *req.UserId
+	}
+	if req.Status != nil {
+		condition["status"] = s.convertFromAPIReviewStatus(*req.Status)
+	}
+
+	reviews, total, err := s.daoFactory.ReviewRepo.ListByCondition(ctx, condition, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return &api.ListReviewsResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询评价列表失败: %v", err),
+		}, nil
+	}
+
+	apiReviews := make([]*api.Review, 0, len(reviews))
+	for _, review := range reviews {
+		apiReviews = append(apiReviews, s.convertToAPIReview(review))
+	}
+
+	return &api.ListReviewsResp{
+		Success:  true,
+		Code:     0,
+		Message:  "查询成功",
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Reviews:  apiReviews,
+	}, nil
+}
+
+// ModerateReview 审核评价，审核状态变化后重新汇总商品评分
+func (s *OrderService) ModerateReview(ctx context.Context, req *api.ModerateReviewReq) (*api.ModerateReviewResp, error) {
+	if req.Status != api.ReviewStatus_APPROVED && req.Status != api.ReviewStatus_HIDDEN {
+		return &api.ModerateReviewResp{
+			Success: false,
+			Code:    400,
+			Message: "审核状态只能为通过或隐藏",
+		}, nil
+	}
+
+	reviewRepo := s.daoFactory.ReviewRepo
+	review, err := reviewRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ModerateReviewResp{
+				Success: false,
+				Code:    404,
+				Message: "评价不存在",
+			}, nil
+		}
+		return &api.ModerateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询评价失败: %v", err),
+		}, nil
+	}
+
+	status := s.convertFromAPIReviewStatus(req.Status)
+	if review.Status != status {
+		review.Status = status
+		if err := reviewRepo.Update(ctx, review); err != nil {
+			return &api.ModerateReviewResp{
+				Success: false,
+				Code:    500,
+				Message: fmt.Sprintf("更新评价失败: %v", err),
+			}, nil
+		}
+	}
+
+	// 无论状态是否变化都重新汇总一次，便于修复之前同步失败的评分
+	if err := s.refreshProductRating(ctx, review.ProductID); err != nil {
+		klog.Errorf("同步商品评分失败, productID=%d: %v", review.ProductID, err)
+		return &api.ModerateReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("评价已审核，但同步商品评分失败: %v", err),
+			Review:  s.convertToAPIReview(review),
+		}, nil
+	}
+
+	klog.Infof("评价审核完成, reviewID=%d, status=%s, operator=%d", review.ID, status, req.OperatorId)
+
+	return &api.ModerateReviewResp{
+		Success: true,
+		Code:    0,
+		Message: "审核成功",
+		Review:  s.convertToAPIReview(review),
+	}, nil
+}
+
+// ReplyReview 商家回复评价
+func (s *OrderService) ReplyReview(ctx context.Context, req *api.ReplyReviewReq) (*api.ReplyReviewResp, error) {
+	reply := strings.TrimSpace(req.Reply)
+	if reply == "" {
+		return &api.ReplyReviewResp{
+			Success: false,
+			Code:    400,
+			Message: "回复内容不能为空",
+		}, nil
+	}
+	if utf8.RuneCountInString(reply) > maxReviewReplyLength {
+		return &api.ReplyReviewResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("回复内容不能超过%d个字符", maxReviewReplyLength),
+		}, nil
+	}
+
+	reviewRepo := s.daoFactory.ReviewRepo
+	review, err := reviewRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &api.ReplyReviewResp{
+				Success: false,
+				Code:    404,
+				Message: "评价不存在",
+			}, nil
+		}
+		return &api.ReplyReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询评价失败: %v", err),
+		}, nil
+	}
+
+	now := time.Now()
+	review.Reply = reply
+	review.Replier = fmt.Sprintf("管理员-%d", req.OperatorId)
+	review.RepliedAt = &now
+	if err := reviewRepo.Update(ctx, review); err != nil {
+		return &api.ReplyReviewResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("回复评价失败: %v", err),
+		}, nil
+	}
+
+	return &api.ReplyReviewResp{
+		Success: true,
+		Code:    0,
+		Message: "回复成功",
+		Review:  s.convertToAPIReview(review),
+	}, nil
+}
+
+// refreshProductRating 汇总已通过审核的评价并写回商品服务
+func (s *OrderService) refreshProductRating(ctx context.Context, productID int64) error {
+	avg, count, err := s.daoFactory.ReviewRepo.AggregateApproved(ctx, productID)
+	if err != nil {
+		return err
+	}
+	// 保留两位小数，与商品表字段精度一致
+	avg = math.Round(avg*100) / 100
+	return s.productClient.UpdateProductRating(ctx, productID, avg, int32(count))
+}
+
+// convertToAPIReview 转换评价为API格式
+func (s *OrderService) convertToAPIReview(review *model.Review) *api.Review {
+	images := make([]string, 0)
+	if review.Images != "" {
+		if err := json.Unmarshal([]byte(review.Images), &images); err != nil {
+			klog.Warnf("解析评价图片失败, reviewID=%d: %v", review.ID, err)
+		}
+	}
+
+	apiReview := &api.Review{
+		Id:          review.ID,
+		OrderNo:     review.OrderNo,
+		OrderItemId: review.OrderItemID,
+		ProductId:   review.ProductID,
+		UserId:      review.UserID,
+		Rating:      review.Rating,
+		Content:     review.Content,
+		Images:      images,
+		Status:      s.convertToAPIReviewStatus(review.Status),
+		CreatedAt:   review.CreatedAt.Unix(),
+		UpdatedAt:   review.UpdatedAt.Unix(),
+	}
+	if review.Reply != "" {
+		reply := review.Reply
+		apiReview.Reply = &reply
+	}
+	if review.RepliedAt != nil {
+		repliedAt := review.RepliedAt.Unix()
+		apiReview.RepliedAt = &repliedAt
+	}
+	return apiReview
+}
+
+// convertToAPIReviewStatus 转换评价状态为API格式
+func (s *OrderService) convertToAPIReviewStatus(status string) api.ReviewStatus {
+	switch status {
+	case model.ReviewStatusApproved:
+		return api.ReviewStatus_APPROVED
+	case model.ReviewStatusHidden:
+		return api.ReviewStatus_HIDDEN
+	default:
+		return api.ReviewStatus_PENDING
+	}
+}
+
+// convertFromAPIReviewStatus 转换API评价状态为内部格式
+func (s *OrderService) convertFromAPIReviewStatus(status api.ReviewStatus) string {
+	switch status {
+	case api.ReviewStatus_APPROVED:
+		return model.ReviewStatusApproved
+	case api.ReviewStatus_HIDDEN:
+		return model.ReviewStatusHidden
+	default:
+		return model.ReviewStatusPending
+	}
+}
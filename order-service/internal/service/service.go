@@ -10,6 +10,7 @@ import (
 
 	"ecommerce/order-service/internal/dao/dao"
 	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/dao/orderDao"
 	"ecommerce/order-service/internal/model"
 	"ecommerce/order-service/kitex_gen/api"
 
@@ -53,6 +54,12 @@ type OrderService struct {
 	daoFactory    *dao.DaoFactory
 	userClient    interfaces.IUserClient
 	productClient interfaces.IProductClient
+
+	// 发货仓库路由策略
+	routingStrategy RoutingStrategy
+
+	// 秒杀运行时状态
+	flashSale flashSaleState
 }
 
 // NewOrderService 创建订单服务实例
@@ -63,10 +70,11 @@ func NewOrderService(
 	productClient interfaces.IProductClient,
 ) *OrderService {
 	return &OrderService{
-		db:            db,
-		daoFactory:    daoFactory,
-		userClient:    userClient,
-		productClient: productClient,
+		db:              db,
+		daoFactory:      daoFactory,
+		userClient:      userClient,
+		productClient:   productClient,
+		routingStrategy: RoutingWholeOrder,
 	}
 }
 
@@ -134,38 +142,75 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 	}
 	var totalAmount float64
 	var orderItems []*model.OrderItem
+	// 订单项所属商家，用于拆单
+	var itemMerchants []int64
 
 	klog.Infof("开始处理 %d 个商品", len(req.Items))
 
+	// 批量获取商品信息，查询失败时拒绝下单，不能用请求中的商品数据绕过价格和限购校验
+	if s.productClient == nil {
+		return &api.CreateOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "商品服务不可用，请稍后重试",
+		}, nil
+	}
+	productIDs := make([]int64, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item != nil {
+			productIDs = append(productIDs, item.ProductId)
+		}
+	}
+	productInfos, err := s.productClient.BatchGetProducts(ctx, productIDs)
+	if err != nil {
+		klog.Errorf("批量查询商品失败: %v", err)
+		return &api.CreateOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "商品服务不可用，请稍后重试",
+		}, nil
+	}
+	// 按购买数量和用户价格组计算成交价，与商品搜索展示的阶梯价一致
+	priceQuotes, err := s.quoteItemPrices(ctx, req.UserId, req.Items)
+	if err != nil {
+		klog.Errorf("计算成交价失败: %v", err)
+		return &api.CreateOrderResp{
+			Success: false,
+			Code:    503,
+			Message: "商品服务不可用，请稍后重试",
+		}, nil
+	}
+
 	for i, item := range req.Items {
 		if item == nil {
 			klog.Errorf(" 商品项 %d 为nil", i)
 			continue
 		}
 
-		var productInfo *interfaces.ProductInfo
-
-		// 获取商品信息
-		if s.productClient != nil {
-			productInfo, _ = s.productClient.GetProductInfo(ctx, item.ProductId)
-		}
-
-		//如果商品信息为空，使用请求数据
+		productInfo := productInfos[item.ProductId]
 		if productInfo == nil {
-			productInfo = &interfaces.ProductInfo{
-				ID:       item.ProductId,
-				Name:     item.ProductName,
-				Price:    item.Price,
-				Stock:    100,
-				Status:   1,
-				Category: "默认分类",
-				Avatar:   "https://example.com/product.jpg",
-			}
+			return &api.CreateOrderResp{
+				Success: false,
+				Code:    404,
+				Message: fmt.Sprintf("商品不存在: %d", item.ProductId),
+			}, nil
 		}
 
-		//计算商品总价
-		itemTotal := productInfo.Price * float64(item.Quantity)
-		totalAmount += itemTotal
+		//售罄商品可以浏览但不能下单，未上架（草稿、已下架、已删除、未审核）的商品同样不能下单
+		if productInfo.Status == interfaces.ProductStatusSoldOut {
+			return &api.CreateOrderResp{
+				Success: false,
+				Code:    400,
+				Message: fmt.Sprintf("商品已售罄: %s", productInfo.Name),
+			}, nil
+		}
+		if productInfo.Status != interfaces.ProductStatusOnline {
+			return &api.CreateOrderResp{
+				Success: false,
+				Code:    400,
+				Message: fmt.Sprintf("商品未上架: %s", productInfo.Name),
+			}, nil
+		}
 
 		//创建订单项模型
 		orderItem := &model.OrderItem{
@@ -174,13 +219,22 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 			Quantity:     item.Quantity,
 			Price:        productInfo.Price,
 			ProductImage: productInfo.Avatar,
+			// 快照下单时的原价，便于展示优惠及退款核对
+			OriginalPrice: productInfo.OriginalPrice,
+			Components:    bundleComponents(productInfo),
 		}
+		applyPriceQuote(orderItem, priceQuotes[i])
+
+		//计算商品总价
+		itemTotal := orderItem.Price * float64(item.Quantity)
+		totalAmount += itemTotal
 
 		if orderItem.ProductImage == "" {
 			orderItem.ProductImage = "https://example.com/default.jpg"
 		}
 
 		orderItems = append(orderItems, orderItem)
+		itemMerchants = append(itemMerchants, productInfo.MerchantID)
 	}
 
 	if len(orderItems) == 0 {
@@ -193,8 +247,13 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 	}
 
 	klog.Infof("所有商品处理完成，总金额: %.2f", totalAmount)
+
+	//选择发货仓库，套装按组件路由
+	warehouseAssignments := s.routeOrderItems(ctx, req.Address, stockItems(orderItems))
+	assignWarehouses(orderItems, warehouseAssignments)
 	orderNo := s.generateOrderNo()
 	klog.Infof("生成订单号: %s", orderNo)
+	groups := splitItemsByMerchant(orderItems, itemMerchants)
 	klog.Info("开始数据库事务")
 
 	//使用一个独立的数据库会话
@@ -219,6 +278,26 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 		}
 	}()
 	now := time.Now()
+
+	//校验每人限购，在事务内加锁后统计已购数量，并发下单不会超出限购
+	limitMessage, err := s.checkPurchaseLimits(tx, req.UserId, limitedPurchases(orderItems, productInfos), now)
+	if err != nil {
+		tx.Rollback()
+		klog.Errorf("校验每人限购失败: %v", err)
+		return &api.CreateOrderResp{
+			Success: false,
+			Code:    500,
+			Message: "校验限购失败",
+		}, nil
+	}
+	if limitMessage != "" {
+		tx.Rollback()
+		return &api.CreateOrderResp{
+			Success: false,
+			Code:    CodePurchaseLimitExceeded,
+			Message: limitMessage,
+		}, nil
+	}
 	receiver := getReceiver(req.Receiver)
 
 	order := &model.Order{
@@ -233,6 +312,31 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 		UpdatedAt:   now,
 	}
 
+	// 涉及多个商家时拆分为子订单，父订单只用于合并支付
+	var subOrders []*model.Order
+	if len(groups) == 1 {
+		order.MerchantID = groups[0].merchantID
+		groups[0].order = order
+	} else {
+		order.IsParent = true
+		for i, group := range groups {
+			group.order = &model.Order{
+				OrderNo:       fmt.Sprintf("%s-%d", orderNo, i+1),
+				UserID:        req.UserId,
+				TotalAmount:   group.totalAmount,
+				Status:        model.OrderStatusPending,
+				Address:       req.Address,
+				Phone:         req.Phone,
+				Receiver:      receiver,
+				MerchantID:    group.merchantID,
+				ParentOrderNo: orderNo,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			}
+			subOrders = append(subOrders, group.order)
+		}
+	}
+
 	klog.Infof("创建订单记录")
 
 	// 使用事务保存订单
@@ -245,16 +349,32 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 			Message: "创建订单失败",
 		}, nil
 	}
+	if len(subOrders) > 0 {
+		if err := tx.Create(subOrders).Error; err != nil {
+			tx.Rollback()
+			klog.Errorf("创建子订单失败: %v", err)
+			return &api.CreateOrderResp{
+				Success: false,
+				Code:    500,
+				Message: "创建订单失败",
+			}, nil
+		}
+	}
 
-	klog.Infof("订单创建成功，订单ID: %d", order.ID)
-	for _, orderItem := range orderItems {
-		orderItem.OrderID = order.ID
-		orderItem.OrderNo = orderNo
-		orderItem.CreatedAt = now
-		orderItem.UpdatedAt = now
+	klog.Infof("订单创建成功，订单ID: %d，子订单数: %d", order.ID, len(subOrders))
+	for _, group := range groups {
+		for _, orderItem := range group.items {
+			orderItem.OrderID = group.order.ID
+			orderItem.OrderNo = group.order.OrderNo
+			orderItem.CreatedAt = now
+			orderItem.UpdatedAt = now
+			for i := range orderItem.Components {
+				orderItem.Components[i].OrderNo = orderItem.OrderNo
+			}
+		}
 	}
 
-	//批量创建订单项
+	//批量创建订单项，套装的组件明细随订单项一起写入
 	if err := tx.CreateInBatches(orderItems, len(orderItems)).Error; err != nil {
 		tx.Rollback()
 		klog.Errorf("创建订单项失败: %v", err)
@@ -277,7 +397,7 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 
 	klog.Info("事务提交成功")
 
-	//预占库存（异步）
+	//预占库存（异步），套装预占各组件的库存
 	if s.productClient != nil {
 		go func() {
 			defer func() {
@@ -286,13 +406,17 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 				}
 			}()
 
-			for _, orderItem := range orderItems {
+			for _, orderItem := range stockItems(orderItems) {
 				reserveReq := &api.ReserveStockReq{
-					OrderNo:       orderNo,
+					OrderNo:       orderItem.OrderNo,
 					ProductId:     orderItem.ProductID,
 					Quantity:      orderItem.Quantity,
 					ExpireSeconds: 900,
 				}
+				if orderItem.WarehouseID > 0 {
+					warehouseID := orderItem.WarehouseID
+					reserveReq.WarehouseId = &warehouseID
+				}
 
 				_, err := s.ReserveStock(ctx, reserveReq)
 				if err != nil {
@@ -330,14 +454,18 @@ func (s *OrderService) CreateOrder(ctx context.Context, req *api.CreateOrderReq)
 	paymentUrl := s.generatePaymentUrl(orderNo)
 	klog.Infof("订单创建完成! 订单号: %s, 总金额: %.2f", orderNo, totalAmount)
 
-	return &api.CreateOrderResp{
+	resp := &api.CreateOrderResp{
 		Success:     true,
 		Code:        0,
 		Message:     "订单创建成功",
 		OrderNo:     orderNo,
 		TotalAmount: totalAmount,
 		PaymentUrl:  &paymentUrl,
-	}, nil
+	}
+	for _, subOrder := range subOrders {
+		resp.SubOrderNos = append(resp.SubOrderNos, subOrder.OrderNo)
+	}
+	return resp, nil
 }
 
 // GetOrder 获取订单详情
@@ -382,8 +510,26 @@ func (s *OrderService) GetOrder(ctx context.Context, req *api.GetOrderReq) (*api
 		}, nil
 	}
 
+	//父订单展示全部子订单的商品
+	subOrders, err := s.loadSubOrders(ctx, order)
+	if err != nil {
+		return &api.GetOrderResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询子订单失败: %v", err),
+		}, nil
+	}
+	var subOrderNos []string
+	for _, subOrder := range subOrders {
+		subOrderNos = append(subOrderNos, subOrder.OrderNo)
+		for i := range subOrder.Items {
+			items = append(items, &subOrder.Items[i])
+		}
+	}
+
 	//转换为API格式
 	apiOrder := s.convertToAPIOrder(order, items, nil)
+	apiOrder.SubOrderNos = subOrderNos
 
 	return &api.GetOrderResp{
 		Success: true,
@@ -440,6 +586,14 @@ func (s *OrderService) PayOrder(ctx context.Context, req *api.PayOrderReq) (*api
 		}, nil
 	}
 
+	if order.ParentOrderNo != "" {
+		return &api.PayOrderResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("该订单需通过父订单%s合并支付", order.ParentOrderNo),
+		}, nil
+	}
+
 	klog.Infof("订单状态检查通过")
 
 	//处理支付单号
@@ -456,7 +610,11 @@ func (s *OrderService) PayOrder(ctx context.Context, req *api.PayOrderReq) (*api
 	klog.Info("更新订单状态...")
 	now := time.Now()
 
-	err = orderRepo.UpdateStatusAndPayment(ctx, req.OrderNo, model.OrderStatusPaid, paymentNo)
+	if order.IsParent {
+		err = orderRepo.PayWithSubOrders(ctx, req.OrderNo, paymentNo)
+	} else {
+		err = orderRepo.UpdateStatusAndPayment(ctx, req.OrderNo, model.OrderStatusPaid, paymentNo)
+	}
 	if err != nil {
 		klog.Errorf("更新订单状态失败: %v", err)
 		return &api.PayOrderResp{
@@ -487,6 +645,17 @@ func (s *OrderService) PayOrder(ctx context.Context, req *api.PayOrderReq) (*api
 		}
 	}()
 
+	//上报商品销量（异步，不阻塞）
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				klog.Errorf("上报商品销量panic: %v", r)
+			}
+		}()
+
+		s.reportPaidOrderSales(context.Background(), order)
+	}()
+
 	//返回结果
 	paidAt := now.Unix()
 	klog.Infof("支付成功! 订单号: %s", req.OrderNo)
@@ -538,6 +707,30 @@ func (s *OrderService) CancelOrder(ctx context.Context, req *api.CancelOrderReq)
 		}, nil
 	}
 
+	//拆单后未支付时整单取消，支付后按商家子订单分别取消
+	if order.ParentOrderNo != "" && order.Status == model.OrderStatusPending {
+		return &api.CancelOrderResp{
+			Success: false,
+			Code:    400,
+			Message: fmt.Sprintf("未支付的子订单需取消父订单%s", order.ParentOrderNo),
+		}, nil
+	}
+	if order.IsParent && order.Status != model.OrderStatusPending {
+		return &api.CancelOrderResp{
+			Success: false,
+			Code:    400,
+			Message: "订单已支付，请分别取消各商家子订单",
+		}, nil
+	}
+	subOrders, err := s.loadSubOrders(ctx, order)
+	if err != nil {
+		return &api.CancelOrderResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("查询子订单失败: %v", err),
+		}, nil
+	}
+
 	//开启数据库事务
 	tx := s.db.WithContext(ctx).Begin()
 	if tx.Error != nil {
@@ -548,8 +741,9 @@ func (s *OrderService) CancelOrder(ctx context.Context, req *api.CancelOrderReq)
 		}, nil
 	}
 
-	//更新订单状态为已取消
-	err = orderRepo.CancelOrder(ctx, req.OrderNo, req.Reason)
+	//父订单和子订单在同一事务内取消，任一失败整体回滚
+	txOrderRepo := orderDao.NewOrderRepository(tx)
+	err = txOrderRepo.CancelOrder(ctx, req.OrderNo, req.Reason)
 	if err != nil {
 		tx.Rollback()
 		return &api.CancelOrderResp{
@@ -559,26 +753,31 @@ func (s *OrderService) CancelOrder(ctx context.Context, req *api.CancelOrderReq)
 		}, nil
 	}
 
-	//释放库存
-	stockReservationRepo := s.daoFactory.StockReservationRepo
-	reservations, err := stockReservationRepo.FindByOrderNo(ctx, req.OrderNo)
-	if err == nil && len(reservations) > 0 {
-		for _, reservation := range reservations {
-			if reservation.Status == model.StockStatusReserved {
-				releaseReq := &api.ReleaseStockReq{
-					ReserveId: reservation.ReserveID,
-					Reason:    "订单取消: " + req.Reason,
-				}
+	//父订单的子订单一并取消
+	for _, subOrder := range subOrders {
+		if err := txOrderRepo.CancelOrder(ctx, subOrder.OrderNo, req.Reason); err != nil {
+			tx.Rollback()
+			return &api.CancelOrderResp{
+				Success: false,
+				Code:    500,
+				Message: fmt.Sprintf("取消子订单失败: %v", err),
+			}, nil
+		}
+	}
 
-				_, err := s.ReleaseStock(ctx, releaseReq)
-				if err != nil {
-					klog.Errorf("释放库存失败: %v", err)
-				}
+	//提交事务
+	if err := tx.Commit().Error; err != nil {
+		return &api.CancelOrderResp{
+			Success: false,
+			Code:    500,
+			Message: fmt.Sprintf("提交事务失败: %v", err),
+		}, nil
+	}
 
-				// 更新预占状态为已释放
-				stockReservationRepo.UpdateStatus(ctx, reservation.ReserveID, model.StockStatusReleased)
-			}
-		}
+	//取消已提交后再释放库存，拆单时库存预占在子订单上
+	s.releaseOrderStock(ctx, req.OrderNo, req.Reason)
+	for _, subOrder := range subOrders {
+		s.releaseOrderStock(ctx, subOrder.OrderNo, req.Reason)
 	}
 
 	//删除超时任务
@@ -590,15 +789,6 @@ func (s *OrderService) CancelOrder(ctx context.Context, req *api.CancelOrderReq)
 		}
 	}
 
-	//提交事务
-	if err := tx.Commit().Error; err != nil {
-		return &api.CancelOrderResp{
-			Success: false,
-			Code:    500,
-			Message: fmt.Sprintf("提交事务失败: %v", err),
-		}, nil
-	}
-
 	//返回结果
 	now := time.Now().Unix()
 	return &api.CancelOrderResp{
@@ -610,6 +800,43 @@ func (s *OrderService) CancelOrder(ctx context.Context, req *api.CancelOrderReq)
 	}, nil
 }
 
+// releaseOrderStock 释放订单预占的库存，已扣减的库存归还
+func (s *OrderService) releaseOrderStock(ctx context.Context, orderNo, reason string) {
+	// 秒杀订单没有库存预占，库存回到活动或商品
+	s.releaseFlashSaleStock(ctx, orderNo)
+
+	stockReservationRepo := s.daoFactory.StockReservationRepo
+	reservations, err := stockReservationRepo.FindByOrderNo(ctx, orderNo)
+	if err != nil || len(reservations) == 0 {
+		return
+	}
+	for _, reservation := range reservations {
+		if reservation.Status == model.StockStatusReserved {
+			releaseReq := &api.ReleaseStockReq{
+				ReserveId: reservation.ReserveID,
+				Reason:    "订单取消: " + reason,
+			}
+
+			_, err := s.ReleaseStock(ctx, releaseReq)
+			if err != nil {
+				klog.Errorf("释放库存失败: %v", err)
+			}
+
+			// 更新预占状态为已释放
+			stockReservationRepo.UpdateStatus(ctx, reservation.ReserveID, model.StockStatusReleased)
+		} else if reservation.Status == model.StockStatusConfirmed {
+			// 已扣减的库存需要归还
+			err := s.productClient.AdjustStock(ctx, reservation.ProductID, reservation.WarehouseID, reservation.Quantity,
+				interfaces.StockChangeCancelRestore, reservation.ReserveID)
+			if err != nil {
+				klog.Errorf("恢复库存失败: reserveID=%s, err=%v", reservation.ReserveID, err)
+				continue
+			}
+			stockReservationRepo.UpdateStatus(ctx, reservation.ReserveID, model.StockStatusReleased)
+		}
+	}
+}
+
 // ListOrders 查询订单列表
 func (s *OrderService) ListOrders(ctx context.Context, req *api.ListOrdersReq) (*api.ListOrdersResp, error) {
 	// 设置默认值
@@ -626,9 +853,14 @@ func (s *OrderService) ListOrders(ctx context.Context, req *api.ListOrdersReq) (
 
 	orderRepo := s.daoFactory.OrderRepo
 
-	// 构建查询条件
+	// 构建查询条件，父订单只用于合并支付，列表展示商家子订单
 	condition := make(map[string]interface{})
-	condition["user_id"] = req.UserId
+	if req.MerchantId != nil {
+		condition["merchant_id"] = *req.MerchantId
+	} else {
+		condition["user_id"] = req.UserId
+	}
+	condition["is_parent"] = false
 
 	if req.Status != nil {
 		condition["status"] = s.convertFromAPIOrderStatus(*req.Status)
@@ -718,6 +950,15 @@ func (s *OrderService) ApplyRefund(ctx context.Context, req *api.ApplyRefundReq)
 		}, nil
 	}
 
+	//退款按商家子订单分别申请
+	if order.IsParent {
+		return &api.ApplyRefundResp{
+			Success: false,
+			Code:    400,
+			Message: "请对各商家子订单分别申请退款",
+		}, nil
+	}
+
 	//检查订单状态（只有已支付、已发货、已完成的订单可以退款）
 	if order.Status != model.OrderStatusPaid &&
 		order.Status != model.OrderStatusShipped &&
@@ -813,6 +1054,25 @@ func (s *OrderService) ProcessRefund(ctx context.Context, req *api.ProcessRefund
 		}, nil
 	}
 
+	//商家只能处理自己订单的退款
+	if req.MerchantId != nil {
+		order, err := s.daoFactory.OrderRepo.FindByOrderNo(ctx, refund.OrderNo)
+		if err != nil {
+			return &api.ProcessRefundResp{
+				Success: false,
+				Code:    500,
+				Message: fmt.Sprintf("查询订单失败: %v", err),
+			}, nil
+		}
+		if !merchantOwnsOrder(order, req.MerchantId) {
+			return &api.ProcessRefundResp{
+				Success: false,
+				Code:    403,
+				Message: "无权处理此退款",
+			}, nil
+		}
+	}
+
 	//验证处理动作
 	var newStatus string
 	var apiStatus api.RefundStatus
@@ -868,6 +1128,27 @@ func (s *OrderService) ProcessRefund(ctx context.Context, req *api.ProcessRefund
 		orderRepo.UpdateStatus(ctx, refund.OrderNo, model.OrderStatusCompleted)
 	}
 
+	//同意退款，归还已扣减的库存
+	if req.Action == api.RefundStatus_APPROVED {
+		s.returnFlashSaleRefund(ctx, refund.OrderNo)
+		stockReservationRepo := s.daoFactory.StockReservationRepo
+		reservations, err := stockReservationRepo.FindByOrderNo(ctx, refund.OrderNo)
+		if err == nil {
+			for _, reservation := range reservations {
+				if reservation.Status != model.StockStatusConfirmed {
+					continue
+				}
+				err := s.productClient.AdjustStock(ctx, reservation.ProductID, reservation.WarehouseID, reservation.Quantity,
+					interfaces.StockChangeRefundReturn, reservation.ReserveID)
+				if err != nil {
+					klog.Errorf("退款归还库存失败: reserveID=%s, err=%v", reservation.ReserveID, err)
+					continue
+				}
+				stockReservationRepo.UpdateStatus(ctx, reservation.ReserveID, model.StockStatusReturned)
+			}
+		}
+	}
+
 	//提交事务
 	if err := tx.Commit().Error; err != nil {
 		return &api.ProcessRefundResp{
@@ -914,8 +1195,15 @@ func (s *OrderService) ReserveStock(ctx context.Context, req *api.ReserveStockRe
 		req.ExpireSeconds = 900 // 默认15分钟
 	}
 
-	//检查商品库存
-	stockOk, err := s.productClient.CheckStock(ctx, req.ProductId, req.Quantity)
+	//检查商品库存，指定仓库时校验该仓库的可用库存
+	warehouseID := req.GetWarehouseId()
+	var stockOk bool
+	var err error
+	if warehouseID > 0 {
+		stockOk, err = s.checkWarehouseStock(ctx, req.ProductId, warehouseID, req.Quantity)
+	} else {
+		stockOk, err = s.productClient.CheckStock(ctx, req.ProductId, req.Quantity)
+	}
 	if err != nil || !stockOk {
 		return &api.ReserveStockResp{
 			Success: false,
@@ -930,14 +1218,15 @@ func (s *OrderService) ReserveStock(ctx context.Context, req *api.ReserveStockRe
 	reserveId := s.generateReserveID()
 
 	reservation := &model.StockReservation{
-		ReserveID:  reserveId,
-		OrderNo:    req.OrderNo,
-		ProductID:  req.ProductId,
-		Quantity:   req.Quantity,
-		Status:     model.StockStatusReserved,
-		ExpireTime: expireTime,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		ReserveID:   reserveId,
+		OrderNo:     req.OrderNo,
+		ProductID:   req.ProductId,
+		Quantity:    req.Quantity,
+		WarehouseID: warehouseID,
+		Status:      model.StockStatusReserved,
+		ExpireTime:  expireTime,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 
 	stockReservationRepo := s.daoFactory.StockReservationRepo
@@ -1080,6 +1369,17 @@ func (s *OrderService) ConfirmStock(ctx context.Context, req *api.ConfirmStockRe
 		}, nil
 	}
 
+	//扣减商品库存，以预占ID作为流水单号保证重试不会重复扣减
+	err = s.productClient.AdjustStock(ctx, reservation.ProductID, reservation.WarehouseID, -reservation.Quantity,
+		interfaces.StockChangeOrderDeduct, reservation.ReserveID)
+	if err != nil {
+		return &api.ConfirmStockResp{
+			Success: false,
+			Code:    409,
+			Message: fmt.Sprintf("扣减库存失败: %v", err),
+		}, nil
+	}
+
 	//更新预占记录状态为已确认
 	err = stockReservationRepo.UpdateStatus(ctx, req.ReserveId, model.StockStatusConfirmed)
 	if err != nil {
@@ -1185,9 +1485,10 @@ func (s *OrderService) ProcessTimeout(ctx context.Context, req *api.ProcessTimeo
 
 // GetOrderStats 获取订单统计
 func (s *OrderService) GetOrderStats(ctx context.Context, req *api.OrderStatsReq) (*api.OrderStatsResp, error) {
-	//构建查询条件
+	//构建查询条件，父订单与子订单金额重复，只统计子订单
 	condition := make(map[string]interface{})
 	condition["user_id"] = req.UserId
+	condition["is_parent"] = false
 
 	if req.StartTime != nil && *req.StartTime > 0 {
 		startTime := time.Unix(*req.StartTime, 0)
@@ -1271,6 +1572,22 @@ func (s *OrderService) ShipOrder(ctx context.Context, req *api.PayOrderReq) (*ap
 		}, nil
 	}
 
+	//父订单不发货，由各商家对子订单发货
+	if order.IsParent {
+		return &api.PayOrderResp{
+			Success: false,
+			Code:    400,
+			Message: "请对各商家子订单分别发货",
+		}, nil
+	}
+	if !merchantOwnsOrder(order, req.MerchantId) {
+		return &api.PayOrderResp{
+			Success: false,
+			Code:    403,
+			Message: "无权操作此订单",
+		}, nil
+	}
+
 	//检查订单状态
 	if order.Status != model.OrderStatusPaid {
 		return &api.PayOrderResp{
@@ -1473,6 +1790,7 @@ func (s *OrderService) convertToAPIOrder(order *model.Order, items []*model.Orde
 		Phone:       order.Phone,
 		CreatedAt:   order.CreatedAt.Unix(),
 		UpdatedAt:   order.UpdatedAt.Unix(),
+		MerchantId:  order.MerchantID,
 	}
 
 	// 处理可选字段
@@ -1488,11 +1806,17 @@ func (s *OrderService) convertToAPIOrder(order *model.Order, items []*model.Orde
 		shippingNo := order.ShippingNo
 		apiOrder.ShippingNo = &shippingNo
 	}
+	if order.ParentOrderNo != "" {
+		parentOrderNo := order.ParentOrderNo
+		apiOrder.ParentOrderNo = &parentOrderNo
+	}
 
 	// 转换订单项
 	var apiItems []*api.OrderItem
 	for _, item := range items {
+		itemID := item.ID
 		apiItem := &api.OrderItem{
+			Id:          &itemID,
 			ProductId:   item.ProductID,
 			ProductName: item.ProductName,
 			Quantity:    item.Quantity,
@@ -1502,6 +1826,40 @@ func (s *OrderService) convertToAPIOrder(order *model.Order, items []*model.Orde
 			productImage := item.ProductImage
 			apiItem.ProductImage = &productImage
 		}
+		if item.WarehouseID > 0 {
+			warehouseID := item.WarehouseID
+			apiItem.WarehouseId = &warehouseID
+		}
+		if item.OriginalPrice > 0 {
+			originalPrice := item.OriginalPrice
+			apiItem.OriginalPrice = &originalPrice
+		}
+		if item.FlashSaleID > 0 {
+			flashSaleID := item.FlashSaleID
+			apiItem.FlashSaleId = &flashSaleID
+		}
+		if item.TierMinQuantity > 0 {
+			apiItem.AppliedTier = &api.AppliedPriceTier{
+				MinQuantity: item.TierMinQuantity,
+				BasePrice:   item.BasePrice,
+			}
+			if item.PriceGroup != "" {
+				priceGroup := item.PriceGroup
+				apiItem.AppliedTier.UserGroup = &priceGroup
+			}
+		}
+		for _, c := range item.Components {
+			component := &api.OrderItemComponent{
+				ProductId:   c.ProductID,
+				ProductName: c.ProductName,
+				Quantity:    c.Quantity,
+			}
+			if c.WarehouseID > 0 {
+				warehouseID := c.WarehouseID
+				component.WarehouseId = &warehouseID
+			}
+			apiItem.Components = append(apiItem.Components, component)
+		}
 		apiItems = append(apiItems, apiItem)
 	}
 	apiOrder.Items = apiItems
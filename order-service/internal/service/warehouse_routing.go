@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"ecommerce/order-service/internal/dao/interfaces"
+	"ecommerce/order-service/internal/model"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// RoutingStrategy 发货仓库路由策略
+type RoutingStrategy string
+
+const (
+	// RoutingNearest 每个商品各自选择离收货地址最近且有货的仓库
+	RoutingNearest RoutingStrategy = "nearest"
+	// RoutingWholeOrder 优先选择能满足整单的仓库，减少拆单，无法整单发货时退化为就近
+	RoutingWholeOrder RoutingStrategy = "whole_order"
+)
+
+// 省份名称的常见后缀，地址中经常省略
+var regionSuffixes = []string{"特别行政区", "维吾尔自治区", "壮族自治区", "回族自治区", "自治区", "省", "市"}
+
+// SetRoutingStrategy 设置仓库路由策略，未知策略按整单优先处理
+func (s *OrderService) SetRoutingStrategy(strategy RoutingStrategy) {
+	switch strategy {
+	case RoutingNearest, RoutingWholeOrder:
+		s.routingStrategy = strategy
+	default:
+		klog.Warnf("未知的仓库路由策略 %q，使用 %s", strategy, RoutingWholeOrder)
+		s.routingStrategy = RoutingWholeOrder
+	}
+}
+
+// routeOrderItems 为订单项选择发货仓库，返回 商品ID -> 仓库ID
+// 未配置仓库或没有仓库能满足的商品不分配（仓库ID为0），由商品总库存兜底
+func (s *OrderService) routeOrderItems(ctx context.Context, address string, items []*model.OrderItem) map[int64]int64 {
+	assignments := make(map[int64]int64, len(items))
+	if s.productClient == nil || len(items) == 0 {
+		return assignments
+	}
+
+	warehouses, err := s.productClient.ListWarehouses(ctx)
+	if err != nil {
+		klog.Warnf("获取仓库列表失败，跳过仓库路由: %v", err)
+		return assignments
+	}
+	if len(warehouses) == 0 {
+		return assignments
+	}
+
+	//同一商品可能出现在多个订单项中，按商品汇总需求量
+	demand := make(map[int64]int32, len(items))
+	productIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		if _, ok := demand[item.ProductID]; !ok {
+			productIDs = append(productIDs, item.ProductID)
+		}
+		demand[item.ProductID] += item.Quantity
+	}
+
+	available, err := s.availableWarehouseStocks(ctx, productIDs)
+	if err != nil {
+		klog.Warnf("获取仓库库存失败，跳过仓库路由: %v", err)
+		return assignments
+	}
+
+	ranked := rankWarehouses(warehouses, address)
+
+	if s.routingStrategy != RoutingNearest {
+		for _, w := range ranked {
+			fulfilled := true
+			for productID, quantity := range demand {
+				if available[productID][w.ID] < quantity {
+					fulfilled = false
+					break
+				}
+			}
+			if fulfilled {
+				for productID := range demand {
+					assignments[productID] = w.ID
+				}
+				return assignments
+			}
+		}
+	}
+
+	for _, productID := range productIDs {
+		for _, w := range ranked {
+			if available[productID][w.ID] >= demand[productID] {
+				assignments[productID] = w.ID
+				break
+			}
+		}
+		if assignments[productID] == 0 {
+			klog.Warnf("没有仓库能满足商品 %d 的需求量 %d", productID, demand[productID])
+		}
+	}
+	return assignments
+}
+
+// checkWarehouseStock 检查指定仓库扣除已预占后的可用库存
+func (s *OrderService) checkWarehouseStock(ctx context.Context, productID, warehouseID int64, quantity int32) (bool, error) {
+	available, err := s.availableWarehouseStocks(ctx, []int64{productID})
+	if err != nil {
+		return false, err
+	}
+	return available[productID][warehouseID] >= quantity, nil
+}
+
+// availableWarehouseStocks 分仓库存减去未过期预占：商品ID -> 仓库ID -> 可用数量
+func (s *OrderService) availableWarehouseStocks(ctx context.Context, productIDs []int64) (map[int64]map[int64]int32, error) {
+	stocks, err := s.productClient.GetWarehouseStocks(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := s.daoFactory.StockReservationRepo.SumReservedByWarehouse(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	for productID, byWarehouse := range stocks {
+		for warehouseID := range byWarehouse {
+			byWarehouse[warehouseID] -= reserved[productID][warehouseID]
+		}
+	}
+	return stocks, nil
+}
+
+// rankWarehouses 按与收货地址的远近排序：同城 > 同省 > 其他，相同距离按优先级
+func rankWarehouses(warehouses []*interfaces.WarehouseInfo, address string) []*interfaces.WarehouseInfo {
+	distance := func(w *interfaces.WarehouseInfo) int {
+		if !regionMatches(address, w.Province) {
+			return 2
+		}
+		if w.City != "" && regionMatches(address, w.City) {
+			return 0
+		}
+		return 1
+	}
+
+	ranked := make([]*interfaces.WarehouseInfo, len(warehouses))
+	copy(ranked, warehouses)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		di, dj := distance(ranked[i]), distance(ranked[j])
+		if di != dj {
+			return di < dj
+		}
+		if ranked[i].Priority != ranked[j].Priority {
+			return ranked[i].Priority < ranked[j].Priority
+		}
+		return ranked[i].ID < ranked[j].ID
+	})
+	return ranked
+}
+
+// regionMatches 判断地址中是否包含某个行政区，兼容省略"省""市"等后缀的写法
+func regionMatches(address, region string) bool {
+	if region == "" {
+		return false
+	}
+	if strings.Contains(address, region) {
+		return true
+	}
+	for _, suffix := range regionSuffixes {
+		short := strings.TrimSuffix(region, suffix)
+		if short != region && utf8.RuneCountInString(short) >= 2 {
+			return strings.Contains(address, short)
+		}
+	}
+	return false
+}
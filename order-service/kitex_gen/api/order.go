@@ -128,6 +128,53 @@ func (p *RefundStatus) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type ReviewStatus int64
+
+const (
+	ReviewStatus_PENDING  ReviewStatus = 0
+	ReviewStatus_APPROVED ReviewStatus = 1
+	ReviewStatus_HIDDEN   ReviewStatus = 2
+)
+
+func (p ReviewStatus) String() string {
+	switch p {
+	case ReviewStatus_PENDING:
+		return "PENDING"
+	case ReviewStatus_APPROVED:
+		return "APPROVED"
+	case ReviewStatus_HIDDEN:
+		return "HIDDEN"
+	}
+	return "<UNSET>"
+}
+
+func ReviewStatusFromString(s string) (ReviewStatus, error) {
+	switch s {
+	case "PENDING":
+		return ReviewStatus_PENDING, nil
+	case "APPROVED":
+		return ReviewStatus_APPROVED, nil
+	case "HIDDEN":
+		return ReviewStatus_HIDDEN, nil
+	}
+	return ReviewStatus(0), fmt.Errorf("not a valid ReviewStatus string")
+}
+
+func ReviewStatusPtr(v ReviewStatus) *ReviewStatus { return &v }
+func (p *ReviewStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ReviewStatus(result.Int64)
+	return
+}
+
+func (p *ReviewStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type TimeoutType int64
 
 const (
@@ -170,12 +217,117 @@ func (p *TimeoutType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type FlashSaleStatus int64
+
+const (
+	FlashSaleStatus_ACTIVE     FlashSaleStatus = 0
+	FlashSaleStatus_RECONCILED FlashSaleStatus = 1
+)
+
+func (p FlashSaleStatus) String() string {
+	switch p {
+	case FlashSaleStatus_ACTIVE:
+		return "ACTIVE"
+	case FlashSaleStatus_RECONCILED:
+		return "RECONCILED"
+	}
+	return "<UNSET>"
+}
+
+func FlashSaleStatusFromString(s string) (FlashSaleStatus, error) {
+	switch s {
+	case "ACTIVE":
+		return FlashSaleStatus_ACTIVE, nil
+	case "RECONCILED":
+		return FlashSaleStatus_RECONCILED, nil
+	}
+	return FlashSaleStatus(0), fmt.Errorf("not a valid FlashSaleStatus string")
+}
+
+func FlashSaleStatusPtr(v FlashSaleStatus) *FlashSaleStatus { return &v }
+func (p *FlashSaleStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = FlashSaleStatus(result.Int64)
+	return
+}
+
+func (p *FlashSaleStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type FlashSaleRequestStatus int64
+
+const (
+	FlashSaleRequestStatus_QUEUED    FlashSaleRequestStatus = 0
+	FlashSaleRequestStatus_CREATED   FlashSaleRequestStatus = 1
+	FlashSaleRequestStatus_FAILED    FlashSaleRequestStatus = 2
+	FlashSaleRequestStatus_CANCELLED FlashSaleRequestStatus = 3
+	FlashSaleRequestStatus_REFUNDED  FlashSaleRequestStatus = 4
+)
+
+func (p FlashSaleRequestStatus) String() string {
+	switch p {
+	case FlashSaleRequestStatus_QUEUED:
+		return "QUEUED"
+	case FlashSaleRequestStatus_CREATED:
+		return "CREATED"
+	case FlashSaleRequestStatus_FAILED:
+		return "FAILED"
+	case FlashSaleRequestStatus_CANCELLED:
+		return "CANCELLED"
+	case FlashSaleRequestStatus_REFUNDED:
+		return "REFUNDED"
+	}
+	return "<UNSET>"
+}
+
+func FlashSaleRequestStatusFromString(s string) (FlashSaleRequestStatus, error) {
+	switch s {
+	case "QUEUED":
+		return FlashSaleRequestStatus_QUEUED, nil
+	case "CREATED":
+		return FlashSaleRequestStatus_CREATED, nil
+	case "FAILED":
+		return FlashSaleRequestStatus_FAILED, nil
+	case "CANCELLED":
+		return FlashSaleRequestStatus_CANCELLED, nil
+	case "REFUNDED":
+		return FlashSaleRequestStatus_REFUNDED, nil
+	}
+	return FlashSaleRequestStatus(0), fmt.Errorf("not a valid FlashSaleRequestStatus string")
+}
+
+func FlashSaleRequestStatusPtr(v FlashSaleRequestStatus) *FlashSaleRequestStatus { return &v }
+func (p *FlashSaleRequestStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = FlashSaleRequestStatus(result.Int64)
+	return
+}
+
+func (p *FlashSaleRequestStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type OrderItem struct {
-	ProductId    int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
-	ProductName  string  `thrift:"productName,2" frugal:"2,default,string" json:"productName"`
-	Quantity     int32   `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
-	Price        float64 `thrift:"price,4" frugal:"4,default,double" json:"price"`
-	ProductImage *string `thrift:"productImage,5,optional" frugal:"5,optional,string" json:"productImage,omitempty"`
+	ProductId     int64                 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	ProductName   string                `thrift:"productName,2" frugal:"2,default,string" json:"productName"`
+	Quantity      int32                 `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
+	Price         float64               `thrift:"price,4" frugal:"4,default,double" json:"price"`
+	ProductImage  *string               `thrift:"productImage,5,optional" frugal:"5,optional,string" json:"productImage,omitempty"`
+	WarehouseId   *int64                `thrift:"warehouseId,6,optional" frugal:"6,optional,i64" json:"warehouseId,omitempty"`
+	OriginalPrice *float64              `thrift:"originalPrice,7,optional" frugal:"7,optional,double" json:"originalPrice,omitempty"`
+	Id            *int64                `thrift:"id,8,optional" frugal:"8,optional,i64" json:"id,omitempty"`
+	Components    []*OrderItemComponent `thrift:"components,9,optional" frugal:"9,optional,list<OrderItemComponent>" json:"components,omitempty"`
+	AppliedTier   *AppliedPriceTier     `thrift:"appliedTier,10,optional" frugal:"10,optional,AppliedPriceTier" json:"appliedTier,omitempty"`
+	FlashSaleId   *int64                `thrift:"flashSaleId,11,optional" frugal:"11,optional,i64" json:"flashSaleId,omitempty"`
 }
 
 func NewOrderItem() *OrderItem {
@@ -209,6 +361,60 @@ func (p *OrderItem) GetProductImage() (v string) {
 	}
 	return *p.ProductImage
 }
+
+var OrderItem_WarehouseId_DEFAULT int64
+
+func (p *OrderItem) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return OrderItem_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
+
+var OrderItem_OriginalPrice_DEFAULT float64
+
+func (p *OrderItem) GetOriginalPrice() (v float64) {
+	if !p.IsSetOriginalPrice() {
+		return OrderItem_OriginalPrice_DEFAULT
+	}
+	return *p.OriginalPrice
+}
+
+var OrderItem_Id_DEFAULT int64
+
+func (p *OrderItem) GetId() (v int64) {
+	if !p.IsSetId() {
+		return OrderItem_Id_DEFAULT
+	}
+	return *p.Id
+}
+
+var OrderItem_Components_DEFAULT []*OrderItemComponent
+
+func (p *OrderItem) GetComponents() (v []*OrderItemComponent) {
+	if !p.IsSetComponents() {
+		return OrderItem_Components_DEFAULT
+	}
+	return p.Components
+}
+
+var OrderItem_AppliedTier_DEFAULT *AppliedPriceTier
+
+func (p *OrderItem) GetAppliedTier() (v *AppliedPriceTier) {
+	if !p.IsSetAppliedTier() {
+		return OrderItem_AppliedTier_DEFAULT
+	}
+	return p.AppliedTier
+}
+
+var OrderItem_FlashSaleId_DEFAULT int64
+
+func (p *OrderItem) GetFlashSaleId() (v int64) {
+	if !p.IsSetFlashSaleId() {
+		return OrderItem_FlashSaleId_DEFAULT
+	}
+	return *p.FlashSaleId
+}
 func (p *OrderItem) SetProductId(val int64) {
 	p.ProductId = val
 }
@@ -224,11 +430,53 @@ func (p *OrderItem) SetPrice(val float64) {
 func (p *OrderItem) SetProductImage(val *string) {
 	p.ProductImage = val
 }
+func (p *OrderItem) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+func (p *OrderItem) SetOriginalPrice(val *float64) {
+	p.OriginalPrice = val
+}
+func (p *OrderItem) SetId(val *int64) {
+	p.Id = val
+}
+func (p *OrderItem) SetComponents(val []*OrderItemComponent) {
+	p.Components = val
+}
+func (p *OrderItem) SetAppliedTier(val *AppliedPriceTier) {
+	p.AppliedTier = val
+}
+func (p *OrderItem) SetFlashSaleId(val *int64) {
+	p.FlashSaleId = val
+}
 
 func (p *OrderItem) IsSetProductImage() bool {
 	return p.ProductImage != nil
 }
 
+func (p *OrderItem) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
+
+func (p *OrderItem) IsSetOriginalPrice() bool {
+	return p.OriginalPrice != nil
+}
+
+func (p *OrderItem) IsSetId() bool {
+	return p.Id != nil
+}
+
+func (p *OrderItem) IsSetComponents() bool {
+	return p.Components != nil
+}
+
+func (p *OrderItem) IsSetAppliedTier() bool {
+	return p.AppliedTier != nil
+}
+
+func (p *OrderItem) IsSetFlashSaleId() bool {
+	return p.FlashSaleId != nil
+}
+
 func (p *OrderItem) String() string {
 	if p == nil {
 		return "<nil>"
@@ -237,27 +485,157 @@ func (p *OrderItem) String() string {
 }
 
 var fieldIDToName_OrderItem = map[int16]string{
+	1:  "productId",
+	2:  "productName",
+	3:  "quantity",
+	4:  "price",
+	5:  "productImage",
+	6:  "warehouseId",
+	7:  "originalPrice",
+	8:  "id",
+	9:  "components",
+	10: "appliedTier",
+	11: "flashSaleId",
+}
+
+type AppliedPriceTier struct {
+	MinQuantity int32   `thrift:"minQuantity,1" frugal:"1,default,i32" json:"minQuantity"`
+	BasePrice   float64 `thrift:"basePrice,2" frugal:"2,default,double" json:"basePrice"`
+	UserGroup   *string `thrift:"userGroup,3,optional" frugal:"3,optional,string" json:"userGroup,omitempty"`
+}
+
+func NewAppliedPriceTier() *AppliedPriceTier {
+	return &AppliedPriceTier{}
+}
+
+func (p *AppliedPriceTier) InitDefault() {
+}
+
+func (p *AppliedPriceTier) GetMinQuantity() (v int32) {
+	return p.MinQuantity
+}
+
+func (p *AppliedPriceTier) GetBasePrice() (v float64) {
+	return p.BasePrice
+}
+
+var AppliedPriceTier_UserGroup_DEFAULT string
+
+func (p *AppliedPriceTier) GetUserGroup() (v string) {
+	if !p.IsSetUserGroup() {
+		return AppliedPriceTier_UserGroup_DEFAULT
+	}
+	return *p.UserGroup
+}
+func (p *AppliedPriceTier) SetMinQuantity(val int32) {
+	p.MinQuantity = val
+}
+func (p *AppliedPriceTier) SetBasePrice(val float64) {
+	p.BasePrice = val
+}
+func (p *AppliedPriceTier) SetUserGroup(val *string) {
+	p.UserGroup = val
+}
+
+func (p *AppliedPriceTier) IsSetUserGroup() bool {
+	return p.UserGroup != nil
+}
+
+func (p *AppliedPriceTier) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AppliedPriceTier(%+v)", *p)
+}
+
+var fieldIDToName_AppliedPriceTier = map[int16]string{
+	1: "minQuantity",
+	2: "basePrice",
+	3: "userGroup",
+}
+
+type OrderItemComponent struct {
+	ProductId   int64  `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	ProductName string `thrift:"productName,2" frugal:"2,default,string" json:"productName"`
+	Quantity    int32  `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
+	WarehouseId *int64 `thrift:"warehouseId,4,optional" frugal:"4,optional,i64" json:"warehouseId,omitempty"`
+}
+
+func NewOrderItemComponent() *OrderItemComponent {
+	return &OrderItemComponent{}
+}
+
+func (p *OrderItemComponent) InitDefault() {
+}
+
+func (p *OrderItemComponent) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *OrderItemComponent) GetProductName() (v string) {
+	return p.ProductName
+}
+
+func (p *OrderItemComponent) GetQuantity() (v int32) {
+	return p.Quantity
+}
+
+var OrderItemComponent_WarehouseId_DEFAULT int64
+
+func (p *OrderItemComponent) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return OrderItemComponent_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
+func (p *OrderItemComponent) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *OrderItemComponent) SetProductName(val string) {
+	p.ProductName = val
+}
+func (p *OrderItemComponent) SetQuantity(val int32) {
+	p.Quantity = val
+}
+func (p *OrderItemComponent) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+
+func (p *OrderItemComponent) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
+
+func (p *OrderItemComponent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderItemComponent(%+v)", *p)
+}
+
+var fieldIDToName_OrderItemComponent = map[int16]string{
 	1: "productId",
 	2: "productName",
 	3: "quantity",
-	4: "price",
-	5: "productImage",
+	4: "warehouseId",
 }
 
 type Order struct {
-	Id          int64        `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	OrderNo     string       `thrift:"orderNo,2" frugal:"2,default,string" json:"orderNo"`
-	UserId      int64        `thrift:"userId,3" frugal:"3,default,i64" json:"userId"`
-	TotalAmount float64      `thrift:"totalAmount,4" frugal:"4,default,double" json:"totalAmount"`
-	Status      OrderStatus  `thrift:"status,5" frugal:"5,default,OrderStatus" json:"status"`
-	Items       []*OrderItem `thrift:"items,6" frugal:"6,default,list<OrderItem>" json:"items"`
-	Address     string       `thrift:"address,7" frugal:"7,default,string" json:"address"`
-	Phone       string       `thrift:"phone,8" frugal:"8,default,string" json:"phone"`
-	CreatedAt   int64        `thrift:"createdAt,9" frugal:"9,default,i64" json:"createdAt"`
-	UpdatedAt   int64        `thrift:"updatedAt,10" frugal:"10,default,i64" json:"updatedAt"`
-	Receiver    *string      `thrift:"receiver,11,optional" frugal:"11,optional,string" json:"receiver,omitempty"`
-	PaymentNo   *string      `thrift:"paymentNo,12,optional" frugal:"12,optional,string" json:"paymentNo,omitempty"`
-	ShippingNo  *string      `thrift:"shippingNo,13,optional" frugal:"13,optional,string" json:"shippingNo,omitempty"`
+	Id            int64        `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	OrderNo       string       `thrift:"orderNo,2" frugal:"2,default,string" json:"orderNo"`
+	UserId        int64        `thrift:"userId,3" frugal:"3,default,i64" json:"userId"`
+	TotalAmount   float64      `thrift:"totalAmount,4" frugal:"4,default,double" json:"totalAmount"`
+	Status        OrderStatus  `thrift:"status,5" frugal:"5,default,OrderStatus" json:"status"`
+	Items         []*OrderItem `thrift:"items,6" frugal:"6,default,list<OrderItem>" json:"items"`
+	Address       string       `thrift:"address,7" frugal:"7,default,string" json:"address"`
+	Phone         string       `thrift:"phone,8" frugal:"8,default,string" json:"phone"`
+	CreatedAt     int64        `thrift:"createdAt,9" frugal:"9,default,i64" json:"createdAt"`
+	UpdatedAt     int64        `thrift:"updatedAt,10" frugal:"10,default,i64" json:"updatedAt"`
+	Receiver      *string      `thrift:"receiver,11,optional" frugal:"11,optional,string" json:"receiver,omitempty"`
+	PaymentNo     *string      `thrift:"paymentNo,12,optional" frugal:"12,optional,string" json:"paymentNo,omitempty"`
+	ShippingNo    *string      `thrift:"shippingNo,13,optional" frugal:"13,optional,string" json:"shippingNo,omitempty"`
+	ParentOrderNo *string      `thrift:"parentOrderNo,14,optional" frugal:"14,optional,string" json:"parentOrderNo,omitempty"`
+	MerchantId    int64        `thrift:"merchantId,15" frugal:"15,default,i64" json:"merchantId"`
+	SubOrderNos   []string     `thrift:"subOrderNos,16,optional" frugal:"16,optional,list<string>" json:"subOrderNos,omitempty"`
 }
 
 func NewOrder() *Order {
@@ -333,6 +711,28 @@ func (p *Order) GetShippingNo() (v string) {
 	}
 	return *p.ShippingNo
 }
+
+var Order_ParentOrderNo_DEFAULT string
+
+func (p *Order) GetParentOrderNo() (v string) {
+	if !p.IsSetParentOrderNo() {
+		return Order_ParentOrderNo_DEFAULT
+	}
+	return *p.ParentOrderNo
+}
+
+func (p *Order) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+var Order_SubOrderNos_DEFAULT []string
+
+func (p *Order) GetSubOrderNos() (v []string) {
+	if !p.IsSetSubOrderNos() {
+		return Order_SubOrderNos_DEFAULT
+	}
+	return p.SubOrderNos
+}
 func (p *Order) SetId(val int64) {
 	p.Id = val
 }
@@ -372,6 +772,15 @@ func (p *Order) SetPaymentNo(val *string) {
 func (p *Order) SetShippingNo(val *string) {
 	p.ShippingNo = val
 }
+func (p *Order) SetParentOrderNo(val *string) {
+	p.ParentOrderNo = val
+}
+func (p *Order) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *Order) SetSubOrderNos(val []string) {
+	p.SubOrderNos = val
+}
 
 func (p *Order) IsSetReceiver() bool {
 	return p.Receiver != nil
@@ -385,6 +794,14 @@ func (p *Order) IsSetShippingNo() bool {
 	return p.ShippingNo != nil
 }
 
+func (p *Order) IsSetParentOrderNo() bool {
+	return p.ParentOrderNo != nil
+}
+
+func (p *Order) IsSetSubOrderNos() bool {
+	return p.SubOrderNos != nil
+}
+
 func (p *Order) String() string {
 	if p == nil {
 		return "<nil>"
@@ -406,17 +823,21 @@ var fieldIDToName_Order = map[int16]string{
 	11: "receiver",
 	12: "paymentNo",
 	13: "shippingNo",
+	14: "parentOrderNo",
+	15: "merchantId",
+	16: "subOrderNos",
 }
 
 type StockReservation struct {
-	ReserveId  string `thrift:"reserveId,1" frugal:"1,default,string" json:"reserveId"`
-	OrderNo    string `thrift:"orderNo,2" frugal:"2,default,string" json:"orderNo"`
-	ProductId  int64  `thrift:"productId,3" frugal:"3,default,i64" json:"productId"`
-	Quantity   int32  `thrift:"quantity,4" frugal:"4,default,i32" json:"quantity"`
-	Status     string `thrift:"status,5" frugal:"5,default,string" json:"status"`
-	ExpireTime int64  `thrift:"expireTime,6" frugal:"6,default,i64" json:"expireTime"`
-	CreatedAt  int64  `thrift:"createdAt,7" frugal:"7,default,i64" json:"createdAt"`
-	UpdatedAt  int64  `thrift:"updatedAt,8" frugal:"8,default,i64" json:"updatedAt"`
+	ReserveId   string `thrift:"reserveId,1" frugal:"1,default,string" json:"reserveId"`
+	OrderNo     string `thrift:"orderNo,2" frugal:"2,default,string" json:"orderNo"`
+	ProductId   int64  `thrift:"productId,3" frugal:"3,default,i64" json:"productId"`
+	Quantity    int32  `thrift:"quantity,4" frugal:"4,default,i32" json:"quantity"`
+	Status      string `thrift:"status,5" frugal:"5,default,string" json:"status"`
+	ExpireTime  int64  `thrift:"expireTime,6" frugal:"6,default,i64" json:"expireTime"`
+	CreatedAt   int64  `thrift:"createdAt,7" frugal:"7,default,i64" json:"createdAt"`
+	UpdatedAt   int64  `thrift:"updatedAt,8" frugal:"8,default,i64" json:"updatedAt"`
+	WarehouseId *int64 `thrift:"warehouseId,9,optional" frugal:"9,optional,i64" json:"warehouseId,omitempty"`
 }
 
 func NewStockReservation() *StockReservation {
@@ -457,6 +878,15 @@ func (p *StockReservation) GetCreatedAt() (v int64) {
 func (p *StockReservation) GetUpdatedAt() (v int64) {
 	return p.UpdatedAt
 }
+
+var StockReservation_WarehouseId_DEFAULT int64
+
+func (p *StockReservation) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return StockReservation_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
 func (p *StockReservation) SetReserveId(val string) {
 	p.ReserveId = val
 }
@@ -481,6 +911,13 @@ func (p *StockReservation) SetCreatedAt(val int64) {
 func (p *StockReservation) SetUpdatedAt(val int64) {
 	p.UpdatedAt = val
 }
+func (p *StockReservation) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+
+func (p *StockReservation) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
 
 func (p *StockReservation) String() string {
 	if p == nil {
@@ -498,6 +935,7 @@ var fieldIDToName_StockReservation = map[int16]string{
 	6: "expireTime",
 	7: "createdAt",
 	8: "updatedAt",
+	9: "warehouseId",
 }
 
 type RefundOrder struct {
@@ -813,12 +1251,13 @@ var fieldIDToName_CreateOrderReq = map[int16]string{
 }
 
 type CreateOrderResp struct {
-	Success     bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code        int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message     string  `thrift:"message,3" frugal:"3,default,string" json:"message"`
-	OrderNo     string  `thrift:"orderNo,4" frugal:"4,default,string" json:"orderNo"`
-	TotalAmount float64 `thrift:"totalAmount,5" frugal:"5,default,double" json:"totalAmount"`
-	PaymentUrl  *string `thrift:"paymentUrl,6,optional" frugal:"6,optional,string" json:"paymentUrl,omitempty"`
+	Success     bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     string   `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	OrderNo     string   `thrift:"orderNo,4" frugal:"4,default,string" json:"orderNo"`
+	TotalAmount float64  `thrift:"totalAmount,5" frugal:"5,default,double" json:"totalAmount"`
+	PaymentUrl  *string  `thrift:"paymentUrl,6,optional" frugal:"6,optional,string" json:"paymentUrl,omitempty"`
+	SubOrderNos []string `thrift:"subOrderNos,7,optional" frugal:"7,optional,list<string>" json:"subOrderNos,omitempty"`
 }
 
 func NewCreateOrderResp() *CreateOrderResp {
@@ -859,6 +1298,15 @@ func (p *CreateOrderResp) GetPaymentUrl() (v string) {
 	}
 	return *p.PaymentUrl
 }
+
+var CreateOrderResp_SubOrderNos_DEFAULT []string
+
+func (p *CreateOrderResp) GetSubOrderNos() (v []string) {
+	if !p.IsSetSubOrderNos() {
+		return CreateOrderResp_SubOrderNos_DEFAULT
+	}
+	return p.SubOrderNos
+}
 func (p *CreateOrderResp) SetSuccess(val bool) {
 	p.Success = val
 }
@@ -877,11 +1325,18 @@ func (p *CreateOrderResp) SetTotalAmount(val float64) {
 func (p *CreateOrderResp) SetPaymentUrl(val *string) {
 	p.PaymentUrl = val
 }
+func (p *CreateOrderResp) SetSubOrderNos(val []string) {
+	p.SubOrderNos = val
+}
 
 func (p *CreateOrderResp) IsSetPaymentUrl() bool {
 	return p.PaymentUrl != nil
 }
 
+func (p *CreateOrderResp) IsSetSubOrderNos() bool {
+	return p.SubOrderNos != nil
+}
+
 func (p *CreateOrderResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -896,6 +1351,7 @@ var fieldIDToName_CreateOrderResp = map[int16]string{
 	4: "orderNo",
 	5: "totalAmount",
 	6: "paymentUrl",
+	7: "subOrderNos",
 }
 
 type GetOrderReq struct {
@@ -1014,12 +1470,13 @@ var fieldIDToName_GetOrderResp = map[int16]string{
 }
 
 type ListOrdersReq struct {
-	UserId    int64        `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	Status    *OrderStatus `thrift:"status,2,optional" frugal:"2,optional,OrderStatus" json:"status,omitempty"`
-	Page      int32        `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize  int32        `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
-	StartTime *int64       `thrift:"startTime,5,optional" frugal:"5,optional,i64" json:"startTime,omitempty"`
-	EndTime   *int64       `thrift:"endTime,6,optional" frugal:"6,optional,i64" json:"endTime,omitempty"`
+	UserId     int64        `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Status     *OrderStatus `thrift:"status,2,optional" frugal:"2,optional,OrderStatus" json:"status,omitempty"`
+	Page       int32        `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize   int32        `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	StartTime  *int64       `thrift:"startTime,5,optional" frugal:"5,optional,i64" json:"startTime,omitempty"`
+	EndTime    *int64       `thrift:"endTime,6,optional" frugal:"6,optional,i64" json:"endTime,omitempty"`
+	MerchantId *int64       `thrift:"merchantId,7,optional" frugal:"7,optional,i64" json:"merchantId,omitempty"`
 }
 
 func NewListOrdersReq() *ListOrdersReq {
@@ -1072,6 +1529,15 @@ func (p *ListOrdersReq) GetEndTime() (v int64) {
 	}
 	return *p.EndTime
 }
+
+var ListOrdersReq_MerchantId_DEFAULT int64
+
+func (p *ListOrdersReq) GetMerchantId() (v int64) {
+	if !p.IsSetMerchantId() {
+		return ListOrdersReq_MerchantId_DEFAULT
+	}
+	return *p.MerchantId
+}
 func (p *ListOrdersReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -1090,6 +1556,9 @@ func (p *ListOrdersReq) SetStartTime(val *int64) {
 func (p *ListOrdersReq) SetEndTime(val *int64) {
 	p.EndTime = val
 }
+func (p *ListOrdersReq) SetMerchantId(val *int64) {
+	p.MerchantId = val
+}
 
 func (p *ListOrdersReq) IsSetStatus() bool {
 	return p.Status != nil
@@ -1103,6 +1572,10 @@ func (p *ListOrdersReq) IsSetEndTime() bool {
 	return p.EndTime != nil
 }
 
+func (p *ListOrdersReq) IsSetMerchantId() bool {
+	return p.MerchantId != nil
+}
+
 func (p *ListOrdersReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1117,6 +1590,7 @@ var fieldIDToName_ListOrdersReq = map[int16]string{
 	4: "pageSize",
 	5: "startTime",
 	6: "endTime",
+	7: "merchantId",
 }
 
 type ListOrdersResp struct {
@@ -1206,9 +1680,10 @@ var fieldIDToName_ListOrdersResp = map[int16]string{
 }
 
 type PayOrderReq struct {
-	OrderNo   string  `thrift:"orderNo,1" frugal:"1,default,string" json:"orderNo"`
-	UserId    int64   `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
-	PaymentNo *string `thrift:"paymentNo,3,optional" frugal:"3,optional,string" json:"paymentNo,omitempty"`
+	OrderNo    string  `thrift:"orderNo,1" frugal:"1,default,string" json:"orderNo"`
+	UserId     int64   `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	PaymentNo  *string `thrift:"paymentNo,3,optional" frugal:"3,optional,string" json:"paymentNo,omitempty"`
+	MerchantId *int64  `thrift:"merchantId,4,optional" frugal:"4,optional,i64" json:"merchantId,omitempty"`
 }
 
 func NewPayOrderReq() *PayOrderReq {
@@ -1234,6 +1709,15 @@ func (p *PayOrderReq) GetPaymentNo() (v string) {
 	}
 	return *p.PaymentNo
 }
+
+var PayOrderReq_MerchantId_DEFAULT int64
+
+func (p *PayOrderReq) GetMerchantId() (v int64) {
+	if !p.IsSetMerchantId() {
+		return PayOrderReq_MerchantId_DEFAULT
+	}
+	return *p.MerchantId
+}
 func (p *PayOrderReq) SetOrderNo(val string) {
 	p.OrderNo = val
 }
@@ -1243,11 +1727,18 @@ func (p *PayOrderReq) SetUserId(val int64) {
 func (p *PayOrderReq) SetPaymentNo(val *string) {
 	p.PaymentNo = val
 }
+func (p *PayOrderReq) SetMerchantId(val *int64) {
+	p.MerchantId = val
+}
 
 func (p *PayOrderReq) IsSetPaymentNo() bool {
 	return p.PaymentNo != nil
 }
 
+func (p *PayOrderReq) IsSetMerchantId() bool {
+	return p.MerchantId != nil
+}
+
 func (p *PayOrderReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1259,6 +1750,7 @@ var fieldIDToName_PayOrderReq = map[int16]string{
 	1: "orderNo",
 	2: "userId",
 	3: "paymentNo",
+	4: "merchantId",
 }
 
 type PayOrderResp struct {
@@ -1600,6 +2092,7 @@ type ProcessRefundReq struct {
 	ProcessorId int64        `thrift:"processorId,2" frugal:"2,default,i64" json:"processorId"`
 	Action      RefundStatus `thrift:"action,3" frugal:"3,default,RefundStatus" json:"action"`
 	Remark      *string      `thrift:"remark,4,optional" frugal:"4,optional,string" json:"remark,omitempty"`
+	MerchantId  *int64       `thrift:"merchantId,5,optional" frugal:"5,optional,i64" json:"merchantId,omitempty"`
 }
 
 func NewProcessRefundReq() *ProcessRefundReq {
@@ -1629,6 +2122,15 @@ func (p *ProcessRefundReq) GetRemark() (v string) {
 	}
 	return *p.Remark
 }
+
+var ProcessRefundReq_MerchantId_DEFAULT int64
+
+func (p *ProcessRefundReq) GetMerchantId() (v int64) {
+	if !p.IsSetMerchantId() {
+		return ProcessRefundReq_MerchantId_DEFAULT
+	}
+	return *p.MerchantId
+}
 func (p *ProcessRefundReq) SetRefundNo(val string) {
 	p.RefundNo = val
 }
@@ -1641,11 +2143,18 @@ func (p *ProcessRefundReq) SetAction(val RefundStatus) {
 func (p *ProcessRefundReq) SetRemark(val *string) {
 	p.Remark = val
 }
+func (p *ProcessRefundReq) SetMerchantId(val *int64) {
+	p.MerchantId = val
+}
 
 func (p *ProcessRefundReq) IsSetRemark() bool {
 	return p.Remark != nil
 }
 
+func (p *ProcessRefundReq) IsSetMerchantId() bool {
+	return p.MerchantId != nil
+}
+
 func (p *ProcessRefundReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1658,6 +2167,7 @@ var fieldIDToName_ProcessRefundReq = map[int16]string{
 	2: "processorId",
 	3: "action",
 	4: "remark",
+	5: "merchantId",
 }
 
 type ProcessRefundResp struct {
@@ -1724,6 +2234,7 @@ type ReserveStockReq struct {
 	ProductId     int64  `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
 	Quantity      int32  `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
 	ExpireSeconds int64  `thrift:"expireSeconds,4" frugal:"4,default,i64" json:"expireSeconds"`
+	WarehouseId   *int64 `thrift:"warehouseId,5,optional" frugal:"5,optional,i64" json:"warehouseId,omitempty"`
 }
 
 func NewReserveStockReq() *ReserveStockReq {
@@ -1751,6 +2262,15 @@ func (p *ReserveStockReq) GetQuantity() (v int32) {
 func (p *ReserveStockReq) GetExpireSeconds() (v int64) {
 	return p.ExpireSeconds
 }
+
+var ReserveStockReq_WarehouseId_DEFAULT int64
+
+func (p *ReserveStockReq) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return ReserveStockReq_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
 func (p *ReserveStockReq) SetOrderNo(val string) {
 	p.OrderNo = val
 }
@@ -1763,6 +2283,13 @@ func (p *ReserveStockReq) SetQuantity(val int32) {
 func (p *ReserveStockReq) SetExpireSeconds(val int64) {
 	p.ExpireSeconds = val
 }
+func (p *ReserveStockReq) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+
+func (p *ReserveStockReq) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
 
 func (p *ReserveStockReq) String() string {
 	if p == nil {
@@ -1776,6 +2303,7 @@ var fieldIDToName_ReserveStockReq = map[int16]string{
 	2: "productId",
 	3: "quantity",
 	4: "expireSeconds",
+	5: "warehouseId",
 }
 
 type ReserveStockResp struct {
@@ -2252,1174 +2780,4028 @@ var fieldIDToName_OrderStatsResp = map[int16]string{
 	6: "statusCounts",
 }
 
-type OrderService interface {
-	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
+type Review struct {
+	Id          int64        `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	OrderNo     string       `thrift:"orderNo,2" frugal:"2,default,string" json:"orderNo"`
+	OrderItemId int64        `thrift:"orderItemId,3" frugal:"3,default,i64" json:"orderItemId"`
+	ProductId   int64        `thrift:"productId,4" frugal:"4,default,i64" json:"productId"`
+	UserId      int64        `thrift:"userId,5" frugal:"5,default,i64" json:"userId"`
+	Rating      int32        `thrift:"rating,6" frugal:"6,default,i32" json:"rating"`
+	Content     string       `thrift:"content,7" frugal:"7,default,string" json:"content"`
+	Images      []string     `thrift:"images,8" frugal:"8,default,list<string>" json:"images"`
+	Status      ReviewStatus `thrift:"status,9" frugal:"9,default,ReviewStatus" json:"status"`
+	Reply       *string      `thrift:"reply,10,optional" frugal:"10,optional,string" json:"reply,omitempty"`
+	RepliedAt   *int64       `thrift:"repliedAt,11,optional" frugal:"11,optional,i64" json:"repliedAt,omitempty"`
+	CreatedAt   int64        `thrift:"createdAt,12" frugal:"12,default,i64" json:"createdAt"`
+	UpdatedAt   int64        `thrift:"updatedAt,13" frugal:"13,default,i64" json:"updatedAt"`
+}
 
-	PayOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+func NewReview() *Review {
+	return &Review{}
+}
 
-	CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
+func (p *Review) InitDefault() {
+}
 
-	GetOrder(ctx context.Context, req *GetOrderReq) (r *GetOrderResp, err error)
+func (p *Review) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Review) GetOrderNo() (v string) {
+	return p.OrderNo
+}
+
+func (p *Review) GetOrderItemId() (v int64) {
+	return p.OrderItemId
+}
+
+func (p *Review) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *Review) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *Review) GetRating() (v int32) {
+	return p.Rating
+}
+
+func (p *Review) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *Review) GetImages() (v []string) {
+	return p.Images
+}
+
+func (p *Review) GetStatus() (v ReviewStatus) {
+	return p.Status
+}
+
+var Review_Reply_DEFAULT string
+
+func (p *Review) GetReply() (v string) {
+	if !p.IsSetReply() {
+		return Review_Reply_DEFAULT
+	}
+	return *p.Reply
+}
+
+var Review_RepliedAt_DEFAULT int64
+
+func (p *Review) GetRepliedAt() (v int64) {
+	if !p.IsSetRepliedAt() {
+		return Review_RepliedAt_DEFAULT
+	}
+	return *p.RepliedAt
+}
+
+func (p *Review) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Review) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Review) SetId(val int64) {
+	p.Id = val
+}
+func (p *Review) SetOrderNo(val string) {
+	p.OrderNo = val
+}
+func (p *Review) SetOrderItemId(val int64) {
+	p.OrderItemId = val
+}
+func (p *Review) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *Review) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *Review) SetRating(val int32) {
+	p.Rating = val
+}
+func (p *Review) SetContent(val string) {
+	p.Content = val
+}
+func (p *Review) SetImages(val []string) {
+	p.Images = val
+}
+func (p *Review) SetStatus(val ReviewStatus) {
+	p.Status = val
+}
+func (p *Review) SetReply(val *string) {
+	p.Reply = val
+}
+func (p *Review) SetRepliedAt(val *int64) {
+	p.RepliedAt = val
+}
+func (p *Review) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Review) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Review) IsSetReply() bool {
+	return p.Reply != nil
+}
+
+func (p *Review) IsSetRepliedAt() bool {
+	return p.RepliedAt != nil
+}
+
+func (p *Review) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Review(%+v)", *p)
+}
+
+var fieldIDToName_Review = map[int16]string{
+	1:  "id",
+	2:  "orderNo",
+	3:  "orderItemId",
+	4:  "productId",
+	5:  "userId",
+	6:  "rating",
+	7:  "content",
+	8:  "images",
+	9:  "status",
+	10: "reply",
+	11: "repliedAt",
+	12: "createdAt",
+	13: "updatedAt",
+}
+
+type CreateReviewReq struct {
+	UserId      int64    `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	OrderNo     string   `thrift:"orderNo,2" frugal:"2,default,string" json:"orderNo"`
+	OrderItemId int64    `thrift:"orderItemId,3" frugal:"3,default,i64" json:"orderItemId"`
+	Rating      int32    `thrift:"rating,4" frugal:"4,default,i32" json:"rating"`
+	Content     *string  `thrift:"content,5,optional" frugal:"5,optional,string" json:"content,omitempty"`
+	Images      []string `thrift:"images,6,optional" frugal:"6,optional,list<string>" json:"images,omitempty"`
+}
+
+func NewCreateReviewReq() *CreateReviewReq {
+	return &CreateReviewReq{}
+}
+
+func (p *CreateReviewReq) InitDefault() {
+}
+
+func (p *CreateReviewReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateReviewReq) GetOrderNo() (v string) {
+	return p.OrderNo
+}
+
+func (p *CreateReviewReq) GetOrderItemId() (v int64) {
+	return p.OrderItemId
+}
+
+func (p *CreateReviewReq) GetRating() (v int32) {
+	return p.Rating
+}
+
+var CreateReviewReq_Content_DEFAULT string
+
+func (p *CreateReviewReq) GetContent() (v string) {
+	if !p.IsSetContent() {
+		return CreateReviewReq_Content_DEFAULT
+	}
+	return *p.Content
+}
+
+var CreateReviewReq_Images_DEFAULT []string
+
+func (p *CreateReviewReq) GetImages() (v []string) {
+	if !p.IsSetImages() {
+		return CreateReviewReq_Images_DEFAULT
+	}
+	return p.Images
+}
+func (p *CreateReviewReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateReviewReq) SetOrderNo(val string) {
+	p.OrderNo = val
+}
+func (p *CreateReviewReq) SetOrderItemId(val int64) {
+	p.OrderItemId = val
+}
+func (p *CreateReviewReq) SetRating(val int32) {
+	p.Rating = val
+}
+func (p *CreateReviewReq) SetContent(val *string) {
+	p.Content = val
+}
+func (p *CreateReviewReq) SetImages(val []string) {
+	p.Images = val
+}
+
+func (p *CreateReviewReq) IsSetContent() bool {
+	return p.Content != nil
+}
+
+func (p *CreateReviewReq) IsSetImages() bool {
+	return p.Images != nil
+}
+
+func (p *CreateReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateReviewReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateReviewReq = map[int16]string{
+	1: "userId",
+	2: "orderNo",
+	3: "orderItemId",
+	4: "rating",
+	5: "content",
+	6: "images",
+}
+
+type CreateReviewResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message string  `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Review  *Review `thrift:"review,4,optional" frugal:"4,optional,Review" json:"review,omitempty"`
+}
+
+func NewCreateReviewResp() *CreateReviewResp {
+	return &CreateReviewResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateReviewResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateReviewResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateReviewResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *CreateReviewResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var CreateReviewResp_Review_DEFAULT *Review
+
+func (p *CreateReviewResp) GetReview() (v *Review) {
+	if !p.IsSetReview() {
+		return CreateReviewResp_Review_DEFAULT
+	}
+	return p.Review
+}
+func (p *CreateReviewResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateReviewResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateReviewResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *CreateReviewResp) SetReview(val *Review) {
+	p.Review = val
+}
+
+func (p *CreateReviewResp) IsSetReview() bool {
+	return p.Review != nil
+}
+
+func (p *CreateReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateReviewResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateReviewResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "review",
+}
+
+type ListReviewsReq struct {
+	ProductId *int64        `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	UserId    *int64        `thrift:"userId,2,optional" frugal:"2,optional,i64" json:"userId,omitempty"`
+	Status    *ReviewStatus `thrift:"status,3,optional" frugal:"3,optional,ReviewStatus" json:"status,omitempty"`
+	Page      int32         `thrift:"page,4" frugal:"4,default,i32" json:"page"`
+	PageSize  int32         `thrift:"pageSize,5" frugal:"5,default,i32" json:"pageSize"`
+}
+
+func NewListReviewsReq() *ListReviewsReq {
+	return &ListReviewsReq{
+		Page:     1,
+		PageSize: 10,
+	}
+}
+
+func (p *ListReviewsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 10
+}
+
+var ListReviewsReq_ProductId_DEFAULT int64
+
+func (p *ListReviewsReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListReviewsReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListReviewsReq_UserId_DEFAULT int64
+
+func (p *ListReviewsReq) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return ListReviewsReq_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+
+var ListReviewsReq_Status_DEFAULT ReviewStatus
+
+func (p *ListReviewsReq) GetStatus() (v ReviewStatus) {
+	if !p.IsSetStatus() {
+		return ListReviewsReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+func (p *ListReviewsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListReviewsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListReviewsReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListReviewsReq) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *ListReviewsReq) SetStatus(val *ReviewStatus) {
+	p.Status = val
+}
+func (p *ListReviewsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListReviewsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListReviewsReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListReviewsReq) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *ListReviewsReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListReviewsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListReviewsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListReviewsReq = map[int16]string{
+	1: "productId",
+	2: "userId",
+	3: "status",
+	4: "page",
+	5: "pageSize",
+}
+
+type ListReviewsResp struct {
+	Success  bool      `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32     `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  string    `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Total    int32     `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32     `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32     `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Reviews  []*Review `thrift:"reviews,7" frugal:"7,default,list<Review>" json:"reviews"`
+}
+
+func NewListReviewsResp() *ListReviewsResp {
+	return &ListReviewsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListReviewsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListReviewsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListReviewsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ListReviewsResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *ListReviewsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListReviewsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListReviewsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListReviewsResp) GetReviews() (v []*Review) {
+	return p.Reviews
+}
+func (p *ListReviewsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListReviewsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListReviewsResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *ListReviewsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListReviewsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListReviewsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListReviewsResp) SetReviews(val []*Review) {
+	p.Reviews = val
+}
+
+func (p *ListReviewsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListReviewsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListReviewsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "reviews",
+}
+
+type ModerateReviewReq struct {
+	Id         int64        `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Status     ReviewStatus `thrift:"status,2" frugal:"2,default,ReviewStatus" json:"status"`
+	OperatorId int64        `thrift:"operatorId,3" frugal:"3,default,i64" json:"operatorId"`
+}
+
+func NewModerateReviewReq() *ModerateReviewReq {
+	return &ModerateReviewReq{}
+}
+
+func (p *ModerateReviewReq) InitDefault() {
+}
+
+func (p *ModerateReviewReq) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ModerateReviewReq) GetStatus() (v ReviewStatus) {
+	return p.Status
+}
+
+func (p *ModerateReviewReq) GetOperatorId() (v int64) {
+	return p.OperatorId
+}
+func (p *ModerateReviewReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *ModerateReviewReq) SetStatus(val ReviewStatus) {
+	p.Status = val
+}
+func (p *ModerateReviewReq) SetOperatorId(val int64) {
+	p.OperatorId = val
+}
+
+func (p *ModerateReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerateReviewReq(%+v)", *p)
+}
+
+var fieldIDToName_ModerateReviewReq = map[int16]string{
+	1: "id",
+	2: "status",
+	3: "operatorId",
+}
+
+type ModerateReviewResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message string  `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Review  *Review `thrift:"review,4,optional" frugal:"4,optional,Review" json:"review,omitempty"`
+}
+
+func NewModerateReviewResp() *ModerateReviewResp {
+	return &ModerateReviewResp{
+		Code: 0,
+	}
+}
+
+func (p *ModerateReviewResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ModerateReviewResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ModerateReviewResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ModerateReviewResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var ModerateReviewResp_Review_DEFAULT *Review
+
+func (p *ModerateReviewResp) GetReview() (v *Review) {
+	if !p.IsSetReview() {
+		return ModerateReviewResp_Review_DEFAULT
+	}
+	return p.Review
+}
+func (p *ModerateReviewResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ModerateReviewResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ModerateReviewResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *ModerateReviewResp) SetReview(val *Review) {
+	p.Review = val
+}
+
+func (p *ModerateReviewResp) IsSetReview() bool {
+	return p.Review != nil
+}
+
+func (p *ModerateReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerateReviewResp(%+v)", *p)
+}
+
+var fieldIDToName_ModerateReviewResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "review",
+}
+
+type ReplyReviewReq struct {
+	Id         int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Reply      string `thrift:"reply,2" frugal:"2,default,string" json:"reply"`
+	OperatorId int64  `thrift:"operatorId,3" frugal:"3,default,i64" json:"operatorId"`
+}
+
+func NewReplyReviewReq() *ReplyReviewReq {
+	return &ReplyReviewReq{}
+}
+
+func (p *ReplyReviewReq) InitDefault() {
+}
+
+func (p *ReplyReviewReq) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ReplyReviewReq) GetReply() (v string) {
+	return p.Reply
+}
+
+func (p *ReplyReviewReq) GetOperatorId() (v int64) {
+	return p.OperatorId
+}
+func (p *ReplyReviewReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *ReplyReviewReq) SetReply(val string) {
+	p.Reply = val
+}
+func (p *ReplyReviewReq) SetOperatorId(val int64) {
+	p.OperatorId = val
+}
+
+func (p *ReplyReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplyReviewReq(%+v)", *p)
+}
+
+var fieldIDToName_ReplyReviewReq = map[int16]string{
+	1: "id",
+	2: "reply",
+	3: "operatorId",
+}
+
+type ReplyReviewResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message string  `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Review  *Review `thrift:"review,4,optional" frugal:"4,optional,Review" json:"review,omitempty"`
+}
+
+func NewReplyReviewResp() *ReplyReviewResp {
+	return &ReplyReviewResp{
+		Code: 0,
+	}
+}
+
+func (p *ReplyReviewResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ReplyReviewResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ReplyReviewResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ReplyReviewResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var ReplyReviewResp_Review_DEFAULT *Review
+
+func (p *ReplyReviewResp) GetReview() (v *Review) {
+	if !p.IsSetReview() {
+		return ReplyReviewResp_Review_DEFAULT
+	}
+	return p.Review
+}
+func (p *ReplyReviewResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ReplyReviewResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ReplyReviewResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *ReplyReviewResp) SetReview(val *Review) {
+	p.Review = val
+}
+
+func (p *ReplyReviewResp) IsSetReview() bool {
+	return p.Review != nil
+}
+
+func (p *ReplyReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplyReviewResp(%+v)", *p)
+}
+
+var fieldIDToName_ReplyReviewResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "review",
+}
+
+type FlashSale struct {
+	Id                int64           `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId         int64           `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	ProductName       string          `thrift:"productName,3" frugal:"3,default,string" json:"productName"`
+	FlashPrice        float64         `thrift:"flashPrice,4" frugal:"4,default,double" json:"flashPrice"`
+	OriginalPrice     float64         `thrift:"originalPrice,5" frugal:"5,default,double" json:"originalPrice"`
+	AllocatedQuantity int32           `thrift:"allocatedQuantity,6" frugal:"6,default,i32" json:"allocatedQuantity"`
+	SoldQuantity      int32           `thrift:"soldQuantity,7" frugal:"7,default,i32" json:"soldQuantity"`
+	RemainingQuantity int32           `thrift:"remainingQuantity,8" frugal:"8,default,i32" json:"remainingQuantity"`
+	PerUserLimit      int32           `thrift:"perUserLimit,9" frugal:"9,default,i32" json:"perUserLimit"`
+	StartAt           int64           `thrift:"startAt,10" frugal:"10,default,i64" json:"startAt"`
+	EndAt             int64           `thrift:"endAt,11" frugal:"11,default,i64" json:"endAt"`
+	Status            FlashSaleStatus `thrift:"status,12" frugal:"12,default,FlashSaleStatus" json:"status"`
+	ReturnedQuantity  int32           `thrift:"returnedQuantity,13" frugal:"13,default,i32" json:"returnedQuantity"`
+	ReconciledAt      *int64          `thrift:"reconciledAt,14,optional" frugal:"14,optional,i64" json:"reconciledAt,omitempty"`
+	CreatedAt         int64           `thrift:"createdAt,15" frugal:"15,default,i64" json:"createdAt"`
+}
+
+func NewFlashSale() *FlashSale {
+	return &FlashSale{}
+}
+
+func (p *FlashSale) InitDefault() {
+}
+
+func (p *FlashSale) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *FlashSale) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *FlashSale) GetProductName() (v string) {
+	return p.ProductName
+}
+
+func (p *FlashSale) GetFlashPrice() (v float64) {
+	return p.FlashPrice
+}
+
+func (p *FlashSale) GetOriginalPrice() (v float64) {
+	return p.OriginalPrice
+}
+
+func (p *FlashSale) GetAllocatedQuantity() (v int32) {
+	return p.AllocatedQuantity
+}
+
+func (p *FlashSale) GetSoldQuantity() (v int32) {
+	return p.SoldQuantity
+}
+
+func (p *FlashSale) GetRemainingQuantity() (v int32) {
+	return p.RemainingQuantity
+}
+
+func (p *FlashSale) GetPerUserLimit() (v int32) {
+	return p.PerUserLimit
+}
+
+func (p *FlashSale) GetStartAt() (v int64) {
+	return p.StartAt
+}
+
+func (p *FlashSale) GetEndAt() (v int64) {
+	return p.EndAt
+}
+
+func (p *FlashSale) GetStatus() (v FlashSaleStatus) {
+	return p.Status
+}
+
+func (p *FlashSale) GetReturnedQuantity() (v int32) {
+	return p.ReturnedQuantity
+}
+
+var FlashSale_ReconciledAt_DEFAULT int64
+
+func (p *FlashSale) GetReconciledAt() (v int64) {
+	if !p.IsSetReconciledAt() {
+		return FlashSale_ReconciledAt_DEFAULT
+	}
+	return *p.ReconciledAt
+}
+
+func (p *FlashSale) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *FlashSale) SetId(val int64) {
+	p.Id = val
+}
+func (p *FlashSale) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *FlashSale) SetProductName(val string) {
+	p.ProductName = val
+}
+func (p *FlashSale) SetFlashPrice(val float64) {
+	p.FlashPrice = val
+}
+func (p *FlashSale) SetOriginalPrice(val float64) {
+	p.OriginalPrice = val
+}
+func (p *FlashSale) SetAllocatedQuantity(val int32) {
+	p.AllocatedQuantity = val
+}
+func (p *FlashSale) SetSoldQuantity(val int32) {
+	p.SoldQuantity = val
+}
+func (p *FlashSale) SetRemainingQuantity(val int32) {
+	p.RemainingQuantity = val
+}
+func (p *FlashSale) SetPerUserLimit(val int32) {
+	p.PerUserLimit = val
+}
+func (p *FlashSale) SetStartAt(val int64) {
+	p.StartAt = val
+}
+func (p *FlashSale) SetEndAt(val int64) {
+	p.EndAt = val
+}
+func (p *FlashSale) SetStatus(val FlashSaleStatus) {
+	p.Status = val
+}
+func (p *FlashSale) SetReturnedQuantity(val int32) {
+	p.ReturnedQuantity = val
+}
+func (p *FlashSale) SetReconciledAt(val *int64) {
+	p.ReconciledAt = val
+}
+func (p *FlashSale) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *FlashSale) IsSetReconciledAt() bool {
+	return p.ReconciledAt != nil
+}
+
+func (p *FlashSale) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlashSale(%+v)", *p)
+}
+
+var fieldIDToName_FlashSale = map[int16]string{
+	1:  "id",
+	2:  "productId",
+	3:  "productName",
+	4:  "flashPrice",
+	5:  "originalPrice",
+	6:  "allocatedQuantity",
+	7:  "soldQuantity",
+	8:  "remainingQuantity",
+	9:  "perUserLimit",
+	10: "startAt",
+	11: "endAt",
+	12: "status",
+	13: "returnedQuantity",
+	14: "reconciledAt",
+	15: "createdAt",
+}
+
+type CreateFlashSaleReq struct {
+	ProductId         int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	FlashPrice        float64 `thrift:"flashPrice,2" frugal:"2,default,double" json:"flashPrice"`
+	AllocatedQuantity int32   `thrift:"allocatedQuantity,3" frugal:"3,default,i32" json:"allocatedQuantity"`
+	PerUserLimit      int32   `thrift:"perUserLimit,4" frugal:"4,default,i32" json:"perUserLimit"`
+	StartAt           int64   `thrift:"startAt,5" frugal:"5,default,i64" json:"startAt"`
+	EndAt             int64   `thrift:"endAt,6" frugal:"6,default,i64" json:"endAt"`
+}
+
+func NewCreateFlashSaleReq() *CreateFlashSaleReq {
+	return &CreateFlashSaleReq{}
+}
+
+func (p *CreateFlashSaleReq) InitDefault() {
+}
+
+func (p *CreateFlashSaleReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *CreateFlashSaleReq) GetFlashPrice() (v float64) {
+	return p.FlashPrice
+}
+
+func (p *CreateFlashSaleReq) GetAllocatedQuantity() (v int32) {
+	return p.AllocatedQuantity
+}
+
+func (p *CreateFlashSaleReq) GetPerUserLimit() (v int32) {
+	return p.PerUserLimit
+}
+
+func (p *CreateFlashSaleReq) GetStartAt() (v int64) {
+	return p.StartAt
+}
+
+func (p *CreateFlashSaleReq) GetEndAt() (v int64) {
+	return p.EndAt
+}
+func (p *CreateFlashSaleReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *CreateFlashSaleReq) SetFlashPrice(val float64) {
+	p.FlashPrice = val
+}
+func (p *CreateFlashSaleReq) SetAllocatedQuantity(val int32) {
+	p.AllocatedQuantity = val
+}
+func (p *CreateFlashSaleReq) SetPerUserLimit(val int32) {
+	p.PerUserLimit = val
+}
+func (p *CreateFlashSaleReq) SetStartAt(val int64) {
+	p.StartAt = val
+}
+func (p *CreateFlashSaleReq) SetEndAt(val int64) {
+	p.EndAt = val
+}
+
+func (p *CreateFlashSaleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateFlashSaleReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateFlashSaleReq = map[int16]string{
+	1: "productId",
+	2: "flashPrice",
+	3: "allocatedQuantity",
+	4: "perUserLimit",
+	5: "startAt",
+	6: "endAt",
+}
+
+type CreateFlashSaleResp struct {
+	Success   bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   string     `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	FlashSale *FlashSale `thrift:"flashSale,4,optional" frugal:"4,optional,FlashSale" json:"flashSale,omitempty"`
+}
+
+func NewCreateFlashSaleResp() *CreateFlashSaleResp {
+	return &CreateFlashSaleResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateFlashSaleResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateFlashSaleResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateFlashSaleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *CreateFlashSaleResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var CreateFlashSaleResp_FlashSale_DEFAULT *FlashSale
+
+func (p *CreateFlashSaleResp) GetFlashSale() (v *FlashSale) {
+	if !p.IsSetFlashSale() {
+		return CreateFlashSaleResp_FlashSale_DEFAULT
+	}
+	return p.FlashSale
+}
+func (p *CreateFlashSaleResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateFlashSaleResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateFlashSaleResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *CreateFlashSaleResp) SetFlashSale(val *FlashSale) {
+	p.FlashSale = val
+}
+
+func (p *CreateFlashSaleResp) IsSetFlashSale() bool {
+	return p.FlashSale != nil
+}
+
+func (p *CreateFlashSaleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateFlashSaleResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateFlashSaleResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "flashSale",
+}
+
+type GetFlashSaleReq struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewGetFlashSaleReq() *GetFlashSaleReq {
+	return &GetFlashSaleReq{}
+}
+
+func (p *GetFlashSaleReq) InitDefault() {
+}
+
+func (p *GetFlashSaleReq) GetId() (v int64) {
+	return p.Id
+}
+func (p *GetFlashSaleReq) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *GetFlashSaleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFlashSaleReq(%+v)", *p)
+}
+
+var fieldIDToName_GetFlashSaleReq = map[int16]string{
+	1: "id",
+}
+
+type GetFlashSaleResp struct {
+	Success   bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   string     `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	FlashSale *FlashSale `thrift:"flashSale,4,optional" frugal:"4,optional,FlashSale" json:"flashSale,omitempty"`
+}
+
+func NewGetFlashSaleResp() *GetFlashSaleResp {
+	return &GetFlashSaleResp{
+		Code: 0,
+	}
+}
+
+func (p *GetFlashSaleResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetFlashSaleResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetFlashSaleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *GetFlashSaleResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var GetFlashSaleResp_FlashSale_DEFAULT *FlashSale
+
+func (p *GetFlashSaleResp) GetFlashSale() (v *FlashSale) {
+	if !p.IsSetFlashSale() {
+		return GetFlashSaleResp_FlashSale_DEFAULT
+	}
+	return p.FlashSale
+}
+func (p *GetFlashSaleResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetFlashSaleResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetFlashSaleResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *GetFlashSaleResp) SetFlashSale(val *FlashSale) {
+	p.FlashSale = val
+}
+
+func (p *GetFlashSaleResp) IsSetFlashSale() bool {
+	return p.FlashSale != nil
+}
+
+func (p *GetFlashSaleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFlashSaleResp(%+v)", *p)
+}
+
+var fieldIDToName_GetFlashSaleResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "flashSale",
+}
+
+type ListFlashSalesReq struct {
+	ProductId    *int64 `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	IncludeEnded *bool  `thrift:"includeEnded,2,optional" frugal:"2,optional,bool" json:"includeEnded,omitempty"`
+	Page         int32  `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize     int32  `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewListFlashSalesReq() *ListFlashSalesReq {
+	return &ListFlashSalesReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListFlashSalesReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListFlashSalesReq_ProductId_DEFAULT int64
+
+func (p *ListFlashSalesReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListFlashSalesReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListFlashSalesReq_IncludeEnded_DEFAULT bool
+
+func (p *ListFlashSalesReq) GetIncludeEnded() (v bool) {
+	if !p.IsSetIncludeEnded() {
+		return ListFlashSalesReq_IncludeEnded_DEFAULT
+	}
+	return *p.IncludeEnded
+}
+
+func (p *ListFlashSalesReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListFlashSalesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListFlashSalesReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListFlashSalesReq) SetIncludeEnded(val *bool) {
+	p.IncludeEnded = val
+}
+func (p *ListFlashSalesReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListFlashSalesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListFlashSalesReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListFlashSalesReq) IsSetIncludeEnded() bool {
+	return p.IncludeEnded != nil
+}
+
+func (p *ListFlashSalesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFlashSalesReq(%+v)", *p)
+}
+
+var fieldIDToName_ListFlashSalesReq = map[int16]string{
+	1: "productId",
+	2: "includeEnded",
+	3: "page",
+	4: "pageSize",
+}
+
+type ListFlashSalesResp struct {
+	Success    bool         `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32        `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    string       `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	FlashSales []*FlashSale `thrift:"flashSales,4" frugal:"4,default,list<FlashSale>" json:"flashSales"`
+	Total      int32        `thrift:"total,5" frugal:"5,default,i32" json:"total"`
+	Page       int32        `thrift:"page,6" frugal:"6,default,i32" json:"page"`
+	PageSize   int32        `thrift:"pageSize,7" frugal:"7,default,i32" json:"pageSize"`
+}
+
+func NewListFlashSalesResp() *ListFlashSalesResp {
+	return &ListFlashSalesResp{
+		Code: 0,
+	}
+}
+
+func (p *ListFlashSalesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListFlashSalesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListFlashSalesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ListFlashSalesResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *ListFlashSalesResp) GetFlashSales() (v []*FlashSale) {
+	return p.FlashSales
+}
+
+func (p *ListFlashSalesResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListFlashSalesResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListFlashSalesResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListFlashSalesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListFlashSalesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListFlashSalesResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *ListFlashSalesResp) SetFlashSales(val []*FlashSale) {
+	p.FlashSales = val
+}
+func (p *ListFlashSalesResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListFlashSalesResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListFlashSalesResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListFlashSalesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFlashSalesResp(%+v)", *p)
+}
+
+var fieldIDToName_ListFlashSalesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "flashSales",
+	5: "total",
+	6: "page",
+	7: "pageSize",
+}
+
+type SubmitFlashSaleOrderReq struct {
+	FlashSaleId int64   `thrift:"flashSaleId,1" frugal:"1,default,i64" json:"flashSaleId"`
+	UserId      int64   `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	Quantity    int32   `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
+	Address     string  `thrift:"address,4" frugal:"4,default,string" json:"address"`
+	Phone       string  `thrift:"phone,5" frugal:"5,default,string" json:"phone"`
+	Receiver    *string `thrift:"receiver,6,optional" frugal:"6,optional,string" json:"receiver,omitempty"`
+}
+
+func NewSubmitFlashSaleOrderReq() *SubmitFlashSaleOrderReq {
+	return &SubmitFlashSaleOrderReq{}
+}
+
+func (p *SubmitFlashSaleOrderReq) InitDefault() {
+}
+
+func (p *SubmitFlashSaleOrderReq) GetFlashSaleId() (v int64) {
+	return p.FlashSaleId
+}
+
+func (p *SubmitFlashSaleOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SubmitFlashSaleOrderReq) GetQuantity() (v int32) {
+	return p.Quantity
+}
+
+func (p *SubmitFlashSaleOrderReq) GetAddress() (v string) {
+	return p.Address
+}
+
+func (p *SubmitFlashSaleOrderReq) GetPhone() (v string) {
+	return p.Phone
+}
+
+var SubmitFlashSaleOrderReq_Receiver_DEFAULT string
+
+func (p *SubmitFlashSaleOrderReq) GetReceiver() (v string) {
+	if !p.IsSetReceiver() {
+		return SubmitFlashSaleOrderReq_Receiver_DEFAULT
+	}
+	return *p.Receiver
+}
+func (p *SubmitFlashSaleOrderReq) SetFlashSaleId(val int64) {
+	p.FlashSaleId = val
+}
+func (p *SubmitFlashSaleOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SubmitFlashSaleOrderReq) SetQuantity(val int32) {
+	p.Quantity = val
+}
+func (p *SubmitFlashSaleOrderReq) SetAddress(val string) {
+	p.Address = val
+}
+func (p *SubmitFlashSaleOrderReq) SetPhone(val string) {
+	p.Phone = val
+}
+func (p *SubmitFlashSaleOrderReq) SetReceiver(val *string) {
+	p.Receiver = val
+}
+
+func (p *SubmitFlashSaleOrderReq) IsSetReceiver() bool {
+	return p.Receiver != nil
+}
+
+func (p *SubmitFlashSaleOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitFlashSaleOrderReq(%+v)", *p)
+}
+
+var fieldIDToName_SubmitFlashSaleOrderReq = map[int16]string{
+	1: "flashSaleId",
+	2: "userId",
+	3: "quantity",
+	4: "address",
+	5: "phone",
+	6: "receiver",
+}
+
+type SubmitFlashSaleOrderResp struct {
+	Success bool              `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32             `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message string            `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Request *FlashSaleRequest `thrift:"request,4,optional" frugal:"4,optional,FlashSaleRequest" json:"request,omitempty"`
+}
+
+func NewSubmitFlashSaleOrderResp() *SubmitFlashSaleOrderResp {
+	return &SubmitFlashSaleOrderResp{
+		Code: 0,
+	}
+}
+
+func (p *SubmitFlashSaleOrderResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SubmitFlashSaleOrderResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SubmitFlashSaleOrderResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *SubmitFlashSaleOrderResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var SubmitFlashSaleOrderResp_Request_DEFAULT *FlashSaleRequest
+
+func (p *SubmitFlashSaleOrderResp) GetRequest() (v *FlashSaleRequest) {
+	if !p.IsSetRequest() {
+		return SubmitFlashSaleOrderResp_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *SubmitFlashSaleOrderResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SubmitFlashSaleOrderResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SubmitFlashSaleOrderResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *SubmitFlashSaleOrderResp) SetRequest(val *FlashSaleRequest) {
+	p.Request = val
+}
+
+func (p *SubmitFlashSaleOrderResp) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SubmitFlashSaleOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitFlashSaleOrderResp(%+v)", *p)
+}
+
+var fieldIDToName_SubmitFlashSaleOrderResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "request",
+}
+
+type FlashSaleRequest struct {
+	RequestId   string                 `thrift:"requestId,1" frugal:"1,default,string" json:"requestId"`
+	FlashSaleId int64                  `thrift:"flashSaleId,2" frugal:"2,default,i64" json:"flashSaleId"`
+	Quantity    int32                  `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
+	Status      FlashSaleRequestStatus `thrift:"status,4" frugal:"4,default,FlashSaleRequestStatus" json:"status"`
+	OrderNo     *string                `thrift:"orderNo,5,optional" frugal:"5,optional,string" json:"orderNo,omitempty"`
+	FailReason  *string                `thrift:"failReason,6,optional" frugal:"6,optional,string" json:"failReason,omitempty"`
+	CreatedAt   int64                  `thrift:"createdAt,7" frugal:"7,default,i64" json:"createdAt"`
+}
+
+func NewFlashSaleRequest() *FlashSaleRequest {
+	return &FlashSaleRequest{}
+}
+
+func (p *FlashSaleRequest) InitDefault() {
+}
+
+func (p *FlashSaleRequest) GetRequestId() (v string) {
+	return p.RequestId
+}
+
+func (p *FlashSaleRequest) GetFlashSaleId() (v int64) {
+	return p.FlashSaleId
+}
+
+func (p *FlashSaleRequest) GetQuantity() (v int32) {
+	return p.Quantity
+}
+
+func (p *FlashSaleRequest) GetStatus() (v FlashSaleRequestStatus) {
+	return p.Status
+}
+
+var FlashSaleRequest_OrderNo_DEFAULT string
+
+func (p *FlashSaleRequest) GetOrderNo() (v string) {
+	if !p.IsSetOrderNo() {
+		return FlashSaleRequest_OrderNo_DEFAULT
+	}
+	return *p.OrderNo
+}
+
+var FlashSaleRequest_FailReason_DEFAULT string
+
+func (p *FlashSaleRequest) GetFailReason() (v string) {
+	if !p.IsSetFailReason() {
+		return FlashSaleRequest_FailReason_DEFAULT
+	}
+	return *p.FailReason
+}
+
+func (p *FlashSaleRequest) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *FlashSaleRequest) SetRequestId(val string) {
+	p.RequestId = val
+}
+func (p *FlashSaleRequest) SetFlashSaleId(val int64) {
+	p.FlashSaleId = val
+}
+func (p *FlashSaleRequest) SetQuantity(val int32) {
+	p.Quantity = val
+}
+func (p *FlashSaleRequest) SetStatus(val FlashSaleRequestStatus) {
+	p.Status = val
+}
+func (p *FlashSaleRequest) SetOrderNo(val *string) {
+	p.OrderNo = val
+}
+func (p *FlashSaleRequest) SetFailReason(val *string) {
+	p.FailReason = val
+}
+func (p *FlashSaleRequest) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *FlashSaleRequest) IsSetOrderNo() bool {
+	return p.OrderNo != nil
+}
+
+func (p *FlashSaleRequest) IsSetFailReason() bool {
+	return p.FailReason != nil
+}
+
+func (p *FlashSaleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlashSaleRequest(%+v)", *p)
+}
+
+var fieldIDToName_FlashSaleRequest = map[int16]string{
+	1: "requestId",
+	2: "flashSaleId",
+	3: "quantity",
+	4: "status",
+	5: "orderNo",
+	6: "failReason",
+	7: "createdAt",
+}
+
+type GetFlashSaleRequestReq struct {
+	RequestId string `thrift:"requestId,1" frugal:"1,default,string" json:"requestId"`
+	UserId    int64  `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+}
+
+func NewGetFlashSaleRequestReq() *GetFlashSaleRequestReq {
+	return &GetFlashSaleRequestReq{}
+}
+
+func (p *GetFlashSaleRequestReq) InitDefault() {
+}
+
+func (p *GetFlashSaleRequestReq) GetRequestId() (v string) {
+	return p.RequestId
+}
+
+func (p *GetFlashSaleRequestReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *GetFlashSaleRequestReq) SetRequestId(val string) {
+	p.RequestId = val
+}
+func (p *GetFlashSaleRequestReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *GetFlashSaleRequestReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFlashSaleRequestReq(%+v)", *p)
+}
+
+var fieldIDToName_GetFlashSaleRequestReq = map[int16]string{
+	1: "requestId",
+	2: "userId",
+}
+
+type GetFlashSaleRequestResp struct {
+	Success bool              `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32             `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message string            `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Request *FlashSaleRequest `thrift:"request,4,optional" frugal:"4,optional,FlashSaleRequest" json:"request,omitempty"`
+}
+
+func NewGetFlashSaleRequestResp() *GetFlashSaleRequestResp {
+	return &GetFlashSaleRequestResp{
+		Code: 0,
+	}
+}
+
+func (p *GetFlashSaleRequestResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetFlashSaleRequestResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetFlashSaleRequestResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *GetFlashSaleRequestResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var GetFlashSaleRequestResp_Request_DEFAULT *FlashSaleRequest
+
+func (p *GetFlashSaleRequestResp) GetRequest() (v *FlashSaleRequest) {
+	if !p.IsSetRequest() {
+		return GetFlashSaleRequestResp_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *GetFlashSaleRequestResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetFlashSaleRequestResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetFlashSaleRequestResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *GetFlashSaleRequestResp) SetRequest(val *FlashSaleRequest) {
+	p.Request = val
+}
+
+func (p *GetFlashSaleRequestResp) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *GetFlashSaleRequestResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFlashSaleRequestResp(%+v)", *p)
+}
+
+var fieldIDToName_GetFlashSaleRequestResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "request",
+}
+
+type ReconcileFlashSaleReq struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewReconcileFlashSaleReq() *ReconcileFlashSaleReq {
+	return &ReconcileFlashSaleReq{}
+}
+
+func (p *ReconcileFlashSaleReq) InitDefault() {
+}
+
+func (p *ReconcileFlashSaleReq) GetId() (v int64) {
+	return p.Id
+}
+func (p *ReconcileFlashSaleReq) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *ReconcileFlashSaleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReconcileFlashSaleReq(%+v)", *p)
+}
+
+var fieldIDToName_ReconcileFlashSaleReq = map[int16]string{
+	1: "id",
+}
+
+type FlashSaleReconciliation struct {
+	FlashSaleId       int64 `thrift:"flashSaleId,1" frugal:"1,default,i64" json:"flashSaleId"`
+	AllocatedQuantity int32 `thrift:"allocatedQuantity,2" frugal:"2,default,i32" json:"allocatedQuantity"`
+	SoldQuantity      int32 `thrift:"soldQuantity,3" frugal:"3,default,i32" json:"soldQuantity"`
+	ReturnedQuantity  int32 `thrift:"returnedQuantity,4" frugal:"4,default,i32" json:"returnedQuantity"`
+	RequestedQuantity int32 `thrift:"requestedQuantity,5" frugal:"5,default,i32" json:"requestedQuantity"`
+	Consistent        bool  `thrift:"consistent,6" frugal:"6,default,bool" json:"consistent"`
+	ReconciledAt      int64 `thrift:"reconciledAt,7" frugal:"7,default,i64" json:"reconciledAt"`
+}
+
+func NewFlashSaleReconciliation() *FlashSaleReconciliation {
+	return &FlashSaleReconciliation{}
+}
+
+func (p *FlashSaleReconciliation) InitDefault() {
+}
+
+func (p *FlashSaleReconciliation) GetFlashSaleId() (v int64) {
+	return p.FlashSaleId
+}
+
+func (p *FlashSaleReconciliation) GetAllocatedQuantity() (v int32) {
+	return p.AllocatedQuantity
+}
+
+func (p *FlashSaleReconciliation) GetSoldQuantity() (v int32) {
+	return p.SoldQuantity
+}
+
+func (p *FlashSaleReconciliation) GetReturnedQuantity() (v int32) {
+	return p.ReturnedQuantity
+}
+
+func (p *FlashSaleReconciliation) GetRequestedQuantity() (v int32) {
+	return p.RequestedQuantity
+}
+
+func (p *FlashSaleReconciliation) GetConsistent() (v bool) {
+	return p.Consistent
+}
+
+func (p *FlashSaleReconciliation) GetReconciledAt() (v int64) {
+	return p.ReconciledAt
+}
+func (p *FlashSaleReconciliation) SetFlashSaleId(val int64) {
+	p.FlashSaleId = val
+}
+func (p *FlashSaleReconciliation) SetAllocatedQuantity(val int32) {
+	p.AllocatedQuantity = val
+}
+func (p *FlashSaleReconciliation) SetSoldQuantity(val int32) {
+	p.SoldQuantity = val
+}
+func (p *FlashSaleReconciliation) SetReturnedQuantity(val int32) {
+	p.ReturnedQuantity = val
+}
+func (p *FlashSaleReconciliation) SetRequestedQuantity(val int32) {
+	p.RequestedQuantity = val
+}
+func (p *FlashSaleReconciliation) SetConsistent(val bool) {
+	p.Consistent = val
+}
+func (p *FlashSaleReconciliation) SetReconciledAt(val int64) {
+	p.ReconciledAt = val
+}
+
+func (p *FlashSaleReconciliation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlashSaleReconciliation(%+v)", *p)
+}
+
+var fieldIDToName_FlashSaleReconciliation = map[int16]string{
+	1: "flashSaleId",
+	2: "allocatedQuantity",
+	3: "soldQuantity",
+	4: "returnedQuantity",
+	5: "requestedQuantity",
+	6: "consistent",
+	7: "reconciledAt",
+}
+
+type ReconcileFlashSaleResp struct {
+	Success        bool                     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code           int32                    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message        string                   `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Reconciliation *FlashSaleReconciliation `thrift:"reconciliation,4,optional" frugal:"4,optional,FlashSaleReconciliation" json:"reconciliation,omitempty"`
+}
+
+func NewReconcileFlashSaleResp() *ReconcileFlashSaleResp {
+	return &ReconcileFlashSaleResp{
+		Code: 0,
+	}
+}
+
+func (p *ReconcileFlashSaleResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ReconcileFlashSaleResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ReconcileFlashSaleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ReconcileFlashSaleResp) GetMessage() (v string) {
+	return p.Message
+}
+
+var ReconcileFlashSaleResp_Reconciliation_DEFAULT *FlashSaleReconciliation
+
+func (p *ReconcileFlashSaleResp) GetReconciliation() (v *FlashSaleReconciliation) {
+	if !p.IsSetReconciliation() {
+		return ReconcileFlashSaleResp_Reconciliation_DEFAULT
+	}
+	return p.Reconciliation
+}
+func (p *ReconcileFlashSaleResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ReconcileFlashSaleResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ReconcileFlashSaleResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *ReconcileFlashSaleResp) SetReconciliation(val *FlashSaleReconciliation) {
+	p.Reconciliation = val
+}
+
+func (p *ReconcileFlashSaleResp) IsSetReconciliation() bool {
+	return p.Reconciliation != nil
+}
+
+func (p *ReconcileFlashSaleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReconcileFlashSaleResp(%+v)", *p)
+}
+
+var fieldIDToName_ReconcileFlashSaleResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "reconciliation",
+}
+
+type PurchaseAllowance struct {
+	ProductId  int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Limit      int32 `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+	WindowDays int32 `thrift:"windowDays,3" frugal:"3,default,i32" json:"windowDays"`
+	Purchased  int32 `thrift:"purchased,4" frugal:"4,default,i32" json:"purchased"`
+	Remaining  int32 `thrift:"remaining,5" frugal:"5,default,i32" json:"remaining"`
+}
+
+func NewPurchaseAllowance() *PurchaseAllowance {
+	return &PurchaseAllowance{}
+}
+
+func (p *PurchaseAllowance) InitDefault() {
+}
+
+func (p *PurchaseAllowance) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *PurchaseAllowance) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *PurchaseAllowance) GetWindowDays() (v int32) {
+	return p.WindowDays
+}
+
+func (p *PurchaseAllowance) GetPurchased() (v int32) {
+	return p.Purchased
+}
+
+func (p *PurchaseAllowance) GetRemaining() (v int32) {
+	return p.Remaining
+}
+func (p *PurchaseAllowance) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *PurchaseAllowance) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *PurchaseAllowance) SetWindowDays(val int32) {
+	p.WindowDays = val
+}
+func (p *PurchaseAllowance) SetPurchased(val int32) {
+	p.Purchased = val
+}
+func (p *PurchaseAllowance) SetRemaining(val int32) {
+	p.Remaining = val
+}
+
+func (p *PurchaseAllowance) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PurchaseAllowance(%+v)", *p)
+}
+
+var fieldIDToName_PurchaseAllowance = map[int16]string{
+	1: "productId",
+	2: "limit",
+	3: "windowDays",
+	4: "purchased",
+	5: "remaining",
+}
+
+type GetPurchaseAllowancesReq struct {
+	UserId     int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ProductIds []int64 `thrift:"productIds,2" frugal:"2,default,list<i64>" json:"productIds"`
+}
+
+func NewGetPurchaseAllowancesReq() *GetPurchaseAllowancesReq {
+	return &GetPurchaseAllowancesReq{}
+}
+
+func (p *GetPurchaseAllowancesReq) InitDefault() {
+}
+
+func (p *GetPurchaseAllowancesReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetPurchaseAllowancesReq) GetProductIds() (v []int64) {
+	return p.ProductIds
+}
+func (p *GetPurchaseAllowancesReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetPurchaseAllowancesReq) SetProductIds(val []int64) {
+	p.ProductIds = val
+}
+
+func (p *GetPurchaseAllowancesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPurchaseAllowancesReq(%+v)", *p)
+}
+
+var fieldIDToName_GetPurchaseAllowancesReq = map[int16]string{
+	1: "userId",
+	2: "productIds",
+}
+
+type GetPurchaseAllowancesResp struct {
+	Success    bool                 `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32                `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    string               `thrift:"message,3" frugal:"3,default,string" json:"message"`
+	Allowances []*PurchaseAllowance `thrift:"allowances,4" frugal:"4,default,list<PurchaseAllowance>" json:"allowances"`
+}
+
+func NewGetPurchaseAllowancesResp() *GetPurchaseAllowancesResp {
+	return &GetPurchaseAllowancesResp{
+		Code: 0,
+	}
+}
+
+func (p *GetPurchaseAllowancesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetPurchaseAllowancesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetPurchaseAllowancesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *GetPurchaseAllowancesResp) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *GetPurchaseAllowancesResp) GetAllowances() (v []*PurchaseAllowance) {
+	return p.Allowances
+}
+func (p *GetPurchaseAllowancesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetPurchaseAllowancesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetPurchaseAllowancesResp) SetMessage(val string) {
+	p.Message = val
+}
+func (p *GetPurchaseAllowancesResp) SetAllowances(val []*PurchaseAllowance) {
+	p.Allowances = val
+}
+
+func (p *GetPurchaseAllowancesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPurchaseAllowancesResp(%+v)", *p)
+}
+
+var fieldIDToName_GetPurchaseAllowancesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "allowances",
+}
+
+type OrderService interface {
+	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
+
+	PayOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+
+	CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
+
+	GetOrder(ctx context.Context, req *GetOrderReq) (r *GetOrderResp, err error)
+
+	ListOrders(ctx context.Context, req *ListOrdersReq) (r *ListOrdersResp, err error)
+
+	ApplyRefund(ctx context.Context, req *ApplyRefundReq) (r *ApplyRefundResp, err error)
+
+	ProcessRefund(ctx context.Context, req *ProcessRefundReq) (r *ProcessRefundResp, err error)
+
+	ReserveStock(ctx context.Context, req *ReserveStockReq) (r *ReserveStockResp, err error)
+
+	ReleaseStock(ctx context.Context, req *ReleaseStockReq) (r *ReleaseStockResp, err error)
+
+	ConfirmStock(ctx context.Context, req *ConfirmStockReq) (r *ConfirmStockResp, err error)
+
+	ProcessTimeout(ctx context.Context, req *ProcessTimeoutReq) (r *ProcessTimeoutResp, err error)
+
+	GetOrderStats(ctx context.Context, req *OrderStatsReq) (r *OrderStatsResp, err error)
+
+	UpdateOrderStatus(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
+
+	ShipOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+
+	ConfirmReceipt(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+
+	CreateReview(ctx context.Context, req *CreateReviewReq) (r *CreateReviewResp, err error)
+
+	ListReviews(ctx context.Context, req *ListReviewsReq) (r *ListReviewsResp, err error)
+
+	ModerateReview(ctx context.Context, req *ModerateReviewReq) (r *ModerateReviewResp, err error)
+
+	ReplyReview(ctx context.Context, req *ReplyReviewReq) (r *ReplyReviewResp, err error)
+
+	CreateFlashSale(ctx context.Context, req *CreateFlashSaleReq) (r *CreateFlashSaleResp, err error)
+
+	GetFlashSale(ctx context.Context, req *GetFlashSaleReq) (r *GetFlashSaleResp, err error)
+
+	ListFlashSales(ctx context.Context, req *ListFlashSalesReq) (r *ListFlashSalesResp, err error)
+
+	SubmitFlashSaleOrder(ctx context.Context, req *SubmitFlashSaleOrderReq) (r *SubmitFlashSaleOrderResp, err error)
+
+	GetFlashSaleRequest(ctx context.Context, req *GetFlashSaleRequestReq) (r *GetFlashSaleRequestResp, err error)
+
+	ReconcileFlashSale(ctx context.Context, req *ReconcileFlashSaleReq) (r *ReconcileFlashSaleResp, err error)
+
+	GetPurchaseAllowances(ctx context.Context, req *GetPurchaseAllowancesReq) (r *GetPurchaseAllowancesResp, err error)
+}
+
+type OrderServiceCreateOrderArgs struct {
+	Req *CreateOrderReq `thrift:"req,1" frugal:"1,default,CreateOrderReq" json:"req"`
+}
+
+func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
+	return &OrderServiceCreateOrderArgs{}
+}
+
+func (p *OrderServiceCreateOrderArgs) InitDefault() {
+}
+
+var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+
+func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCreateOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCreateOrderArgs) SetReq(val *CreateOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCreateOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceCreateOrderResult struct {
+	Success *CreateOrderResp `thrift:"success,0,optional" frugal:"0,optional,CreateOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
+	return &OrderServiceCreateOrderResult{}
+}
+
+func (p *OrderServiceCreateOrderResult) InitDefault() {
+}
+
+var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+
+func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCreateOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCreateOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateOrderResp)
+}
+
+func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCreateOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServicePayOrderArgs struct {
+	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
+}
+
+func NewOrderServicePayOrderArgs() *OrderServicePayOrderArgs {
+	return &OrderServicePayOrderArgs{}
+}
+
+func (p *OrderServicePayOrderArgs) InitDefault() {
+}
+
+var OrderServicePayOrderArgs_Req_DEFAULT *PayOrderReq
+
+func (p *OrderServicePayOrderArgs) GetReq() (v *PayOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServicePayOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServicePayOrderArgs) SetReq(val *PayOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServicePayOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServicePayOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServicePayOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServicePayOrderResult struct {
+	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServicePayOrderResult() *OrderServicePayOrderResult {
+	return &OrderServicePayOrderResult{}
+}
+
+func (p *OrderServicePayOrderResult) InitDefault() {
+}
+
+var OrderServicePayOrderResult_Success_DEFAULT *PayOrderResp
+
+func (p *OrderServicePayOrderResult) GetSuccess() (v *PayOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServicePayOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServicePayOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PayOrderResp)
+}
+
+func (p *OrderServicePayOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServicePayOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServicePayOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceCancelOrderArgs struct {
+	Req *CancelOrderReq `thrift:"req,1" frugal:"1,default,CancelOrderReq" json:"req"`
+}
+
+func NewOrderServiceCancelOrderArgs() *OrderServiceCancelOrderArgs {
+	return &OrderServiceCancelOrderArgs{}
+}
+
+func (p *OrderServiceCancelOrderArgs) InitDefault() {
+}
+
+var OrderServiceCancelOrderArgs_Req_DEFAULT *CancelOrderReq
+
+func (p *OrderServiceCancelOrderArgs) GetReq() (v *CancelOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCancelOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCancelOrderArgs) SetReq(val *CancelOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceCancelOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCancelOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCancelOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCancelOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceCancelOrderResult struct {
+	Success *CancelOrderResp `thrift:"success,0,optional" frugal:"0,optional,CancelOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceCancelOrderResult() *OrderServiceCancelOrderResult {
+	return &OrderServiceCancelOrderResult{}
+}
+
+func (p *OrderServiceCancelOrderResult) InitDefault() {
+}
+
+var OrderServiceCancelOrderResult_Success_DEFAULT *CancelOrderResp
+
+func (p *OrderServiceCancelOrderResult) GetSuccess() (v *CancelOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCancelOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCancelOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelOrderResp)
+}
+
+func (p *OrderServiceCancelOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCancelOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCancelOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCancelOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceGetOrderArgs struct {
+	Req *GetOrderReq `thrift:"req,1" frugal:"1,default,GetOrderReq" json:"req"`
+}
+
+func NewOrderServiceGetOrderArgs() *OrderServiceGetOrderArgs {
+	return &OrderServiceGetOrderArgs{}
+}
+
+func (p *OrderServiceGetOrderArgs) InitDefault() {
+}
+
+var OrderServiceGetOrderArgs_Req_DEFAULT *GetOrderReq
+
+func (p *OrderServiceGetOrderArgs) GetReq() (v *GetOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceGetOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceGetOrderArgs) SetReq(val *GetOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceGetOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceGetOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceGetOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceGetOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceGetOrderResult struct {
+	Success *GetOrderResp `thrift:"success,0,optional" frugal:"0,optional,GetOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceGetOrderResult() *OrderServiceGetOrderResult {
+	return &OrderServiceGetOrderResult{}
+}
+
+func (p *OrderServiceGetOrderResult) InitDefault() {
+}
+
+var OrderServiceGetOrderResult_Success_DEFAULT *GetOrderResp
+
+func (p *OrderServiceGetOrderResult) GetSuccess() (v *GetOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceGetOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceGetOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOrderResp)
+}
+
+func (p *OrderServiceGetOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceGetOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceGetOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceGetOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceListOrdersArgs struct {
+	Req *ListOrdersReq `thrift:"req,1" frugal:"1,default,ListOrdersReq" json:"req"`
+}
+
+func NewOrderServiceListOrdersArgs() *OrderServiceListOrdersArgs {
+	return &OrderServiceListOrdersArgs{}
+}
+
+func (p *OrderServiceListOrdersArgs) InitDefault() {
+}
+
+var OrderServiceListOrdersArgs_Req_DEFAULT *ListOrdersReq
+
+func (p *OrderServiceListOrdersArgs) GetReq() (v *ListOrdersReq) {
+	if !p.IsSetReq() {
+		return OrderServiceListOrdersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceListOrdersArgs) SetReq(val *ListOrdersReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceListOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceListOrdersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceListOrdersArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceListOrdersArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceListOrdersResult struct {
+	Success *ListOrdersResp `thrift:"success,0,optional" frugal:"0,optional,ListOrdersResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceListOrdersResult() *OrderServiceListOrdersResult {
+	return &OrderServiceListOrdersResult{}
+}
+
+func (p *OrderServiceListOrdersResult) InitDefault() {
+}
+
+var OrderServiceListOrdersResult_Success_DEFAULT *ListOrdersResp
+
+func (p *OrderServiceListOrdersResult) GetSuccess() (v *ListOrdersResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceListOrdersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceListOrdersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListOrdersResp)
+}
+
+func (p *OrderServiceListOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceListOrdersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceListOrdersResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceListOrdersResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceApplyRefundArgs struct {
+	Req *ApplyRefundReq `thrift:"req,1" frugal:"1,default,ApplyRefundReq" json:"req"`
+}
+
+func NewOrderServiceApplyRefundArgs() *OrderServiceApplyRefundArgs {
+	return &OrderServiceApplyRefundArgs{}
+}
+
+func (p *OrderServiceApplyRefundArgs) InitDefault() {
+}
+
+var OrderServiceApplyRefundArgs_Req_DEFAULT *ApplyRefundReq
+
+func (p *OrderServiceApplyRefundArgs) GetReq() (v *ApplyRefundReq) {
+	if !p.IsSetReq() {
+		return OrderServiceApplyRefundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceApplyRefundArgs) SetReq(val *ApplyRefundReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceApplyRefundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceApplyRefundArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceApplyRefundArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceApplyRefundArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceApplyRefundResult struct {
+	Success *ApplyRefundResp `thrift:"success,0,optional" frugal:"0,optional,ApplyRefundResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceApplyRefundResult() *OrderServiceApplyRefundResult {
+	return &OrderServiceApplyRefundResult{}
+}
+
+func (p *OrderServiceApplyRefundResult) InitDefault() {
+}
+
+var OrderServiceApplyRefundResult_Success_DEFAULT *ApplyRefundResp
+
+func (p *OrderServiceApplyRefundResult) GetSuccess() (v *ApplyRefundResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceApplyRefundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceApplyRefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ApplyRefundResp)
+}
+
+func (p *OrderServiceApplyRefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceApplyRefundResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceApplyRefundResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceApplyRefundResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceProcessRefundArgs struct {
+	Req *ProcessRefundReq `thrift:"req,1" frugal:"1,default,ProcessRefundReq" json:"req"`
+}
+
+func NewOrderServiceProcessRefundArgs() *OrderServiceProcessRefundArgs {
+	return &OrderServiceProcessRefundArgs{}
+}
+
+func (p *OrderServiceProcessRefundArgs) InitDefault() {
+}
+
+var OrderServiceProcessRefundArgs_Req_DEFAULT *ProcessRefundReq
+
+func (p *OrderServiceProcessRefundArgs) GetReq() (v *ProcessRefundReq) {
+	if !p.IsSetReq() {
+		return OrderServiceProcessRefundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceProcessRefundArgs) SetReq(val *ProcessRefundReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceProcessRefundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceProcessRefundArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceProcessRefundArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceProcessRefundArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceProcessRefundResult struct {
+	Success *ProcessRefundResp `thrift:"success,0,optional" frugal:"0,optional,ProcessRefundResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceProcessRefundResult() *OrderServiceProcessRefundResult {
+	return &OrderServiceProcessRefundResult{}
+}
+
+func (p *OrderServiceProcessRefundResult) InitDefault() {
+}
+
+var OrderServiceProcessRefundResult_Success_DEFAULT *ProcessRefundResp
+
+func (p *OrderServiceProcessRefundResult) GetSuccess() (v *ProcessRefundResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceProcessRefundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceProcessRefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ProcessRefundResp)
+}
+
+func (p *OrderServiceProcessRefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceProcessRefundResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceProcessRefundResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceProcessRefundResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceReserveStockArgs struct {
+	Req *ReserveStockReq `thrift:"req,1" frugal:"1,default,ReserveStockReq" json:"req"`
+}
+
+func NewOrderServiceReserveStockArgs() *OrderServiceReserveStockArgs {
+	return &OrderServiceReserveStockArgs{}
+}
+
+func (p *OrderServiceReserveStockArgs) InitDefault() {
+}
+
+var OrderServiceReserveStockArgs_Req_DEFAULT *ReserveStockReq
+
+func (p *OrderServiceReserveStockArgs) GetReq() (v *ReserveStockReq) {
+	if !p.IsSetReq() {
+		return OrderServiceReserveStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceReserveStockArgs) SetReq(val *ReserveStockReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceReserveStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceReserveStockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceReserveStockArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceReserveStockArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceReserveStockResult struct {
+	Success *ReserveStockResp `thrift:"success,0,optional" frugal:"0,optional,ReserveStockResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceReserveStockResult() *OrderServiceReserveStockResult {
+	return &OrderServiceReserveStockResult{}
+}
+
+func (p *OrderServiceReserveStockResult) InitDefault() {
+}
+
+var OrderServiceReserveStockResult_Success_DEFAULT *ReserveStockResp
 
-	ListOrders(ctx context.Context, req *ListOrdersReq) (r *ListOrdersResp, err error)
+func (p *OrderServiceReserveStockResult) GetSuccess() (v *ReserveStockResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceReserveStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceReserveStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReserveStockResp)
+}
 
-	ApplyRefund(ctx context.Context, req *ApplyRefundReq) (r *ApplyRefundResp, err error)
+func (p *OrderServiceReserveStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	ProcessRefund(ctx context.Context, req *ProcessRefundReq) (r *ProcessRefundResp, err error)
+func (p *OrderServiceReserveStockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceReserveStockResult(%+v)", *p)
+}
 
-	ReserveStock(ctx context.Context, req *ReserveStockReq) (r *ReserveStockResp, err error)
+var fieldIDToName_OrderServiceReserveStockResult = map[int16]string{
+	0: "success",
+}
 
-	ReleaseStock(ctx context.Context, req *ReleaseStockReq) (r *ReleaseStockResp, err error)
+type OrderServiceReleaseStockArgs struct {
+	Req *ReleaseStockReq `thrift:"req,1" frugal:"1,default,ReleaseStockReq" json:"req"`
+}
 
-	ConfirmStock(ctx context.Context, req *ConfirmStockReq) (r *ConfirmStockResp, err error)
+func NewOrderServiceReleaseStockArgs() *OrderServiceReleaseStockArgs {
+	return &OrderServiceReleaseStockArgs{}
+}
 
-	ProcessTimeout(ctx context.Context, req *ProcessTimeoutReq) (r *ProcessTimeoutResp, err error)
+func (p *OrderServiceReleaseStockArgs) InitDefault() {
+}
 
-	GetOrderStats(ctx context.Context, req *OrderStatsReq) (r *OrderStatsResp, err error)
+var OrderServiceReleaseStockArgs_Req_DEFAULT *ReleaseStockReq
 
-	UpdateOrderStatus(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
+func (p *OrderServiceReleaseStockArgs) GetReq() (v *ReleaseStockReq) {
+	if !p.IsSetReq() {
+		return OrderServiceReleaseStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceReleaseStockArgs) SetReq(val *ReleaseStockReq) {
+	p.Req = val
+}
 
-	ShipOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+func (p *OrderServiceReleaseStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	ConfirmReceipt(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+func (p *OrderServiceReleaseStockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceReleaseStockArgs(%+v)", *p)
 }
 
-type OrderServiceCreateOrderArgs struct {
-	Req *CreateOrderReq `thrift:"req,1" frugal:"1,default,CreateOrderReq" json:"req"`
+var fieldIDToName_OrderServiceReleaseStockArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceReleaseStockResult struct {
+	Success *ReleaseStockResp `thrift:"success,0,optional" frugal:"0,optional,ReleaseStockResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceReleaseStockResult() *OrderServiceReleaseStockResult {
+	return &OrderServiceReleaseStockResult{}
+}
+
+func (p *OrderServiceReleaseStockResult) InitDefault() {
+}
+
+var OrderServiceReleaseStockResult_Success_DEFAULT *ReleaseStockResp
+
+func (p *OrderServiceReleaseStockResult) GetSuccess() (v *ReleaseStockResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceReleaseStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceReleaseStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReleaseStockResp)
+}
+
+func (p *OrderServiceReleaseStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceReleaseStockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceReleaseStockResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceReleaseStockResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceConfirmStockArgs struct {
+	Req *ConfirmStockReq `thrift:"req,1" frugal:"1,default,ConfirmStockReq" json:"req"`
+}
+
+func NewOrderServiceConfirmStockArgs() *OrderServiceConfirmStockArgs {
+	return &OrderServiceConfirmStockArgs{}
+}
+
+func (p *OrderServiceConfirmStockArgs) InitDefault() {
+}
+
+var OrderServiceConfirmStockArgs_Req_DEFAULT *ConfirmStockReq
+
+func (p *OrderServiceConfirmStockArgs) GetReq() (v *ConfirmStockReq) {
+	if !p.IsSetReq() {
+		return OrderServiceConfirmStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceConfirmStockArgs) SetReq(val *ConfirmStockReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceConfirmStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceConfirmStockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceConfirmStockArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceConfirmStockArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceConfirmStockResult struct {
+	Success *ConfirmStockResp `thrift:"success,0,optional" frugal:"0,optional,ConfirmStockResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceConfirmStockResult() *OrderServiceConfirmStockResult {
+	return &OrderServiceConfirmStockResult{}
+}
+
+func (p *OrderServiceConfirmStockResult) InitDefault() {
+}
+
+var OrderServiceConfirmStockResult_Success_DEFAULT *ConfirmStockResp
+
+func (p *OrderServiceConfirmStockResult) GetSuccess() (v *ConfirmStockResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceConfirmStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceConfirmStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ConfirmStockResp)
+}
+
+func (p *OrderServiceConfirmStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceConfirmStockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceConfirmStockResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceConfirmStockResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceProcessTimeoutArgs struct {
+	Req *ProcessTimeoutReq `thrift:"req,1" frugal:"1,default,ProcessTimeoutReq" json:"req"`
+}
+
+func NewOrderServiceProcessTimeoutArgs() *OrderServiceProcessTimeoutArgs {
+	return &OrderServiceProcessTimeoutArgs{}
+}
+
+func (p *OrderServiceProcessTimeoutArgs) InitDefault() {
+}
+
+var OrderServiceProcessTimeoutArgs_Req_DEFAULT *ProcessTimeoutReq
+
+func (p *OrderServiceProcessTimeoutArgs) GetReq() (v *ProcessTimeoutReq) {
+	if !p.IsSetReq() {
+		return OrderServiceProcessTimeoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceProcessTimeoutArgs) SetReq(val *ProcessTimeoutReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceProcessTimeoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceProcessTimeoutArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceProcessTimeoutArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceProcessTimeoutArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceProcessTimeoutResult struct {
+	Success *ProcessTimeoutResp `thrift:"success,0,optional" frugal:"0,optional,ProcessTimeoutResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceProcessTimeoutResult() *OrderServiceProcessTimeoutResult {
+	return &OrderServiceProcessTimeoutResult{}
+}
+
+func (p *OrderServiceProcessTimeoutResult) InitDefault() {
+}
+
+var OrderServiceProcessTimeoutResult_Success_DEFAULT *ProcessTimeoutResp
+
+func (p *OrderServiceProcessTimeoutResult) GetSuccess() (v *ProcessTimeoutResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceProcessTimeoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceProcessTimeoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ProcessTimeoutResp)
+}
+
+func (p *OrderServiceProcessTimeoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceProcessTimeoutResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceProcessTimeoutResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceProcessTimeoutResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceGetOrderStatsArgs struct {
+	Req *OrderStatsReq `thrift:"req,1" frugal:"1,default,OrderStatsReq" json:"req"`
 }
 
-func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
-	return &OrderServiceCreateOrderArgs{}
+func NewOrderServiceGetOrderStatsArgs() *OrderServiceGetOrderStatsArgs {
+	return &OrderServiceGetOrderStatsArgs{}
 }
 
-func (p *OrderServiceCreateOrderArgs) InitDefault() {
+func (p *OrderServiceGetOrderStatsArgs) InitDefault() {
 }
 
-var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+var OrderServiceGetOrderStatsArgs_Req_DEFAULT *OrderStatsReq
 
-func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+func (p *OrderServiceGetOrderStatsArgs) GetReq() (v *OrderStatsReq) {
 	if !p.IsSetReq() {
-		return OrderServiceCreateOrderArgs_Req_DEFAULT
+		return OrderServiceGetOrderStatsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceCreateOrderArgs) SetReq(val *CreateOrderReq) {
+func (p *OrderServiceGetOrderStatsArgs) SetReq(val *OrderStatsReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+func (p *OrderServiceGetOrderStatsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceCreateOrderArgs) String() string {
+func (p *OrderServiceGetOrderStatsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetOrderStatsArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceGetOrderStatsArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceCreateOrderResult struct {
-	Success *CreateOrderResp `thrift:"success,0,optional" frugal:"0,optional,CreateOrderResp" json:"success,omitempty"`
+type OrderServiceGetOrderStatsResult struct {
+	Success *OrderStatsResp `thrift:"success,0,optional" frugal:"0,optional,OrderStatsResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
-	return &OrderServiceCreateOrderResult{}
+func NewOrderServiceGetOrderStatsResult() *OrderServiceGetOrderStatsResult {
+	return &OrderServiceGetOrderStatsResult{}
 }
 
-func (p *OrderServiceCreateOrderResult) InitDefault() {
+func (p *OrderServiceGetOrderStatsResult) InitDefault() {
 }
 
-var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+var OrderServiceGetOrderStatsResult_Success_DEFAULT *OrderStatsResp
 
-func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+func (p *OrderServiceGetOrderStatsResult) GetSuccess() (v *OrderStatsResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceCreateOrderResult_Success_DEFAULT
+		return OrderServiceGetOrderStatsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceCreateOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateOrderResp)
+func (p *OrderServiceGetOrderStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*OrderStatsResp)
 }
 
-func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceGetOrderStatsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceCreateOrderResult) String() string {
+func (p *OrderServiceGetOrderStatsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetOrderStatsResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceGetOrderStatsResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServicePayOrderArgs struct {
-	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
+type OrderServiceUpdateOrderStatusArgs struct {
+	Req *CancelOrderReq `thrift:"req,1" frugal:"1,default,CancelOrderReq" json:"req"`
 }
 
-func NewOrderServicePayOrderArgs() *OrderServicePayOrderArgs {
-	return &OrderServicePayOrderArgs{}
+func NewOrderServiceUpdateOrderStatusArgs() *OrderServiceUpdateOrderStatusArgs {
+	return &OrderServiceUpdateOrderStatusArgs{}
 }
 
-func (p *OrderServicePayOrderArgs) InitDefault() {
+func (p *OrderServiceUpdateOrderStatusArgs) InitDefault() {
 }
 
-var OrderServicePayOrderArgs_Req_DEFAULT *PayOrderReq
+var OrderServiceUpdateOrderStatusArgs_Req_DEFAULT *CancelOrderReq
 
-func (p *OrderServicePayOrderArgs) GetReq() (v *PayOrderReq) {
+func (p *OrderServiceUpdateOrderStatusArgs) GetReq() (v *CancelOrderReq) {
 	if !p.IsSetReq() {
-		return OrderServicePayOrderArgs_Req_DEFAULT
+		return OrderServiceUpdateOrderStatusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServicePayOrderArgs) SetReq(val *PayOrderReq) {
+func (p *OrderServiceUpdateOrderStatusArgs) SetReq(val *CancelOrderReq) {
 	p.Req = val
 }
 
-func (p *OrderServicePayOrderArgs) IsSetReq() bool {
+func (p *OrderServiceUpdateOrderStatusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServicePayOrderArgs) String() string {
+func (p *OrderServiceUpdateOrderStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServicePayOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceUpdateOrderStatusArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServicePayOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceUpdateOrderStatusArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServicePayOrderResult struct {
-	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
+type OrderServiceUpdateOrderStatusResult struct {
+	Success *CancelOrderResp `thrift:"success,0,optional" frugal:"0,optional,CancelOrderResp" json:"success,omitempty"`
 }
 
-func NewOrderServicePayOrderResult() *OrderServicePayOrderResult {
-	return &OrderServicePayOrderResult{}
+func NewOrderServiceUpdateOrderStatusResult() *OrderServiceUpdateOrderStatusResult {
+	return &OrderServiceUpdateOrderStatusResult{}
 }
 
-func (p *OrderServicePayOrderResult) InitDefault() {
+func (p *OrderServiceUpdateOrderStatusResult) InitDefault() {
 }
 
-var OrderServicePayOrderResult_Success_DEFAULT *PayOrderResp
+var OrderServiceUpdateOrderStatusResult_Success_DEFAULT *CancelOrderResp
 
-func (p *OrderServicePayOrderResult) GetSuccess() (v *PayOrderResp) {
+func (p *OrderServiceUpdateOrderStatusResult) GetSuccess() (v *CancelOrderResp) {
 	if !p.IsSetSuccess() {
-		return OrderServicePayOrderResult_Success_DEFAULT
+		return OrderServiceUpdateOrderStatusResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServicePayOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PayOrderResp)
+func (p *OrderServiceUpdateOrderStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelOrderResp)
 }
 
-func (p *OrderServicePayOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceUpdateOrderStatusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServicePayOrderResult) String() string {
+func (p *OrderServiceUpdateOrderStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServicePayOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceUpdateOrderStatusResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServicePayOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceUpdateOrderStatusResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceCancelOrderArgs struct {
-	Req *CancelOrderReq `thrift:"req,1" frugal:"1,default,CancelOrderReq" json:"req"`
+type OrderServiceShipOrderArgs struct {
+	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
 }
 
-func NewOrderServiceCancelOrderArgs() *OrderServiceCancelOrderArgs {
-	return &OrderServiceCancelOrderArgs{}
+func NewOrderServiceShipOrderArgs() *OrderServiceShipOrderArgs {
+	return &OrderServiceShipOrderArgs{}
 }
 
-func (p *OrderServiceCancelOrderArgs) InitDefault() {
+func (p *OrderServiceShipOrderArgs) InitDefault() {
 }
 
-var OrderServiceCancelOrderArgs_Req_DEFAULT *CancelOrderReq
+var OrderServiceShipOrderArgs_Req_DEFAULT *PayOrderReq
 
-func (p *OrderServiceCancelOrderArgs) GetReq() (v *CancelOrderReq) {
+func (p *OrderServiceShipOrderArgs) GetReq() (v *PayOrderReq) {
 	if !p.IsSetReq() {
-		return OrderServiceCancelOrderArgs_Req_DEFAULT
+		return OrderServiceShipOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceCancelOrderArgs) SetReq(val *CancelOrderReq) {
+func (p *OrderServiceShipOrderArgs) SetReq(val *PayOrderReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceCancelOrderArgs) IsSetReq() bool {
+func (p *OrderServiceShipOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceCancelOrderArgs) String() string {
+func (p *OrderServiceShipOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCancelOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceShipOrderArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceCancelOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceShipOrderArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceCancelOrderResult struct {
-	Success *CancelOrderResp `thrift:"success,0,optional" frugal:"0,optional,CancelOrderResp" json:"success,omitempty"`
+type OrderServiceShipOrderResult struct {
+	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceCancelOrderResult() *OrderServiceCancelOrderResult {
-	return &OrderServiceCancelOrderResult{}
+func NewOrderServiceShipOrderResult() *OrderServiceShipOrderResult {
+	return &OrderServiceShipOrderResult{}
 }
 
-func (p *OrderServiceCancelOrderResult) InitDefault() {
+func (p *OrderServiceShipOrderResult) InitDefault() {
 }
 
-var OrderServiceCancelOrderResult_Success_DEFAULT *CancelOrderResp
+var OrderServiceShipOrderResult_Success_DEFAULT *PayOrderResp
 
-func (p *OrderServiceCancelOrderResult) GetSuccess() (v *CancelOrderResp) {
+func (p *OrderServiceShipOrderResult) GetSuccess() (v *PayOrderResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceCancelOrderResult_Success_DEFAULT
+		return OrderServiceShipOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceCancelOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CancelOrderResp)
+func (p *OrderServiceShipOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PayOrderResp)
 }
 
-func (p *OrderServiceCancelOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceShipOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceCancelOrderResult) String() string {
+func (p *OrderServiceShipOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCancelOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceShipOrderResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceCancelOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceShipOrderResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceGetOrderArgs struct {
-	Req *GetOrderReq `thrift:"req,1" frugal:"1,default,GetOrderReq" json:"req"`
+type OrderServiceConfirmReceiptArgs struct {
+	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
 }
 
-func NewOrderServiceGetOrderArgs() *OrderServiceGetOrderArgs {
-	return &OrderServiceGetOrderArgs{}
+func NewOrderServiceConfirmReceiptArgs() *OrderServiceConfirmReceiptArgs {
+	return &OrderServiceConfirmReceiptArgs{}
 }
 
-func (p *OrderServiceGetOrderArgs) InitDefault() {
+func (p *OrderServiceConfirmReceiptArgs) InitDefault() {
 }
 
-var OrderServiceGetOrderArgs_Req_DEFAULT *GetOrderReq
+var OrderServiceConfirmReceiptArgs_Req_DEFAULT *PayOrderReq
 
-func (p *OrderServiceGetOrderArgs) GetReq() (v *GetOrderReq) {
+func (p *OrderServiceConfirmReceiptArgs) GetReq() (v *PayOrderReq) {
 	if !p.IsSetReq() {
-		return OrderServiceGetOrderArgs_Req_DEFAULT
+		return OrderServiceConfirmReceiptArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceGetOrderArgs) SetReq(val *GetOrderReq) {
+func (p *OrderServiceConfirmReceiptArgs) SetReq(val *PayOrderReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceGetOrderArgs) IsSetReq() bool {
+func (p *OrderServiceConfirmReceiptArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceGetOrderArgs) String() string {
+func (p *OrderServiceConfirmReceiptArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceGetOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceConfirmReceiptArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceGetOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceConfirmReceiptArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceGetOrderResult struct {
-	Success *GetOrderResp `thrift:"success,0,optional" frugal:"0,optional,GetOrderResp" json:"success,omitempty"`
+type OrderServiceConfirmReceiptResult struct {
+	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceGetOrderResult() *OrderServiceGetOrderResult {
-	return &OrderServiceGetOrderResult{}
+func NewOrderServiceConfirmReceiptResult() *OrderServiceConfirmReceiptResult {
+	return &OrderServiceConfirmReceiptResult{}
 }
 
-func (p *OrderServiceGetOrderResult) InitDefault() {
+func (p *OrderServiceConfirmReceiptResult) InitDefault() {
 }
 
-var OrderServiceGetOrderResult_Success_DEFAULT *GetOrderResp
+var OrderServiceConfirmReceiptResult_Success_DEFAULT *PayOrderResp
 
-func (p *OrderServiceGetOrderResult) GetSuccess() (v *GetOrderResp) {
+func (p *OrderServiceConfirmReceiptResult) GetSuccess() (v *PayOrderResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceGetOrderResult_Success_DEFAULT
+		return OrderServiceConfirmReceiptResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceGetOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetOrderResp)
+func (p *OrderServiceConfirmReceiptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PayOrderResp)
 }
 
-func (p *OrderServiceGetOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceConfirmReceiptResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceGetOrderResult) String() string {
+func (p *OrderServiceConfirmReceiptResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceGetOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceConfirmReceiptResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceGetOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceConfirmReceiptResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceListOrdersArgs struct {
-	Req *ListOrdersReq `thrift:"req,1" frugal:"1,default,ListOrdersReq" json:"req"`
+type OrderServiceCreateReviewArgs struct {
+	Req *CreateReviewReq `thrift:"req,1" frugal:"1,default,CreateReviewReq" json:"req"`
 }
 
-func NewOrderServiceListOrdersArgs() *OrderServiceListOrdersArgs {
-	return &OrderServiceListOrdersArgs{}
+func NewOrderServiceCreateReviewArgs() *OrderServiceCreateReviewArgs {
+	return &OrderServiceCreateReviewArgs{}
 }
 
-func (p *OrderServiceListOrdersArgs) InitDefault() {
+func (p *OrderServiceCreateReviewArgs) InitDefault() {
 }
 
-var OrderServiceListOrdersArgs_Req_DEFAULT *ListOrdersReq
+var OrderServiceCreateReviewArgs_Req_DEFAULT *CreateReviewReq
 
-func (p *OrderServiceListOrdersArgs) GetReq() (v *ListOrdersReq) {
+func (p *OrderServiceCreateReviewArgs) GetReq() (v *CreateReviewReq) {
 	if !p.IsSetReq() {
-		return OrderServiceListOrdersArgs_Req_DEFAULT
+		return OrderServiceCreateReviewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceListOrdersArgs) SetReq(val *ListOrdersReq) {
+func (p *OrderServiceCreateReviewArgs) SetReq(val *CreateReviewReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceListOrdersArgs) IsSetReq() bool {
+func (p *OrderServiceCreateReviewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceListOrdersArgs) String() string {
+func (p *OrderServiceCreateReviewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceListOrdersArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCreateReviewArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceListOrdersArgs = map[int16]string{
+var fieldIDToName_OrderServiceCreateReviewArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceListOrdersResult struct {
-	Success *ListOrdersResp `thrift:"success,0,optional" frugal:"0,optional,ListOrdersResp" json:"success,omitempty"`
+type OrderServiceCreateReviewResult struct {
+	Success *CreateReviewResp `thrift:"success,0,optional" frugal:"0,optional,CreateReviewResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceListOrdersResult() *OrderServiceListOrdersResult {
-	return &OrderServiceListOrdersResult{}
+func NewOrderServiceCreateReviewResult() *OrderServiceCreateReviewResult {
+	return &OrderServiceCreateReviewResult{}
 }
 
-func (p *OrderServiceListOrdersResult) InitDefault() {
+func (p *OrderServiceCreateReviewResult) InitDefault() {
 }
 
-var OrderServiceListOrdersResult_Success_DEFAULT *ListOrdersResp
+var OrderServiceCreateReviewResult_Success_DEFAULT *CreateReviewResp
 
-func (p *OrderServiceListOrdersResult) GetSuccess() (v *ListOrdersResp) {
+func (p *OrderServiceCreateReviewResult) GetSuccess() (v *CreateReviewResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceListOrdersResult_Success_DEFAULT
+		return OrderServiceCreateReviewResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceListOrdersResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListOrdersResp)
+func (p *OrderServiceCreateReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateReviewResp)
 }
 
-func (p *OrderServiceListOrdersResult) IsSetSuccess() bool {
+func (p *OrderServiceCreateReviewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceListOrdersResult) String() string {
+func (p *OrderServiceCreateReviewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceListOrdersResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCreateReviewResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceListOrdersResult = map[int16]string{
+var fieldIDToName_OrderServiceCreateReviewResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceApplyRefundArgs struct {
-	Req *ApplyRefundReq `thrift:"req,1" frugal:"1,default,ApplyRefundReq" json:"req"`
+type OrderServiceListReviewsArgs struct {
+	Req *ListReviewsReq `thrift:"req,1" frugal:"1,default,ListReviewsReq" json:"req"`
 }
 
-func NewOrderServiceApplyRefundArgs() *OrderServiceApplyRefundArgs {
-	return &OrderServiceApplyRefundArgs{}
+func NewOrderServiceListReviewsArgs() *OrderServiceListReviewsArgs {
+	return &OrderServiceListReviewsArgs{}
 }
 
-func (p *OrderServiceApplyRefundArgs) InitDefault() {
+func (p *OrderServiceListReviewsArgs) InitDefault() {
 }
 
-var OrderServiceApplyRefundArgs_Req_DEFAULT *ApplyRefundReq
+var OrderServiceListReviewsArgs_Req_DEFAULT *ListReviewsReq
 
-func (p *OrderServiceApplyRefundArgs) GetReq() (v *ApplyRefundReq) {
+func (p *OrderServiceListReviewsArgs) GetReq() (v *ListReviewsReq) {
 	if !p.IsSetReq() {
-		return OrderServiceApplyRefundArgs_Req_DEFAULT
+		return OrderServiceListReviewsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceApplyRefundArgs) SetReq(val *ApplyRefundReq) {
+func (p *OrderServiceListReviewsArgs) SetReq(val *ListReviewsReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceApplyRefundArgs) IsSetReq() bool {
+func (p *OrderServiceListReviewsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceApplyRefundArgs) String() string {
+func (p *OrderServiceListReviewsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceApplyRefundArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceListReviewsArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceApplyRefundArgs = map[int16]string{
+var fieldIDToName_OrderServiceListReviewsArgs = map[int16]string{
 	1: "req",
 }
-
-type OrderServiceApplyRefundResult struct {
-	Success *ApplyRefundResp `thrift:"success,0,optional" frugal:"0,optional,ApplyRefundResp" json:"success,omitempty"`
+
+type OrderServiceListReviewsResult struct {
+	Success *ListReviewsResp `thrift:"success,0,optional" frugal:"0,optional,ListReviewsResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceApplyRefundResult() *OrderServiceApplyRefundResult {
-	return &OrderServiceApplyRefundResult{}
+func NewOrderServiceListReviewsResult() *OrderServiceListReviewsResult {
+	return &OrderServiceListReviewsResult{}
 }
 
-func (p *OrderServiceApplyRefundResult) InitDefault() {
+func (p *OrderServiceListReviewsResult) InitDefault() {
 }
 
-var OrderServiceApplyRefundResult_Success_DEFAULT *ApplyRefundResp
+var OrderServiceListReviewsResult_Success_DEFAULT *ListReviewsResp
 
-func (p *OrderServiceApplyRefundResult) GetSuccess() (v *ApplyRefundResp) {
+func (p *OrderServiceListReviewsResult) GetSuccess() (v *ListReviewsResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceApplyRefundResult_Success_DEFAULT
+		return OrderServiceListReviewsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceApplyRefundResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ApplyRefundResp)
+func (p *OrderServiceListReviewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListReviewsResp)
 }
 
-func (p *OrderServiceApplyRefundResult) IsSetSuccess() bool {
+func (p *OrderServiceListReviewsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceApplyRefundResult) String() string {
+func (p *OrderServiceListReviewsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceApplyRefundResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceListReviewsResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceApplyRefundResult = map[int16]string{
+var fieldIDToName_OrderServiceListReviewsResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceProcessRefundArgs struct {
-	Req *ProcessRefundReq `thrift:"req,1" frugal:"1,default,ProcessRefundReq" json:"req"`
+type OrderServiceModerateReviewArgs struct {
+	Req *ModerateReviewReq `thrift:"req,1" frugal:"1,default,ModerateReviewReq" json:"req"`
 }
 
-func NewOrderServiceProcessRefundArgs() *OrderServiceProcessRefundArgs {
-	return &OrderServiceProcessRefundArgs{}
+func NewOrderServiceModerateReviewArgs() *OrderServiceModerateReviewArgs {
+	return &OrderServiceModerateReviewArgs{}
 }
 
-func (p *OrderServiceProcessRefundArgs) InitDefault() {
+func (p *OrderServiceModerateReviewArgs) InitDefault() {
 }
 
-var OrderServiceProcessRefundArgs_Req_DEFAULT *ProcessRefundReq
+var OrderServiceModerateReviewArgs_Req_DEFAULT *ModerateReviewReq
 
-func (p *OrderServiceProcessRefundArgs) GetReq() (v *ProcessRefundReq) {
+func (p *OrderServiceModerateReviewArgs) GetReq() (v *ModerateReviewReq) {
 	if !p.IsSetReq() {
-		return OrderServiceProcessRefundArgs_Req_DEFAULT
+		return OrderServiceModerateReviewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceProcessRefundArgs) SetReq(val *ProcessRefundReq) {
+func (p *OrderServiceModerateReviewArgs) SetReq(val *ModerateReviewReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceProcessRefundArgs) IsSetReq() bool {
+func (p *OrderServiceModerateReviewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceProcessRefundArgs) String() string {
+func (p *OrderServiceModerateReviewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceProcessRefundArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceModerateReviewArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceProcessRefundArgs = map[int16]string{
+var fieldIDToName_OrderServiceModerateReviewArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceProcessRefundResult struct {
-	Success *ProcessRefundResp `thrift:"success,0,optional" frugal:"0,optional,ProcessRefundResp" json:"success,omitempty"`
+type OrderServiceModerateReviewResult struct {
+	Success *ModerateReviewResp `thrift:"success,0,optional" frugal:"0,optional,ModerateReviewResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceProcessRefundResult() *OrderServiceProcessRefundResult {
-	return &OrderServiceProcessRefundResult{}
+func NewOrderServiceModerateReviewResult() *OrderServiceModerateReviewResult {
+	return &OrderServiceModerateReviewResult{}
 }
 
-func (p *OrderServiceProcessRefundResult) InitDefault() {
+func (p *OrderServiceModerateReviewResult) InitDefault() {
 }
 
-var OrderServiceProcessRefundResult_Success_DEFAULT *ProcessRefundResp
+var OrderServiceModerateReviewResult_Success_DEFAULT *ModerateReviewResp
 
-func (p *OrderServiceProcessRefundResult) GetSuccess() (v *ProcessRefundResp) {
+func (p *OrderServiceModerateReviewResult) GetSuccess() (v *ModerateReviewResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceProcessRefundResult_Success_DEFAULT
+		return OrderServiceModerateReviewResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceProcessRefundResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ProcessRefundResp)
+func (p *OrderServiceModerateReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ModerateReviewResp)
 }
 
-func (p *OrderServiceProcessRefundResult) IsSetSuccess() bool {
+func (p *OrderServiceModerateReviewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceProcessRefundResult) String() string {
+func (p *OrderServiceModerateReviewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceProcessRefundResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceModerateReviewResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceProcessRefundResult = map[int16]string{
+var fieldIDToName_OrderServiceModerateReviewResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceReserveStockArgs struct {
-	Req *ReserveStockReq `thrift:"req,1" frugal:"1,default,ReserveStockReq" json:"req"`
+type OrderServiceReplyReviewArgs struct {
+	Req *ReplyReviewReq `thrift:"req,1" frugal:"1,default,ReplyReviewReq" json:"req"`
 }
 
-func NewOrderServiceReserveStockArgs() *OrderServiceReserveStockArgs {
-	return &OrderServiceReserveStockArgs{}
+func NewOrderServiceReplyReviewArgs() *OrderServiceReplyReviewArgs {
+	return &OrderServiceReplyReviewArgs{}
 }
 
-func (p *OrderServiceReserveStockArgs) InitDefault() {
+func (p *OrderServiceReplyReviewArgs) InitDefault() {
 }
 
-var OrderServiceReserveStockArgs_Req_DEFAULT *ReserveStockReq
+var OrderServiceReplyReviewArgs_Req_DEFAULT *ReplyReviewReq
 
-func (p *OrderServiceReserveStockArgs) GetReq() (v *ReserveStockReq) {
+func (p *OrderServiceReplyReviewArgs) GetReq() (v *ReplyReviewReq) {
 	if !p.IsSetReq() {
-		return OrderServiceReserveStockArgs_Req_DEFAULT
+		return OrderServiceReplyReviewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceReserveStockArgs) SetReq(val *ReserveStockReq) {
+func (p *OrderServiceReplyReviewArgs) SetReq(val *ReplyReviewReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceReserveStockArgs) IsSetReq() bool {
+func (p *OrderServiceReplyReviewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceReserveStockArgs) String() string {
+func (p *OrderServiceReplyReviewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceReserveStockArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReplyReviewArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceReserveStockArgs = map[int16]string{
+var fieldIDToName_OrderServiceReplyReviewArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceReserveStockResult struct {
-	Success *ReserveStockResp `thrift:"success,0,optional" frugal:"0,optional,ReserveStockResp" json:"success,omitempty"`
+type OrderServiceReplyReviewResult struct {
+	Success *ReplyReviewResp `thrift:"success,0,optional" frugal:"0,optional,ReplyReviewResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceReserveStockResult() *OrderServiceReserveStockResult {
-	return &OrderServiceReserveStockResult{}
+func NewOrderServiceReplyReviewResult() *OrderServiceReplyReviewResult {
+	return &OrderServiceReplyReviewResult{}
 }
 
-func (p *OrderServiceReserveStockResult) InitDefault() {
+func (p *OrderServiceReplyReviewResult) InitDefault() {
 }
 
-var OrderServiceReserveStockResult_Success_DEFAULT *ReserveStockResp
+var OrderServiceReplyReviewResult_Success_DEFAULT *ReplyReviewResp
 
-func (p *OrderServiceReserveStockResult) GetSuccess() (v *ReserveStockResp) {
+func (p *OrderServiceReplyReviewResult) GetSuccess() (v *ReplyReviewResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceReserveStockResult_Success_DEFAULT
+		return OrderServiceReplyReviewResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceReserveStockResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReserveStockResp)
+func (p *OrderServiceReplyReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReplyReviewResp)
 }
 
-func (p *OrderServiceReserveStockResult) IsSetSuccess() bool {
+func (p *OrderServiceReplyReviewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceReserveStockResult) String() string {
+func (p *OrderServiceReplyReviewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceReserveStockResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReplyReviewResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceReserveStockResult = map[int16]string{
+var fieldIDToName_OrderServiceReplyReviewResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceReleaseStockArgs struct {
-	Req *ReleaseStockReq `thrift:"req,1" frugal:"1,default,ReleaseStockReq" json:"req"`
+type OrderServiceCreateFlashSaleArgs struct {
+	Req *CreateFlashSaleReq `thrift:"req,1" frugal:"1,default,CreateFlashSaleReq" json:"req"`
 }
 
-func NewOrderServiceReleaseStockArgs() *OrderServiceReleaseStockArgs {
-	return &OrderServiceReleaseStockArgs{}
+func NewOrderServiceCreateFlashSaleArgs() *OrderServiceCreateFlashSaleArgs {
+	return &OrderServiceCreateFlashSaleArgs{}
 }
 
-func (p *OrderServiceReleaseStockArgs) InitDefault() {
+func (p *OrderServiceCreateFlashSaleArgs) InitDefault() {
 }
 
-var OrderServiceReleaseStockArgs_Req_DEFAULT *ReleaseStockReq
+var OrderServiceCreateFlashSaleArgs_Req_DEFAULT *CreateFlashSaleReq
 
-func (p *OrderServiceReleaseStockArgs) GetReq() (v *ReleaseStockReq) {
+func (p *OrderServiceCreateFlashSaleArgs) GetReq() (v *CreateFlashSaleReq) {
 	if !p.IsSetReq() {
-		return OrderServiceReleaseStockArgs_Req_DEFAULT
+		return OrderServiceCreateFlashSaleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceReleaseStockArgs) SetReq(val *ReleaseStockReq) {
+func (p *OrderServiceCreateFlashSaleArgs) SetReq(val *CreateFlashSaleReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceReleaseStockArgs) IsSetReq() bool {
+func (p *OrderServiceCreateFlashSaleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceReleaseStockArgs) String() string {
+func (p *OrderServiceCreateFlashSaleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceReleaseStockArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCreateFlashSaleArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceReleaseStockArgs = map[int16]string{
+var fieldIDToName_OrderServiceCreateFlashSaleArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceReleaseStockResult struct {
-	Success *ReleaseStockResp `thrift:"success,0,optional" frugal:"0,optional,ReleaseStockResp" json:"success,omitempty"`
+type OrderServiceCreateFlashSaleResult struct {
+	Success *CreateFlashSaleResp `thrift:"success,0,optional" frugal:"0,optional,CreateFlashSaleResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceReleaseStockResult() *OrderServiceReleaseStockResult {
-	return &OrderServiceReleaseStockResult{}
+func NewOrderServiceCreateFlashSaleResult() *OrderServiceCreateFlashSaleResult {
+	return &OrderServiceCreateFlashSaleResult{}
 }
 
-func (p *OrderServiceReleaseStockResult) InitDefault() {
+func (p *OrderServiceCreateFlashSaleResult) InitDefault() {
 }
 
-var OrderServiceReleaseStockResult_Success_DEFAULT *ReleaseStockResp
+var OrderServiceCreateFlashSaleResult_Success_DEFAULT *CreateFlashSaleResp
 
-func (p *OrderServiceReleaseStockResult) GetSuccess() (v *ReleaseStockResp) {
+func (p *OrderServiceCreateFlashSaleResult) GetSuccess() (v *CreateFlashSaleResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceReleaseStockResult_Success_DEFAULT
+		return OrderServiceCreateFlashSaleResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceReleaseStockResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReleaseStockResp)
+func (p *OrderServiceCreateFlashSaleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateFlashSaleResp)
 }
 
-func (p *OrderServiceReleaseStockResult) IsSetSuccess() bool {
+func (p *OrderServiceCreateFlashSaleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceReleaseStockResult) String() string {
+func (p *OrderServiceCreateFlashSaleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceReleaseStockResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCreateFlashSaleResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceReleaseStockResult = map[int16]string{
+var fieldIDToName_OrderServiceCreateFlashSaleResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceConfirmStockArgs struct {
-	Req *ConfirmStockReq `thrift:"req,1" frugal:"1,default,ConfirmStockReq" json:"req"`
+type OrderServiceGetFlashSaleArgs struct {
+	Req *GetFlashSaleReq `thrift:"req,1" frugal:"1,default,GetFlashSaleReq" json:"req"`
 }
 
-func NewOrderServiceConfirmStockArgs() *OrderServiceConfirmStockArgs {
-	return &OrderServiceConfirmStockArgs{}
+func NewOrderServiceGetFlashSaleArgs() *OrderServiceGetFlashSaleArgs {
+	return &OrderServiceGetFlashSaleArgs{}
 }
 
-func (p *OrderServiceConfirmStockArgs) InitDefault() {
+func (p *OrderServiceGetFlashSaleArgs) InitDefault() {
 }
 
-var OrderServiceConfirmStockArgs_Req_DEFAULT *ConfirmStockReq
+var OrderServiceGetFlashSaleArgs_Req_DEFAULT *GetFlashSaleReq
 
-func (p *OrderServiceConfirmStockArgs) GetReq() (v *ConfirmStockReq) {
+func (p *OrderServiceGetFlashSaleArgs) GetReq() (v *GetFlashSaleReq) {
 	if !p.IsSetReq() {
-		return OrderServiceConfirmStockArgs_Req_DEFAULT
+		return OrderServiceGetFlashSaleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceConfirmStockArgs) SetReq(val *ConfirmStockReq) {
+func (p *OrderServiceGetFlashSaleArgs) SetReq(val *GetFlashSaleReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceConfirmStockArgs) IsSetReq() bool {
+func (p *OrderServiceGetFlashSaleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceConfirmStockArgs) String() string {
+func (p *OrderServiceGetFlashSaleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceConfirmStockArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetFlashSaleArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceConfirmStockArgs = map[int16]string{
+var fieldIDToName_OrderServiceGetFlashSaleArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceConfirmStockResult struct {
-	Success *ConfirmStockResp `thrift:"success,0,optional" frugal:"0,optional,ConfirmStockResp" json:"success,omitempty"`
+type OrderServiceGetFlashSaleResult struct {
+	Success *GetFlashSaleResp `thrift:"success,0,optional" frugal:"0,optional,GetFlashSaleResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceConfirmStockResult() *OrderServiceConfirmStockResult {
-	return &OrderServiceConfirmStockResult{}
+func NewOrderServiceGetFlashSaleResult() *OrderServiceGetFlashSaleResult {
+	return &OrderServiceGetFlashSaleResult{}
 }
 
-func (p *OrderServiceConfirmStockResult) InitDefault() {
+func (p *OrderServiceGetFlashSaleResult) InitDefault() {
 }
 
-var OrderServiceConfirmStockResult_Success_DEFAULT *ConfirmStockResp
+var OrderServiceGetFlashSaleResult_Success_DEFAULT *GetFlashSaleResp
 
-func (p *OrderServiceConfirmStockResult) GetSuccess() (v *ConfirmStockResp) {
+func (p *OrderServiceGetFlashSaleResult) GetSuccess() (v *GetFlashSaleResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceConfirmStockResult_Success_DEFAULT
+		return OrderServiceGetFlashSaleResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceConfirmStockResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ConfirmStockResp)
+func (p *OrderServiceGetFlashSaleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFlashSaleResp)
 }
 
-func (p *OrderServiceConfirmStockResult) IsSetSuccess() bool {
+func (p *OrderServiceGetFlashSaleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceConfirmStockResult) String() string {
+func (p *OrderServiceGetFlashSaleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceConfirmStockResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetFlashSaleResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceConfirmStockResult = map[int16]string{
+var fieldIDToName_OrderServiceGetFlashSaleResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceProcessTimeoutArgs struct {
-	Req *ProcessTimeoutReq `thrift:"req,1" frugal:"1,default,ProcessTimeoutReq" json:"req"`
+type OrderServiceListFlashSalesArgs struct {
+	Req *ListFlashSalesReq `thrift:"req,1" frugal:"1,default,ListFlashSalesReq" json:"req"`
 }
 
-func NewOrderServiceProcessTimeoutArgs() *OrderServiceProcessTimeoutArgs {
-	return &OrderServiceProcessTimeoutArgs{}
+func NewOrderServiceListFlashSalesArgs() *OrderServiceListFlashSalesArgs {
+	return &OrderServiceListFlashSalesArgs{}
 }
 
-func (p *OrderServiceProcessTimeoutArgs) InitDefault() {
+func (p *OrderServiceListFlashSalesArgs) InitDefault() {
 }
 
-var OrderServiceProcessTimeoutArgs_Req_DEFAULT *ProcessTimeoutReq
+var OrderServiceListFlashSalesArgs_Req_DEFAULT *ListFlashSalesReq
 
-func (p *OrderServiceProcessTimeoutArgs) GetReq() (v *ProcessTimeoutReq) {
+func (p *OrderServiceListFlashSalesArgs) GetReq() (v *ListFlashSalesReq) {
 	if !p.IsSetReq() {
-		return OrderServiceProcessTimeoutArgs_Req_DEFAULT
+		return OrderServiceListFlashSalesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceProcessTimeoutArgs) SetReq(val *ProcessTimeoutReq) {
+func (p *OrderServiceListFlashSalesArgs) SetReq(val *ListFlashSalesReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceProcessTimeoutArgs) IsSetReq() bool {
+func (p *OrderServiceListFlashSalesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceProcessTimeoutArgs) String() string {
+func (p *OrderServiceListFlashSalesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceProcessTimeoutArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceListFlashSalesArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceProcessTimeoutArgs = map[int16]string{
+var fieldIDToName_OrderServiceListFlashSalesArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceProcessTimeoutResult struct {
-	Success *ProcessTimeoutResp `thrift:"success,0,optional" frugal:"0,optional,ProcessTimeoutResp" json:"success,omitempty"`
+type OrderServiceListFlashSalesResult struct {
+	Success *ListFlashSalesResp `thrift:"success,0,optional" frugal:"0,optional,ListFlashSalesResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceProcessTimeoutResult() *OrderServiceProcessTimeoutResult {
-	return &OrderServiceProcessTimeoutResult{}
+func NewOrderServiceListFlashSalesResult() *OrderServiceListFlashSalesResult {
+	return &OrderServiceListFlashSalesResult{}
 }
 
-func (p *OrderServiceProcessTimeoutResult) InitDefault() {
+func (p *OrderServiceListFlashSalesResult) InitDefault() {
 }
 
-var OrderServiceProcessTimeoutResult_Success_DEFAULT *ProcessTimeoutResp
+var OrderServiceListFlashSalesResult_Success_DEFAULT *ListFlashSalesResp
 
-func (p *OrderServiceProcessTimeoutResult) GetSuccess() (v *ProcessTimeoutResp) {
+func (p *OrderServiceListFlashSalesResult) GetSuccess() (v *ListFlashSalesResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceProcessTimeoutResult_Success_DEFAULT
+		return OrderServiceListFlashSalesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceProcessTimeoutResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ProcessTimeoutResp)
+func (p *OrderServiceListFlashSalesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListFlashSalesResp)
 }
 
-func (p *OrderServiceProcessTimeoutResult) IsSetSuccess() bool {
+func (p *OrderServiceListFlashSalesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceProcessTimeoutResult) String() string {
+func (p *OrderServiceListFlashSalesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceProcessTimeoutResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceListFlashSalesResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceProcessTimeoutResult = map[int16]string{
+var fieldIDToName_OrderServiceListFlashSalesResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceGetOrderStatsArgs struct {
-	Req *OrderStatsReq `thrift:"req,1" frugal:"1,default,OrderStatsReq" json:"req"`
+type OrderServiceSubmitFlashSaleOrderArgs struct {
+	Req *SubmitFlashSaleOrderReq `thrift:"req,1" frugal:"1,default,SubmitFlashSaleOrderReq" json:"req"`
 }
 
-func NewOrderServiceGetOrderStatsArgs() *OrderServiceGetOrderStatsArgs {
-	return &OrderServiceGetOrderStatsArgs{}
+func NewOrderServiceSubmitFlashSaleOrderArgs() *OrderServiceSubmitFlashSaleOrderArgs {
+	return &OrderServiceSubmitFlashSaleOrderArgs{}
 }
 
-func (p *OrderServiceGetOrderStatsArgs) InitDefault() {
+func (p *OrderServiceSubmitFlashSaleOrderArgs) InitDefault() {
 }
 
-var OrderServiceGetOrderStatsArgs_Req_DEFAULT *OrderStatsReq
+var OrderServiceSubmitFlashSaleOrderArgs_Req_DEFAULT *SubmitFlashSaleOrderReq
 
-func (p *OrderServiceGetOrderStatsArgs) GetReq() (v *OrderStatsReq) {
+func (p *OrderServiceSubmitFlashSaleOrderArgs) GetReq() (v *SubmitFlashSaleOrderReq) {
 	if !p.IsSetReq() {
-		return OrderServiceGetOrderStatsArgs_Req_DEFAULT
+		return OrderServiceSubmitFlashSaleOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceGetOrderStatsArgs) SetReq(val *OrderStatsReq) {
+func (p *OrderServiceSubmitFlashSaleOrderArgs) SetReq(val *SubmitFlashSaleOrderReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceGetOrderStatsArgs) IsSetReq() bool {
+func (p *OrderServiceSubmitFlashSaleOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceGetOrderStatsArgs) String() string {
+func (p *OrderServiceSubmitFlashSaleOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceGetOrderStatsArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceSubmitFlashSaleOrderArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceGetOrderStatsArgs = map[int16]string{
+var fieldIDToName_OrderServiceSubmitFlashSaleOrderArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceGetOrderStatsResult struct {
-	Success *OrderStatsResp `thrift:"success,0,optional" frugal:"0,optional,OrderStatsResp" json:"success,omitempty"`
+type OrderServiceSubmitFlashSaleOrderResult struct {
+	Success *SubmitFlashSaleOrderResp `thrift:"success,0,optional" frugal:"0,optional,SubmitFlashSaleOrderResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceGetOrderStatsResult() *OrderServiceGetOrderStatsResult {
-	return &OrderServiceGetOrderStatsResult{}
+func NewOrderServiceSubmitFlashSaleOrderResult() *OrderServiceSubmitFlashSaleOrderResult {
+	return &OrderServiceSubmitFlashSaleOrderResult{}
 }
 
-func (p *OrderServiceGetOrderStatsResult) InitDefault() {
+func (p *OrderServiceSubmitFlashSaleOrderResult) InitDefault() {
 }
 
-var OrderServiceGetOrderStatsResult_Success_DEFAULT *OrderStatsResp
+var OrderServiceSubmitFlashSaleOrderResult_Success_DEFAULT *SubmitFlashSaleOrderResp
 
-func (p *OrderServiceGetOrderStatsResult) GetSuccess() (v *OrderStatsResp) {
+func (p *OrderServiceSubmitFlashSaleOrderResult) GetSuccess() (v *SubmitFlashSaleOrderResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceGetOrderStatsResult_Success_DEFAULT
+		return OrderServiceSubmitFlashSaleOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceGetOrderStatsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*OrderStatsResp)
+func (p *OrderServiceSubmitFlashSaleOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitFlashSaleOrderResp)
 }
 
-func (p *OrderServiceGetOrderStatsResult) IsSetSuccess() bool {
+func (p *OrderServiceSubmitFlashSaleOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceGetOrderStatsResult) String() string {
+func (p *OrderServiceSubmitFlashSaleOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceGetOrderStatsResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceSubmitFlashSaleOrderResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceGetOrderStatsResult = map[int16]string{
+var fieldIDToName_OrderServiceSubmitFlashSaleOrderResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceUpdateOrderStatusArgs struct {
-	Req *CancelOrderReq `thrift:"req,1" frugal:"1,default,CancelOrderReq" json:"req"`
+type OrderServiceGetFlashSaleRequestArgs struct {
+	Req *GetFlashSaleRequestReq `thrift:"req,1" frugal:"1,default,GetFlashSaleRequestReq" json:"req"`
 }
 
-func NewOrderServiceUpdateOrderStatusArgs() *OrderServiceUpdateOrderStatusArgs {
-	return &OrderServiceUpdateOrderStatusArgs{}
+func NewOrderServiceGetFlashSaleRequestArgs() *OrderServiceGetFlashSaleRequestArgs {
+	return &OrderServiceGetFlashSaleRequestArgs{}
 }
 
-func (p *OrderServiceUpdateOrderStatusArgs) InitDefault() {
+func (p *OrderServiceGetFlashSaleRequestArgs) InitDefault() {
 }
 
-var OrderServiceUpdateOrderStatusArgs_Req_DEFAULT *CancelOrderReq
+var OrderServiceGetFlashSaleRequestArgs_Req_DEFAULT *GetFlashSaleRequestReq
 
-func (p *OrderServiceUpdateOrderStatusArgs) GetReq() (v *CancelOrderReq) {
+func (p *OrderServiceGetFlashSaleRequestArgs) GetReq() (v *GetFlashSaleRequestReq) {
 	if !p.IsSetReq() {
-		return OrderServiceUpdateOrderStatusArgs_Req_DEFAULT
+		return OrderServiceGetFlashSaleRequestArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceUpdateOrderStatusArgs) SetReq(val *CancelOrderReq) {
+func (p *OrderServiceGetFlashSaleRequestArgs) SetReq(val *GetFlashSaleRequestReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceUpdateOrderStatusArgs) IsSetReq() bool {
+func (p *OrderServiceGetFlashSaleRequestArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceUpdateOrderStatusArgs) String() string {
+func (p *OrderServiceGetFlashSaleRequestArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceUpdateOrderStatusArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetFlashSaleRequestArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceUpdateOrderStatusArgs = map[int16]string{
+var fieldIDToName_OrderServiceGetFlashSaleRequestArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceUpdateOrderStatusResult struct {
-	Success *CancelOrderResp `thrift:"success,0,optional" frugal:"0,optional,CancelOrderResp" json:"success,omitempty"`
+type OrderServiceGetFlashSaleRequestResult struct {
+	Success *GetFlashSaleRequestResp `thrift:"success,0,optional" frugal:"0,optional,GetFlashSaleRequestResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceUpdateOrderStatusResult() *OrderServiceUpdateOrderStatusResult {
-	return &OrderServiceUpdateOrderStatusResult{}
+func NewOrderServiceGetFlashSaleRequestResult() *OrderServiceGetFlashSaleRequestResult {
+	return &OrderServiceGetFlashSaleRequestResult{}
 }
 
-func (p *OrderServiceUpdateOrderStatusResult) InitDefault() {
+func (p *OrderServiceGetFlashSaleRequestResult) InitDefault() {
 }
 
-var OrderServiceUpdateOrderStatusResult_Success_DEFAULT *CancelOrderResp
+var OrderServiceGetFlashSaleRequestResult_Success_DEFAULT *GetFlashSaleRequestResp
 
-func (p *OrderServiceUpdateOrderStatusResult) GetSuccess() (v *CancelOrderResp) {
+func (p *OrderServiceGetFlashSaleRequestResult) GetSuccess() (v *GetFlashSaleRequestResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceUpdateOrderStatusResult_Success_DEFAULT
+		return OrderServiceGetFlashSaleRequestResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceUpdateOrderStatusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CancelOrderResp)
+func (p *OrderServiceGetFlashSaleRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFlashSaleRequestResp)
 }
 
-func (p *OrderServiceUpdateOrderStatusResult) IsSetSuccess() bool {
+func (p *OrderServiceGetFlashSaleRequestResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceUpdateOrderStatusResult) String() string {
+func (p *OrderServiceGetFlashSaleRequestResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceUpdateOrderStatusResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetFlashSaleRequestResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceUpdateOrderStatusResult = map[int16]string{
+var fieldIDToName_OrderServiceGetFlashSaleRequestResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceShipOrderArgs struct {
-	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
+type OrderServiceReconcileFlashSaleArgs struct {
+	Req *ReconcileFlashSaleReq `thrift:"req,1" frugal:"1,default,ReconcileFlashSaleReq" json:"req"`
 }
 
-func NewOrderServiceShipOrderArgs() *OrderServiceShipOrderArgs {
-	return &OrderServiceShipOrderArgs{}
+func NewOrderServiceReconcileFlashSaleArgs() *OrderServiceReconcileFlashSaleArgs {
+	return &OrderServiceReconcileFlashSaleArgs{}
 }
 
-func (p *OrderServiceShipOrderArgs) InitDefault() {
+func (p *OrderServiceReconcileFlashSaleArgs) InitDefault() {
 }
 
-var OrderServiceShipOrderArgs_Req_DEFAULT *PayOrderReq
+var OrderServiceReconcileFlashSaleArgs_Req_DEFAULT *ReconcileFlashSaleReq
 
-func (p *OrderServiceShipOrderArgs) GetReq() (v *PayOrderReq) {
+func (p *OrderServiceReconcileFlashSaleArgs) GetReq() (v *ReconcileFlashSaleReq) {
 	if !p.IsSetReq() {
-		return OrderServiceShipOrderArgs_Req_DEFAULT
+		return OrderServiceReconcileFlashSaleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceShipOrderArgs) SetReq(val *PayOrderReq) {
+func (p *OrderServiceReconcileFlashSaleArgs) SetReq(val *ReconcileFlashSaleReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceShipOrderArgs) IsSetReq() bool {
+func (p *OrderServiceReconcileFlashSaleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceShipOrderArgs) String() string {
+func (p *OrderServiceReconcileFlashSaleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceShipOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReconcileFlashSaleArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceShipOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceReconcileFlashSaleArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceShipOrderResult struct {
-	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
+type OrderServiceReconcileFlashSaleResult struct {
+	Success *ReconcileFlashSaleResp `thrift:"success,0,optional" frugal:"0,optional,ReconcileFlashSaleResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceShipOrderResult() *OrderServiceShipOrderResult {
-	return &OrderServiceShipOrderResult{}
+func NewOrderServiceReconcileFlashSaleResult() *OrderServiceReconcileFlashSaleResult {
+	return &OrderServiceReconcileFlashSaleResult{}
 }
 
-func (p *OrderServiceShipOrderResult) InitDefault() {
+func (p *OrderServiceReconcileFlashSaleResult) InitDefault() {
 }
 
-var OrderServiceShipOrderResult_Success_DEFAULT *PayOrderResp
+var OrderServiceReconcileFlashSaleResult_Success_DEFAULT *ReconcileFlashSaleResp
 
-func (p *OrderServiceShipOrderResult) GetSuccess() (v *PayOrderResp) {
+func (p *OrderServiceReconcileFlashSaleResult) GetSuccess() (v *ReconcileFlashSaleResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceShipOrderResult_Success_DEFAULT
+		return OrderServiceReconcileFlashSaleResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceShipOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PayOrderResp)
+func (p *OrderServiceReconcileFlashSaleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReconcileFlashSaleResp)
 }
 
-func (p *OrderServiceShipOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceReconcileFlashSaleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceShipOrderResult) String() string {
+func (p *OrderServiceReconcileFlashSaleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceShipOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReconcileFlashSaleResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceShipOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceReconcileFlashSaleResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceConfirmReceiptArgs struct {
-	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
+type OrderServiceGetPurchaseAllowancesArgs struct {
+	Req *GetPurchaseAllowancesReq `thrift:"req,1" frugal:"1,default,GetPurchaseAllowancesReq" json:"req"`
 }
 
-func NewOrderServiceConfirmReceiptArgs() *OrderServiceConfirmReceiptArgs {
-	return &OrderServiceConfirmReceiptArgs{}
+func NewOrderServiceGetPurchaseAllowancesArgs() *OrderServiceGetPurchaseAllowancesArgs {
+	return &OrderServiceGetPurchaseAllowancesArgs{}
 }
 
-func (p *OrderServiceConfirmReceiptArgs) InitDefault() {
+func (p *OrderServiceGetPurchaseAllowancesArgs) InitDefault() {
 }
 
-var OrderServiceConfirmReceiptArgs_Req_DEFAULT *PayOrderReq
+var OrderServiceGetPurchaseAllowancesArgs_Req_DEFAULT *GetPurchaseAllowancesReq
 
-func (p *OrderServiceConfirmReceiptArgs) GetReq() (v *PayOrderReq) {
+func (p *OrderServiceGetPurchaseAllowancesArgs) GetReq() (v *GetPurchaseAllowancesReq) {
 	if !p.IsSetReq() {
-		return OrderServiceConfirmReceiptArgs_Req_DEFAULT
+		return OrderServiceGetPurchaseAllowancesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceConfirmReceiptArgs) SetReq(val *PayOrderReq) {
+func (p *OrderServiceGetPurchaseAllowancesArgs) SetReq(val *GetPurchaseAllowancesReq) {
 	p.Req = val
 }
 
-func (p *OrderServiceConfirmReceiptArgs) IsSetReq() bool {
+func (p *OrderServiceGetPurchaseAllowancesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceConfirmReceiptArgs) String() string {
+func (p *OrderServiceGetPurchaseAllowancesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceConfirmReceiptArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetPurchaseAllowancesArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceConfirmReceiptArgs = map[int16]string{
+var fieldIDToName_OrderServiceGetPurchaseAllowancesArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceConfirmReceiptResult struct {
-	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
+type OrderServiceGetPurchaseAllowancesResult struct {
+	Success *GetPurchaseAllowancesResp `thrift:"success,0,optional" frugal:"0,optional,GetPurchaseAllowancesResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceConfirmReceiptResult() *OrderServiceConfirmReceiptResult {
-	return &OrderServiceConfirmReceiptResult{}
+func NewOrderServiceGetPurchaseAllowancesResult() *OrderServiceGetPurchaseAllowancesResult {
+	return &OrderServiceGetPurchaseAllowancesResult{}
 }
 
-func (p *OrderServiceConfirmReceiptResult) InitDefault() {
+func (p *OrderServiceGetPurchaseAllowancesResult) InitDefault() {
 }
 
-var OrderServiceConfirmReceiptResult_Success_DEFAULT *PayOrderResp
+var OrderServiceGetPurchaseAllowancesResult_Success_DEFAULT *GetPurchaseAllowancesResp
 
-func (p *OrderServiceConfirmReceiptResult) GetSuccess() (v *PayOrderResp) {
+func (p *OrderServiceGetPurchaseAllowancesResult) GetSuccess() (v *GetPurchaseAllowancesResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceConfirmReceiptResult_Success_DEFAULT
+		return OrderServiceGetPurchaseAllowancesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceConfirmReceiptResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PayOrderResp)
+func (p *OrderServiceGetPurchaseAllowancesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPurchaseAllowancesResp)
 }
 
-func (p *OrderServiceConfirmReceiptResult) IsSetSuccess() bool {
+func (p *OrderServiceGetPurchaseAllowancesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceConfirmReceiptResult) String() string {
+func (p *OrderServiceGetPurchaseAllowancesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceConfirmReceiptResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetPurchaseAllowancesResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceConfirmReceiptResult = map[int16]string{
+var fieldIDToName_OrderServiceGetPurchaseAllowancesResult = map[int16]string{
 	0: "success",
 }
@@ -26,6 +26,17 @@ type Client interface {
 	UpdateOrderStatus(ctx context.Context, req *api.CancelOrderReq, callOptions ...callopt.Option) (r *api.CancelOrderResp, err error)
 	ShipOrder(ctx context.Context, req *api.PayOrderReq, callOptions ...callopt.Option) (r *api.PayOrderResp, err error)
 	ConfirmReceipt(ctx context.Context, req *api.PayOrderReq, callOptions ...callopt.Option) (r *api.PayOrderResp, err error)
+	CreateReview(ctx context.Context, req *api.CreateReviewReq, callOptions ...callopt.Option) (r *api.CreateReviewResp, err error)
+	ListReviews(ctx context.Context, req *api.ListReviewsReq, callOptions ...callopt.Option) (r *api.ListReviewsResp, err error)
+	ModerateReview(ctx context.Context, req *api.ModerateReviewReq, callOptions ...callopt.Option) (r *api.ModerateReviewResp, err error)
+	ReplyReview(ctx context.Context, req *api.ReplyReviewReq, callOptions ...callopt.Option) (r *api.ReplyReviewResp, err error)
+	CreateFlashSale(ctx context.Context, req *api.CreateFlashSaleReq, callOptions ...callopt.Option) (r *api.CreateFlashSaleResp, err error)
+	GetFlashSale(ctx context.Context, req *api.GetFlashSaleReq, callOptions ...callopt.Option) (r *api.GetFlashSaleResp, err error)
+	ListFlashSales(ctx context.Context, req *api.ListFlashSalesReq, callOptions ...callopt.Option) (r *api.ListFlashSalesResp, err error)
+	SubmitFlashSaleOrder(ctx context.Context, req *api.SubmitFlashSaleOrderReq, callOptions ...callopt.Option) (r *api.SubmitFlashSaleOrderResp, err error)
+	GetFlashSaleRequest(ctx context.Context, req *api.GetFlashSaleRequestReq, callOptions ...callopt.Option) (r *api.GetFlashSaleRequestResp, err error)
+	ReconcileFlashSale(ctx context.Context, req *api.ReconcileFlashSaleReq, callOptions ...callopt.Option) (r *api.ReconcileFlashSaleResp, err error)
+	GetPurchaseAllowances(ctx context.Context, req *api.GetPurchaseAllowancesReq, callOptions ...callopt.Option) (r *api.GetPurchaseAllowancesResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -131,3 +142,58 @@ func (p *kOrderServiceClient) ConfirmReceipt(ctx context.Context, req *api.PayOr
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ConfirmReceipt(ctx, req)
 }
+
+func (p *kOrderServiceClient) CreateReview(ctx context.Context, req *api.CreateReviewReq, callOptions ...callopt.Option) (r *api.CreateReviewResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateReview(ctx, req)
+}
+
+func (p *kOrderServiceClient) ListReviews(ctx context.Context, req *api.ListReviewsReq, callOptions ...callopt.Option) (r *api.ListReviewsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListReviews(ctx, req)
+}
+
+func (p *kOrderServiceClient) ModerateReview(ctx context.Context, req *api.ModerateReviewReq, callOptions ...callopt.Option) (r *api.ModerateReviewResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ModerateReview(ctx, req)
+}
+
+func (p *kOrderServiceClient) ReplyReview(ctx context.Context, req *api.ReplyReviewReq, callOptions ...callopt.Option) (r *api.ReplyReviewResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReplyReview(ctx, req)
+}
+
+func (p *kOrderServiceClient) CreateFlashSale(ctx context.Context, req *api.CreateFlashSaleReq, callOptions ...callopt.Option) (r *api.CreateFlashSaleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateFlashSale(ctx, req)
+}
+
+func (p *kOrderServiceClient) GetFlashSale(ctx context.Context, req *api.GetFlashSaleReq, callOptions ...callopt.Option) (r *api.GetFlashSaleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFlashSale(ctx, req)
+}
+
+func (p *kOrderServiceClient) ListFlashSales(ctx context.Context, req *api.ListFlashSalesReq, callOptions ...callopt.Option) (r *api.ListFlashSalesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListFlashSales(ctx, req)
+}
+
+func (p *kOrderServiceClient) SubmitFlashSaleOrder(ctx context.Context, req *api.SubmitFlashSaleOrderReq, callOptions ...callopt.Option) (r *api.SubmitFlashSaleOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitFlashSaleOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) GetFlashSaleRequest(ctx context.Context, req *api.GetFlashSaleRequestReq, callOptions ...callopt.Option) (r *api.GetFlashSaleRequestResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFlashSaleRequest(ctx, req)
+}
+
+func (p *kOrderServiceClient) ReconcileFlashSale(ctx context.Context, req *api.ReconcileFlashSaleReq, callOptions ...callopt.Option) (r *api.ReconcileFlashSaleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReconcileFlashSale(ctx, req)
+}
+
+func (p *kOrderServiceClient) GetPurchaseAllowances(ctx context.Context, req *api.GetPurchaseAllowancesReq, callOptions ...callopt.Option) (r *api.GetPurchaseAllowancesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPurchaseAllowances(ctx, req)
+}
@@ -118,6 +118,83 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateReview": kitex.NewMethodInfo(
+		createReviewHandler,
+		newOrderServiceCreateReviewArgs,
+		newOrderServiceCreateReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListReviews": kitex.NewMethodInfo(
+		listReviewsHandler,
+		newOrderServiceListReviewsArgs,
+		newOrderServiceListReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ModerateReview": kitex.NewMethodInfo(
+		moderateReviewHandler,
+		newOrderServiceModerateReviewArgs,
+		newOrderServiceModerateReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReplyReview": kitex.NewMethodInfo(
+		replyReviewHandler,
+		newOrderServiceReplyReviewArgs,
+		newOrderServiceReplyReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateFlashSale": kitex.NewMethodInfo(
+		createFlashSaleHandler,
+		newOrderServiceCreateFlashSaleArgs,
+		newOrderServiceCreateFlashSaleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFlashSale": kitex.NewMethodInfo(
+		getFlashSaleHandler,
+		newOrderServiceGetFlashSaleArgs,
+		newOrderServiceGetFlashSaleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListFlashSales": kitex.NewMethodInfo(
+		listFlashSalesHandler,
+		newOrderServiceListFlashSalesArgs,
+		newOrderServiceListFlashSalesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubmitFlashSaleOrder": kitex.NewMethodInfo(
+		submitFlashSaleOrderHandler,
+		newOrderServiceSubmitFlashSaleOrderArgs,
+		newOrderServiceSubmitFlashSaleOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFlashSaleRequest": kitex.NewMethodInfo(
+		getFlashSaleRequestHandler,
+		newOrderServiceGetFlashSaleRequestArgs,
+		newOrderServiceGetFlashSaleRequestResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReconcileFlashSale": kitex.NewMethodInfo(
+		reconcileFlashSaleHandler,
+		newOrderServiceReconcileFlashSaleArgs,
+		newOrderServiceReconcileFlashSaleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPurchaseAllowances": kitex.NewMethodInfo(
+		getPurchaseAllowancesHandler,
+		newOrderServiceGetPurchaseAllowancesArgs,
+		newOrderServiceGetPurchaseAllowancesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -454,6 +531,204 @@ func newOrderServiceConfirmReceiptResult() interface{} {
 	return api.NewOrderServiceConfirmReceiptResult()
 }
 
+func createReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceCreateReviewArgs)
+	realResult := result.(*api.OrderServiceCreateReviewResult)
+	success, err := handler.(api.OrderService).CreateReview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCreateReviewArgs() interface{} {
+	return api.NewOrderServiceCreateReviewArgs()
+}
+
+func newOrderServiceCreateReviewResult() interface{} {
+	return api.NewOrderServiceCreateReviewResult()
+}
+
+func listReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceListReviewsArgs)
+	realResult := result.(*api.OrderServiceListReviewsResult)
+	success, err := handler.(api.OrderService).ListReviews(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceListReviewsArgs() interface{} {
+	return api.NewOrderServiceListReviewsArgs()
+}
+
+func newOrderServiceListReviewsResult() interface{} {
+	return api.NewOrderServiceListReviewsResult()
+}
+
+func moderateReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceModerateReviewArgs)
+	realResult := result.(*api.OrderServiceModerateReviewResult)
+	success, err := handler.(api.OrderService).ModerateReview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceModerateReviewArgs() interface{} {
+	return api.NewOrderServiceModerateReviewArgs()
+}
+
+func newOrderServiceModerateReviewResult() interface{} {
+	return api.NewOrderServiceModerateReviewResult()
+}
+
+func replyReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceReplyReviewArgs)
+	realResult := result.(*api.OrderServiceReplyReviewResult)
+	success, err := handler.(api.OrderService).ReplyReview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceReplyReviewArgs() interface{} {
+	return api.NewOrderServiceReplyReviewArgs()
+}
+
+func newOrderServiceReplyReviewResult() interface{} {
+	return api.NewOrderServiceReplyReviewResult()
+}
+
+func createFlashSaleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceCreateFlashSaleArgs)
+	realResult := result.(*api.OrderServiceCreateFlashSaleResult)
+	success, err := handler.(api.OrderService).CreateFlashSale(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCreateFlashSaleArgs() interface{} {
+	return api.NewOrderServiceCreateFlashSaleArgs()
+}
+
+func newOrderServiceCreateFlashSaleResult() interface{} {
+	return api.NewOrderServiceCreateFlashSaleResult()
+}
+
+func getFlashSaleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceGetFlashSaleArgs)
+	realResult := result.(*api.OrderServiceGetFlashSaleResult)
+	success, err := handler.(api.OrderService).GetFlashSale(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceGetFlashSaleArgs() interface{} {
+	return api.NewOrderServiceGetFlashSaleArgs()
+}
+
+func newOrderServiceGetFlashSaleResult() interface{} {
+	return api.NewOrderServiceGetFlashSaleResult()
+}
+
+func listFlashSalesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceListFlashSalesArgs)
+	realResult := result.(*api.OrderServiceListFlashSalesResult)
+	success, err := handler.(api.OrderService).ListFlashSales(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceListFlashSalesArgs() interface{} {
+	return api.NewOrderServiceListFlashSalesArgs()
+}
+
+func newOrderServiceListFlashSalesResult() interface{} {
+	return api.NewOrderServiceListFlashSalesResult()
+}
+
+func submitFlashSaleOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceSubmitFlashSaleOrderArgs)
+	realResult := result.(*api.OrderServiceSubmitFlashSaleOrderResult)
+	success, err := handler.(api.OrderService).SubmitFlashSaleOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceSubmitFlashSaleOrderArgs() interface{} {
+	return api.NewOrderServiceSubmitFlashSaleOrderArgs()
+}
+
+func newOrderServiceSubmitFlashSaleOrderResult() interface{} {
+	return api.NewOrderServiceSubmitFlashSaleOrderResult()
+}
+
+func getFlashSaleRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceGetFlashSaleRequestArgs)
+	realResult := result.(*api.OrderServiceGetFlashSaleRequestResult)
+	success, err := handler.(api.OrderService).GetFlashSaleRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceGetFlashSaleRequestArgs() interface{} {
+	return api.NewOrderServiceGetFlashSaleRequestArgs()
+}
+
+func newOrderServiceGetFlashSaleRequestResult() interface{} {
+	return api.NewOrderServiceGetFlashSaleRequestResult()
+}
+
+func reconcileFlashSaleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceReconcileFlashSaleArgs)
+	realResult := result.(*api.OrderServiceReconcileFlashSaleResult)
+	success, err := handler.(api.OrderService).ReconcileFlashSale(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceReconcileFlashSaleArgs() interface{} {
+	return api.NewOrderServiceReconcileFlashSaleArgs()
+}
+
+func newOrderServiceReconcileFlashSaleResult() interface{} {
+	return api.NewOrderServiceReconcileFlashSaleResult()
+}
+
+func getPurchaseAllowancesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.OrderServiceGetPurchaseAllowancesArgs)
+	realResult := result.(*api.OrderServiceGetPurchaseAllowancesResult)
+	success, err := handler.(api.OrderService).GetPurchaseAllowances(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceGetPurchaseAllowancesArgs() interface{} {
+	return api.NewOrderServiceGetPurchaseAllowancesArgs()
+}
+
+func newOrderServiceGetPurchaseAllowancesResult() interface{} {
+	return api.NewOrderServiceGetPurchaseAllowancesResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -613,3 +888,113 @@ func (p *kClient) ConfirmReceipt(ctx context.Context, req *api.PayOrderReq) (r *
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateReview(ctx context.Context, req *api.CreateReviewReq) (r *api.CreateReviewResp, err error) {
+	var _args api.OrderServiceCreateReviewArgs
+	_args.Req = req
+	var _result api.OrderServiceCreateReviewResult
+	if err = p.c.Call(ctx, "CreateReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListReviews(ctx context.Context, req *api.ListReviewsReq) (r *api.ListReviewsResp, err error) {
+	var _args api.OrderServiceListReviewsArgs
+	_args.Req = req
+	var _result api.OrderServiceListReviewsResult
+	if err = p.c.Call(ctx, "ListReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ModerateReview(ctx context.Context, req *api.ModerateReviewReq) (r *api.ModerateReviewResp, err error) {
+	var _args api.OrderServiceModerateReviewArgs
+	_args.Req = req
+	var _result api.OrderServiceModerateReviewResult
+	if err = p.c.Call(ctx, "ModerateReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReplyReview(ctx context.Context, req *api.ReplyReviewReq) (r *api.ReplyReviewResp, err error) {
+	var _args api.OrderServiceReplyReviewArgs
+	_args.Req = req
+	var _result api.OrderServiceReplyReviewResult
+	if err = p.c.Call(ctx, "ReplyReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateFlashSale(ctx context.Context, req *api.CreateFlashSaleReq) (r *api.CreateFlashSaleResp, err error) {
+	var _args api.OrderServiceCreateFlashSaleArgs
+	_args.Req = req
+	var _result api.OrderServiceCreateFlashSaleResult
+	if err = p.c.Call(ctx, "CreateFlashSale", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFlashSale(ctx context.Context, req *api.GetFlashSaleReq) (r *api.GetFlashSaleResp, err error) {
+	var _args api.OrderServiceGetFlashSaleArgs
+	_args.Req = req
+	var _result api.OrderServiceGetFlashSaleResult
+	if err = p.c.Call(ctx, "GetFlashSale", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListFlashSales(ctx context.Context, req *api.ListFlashSalesReq) (r *api.ListFlashSalesResp, err error) {
+	var _args api.OrderServiceListFlashSalesArgs
+	_args.Req = req
+	var _result api.OrderServiceListFlashSalesResult
+	if err = p.c.Call(ctx, "ListFlashSales", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitFlashSaleOrder(ctx context.Context, req *api.SubmitFlashSaleOrderReq) (r *api.SubmitFlashSaleOrderResp, err error) {
+	var _args api.OrderServiceSubmitFlashSaleOrderArgs
+	_args.Req = req
+	var _result api.OrderServiceSubmitFlashSaleOrderResult
+	if err = p.c.Call(ctx, "SubmitFlashSaleOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFlashSaleRequest(ctx context.Context, req *api.GetFlashSaleRequestReq) (r *api.GetFlashSaleRequestResp, err error) {
+	var _args api.OrderServiceGetFlashSaleRequestArgs
+	_args.Req = req
+	var _result api.OrderServiceGetFlashSaleRequestResult
+	if err = p.c.Call(ctx, "GetFlashSaleRequest", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReconcileFlashSale(ctx context.Context, req *api.ReconcileFlashSaleReq) (r *api.ReconcileFlashSaleResp, err error) {
+	var _args api.OrderServiceReconcileFlashSaleArgs
+	_args.Req = req
+	var _result api.OrderServiceReconcileFlashSaleResult
+	if err = p.c.Call(ctx, "ReconcileFlashSale", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPurchaseAllowances(ctx context.Context, req *api.GetPurchaseAllowancesReq) (r *api.GetPurchaseAllowancesResp, err error) {
+	var _args api.OrderServiceGetPurchaseAllowancesArgs
+	_args.Req = req
+	var _result api.OrderServiceGetPurchaseAllowancesResult
+	if err = p.c.Call(ctx, "GetPurchaseAllowances", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
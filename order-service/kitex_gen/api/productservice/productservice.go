@@ -69,6 +69,433 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"BatchGetProducts": kitex.NewMethodInfo(
+		batchGetProductsHandler,
+		newProductServiceBatchGetProductsArgs,
+		newProductServiceBatchGetProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListProductEvents": kitex.NewMethodInfo(
+		listProductEventsHandler,
+		newProductServiceListProductEventsArgs,
+		newProductServiceListProductEventsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AdjustStock": kitex.NewMethodInfo(
+		adjustStockHandler,
+		newProductServiceAdjustStockArgs,
+		newProductServiceAdjustStockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListStockMovements": kitex.NewMethodInfo(
+		listStockMovementsHandler,
+		newProductServiceListStockMovementsArgs,
+		newProductServiceListStockMovementsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReconcileStock": kitex.NewMethodInfo(
+		reconcileStockHandler,
+		newProductServiceReconcileStockArgs,
+		newProductServiceReconcileStockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateWarehouse": kitex.NewMethodInfo(
+		createWarehouseHandler,
+		newProductServiceCreateWarehouseArgs,
+		newProductServiceCreateWarehouseResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateWarehouse": kitex.NewMethodInfo(
+		updateWarehouseHandler,
+		newProductServiceUpdateWarehouseArgs,
+		newProductServiceUpdateWarehouseResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListWarehouses": kitex.NewMethodInfo(
+		listWarehousesHandler,
+		newProductServiceListWarehousesArgs,
+		newProductServiceListWarehousesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetWarehouseStocks": kitex.NewMethodInfo(
+		getWarehouseStocksHandler,
+		newProductServiceGetWarehouseStocksArgs,
+		newProductServiceGetWarehouseStocksResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListLowStockProducts": kitex.NewMethodInfo(
+		listLowStockProductsHandler,
+		newProductServiceListLowStockProductsArgs,
+		newProductServiceListLowStockProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreatePriceSchedule": kitex.NewMethodInfo(
+		createPriceScheduleHandler,
+		newProductServiceCreatePriceScheduleArgs,
+		newProductServiceCreatePriceScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListPriceSchedules": kitex.NewMethodInfo(
+		listPriceSchedulesHandler,
+		newProductServiceListPriceSchedulesArgs,
+		newProductServiceListPriceSchedulesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelPriceSchedule": kitex.NewMethodInfo(
+		cancelPriceScheduleHandler,
+		newProductServiceCancelPriceScheduleArgs,
+		newProductServiceCancelPriceScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPriceHistory": kitex.NewMethodInfo(
+		getPriceHistoryHandler,
+		newProductServiceGetPriceHistoryArgs,
+		newProductServiceGetPriceHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListProductStatusSchedules": kitex.NewMethodInfo(
+		listProductStatusSchedulesHandler,
+		newProductServiceListProductStatusSchedulesArgs,
+		newProductServiceListProductStatusSchedulesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelProductStatusSchedule": kitex.NewMethodInfo(
+		cancelProductStatusScheduleHandler,
+		newProductServiceCancelProductStatusScheduleArgs,
+		newProductServiceCancelProductStatusScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ImportProducts": kitex.NewMethodInfo(
+		importProductsHandler,
+		newProductServiceImportProductsArgs,
+		newProductServiceImportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateAttributeDefinition": kitex.NewMethodInfo(
+		createAttributeDefinitionHandler,
+		newProductServiceCreateAttributeDefinitionArgs,
+		newProductServiceCreateAttributeDefinitionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateAttributeDefinition": kitex.NewMethodInfo(
+		updateAttributeDefinitionHandler,
+		newProductServiceUpdateAttributeDefinitionArgs,
+		newProductServiceUpdateAttributeDefinitionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListAttributeDefinitions": kitex.NewMethodInfo(
+		listAttributeDefinitionsHandler,
+		newProductServiceListAttributeDefinitionsArgs,
+		newProductServiceListAttributeDefinitionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteAttributeDefinition": kitex.NewMethodInfo(
+		deleteAttributeDefinitionHandler,
+		newProductServiceDeleteAttributeDefinitionArgs,
+		newProductServiceDeleteAttributeDefinitionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateProductRating": kitex.NewMethodInfo(
+		updateProductRatingHandler,
+		newProductServiceUpdateProductRatingArgs,
+		newProductServiceUpdateProductRatingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListProductAudit": kitex.NewMethodInfo(
+		listProductAuditHandler,
+		newProductServiceListProductAuditArgs,
+		newProductServiceListProductAuditResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SyncPurchaseStats": kitex.NewMethodInfo(
+		syncPurchaseStatsHandler,
+		newProductServiceSyncPurchaseStatsArgs,
+		newProductServiceSyncPurchaseStatsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetRelatedProducts": kitex.NewMethodInfo(
+		getRelatedProductsHandler,
+		newProductServiceGetRelatedProductsArgs,
+		newProductServiceGetRelatedProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFrequentlyBoughtTogether": kitex.NewMethodInfo(
+		getFrequentlyBoughtTogetherHandler,
+		newProductServiceGetFrequentlyBoughtTogetherArgs,
+		newProductServiceGetFrequentlyBoughtTogetherResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateBrand": kitex.NewMethodInfo(
+		createBrandHandler,
+		newProductServiceCreateBrandArgs,
+		newProductServiceCreateBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateBrand": kitex.NewMethodInfo(
+		updateBrandHandler,
+		newProductServiceUpdateBrandArgs,
+		newProductServiceUpdateBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetBrand": kitex.NewMethodInfo(
+		getBrandHandler,
+		newProductServiceGetBrandArgs,
+		newProductServiceGetBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListBrands": kitex.NewMethodInfo(
+		listBrandsHandler,
+		newProductServiceListBrandsArgs,
+		newProductServiceListBrandsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteBrand": kitex.NewMethodInfo(
+		deleteBrandHandler,
+		newProductServiceDeleteBrandArgs,
+		newProductServiceDeleteBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubmitProductApproval": kitex.NewMethodInfo(
+		submitProductApprovalHandler,
+		newProductServiceSubmitProductApprovalArgs,
+		newProductServiceSubmitProductApprovalResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReviewProductApproval": kitex.NewMethodInfo(
+		reviewProductApprovalHandler,
+		newProductServiceReviewProductApprovalArgs,
+		newProductServiceReviewProductApprovalResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListProductApprovals": kitex.NewMethodInfo(
+		listProductApprovalsHandler,
+		newProductServiceListProductApprovalsArgs,
+		newProductServiceListProductApprovalsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateMerchant": kitex.NewMethodInfo(
+		createMerchantHandler,
+		newProductServiceCreateMerchantArgs,
+		newProductServiceCreateMerchantResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateMerchant": kitex.NewMethodInfo(
+		updateMerchantHandler,
+		newProductServiceUpdateMerchantArgs,
+		newProductServiceUpdateMerchantResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMerchant": kitex.NewMethodInfo(
+		getMerchantHandler,
+		newProductServiceGetMerchantArgs,
+		newProductServiceGetMerchantResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListMerchants": kitex.NewMethodInfo(
+		listMerchantsHandler,
+		newProductServiceListMerchantsArgs,
+		newProductServiceListMerchantsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SuggestProducts": kitex.NewMethodInfo(
+		suggestProductsHandler,
+		newProductServiceSuggestProductsArgs,
+		newProductServiceSuggestProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListSearchQueries": kitex.NewMethodInfo(
+		listSearchQueriesHandler,
+		newProductServiceListSearchQueriesArgs,
+		newProductServiceListSearchQueriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RecordProductSales": kitex.NewMethodInfo(
+		recordProductSalesHandler,
+		newProductServiceRecordProductSalesArgs,
+		newProductServiceRecordProductSalesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetTopProducts": kitex.NewMethodInfo(
+		getTopProductsHandler,
+		newProductServiceGetTopProductsArgs,
+		newProductServiceGetTopProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateBundleComponents": kitex.NewMethodInfo(
+		updateBundleComponentsHandler,
+		newProductServiceUpdateBundleComponentsArgs,
+		newProductServiceUpdateBundleComponentsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetProductTranslation": kitex.NewMethodInfo(
+		setProductTranslationHandler,
+		newProductServiceSetProductTranslationArgs,
+		newProductServiceSetProductTranslationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteProductTranslation": kitex.NewMethodInfo(
+		deleteProductTranslationHandler,
+		newProductServiceDeleteProductTranslationArgs,
+		newProductServiceDeleteProductTranslationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListProductTranslations": kitex.NewMethodInfo(
+		listProductTranslationsHandler,
+		newProductServiceListProductTranslationsArgs,
+		newProductServiceListProductTranslationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetCategoryTranslation": kitex.NewMethodInfo(
+		setCategoryTranslationHandler,
+		newProductServiceSetCategoryTranslationArgs,
+		newProductServiceSetCategoryTranslationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteCategoryTranslation": kitex.NewMethodInfo(
+		deleteCategoryTranslationHandler,
+		newProductServiceDeleteCategoryTranslationArgs,
+		newProductServiceDeleteCategoryTranslationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCategoryTranslations": kitex.NewMethodInfo(
+		listCategoryTranslationsHandler,
+		newProductServiceListCategoryTranslationsArgs,
+		newProductServiceListCategoryTranslationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AddFavorite": kitex.NewMethodInfo(
+		addFavoriteHandler,
+		newProductServiceAddFavoriteArgs,
+		newProductServiceAddFavoriteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RemoveFavorite": kitex.NewMethodInfo(
+		removeFavoriteHandler,
+		newProductServiceRemoveFavoriteArgs,
+		newProductServiceRemoveFavoriteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListFavorites": kitex.NewMethodInfo(
+		listFavoritesHandler,
+		newProductServiceListFavoritesArgs,
+		newProductServiceListFavoritesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubscribeRestock": kitex.NewMethodInfo(
+		subscribeRestockHandler,
+		newProductServiceSubscribeRestockArgs,
+		newProductServiceSubscribeRestockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UnsubscribeRestock": kitex.NewMethodInfo(
+		unsubscribeRestockHandler,
+		newProductServiceUnsubscribeRestockArgs,
+		newProductServiceUnsubscribeRestockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListRestockSubscriptions": kitex.NewMethodInfo(
+		listRestockSubscriptionsHandler,
+		newProductServiceListRestockSubscriptionsArgs,
+		newProductServiceListRestockSubscriptionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetProductPriceTiers": kitex.NewMethodInfo(
+		setProductPriceTiersHandler,
+		newProductServiceSetProductPriceTiersArgs,
+		newProductServiceSetProductPriceTiersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetProductPriceTiers": kitex.NewMethodInfo(
+		getProductPriceTiersHandler,
+		newProductServiceGetProductPriceTiersArgs,
+		newProductServiceGetProductPriceTiersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetUserPriceGroup": kitex.NewMethodInfo(
+		setUserPriceGroupHandler,
+		newProductServiceSetUserPriceGroupArgs,
+		newProductServiceSetUserPriceGroupResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListUserPriceGroups": kitex.NewMethodInfo(
+		listUserPriceGroupsHandler,
+		newProductServiceListUserPriceGroupsArgs,
+		newProductServiceListUserPriceGroupsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"QuotePrices": kitex.NewMethodInfo(
+		quotePricesHandler,
+		newProductServiceQuotePricesArgs,
+		newProductServiceQuotePricesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetProductPurchaseLimit": kitex.NewMethodInfo(
+		setProductPurchaseLimitHandler,
+		newProductServiceSetProductPurchaseLimitArgs,
+		newProductServiceSetProductPurchaseLimitResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -279,91 +706,1799 @@ func newProductServiceAdminSearchProductsResult() interface{} {
 	return api.NewProductServiceAdminSearchProductsResult()
 }
 
-type kClient struct {
-	c client.Client
+func batchGetProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceBatchGetProductsArgs)
+	realResult := result.(*api.ProductServiceBatchGetProductsResult)
+	success, err := handler.(api.ProductService).BatchGetProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceBatchGetProductsArgs() interface{} {
+	return api.NewProductServiceBatchGetProductsArgs()
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
-	}
+func newProductServiceBatchGetProductsResult() interface{} {
+	return api.NewProductServiceBatchGetProductsResult()
 }
 
-func (p *kClient) CreateProduct(ctx context.Context, req *api.CreateProductReq) (r *api.CreateProductResp, err error) {
-	var _args api.ProductServiceCreateProductArgs
-	_args.Req = req
-	var _result api.ProductServiceCreateProductResult
-	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
-		return
+func listProductEventsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListProductEventsArgs)
+	realResult := result.(*api.ProductServiceListProductEventsResult)
+	success, err := handler.(api.ProductService).ListProductEvents(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListProductEventsArgs() interface{} {
+	return api.NewProductServiceListProductEventsArgs()
 }
 
-func (p *kClient) GetProduct(ctx context.Context, req *api.GetProductReq) (r *api.GetProductResp, err error) {
-	var _args api.ProductServiceGetProductArgs
-	_args.Req = req
-	var _result api.ProductServiceGetProductResult
-	if err = p.c.Call(ctx, "GetProduct", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newProductServiceListProductEventsResult() interface{} {
+	return api.NewProductServiceListProductEventsResult()
 }
 
-func (p *kClient) UpdateProduct(ctx context.Context, req *api.UpdateProductReq) (r *api.UpdateProductResp, err error) {
-	var _args api.ProductServiceUpdateProductArgs
-	_args.Req = req
-	var _result api.ProductServiceUpdateProductResult
-	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
-		return
+func adjustStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceAdjustStockArgs)
+	realResult := result.(*api.ProductServiceAdjustStockResult)
+	success, err := handler.(api.ProductService).AdjustStock(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newProductServiceAdjustStockArgs() interface{} {
+	return api.NewProductServiceAdjustStockArgs()
 }
 
-func (p *kClient) OnlineProduct(ctx context.Context, req *api.OnlineProductReq) (r *api.OnlineProductResp, err error) {
-	var _args api.ProductServiceOnlineProductArgs
-	_args.Req = req
-	var _result api.ProductServiceOnlineProductResult
-	if err = p.c.Call(ctx, "OnlineProduct", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newProductServiceAdjustStockResult() interface{} {
+	return api.NewProductServiceAdjustStockResult()
 }
 
-func (p *kClient) OfflineProduct(ctx context.Context, req *api.OfflineProductReq) (r *api.OfflineProductResp, err error) {
-	var _args api.ProductServiceOfflineProductArgs
-	_args.Req = req
-	var _result api.ProductServiceOfflineProductResult
-	if err = p.c.Call(ctx, "OfflineProduct", &_args, &_result); err != nil {
-		return
+func listStockMovementsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListStockMovementsArgs)
+	realResult := result.(*api.ProductServiceListStockMovementsResult)
+	success, err := handler.(api.ProductService).ListStockMovements(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListStockMovementsArgs() interface{} {
+	return api.NewProductServiceListStockMovementsArgs()
 }
 
-func (p *kClient) DeleteProduct(ctx context.Context, req *api.DeleteProductReq) (r *api.DeleteProductResp, err error) {
-	var _args api.ProductServiceDeleteProductArgs
-	_args.Req = req
-	var _result api.ProductServiceDeleteProductResult
-	if err = p.c.Call(ctx, "DeleteProduct", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newProductServiceListStockMovementsResult() interface{} {
+	return api.NewProductServiceListStockMovementsResult()
 }
 
-func (p *kClient) UserSearchProducts(ctx context.Context, req *api.UserSearchProductsReq) (r *api.UserSearchProductsResp, err error) {
+func reconcileStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceReconcileStockArgs)
+	realResult := result.(*api.ProductServiceReconcileStockResult)
+	success, err := handler.(api.ProductService).ReconcileStock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceReconcileStockArgs() interface{} {
+	return api.NewProductServiceReconcileStockArgs()
+}
+
+func newProductServiceReconcileStockResult() interface{} {
+	return api.NewProductServiceReconcileStockResult()
+}
+
+func createWarehouseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCreateWarehouseArgs)
+	realResult := result.(*api.ProductServiceCreateWarehouseResult)
+	success, err := handler.(api.ProductService).CreateWarehouse(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCreateWarehouseArgs() interface{} {
+	return api.NewProductServiceCreateWarehouseArgs()
+}
+
+func newProductServiceCreateWarehouseResult() interface{} {
+	return api.NewProductServiceCreateWarehouseResult()
+}
+
+func updateWarehouseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUpdateWarehouseArgs)
+	realResult := result.(*api.ProductServiceUpdateWarehouseResult)
+	success, err := handler.(api.ProductService).UpdateWarehouse(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUpdateWarehouseArgs() interface{} {
+	return api.NewProductServiceUpdateWarehouseArgs()
+}
+
+func newProductServiceUpdateWarehouseResult() interface{} {
+	return api.NewProductServiceUpdateWarehouseResult()
+}
+
+func listWarehousesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListWarehousesArgs)
+	realResult := result.(*api.ProductServiceListWarehousesResult)
+	success, err := handler.(api.ProductService).ListWarehouses(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListWarehousesArgs() interface{} {
+	return api.NewProductServiceListWarehousesArgs()
+}
+
+func newProductServiceListWarehousesResult() interface{} {
+	return api.NewProductServiceListWarehousesResult()
+}
+
+func getWarehouseStocksHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetWarehouseStocksArgs)
+	realResult := result.(*api.ProductServiceGetWarehouseStocksResult)
+	success, err := handler.(api.ProductService).GetWarehouseStocks(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetWarehouseStocksArgs() interface{} {
+	return api.NewProductServiceGetWarehouseStocksArgs()
+}
+
+func newProductServiceGetWarehouseStocksResult() interface{} {
+	return api.NewProductServiceGetWarehouseStocksResult()
+}
+
+func listLowStockProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListLowStockProductsArgs)
+	realResult := result.(*api.ProductServiceListLowStockProductsResult)
+	success, err := handler.(api.ProductService).ListLowStockProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListLowStockProductsArgs() interface{} {
+	return api.NewProductServiceListLowStockProductsArgs()
+}
+
+func newProductServiceListLowStockProductsResult() interface{} {
+	return api.NewProductServiceListLowStockProductsResult()
+}
+
+func createPriceScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCreatePriceScheduleArgs)
+	realResult := result.(*api.ProductServiceCreatePriceScheduleResult)
+	success, err := handler.(api.ProductService).CreatePriceSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCreatePriceScheduleArgs() interface{} {
+	return api.NewProductServiceCreatePriceScheduleArgs()
+}
+
+func newProductServiceCreatePriceScheduleResult() interface{} {
+	return api.NewProductServiceCreatePriceScheduleResult()
+}
+
+func listPriceSchedulesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListPriceSchedulesArgs)
+	realResult := result.(*api.ProductServiceListPriceSchedulesResult)
+	success, err := handler.(api.ProductService).ListPriceSchedules(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListPriceSchedulesArgs() interface{} {
+	return api.NewProductServiceListPriceSchedulesArgs()
+}
+
+func newProductServiceListPriceSchedulesResult() interface{} {
+	return api.NewProductServiceListPriceSchedulesResult()
+}
+
+func cancelPriceScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCancelPriceScheduleArgs)
+	realResult := result.(*api.ProductServiceCancelPriceScheduleResult)
+	success, err := handler.(api.ProductService).CancelPriceSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCancelPriceScheduleArgs() interface{} {
+	return api.NewProductServiceCancelPriceScheduleArgs()
+}
+
+func newProductServiceCancelPriceScheduleResult() interface{} {
+	return api.NewProductServiceCancelPriceScheduleResult()
+}
+
+func getPriceHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetPriceHistoryArgs)
+	realResult := result.(*api.ProductServiceGetPriceHistoryResult)
+	success, err := handler.(api.ProductService).GetPriceHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetPriceHistoryArgs() interface{} {
+	return api.NewProductServiceGetPriceHistoryArgs()
+}
+
+func newProductServiceGetPriceHistoryResult() interface{} {
+	return api.NewProductServiceGetPriceHistoryResult()
+}
+
+func listProductStatusSchedulesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListProductStatusSchedulesArgs)
+	realResult := result.(*api.ProductServiceListProductStatusSchedulesResult)
+	success, err := handler.(api.ProductService).ListProductStatusSchedules(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListProductStatusSchedulesArgs() interface{} {
+	return api.NewProductServiceListProductStatusSchedulesArgs()
+}
+
+func newProductServiceListProductStatusSchedulesResult() interface{} {
+	return api.NewProductServiceListProductStatusSchedulesResult()
+}
+
+func cancelProductStatusScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCancelProductStatusScheduleArgs)
+	realResult := result.(*api.ProductServiceCancelProductStatusScheduleResult)
+	success, err := handler.(api.ProductService).CancelProductStatusSchedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCancelProductStatusScheduleArgs() interface{} {
+	return api.NewProductServiceCancelProductStatusScheduleArgs()
+}
+
+func newProductServiceCancelProductStatusScheduleResult() interface{} {
+	return api.NewProductServiceCancelProductStatusScheduleResult()
+}
+
+func importProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceImportProductsArgs)
+	realResult := result.(*api.ProductServiceImportProductsResult)
+	success, err := handler.(api.ProductService).ImportProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceImportProductsArgs() interface{} {
+	return api.NewProductServiceImportProductsArgs()
+}
+
+func newProductServiceImportProductsResult() interface{} {
+	return api.NewProductServiceImportProductsResult()
+}
+
+func createAttributeDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCreateAttributeDefinitionArgs)
+	realResult := result.(*api.ProductServiceCreateAttributeDefinitionResult)
+	success, err := handler.(api.ProductService).CreateAttributeDefinition(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCreateAttributeDefinitionArgs() interface{} {
+	return api.NewProductServiceCreateAttributeDefinitionArgs()
+}
+
+func newProductServiceCreateAttributeDefinitionResult() interface{} {
+	return api.NewProductServiceCreateAttributeDefinitionResult()
+}
+
+func updateAttributeDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUpdateAttributeDefinitionArgs)
+	realResult := result.(*api.ProductServiceUpdateAttributeDefinitionResult)
+	success, err := handler.(api.ProductService).UpdateAttributeDefinition(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUpdateAttributeDefinitionArgs() interface{} {
+	return api.NewProductServiceUpdateAttributeDefinitionArgs()
+}
+
+func newProductServiceUpdateAttributeDefinitionResult() interface{} {
+	return api.NewProductServiceUpdateAttributeDefinitionResult()
+}
+
+func listAttributeDefinitionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListAttributeDefinitionsArgs)
+	realResult := result.(*api.ProductServiceListAttributeDefinitionsResult)
+	success, err := handler.(api.ProductService).ListAttributeDefinitions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListAttributeDefinitionsArgs() interface{} {
+	return api.NewProductServiceListAttributeDefinitionsArgs()
+}
+
+func newProductServiceListAttributeDefinitionsResult() interface{} {
+	return api.NewProductServiceListAttributeDefinitionsResult()
+}
+
+func deleteAttributeDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceDeleteAttributeDefinitionArgs)
+	realResult := result.(*api.ProductServiceDeleteAttributeDefinitionResult)
+	success, err := handler.(api.ProductService).DeleteAttributeDefinition(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceDeleteAttributeDefinitionArgs() interface{} {
+	return api.NewProductServiceDeleteAttributeDefinitionArgs()
+}
+
+func newProductServiceDeleteAttributeDefinitionResult() interface{} {
+	return api.NewProductServiceDeleteAttributeDefinitionResult()
+}
+
+func updateProductRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUpdateProductRatingArgs)
+	realResult := result.(*api.ProductServiceUpdateProductRatingResult)
+	success, err := handler.(api.ProductService).UpdateProductRating(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUpdateProductRatingArgs() interface{} {
+	return api.NewProductServiceUpdateProductRatingArgs()
+}
+
+func newProductServiceUpdateProductRatingResult() interface{} {
+	return api.NewProductServiceUpdateProductRatingResult()
+}
+
+func listProductAuditHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListProductAuditArgs)
+	realResult := result.(*api.ProductServiceListProductAuditResult)
+	success, err := handler.(api.ProductService).ListProductAudit(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListProductAuditArgs() interface{} {
+	return api.NewProductServiceListProductAuditArgs()
+}
+
+func newProductServiceListProductAuditResult() interface{} {
+	return api.NewProductServiceListProductAuditResult()
+}
+
+func syncPurchaseStatsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSyncPurchaseStatsArgs)
+	realResult := result.(*api.ProductServiceSyncPurchaseStatsResult)
+	success, err := handler.(api.ProductService).SyncPurchaseStats(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSyncPurchaseStatsArgs() interface{} {
+	return api.NewProductServiceSyncPurchaseStatsArgs()
+}
+
+func newProductServiceSyncPurchaseStatsResult() interface{} {
+	return api.NewProductServiceSyncPurchaseStatsResult()
+}
+
+func getRelatedProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetRelatedProductsArgs)
+	realResult := result.(*api.ProductServiceGetRelatedProductsResult)
+	success, err := handler.(api.ProductService).GetRelatedProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetRelatedProductsArgs() interface{} {
+	return api.NewProductServiceGetRelatedProductsArgs()
+}
+
+func newProductServiceGetRelatedProductsResult() interface{} {
+	return api.NewProductServiceGetRelatedProductsResult()
+}
+
+func getFrequentlyBoughtTogetherHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetFrequentlyBoughtTogetherArgs)
+	realResult := result.(*api.ProductServiceGetFrequentlyBoughtTogetherResult)
+	success, err := handler.(api.ProductService).GetFrequentlyBoughtTogether(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetFrequentlyBoughtTogetherArgs() interface{} {
+	return api.NewProductServiceGetFrequentlyBoughtTogetherArgs()
+}
+
+func newProductServiceGetFrequentlyBoughtTogetherResult() interface{} {
+	return api.NewProductServiceGetFrequentlyBoughtTogetherResult()
+}
+
+func createBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCreateBrandArgs)
+	realResult := result.(*api.ProductServiceCreateBrandResult)
+	success, err := handler.(api.ProductService).CreateBrand(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCreateBrandArgs() interface{} {
+	return api.NewProductServiceCreateBrandArgs()
+}
+
+func newProductServiceCreateBrandResult() interface{} {
+	return api.NewProductServiceCreateBrandResult()
+}
+
+func updateBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUpdateBrandArgs)
+	realResult := result.(*api.ProductServiceUpdateBrandResult)
+	success, err := handler.(api.ProductService).UpdateBrand(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUpdateBrandArgs() interface{} {
+	return api.NewProductServiceUpdateBrandArgs()
+}
+
+func newProductServiceUpdateBrandResult() interface{} {
+	return api.NewProductServiceUpdateBrandResult()
+}
+
+func getBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetBrandArgs)
+	realResult := result.(*api.ProductServiceGetBrandResult)
+	success, err := handler.(api.ProductService).GetBrand(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetBrandArgs() interface{} {
+	return api.NewProductServiceGetBrandArgs()
+}
+
+func newProductServiceGetBrandResult() interface{} {
+	return api.NewProductServiceGetBrandResult()
+}
+
+func listBrandsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListBrandsArgs)
+	realResult := result.(*api.ProductServiceListBrandsResult)
+	success, err := handler.(api.ProductService).ListBrands(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListBrandsArgs() interface{} {
+	return api.NewProductServiceListBrandsArgs()
+}
+
+func newProductServiceListBrandsResult() interface{} {
+	return api.NewProductServiceListBrandsResult()
+}
+
+func deleteBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceDeleteBrandArgs)
+	realResult := result.(*api.ProductServiceDeleteBrandResult)
+	success, err := handler.(api.ProductService).DeleteBrand(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceDeleteBrandArgs() interface{} {
+	return api.NewProductServiceDeleteBrandArgs()
+}
+
+func newProductServiceDeleteBrandResult() interface{} {
+	return api.NewProductServiceDeleteBrandResult()
+}
+
+func submitProductApprovalHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSubmitProductApprovalArgs)
+	realResult := result.(*api.ProductServiceSubmitProductApprovalResult)
+	success, err := handler.(api.ProductService).SubmitProductApproval(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSubmitProductApprovalArgs() interface{} {
+	return api.NewProductServiceSubmitProductApprovalArgs()
+}
+
+func newProductServiceSubmitProductApprovalResult() interface{} {
+	return api.NewProductServiceSubmitProductApprovalResult()
+}
+
+func reviewProductApprovalHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceReviewProductApprovalArgs)
+	realResult := result.(*api.ProductServiceReviewProductApprovalResult)
+	success, err := handler.(api.ProductService).ReviewProductApproval(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceReviewProductApprovalArgs() interface{} {
+	return api.NewProductServiceReviewProductApprovalArgs()
+}
+
+func newProductServiceReviewProductApprovalResult() interface{} {
+	return api.NewProductServiceReviewProductApprovalResult()
+}
+
+func listProductApprovalsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListProductApprovalsArgs)
+	realResult := result.(*api.ProductServiceListProductApprovalsResult)
+	success, err := handler.(api.ProductService).ListProductApprovals(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListProductApprovalsArgs() interface{} {
+	return api.NewProductServiceListProductApprovalsArgs()
+}
+
+func newProductServiceListProductApprovalsResult() interface{} {
+	return api.NewProductServiceListProductApprovalsResult()
+}
+
+func createMerchantHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceCreateMerchantArgs)
+	realResult := result.(*api.ProductServiceCreateMerchantResult)
+	success, err := handler.(api.ProductService).CreateMerchant(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceCreateMerchantArgs() interface{} {
+	return api.NewProductServiceCreateMerchantArgs()
+}
+
+func newProductServiceCreateMerchantResult() interface{} {
+	return api.NewProductServiceCreateMerchantResult()
+}
+
+func updateMerchantHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUpdateMerchantArgs)
+	realResult := result.(*api.ProductServiceUpdateMerchantResult)
+	success, err := handler.(api.ProductService).UpdateMerchant(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUpdateMerchantArgs() interface{} {
+	return api.NewProductServiceUpdateMerchantArgs()
+}
+
+func newProductServiceUpdateMerchantResult() interface{} {
+	return api.NewProductServiceUpdateMerchantResult()
+}
+
+func getMerchantHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetMerchantArgs)
+	realResult := result.(*api.ProductServiceGetMerchantResult)
+	success, err := handler.(api.ProductService).GetMerchant(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetMerchantArgs() interface{} {
+	return api.NewProductServiceGetMerchantArgs()
+}
+
+func newProductServiceGetMerchantResult() interface{} {
+	return api.NewProductServiceGetMerchantResult()
+}
+
+func listMerchantsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListMerchantsArgs)
+	realResult := result.(*api.ProductServiceListMerchantsResult)
+	success, err := handler.(api.ProductService).ListMerchants(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListMerchantsArgs() interface{} {
+	return api.NewProductServiceListMerchantsArgs()
+}
+
+func newProductServiceListMerchantsResult() interface{} {
+	return api.NewProductServiceListMerchantsResult()
+}
+
+func suggestProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSuggestProductsArgs)
+	realResult := result.(*api.ProductServiceSuggestProductsResult)
+	success, err := handler.(api.ProductService).SuggestProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSuggestProductsArgs() interface{} {
+	return api.NewProductServiceSuggestProductsArgs()
+}
+
+func newProductServiceSuggestProductsResult() interface{} {
+	return api.NewProductServiceSuggestProductsResult()
+}
+
+func listSearchQueriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListSearchQueriesArgs)
+	realResult := result.(*api.ProductServiceListSearchQueriesResult)
+	success, err := handler.(api.ProductService).ListSearchQueries(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListSearchQueriesArgs() interface{} {
+	return api.NewProductServiceListSearchQueriesArgs()
+}
+
+func newProductServiceListSearchQueriesResult() interface{} {
+	return api.NewProductServiceListSearchQueriesResult()
+}
+
+func recordProductSalesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceRecordProductSalesArgs)
+	realResult := result.(*api.ProductServiceRecordProductSalesResult)
+	success, err := handler.(api.ProductService).RecordProductSales(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceRecordProductSalesArgs() interface{} {
+	return api.NewProductServiceRecordProductSalesArgs()
+}
+
+func newProductServiceRecordProductSalesResult() interface{} {
+	return api.NewProductServiceRecordProductSalesResult()
+}
+
+func getTopProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetTopProductsArgs)
+	realResult := result.(*api.ProductServiceGetTopProductsResult)
+	success, err := handler.(api.ProductService).GetTopProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetTopProductsArgs() interface{} {
+	return api.NewProductServiceGetTopProductsArgs()
+}
+
+func newProductServiceGetTopProductsResult() interface{} {
+	return api.NewProductServiceGetTopProductsResult()
+}
+
+func updateBundleComponentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUpdateBundleComponentsArgs)
+	realResult := result.(*api.ProductServiceUpdateBundleComponentsResult)
+	success, err := handler.(api.ProductService).UpdateBundleComponents(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUpdateBundleComponentsArgs() interface{} {
+	return api.NewProductServiceUpdateBundleComponentsArgs()
+}
+
+func newProductServiceUpdateBundleComponentsResult() interface{} {
+	return api.NewProductServiceUpdateBundleComponentsResult()
+}
+
+func setProductTranslationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSetProductTranslationArgs)
+	realResult := result.(*api.ProductServiceSetProductTranslationResult)
+	success, err := handler.(api.ProductService).SetProductTranslation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSetProductTranslationArgs() interface{} {
+	return api.NewProductServiceSetProductTranslationArgs()
+}
+
+func newProductServiceSetProductTranslationResult() interface{} {
+	return api.NewProductServiceSetProductTranslationResult()
+}
+
+func deleteProductTranslationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceDeleteProductTranslationArgs)
+	realResult := result.(*api.ProductServiceDeleteProductTranslationResult)
+	success, err := handler.(api.ProductService).DeleteProductTranslation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceDeleteProductTranslationArgs() interface{} {
+	return api.NewProductServiceDeleteProductTranslationArgs()
+}
+
+func newProductServiceDeleteProductTranslationResult() interface{} {
+	return api.NewProductServiceDeleteProductTranslationResult()
+}
+
+func listProductTranslationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListProductTranslationsArgs)
+	realResult := result.(*api.ProductServiceListProductTranslationsResult)
+	success, err := handler.(api.ProductService).ListProductTranslations(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListProductTranslationsArgs() interface{} {
+	return api.NewProductServiceListProductTranslationsArgs()
+}
+
+func newProductServiceListProductTranslationsResult() interface{} {
+	return api.NewProductServiceListProductTranslationsResult()
+}
+
+func setCategoryTranslationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSetCategoryTranslationArgs)
+	realResult := result.(*api.ProductServiceSetCategoryTranslationResult)
+	success, err := handler.(api.ProductService).SetCategoryTranslation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSetCategoryTranslationArgs() interface{} {
+	return api.NewProductServiceSetCategoryTranslationArgs()
+}
+
+func newProductServiceSetCategoryTranslationResult() interface{} {
+	return api.NewProductServiceSetCategoryTranslationResult()
+}
+
+func deleteCategoryTranslationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceDeleteCategoryTranslationArgs)
+	realResult := result.(*api.ProductServiceDeleteCategoryTranslationResult)
+	success, err := handler.(api.ProductService).DeleteCategoryTranslation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceDeleteCategoryTranslationArgs() interface{} {
+	return api.NewProductServiceDeleteCategoryTranslationArgs()
+}
+
+func newProductServiceDeleteCategoryTranslationResult() interface{} {
+	return api.NewProductServiceDeleteCategoryTranslationResult()
+}
+
+func listCategoryTranslationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListCategoryTranslationsArgs)
+	realResult := result.(*api.ProductServiceListCategoryTranslationsResult)
+	success, err := handler.(api.ProductService).ListCategoryTranslations(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListCategoryTranslationsArgs() interface{} {
+	return api.NewProductServiceListCategoryTranslationsArgs()
+}
+
+func newProductServiceListCategoryTranslationsResult() interface{} {
+	return api.NewProductServiceListCategoryTranslationsResult()
+}
+
+func addFavoriteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceAddFavoriteArgs)
+	realResult := result.(*api.ProductServiceAddFavoriteResult)
+	success, err := handler.(api.ProductService).AddFavorite(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceAddFavoriteArgs() interface{} {
+	return api.NewProductServiceAddFavoriteArgs()
+}
+
+func newProductServiceAddFavoriteResult() interface{} {
+	return api.NewProductServiceAddFavoriteResult()
+}
+
+func removeFavoriteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceRemoveFavoriteArgs)
+	realResult := result.(*api.ProductServiceRemoveFavoriteResult)
+	success, err := handler.(api.ProductService).RemoveFavorite(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceRemoveFavoriteArgs() interface{} {
+	return api.NewProductServiceRemoveFavoriteArgs()
+}
+
+func newProductServiceRemoveFavoriteResult() interface{} {
+	return api.NewProductServiceRemoveFavoriteResult()
+}
+
+func listFavoritesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListFavoritesArgs)
+	realResult := result.(*api.ProductServiceListFavoritesResult)
+	success, err := handler.(api.ProductService).ListFavorites(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListFavoritesArgs() interface{} {
+	return api.NewProductServiceListFavoritesArgs()
+}
+
+func newProductServiceListFavoritesResult() interface{} {
+	return api.NewProductServiceListFavoritesResult()
+}
+
+func subscribeRestockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSubscribeRestockArgs)
+	realResult := result.(*api.ProductServiceSubscribeRestockResult)
+	success, err := handler.(api.ProductService).SubscribeRestock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSubscribeRestockArgs() interface{} {
+	return api.NewProductServiceSubscribeRestockArgs()
+}
+
+func newProductServiceSubscribeRestockResult() interface{} {
+	return api.NewProductServiceSubscribeRestockResult()
+}
+
+func unsubscribeRestockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceUnsubscribeRestockArgs)
+	realResult := result.(*api.ProductServiceUnsubscribeRestockResult)
+	success, err := handler.(api.ProductService).UnsubscribeRestock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceUnsubscribeRestockArgs() interface{} {
+	return api.NewProductServiceUnsubscribeRestockArgs()
+}
+
+func newProductServiceUnsubscribeRestockResult() interface{} {
+	return api.NewProductServiceUnsubscribeRestockResult()
+}
+
+func listRestockSubscriptionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListRestockSubscriptionsArgs)
+	realResult := result.(*api.ProductServiceListRestockSubscriptionsResult)
+	success, err := handler.(api.ProductService).ListRestockSubscriptions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListRestockSubscriptionsArgs() interface{} {
+	return api.NewProductServiceListRestockSubscriptionsArgs()
+}
+
+func newProductServiceListRestockSubscriptionsResult() interface{} {
+	return api.NewProductServiceListRestockSubscriptionsResult()
+}
+
+func setProductPriceTiersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSetProductPriceTiersArgs)
+	realResult := result.(*api.ProductServiceSetProductPriceTiersResult)
+	success, err := handler.(api.ProductService).SetProductPriceTiers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSetProductPriceTiersArgs() interface{} {
+	return api.NewProductServiceSetProductPriceTiersArgs()
+}
+
+func newProductServiceSetProductPriceTiersResult() interface{} {
+	return api.NewProductServiceSetProductPriceTiersResult()
+}
+
+func getProductPriceTiersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceGetProductPriceTiersArgs)
+	realResult := result.(*api.ProductServiceGetProductPriceTiersResult)
+	success, err := handler.(api.ProductService).GetProductPriceTiers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceGetProductPriceTiersArgs() interface{} {
+	return api.NewProductServiceGetProductPriceTiersArgs()
+}
+
+func newProductServiceGetProductPriceTiersResult() interface{} {
+	return api.NewProductServiceGetProductPriceTiersResult()
+}
+
+func setUserPriceGroupHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSetUserPriceGroupArgs)
+	realResult := result.(*api.ProductServiceSetUserPriceGroupResult)
+	success, err := handler.(api.ProductService).SetUserPriceGroup(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSetUserPriceGroupArgs() interface{} {
+	return api.NewProductServiceSetUserPriceGroupArgs()
+}
+
+func newProductServiceSetUserPriceGroupResult() interface{} {
+	return api.NewProductServiceSetUserPriceGroupResult()
+}
+
+func listUserPriceGroupsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceListUserPriceGroupsArgs)
+	realResult := result.(*api.ProductServiceListUserPriceGroupsResult)
+	success, err := handler.(api.ProductService).ListUserPriceGroups(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceListUserPriceGroupsArgs() interface{} {
+	return api.NewProductServiceListUserPriceGroupsArgs()
+}
+
+func newProductServiceListUserPriceGroupsResult() interface{} {
+	return api.NewProductServiceListUserPriceGroupsResult()
+}
+
+func quotePricesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceQuotePricesArgs)
+	realResult := result.(*api.ProductServiceQuotePricesResult)
+	success, err := handler.(api.ProductService).QuotePrices(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceQuotePricesArgs() interface{} {
+	return api.NewProductServiceQuotePricesArgs()
+}
+
+func newProductServiceQuotePricesResult() interface{} {
+	return api.NewProductServiceQuotePricesResult()
+}
+
+func setProductPurchaseLimitHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.ProductServiceSetProductPurchaseLimitArgs)
+	realResult := result.(*api.ProductServiceSetProductPurchaseLimitResult)
+	success, err := handler.(api.ProductService).SetProductPurchaseLimit(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newProductServiceSetProductPurchaseLimitArgs() interface{} {
+	return api.NewProductServiceSetProductPurchaseLimitArgs()
+}
+
+func newProductServiceSetProductPurchaseLimitResult() interface{} {
+	return api.NewProductServiceSetProductPurchaseLimitResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreateProduct(ctx context.Context, req *api.CreateProductReq) (r *api.CreateProductResp, err error) {
+	var _args api.ProductServiceCreateProductArgs
+	_args.Req = req
+	var _result api.ProductServiceCreateProductResult
+	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProduct(ctx context.Context, req *api.GetProductReq) (r *api.GetProductResp, err error) {
+	var _args api.ProductServiceGetProductArgs
+	_args.Req = req
+	var _result api.ProductServiceGetProductResult
+	if err = p.c.Call(ctx, "GetProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProduct(ctx context.Context, req *api.UpdateProductReq) (r *api.UpdateProductResp, err error) {
+	var _args api.ProductServiceUpdateProductArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateProductResult
+	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) OnlineProduct(ctx context.Context, req *api.OnlineProductReq) (r *api.OnlineProductResp, err error) {
+	var _args api.ProductServiceOnlineProductArgs
+	_args.Req = req
+	var _result api.ProductServiceOnlineProductResult
+	if err = p.c.Call(ctx, "OnlineProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) OfflineProduct(ctx context.Context, req *api.OfflineProductReq) (r *api.OfflineProductResp, err error) {
+	var _args api.ProductServiceOfflineProductArgs
+	_args.Req = req
+	var _result api.ProductServiceOfflineProductResult
+	if err = p.c.Call(ctx, "OfflineProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteProduct(ctx context.Context, req *api.DeleteProductReq) (r *api.DeleteProductResp, err error) {
+	var _args api.ProductServiceDeleteProductArgs
+	_args.Req = req
+	var _result api.ProductServiceDeleteProductResult
+	if err = p.c.Call(ctx, "DeleteProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UserSearchProducts(ctx context.Context, req *api.UserSearchProductsReq) (r *api.UserSearchProductsResp, err error) {
 	var _args api.ProductServiceUserSearchProductsArgs
 	_args.Req = req
-	var _result api.ProductServiceUserSearchProductsResult
-	if err = p.c.Call(ctx, "UserSearchProducts", &_args, &_result); err != nil {
+	var _result api.ProductServiceUserSearchProductsResult
+	if err = p.c.Call(ctx, "UserSearchProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AdminSearchProducts(ctx context.Context, req *api.AdminSearchProductsReq) (r *api.AdminSearchProductsResp, err error) {
+	var _args api.ProductServiceAdminSearchProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceAdminSearchProductsResult
+	if err = p.c.Call(ctx, "AdminSearchProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchGetProducts(ctx context.Context, req *api.BatchGetProductsReq) (r *api.BatchGetProductsResp, err error) {
+	var _args api.ProductServiceBatchGetProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceBatchGetProductsResult
+	if err = p.c.Call(ctx, "BatchGetProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductEvents(ctx context.Context, req *api.ListProductEventsReq) (r *api.ListProductEventsResp, err error) {
+	var _args api.ProductServiceListProductEventsArgs
+	_args.Req = req
+	var _result api.ProductServiceListProductEventsResult
+	if err = p.c.Call(ctx, "ListProductEvents", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AdjustStock(ctx context.Context, req *api.AdjustStockReq) (r *api.AdjustStockResp, err error) {
+	var _args api.ProductServiceAdjustStockArgs
+	_args.Req = req
+	var _result api.ProductServiceAdjustStockResult
+	if err = p.c.Call(ctx, "AdjustStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq) (r *api.ListStockMovementsResp, err error) {
+	var _args api.ProductServiceListStockMovementsArgs
+	_args.Req = req
+	var _result api.ProductServiceListStockMovementsResult
+	if err = p.c.Call(ctx, "ListStockMovements", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReconcileStock(ctx context.Context, req *api.ReconcileStockReq) (r *api.ReconcileStockResp, err error) {
+	var _args api.ProductServiceReconcileStockArgs
+	_args.Req = req
+	var _result api.ProductServiceReconcileStockResult
+	if err = p.c.Call(ctx, "ReconcileStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq) (r *api.CreateWarehouseResp, err error) {
+	var _args api.ProductServiceCreateWarehouseArgs
+	_args.Req = req
+	var _result api.ProductServiceCreateWarehouseResult
+	if err = p.c.Call(ctx, "CreateWarehouse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq) (r *api.UpdateWarehouseResp, err error) {
+	var _args api.ProductServiceUpdateWarehouseArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateWarehouseResult
+	if err = p.c.Call(ctx, "UpdateWarehouse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListWarehouses(ctx context.Context, req *api.ListWarehousesReq) (r *api.ListWarehousesResp, err error) {
+	var _args api.ProductServiceListWarehousesArgs
+	_args.Req = req
+	var _result api.ProductServiceListWarehousesResult
+	if err = p.c.Call(ctx, "ListWarehouses", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetWarehouseStocks(ctx context.Context, req *api.GetWarehouseStocksReq) (r *api.GetWarehouseStocksResp, err error) {
+	var _args api.ProductServiceGetWarehouseStocksArgs
+	_args.Req = req
+	var _result api.ProductServiceGetWarehouseStocksResult
+	if err = p.c.Call(ctx, "GetWarehouseStocks", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListLowStockProducts(ctx context.Context, req *api.ListLowStockProductsReq) (r *api.ListLowStockProductsResp, err error) {
+	var _args api.ProductServiceListLowStockProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceListLowStockProductsResult
+	if err = p.c.Call(ctx, "ListLowStockProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq) (r *api.CreatePriceScheduleResp, err error) {
+	var _args api.ProductServiceCreatePriceScheduleArgs
+	_args.Req = req
+	var _result api.ProductServiceCreatePriceScheduleResult
+	if err = p.c.Call(ctx, "CreatePriceSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq) (r *api.ListPriceSchedulesResp, err error) {
+	var _args api.ProductServiceListPriceSchedulesArgs
+	_args.Req = req
+	var _result api.ProductServiceListPriceSchedulesResult
+	if err = p.c.Call(ctx, "ListPriceSchedules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelPriceSchedule(ctx context.Context, req *api.CancelPriceScheduleReq) (r *api.CancelPriceScheduleResp, err error) {
+	var _args api.ProductServiceCancelPriceScheduleArgs
+	_args.Req = req
+	var _result api.ProductServiceCancelPriceScheduleResult
+	if err = p.c.Call(ctx, "CancelPriceSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq) (r *api.GetPriceHistoryResp, err error) {
+	var _args api.ProductServiceGetPriceHistoryArgs
+	_args.Req = req
+	var _result api.ProductServiceGetPriceHistoryResult
+	if err = p.c.Call(ctx, "GetPriceHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq) (r *api.ListProductStatusSchedulesResp, err error) {
+	var _args api.ProductServiceListProductStatusSchedulesArgs
+	_args.Req = req
+	var _result api.ProductServiceListProductStatusSchedulesResult
+	if err = p.c.Call(ctx, "ListProductStatusSchedules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelProductStatusSchedule(ctx context.Context, req *api.CancelProductStatusScheduleReq) (r *api.CancelProductStatusScheduleResp, err error) {
+	var _args api.ProductServiceCancelProductStatusScheduleArgs
+	_args.Req = req
+	var _result api.ProductServiceCancelProductStatusScheduleResult
+	if err = p.c.Call(ctx, "CancelProductStatusSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ImportProducts(ctx context.Context, req *api.ImportProductsReq) (r *api.ImportProductsResp, err error) {
+	var _args api.ProductServiceImportProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceImportProductsResult
+	if err = p.c.Call(ctx, "ImportProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq) (r *api.CreateAttributeDefinitionResp, err error) {
+	var _args api.ProductServiceCreateAttributeDefinitionArgs
+	_args.Req = req
+	var _result api.ProductServiceCreateAttributeDefinitionResult
+	if err = p.c.Call(ctx, "CreateAttributeDefinition", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq) (r *api.UpdateAttributeDefinitionResp, err error) {
+	var _args api.ProductServiceUpdateAttributeDefinitionArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateAttributeDefinitionResult
+	if err = p.c.Call(ctx, "UpdateAttributeDefinition", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) AdminSearchProducts(ctx context.Context, req *api.AdminSearchProductsReq) (r *api.AdminSearchProductsResp, err error) {
-	var _args api.ProductServiceAdminSearchProductsArgs
+func (p *kClient) ListAttributeDefinitions(ctx context.Context, req *api.ListAttributeDefinitionsReq) (r *api.ListAttributeDefinitionsResp, err error) {
+	var _args api.ProductServiceListAttributeDefinitionsArgs
 	_args.Req = req
-	var _result api.ProductServiceAdminSearchProductsResult
-	if err = p.c.Call(ctx, "AdminSearchProducts", &_args, &_result); err != nil {
+	var _result api.ProductServiceListAttributeDefinitionsResult
+	if err = p.c.Call(ctx, "ListAttributeDefinitions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAttributeDefinition(ctx context.Context, req *api.DeleteAttributeDefinitionReq) (r *api.DeleteAttributeDefinitionResp, err error) {
+	var _args api.ProductServiceDeleteAttributeDefinitionArgs
+	_args.Req = req
+	var _result api.ProductServiceDeleteAttributeDefinitionResult
+	if err = p.c.Call(ctx, "DeleteAttributeDefinition", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProductRating(ctx context.Context, req *api.UpdateProductRatingReq) (r *api.UpdateProductRatingResp, err error) {
+	var _args api.ProductServiceUpdateProductRatingArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateProductRatingResult
+	if err = p.c.Call(ctx, "UpdateProductRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductAudit(ctx context.Context, req *api.ListProductAuditReq) (r *api.ListProductAuditResp, err error) {
+	var _args api.ProductServiceListProductAuditArgs
+	_args.Req = req
+	var _result api.ProductServiceListProductAuditResult
+	if err = p.c.Call(ctx, "ListProductAudit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SyncPurchaseStats(ctx context.Context, req *api.SyncPurchaseStatsReq) (r *api.SyncPurchaseStatsResp, err error) {
+	var _args api.ProductServiceSyncPurchaseStatsArgs
+	_args.Req = req
+	var _result api.ProductServiceSyncPurchaseStatsResult
+	if err = p.c.Call(ctx, "SyncPurchaseStats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq) (r *api.GetRelatedProductsResp, err error) {
+	var _args api.ProductServiceGetRelatedProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceGetRelatedProductsResult
+	if err = p.c.Call(ctx, "GetRelatedProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq) (r *api.GetFrequentlyBoughtTogetherResp, err error) {
+	var _args api.ProductServiceGetFrequentlyBoughtTogetherArgs
+	_args.Req = req
+	var _result api.ProductServiceGetFrequentlyBoughtTogetherResult
+	if err = p.c.Call(ctx, "GetFrequentlyBoughtTogether", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateBrand(ctx context.Context, req *api.CreateBrandReq) (r *api.CreateBrandResp, err error) {
+	var _args api.ProductServiceCreateBrandArgs
+	_args.Req = req
+	var _result api.ProductServiceCreateBrandResult
+	if err = p.c.Call(ctx, "CreateBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateBrand(ctx context.Context, req *api.UpdateBrandReq) (r *api.UpdateBrandResp, err error) {
+	var _args api.ProductServiceUpdateBrandArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateBrandResult
+	if err = p.c.Call(ctx, "UpdateBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBrand(ctx context.Context, req *api.GetBrandReq) (r *api.GetBrandResp, err error) {
+	var _args api.ProductServiceGetBrandArgs
+	_args.Req = req
+	var _result api.ProductServiceGetBrandResult
+	if err = p.c.Call(ctx, "GetBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListBrands(ctx context.Context, req *api.ListBrandsReq) (r *api.ListBrandsResp, err error) {
+	var _args api.ProductServiceListBrandsArgs
+	_args.Req = req
+	var _result api.ProductServiceListBrandsResult
+	if err = p.c.Call(ctx, "ListBrands", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteBrand(ctx context.Context, req *api.DeleteBrandReq) (r *api.DeleteBrandResp, err error) {
+	var _args api.ProductServiceDeleteBrandArgs
+	_args.Req = req
+	var _result api.ProductServiceDeleteBrandResult
+	if err = p.c.Call(ctx, "DeleteBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq) (r *api.SubmitProductApprovalResp, err error) {
+	var _args api.ProductServiceSubmitProductApprovalArgs
+	_args.Req = req
+	var _result api.ProductServiceSubmitProductApprovalResult
+	if err = p.c.Call(ctx, "SubmitProductApproval", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq) (r *api.ReviewProductApprovalResp, err error) {
+	var _args api.ProductServiceReviewProductApprovalArgs
+	_args.Req = req
+	var _result api.ProductServiceReviewProductApprovalResult
+	if err = p.c.Call(ctx, "ReviewProductApproval", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq) (r *api.ListProductApprovalsResp, err error) {
+	var _args api.ProductServiceListProductApprovalsArgs
+	_args.Req = req
+	var _result api.ProductServiceListProductApprovalsResult
+	if err = p.c.Call(ctx, "ListProductApprovals", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateMerchant(ctx context.Context, req *api.CreateMerchantReq) (r *api.CreateMerchantResp, err error) {
+	var _args api.ProductServiceCreateMerchantArgs
+	_args.Req = req
+	var _result api.ProductServiceCreateMerchantResult
+	if err = p.c.Call(ctx, "CreateMerchant", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq) (r *api.UpdateMerchantResp, err error) {
+	var _args api.ProductServiceUpdateMerchantArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateMerchantResult
+	if err = p.c.Call(ctx, "UpdateMerchant", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMerchant(ctx context.Context, req *api.GetMerchantReq) (r *api.GetMerchantResp, err error) {
+	var _args api.ProductServiceGetMerchantArgs
+	_args.Req = req
+	var _result api.ProductServiceGetMerchantResult
+	if err = p.c.Call(ctx, "GetMerchant", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListMerchants(ctx context.Context, req *api.ListMerchantsReq) (r *api.ListMerchantsResp, err error) {
+	var _args api.ProductServiceListMerchantsArgs
+	_args.Req = req
+	var _result api.ProductServiceListMerchantsResult
+	if err = p.c.Call(ctx, "ListMerchants", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SuggestProducts(ctx context.Context, req *api.SuggestProductsReq) (r *api.SuggestProductsResp, err error) {
+	var _args api.ProductServiceSuggestProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceSuggestProductsResult
+	if err = p.c.Call(ctx, "SuggestProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq) (r *api.ListSearchQueriesResp, err error) {
+	var _args api.ProductServiceListSearchQueriesArgs
+	_args.Req = req
+	var _result api.ProductServiceListSearchQueriesResult
+	if err = p.c.Call(ctx, "ListSearchQueries", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RecordProductSales(ctx context.Context, req *api.RecordProductSalesReq) (r *api.RecordProductSalesResp, err error) {
+	var _args api.ProductServiceRecordProductSalesArgs
+	_args.Req = req
+	var _result api.ProductServiceRecordProductSalesResult
+	if err = p.c.Call(ctx, "RecordProductSales", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTopProducts(ctx context.Context, req *api.GetTopProductsReq) (r *api.GetTopProductsResp, err error) {
+	var _args api.ProductServiceGetTopProductsArgs
+	_args.Req = req
+	var _result api.ProductServiceGetTopProductsResult
+	if err = p.c.Call(ctx, "GetTopProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq) (r *api.UpdateBundleComponentsResp, err error) {
+	var _args api.ProductServiceUpdateBundleComponentsArgs
+	_args.Req = req
+	var _result api.ProductServiceUpdateBundleComponentsResult
+	if err = p.c.Call(ctx, "UpdateBundleComponents", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq) (r *api.SetProductTranslationResp, err error) {
+	var _args api.ProductServiceSetProductTranslationArgs
+	_args.Req = req
+	var _result api.ProductServiceSetProductTranslationResult
+	if err = p.c.Call(ctx, "SetProductTranslation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq) (r *api.DeleteProductTranslationResp, err error) {
+	var _args api.ProductServiceDeleteProductTranslationArgs
+	_args.Req = req
+	var _result api.ProductServiceDeleteProductTranslationResult
+	if err = p.c.Call(ctx, "DeleteProductTranslation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq) (r *api.ListProductTranslationsResp, err error) {
+	var _args api.ProductServiceListProductTranslationsArgs
+	_args.Req = req
+	var _result api.ProductServiceListProductTranslationsResult
+	if err = p.c.Call(ctx, "ListProductTranslations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq) (r *api.SetCategoryTranslationResp, err error) {
+	var _args api.ProductServiceSetCategoryTranslationArgs
+	_args.Req = req
+	var _result api.ProductServiceSetCategoryTranslationResult
+	if err = p.c.Call(ctx, "SetCategoryTranslation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq) (r *api.DeleteCategoryTranslationResp, err error) {
+	var _args api.ProductServiceDeleteCategoryTranslationArgs
+	_args.Req = req
+	var _result api.ProductServiceDeleteCategoryTranslationResult
+	if err = p.c.Call(ctx, "DeleteCategoryTranslation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq) (r *api.ListCategoryTranslationsResp, err error) {
+	var _args api.ProductServiceListCategoryTranslationsArgs
+	_args.Req = req
+	var _result api.ProductServiceListCategoryTranslationsResult
+	if err = p.c.Call(ctx, "ListCategoryTranslations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddFavorite(ctx context.Context, req *api.AddFavoriteReq) (r *api.AddFavoriteResp, err error) {
+	var _args api.ProductServiceAddFavoriteArgs
+	_args.Req = req
+	var _result api.ProductServiceAddFavoriteResult
+	if err = p.c.Call(ctx, "AddFavorite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq) (r *api.RemoveFavoriteResp, err error) {
+	var _args api.ProductServiceRemoveFavoriteArgs
+	_args.Req = req
+	var _result api.ProductServiceRemoveFavoriteResult
+	if err = p.c.Call(ctx, "RemoveFavorite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListFavorites(ctx context.Context, req *api.ListFavoritesReq) (r *api.ListFavoritesResp, err error) {
+	var _args api.ProductServiceListFavoritesArgs
+	_args.Req = req
+	var _result api.ProductServiceListFavoritesResult
+	if err = p.c.Call(ctx, "ListFavorites", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq) (r *api.SubscribeRestockResp, err error) {
+	var _args api.ProductServiceSubscribeRestockArgs
+	_args.Req = req
+	var _result api.ProductServiceSubscribeRestockResult
+	if err = p.c.Call(ctx, "SubscribeRestock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq) (r *api.UnsubscribeRestockResp, err error) {
+	var _args api.ProductServiceUnsubscribeRestockArgs
+	_args.Req = req
+	var _result api.ProductServiceUnsubscribeRestockResult
+	if err = p.c.Call(ctx, "UnsubscribeRestock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq) (r *api.ListRestockSubscriptionsResp, err error) {
+	var _args api.ProductServiceListRestockSubscriptionsArgs
+	_args.Req = req
+	var _result api.ProductServiceListRestockSubscriptionsResult
+	if err = p.c.Call(ctx, "ListRestockSubscriptions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq) (r *api.SetProductPriceTiersResp, err error) {
+	var _args api.ProductServiceSetProductPriceTiersArgs
+	_args.Req = req
+	var _result api.ProductServiceSetProductPriceTiersResult
+	if err = p.c.Call(ctx, "SetProductPriceTiers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq) (r *api.GetProductPriceTiersResp, err error) {
+	var _args api.ProductServiceGetProductPriceTiersArgs
+	_args.Req = req
+	var _result api.ProductServiceGetProductPriceTiersResult
+	if err = p.c.Call(ctx, "GetProductPriceTiers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq) (r *api.SetUserPriceGroupResp, err error) {
+	var _args api.ProductServiceSetUserPriceGroupArgs
+	_args.Req = req
+	var _result api.ProductServiceSetUserPriceGroupResult
+	if err = p.c.Call(ctx, "SetUserPriceGroup", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq) (r *api.ListUserPriceGroupsResp, err error) {
+	var _args api.ProductServiceListUserPriceGroupsArgs
+	_args.Req = req
+	var _result api.ProductServiceListUserPriceGroupsResult
+	if err = p.c.Call(ctx, "ListUserPriceGroups", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QuotePrices(ctx context.Context, req *api.QuotePricesReq) (r *api.QuotePricesResp, err error) {
+	var _args api.ProductServiceQuotePricesArgs
+	_args.Req = req
+	var _result api.ProductServiceQuotePricesResult
+	if err = p.c.Call(ctx, "QuotePrices", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq) (r *api.SetProductPurchaseLimitResp, err error) {
+	var _args api.ProductServiceSetProductPurchaseLimitArgs
+	_args.Req = req
+	var _result api.ProductServiceSetProductPurchaseLimitResult
+	if err = p.c.Call(ctx, "SetProductPurchaseLimit", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
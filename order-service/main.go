@@ -12,6 +12,7 @@ import (
 
 	"ecommerce/order-service/internal/client"
 	"ecommerce/order-service/internal/dao/dao"
+	"ecommerce/order-service/internal/flashsale"
 	"ecommerce/order-service/internal/service"
 	"ecommerce/order-service/kitex_gen/api/orderservice"
 	"ecommerce/order-service/pkg/config"
@@ -71,6 +72,12 @@ func main() {
 	}
 	kitexServer := startKitexServer(kitexPort, orderService)
 
+	// 启动购买统计同步，供商品服务计算推荐
+	stopStatsSync := orderService.StartPurchaseStatsSync(cfg.Recommend.SyncInterval, cfg.Recommend.LookbackDays)
+
+	// 启动秒杀下单队列和活动对账
+	stopFlashSale := orderService.StartFlashSale(cfg.FlashSale.Workers, cfg.FlashSale.QueueSize, cfg.FlashSale.ReconcileInterval)
+
 	log.Printf("🚀 服务启动成功!")
 	log.Printf("🌐 HTTP API: http://localhost:%d", cfg.Hertz.Port)
 	log.Printf("🔌 RPC 服务: localhost:%d", kitexPort)
@@ -81,6 +88,8 @@ func main() {
 	log.Println("⏳ 收到关闭信号，开始关闭...")
 
 	//关闭
+	stopStatsSync()
+	stopFlashSale()
 	gracefulShutdown(httpServer, kitexServer, db)
 }
 
@@ -147,6 +156,13 @@ func initOrderService(cfg *config.Config, db *gorm.DB) (*service.OrderService, e
 
 	//创建订单服务
 	orderService := service.NewOrderService(db, daoFactory, userClient, productClient)
+	orderService.SetRoutingStrategy(service.RoutingStrategy(cfg.Fulfil.RoutingStrategy))
+	counter, err := flashsale.NewStockCounter(&cfg.FlashSale, &cfg.Redis)
+	if err != nil {
+		log.Printf("⚠️  秒杀库存计数器不可用，秒杀下单暂停: %v", err)
+	} else {
+		orderService.SetFlashSaleCounter(counter)
+	}
 
 	log.Println("✅ 订单服务初始化成功")
 	return orderService, nil
@@ -9,13 +9,16 @@ import (
 
 // Config应用配置
 type Config struct {
-	Hertz    HertzConfig    `mapstructure:"hertz"`
-	Log      LogConfig      `mapstructure:"log"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	Kafka    KafkaConfig    `mapstructure:"kafka"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Kitex    KitexConfig    `mapstructure:"kitex"`
+	Hertz     HertzConfig     `mapstructure:"hertz"`
+	Log       LogConfig       `mapstructure:"log"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Redis     RedisConfig     `mapstructure:"redis"`
+	Kafka     KafkaConfig     `mapstructure:"kafka"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	Kitex     KitexConfig     `mapstructure:"kitex"`
+	Fulfil    FulfilConfig    `mapstructure:"fulfil"`
+	Recommend RecommendConfig `mapstructure:"recommend"`
+	FlashSale FlashSaleConfig `mapstructure:"flash_sale"`
 }
 
 // Hertz配置
@@ -77,6 +80,25 @@ type KitexConfig struct {
 	ServerTimeout int `mapstructure:"server_timeout"`
 }
 
+// 履约配置
+type FulfilConfig struct {
+	RoutingStrategy string `mapstructure:"routing_strategy"` // nearest / whole_order
+}
+
+// 推荐统计配置
+type RecommendConfig struct {
+	SyncInterval time.Duration `mapstructure:"sync_interval"` // 购买统计同步到商品服务的间隔
+	LookbackDays int           `mapstructure:"lookback_days"` // 统计最近多少天的已完成订单
+}
+
+// 秒杀配置
+type FlashSaleConfig struct {
+	Driver            string        `mapstructure:"driver"`             // 库存计数器：memory / redis，多实例部署需使用redis
+	QueueSize         int           `mapstructure:"queue_size"`         // 下单队列长度，队列满时直接拒绝
+	Workers           int           `mapstructure:"workers"`            // 创建订单的并发数
+	ReconcileInterval time.Duration `mapstructure:"reconcile_interval"` // 刷新活动和对账已结束活动的间隔
+}
+
 // LoadConfig
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
@@ -144,4 +166,17 @@ func setDefaults() {
 	viper.SetDefault("kitex.port", 50053)
 	viper.SetDefault("kitex.client_timeout", 3000)
 	viper.SetDefault("kitex.server_timeout", 5000)
+
+	// 履约默认值
+	viper.SetDefault("fulfil.routing_strategy", "whole_order")
+
+	// 推荐统计默认值
+	viper.SetDefault("recommend.sync_interval", "1h")
+	viper.SetDefault("recommend.lookback_days", 90)
+
+	// 秒杀默认值
+	viper.SetDefault("flash_sale.driver", "memory")
+	viper.SetDefault("flash_sale.queue_size", 10000)
+	viper.SetDefault("flash_sale.workers", 8)
+	viper.SetDefault("flash_sale.reconcile_interval", "1m")
 }
@@ -112,9 +112,14 @@ func AutoMigrate(db *gorm.DB) error {
 	models := []interface{}{
 		&model.Order{},
 		&model.OrderItem{},
+		&model.OrderItemComponent{},
 		&model.RefundOrder{},
 		&model.StockReservation{},
 		&model.TimeoutTask{},
+		&model.Review{},
+		&model.FlashSale{},
+		&model.FlashSaleRequest{},
+		&model.PurchaseLimitLock{},
 	}
 
 	for _, m := range models {
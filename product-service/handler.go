@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"ecommerce/product-service/internal/cache"
+	"ecommerce/product-service/internal/notifier"
 	"ecommerce/product-service/internal/repository"
 	"ecommerce/product-service/internal/service"
 	api "ecommerce/product-service/kitex_gen/api"
@@ -27,7 +29,30 @@ func NewProductServiceImpl() (*ProductServiceImpl, error) {
 		return nil, fmt.Errorf("数据库连接失败: %v", err)
 	}
 	productRepo := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepo)
+	eventRepo := repository.NewEventRepository(db)
+	movementRepo := repository.NewStockMovementRepository(db)
+	warehouseRepo := repository.NewWarehouseRepository(db)
+	priceRepo := repository.NewPriceRepository(db)
+	scheduleRepo := repository.NewStatusScheduleRepository(db)
+	attributeRepo := repository.NewAttributeRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	recommendRepo := repository.NewRecommendationRepository(db)
+	brandRepo := repository.NewBrandRepository(db)
+	approvalRepo := repository.NewApprovalRepository(db)
+	merchantRepo := repository.NewMerchantRepository(db)
+	suggestionRepo := repository.NewSuggestionRepository(db)
+	popularityRepo := repository.NewPopularityRepository(db)
+	bundleRepo := repository.NewBundleRepository(db)
+	translationRepo := repository.NewTranslationRepository(db)
+	favoriteRepo := repository.NewFavoriteRepository(db)
+	restockRepo := repository.NewRestockRepository(db)
+	pricingRepo := repository.NewPricingRepository(db)
+	alertNotifier := notifier.New(&cfg.Alert)
+	restockNotifier := notifier.NewRestockNotifier(&cfg.Restock)
+	productCache := cache.New(&cfg.Cache, &cfg.Redis)
+	productService := service.NewProductService(productRepo, eventRepo, movementRepo, warehouseRepo, priceRepo, scheduleRepo, attributeRepo, auditRepo, recommendRepo, brandRepo, approvalRepo, merchantRepo, suggestionRepo, popularityRepo, bundleRepo, translationRepo, favoriteRepo, restockRepo, pricingRepo, alertNotifier, restockNotifier, productCache)
+	productService.SetDefaultLocale(cfg.I18n.DefaultLocale)
+	productService.SetRestockLimit(cfg.Restock.MaxPerUserHour)
 	return &ProductServiceImpl{
 		productService: productService,
 	}, nil
@@ -44,7 +69,13 @@ func (s *ProductServiceImpl) CreateProduct(ctx context.Context, req *api.CreateP
 func (s *ProductServiceImpl) GetProduct(ctx context.Context, req *api.GetProductReq) (resp *api.GetProductResp, err error) {
 	// TODO: Your code here...
 	log.Printf("接收到获取商品请求: id=%d", req.GetId())
-	return s.productService.GetProduct(ctx, req.GetId())
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	ctx = service.WithPriceUser(ctx, req.GetUserId())
+	resp, err = s.productService.GetProduct(ctx, req.GetId())
+	if err == nil && resp.Success && req.GetCountView() {
+		s.productService.RecordProductView(req.GetId())
+	}
+	return resp, err
 }
 
 // UpdateProduct implements the ProductServiceImpl interface.
@@ -58,27 +89,29 @@ func (s *ProductServiceImpl) UpdateProduct(ctx context.Context, req *api.UpdateP
 func (s *ProductServiceImpl) OnlineProduct(ctx context.Context, req *api.OnlineProductReq) (resp *api.OnlineProductResp, err error) {
 	// TODO: Your code here...
 	log.Printf("接收到上架商品请求: id=%d", req.GetId())
-	return s.productService.OnlineProduct(ctx, req.GetId())
+	return s.productService.OnlineProduct(ctx, req)
 }
 
 // OfflineProduct implements the ProductServiceImpl interface.
 func (s *ProductServiceImpl) OfflineProduct(ctx context.Context, req *api.OfflineProductReq) (resp *api.OfflineProductResp, err error) {
 	// TODO: Your code here...
 	log.Printf("接收到下架商品请求:id=%d", req.GetId())
-	return s.productService.OfflineProduct(ctx, req.GetId())
+	return s.productService.OfflineProduct(ctx, req)
 }
 
 // DeleteProduct implements the ProductServiceImpl interface.
 func (s *ProductServiceImpl) DeleteProduct(ctx context.Context, req *api.DeleteProductReq) (resp *api.DeleteProductResp, err error) {
 	// TODO: Your code here...
 	log.Printf("接收到删除商品请求:id=%d", req.GetId())
-	return s.productService.DeleteProduct(ctx, req.GetId())
+	return s.productService.DeleteProduct(ctx, req)
 }
 
 // UserSearchProducts implements the ProductServiceImpl interface.
 func (s *ProductServiceImpl) UserSearchProducts(ctx context.Context, req *api.UserSearchProductsReq) (resp *api.UserSearchProductsResp, err error) {
 	// TODO: Your code here...
 	log.Printf("接收到用户搜索商品的请求")
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	ctx = service.WithPriceUser(ctx, req.GetUserId())
 	return s.productService.UserSearchProducts(ctx, req)
 }
 
@@ -88,3 +121,372 @@ func (s *ProductServiceImpl) AdminSearchProducts(ctx context.Context, req *api.A
 	log.Printf("接收到管理员搜索商品的请求")
 	return s.productService.AdminSearchProducts(ctx, req)
 }
+
+// BatchGetProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) BatchGetProducts(ctx context.Context, req *api.BatchGetProductsReq) (resp *api.BatchGetProductsResp, err error) {
+	log.Printf("接收到批量获取商品请求: count=%d", len(req.GetIds()))
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	return s.productService.BatchGetProducts(ctx, req.GetIds())
+}
+
+// ListProductEvents implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductEvents(ctx context.Context, req *api.ListProductEventsReq) (resp *api.ListProductEventsResp, err error) {
+	return s.productService.ListProductEvents(ctx, req.GetAfterId(), req.GetLimit())
+}
+
+// AdjustStock implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) AdjustStock(ctx context.Context, req *api.AdjustStockReq) (resp *api.AdjustStockResp, err error) {
+	log.Printf("接收到调整库存请求: productId=%d, delta=%d, reason=%s", req.GetProductId(), req.GetDelta(), req.GetReason())
+	return s.productService.AdjustStock(ctx, req)
+}
+
+// ListStockMovements implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq) (resp *api.ListStockMovementsResp, err error) {
+	log.Printf("接收到查询库存流水请求")
+	return s.productService.ListStockMovements(ctx, req)
+}
+
+// ReconcileStock implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ReconcileStock(ctx context.Context, req *api.ReconcileStockReq) (resp *api.ReconcileStockResp, err error) {
+	log.Printf("接收到库存对账请求")
+	return s.productService.ReconcileStock(ctx, req.ProductId)
+}
+
+// CreateWarehouse implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq) (resp *api.CreateWarehouseResp, err error) {
+	log.Printf("接收到创建仓库请求: code=%s", req.GetCode())
+	return s.productService.CreateWarehouse(ctx, req)
+}
+
+// UpdateWarehouse implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq) (resp *api.UpdateWarehouseResp, err error) {
+	log.Printf("接收到更新仓库请求: id=%d", req.GetId())
+	return s.productService.UpdateWarehouse(ctx, req)
+}
+
+// ListWarehouses implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListWarehouses(ctx context.Context, req *api.ListWarehousesReq) (resp *api.ListWarehousesResp, err error) {
+	return s.productService.ListWarehouses(ctx, req.GetActiveOnly())
+}
+
+// GetWarehouseStocks implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetWarehouseStocks(ctx context.Context, req *api.GetWarehouseStocksReq) (resp *api.GetWarehouseStocksResp, err error) {
+	return s.productService.GetWarehouseStocks(ctx, req.GetProductIds(), req.WarehouseId)
+}
+
+// ListLowStockProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListLowStockProducts(ctx context.Context, req *api.ListLowStockProductsReq) (resp *api.ListLowStockProductsResp, err error) {
+	log.Printf("接收到查询低库存商品请求")
+	return s.productService.ListLowStockProducts(ctx, req.GetPage(), req.GetPageSize())
+}
+
+// CreatePriceSchedule implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq) (resp *api.CreatePriceScheduleResp, err error) {
+	log.Printf("接收到创建调价计划请求: productId=%d, price=%.2f", req.GetProductId(), req.GetPrice())
+	return s.productService.CreatePriceSchedule(ctx, req)
+}
+
+// ListPriceSchedules implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq) (resp *api.ListPriceSchedulesResp, err error) {
+	log.Printf("接收到查询调价计划请求")
+	return s.productService.ListPriceSchedules(ctx, req)
+}
+
+// CancelPriceSchedule implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CancelPriceSchedule(ctx context.Context, req *api.CancelPriceScheduleReq) (resp *api.CancelPriceScheduleResp, err error) {
+	log.Printf("接收到取消调价计划请求: id=%d", req.GetId())
+	return s.productService.CancelPriceSchedule(ctx, req.GetId(), req.Operator)
+}
+
+// GetPriceHistory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq) (resp *api.GetPriceHistoryResp, err error) {
+	log.Printf("接收到查询价格历史请求: productId=%d", req.GetProductId())
+	return s.productService.GetPriceHistory(ctx, req)
+}
+
+// ListProductStatusSchedules implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq) (resp *api.ListProductStatusSchedulesResp, err error) {
+	log.Printf("接收到查询定时上下架计划请求")
+	return s.productService.ListProductStatusSchedules(ctx, req)
+}
+
+// CancelProductStatusSchedule implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CancelProductStatusSchedule(ctx context.Context, req *api.CancelProductStatusScheduleReq) (resp *api.CancelProductStatusScheduleResp, err error) {
+	log.Printf("接收到取消定时上下架计划请求: id=%d", req.GetId())
+	return s.productService.CancelProductStatusSchedule(ctx, req.GetId())
+}
+
+// ImportProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ImportProducts(ctx context.Context, req *api.ImportProductsReq) (resp *api.ImportProductsResp, err error) {
+	log.Printf("接收到批量导入商品请求: rows=%d, dryRun=%v", len(req.GetRows()), req.GetDryRun())
+	return s.productService.ImportProducts(ctx, req)
+}
+
+// CreateAttributeDefinition implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq) (resp *api.CreateAttributeDefinitionResp, err error) {
+	log.Printf("接收到创建属性定义请求: category=%s, code=%s", req.GetCategory(), req.GetCode())
+	return s.productService.CreateAttributeDefinition(ctx, req)
+}
+
+// UpdateAttributeDefinition implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq) (resp *api.UpdateAttributeDefinitionResp, err error) {
+	log.Printf("接收到更新属性定义请求: id=%d", req.GetId())
+	return s.productService.UpdateAttributeDefinition(ctx, req)
+}
+
+// ListAttributeDefinitions implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListAttributeDefinitions(ctx context.Context, req *api.ListAttributeDefinitionsReq) (resp *api.ListAttributeDefinitionsResp, err error) {
+	log.Printf("接收到查询属性定义请求: category=%s", req.GetCategory())
+	return s.productService.ListAttributeDefinitions(ctx, req.Category)
+}
+
+// DeleteAttributeDefinition implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteAttributeDefinition(ctx context.Context, req *api.DeleteAttributeDefinitionReq) (resp *api.DeleteAttributeDefinitionResp, err error) {
+	log.Printf("接收到删除属性定义请求: id=%d", req.GetId())
+	return s.productService.DeleteAttributeDefinition(ctx, req.GetId())
+}
+
+// UpdateProductRating implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateProductRating(ctx context.Context, req *api.UpdateProductRatingReq) (resp *api.UpdateProductRatingResp, err error) {
+	log.Printf("接收到更新商品评分请求: id=%d, avg=%.2f, count=%d", req.GetProductId(), req.GetRatingAvg(), req.GetRatingCount())
+	return s.productService.UpdateProductRating(ctx, req)
+}
+
+// ListProductAudit implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductAudit(ctx context.Context, req *api.ListProductAuditReq) (resp *api.ListProductAuditResp, err error) {
+	log.Printf("接收到查询商品审计日志请求: page=%d, pageSize=%d", req.GetPage(), req.GetPageSize())
+	return s.productService.ListProductAudit(ctx, req)
+}
+
+// SyncPurchaseStats implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SyncPurchaseStats(ctx context.Context, req *api.SyncPurchaseStatsReq) (resp *api.SyncPurchaseStatsResp, err error) {
+	log.Printf("接收到同步购买统计请求: generation=%d, pairs=%d, sales=%d, final=%v",
+		req.GetGeneration(), len(req.GetPairs()), len(req.GetSales()), req.GetFinal())
+	return s.productService.SyncPurchaseStats(ctx, req)
+}
+
+// GetRelatedProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq) (resp *api.GetRelatedProductsResp, err error) {
+	log.Printf("接收到查询相关商品请求: productId=%d, limit=%d", req.GetProductId(), req.GetLimit())
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	return s.productService.GetRelatedProducts(ctx, req)
+}
+
+// GetFrequentlyBoughtTogether implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq) (resp *api.GetFrequentlyBoughtTogetherResp, err error) {
+	log.Printf("接收到查询经常一起购买请求: productId=%d, limit=%d", req.GetProductId(), req.GetLimit())
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	return s.productService.GetFrequentlyBoughtTogether(ctx, req)
+}
+
+// CreateBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateBrand(ctx context.Context, req *api.CreateBrandReq) (resp *api.CreateBrandResp, err error) {
+	log.Printf("接收到创建品牌请求: name=%s", req.GetName())
+	return s.productService.CreateBrand(ctx, req)
+}
+
+// UpdateBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateBrand(ctx context.Context, req *api.UpdateBrandReq) (resp *api.UpdateBrandResp, err error) {
+	log.Printf("接收到更新品牌请求: id=%d", req.GetId())
+	return s.productService.UpdateBrand(ctx, req)
+}
+
+// GetBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetBrand(ctx context.Context, req *api.GetBrandReq) (resp *api.GetBrandResp, err error) {
+	log.Printf("接收到查询品牌请求: id=%d", req.GetId())
+	return s.productService.GetBrand(ctx, req.GetId())
+}
+
+// ListBrands implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListBrands(ctx context.Context, req *api.ListBrandsReq) (resp *api.ListBrandsResp, err error) {
+	log.Printf("接收到查询品牌列表请求: page=%d, pageSize=%d", req.GetPage(), req.GetPageSize())
+	return s.productService.ListBrands(ctx, req)
+}
+
+// DeleteBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteBrand(ctx context.Context, req *api.DeleteBrandReq) (resp *api.DeleteBrandResp, err error) {
+	log.Printf("接收到删除品牌请求: id=%d, mergeIntoId=%d", req.GetId(), req.GetMergeIntoId())
+	return s.productService.DeleteBrand(ctx, req)
+}
+
+// SubmitProductApproval implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq) (resp *api.SubmitProductApprovalResp, err error) {
+	log.Printf("接收到提交商品审核请求: productId=%d", req.GetProductId())
+	return s.productService.SubmitProductApproval(ctx, req)
+}
+
+// ReviewProductApproval implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq) (resp *api.ReviewProductApprovalResp, err error) {
+	log.Printf("接收到审核商品请求: id=%d, approved=%v", req.GetId(), req.GetApproved())
+	return s.productService.ReviewProductApproval(ctx, req)
+}
+
+// ListProductApprovals implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq) (resp *api.ListProductApprovalsResp, err error) {
+	log.Printf("接收到查询商品审核记录请求: page=%d, pageSize=%d", req.GetPage(), req.GetPageSize())
+	return s.productService.ListProductApprovals(ctx, req)
+}
+
+// CreateMerchant implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateMerchant(ctx context.Context, req *api.CreateMerchantReq) (resp *api.CreateMerchantResp, err error) {
+	log.Printf("接收到创建商家请求: name=%s", req.GetName())
+	return s.productService.CreateMerchant(ctx, req)
+}
+
+// UpdateMerchant implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq) (resp *api.UpdateMerchantResp, err error) {
+	log.Printf("接收到更新商家请求: id=%d", req.GetId())
+	return s.productService.UpdateMerchant(ctx, req)
+}
+
+// GetMerchant implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetMerchant(ctx context.Context, req *api.GetMerchantReq) (resp *api.GetMerchantResp, err error) {
+	log.Printf("接收到查询商家请求: id=%d", req.GetId())
+	return s.productService.GetMerchant(ctx, req.GetId())
+}
+
+// ListMerchants implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListMerchants(ctx context.Context, req *api.ListMerchantsReq) (resp *api.ListMerchantsResp, err error) {
+	log.Printf("接收到查询商家列表请求: page=%d, pageSize=%d", req.GetPage(), req.GetPageSize())
+	return s.productService.ListMerchants(ctx, req)
+}
+
+// SuggestProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SuggestProducts(ctx context.Context, req *api.SuggestProductsReq) (resp *api.SuggestProductsResp, err error) {
+	log.Printf("接收到搜索联想请求: prefix=%s, limit=%d", req.GetPrefix(), req.GetLimit())
+	return s.productService.SuggestProducts(ctx, req)
+}
+
+// ListSearchQueries implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq) (resp *api.ListSearchQueriesResp, err error) {
+	log.Printf("接收到查询搜索词统计请求: zeroResultOnly=%v, sortBy=%s, page=%d", req.GetZeroResultOnly(), req.GetSortBy(), req.GetPage())
+	return s.productService.ListSearchQueries(ctx, req)
+}
+
+// RecordProductSales implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) RecordProductSales(ctx context.Context, req *api.RecordProductSalesReq) (resp *api.RecordProductSalesResp, err error) {
+	log.Printf("接收到记录商品销量请求: count=%d", len(req.GetSales()))
+	return s.productService.RecordProductSales(ctx, req)
+}
+
+// GetTopProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetTopProducts(ctx context.Context, req *api.GetTopProductsReq) (resp *api.GetTopProductsResp, err error) {
+	log.Printf("接收到查询热门商品请求: window=%s, category=%s, sortBy=%s", req.GetWindow(), req.GetCategory(), req.GetSortBy())
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	return s.productService.GetTopProducts(ctx, req)
+}
+
+// UpdateBundleComponents implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq) (resp *api.UpdateBundleComponentsResp, err error) {
+	log.Printf("接收到修改套装组件请求: bundleId=%d, components=%d", req.BundleId, len(req.Components))
+	return s.productService.UpdateBundleComponents(ctx, req)
+}
+
+// SetProductTranslation implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq) (resp *api.SetProductTranslationResp, err error) {
+	log.Printf("接收到保存商品翻译请求: productId=%d, locale=%s", req.ProductId, req.Locale)
+	return s.productService.SetProductTranslation(ctx, req)
+}
+
+// DeleteProductTranslation implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq) (resp *api.DeleteProductTranslationResp, err error) {
+	log.Printf("接收到删除商品翻译请求: productId=%d, locale=%s", req.ProductId, req.Locale)
+	return s.productService.DeleteProductTranslation(ctx, req)
+}
+
+// ListProductTranslations implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq) (resp *api.ListProductTranslationsResp, err error) {
+	log.Printf("接收到查询商品翻译请求: productId=%d", req.ProductId)
+	return s.productService.ListProductTranslations(ctx, req)
+}
+
+// SetCategoryTranslation implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq) (resp *api.SetCategoryTranslationResp, err error) {
+	log.Printf("接收到保存分类翻译请求: category=%s, locale=%s", req.Category, req.Locale)
+	return s.productService.SetCategoryTranslation(ctx, req)
+}
+
+// DeleteCategoryTranslation implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq) (resp *api.DeleteCategoryTranslationResp, err error) {
+	log.Printf("接收到删除分类翻译请求: category=%s, locale=%s", req.Category, req.Locale)
+	return s.productService.DeleteCategoryTranslation(ctx, req)
+}
+
+// ListCategoryTranslations implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq) (resp *api.ListCategoryTranslationsResp, err error) {
+	log.Printf("接收到查询分类翻译请求: category=%s, locale=%s", req.GetCategory(), req.GetLocale())
+	return s.productService.ListCategoryTranslations(ctx, req)
+}
+
+// AddFavorite implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) AddFavorite(ctx context.Context, req *api.AddFavoriteReq) (resp *api.AddFavoriteResp, err error) {
+	log.Printf("接收到收藏商品请求: userId=%d, productId=%d", req.UserId, req.ProductId)
+	return s.productService.AddFavorite(ctx, req)
+}
+
+// RemoveFavorite implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq) (resp *api.RemoveFavoriteResp, err error) {
+	log.Printf("接收到取消收藏请求: userId=%d, productId=%d", req.UserId, req.ProductId)
+	return s.productService.RemoveFavorite(ctx, req)
+}
+
+// ListFavorites implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListFavorites(ctx context.Context, req *api.ListFavoritesReq) (resp *api.ListFavoritesResp, err error) {
+	log.Printf("接收到查询收藏请求: userId=%d, priceDroppedOnly=%v", req.UserId, req.GetPriceDroppedOnly())
+	ctx = service.WithLocale(ctx, req.GetLocale())
+	return s.productService.ListFavorites(ctx, req)
+}
+
+// SubscribeRestock implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq) (resp *api.SubscribeRestockResp, err error) {
+	log.Printf("接收到订阅到货通知请求: userId=%d, productId=%d", req.UserId, req.ProductId)
+	return s.productService.SubscribeRestock(ctx, req)
+}
+
+// UnsubscribeRestock implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq) (resp *api.UnsubscribeRestockResp, err error) {
+	log.Printf("接收到取消到货订阅请求: userId=%d, productId=%d", req.UserId, req.ProductId)
+	return s.productService.UnsubscribeRestock(ctx, req)
+}
+
+// ListRestockSubscriptions implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq) (resp *api.ListRestockSubscriptionsResp, err error) {
+	log.Printf("接收到查询到货订阅请求: userId=%d", req.UserId)
+	return s.productService.ListRestockSubscriptions(ctx, req)
+}
+
+// SetProductPriceTiers implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq) (resp *api.SetProductPriceTiersResp, err error) {
+	log.Printf("接收到设置阶梯价请求: productId=%d, tiers=%d", req.ProductId, len(req.Tiers))
+	return s.productService.SetProductPriceTiers(ctx, req)
+}
+
+// GetProductPriceTiers implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq) (resp *api.GetProductPriceTiersResp, err error) {
+	log.Printf("接收到查询阶梯价请求: productId=%d", req.ProductId)
+	return s.productService.GetProductPriceTiers(ctx, req)
+}
+
+// SetUserPriceGroup implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq) (resp *api.SetUserPriceGroupResp, err error) {
+	log.Printf("接收到设置用户价格组请求: userId=%d, group=%s", req.UserId, req.Group)
+	return s.productService.SetUserPriceGroup(ctx, req)
+}
+
+// ListUserPriceGroups implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq) (resp *api.ListUserPriceGroupsResp, err error) {
+	log.Printf("接收到查询价格组成员请求: group=%s", req.GetGroup())
+	return s.productService.ListUserPriceGroups(ctx, req)
+}
+
+// QuotePrices implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) QuotePrices(ctx context.Context, req *api.QuotePricesReq) (resp *api.QuotePricesResp, err error) {
+	log.Printf("接收到计算成交价请求: userId=%d, items=%d", req.UserId, len(req.Items))
+	return s.productService.QuotePrices(ctx, req)
+}
+
+// SetProductPurchaseLimit implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq) (resp *api.SetProductPurchaseLimitResp, err error) {
+	log.Printf("接收到设置每人限购请求: id=%d, limit=%d, windowDays=%d", req.ProductId, req.Limit, req.WindowDays)
+	return s.productService.SetProductPurchaseLimit(ctx, req)
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand"
+	"time"
+
+	"ecommerce/product-service/pkg/config"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// 缓存驱动
+const (
+	DriverMemory = "memory"
+	DriverRedis  = "redis"
+)
+
+// 缓存未命中
+var ErrMiss = errors.New("cache miss")
+
+// 缓存接口，值统一为序列化后的字节
+type Cache interface {
+	// Get 未命中时返回ErrMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set ttl<=0表示不过期
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// 根据配置创建缓存，Redis不可用时降级为进程内LRU
+func New(cfg *config.CacheConfig, redisCfg *config.RedisConfig) Cache {
+	capacity := 10000
+	if cfg != nil && cfg.Capacity > 0 {
+		capacity = cfg.Capacity
+	}
+	if cfg == nil || cfg.Driver != DriverRedis {
+		return NewLRUCache(capacity)
+	}
+	redisCache, err := NewRedisCache(redisCfg)
+	if err != nil {
+		log.Printf("Redis缓存不可用，降级为内存缓存: %v", err)
+		return NewLRUCache(capacity)
+	}
+	return redisCache
+}
+
+// 读穿加载器，合并同一key的并发回源，防止缓存击穿
+type Loader struct {
+	cache Cache
+	group singleflight.Group
+}
+
+func NewLoader(c Cache) *Loader {
+	return &Loader{cache: c}
+}
+
+// 回源函数，返回值及其缓存时长
+type LoadFunc func(ctx context.Context) ([]byte, time.Duration, error)
+
+// Get 先读缓存，未命中时回源并写回缓存
+// 缓存读写失败只记录日志，不影响回源结果
+func (l *Loader) Get(ctx context.Context, key string, load LoadFunc) ([]byte, error) {
+	value, err := l.cache.Get(ctx, key)
+	if err == nil {
+		return value, nil
+	}
+	if !errors.Is(err, ErrMiss) {
+		log.Printf("读取缓存失败: key=%s, err=%v", key, err)
+	}
+
+	result, err, _ := l.group.Do(key, func() (interface{}, error) {
+		value, ttl, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.cache.Set(ctx, key, value, withJitter(ttl)); err != nil {
+			log.Printf("写入缓存失败: key=%s, err=%v", key, err)
+		}
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), nil
+}
+
+// withJitter 在过期时间上增加最多10%的随机值，避免大量key同时过期造成缓存雪崩
+func withJitter(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return ttl
+	}
+	jitter := int64(ttl) / 10
+	if jitter <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int63n(jitter))
+}
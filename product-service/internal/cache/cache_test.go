@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithJitter(t *testing.T) {
+	tests := []struct {
+		name string
+		ttl  time.Duration
+		max  time.Duration
+	}{
+		{name: "不过期", ttl: 0, max: 0},
+		{name: "负数原样返回", ttl: -time.Second, max: -time.Second},
+		{name: "过短无法加随机值", ttl: 5 * time.Nanosecond, max: 5 * time.Nanosecond},
+		{name: "最多增加10%", ttl: time.Minute, max: time.Minute + 6*time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got := withJitter(tt.ttl)
+				if got < tt.ttl || got > tt.max {
+					t.Fatalf("withJitter(%v) = %v, want [%v, %v]", tt.ttl, got, tt.ttl, tt.max)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"ecommerce/product-service/internal/model"
 	"ecommerce/product-service/internal/service"
 	api "ecommerce/product-service/kitex_gen/api"
 
@@ -43,6 +44,11 @@ func getStringValue(s *string) string {
 	return *s
 }
 
+// 标记请求来源为管理HTTP接口，供审计日志使用
+func AdminAuditSource(ctx context.Context, c *app.RequestContext) {
+	c.Next(service.WithAuditSource(ctx, model.AuditSourceAdminHTTP))
+}
+
 // 创建产品
 func (h *ProductHTTPHandler) CreateProduct(ctx context.Context, c *app.RequestContext) {
 	var req api.CreateProductReq
@@ -203,7 +209,7 @@ func (h *ProductHTTPHandler) DeleteProduct(ctx context.Context, c *app.RequestCo
 		return
 	}
 
-	resp, err := h.productService.DeleteProduct(ctx, id)
+	resp, err := h.productService.DeleteProduct(ctx, &api.DeleteProductReq{Id: id})
 	if err != nil {
 		hlog.CtxErrorf(ctx, "删除产品失败: %v", err)
 		c.JSON(consts.StatusInternalServerError, HTTPResponse{
@@ -356,7 +362,7 @@ func (h *ProductHTTPHandler) OnlineProduct(ctx context.Context, c *app.RequestCo
 		})
 		return
 	}
-	resp, err := h.productService.OnlineProduct(ctx, id)
+	resp, err := h.productService.OnlineProduct(ctx, &api.OnlineProductReq{Id: id})
 	if err != nil {
 		hlog.CtxErrorf(ctx, "上架产品失败: %v", err)
 		c.JSON(consts.StatusInternalServerError, HTTPResponse{
@@ -400,7 +406,7 @@ func (h *ProductHTTPHandler) OfflineProduct(ctx context.Context, c *app.RequestC
 		})
 		return
 	}
-	resp, err := h.productService.OfflineProduct(ctx, id)
+	resp, err := h.productService.OfflineProduct(ctx, &api.OfflineProductReq{Id: id})
 	if err != nil {
 		hlog.CtxErrorf(ctx, "下架产品失败: %v", err)
 		c.JSON(consts.StatusInternalServerError, HTTPResponse{
@@ -431,3 +437,46 @@ func (h *ProductHTTPHandler) OfflineProduct(ctx context.Context, c *app.RequestC
 		},
 	})
 }
+
+// 批量获取产品
+func (h *ProductHTTPHandler) BatchGetProducts(ctx context.Context, c *app.RequestContext) {
+	var req api.BatchGetProductsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		hlog.CtxErrorf(ctx, "参数绑定失败: %v", err)
+		c.JSON(consts.StatusBadRequest, HTTPResponse{
+			Success: false,
+			Code:    400,
+			Message: "参数错误",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.productService.BatchGetProducts(ctx, req.Ids)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "批量获取产品失败: %v", err)
+		c.JSON(consts.StatusInternalServerError, HTTPResponse{
+			Success: false,
+			Code:    500,
+			Message: "批量获取产品失败",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	if !resp.Success {
+		c.JSON(consts.StatusBadRequest, HTTPResponse{
+			Success: false,
+			Code:    resp.Code,
+			Message: getStringValue(resp.Message),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, HTTPResponse{
+		Success: true,
+		Code:    0,
+		Message: getStringValue(resp.Message),
+		Data:    resp.Products,
+	})
+}
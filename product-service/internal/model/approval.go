@@ -0,0 +1,57 @@
+package model
+
+// 商品审核状态
+type ApprovalStatus int32
+
+const (
+	ApprovalStatusUNSUBMITTED ApprovalStatus = 0 //未提交审核
+	ApprovalStatusPENDING     ApprovalStatus = 1 //待审核
+	ApprovalStatusAPPROVED    ApprovalStatus = 2 //审核通过
+	ApprovalStatusREJECTED    ApprovalStatus = 3 //已驳回
+)
+
+// 审核类型
+type ApprovalKind int32
+
+const (
+	ApprovalKindLISTING  ApprovalKind = 0 //新商品上架审核
+	ApprovalKindREVISION ApprovalKind = 1 //已审核商品的内容修改
+)
+
+// 商品审核记录，上架审核和内容修改审核共用
+type ProductApproval struct {
+	ID          int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID   int64          `gorm:"column:product_id;not null;index:idx_approval_product_status,priority:1"`
+	Kind        ApprovalKind   `gorm:"column:kind;not null"`
+	Status      ApprovalStatus `gorm:"column:status;not null;index:idx_approval_product_status,priority:2;index"`
+	Fields      string         `gorm:"column:fields;type:text"`                //待生效的修改内容，JSON，仅内容修改审核
+	Changes     string         `gorm:"column:changes;type:text"`               //提交时的字段变化，JSON数组
+	BaseVersion int64          `gorm:"column:base_version;not null;default:0"` //提交时的商品版本号
+	Submitter   string         `gorm:"column:submitter;type:varchar(100);not null;default:''"`
+	Remark      string         `gorm:"column:remark;type:varchar(500);default:''"`
+	Reviewer    string         `gorm:"column:reviewer;type:varchar(100);not null;default:''"`
+	Comment     string         `gorm:"column:comment;type:varchar(500);default:''"`
+	CreatedAt   int64          `gorm:"column:created_at;type:bigint;not null;index"`
+	ReviewedAt  int64          `gorm:"column:reviewed_at;type:bigint;not null;default:0"`
+}
+
+// 表名
+func (ProductApproval) TableName() string {
+	return "product_approvals"
+}
+
+// 内容修改审核中待生效的字段，为nil的字段不修改
+type ProductRevisionFields struct {
+	Name       *string           `json:"name,omitempty"`
+	Avatar     *string           `json:"avatar,omitempty"`
+	Category   *string           `json:"category,omitempty"`
+	Brand      *string           `json:"brand,omitempty"`
+	BrandID    *int64            `json:"brand_id,omitempty"`
+	Attributes map[string]string `json:"attributes"` //null表示不修改，空对象表示清空
+}
+
+// 是否包含需要修改的字段
+func (f *ProductRevisionFields) Empty() bool {
+	return f.Name == nil && f.Avatar == nil && f.Category == nil &&
+		f.Brand == nil && f.BrandID == nil && f.Attributes == nil
+}
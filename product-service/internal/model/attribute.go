@@ -0,0 +1,46 @@
+package model
+
+// 属性类型
+type AttributeType int32
+
+const (
+	AttributeTypeString AttributeType = 0 //文本
+	AttributeTypeNumber AttributeType = 1 //数值
+	AttributeTypeEnum   AttributeType = 2 //枚举
+	AttributeTypeBool   AttributeType = 3 //布尔
+)
+
+// 分类属性定义
+type AttributeDefinition struct {
+	ID        int64         `gorm:"column:id;primaryKey;autoIncrement"`
+	Category  string        `gorm:"column:category;type:varchar(100);not null;uniqueIndex:idx_category_code"`
+	Code      string        `gorm:"column:code;type:varchar(64);not null;uniqueIndex:idx_category_code;index"`
+	Name      string        `gorm:"column:name;type:varchar(100);not null"`
+	Type      AttributeType `gorm:"column:type;not null;default:0"`
+	Required  bool          `gorm:"column:required;not null;default:false"`
+	Unit      string        `gorm:"column:unit;type:varchar(20);default:''"`
+	Options   string        `gorm:"column:options;type:text"` //枚举可选值，JSON数组
+	Sort      int32         `gorm:"column:sort;not null;default:0"`
+	CreatedAt int64         `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt int64         `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (AttributeDefinition) TableName() string {
+	return "attribute_definitions"
+}
+
+// 商品属性值
+type ProductAttributeValue struct {
+	ID          int64   `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID   int64   `gorm:"column:product_id;not null;uniqueIndex:idx_product_attribute"`
+	AttributeID int64   `gorm:"column:attribute_id;not null;uniqueIndex:idx_product_attribute;index"`
+	Code        string  `gorm:"column:code;type:varchar(64);not null;index:idx_code_value"`
+	Value       string  `gorm:"column:value;type:varchar(255);not null;index:idx_code_value"`
+	NumValue    float64 `gorm:"column:num_value;not null;default:0"` //数值属性的值，布尔属性为1/0，用于范围筛选
+}
+
+// 表名
+func (ProductAttributeValue) TableName() string {
+	return "product_attribute_values"
+}
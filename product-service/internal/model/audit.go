@@ -0,0 +1,48 @@
+package model
+
+// 商品审计日志
+type ProductAudit struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID int64  `gorm:"column:product_id;not null;index:idx_audit_product_time,priority:1"`
+	Action    string `gorm:"column:action;type:varchar(30);not null"`
+	Operator  string `gorm:"column:operator;type:varchar(100);not null;default:'';index"`
+	Source    string `gorm:"column:source;type:varchar(20);not null"`
+	Changes   string `gorm:"column:changes;type:text"` //字段变更，JSON数组
+	Remark    string `gorm:"column:remark;type:varchar(255);default:''"`
+	CreatedAt int64  `gorm:"column:created_at;type:bigint;not null;index;index:idx_audit_product_time,priority:2"`
+}
+
+// 表名
+func (ProductAudit) TableName() string {
+	return "product_audits"
+}
+
+// 字段变更
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// 审计动作
+const (
+	AuditActionCreate       = "create"
+	AuditActionUpdate       = "update"
+	AuditActionOnline       = "online"
+	AuditActionOffline      = "offline"
+	AuditActionDelete       = "delete"
+	AuditActionStockChange  = "stock_change"
+	AuditActionPriceChange  = "price_change"  //定时调价生效或恢复
+	AuditActionStatusChange = "status_change" //系统自动售罄/下架/恢复上架
+	AuditActionSubmitReview = "submit_review" //提交上架审核或内容修改审核
+	AuditActionApprove      = "approve"       //审核通过
+	AuditActionReject       = "reject"        //审核驳回
+)
+
+// 变更来源
+const (
+	AuditSourceAdminHTTP = "admin_http" //商品服务管理HTTP接口
+	AuditSourceRPC       = "rpc"        //RPC调用（网关、订单服务等）
+	AuditSourceImport    = "import"     //批量导入
+	AuditSourceScheduler = "scheduler"  //定时任务
+)
@@ -0,0 +1,45 @@
+package model
+
+import "strings"
+
+// 品牌
+type Brand struct {
+	ID          int64       `gorm:"column:id;primaryKey;autoIncrement"`
+	Name        string      `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	Logo        string      `gorm:"column:logo;type:varchar(500);default:''"`
+	Description string      `gorm:"column:description;type:varchar(1000);default:''"`
+	Status      BrandStatus `gorm:"column:status;not null;default:0"`
+	CreatedAt   int64       `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt   int64       `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (Brand) TableName() string {
+	return "brands"
+}
+
+// 品牌匹配键，品牌名本身也存一条，保证名称和别名整体唯一
+type BrandAlias struct {
+	ID      int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	BrandID int64  `gorm:"column:brand_id;not null;index"`
+	Alias   string `gorm:"column:alias;type:varchar(100);not null"`
+	Key     string `gorm:"column:alias_key;type:varchar(100);not null;uniqueIndex"` //归一化后的写法
+}
+
+// 表名
+func (BrandAlias) TableName() string {
+	return "brand_aliases"
+}
+
+// 品牌状态
+type BrandStatus int32
+
+const (
+	BrandStatusACTIVE   BrandStatus = 0 //启用
+	BrandStatusDISABLED BrandStatus = 1 //停用
+)
+
+// 品牌名归一化：去掉空白并转小写，"Apple"、" apple "视为同一品牌
+func NormalizeBrandKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
@@ -0,0 +1,21 @@
+package model
+
+// 商品类型
+type ProductType int32
+
+const (
+	ProductTypeNORMAL ProductType = 0 //普通商品
+	ProductTypeBUNDLE ProductType = 1 //套装，库存由组件库存决定
+)
+
+// 套装组件，一个套装包含若干普通商品及其数量
+type ProductBundleItem struct {
+	BundleID    int64 `gorm:"column:bundle_id;primaryKey;autoIncrement:false"`
+	ComponentID int64 `gorm:"column:component_id;primaryKey;autoIncrement:false;index"`
+	Quantity    int32 `gorm:"column:quantity;not null"` //每套包含的数量
+}
+
+// 表名
+func (ProductBundleItem) TableName() string {
+	return "product_bundle_items"
+}
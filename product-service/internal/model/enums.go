@@ -8,4 +8,28 @@ const (
 	ProductStatusONLINE  ProductStatus = 1 //上架
 	ProductStatusOFFLINE ProductStatus = 2 //下架
 	ProductStatusDELETED ProductStatus = 3 //删除
+	ProductStatusSOLDOUT ProductStatus = 4 //售罄
+)
+
+//SoldOutPolicy
+type SoldOutPolicy int32
+
+const (
+	SoldOutPolicyNONE    SoldOutPolicy = 0 //不处理
+	SoldOutPolicySOLDOUT SoldOutPolicy = 1 //标记售罄
+	SoldOutPolicyOFFLINE SoldOutPolicy = 2 //自动下架
+)
+
+//StockChangeReason
+type StockChangeReason int32
+
+const (
+	StockChangeManualAdjust      StockChangeReason = 0 //人工调整
+	StockChangeOrderDeduct       StockChangeReason = 1 //下单扣减
+	StockChangeCancelRestore     StockChangeReason = 2 //取消恢复
+	StockChangeRefundReturn      StockChangeReason = 3 //退款退回
+	StockChangeImport            StockChangeReason = 4 //导入
+	StockChangeInitial           StockChangeReason = 5 //初始库存
+	StockChangeFlashSaleAllocate StockChangeReason = 6 //划拨秒杀库存
+	StockChangeFlashSaleReturn   StockChangeReason = 7 //秒杀结束退回
 )
@@ -0,0 +1,25 @@
+package model
+
+// 商品变更事件
+type ProductEvent struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID int64  `gorm:"column:product_id;not null;index"`
+	Type      string `gorm:"column:type;type:varchar(50);not null"`
+	CreatedAt int64  `gorm:"column:created_at;type:bigint;not null;index"`
+}
+
+// 表名
+func (ProductEvent) TableName() string {
+	return "product_events"
+}
+
+// 事件类型
+const (
+	ProductEventUpdated       = "product.updated"        //商品信息变更
+	ProductEventStatusChanged = "product.status_changed" //上下架状态变更
+	ProductEventStockChanged  = "product.stock_changed"  //库存变更
+	ProductEventDeleted       = "product.deleted"        //商品删除
+	ProductEventLowStock      = "product.low_stock"      //库存低于阈值
+	ProductEventSoldOut       = "product.sold_out"       //库存售罄
+	ProductEventRestocked     = "product.restocked"      //补货后恢复上架
+)
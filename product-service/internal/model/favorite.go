@@ -0,0 +1,15 @@
+package model
+
+// 用户收藏的商品，记录收藏时的价格用于判断降价
+type Favorite struct {
+	ID         int64   `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64   `gorm:"column:user_id;not null;uniqueIndex:idx_user_product"`
+	ProductID  int64   `gorm:"column:product_id;not null;uniqueIndex:idx_user_product;index"`
+	SavedPrice float64 `gorm:"column:saved_price;type:decimal(10,2);not null"`
+	CreatedAt  int64   `gorm:"column:created_at;type:bigint;not null"`
+}
+
+// 表名
+func (Favorite) TableName() string {
+	return "favorites"
+}
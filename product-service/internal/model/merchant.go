@@ -0,0 +1,25 @@
+package model
+
+// 商家，商品通过merchant_id归属商家，0表示平台自营
+type Merchant struct {
+	ID           int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	Name         string         `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	ContactName  string         `gorm:"column:contact_name;type:varchar(50);default:''"`
+	ContactPhone string         `gorm:"column:contact_phone;type:varchar(20);default:''"`
+	Status       MerchantStatus `gorm:"column:status;not null;default:0"`
+	CreatedAt    int64          `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt    int64          `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (Merchant) TableName() string {
+	return "merchants"
+}
+
+// 商家状态
+type MerchantStatus int32
+
+const (
+	MerchantStatusACTIVE   MerchantStatus = 0 //正常经营
+	MerchantStatusDISABLED MerchantStatus = 1 //停用
+)
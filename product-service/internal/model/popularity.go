@@ -0,0 +1,14 @@
+package model
+
+// 商品热度计数，按小时分桶累计，查询时按时间窗口汇总
+type ProductPopularityBucket struct {
+	ProductID  int64 `gorm:"column:product_id;primaryKey;autoIncrement:false"`
+	BucketHour int64 `gorm:"column:bucket_hour;primaryKey;autoIncrement:false;index"` //整点时间戳
+	ViewCount  int64 `gorm:"column:view_count;not null;default:0"`
+	SalesCount int64 `gorm:"column:sales_count;not null;default:0"` //已支付的商品件数
+}
+
+// 表名
+func (ProductPopularityBucket) TableName() string {
+	return "product_popularity_buckets"
+}
@@ -0,0 +1,53 @@
+package model
+
+// 调价计划状态
+const (
+	PriceScheduleStatusPending   = "pending"   //待生效
+	PriceScheduleStatusActive    = "active"    //生效中
+	PriceScheduleStatusCompleted = "completed" //已结束
+	PriceScheduleStatusCancelled = "cancelled" //已取消
+)
+
+// 价格变更来源
+const (
+	PriceSourceManual   = "manual"   //人工修改
+	PriceSourceSchedule = "schedule" //调价计划生效
+	PriceSourceRevert   = "revert"   //调价计划结束恢复原价
+	PriceSourceImport   = "import"   //批量导入
+)
+
+// 定时调价计划
+type PriceSchedule struct {
+	ID            int64   `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID     int64   `gorm:"column:product_id;not null;index"`
+	Price         float64 `gorm:"column:price;type:decimal(10,2);not null"`
+	StartAt       int64   `gorm:"column:start_at;type:bigint;not null;index"`
+	EndAt         int64   `gorm:"column:end_at;type:bigint;not null;default:0;index"`
+	Status        string  `gorm:"column:status;type:varchar(20);not null;index"`
+	OriginalPrice float64 `gorm:"column:original_price;type:decimal(10,2);default:0"`
+	Operator      string  `gorm:"column:operator;type:varchar(64);default:''"`
+	CreatedAt     int64   `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt     int64   `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (PriceSchedule) TableName() string {
+	return "price_schedules"
+}
+
+// 价格历史
+type PriceHistory struct {
+	ID         int64   `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID  int64   `gorm:"column:product_id;not null;index"`
+	OldPrice   float64 `gorm:"column:old_price;type:decimal(10,2);not null"`
+	NewPrice   float64 `gorm:"column:new_price;type:decimal(10,2);not null"`
+	Source     string  `gorm:"column:source;type:varchar(20);not null"`
+	ScheduleID int64   `gorm:"column:schedule_id;not null;default:0"`
+	Operator   string  `gorm:"column:operator;type:varchar(64);default:''"`
+	CreatedAt  int64   `gorm:"column:created_at;type:bigint;not null;index"`
+}
+
+// 表名
+func (PriceHistory) TableName() string {
+	return "price_histories"
+}
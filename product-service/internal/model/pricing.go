@@ -0,0 +1,27 @@
+package model
+
+// 商品阶梯价：购买数量达到MinQuantity时按Price计价，UserGroup为空时对所有用户生效
+type ProductPriceTier struct {
+	ID          int64   `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID   int64   `gorm:"column:product_id;not null;uniqueIndex:idx_price_tier_product_group_qty"`
+	UserGroup   string  `gorm:"column:user_group;type:varchar(50);not null;default:'';uniqueIndex:idx_price_tier_product_group_qty"`
+	MinQuantity int32   `gorm:"column:min_quantity;not null;uniqueIndex:idx_price_tier_product_group_qty"`
+	Price       float64 `gorm:"column:price;type:decimal(10,2);not null"`
+}
+
+// 表名
+func (ProductPriceTier) TableName() string {
+	return "product_price_tiers"
+}
+
+// 用户所在的价格组，如批发客户
+type UserPriceGroup struct {
+	UserID    int64  `gorm:"column:user_id;primaryKey;autoIncrement:false"`
+	Group     string `gorm:"column:price_group;type:varchar(50);not null;index"`
+	UpdatedAt int64  `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (UserPriceGroup) TableName() string {
+	return "user_price_groups"
+}
@@ -12,6 +12,22 @@ type Product struct {
 	CreatedAt int64         `gorm:"column:created_at;type:bigint;not null"`
 	UpdatedAt int64         `gorm:"column:updated_at;type:bigint;not null"`
 	Brand     string        `gorm:"column:brand;type:varchar(100);default:''"`
+
+	LowStockThreshold int32          `gorm:"column:low_stock_threshold;not null;default:0"`
+	SoldOutPolicy     SoldOutPolicy  `gorm:"column:sold_out_policy;not null;default:0"`
+	AutoDelisted      bool           `gorm:"column:auto_delisted;not null;default:false"`        //因库存为0被系统自动售罄/下架
+	OriginalPrice     float64        `gorm:"column:original_price;type:decimal(10,2);default:0"` //调价生效期间的原价，0表示未在调价
+	ExternalCode      *string        `gorm:"column:external_code;type:varchar(64);uniqueIndex"`  //外部编码，为空时不参与唯一约束
+	RatingAvg         float64        `gorm:"column:rating_avg;type:decimal(3,2);not null;default:0"`
+	RatingCount       int32          `gorm:"column:rating_count;not null;default:0"`
+	Version           int64          `gorm:"column:version;not null;default:1"`               //乐观锁版本号，编辑商品时递增
+	BrandID           int64          `gorm:"column:brand_id;not null;default:0;index"`        //关联品牌，Brand为品牌名冗余
+	ApprovalStatus    ApprovalStatus `gorm:"column:approval_status;not null;default:0;index"` //审核通过后才能上架
+	MerchantID        int64          `gorm:"column:merchant_id;not null;default:0;index"`     //所属商家，0表示平台自营
+	Popularity        float64        `gorm:"column:popularity;not null;default:0;index"`      //近7天浏览和销量的加权热度，由定时任务更新
+	Type              ProductType    `gorm:"column:product_type;not null;default:0;index"`    //商品类型，创建后不能修改
+	PurchaseLimit     int32          `gorm:"column:purchase_limit;not null;default:0"`        //每人限购数量，0表示不限购，由订单服务下单时校验
+	PurchaseLimitDays int32          `gorm:"column:purchase_limit_days;not null;default:0"`   //限购周期天数，0表示终身限购
 }
 
 //表名
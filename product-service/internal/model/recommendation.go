@@ -0,0 +1,49 @@
+package model
+
+// 共同购买统计，每对商品双向各存一条
+type ProductCoPurchase struct {
+	ProductID        int64 `gorm:"column:product_id;primaryKey;autoIncrement:false"`
+	RelatedProductID int64 `gorm:"column:related_product_id;primaryKey;autoIncrement:false"`
+	OrderCount       int32 `gorm:"column:order_count;not null;default:0"`
+	Generation       int64 `gorm:"column:generation;not null;index"` //同步批次号，用于清理旧数据
+}
+
+// 表名
+func (ProductCoPurchase) TableName() string {
+	return "product_co_purchases"
+}
+
+// 商品销量统计
+type ProductSalesStat struct {
+	ProductID  int64 `gorm:"column:product_id;primaryKey;autoIncrement:false"`
+	OrderCount int32 `gorm:"column:order_count;not null;default:0"`
+	Quantity   int32 `gorm:"column:quantity;not null;default:0"`
+	Generation int64 `gorm:"column:generation;not null;index"`
+}
+
+// 表名
+func (ProductSalesStat) TableName() string {
+	return "product_sales_stats"
+}
+
+// 推荐结果，由定时任务批量计算
+type ProductRecommendation struct {
+	ID            int64   `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID     int64   `gorm:"column:product_id;not null;index:idx_recommend_product_type,priority:1"`
+	Type          string  `gorm:"column:type;type:varchar(20);not null;index:idx_recommend_product_type,priority:2"`
+	RecommendedID int64   `gorm:"column:recommended_id;not null"`
+	Score         float64 `gorm:"column:score;not null;default:0"`
+	Position      int32   `gorm:"column:position;not null;default:0"` //排序位置，从1开始
+	CreatedAt     int64   `gorm:"column:created_at;type:bigint;not null"`
+}
+
+// 表名
+func (ProductRecommendation) TableName() string {
+	return "product_recommendations"
+}
+
+// 推荐类型
+const (
+	RecommendTypeRelated        = "related"         //相关商品
+	RecommendTypeBoughtTogether = "bought_together" //经常一起购买
+)
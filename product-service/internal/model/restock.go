@@ -0,0 +1,24 @@
+package model
+
+// 到货通知订阅状态
+type RestockSubscriptionStatus int32
+
+const (
+	RestockSubscriptionPENDING  RestockSubscriptionStatus = 0 //等待补货
+	RestockSubscriptionNOTIFIED RestockSubscriptionStatus = 1 //已发送到货通知
+)
+
+// 用户订阅的商品到货通知，同一用户同一商品只保留一条，通知后再次订阅时重新等待
+type RestockSubscription struct {
+	ID         int64                     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64                     `gorm:"column:user_id;not null;uniqueIndex:idx_restock_user_product;index:idx_restock_user_notified,priority:1"`
+	ProductID  int64                     `gorm:"column:product_id;not null;uniqueIndex:idx_restock_user_product;index:idx_restock_product_status,priority:1"`
+	Status     RestockSubscriptionStatus `gorm:"column:status;not null;default:0;index:idx_restock_product_status,priority:2"`
+	CreatedAt  int64                     `gorm:"column:created_at;type:bigint;not null"`
+	NotifiedAt int64                     `gorm:"column:notified_at;type:bigint;not null;default:0;index:idx_restock_user_notified,priority:2"` //最近一次通知时间，用于按用户限流
+}
+
+// 表名
+func (RestockSubscription) TableName() string {
+	return "restock_subscriptions"
+}
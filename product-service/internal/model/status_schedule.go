@@ -0,0 +1,28 @@
+package model
+
+// 定时上下架计划状态
+const (
+	StatusScheduleStatusPending   = "pending"   //待执行
+	StatusScheduleStatusDone      = "done"      //已执行
+	StatusScheduleStatusFailed    = "failed"    //执行失败
+	StatusScheduleStatusCancelled = "cancelled" //已取消
+)
+
+// 定时上下架计划
+type ProductStatusSchedule struct {
+	ID           int64         `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID    int64         `gorm:"column:product_id;not null;index"`
+	TargetStatus ProductStatus `gorm:"column:target_status;not null"`
+	ExecuteAt    int64         `gorm:"column:execute_at;type:bigint;not null;index"`
+	Status       string        `gorm:"column:status;type:varchar(20);not null;index"`
+	Message      string        `gorm:"column:message;type:varchar(255);default:''"`
+	Operator     string        `gorm:"column:operator;type:varchar(64);default:''"`
+	ExecutedAt   int64         `gorm:"column:executed_at;type:bigint;not null;default:0"`
+	CreatedAt    int64         `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt    int64         `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (ProductStatusSchedule) TableName() string {
+	return "product_status_schedules"
+}
@@ -0,0 +1,19 @@
+package model
+
+// 库存流水
+type StockMovement struct {
+	ID          int64             `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID   int64             `gorm:"column:product_id;not null;index;uniqueIndex:idx_product_reason_reference"`
+	WarehouseID int64             `gorm:"column:warehouse_id;not null;default:0;index"`
+	Delta       int32             `gorm:"column:delta;not null"`
+	Balance     int32             `gorm:"column:balance;not null"`
+	Reason      StockChangeReason `gorm:"column:reason;not null;index;uniqueIndex:idx_product_reason_reference"`
+	ReferenceID *string           `gorm:"column:reference_id;type:varchar(64);uniqueIndex:idx_product_reason_reference"` //无关联单号时为NULL，不参与唯一约束
+	Operator    string            `gorm:"column:operator;type:varchar(64);default:''"`
+	CreatedAt   int64             `gorm:"column:created_at;type:bigint;not null;index"`
+}
+
+// 表名
+func (StockMovement) TableName() string {
+	return "stock_movements"
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// 搜索联想词，由定时任务根据在售商品整体重建
+type SearchSuggestion struct {
+	ID       int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	Text     string         `gorm:"column:text;type:varchar(255);not null"`
+	Kind     SuggestionKind `gorm:"column:kind;not null;default:0"`
+	RefID    int64          `gorm:"column:ref_id;not null;default:0"`                  //商品ID或品牌ID，类目为0
+	Key      string         `gorm:"column:match_key;type:varchar(255);not null;index"` //归一化后的匹配键，品牌别名单独存一条
+	Initials string         `gorm:"column:initials;type:varchar(255);not null;default:'';index"`
+	Weight   float64        `gorm:"column:weight;not null;default:0"`
+}
+
+// 表名
+func (SearchSuggestion) TableName() string {
+	return "search_suggestions"
+}
+
+// 联想词类型
+type SuggestionKind int32
+
+const (
+	SuggestionKindPRODUCT  SuggestionKind = 0 //商品名称
+	SuggestionKindBRAND    SuggestionKind = 1 //品牌
+	SuggestionKindCATEGORY SuggestionKind = 2 //类目
+)
+
+// 用户搜索词统计，按归一化后的搜索词累计
+type SearchQueryStat struct {
+	ID              int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	Query           string `gorm:"column:query;type:varchar(255);not null;uniqueIndex"`
+	SearchCount     int64  `gorm:"column:search_count;not null;default:0;index"`
+	ZeroResultCount int64  `gorm:"column:zero_result_count;not null;default:0"`
+	LastResultCount int32  `gorm:"column:last_result_count;not null;default:0"`
+	LastSearchedAt  int64  `gorm:"column:last_searched_at;type:bigint;not null;default:0"`
+	CreatedAt       int64  `gorm:"column:created_at;type:bigint;not null"`
+}
+
+// 表名
+func (SearchQueryStat) TableName() string {
+	return "search_query_stats"
+}
+
+// 搜索词归一化：去掉空白和LIKE通配符并转小写
+func NormalizeSearchTerm(term string) string {
+	term = strings.ToLower(strings.Join(strings.Fields(term), ""))
+	return strings.NewReplacer("%", "", "_", "").Replace(term)
+}
+
+// GB2312一级汉字按拼音排序，各声母首字的编码，I、U、V没有汉字
+var pinyinInitialBoundaries = []struct {
+	code    uint16
+	initial byte
+}{
+	{0xB0A1, 'a'}, {0xB0C5, 'b'}, {0xB2C1, 'c'}, {0xB4EE, 'd'}, {0xB6EA, 'e'},
+	{0xB7A2, 'f'}, {0xB8C1, 'g'}, {0xB9FE, 'h'}, {0xBBF7, 'j'}, {0xBFA6, 'k'},
+	{0xC0AC, 'l'}, {0xC2E8, 'm'}, {0xC4C3, 'n'}, {0xC5B6, 'o'}, {0xC5BE, 'p'},
+	{0xC6DA, 'q'}, {0xC8BB, 'r'}, {0xC8F6, 's'}, {0xCBFA, 't'}, {0xCDDA, 'w'},
+	{0xCEF4, 'x'}, {0xD1B9, 'y'}, {0xD4D1, 'z'},
+}
+
+// 一级汉字的编码上限，二级汉字按部首排序无法推算拼音
+const pinyinLevel1End = 0xD7F9
+
+// 拼音首字母，如"华为Mate60"得到"hwmate60"；字母数字保留，无法识别的汉字和符号跳过
+func PinyinInitials(term string) string {
+	encoder := simplifiedchinese.GBK.NewEncoder()
+	var b strings.Builder
+	for _, r := range strings.ToLower(term) {
+		if r < unicode.MaxASCII {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				b.WriteRune(r)
+			}
+			continue
+		}
+		if !unicode.Is(unicode.Han, r) {
+			continue
+		}
+		encoded, err := encoder.String(string(r))
+		if err != nil || len(encoded) != 2 {
+			continue
+		}
+		code := uint16(encoded[0])<<8 | uint16(encoded[1])
+		if code < pinyinInitialBoundaries[0].code || code > pinyinLevel1End {
+			continue
+		}
+		initial := pinyinInitialBoundaries[0].initial
+		for _, boundary := range pinyinInitialBoundaries {
+			if code < boundary.code {
+				break
+			}
+			initial = boundary.initial
+		}
+		b.WriteByte(initial)
+	}
+	return b.String()
+}
+
+// 是否包含汉字，只有含汉字的词才需要按拼音首字母匹配
+func ContainsHan(term string) bool {
+	for _, r := range term {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
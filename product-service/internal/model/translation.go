@@ -0,0 +1,32 @@
+package model
+
+// 商品内容的多语言翻译，默认语言的内容保存在商品表
+type ProductTranslation struct {
+	ID          int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	ProductID   int64  `gorm:"column:product_id;not null;uniqueIndex:idx_product_locale"`
+	Locale      string `gorm:"column:locale;type:varchar(35);not null;uniqueIndex:idx_product_locale"` //BCP 47语言标签，如en-US
+	Name        string `gorm:"column:name;type:varchar(255);not null"`
+	Description string `gorm:"column:description;type:text"`
+	CreatedAt   int64  `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt   int64  `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (ProductTranslation) TableName() string {
+	return "product_translations"
+}
+
+// 分类名称的多语言翻译，按分类名称关联
+type CategoryTranslation struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	Category  string `gorm:"column:category;type:varchar(100);not null;uniqueIndex:idx_category_locale"`
+	Locale    string `gorm:"column:locale;type:varchar(35);not null;uniqueIndex:idx_category_locale;index"`
+	Label     string `gorm:"column:label;type:varchar(100);not null"`
+	CreatedAt int64  `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt int64  `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (CategoryTranslation) TableName() string {
+	return "category_translations"
+}
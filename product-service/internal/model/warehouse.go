@@ -0,0 +1,34 @@
+package model
+
+// 仓库
+type Warehouse struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	Code      string `gorm:"column:code;type:varchar(32);not null;uniqueIndex"`
+	Name      string `gorm:"column:name;type:varchar(100);not null"`
+	Province  string `gorm:"column:province;type:varchar(50);not null;index"`
+	City      string `gorm:"column:city;type:varchar(50);default:''"`
+	Address   string `gorm:"column:address;type:varchar(255);default:''"`
+	Priority  int32  `gorm:"column:priority;not null;default:0"`
+	Active    bool   `gorm:"column:active;not null;default:true"`
+	CreatedAt int64  `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt int64  `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (Warehouse) TableName() string {
+	return "warehouses"
+}
+
+// 仓库商品库存
+type WarehouseStock struct {
+	ID          int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	WarehouseID int64 `gorm:"column:warehouse_id;not null;uniqueIndex:idx_warehouse_product"`
+	ProductID   int64 `gorm:"column:product_id;not null;uniqueIndex:idx_warehouse_product;index"`
+	Stock       int32 `gorm:"column:stock;not null;default:0"`
+	UpdatedAt   int64 `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+// 表名
+func (WarehouseStock) TableName() string {
+	return "warehouse_stocks"
+}
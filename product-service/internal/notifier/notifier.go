@@ -0,0 +1,105 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"ecommerce/product-service/pkg/config"
+)
+
+// 预警类型
+const (
+	AlertLowStock  = "low_stock" //库存低于阈值
+	AlertSoldOut   = "sold_out"  //库存售罄
+	AlertRestocked = "restocked" //补货恢复
+)
+
+// 库存预警
+type Alert struct {
+	Type        string `json:"type"`
+	ProductID   int64  `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Stock       int32  `json:"stock"`
+	Threshold   int32  `json:"threshold"`
+	Action      string `json:"action,omitempty"` //系统执行的处理：sold_out / offline / relisted
+	CreatedAt   int64  `json:"created_at"`
+}
+
+// 通知接口
+type Notifier interface {
+	Notify(ctx context.Context, alert *Alert) error
+}
+
+// 根据配置创建通知器，始终写日志，配置了webhook时同时推送
+func New(cfg *config.AlertConfig) Notifier {
+	notifiers := []Notifier{&LogNotifier{}}
+	if cfg != nil && cfg.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.WebhookURL, cfg.Timeout))
+	}
+	return multiNotifier(notifiers)
+}
+
+// 日志通知
+type LogNotifier struct{}
+
+func (n *LogNotifier) Notify(ctx context.Context, alert *Alert) error {
+	log.Printf("库存预警[%s]: 商品=%d(%s), 库存=%d, 阈值=%d, 处理=%s",
+		alert.Type, alert.ProductID, alert.ProductName, alert.Stock, alert.Threshold, alert.Action)
+	return nil
+}
+
+// Webhook通知
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// 创建Webhook通知器
+func NewWebhookNotifier(url string, timeout time.Duration) *WebhookNotifier {
+	if timeout <= 0 {
+		timeout = 3 * time.Second
+	}
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook返回状态码%d", resp.StatusCode)
+	}
+	return nil
+}
+
+// 依次调用多个通知器，返回第一个错误
+type multiNotifier []Notifier
+
+func (m multiNotifier) Notify(ctx context.Context, alert *Alert) error {
+	var firstErr error
+	for _, n := range m {
+		if err := n.Notify(ctx, alert); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
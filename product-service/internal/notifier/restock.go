@@ -0,0 +1,84 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+
+	"ecommerce/product-service/pkg/config"
+)
+
+// 到货通知，发给订阅了商品补货的用户
+type RestockNotice struct {
+	UserID      int64   `json:"user_id"`
+	ProductID   int64   `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Price       float64 `json:"price"`
+	Stock       int32   `json:"stock"`
+	CreatedAt   int64   `json:"created_at"`
+}
+
+// 到货通知接口
+type RestockNotifier interface {
+	NotifyRestock(ctx context.Context, notice *RestockNotice) error
+}
+
+// 根据配置创建到货通知器，始终写日志，配置了文件时同时追加到文件
+func NewRestockNotifier(cfg *config.RestockConfig) RestockNotifier {
+	notifiers := []RestockNotifier{&LogRestockNotifier{}}
+	if cfg != nil && cfg.LogFile != "" {
+		notifiers = append(notifiers, NewFileRestockNotifier(cfg.LogFile))
+	}
+	return multiRestockNotifier(notifiers)
+}
+
+// 日志到货通知
+type LogRestockNotifier struct{}
+
+func (n *LogRestockNotifier) NotifyRestock(ctx context.Context, notice *RestockNotice) error {
+	log.Printf("到货通知: 用户=%d, 商品=%d(%s), 价格=%.2f, 库存=%d",
+		notice.UserID, notice.ProductID, notice.ProductName, notice.Price, notice.Stock)
+	return nil
+}
+
+// 文件到货通知，每条通知一行JSON
+type FileRestockNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// 创建文件到货通知器
+func NewFileRestockNotifier(path string) *FileRestockNotifier {
+	return &FileRestockNotifier{path: path}
+}
+
+func (n *FileRestockNotifier) NotifyRestock(ctx context.Context, notice *RestockNotice) error {
+	line, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
+
+// 依次调用多个到货通知器，返回第一个错误
+type multiRestockNotifier []RestockNotifier
+
+func (m multiRestockNotifier) NotifyRestock(ctx context.Context, notice *RestockNotice) error {
+	var firstErr error
+	for _, n := range m {
+		if err := n.NotifyRestock(ctx, notice); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
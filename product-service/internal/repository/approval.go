@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 商品审核状态已变化，提交或审核时使用
+var ErrApprovalConflict = errors.New("approval status changed")
+
+// 审核记录查询条件
+type ApprovalFilter struct {
+	ProductID *int64
+	Status    *model.ApprovalStatus
+	Kind      *model.ApprovalKind
+}
+
+// 商品审核接口
+type ApprovalRepository interface {
+	// 提交上架审核，商品审核状态须为未提交或已驳回，否则返回ErrApprovalConflict
+	SubmitListing(ctx context.Context, approval *model.ProductApproval) error
+	// 提交内容修改审核，商品已有待审核的修改时返回ErrApprovalConflict
+	SubmitRevision(ctx context.Context, approval *model.ProductApproval) error
+	// 审核上架申请并同步商品审核状态，申请已被处理时返回ErrApprovalConflict
+	ResolveListing(ctx context.Context, approval *model.ProductApproval) error
+	// 审核内容修改，申请已被处理时返回ErrApprovalConflict
+	ResolveRevision(ctx context.Context, approval *model.ProductApproval) error
+	// 修改内容应用失败时恢复为待审核
+	Reopen(ctx context.Context, id int64) error
+
+	FindByID(ctx context.Context, id int64) (*model.ProductApproval, error)
+	FindPendingRevision(ctx context.Context, productID int64) (*model.ProductApproval, error)
+	List(ctx context.Context, filter *ApprovalFilter, page, pageSize int32) ([]*model.ProductApproval, int64, error)
+}
+
+type approvalRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建商品审核存储实例
+func NewApprovalRepository(db *gorm.DB) ApprovalRepository {
+	return &approvalRepositoryImpl{db: db}
+}
+
+// 提交上架审核
+func (r *approvalRepositoryImpl) SubmitListing(ctx context.Context, approval *model.ProductApproval) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Product{}).
+			Where("id = ? AND approval_status IN ?", approval.ProductID,
+				[]model.ApprovalStatus{model.ApprovalStatusUNSUBMITTED, model.ApprovalStatusREJECTED}).
+			Updates(map[string]interface{}{
+				"approval_status": model.ApprovalStatusPENDING,
+				"version":         gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrApprovalConflict
+		}
+		return tx.Create(approval).Error
+	})
+}
+
+// 提交内容修改审核
+func (r *approvalRepositoryImpl) SubmitRevision(ctx context.Context, approval *model.ProductApproval) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&model.ProductApproval{}).
+			Where("product_id = ? AND kind = ? AND status = ?", approval.ProductID,
+				model.ApprovalKindREVISION, model.ApprovalStatusPENDING).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrApprovalConflict
+		}
+		return tx.Create(approval).Error
+	})
+}
+
+// 审核上架申请
+func (r *approvalRepositoryImpl) ResolveListing(ctx context.Context, approval *model.ProductApproval) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := resolveApproval(tx, approval); err != nil {
+			return err
+		}
+		return tx.Model(&model.Product{}).
+			Where("id = ? AND approval_status = ?", approval.ProductID, model.ApprovalStatusPENDING).
+			Updates(map[string]interface{}{
+				"approval_status": approval.Status,
+				"version":         gorm.Expr("version + 1"),
+			}).Error
+	})
+}
+
+// 审核内容修改
+func (r *approvalRepositoryImpl) ResolveRevision(ctx context.Context, approval *model.ProductApproval) error {
+	return resolveApproval(r.db.WithContext(ctx), approval)
+}
+
+// 只处理仍为待审核的申请
+func resolveApproval(tx *gorm.DB, approval *model.ProductApproval) error {
+	result := tx.Model(&model.ProductApproval{}).
+		Where("id = ? AND status = ?", approval.ID, model.ApprovalStatusPENDING).
+		Updates(map[string]interface{}{
+			"status":      approval.Status,
+			"reviewer":    approval.Reviewer,
+			"comment":     approval.Comment,
+			"reviewed_at": approval.ReviewedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrApprovalConflict
+	}
+	return nil
+}
+
+// 恢复为待审核
+func (r *approvalRepositoryImpl) Reopen(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.ProductApproval{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":      model.ApprovalStatusPENDING,
+			"reviewer":    "",
+			"comment":     "",
+			"reviewed_at": 0,
+		}).Error
+}
+
+// 根据ID查询审核记录，不存在时返回nil
+func (r *approvalRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.ProductApproval, error) {
+	var approval model.ProductApproval
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&approval).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &approval, nil
+}
+
+// 查询商品待审核的内容修改，没有时返回nil
+func (r *approvalRepositoryImpl) FindPendingRevision(ctx context.Context, productID int64) (*model.ProductApproval, error) {
+	var approvals []*model.ProductApproval
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND kind = ? AND status = ?", productID,
+			model.ApprovalKindREVISION, model.ApprovalStatusPENDING).
+		Limit(1).
+		Find(&approvals).Error
+	if err != nil || len(approvals) == 0 {
+		return nil, err
+	}
+	return approvals[0], nil
+}
+
+// 按条件分页查询，待审核时最早提交的在前，其余最新的在前
+func (r *approvalRepositoryImpl) List(ctx context.Context, filter *ApprovalFilter, page, pageSize int32) ([]*model.ProductApproval, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.ProductApproval{})
+	order := "created_at DESC, id DESC"
+	if filter != nil {
+		if filter.ProductID != nil {
+			query = query.Where("product_id = ?", *filter.ProductID)
+		}
+		if filter.Status != nil {
+			query = query.Where("status = ?", *filter.Status)
+			if *filter.Status == model.ApprovalStatusPENDING {
+				order = "created_at ASC, id ASC"
+			}
+		}
+		if filter.Kind != nil {
+			query = query.Where("kind = ?", *filter.Kind)
+		}
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var approvals []*model.ProductApproval
+	err := query.
+		Order(order).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&approvals).Error
+	return approvals, total, err
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 属性筛选条件
+type AttributeCondition struct {
+	Code    string
+	Op      string
+	Numeric bool      //按数值比较
+	Values  []string  //文本比较的值
+	Numbers []float64 //数值比较的值
+}
+
+// 属性定义与商品属性值接口
+type AttributeRepository interface {
+	CreateDefinition(ctx context.Context, def *model.AttributeDefinition) error
+	UpdateDefinition(ctx context.Context, def *model.AttributeDefinition) error
+	DeleteDefinition(ctx context.Context, id int64) error
+	FindDefinitionByID(ctx context.Context, id int64) (*model.AttributeDefinition, error)
+	FindDefinitionByCode(ctx context.Context, category, code string) (*model.AttributeDefinition, error)
+	ListDefinitions(ctx context.Context, category *string) ([]*model.AttributeDefinition, error)
+	ListDefinitionsByCode(ctx context.Context, code string) ([]*model.AttributeDefinition, error)
+
+	ListValues(ctx context.Context, productID int64) ([]*model.ProductAttributeValue, error)
+}
+
+type attributeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建属性存储实例
+func NewAttributeRepository(db *gorm.DB) AttributeRepository {
+	return &attributeRepositoryImpl{db: db}
+}
+
+// 创建属性定义
+func (r *attributeRepositoryImpl) CreateDefinition(ctx context.Context, def *model.AttributeDefinition) error {
+	return r.db.WithContext(ctx).Create(def).Error
+}
+
+// 更新属性定义
+func (r *attributeRepositoryImpl) UpdateDefinition(ctx context.Context, def *model.AttributeDefinition) error {
+	return r.db.WithContext(ctx).Save(def).Error
+}
+
+// 删除属性定义及所有商品上的该属性值
+func (r *attributeRepositoryImpl) DeleteDefinition(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("attribute_id = ?", id).Delete(&model.ProductAttributeValue{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.AttributeDefinition{}).Error
+	})
+}
+
+// 根据ID查找属性定义
+func (r *attributeRepositoryImpl) FindDefinitionByID(ctx context.Context, id int64) (*model.AttributeDefinition, error) {
+	var def model.AttributeDefinition
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&def).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &def, nil
+}
+
+// 根据分类和编码查找属性定义
+func (r *attributeRepositoryImpl) FindDefinitionByCode(ctx context.Context, category, code string) (*model.AttributeDefinition, error) {
+	var def model.AttributeDefinition
+	err := r.db.WithContext(ctx).
+		Where("category = ? AND code = ?", category, code).
+		First(&def).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &def, nil
+}
+
+// 查询属性定义，不指定分类时返回全部
+func (r *attributeRepositoryImpl) ListDefinitions(ctx context.Context, category *string) ([]*model.AttributeDefinition, error) {
+	query := r.db.WithContext(ctx).Model(&model.AttributeDefinition{})
+	if category != nil && *category != "" {
+		query = query.Where("category = ?", *category)
+	}
+	var defs []*model.AttributeDefinition
+	err := query.Order("category ASC, sort ASC, id ASC").Find(&defs).Error
+	return defs, err
+}
+
+// 查询所有分类下同一编码的属性定义
+func (r *attributeRepositoryImpl) ListDefinitionsByCode(ctx context.Context, code string) ([]*model.AttributeDefinition, error) {
+	var defs []*model.AttributeDefinition
+	err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		Order("id ASC").
+		Find(&defs).Error
+	return defs, err
+}
+
+// 查询商品的属性值
+func (r *attributeRepositoryImpl) ListValues(ctx context.Context, productID int64) ([]*model.ProductAttributeValue, error) {
+	var values []*model.ProductAttributeValue
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("id ASC").
+		Find(&values).Error
+	return values, err
+}
+
+// 整体替换商品的属性值，在商品写入的同一事务内调用
+func replaceAttributeValues(tx *gorm.DB, productID int64, values []*model.ProductAttributeValue) error {
+	if err := tx.Where("product_id = ?", productID).Delete(&model.ProductAttributeValue{}).Error; err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	for _, v := range values {
+		v.ID = 0
+		v.ProductID = productID
+	}
+	return tx.Create(&values).Error
+}
+
+// 按属性条件过滤商品，条件之间为且的关系
+func applyAttributeConditions(db, query *gorm.DB, conditions []AttributeCondition) *gorm.DB {
+	for _, c := range conditions {
+		sub := db.Model(&model.ProductAttributeValue{}).
+			Select("product_id").
+			Where("code = ?", c.Code)
+		if c.Numeric {
+			switch c.Op {
+			case "eq":
+				sub = sub.Where("num_value = ?", c.Numbers[0])
+			case "ne":
+				sub = sub.Where("num_value <> ?", c.Numbers[0])
+			case "lt":
+				sub = sub.Where("num_value < ?", c.Numbers[0])
+			case "lte":
+				sub = sub.Where("num_value <= ?", c.Numbers[0])
+			case "gt":
+				sub = sub.Where("num_value > ?", c.Numbers[0])
+			case "gte":
+				sub = sub.Where("num_value >= ?", c.Numbers[0])
+			case "in":
+				sub = sub.Where("num_value IN ?", c.Numbers)
+			}
+		} else {
+			switch c.Op {
+			case "eq":
+				sub = sub.Where("value = ?", c.Values[0])
+			case "ne":
+				sub = sub.Where("value <> ?", c.Values[0])
+			case "in":
+				sub = sub.Where("value IN ?", c.Values)
+			}
+		}
+		query = query.Where("id IN (?)", sub)
+	}
+	return query
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// 审计日志查询条件
+type AuditFilter struct {
+	ProductID *int64
+	Operator  *string
+	StartTime *int64 //包含
+	EndTime   *int64 //不包含
+}
+
+// 审计日志接口
+type AuditRepository interface {
+	Create(ctx context.Context, audit *model.ProductAudit) error
+	List(ctx context.Context, filter *AuditFilter, page, pageSize int32) ([]*model.ProductAudit, int64, error)
+}
+
+type auditRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建审计日志存储实例
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepositoryImpl{db: db}
+}
+
+// 写入审计日志
+func (r *auditRepositoryImpl) Create(ctx context.Context, audit *model.ProductAudit) error {
+	return r.db.WithContext(ctx).Create(audit).Error
+}
+
+// 按条件分页查询，最新的在前
+func (r *auditRepositoryImpl) List(ctx context.Context, filter *AuditFilter, page, pageSize int32) ([]*model.ProductAudit, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.ProductAudit{})
+	if filter != nil {
+		if filter.ProductID != nil {
+			query = query.Where("product_id = ?", *filter.ProductID)
+		}
+		if filter.Operator != nil && *filter.Operator != "" {
+			query = query.Where("operator = ?", *filter.Operator)
+		}
+		if filter.StartTime != nil {
+			query = query.Where("created_at >= ?", *filter.StartTime)
+		}
+		if filter.EndTime != nil {
+			query = query.Where("created_at < ?", *filter.EndTime)
+		}
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var audits []*model.ProductAudit
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&audits).Error
+	return audits, total, err
+}
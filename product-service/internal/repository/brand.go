@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 品牌接口
+type BrandRepository interface {
+	// aliases包含品牌名本身，按归一化键整体替换
+	Create(ctx context.Context, brand *model.Brand, aliases []*model.BrandAlias) error
+	// aliases为nil时不修改别名；品牌改名时同步更新商品上的品牌名
+	Update(ctx context.Context, brand *model.Brand, aliases []*model.BrandAlias, renamed bool) error
+	// target不为nil时商品和别名并入target
+	Delete(ctx context.Context, id int64, target *model.Brand) error
+
+	FindByID(ctx context.Context, id int64) (*model.Brand, error)
+	FindByIDs(ctx context.Context, ids []int64) (map[int64]*model.Brand, error)
+	FindByKeys(ctx context.Context, keys []string) (map[string]*model.Brand, error)
+	List(ctx context.Context, keyword *string, status *model.BrandStatus, page, pageSize int32) ([]*model.Brand, int64, error)
+
+	// 查询品牌的别名，品牌ID -> 别名列表
+	ListAliases(ctx context.Context, brandIDs []int64) (map[int64][]*model.BrandAlias, error)
+	// 查询被其他品牌占用的匹配键，匹配键 -> 品牌ID
+	FindKeyOwners(ctx context.Context, keys []string, excludeBrandID int64) (map[string]int64, error)
+	ListProductIDs(ctx context.Context, brandID int64) ([]int64, error)
+}
+
+type brandRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建品牌存储实例
+func NewBrandRepository(db *gorm.DB) BrandRepository {
+	return &brandRepositoryImpl{db: db}
+}
+
+// 创建品牌及其别名
+func (r *brandRepositoryImpl) Create(ctx context.Context, brand *model.Brand, aliases []*model.BrandAlias) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(brand).Error; err != nil {
+			return err
+		}
+		return replaceBrandAliases(tx, brand.ID, aliases)
+	})
+}
+
+// 更新品牌
+func (r *brandRepositoryImpl) Update(ctx context.Context, brand *model.Brand, aliases []*model.BrandAlias, renamed bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(brand).Error; err != nil {
+			return err
+		}
+		if aliases != nil {
+			if err := replaceBrandAliases(tx, brand.ID, aliases); err != nil {
+				return err
+			}
+		}
+		if renamed {
+			return tx.Model(&model.Product{}).
+				Where("brand_id = ?", brand.ID).
+				Updates(map[string]interface{}{
+					"brand":      brand.Name,
+					"version":    gorm.Expr("version + 1"),
+					"updated_at": brand.UpdatedAt,
+				}).Error
+		}
+		return nil
+	})
+}
+
+func replaceBrandAliases(tx *gorm.DB, brandID int64, aliases []*model.BrandAlias) error {
+	if err := tx.Where("brand_id = ?", brandID).Delete(&model.BrandAlias{}).Error; err != nil {
+		return err
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	for _, alias := range aliases {
+		alias.ID = 0
+		alias.BrandID = brandID
+	}
+	return tx.Create(&aliases).Error
+}
+
+// 删除品牌，有合并目标时先迁移商品和别名
+func (r *brandRepositoryImpl) Delete(ctx context.Context, id int64, target *model.Brand) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if target != nil {
+			err := tx.Model(&model.Product{}).
+				Where("brand_id = ?", id).
+				Updates(map[string]interface{}{
+					"brand_id": target.ID,
+					"brand":    target.Name,
+					"version":  gorm.Expr("version + 1"),
+				}).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Model(&model.BrandAlias{}).
+				Where("brand_id = ?", id).
+				Update("brand_id", target.ID).Error
+			if err != nil {
+				return err
+			}
+		} else {
+			if err := tx.Where("brand_id = ?", id).Delete(&model.BrandAlias{}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&model.Brand{}, id).Error
+	})
+}
+
+// 根据ID查询品牌，不存在时返回nil
+func (r *brandRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.Brand, error) {
+	var brand model.Brand
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&brand).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &brand, nil
+}
+
+// 批量查询品牌
+func (r *brandRepositoryImpl) FindByIDs(ctx context.Context, ids []int64) (map[int64]*model.Brand, error) {
+	result := make(map[int64]*model.Brand, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	var brands []*model.Brand
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&brands).Error; err != nil {
+		return nil, err
+	}
+	for _, b := range brands {
+		result[b.ID] = b
+	}
+	return result, nil
+}
+
+// 按归一化的名称或别名查询品牌，匹配键 -> 品牌
+func (r *brandRepositoryImpl) FindByKeys(ctx context.Context, keys []string) (map[string]*model.Brand, error) {
+	result := make(map[string]*model.Brand, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+	var aliases []*model.BrandAlias
+	if err := r.db.WithContext(ctx).Where("alias_key IN ?", keys).Find(&aliases).Error; err != nil {
+		return nil, err
+	}
+	if len(aliases) == 0 {
+		return result, nil
+	}
+	ids := make([]int64, 0, len(aliases))
+	for _, a := range aliases {
+		ids = append(ids, a.BrandID)
+	}
+	brands, err := r.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range aliases {
+		if brand, ok := brands[a.BrandID]; ok {
+			result[a.Key] = brand
+		}
+	}
+	return result, nil
+}
+
+// 分页查询品牌，关键字同时匹配名称和别名
+func (r *brandRepositoryImpl) List(ctx context.Context, keyword *string, status *model.BrandStatus,
+	page, pageSize int32) ([]*model.Brand, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.Brand{})
+	if keyword != nil && *keyword != "" {
+		query = query.Where("name LIKE ? OR id IN (?)", "%"+*keyword+"%",
+			r.db.Model(&model.BrandAlias{}).Select("brand_id").Where("alias LIKE ?", "%"+*keyword+"%"))
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var brands []*model.Brand
+	err := query.
+		Order("name ASC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&brands).Error
+	return brands, total, err
+}
+
+// 查询品牌别名
+func (r *brandRepositoryImpl) ListAliases(ctx context.Context, brandIDs []int64) (map[int64][]*model.BrandAlias, error) {
+	result := make(map[int64][]*model.BrandAlias, len(brandIDs))
+	if len(brandIDs) == 0 {
+		return result, nil
+	}
+	var aliases []*model.BrandAlias
+	err := r.db.WithContext(ctx).
+		Where("brand_id IN ?", brandIDs).
+		Order("id ASC").
+		Find(&aliases).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range aliases {
+		result[a.BrandID] = append(result[a.BrandID], a)
+	}
+	return result, nil
+}
+
+// 查询被其他品牌占用的匹配键
+func (r *brandRepositoryImpl) FindKeyOwners(ctx context.Context, keys []string, excludeBrandID int64) (map[string]int64, error) {
+	result := make(map[string]int64)
+	if len(keys) == 0 {
+		return result, nil
+	}
+	var aliases []*model.BrandAlias
+	err := r.db.WithContext(ctx).
+		Where("alias_key IN ? AND brand_id != ?", keys, excludeBrandID).
+		Find(&aliases).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range aliases {
+		result[a.Key] = a.BrandID
+	}
+	return result, nil
+}
+
+// 查询品牌下的商品ID，用于品牌变更后清理缓存
+func (r *brandRepositoryImpl) ListProductIDs(ctx context.Context, brandID int64) ([]int64, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Product{}).
+		Where("brand_id = ?", brandID).
+		Pluck("id", &ids).Error
+	return ids, err
+}
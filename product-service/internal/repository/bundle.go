@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 套装库存的变化
+type BundleStockChange struct {
+	BundleID int64
+	OldStock int32
+	NewStock int32
+}
+
+// 套装组件接口
+type BundleRepository interface {
+	// 按套装ID查询组件：套装ID -> 组件列表
+	ListComponents(ctx context.Context, bundleIDs []int64) (map[int64][]*model.ProductBundleItem, error)
+	// 查询包含某个商品的套装ID
+	FindBundleIDs(ctx context.Context, componentID int64) ([]int64, error)
+	// 整体替换套装组件
+	ReplaceComponents(ctx context.Context, bundleID int64, items []*model.ProductBundleItem) error
+	// 按组件库存重新计算套装库存，只返回有变化的套装
+	RecalculateStocks(ctx context.Context, bundleIDs []int64) ([]*BundleStockChange, error)
+}
+
+type bundleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建套装组件存储实例
+func NewBundleRepository(db *gorm.DB) BundleRepository {
+	return &bundleRepositoryImpl{db: db}
+}
+
+// 查询套装组件
+func (r *bundleRepositoryImpl) ListComponents(ctx context.Context, bundleIDs []int64) (map[int64][]*model.ProductBundleItem, error) {
+	result := make(map[int64][]*model.ProductBundleItem, len(bundleIDs))
+	if len(bundleIDs) == 0 {
+		return result, nil
+	}
+	var items []*model.ProductBundleItem
+	err := r.db.WithContext(ctx).
+		Where("bundle_id IN ?", bundleIDs).
+		Order("bundle_id ASC, component_id ASC").
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		result[item.BundleID] = append(result[item.BundleID], item)
+	}
+	return result, nil
+}
+
+// 查询包含某个商品的套装
+func (r *bundleRepositoryImpl) FindBundleIDs(ctx context.Context, componentID int64) ([]int64, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).
+		Model(&model.ProductBundleItem{}).
+		Where("component_id = ?", componentID).
+		Order("bundle_id ASC").
+		Pluck("bundle_id", &ids).Error
+	return ids, err
+}
+
+// 替换套装组件
+func (r *bundleRepositoryImpl) ReplaceComponents(ctx context.Context, bundleID int64, items []*model.ProductBundleItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceBundleComponents(tx, bundleID, items)
+	})
+}
+
+// 在事务内替换套装组件
+func replaceBundleComponents(tx *gorm.DB, bundleID int64, items []*model.ProductBundleItem) error {
+	if err := tx.Where("bundle_id = ?", bundleID).Delete(&model.ProductBundleItem{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	for _, item := range items {
+		item.BundleID = bundleID
+	}
+	return tx.Create(&items).Error
+}
+
+// 重新计算套装库存：各组件库存除以每套数量取最小值，没有组件或组件已删除时为0
+func (r *bundleRepositoryImpl) RecalculateStocks(ctx context.Context, bundleIDs []int64) ([]*BundleStockChange, error) {
+	var changes []*BundleStockChange
+	for _, bundleID := range bundleIDs {
+		var change *BundleStockChange
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var bundle model.Product
+			err := tx.Select("id, stock").
+				Where("id = ? AND product_type = ?", bundleID, model.ProductTypeBUNDLE).
+				Take(&bundle).Error
+			if err != nil {
+				return err
+			}
+			var rows []struct {
+				Quantity int32
+				Stock    int32
+				Status   model.ProductStatus
+			}
+			err = tx.Table("product_bundle_items AS i").
+				Select("i.quantity AS quantity, p.stock AS stock, p.status AS status").
+				Joins("JOIN products AS p ON p.id = i.component_id").
+				Where("i.bundle_id = ?", bundleID).
+				Scan(&rows).Error
+			if err != nil {
+				return err
+			}
+			var stock int32
+			for i, row := range rows {
+				available := int32(0)
+				if row.Status != model.ProductStatusDELETED && row.Quantity > 0 && row.Stock > 0 {
+					available = row.Stock / row.Quantity
+				}
+				if i == 0 || available < stock {
+					stock = available
+				}
+			}
+			if stock == bundle.Stock {
+				return nil
+			}
+			err = tx.Model(&model.Product{}).
+				Where("id = ?", bundleID).
+				Update("stock", stock).Error
+			if err != nil {
+				return err
+			}
+			change = &BundleStockChange{BundleID: bundleID, OldStock: bundle.Stock, NewStock: stock}
+			return nil
+		})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return changes, err
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// 商品事件接口
+type EventRepository interface {
+	Create(ctx context.Context, event *model.ProductEvent) error
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]*model.ProductEvent, error)
+	LastID(ctx context.Context) (int64, error)
+	DeleteBefore(ctx context.Context, before int64, limit int) (int64, error)
+}
+
+type eventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建事件存储实例
+func NewEventRepository(db *gorm.DB) EventRepository {
+	return &eventRepositoryImpl{db: db}
+}
+
+// 写入事件
+func (r *eventRepositoryImpl) Create(ctx context.Context, event *model.ProductEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// 按游标查询事件
+func (r *eventRepositoryImpl) ListAfter(ctx context.Context, afterID int64, limit int) ([]*model.ProductEvent, error) {
+	var events []*model.ProductEvent
+	err := r.db.WithContext(ctx).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// 最新事件ID
+func (r *eventRepositoryImpl) LastID(ctx context.Context) (int64, error) {
+	var lastID int64
+	err := r.db.WithContext(ctx).
+		Model(&model.ProductEvent{}).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&lastID).Error
+	return lastID, err
+}
+
+// 删除指定时间之前的事件，每次最多删除limit条，返回删除数量
+func (r *eventRepositoryImpl) DeleteBefore(ctx context.Context, before int64, limit int) (int64, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).
+		Model(&model.ProductEvent{}).
+		Where("created_at < ?", before).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	result := r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&model.ProductEvent{})
+	return result.RowsAffected, result.Error
+}
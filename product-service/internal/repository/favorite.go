@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 收藏接口
+type FavoriteRepository interface {
+	// 收藏商品，已收藏时保留原记录并回填，返回是否新增
+	Add(ctx context.Context, favorite *model.Favorite) (bool, error)
+	// 查询用户对商品的收藏，未收藏时返回nil
+	Find(ctx context.Context, userID, productID int64) (*model.Favorite, error)
+	// 取消收藏，返回是否存在
+	Remove(ctx context.Context, userID, productID int64) (bool, error)
+	// 分页查询用户的收藏，按收藏时间倒序；priceDroppedOnly只返回当前价格低于收藏价格且未删除的商品
+	List(ctx context.Context, userID int64, priceDroppedOnly bool, page, pageSize int32) ([]*model.Favorite, int64, error)
+	CountByUser(ctx context.Context, userID int64) (int64, error)
+	CountByProduct(ctx context.Context, productID int64) (int64, error)
+}
+
+type favoriteRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建收藏存储实例
+func NewFavoriteRepository(db *gorm.DB) FavoriteRepository {
+	return &favoriteRepositoryImpl{db: db}
+}
+
+// 收藏商品
+func (r *favoriteRepositoryImpl) Add(ctx context.Context, favorite *model.Favorite) (bool, error) {
+	db := r.db.WithContext(ctx)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(favorite)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	//已收藏时按唯一键读取原记录，保留原来的收藏价格
+	var saved model.Favorite
+	err := db.Where("user_id = ? AND product_id = ?", favorite.UserID, favorite.ProductID).
+		Take(&saved).Error
+	if err != nil {
+		return false, err
+	}
+	*favorite = saved
+	return result.RowsAffected > 0, nil
+}
+
+// 查询单条收藏
+func (r *favoriteRepositoryImpl) Find(ctx context.Context, userID, productID int64) (*model.Favorite, error) {
+	var favorite model.Favorite
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND product_id = ?", userID, productID).
+		Take(&favorite).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &favorite, nil
+}
+
+// 取消收藏
+func (r *favoriteRepositoryImpl) Remove(ctx context.Context, userID, productID int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND product_id = ?", userID, productID).
+		Delete(&model.Favorite{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 查询用户的收藏
+func (r *favoriteRepositoryImpl) List(ctx context.Context, userID int64, priceDroppedOnly bool,
+	page, pageSize int32) ([]*model.Favorite, int64, error) {
+	query := r.db.WithContext(ctx).
+		Table("favorites AS f").
+		Where("f.user_id = ?", userID)
+	if priceDroppedOnly {
+		query = query.Joins("JOIN products AS p ON p.id = f.product_id").
+			Where("p.price < f.saved_price AND p.status <> ?", model.ProductStatusDELETED)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var favorites []*model.Favorite
+	err := query.
+		Select("f.*").
+		Order("f.created_at DESC, f.id DESC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&favorites).Error
+	return favorites, total, err
+}
+
+// 统计用户的收藏数量
+func (r *favoriteRepositoryImpl) CountByUser(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Favorite{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// 统计商品的收藏人数
+func (r *favoriteRepositoryImpl) CountByProduct(ctx context.Context, productID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Favorite{}).
+		Where("product_id = ?", productID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// 批量导入的单条写入内容
+type ProductImportItem struct {
+	Product     *model.Product
+	Created     bool                 //是否为新建商品
+	Update      *ProductUpdate       //更新已有商品时的条件更新内容
+	Movement    *model.StockMovement //库存有变化时的流水
+	PriceChange *model.PriceHistory  //价格有变化时的历史
+}
+
+// 按外部编码批量查找商品
+func (r *productRepositoryImpl) FindByExternalCodes(ctx context.Context, codes []string) (map[string]*model.Product, error) {
+	result := make(map[string]*model.Product)
+	if len(codes) == 0 {
+		return result, nil
+	}
+	var products []*model.Product
+	err := r.db.WithContext(ctx).
+		Where("external_code IN ?", codes).
+		Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		if p.ExternalCode != nil {
+			result[*p.ExternalCode] = p
+		}
+	}
+	return result, nil
+}
+
+// 在同一事务中写入一批导入数据，任一条失败整批回滚
+func (r *productRepositoryImpl) ImportBatch(ctx context.Context, items []*ProductImportItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			if item.Created {
+				if err := tx.Create(item.Product).Error; err != nil {
+					return err
+				}
+			} else if err := applyProductUpdate(tx, item.Update); err != nil {
+				return err
+			}
+			if item.Movement != nil {
+				item.Movement.ProductID = item.Product.ID
+				item.Movement.Balance = item.Product.Stock
+				if err := tx.Create(item.Movement).Error; err != nil {
+					return err
+				}
+			}
+			if item.PriceChange != nil {
+				item.PriceChange.ProductID = item.Product.ID
+				if err := tx.Create(item.PriceChange).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 商家接口
+type MerchantRepository interface {
+	Create(ctx context.Context, merchant *model.Merchant) error
+	Update(ctx context.Context, merchant *model.Merchant) error
+	FindByID(ctx context.Context, id int64) (*model.Merchant, error)
+	FindByName(ctx context.Context, name string) (*model.Merchant, error)
+	List(ctx context.Context, keyword *string, status *model.MerchantStatus, page, pageSize int32) ([]*model.Merchant, int64, error)
+}
+
+type merchantRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建商家存储实例
+func NewMerchantRepository(db *gorm.DB) MerchantRepository {
+	return &merchantRepositoryImpl{db: db}
+}
+
+// 创建商家
+func (r *merchantRepositoryImpl) Create(ctx context.Context, merchant *model.Merchant) error {
+	return r.db.WithContext(ctx).Create(merchant).Error
+}
+
+// 更新商家
+func (r *merchantRepositoryImpl) Update(ctx context.Context, merchant *model.Merchant) error {
+	return r.db.WithContext(ctx).Save(merchant).Error
+}
+
+// 根据ID查询商家，不存在时返回nil
+func (r *merchantRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.Merchant, error) {
+	var merchant model.Merchant
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&merchant).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &merchant, nil
+}
+
+// 根据名称查询商家，不存在时返回nil
+func (r *merchantRepositoryImpl) FindByName(ctx context.Context, name string) (*model.Merchant, error) {
+	var merchants []*model.Merchant
+	err := r.db.WithContext(ctx).Where("name = ?", name).Limit(1).Find(&merchants).Error
+	if err != nil || len(merchants) == 0 {
+		return nil, err
+	}
+	return merchants[0], nil
+}
+
+// 分页查询商家
+func (r *merchantRepositoryImpl) List(ctx context.Context, keyword *string, status *model.MerchantStatus,
+	page, pageSize int32) ([]*model.Merchant, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.Merchant{})
+	if keyword != nil && *keyword != "" {
+		query = query.Where("name LIKE ?", "%"+*keyword+"%")
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var merchants []*model.Merchant
+	err := query.
+		Order("id ASC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&merchants).Error
+	return merchants, total, err
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 热门商品排序方式
+const (
+	PopularitySortByScore = "popularity"
+	PopularitySortByViews = "views"
+	PopularitySortBySales = "sales"
+)
+
+// 热度分数中一件销量相当于的浏览次数
+const PopularitySalesWeight = 10
+
+// 时间窗口内的商品热度汇总
+type ProductPopularity struct {
+	ProductID  int64   `gorm:"column:product_id"`
+	ViewCount  int64   `gorm:"column:view_count"`
+	SalesCount int64   `gorm:"column:sales_count"`
+	Score      float64 `gorm:"column:score"`
+}
+
+// 商品热度接口
+type PopularityRepository interface {
+	// 按小时桶累加计数
+	IncrementBuckets(ctx context.Context, buckets []*model.ProductPopularityBucket) error
+	PurgeBuckets(ctx context.Context, beforeHour int64) error
+	// 汇总时间窗口内可见商品的热度，limit<=0时不限制数量
+	TopProducts(ctx context.Context, sinceHour int64, category *string, sortBy string, limit int) ([]*ProductPopularity, error)
+	// 整体更新商品的热度字段，未出现的商品置0
+	UpdateProductPopularity(ctx context.Context, scores map[int64]float64) error
+}
+
+type popularityRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建商品热度存储实例
+func NewPopularityRepository(db *gorm.DB) PopularityRepository {
+	return &popularityRepositoryImpl{db: db}
+}
+
+// 累加计数，同一商品同一小时的桶已存在时叠加
+func (r *popularityRepositoryImpl) IncrementBuckets(ctx context.Context, buckets []*model.ProductPopularityBucket) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, bucket := range buckets {
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "product_id"}, {Name: "bucket_hour"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"view_count":  gorm.Expr("view_count + ?", bucket.ViewCount),
+					"sales_count": gorm.Expr("sales_count + ?", bucket.SalesCount),
+				}),
+			}).Create(bucket).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// 删除过期的计数桶
+func (r *popularityRepositoryImpl) PurgeBuckets(ctx context.Context, beforeHour int64) error {
+	return r.db.WithContext(ctx).
+		Where("bucket_hour < ?", beforeHour).
+		Delete(&model.ProductPopularityBucket{}).Error
+}
+
+// 汇总热度，只统计在售和售罄的商品
+func (r *popularityRepositoryImpl) TopProducts(ctx context.Context, sinceHour int64, category *string,
+	sortBy string, limit int) ([]*ProductPopularity, error) {
+	query := r.db.WithContext(ctx).
+		Table("product_popularity_buckets AS b").
+		Select("b.product_id AS product_id, SUM(b.view_count) AS view_count, SUM(b.sales_count) AS sales_count, "+
+			fmt.Sprintf("SUM(b.view_count) + %d * SUM(b.sales_count) AS score", PopularitySalesWeight)).
+		Joins("JOIN products AS p ON p.id = b.product_id").
+		Where("b.bucket_hour >= ?", sinceHour).
+		Where("p.status IN ?", []model.ProductStatus{model.ProductStatusONLINE, model.ProductStatusSOLDOUT})
+	if category != nil && *category != "" {
+		query = query.Where("p.category = ?", *category)
+	}
+	query = query.Group("b.product_id")
+	switch sortBy {
+	case PopularitySortByViews:
+		query = query.Order("view_count DESC")
+	case PopularitySortBySales:
+		query = query.Order("sales_count DESC")
+	default:
+		query = query.Order("score DESC")
+	}
+	query = query.Order("product_id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var result []*ProductPopularity
+	err := query.Scan(&result).Error
+	return result, err
+}
+
+// 更新热度字段，只改热度不影响商品版本和更新时间
+func (r *popularityRepositoryImpl) UpdateProductPopularity(ctx context.Context, scores map[int64]float64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Product{}).
+			Where("popularity <> 0").
+			UpdateColumn("popularity", 0).Error
+		if err != nil {
+			return err
+		}
+		for id, score := range scores {
+			err := tx.Model(&model.Product{}).
+				Where("id = ?", id).
+				UpdateColumn("popularity", score).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
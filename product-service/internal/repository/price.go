@@ -0,0 +1,277 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+	"math"
+
+	"gorm.io/gorm"
+)
+
+// 调价计划与价格历史接口
+type PriceRepository interface {
+	CreateSchedule(ctx context.Context, schedule *model.PriceSchedule) error
+	FindScheduleByID(ctx context.Context, id int64) (*model.PriceSchedule, error)
+	HasOverlap(ctx context.Context, productID, startAt, endAt int64) (bool, error)
+	ListSchedules(ctx context.Context, productID *int64, status *string, page, pageSize int32) ([]*model.PriceSchedule, int64, error)
+	FindDueActivations(ctx context.Context, now int64, limit int) ([]*model.PriceSchedule, error)
+	FindDueReverts(ctx context.Context, now int64, limit int) ([]*model.PriceSchedule, error)
+
+	ActivateSchedule(ctx context.Context, id, now int64) (bool, error)
+	RevertSchedule(ctx context.Context, id, now int64, status, operator string) (bool, error)
+	CancelPending(ctx context.Context, id, now int64) (bool, error)
+
+	ListHistory(ctx context.Context, productID int64, page, pageSize int32) ([]*model.PriceHistory, int64, error)
+}
+
+type priceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建价格存储实例
+func NewPriceRepository(db *gorm.DB) PriceRepository {
+	return &priceRepositoryImpl{db: db}
+}
+
+// 创建调价计划
+func (r *priceRepositoryImpl) CreateSchedule(ctx context.Context, schedule *model.PriceSchedule) error {
+	return r.db.WithContext(ctx).Create(schedule).Error
+}
+
+// 根据ID查找调价计划
+func (r *priceRepositoryImpl) FindScheduleByID(ctx context.Context, id int64) (*model.PriceSchedule, error) {
+	var schedule model.PriceSchedule
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// 检查同一商品是否存在时间重叠的未结束计划，endAt为0表示不结束
+func (r *priceRepositoryImpl) HasOverlap(ctx context.Context, productID, startAt, endAt int64) (bool, error) {
+	if endAt <= 0 {
+		endAt = math.MaxInt64
+	}
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.PriceSchedule{}).
+		Where("product_id = ? AND status IN ?", productID,
+			[]string{model.PriceScheduleStatusPending, model.PriceScheduleStatusActive}).
+		Where("start_at < ? AND (end_at = 0 OR end_at > ?)", endAt, startAt).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// 分页查询调价计划
+func (r *priceRepositoryImpl) ListSchedules(ctx context.Context, productID *int64, status *string,
+	page, pageSize int32) ([]*model.PriceSchedule, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.PriceSchedule{})
+	if productID != nil && *productID > 0 {
+		query = query.Where("product_id = ?", *productID)
+	}
+	if status != nil && *status != "" {
+		query = query.Where("status = ?", *status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var schedules []*model.PriceSchedule
+	offset := (page - 1) * pageSize
+	err := query.Offset(int(offset)).
+		Limit(int(pageSize)).
+		Order("start_at DESC, id DESC").
+		Find(&schedules).Error
+	return schedules, total, err
+}
+
+// 到期待生效的计划
+func (r *priceRepositoryImpl) FindDueActivations(ctx context.Context, now int64, limit int) ([]*model.PriceSchedule, error) {
+	var schedules []*model.PriceSchedule
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND start_at <= ?", model.PriceScheduleStatusPending, now).
+		Order("start_at ASC").
+		Limit(limit).
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// 到期需恢复原价的计划
+func (r *priceRepositoryImpl) FindDueReverts(ctx context.Context, now int64, limit int) ([]*model.PriceSchedule, error) {
+	var schedules []*model.PriceSchedule
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND end_at > 0 AND end_at <= ?", model.PriceScheduleStatusActive, now).
+		Order("end_at ASC").
+		Limit(limit).
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// 使计划生效：抢占状态后修改商品价格并记录历史，返回是否由本次调用执行
+func (r *priceRepositoryImpl) ActivateSchedule(ctx context.Context, id, now int64) (bool, error) {
+	applied := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var schedule model.PriceSchedule
+		if err := tx.Where("id = ?", id).First(&schedule).Error; err != nil {
+			return err
+		}
+		if schedule.Status != model.PriceScheduleStatusPending {
+			return nil
+		}
+
+		// 错过了整个生效窗口，直接结束
+		if schedule.EndAt > 0 && schedule.EndAt <= now {
+			return tx.Model(&model.PriceSchedule{}).
+				Where("id = ? AND status = ?", id, model.PriceScheduleStatusPending).
+				Updates(map[string]interface{}{
+					"status":     model.PriceScheduleStatusCompleted,
+					"updated_at": now,
+				}).Error
+		}
+
+		var product model.Product
+		if err := tx.Where("id = ?", schedule.ProductID).First(&product).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.PriceSchedule{}).
+			Where("id = ? AND status = ?", id, model.PriceScheduleStatusPending).
+			Updates(map[string]interface{}{
+				"status":         model.PriceScheduleStatusActive,
+				"original_price": product.Price,
+				"updated_at":     now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		productUpdates := map[string]interface{}{
+			"price":      schedule.Price,
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": now,
+		}
+		// 不自动恢复的计划视为永久调价，不保留原价
+		if schedule.EndAt > 0 {
+			productUpdates["original_price"] = product.Price
+		} else {
+			productUpdates["original_price"] = 0
+		}
+		if err := tx.Model(&model.Product{}).
+			Where("id = ?", product.ID).
+			Updates(productUpdates).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&model.PriceHistory{
+			ProductID:  product.ID,
+			OldPrice:   product.Price,
+			NewPrice:   schedule.Price,
+			Source:     model.PriceSourceSchedule,
+			ScheduleID: schedule.ID,
+			Operator:   schedule.Operator,
+			CreatedAt:  now,
+		}).Error; err != nil {
+			return err
+		}
+		applied = true
+		return nil
+	})
+	return applied, err
+}
+
+// 结束生效中的计划并恢复原价；价格已被人工改动时保留人工价格
+func (r *priceRepositoryImpl) RevertSchedule(ctx context.Context, id, now int64, status, operator string) (bool, error) {
+	applied := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var schedule model.PriceSchedule
+		if err := tx.Where("id = ?", id).First(&schedule).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&model.PriceSchedule{}).
+			Where("id = ? AND status = ?", id, model.PriceScheduleStatusActive).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"updated_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		applied = true
+
+		var product model.Product
+		if err := tx.Where("id = ?", schedule.ProductID).First(&product).Error; err != nil {
+			return err
+		}
+		if product.Price != schedule.Price {
+			return tx.Model(&model.Product{}).
+				Where("id = ?", product.ID).
+				Updates(map[string]interface{}{
+					"original_price": 0,
+					"version":        gorm.Expr("version + 1"),
+				}).Error
+		}
+		if err := tx.Model(&model.Product{}).
+			Where("id = ?", product.ID).
+			Updates(map[string]interface{}{
+				"price":          schedule.OriginalPrice,
+				"original_price": 0,
+				"version":        gorm.Expr("version + 1"),
+				"updated_at":     now,
+			}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.PriceHistory{
+			ProductID:  product.ID,
+			OldPrice:   product.Price,
+			NewPrice:   schedule.OriginalPrice,
+			Source:     model.PriceSourceRevert,
+			ScheduleID: schedule.ID,
+			Operator:   operator,
+			CreatedAt:  now,
+		}).Error
+	})
+	return applied, err
+}
+
+// 取消未生效的计划
+func (r *priceRepositoryImpl) CancelPending(ctx context.Context, id, now int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.PriceSchedule{}).
+		Where("id = ? AND status = ?", id, model.PriceScheduleStatusPending).
+		Updates(map[string]interface{}{
+			"status":     model.PriceScheduleStatusCancelled,
+			"updated_at": now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 分页查询价格历史
+func (r *priceRepositoryImpl) ListHistory(ctx context.Context, productID int64,
+	page, pageSize int32) ([]*model.PriceHistory, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&model.PriceHistory{}).
+		Where("product_id = ?", productID)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var history []*model.PriceHistory
+	offset := (page - 1) * pageSize
+	err := query.Offset(int(offset)).
+		Limit(int(pageSize)).
+		Order("id DESC").
+		Find(&history).Error
+	return history, total, err
+}
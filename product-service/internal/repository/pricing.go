@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 阶梯价和价格组接口
+type PricingRepository interface {
+	// 整体替换商品的阶梯价，tiers为空时清除
+	ReplaceTiers(ctx context.Context, productID int64, tiers []*model.ProductPriceTier) error
+	// 查询商品的阶梯价，按价格组和起购数量排序
+	FindTiers(ctx context.Context, productID int64) ([]*model.ProductPriceTier, error)
+	// 批量查询多个商品的阶梯价，按商品ID分组
+	FindTiersByProductIDs(ctx context.Context, productIDs []int64) (map[int64][]*model.ProductPriceTier, error)
+	// 设置用户的价格组，group为空时移出价格组
+	SetUserGroup(ctx context.Context, userID int64, group string, updatedAt int64) error
+	// 查询用户的价格组，不在任何价格组时返回空字符串
+	FindUserGroup(ctx context.Context, userID int64) (string, error)
+	// 分页查询价格组成员，group为空时查询全部
+	ListUserGroups(ctx context.Context, group string, page, pageSize int32) ([]*model.UserPriceGroup, int64, error)
+}
+
+type pricingRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建阶梯价存储实例
+func NewPricingRepository(db *gorm.DB) PricingRepository {
+	return &pricingRepositoryImpl{db: db}
+}
+
+// 替换阶梯价
+func (r *pricingRepositoryImpl) ReplaceTiers(ctx context.Context, productID int64, tiers []*model.ProductPriceTier) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&model.ProductPriceTier{}).Error; err != nil {
+			return err
+		}
+		if len(tiers) == 0 {
+			return nil
+		}
+		for _, tier := range tiers {
+			tier.ID = 0
+			tier.ProductID = productID
+		}
+		return tx.Create(&tiers).Error
+	})
+}
+
+// 查询阶梯价
+func (r *pricingRepositoryImpl) FindTiers(ctx context.Context, productID int64) ([]*model.ProductPriceTier, error) {
+	var tiers []*model.ProductPriceTier
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("user_group ASC, min_quantity ASC").
+		Find(&tiers).Error
+	return tiers, err
+}
+
+// 批量查询阶梯价
+func (r *pricingRepositoryImpl) FindTiersByProductIDs(ctx context.Context, productIDs []int64) (map[int64][]*model.ProductPriceTier, error) {
+	result := make(map[int64][]*model.ProductPriceTier)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+	var tiers []*model.ProductPriceTier
+	err := r.db.WithContext(ctx).
+		Where("product_id IN ?", productIDs).
+		Order("product_id ASC, user_group ASC, min_quantity ASC").
+		Find(&tiers).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, tier := range tiers {
+		result[tier.ProductID] = append(result[tier.ProductID], tier)
+	}
+	return result, nil
+}
+
+// 设置价格组
+func (r *pricingRepositoryImpl) SetUserGroup(ctx context.Context, userID int64, group string, updatedAt int64) error {
+	db := r.db.WithContext(ctx)
+	if group == "" {
+		return db.Where("user_id = ?", userID).Delete(&model.UserPriceGroup{}).Error
+	}
+	member := &model.UserPriceGroup{UserID: userID, Group: group, UpdatedAt: updatedAt}
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"price_group": group,
+			"updated_at":  updatedAt,
+		}),
+	}).Create(member).Error
+}
+
+// 查询价格组
+func (r *pricingRepositoryImpl) FindUserGroup(ctx context.Context, userID int64) (string, error) {
+	var member model.UserPriceGroup
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Take(&member).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return member.Group, nil
+}
+
+// 查询价格组成员
+func (r *pricingRepositoryImpl) ListUserGroups(ctx context.Context, group string, page, pageSize int32) ([]*model.UserPriceGroup, int64, error) {
+	var members []*model.UserPriceGroup
+	var total int64
+	query := r.db.WithContext(ctx).Model(&model.UserPriceGroup{})
+	if group != "" {
+		query = query.Where("price_group = ?", group)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("updated_at DESC, user_id ASC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&members).Error
+	return members, total, err
+}
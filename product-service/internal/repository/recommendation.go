@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 推荐数据接口
+type RecommendationRepository interface {
+	// 购买统计，按批次号覆盖写入
+	SaveCoPurchases(ctx context.Context, pairs []*model.ProductCoPurchase) error
+	SaveSalesStats(ctx context.Context, stats []*model.ProductSalesStat) error
+	PurgeStaleStats(ctx context.Context, generation int64) error
+	ListCoPurchases(ctx context.Context) ([]*model.ProductCoPurchase, error)
+	ListSalesStats(ctx context.Context) ([]*model.ProductSalesStat, error)
+
+	// 参与推荐计算的在售商品
+	ListOnlineProducts(ctx context.Context) ([]*model.Product, error)
+
+	// 推荐结果
+	ReplaceRecommendations(ctx context.Context, recType string, recs []*model.ProductRecommendation) error
+	ListRecommendations(ctx context.Context, productID int64, recType string) ([]*model.ProductRecommendation, error)
+}
+
+type recommendationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建推荐数据存储实例
+func NewRecommendationRepository(db *gorm.DB) RecommendationRepository {
+	return &recommendationRepositoryImpl{db: db}
+}
+
+// 写入共同购买统计，已存在的商品对覆盖
+func (r *recommendationRepositoryImpl) SaveCoPurchases(ctx context.Context, pairs []*model.ProductCoPurchase) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		CreateInBatches(pairs, 500).Error
+}
+
+// 写入销量统计，已存在的商品覆盖
+func (r *recommendationRepositoryImpl) SaveSalesStats(ctx context.Context, stats []*model.ProductSalesStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		CreateInBatches(stats, 500).Error
+}
+
+// 删除旧批次的统计，本次同步未出现的商品不再保留
+func (r *recommendationRepositoryImpl) PurgeStaleStats(ctx context.Context, generation int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("generation < ?", generation).Delete(&model.ProductCoPurchase{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("generation < ?", generation).Delete(&model.ProductSalesStat{}).Error
+	})
+}
+
+// 查询全部共同购买统计
+func (r *recommendationRepositoryImpl) ListCoPurchases(ctx context.Context) ([]*model.ProductCoPurchase, error) {
+	var pairs []*model.ProductCoPurchase
+	err := r.db.WithContext(ctx).Find(&pairs).Error
+	return pairs, err
+}
+
+// 查询全部销量统计
+func (r *recommendationRepositoryImpl) ListSalesStats(ctx context.Context) ([]*model.ProductSalesStat, error) {
+	var stats []*model.ProductSalesStat
+	err := r.db.WithContext(ctx).Find(&stats).Error
+	return stats, err
+}
+
+// 查询在售商品，只取推荐计算需要的字段
+func (r *recommendationRepositoryImpl) ListOnlineProducts(ctx context.Context) ([]*model.Product, error) {
+	var products []*model.Product
+	err := r.db.WithContext(ctx).
+		Select("id", "category", "brand_id", "rating_avg", "rating_count").
+		Where("status = ?", model.ProductStatusONLINE).
+		Find(&products).Error
+	return products, err
+}
+
+// 整体替换某类推荐结果
+func (r *recommendationRepositoryImpl) ReplaceRecommendations(ctx context.Context, recType string, recs []*model.ProductRecommendation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("type = ?", recType).Delete(&model.ProductRecommendation{}).Error; err != nil {
+			return err
+		}
+		if len(recs) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(recs, 500).Error
+	})
+}
+
+// 查询商品的推荐结果，按位置排序
+func (r *recommendationRepositoryImpl) ListRecommendations(ctx context.Context, productID int64, recType string) ([]*model.ProductRecommendation, error) {
+	var recs []*model.ProductRecommendation
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND type = ?", productID, recType).
+		Order("position ASC").
+		Find(&recs).Error
+	return recs, err
+}
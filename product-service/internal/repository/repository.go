@@ -4,16 +4,44 @@ import (
 	"context"
 	"ecommerce/product-service/internal/model"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+// 库存相关错误
+var (
+	ErrProductNotFound   = errors.New("商品不存在")
+	ErrStockNotEnough    = errors.New("库存不足")
+	ErrVersionConflict   = errors.New("商品已被修改")
+	ErrWarehouseNotFound = errors.New("仓库不存在")
+)
+
+// 搜索排序方式
+const (
+	SortByNewest      = "newest"
+	SortByRating      = "rating"
+	SortByRatingCount = "rating_count"
+	SortByPriceAsc    = "price_asc"
+	SortByPriceDesc   = "price_desc"
+	SortByPopularity  = "popularity"
+)
+
+// 品牌分面统计
+type BrandFacet struct {
+	BrandID int64
+	Count   int64
+}
+
 // 货物接口
 type ProductRepository interface {
 	//基础CRUD
-	Create(ctx context.Context, product *model.Product) error
+	Create(ctx context.Context, product *model.Product, operator string, attributes []*model.ProductAttributeValue,
+		bundleItems []*model.ProductBundleItem) error
 	FindByID(ctx context.Context, id int64) (*model.Product, error)
-	Update(ctx context.Context, product *model.Product) error
+	FindByIDs(ctx context.Context, ids []int64) ([]*model.Product, error)
+	Update(ctx context.Context, update *ProductUpdate, movement *model.StockMovement, priceChange *model.PriceHistory,
+		attributes []*model.ProductAttributeValue) error
 	Delete(ctx context.Context, id int64) error
 
 	//状态
@@ -26,18 +54,53 @@ type ProductRepository interface {
 		category *string,
 		minPrice, maxPrice *float64,
 		keyword *string,
+		brandIDs []int64,
+		attributes []AttributeCondition,
+		sortBy string,
 		page, pageSize int32) ([]*model.Product, int64, error)
 
 	SearchForAdmin(ctx context.Context,
 		id *int64,
+		merchantID *int64,
 		category *string,
 		minPrice, maxPrice *float64,
 		keyword *string,
+		brandIDs []int64,
+		attributes []AttributeCondition,
+		sortBy string,
 		page, pageSize int32) ([]*model.Product, int64, error)
 
+	//品牌分面，统计时不带品牌筛选条件
+	BrandFacetsForUser(ctx context.Context,
+		category *string,
+		minPrice, maxPrice *float64,
+		keyword *string,
+		attributes []AttributeCondition) ([]*BrandFacet, error)
+
+	BrandFacetsForAdmin(ctx context.Context,
+		id *int64,
+		merchantID *int64,
+		category *string,
+		minPrice, maxPrice *float64,
+		keyword *string,
+		attributes []AttributeCondition) ([]*BrandFacet, error)
+
 	//库存管理
-	UpdateStock(ctx context.Context, id int64, delta int32) (bool, error)
+	AdjustStock(ctx context.Context, movement *model.StockMovement) (bool, error)
 	CheckStock(ctx context.Context, id int64, quantity int32) (bool, error)
+	StockSnapshot(ctx context.Context, id *int64) (map[int64]int32, error)
+
+	//售罄处理
+	MarkSoldOut(ctx context.Context, id int64, status model.ProductStatus) (bool, error)
+	Relist(ctx context.Context, id int64) (bool, error)
+	FindLowStock(ctx context.Context, page, pageSize int32) ([]*model.Product, int64, error)
+
+	//评分
+	UpdateRating(ctx context.Context, id int64, ratingAvg float64, ratingCount int32) error
+
+	//批量导入
+	FindByExternalCodes(ctx context.Context, codes []string) (map[string]*model.Product, error)
+	ImportBatch(ctx context.Context, items []*ProductImportItem) error
 }
 
 type productRepositoryImpl struct {
@@ -49,9 +112,35 @@ func NewProductRepository(db *gorm.DB) ProductRepository {
 	return &productRepositoryImpl{db: db}
 }
 
-// 创建商品
-func (r *productRepositoryImpl) Create(ctx context.Context, product *model.Product) error {
-	return r.db.WithContext(ctx).Create(product).Error
+// 创建商品，初始库存同时记入库存流水，属性值和套装组件在同一事务内写入
+func (r *productRepositoryImpl) Create(ctx context.Context, product *model.Product, operator string,
+	attributes []*model.ProductAttributeValue, bundleItems []*model.ProductBundleItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(product).Error; err != nil {
+			return err
+		}
+		if len(attributes) > 0 {
+			if err := replaceAttributeValues(tx, product.ID, attributes); err != nil {
+				return err
+			}
+		}
+		if len(bundleItems) > 0 {
+			if err := replaceBundleComponents(tx, product.ID, bundleItems); err != nil {
+				return err
+			}
+		}
+		if product.Stock == 0 {
+			return nil
+		}
+		return tx.Create(&model.StockMovement{
+			ProductID: product.ID,
+			Delta:     product.Stock,
+			Balance:   product.Stock,
+			Reason:    model.StockChangeInitial,
+			Operator:  operator,
+			CreatedAt: product.CreatedAt,
+		}).Error
+	})
 }
 
 // 根据ID查找商品
@@ -69,9 +158,78 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.
 	return &product, nil
 }
 
-// 更新商品
-func (r *productRepositoryImpl) Update(ctx context.Context, product *model.Product) error {
-	return r.db.WithContext(ctx).Save(product).Error
+// 根据ID批量查找商品
+func (r *productRepositoryImpl) FindByIDs(ctx context.Context, ids []int64) ([]*model.Product, error) {
+	var products []*model.Product
+	if len(ids) == 0 {
+		return products, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Find(&products).Error
+	return products, err
+}
+
+// 商品字段级更新
+type ProductUpdate struct {
+	ID      int64
+	Version int64                  //期望的版本号
+	Fields  map[string]interface{} //只更新这些列
+	// 附加条件：列名到读取时的值。库存扣减不递增版本号，
+	// 更新库存时需确认其未被并发修改
+	Expected map[string]interface{}
+}
+
+// 更新商品，版本号或附加条件不满足时返回ErrVersionConflict
+// 库存或价格有变化时同一事务内写入流水和价格历史，attributes非nil时整体替换属性值
+func (r *productRepositoryImpl) Update(ctx context.Context, update *ProductUpdate,
+	movement *model.StockMovement, priceChange *model.PriceHistory, attributes []*model.ProductAttributeValue) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := applyProductUpdate(tx, update); err != nil {
+			return err
+		}
+		if movement != nil {
+			movement.ProductID = update.ID
+			if err := tx.Create(movement).Error; err != nil {
+				return err
+			}
+		}
+		if priceChange != nil {
+			priceChange.ProductID = update.ID
+			if err := tx.Create(priceChange).Error; err != nil {
+				return err
+			}
+		}
+		if attributes != nil {
+			if err := replaceAttributeValues(tx, update.ID, attributes); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// 条件更新并递增版本号
+func applyProductUpdate(tx *gorm.DB, update *ProductUpdate) error {
+	fields := make(map[string]interface{}, len(update.Fields)+1)
+	for column, value := range update.Fields {
+		fields[column] = value
+	}
+	fields["version"] = gorm.Expr("version + 1")
+
+	query := tx.Model(&model.Product{}).
+		Where("id = ? AND version = ?", update.ID, update.Version)
+	for column, value := range update.Expected {
+		query = query.Where(column+" = ?", value)
+	}
+	result := query.Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
 }
 
 // 删除商品
@@ -79,15 +237,22 @@ func (r *productRepositoryImpl) Delete(ctx context.Context, id int64) error {
 	return r.db.WithContext(ctx).
 		Model(&model.Product{}).
 		Where("id = ?", id).
-		Update("status", model.ProductStatusDELETED).Error
+		Updates(map[string]interface{}{
+			"status":  model.ProductStatusDELETED,
+			"version": gorm.Expr("version + 1"),
+		}).Error
 }
 
-// 更新商品状态
+// 更新商品状态，人工改状态后不再自动恢复上架
 func (r *productRepositoryImpl) UpdateStatus(ctx context.Context, id int64, status model.ProductStatus) error {
 	return r.db.WithContext(ctx).
 		Model(&model.Product{}).
 		Where("id = ?", id).
-		Update("status", status).Error
+		Updates(map[string]interface{}{
+			"status":        status,
+			"auto_delisted": false,
+			"version":       gorm.Expr("version + 1"),
+		}).Error
 }
 
 // 上架商品
@@ -102,21 +267,11 @@ func (r *productRepositoryImpl) Offline(ctx context.Context, id int64) error {
 
 // 用户搜索商品
 func (r *productRepositoryImpl) SearchForUser(ctx context.Context, category *string,
-	minPrice, maxPrice *float64, keyword *string,
-	page, pageSize int32) ([]*model.Product, int64, error) {
-	query := r.db.WithContext(ctx).Model(&model.Product{}).
-		Where("status = ?", model.ProductStatusONLINE)
-	if category != nil && *category != "" {
-		query = query.Where("category = ?", *category)
-	}
-	if minPrice != nil {
-		query = query.Where("price >= ?", *minPrice)
-	}
-	if maxPrice != nil {
-		query = query.Where("price <= ?", *maxPrice)
-	}
-	if keyword != nil && *keyword != "" {
-		query = query.Where("name Like ?", "%"+*keyword+"%")
+	minPrice, maxPrice *float64, keyword *string, brandIDs []int64, attributes []AttributeCondition,
+	sortBy string, page, pageSize int32) ([]*model.Product, int64, error) {
+	query := r.userSearchQuery(ctx, category, minPrice, maxPrice, keyword, attributes)
+	if len(brandIDs) > 0 {
+		query = query.Where("brand_id IN ?", brandIDs)
 	}
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
@@ -126,20 +281,70 @@ func (r *productRepositoryImpl) SearchForUser(ctx context.Context, category *str
 	offset := (page - 1) * pageSize
 	err := query.Offset(int(offset)).
 		Limit(int(pageSize)).
-		Order("created_at DESC").
+		Order(searchOrder(sortBy)).
 		Find(&products).Error
 	return products, total, err
 }
 
 // 管理员搜素商品
-func (r *productRepositoryImpl) SearchForAdmin(ctx context.Context, id *int64,
+func (r *productRepositoryImpl) SearchForAdmin(ctx context.Context, id, merchantID *int64,
 	category *string, minPrice, maxPrice *float64,
-	keyword *string, page, pageSize int32) ([]*model.Product, int64, error) {
+	keyword *string, brandIDs []int64, attributes []AttributeCondition, sortBy string,
+	page, pageSize int32) ([]*model.Product, int64, error) {
+	query := r.adminSearchQuery(ctx, id, merchantID, category, minPrice, maxPrice, keyword, attributes)
+	if len(brandIDs) > 0 {
+		query = query.Where("brand_id IN ?", brandIDs)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var products []*model.Product
+	offset := (page - 1) * pageSize
+	err := query.Offset(int(offset)).
+		Limit(int(pageSize)).
+		Order(searchOrder(sortBy)).
+		Find(&products).Error
+	return products, total, err
+}
+
+// 用户搜索的品牌分面
+func (r *productRepositoryImpl) BrandFacetsForUser(ctx context.Context, category *string,
+	minPrice, maxPrice *float64, keyword *string, attributes []AttributeCondition) ([]*BrandFacet, error) {
+	return countByBrand(r.userSearchQuery(ctx, category, minPrice, maxPrice, keyword, attributes))
+}
+
+// 管理员搜索的品牌分面
+func (r *productRepositoryImpl) BrandFacetsForAdmin(ctx context.Context, id, merchantID *int64, category *string,
+	minPrice, maxPrice *float64, keyword *string, attributes []AttributeCondition) ([]*BrandFacet, error) {
+	return countByBrand(r.adminSearchQuery(ctx, id, merchantID, category, minPrice, maxPrice, keyword, attributes))
+}
+
+// 用户可见商品的公共筛选条件
+func (r *productRepositoryImpl) userSearchQuery(ctx context.Context, category *string,
+	minPrice, maxPrice *float64, keyword *string, attributes []AttributeCondition) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&model.Product{}).
+		Where("status IN ?", []model.ProductStatus{model.ProductStatusONLINE, model.ProductStatusSOLDOUT})
+	query = applySearchFilters(query, category, minPrice, maxPrice, keyword)
+	return applyAttributeConditions(r.db.WithContext(ctx), query, attributes)
+}
+
+// 管理员搜索的公共筛选条件
+func (r *productRepositoryImpl) adminSearchQuery(ctx context.Context, id, merchantID *int64, category *string,
+	minPrice, maxPrice *float64, keyword *string, attributes []AttributeCondition) *gorm.DB {
 	query := r.db.WithContext(ctx).Model(&model.Product{}).
 		Where("status != ?", model.ProductStatusDELETED)
 	if id != nil && *id > 0 {
 		query = query.Where("id = ?", *id)
 	}
+	if merchantID != nil {
+		query = query.Where("merchant_id = ?", *merchantID)
+	}
+	query = applySearchFilters(query, category, minPrice, maxPrice, keyword)
+	return applyAttributeConditions(r.db.WithContext(ctx), query, attributes)
+}
+
+func applySearchFilters(query *gorm.DB, category *string, minPrice, maxPrice *float64, keyword *string) *gorm.DB {
 	if category != nil && *category != "" {
 		query = query.Where("category = ?", *category)
 	}
@@ -152,44 +357,160 @@ func (r *productRepositoryImpl) SearchForAdmin(ctx context.Context, id *int64,
 	if keyword != nil && *keyword != "" {
 		query = query.Where("name Like ?", "%"+*keyword+"%")
 	}
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	return query
+}
+
+// 按品牌分组计数，未关联品牌的商品不参与分面
+func countByBrand(query *gorm.DB) ([]*BrandFacet, error) {
+	var facets []*BrandFacet
+	err := query.
+		Select("brand_id, COUNT(*) AS count").
+		Where("brand_id > 0").
+		Group("brand_id").
+		Order("count DESC, brand_id ASC").
+		Scan(&facets).Error
+	return facets, err
+}
+
+// 搜索排序，未识别的排序方式按最新排序
+func searchOrder(sortBy string) string {
+	switch sortBy {
+	case SortByRating:
+		return "rating_avg DESC, rating_count DESC, id DESC"
+	case SortByRatingCount:
+		return "rating_count DESC, rating_avg DESC, id DESC"
+	case SortByPriceAsc:
+		return "price ASC, id DESC"
+	case SortByPriceDesc:
+		return "price DESC, id DESC"
+	case SortByPopularity:
+		return "popularity DESC, id DESC"
+	default:
+		return "created_at DESC"
 	}
-	var products []*model.Product
-	offset := (page - 1) * pageSize
-	err := query.Offset(int(offset)).
-		Limit(int(pageSize)).
-		Order("created_at DESC").
-		Find(&products).Error
-	return products, total, err
 }
 
-// 更新库存
-func (r *productRepositoryImpl) UpdateStock(ctx context.Context,
-	id int64, delta int32) (bool, error) {
-	if delta < 0 {
-		var currentStock int32
-		err := r.db.WithContext(ctx).
-			Model(&model.Product{}).
-			Select("stock").
-			Where("id = ?", id).
-			Scan(&currentStock).Error
+// 更新商品评分汇总
+func (r *productRepositoryImpl) UpdateRating(ctx context.Context, id int64, ratingAvg float64, ratingCount int32) error {
+	return r.db.WithContext(ctx).
+		Model(&model.Product{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"rating_avg":   ratingAvg,
+			"rating_count": ratingCount,
+			"version":      gorm.Expr("version + 1"),
+		}).Error
+}
 
-		if err != nil {
-			return false, err
+// 调整库存并记录流水，带单号的变更按商品+原因+单号幂等，重复请求返回true
+func (r *productRepositoryImpl) AdjustStock(ctx context.Context, movement *model.StockMovement) (bool, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 先写入流水占用单号，同一单号的并发请求由唯一索引拦截，不会重复扣减库存
+		if movement.CreatedAt == 0 {
+			movement.CreatedAt = time.Now().Unix()
+		}
+		if err := tx.Create(movement).Error; err != nil {
+			return err
 		}
 
-		if currentStock+delta < 0 {
-			return false, errors.New("库存不足")
+		result := tx.Model(&model.Product{}).
+			Where("id = ? AND stock + ? >= 0", movement.ProductID, movement.Delta).
+			Update("stock", gorm.Expr("stock + ?", movement.Delta))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&model.Product{}).
+				Where("id = ?", movement.ProductID).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return ErrProductNotFound
+			}
+			return ErrStockNotEnough
 		}
+
+		if movement.WarehouseID > 0 {
+			if err := adjustWarehouseStock(tx, movement.WarehouseID, movement.ProductID, movement.Delta); err != nil {
+				return err
+			}
+		}
+
+		var balance int32
+		if err := tx.Model(&model.Product{}).
+			Select("stock").
+			Where("id = ?", movement.ProductID).
+			Scan(&balance).Error; err != nil {
+			return err
+		}
+		movement.Balance = balance
+		return tx.Model(movement).Update("balance", balance).Error
+	})
+	if err == nil || movement.ReferenceID == nil || !isDuplicatedKey(r.db, err) {
+		return false, err
 	}
-	result := r.db.WithContext(ctx).
-		Model(&model.Product{}).
-		Where("id = ? AND stock + ? >= 0", id, delta).
-		Update("stock", gorm.Expr("stock + ?", delta))
 
-	return result.RowsAffected > 0, result.Error
+	var existing model.StockMovement
+	err = r.db.WithContext(ctx).
+		Where("product_id = ? AND reason = ? AND reference_id = ?",
+			movement.ProductID, movement.Reason, *movement.ReferenceID).
+		First(&existing).Error
+	if err != nil {
+		return false, err
+	}
+	*movement = existing
+	return true, nil
+}
+
+// 是否为唯一索引冲突，驱动错误由方言转换为 gorm.ErrDuplicatedKey
+func isDuplicatedKey(db *gorm.DB, err error) bool {
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
+// 调整仓库库存，仓库中尚无该商品时入库会新建记录
+func adjustWarehouseStock(tx *gorm.DB, warehouseID, productID int64, delta int32) error {
+	now := time.Now().Unix()
+	result := tx.Model(&model.WarehouseStock{}).
+		Where("warehouse_id = ? AND product_id = ? AND stock + ? >= 0", warehouseID, productID, delta).
+		Updates(map[string]interface{}{
+			"stock":      gorm.Expr("stock + ?", delta),
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&model.WarehouseStock{}).
+		Where("warehouse_id = ? AND product_id = ?", warehouseID, productID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 || delta < 0 {
+		return ErrStockNotEnough
+	}
+	if err := tx.Model(&model.Warehouse{}).
+		Where("id = ?", warehouseID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrWarehouseNotFound
+	}
+	return tx.Create(&model.WarehouseStock{
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+		Stock:       delta,
+		UpdatedAt:   now,
+	}).Error
 }
 
 // 检查库存
@@ -208,3 +529,72 @@ func (r *productRepositoryImpl) CheckStock(ctx context.Context,
 
 	return stock >= quantity, nil
 }
+
+// 商品库存快照
+func (r *productRepositoryImpl) StockSnapshot(ctx context.Context, id *int64) (map[int64]int32, error) {
+	var rows []struct {
+		ID    int64
+		Stock int32
+	}
+	//套装库存由组件推算，没有库存流水，不参与对账
+	query := r.db.WithContext(ctx).Model(&model.Product{}).
+		Select("id, stock").
+		Where("product_type = ?", model.ProductTypeNORMAL)
+	if id != nil && *id > 0 {
+		query = query.Where("id = ?", *id)
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	stocks := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		stocks[row.ID] = row.Stock
+	}
+	return stocks, nil
+}
+
+// 库存为0时按策略标记售罄或下架，仅对在售商品生效
+func (r *productRepositoryImpl) MarkSoldOut(ctx context.Context, id int64, status model.ProductStatus) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Product{}).
+		Where("id = ? AND status = ? AND stock <= 0", id, model.ProductStatusONLINE).
+		Updates(map[string]interface{}{
+			"status":        status,
+			"auto_delisted": true,
+			"version":       gorm.Expr("version + 1"),
+			"updated_at":    time.Now().Unix(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 补货后恢复被系统自动售罄/下架的商品
+func (r *productRepositoryImpl) Relist(ctx context.Context, id int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Product{}).
+		Where("id = ? AND auto_delisted = ? AND stock > 0", id, true).
+		Updates(map[string]interface{}{
+			"status":        model.ProductStatusONLINE,
+			"auto_delisted": false,
+			"version":       gorm.Expr("version + 1"),
+			"updated_at":    time.Now().Unix(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 查询库存不高于阈值的商品
+func (r *productRepositoryImpl) FindLowStock(ctx context.Context, page, pageSize int32) ([]*model.Product, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.Product{}).
+		Where("status != ?", model.ProductStatusDELETED).
+		Where("low_stock_threshold > 0 AND stock <= low_stock_threshold")
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var products []*model.Product
+	offset := (page - 1) * pageSize
+	err := query.Offset(int(offset)).
+		Limit(int(pageSize)).
+		Order("stock ASC, id ASC").
+		Find(&products).Error
+	return products, total, err
+}
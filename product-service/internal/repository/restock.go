@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 到货通知订阅接口
+type RestockRepository interface {
+	// 订阅到货通知，已有订阅时重新置为等待通知，保存后回填
+	Subscribe(ctx context.Context, subscription *model.RestockSubscription) error
+	// 取消订阅，返回是否存在
+	Unsubscribe(ctx context.Context, userID, productID int64) (bool, error)
+	// 分页查询用户的订阅，按订阅时间倒序
+	ListByUser(ctx context.Context, userID int64, page, pageSize int32) ([]*model.RestockSubscription, int64, error)
+	CountByUser(ctx context.Context, userID int64) (int64, error)
+	// 查询商品等待通知的订阅，按订阅时间先后
+	ListPending(ctx context.Context, productID int64, limit int) ([]*model.RestockSubscription, error)
+	// 查询有等待通知的订阅、当前有货且在售的商品
+	FindRestockedProductIDs(ctx context.Context, limit int) ([]int64, error)
+	// 将等待通知的订阅标记为已通知，返回是否由本次标记，避免重复通知
+	MarkNotified(ctx context.Context, id, notifiedAt int64) (bool, error)
+	// 通知发送失败时恢复为等待通知
+	ResetPending(ctx context.Context, id, notifiedAt int64) error
+	// 统计用户某个时间之后收到的通知数
+	CountNotifiedSince(ctx context.Context, userID, since int64) (int64, error)
+}
+
+type restockRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建到货通知订阅存储实例
+func NewRestockRepository(db *gorm.DB) RestockRepository {
+	return &restockRepositoryImpl{db: db}
+}
+
+// 订阅到货通知
+func (r *restockRepositoryImpl) Subscribe(ctx context.Context, subscription *model.RestockSubscription) error {
+	db := r.db.WithContext(ctx)
+	err := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "product_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":     model.RestockSubscriptionPENDING,
+			"created_at": subscription.CreatedAt,
+		}),
+	}).Create(subscription).Error
+	if err != nil {
+		return err
+	}
+	//冲突更新时自增ID不可靠，按唯一键重新读取
+	var saved model.RestockSubscription
+	err = db.Where("user_id = ? AND product_id = ?", subscription.UserID, subscription.ProductID).
+		Take(&saved).Error
+	if err != nil {
+		return err
+	}
+	*subscription = saved
+	return nil
+}
+
+// 取消订阅
+func (r *restockRepositoryImpl) Unsubscribe(ctx context.Context, userID, productID int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND product_id = ?", userID, productID).
+		Delete(&model.RestockSubscription{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 查询用户的订阅
+func (r *restockRepositoryImpl) ListByUser(ctx context.Context, userID int64, page, pageSize int32) ([]*model.RestockSubscription, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&model.RestockSubscription{}).
+		Where("user_id = ?", userID)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var subscriptions []*model.RestockSubscription
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&subscriptions).Error
+	return subscriptions, total, err
+}
+
+// 统计用户的订阅数量
+func (r *restockRepositoryImpl) CountByUser(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.RestockSubscription{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// 查询商品等待通知的订阅
+func (r *restockRepositoryImpl) ListPending(ctx context.Context, productID int64, limit int) ([]*model.RestockSubscription, error) {
+	var subscriptions []*model.RestockSubscription
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND status = ?", productID, model.RestockSubscriptionPENDING).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// 查询有货待通知的商品
+func (r *restockRepositoryImpl) FindRestockedProductIDs(ctx context.Context, limit int) ([]int64, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).
+		Table("restock_subscriptions AS s").
+		Joins("JOIN products AS p ON p.id = s.product_id").
+		Where("s.status = ? AND p.stock > 0 AND p.status IN ?", model.RestockSubscriptionPENDING,
+			[]model.ProductStatus{model.ProductStatusONLINE, model.ProductStatusSOLDOUT}).
+		Distinct("s.product_id").
+		Order("s.product_id ASC").
+		Limit(limit).
+		Pluck("s.product_id", &ids).Error
+	return ids, err
+}
+
+// 标记已通知
+func (r *restockRepositoryImpl) MarkNotified(ctx context.Context, id, notifiedAt int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.RestockSubscription{}).
+		Where("id = ? AND status = ?", id, model.RestockSubscriptionPENDING).
+		Updates(map[string]interface{}{
+			"status":      model.RestockSubscriptionNOTIFIED,
+			"notified_at": notifiedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 恢复为等待通知
+func (r *restockRepositoryImpl) ResetPending(ctx context.Context, id, notifiedAt int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.RestockSubscription{}).
+		Where("id = ? AND status = ?", id, model.RestockSubscriptionNOTIFIED).
+		Updates(map[string]interface{}{
+			"status":      model.RestockSubscriptionPENDING,
+			"notified_at": notifiedAt,
+		}).Error
+}
+
+// 统计用户最近收到的通知数，重新订阅不清除通知时间，仍计入限流
+func (r *restockRepositoryImpl) CountNotifiedSince(ctx context.Context, userID, since int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.RestockSubscription{}).
+		Where("user_id = ? AND notified_at >= ?", userID, since).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 定时上下架计划接口
+type StatusScheduleRepository interface {
+	Create(ctx context.Context, schedule *model.ProductStatusSchedule) error
+	FindByID(ctx context.Context, id int64) (*model.ProductStatusSchedule, error)
+	List(ctx context.Context, productID *int64, status *string, page, pageSize int32) ([]*model.ProductStatusSchedule, int64, error)
+	FindDue(ctx context.Context, now int64, limit int) ([]*model.ProductStatusSchedule, error)
+
+	Execute(ctx context.Context, id, now int64) (bool, error)
+	CancelPending(ctx context.Context, id, now int64) (bool, error)
+}
+
+type statusScheduleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建定时上下架存储实例
+func NewStatusScheduleRepository(db *gorm.DB) StatusScheduleRepository {
+	return &statusScheduleRepositoryImpl{db: db}
+}
+
+// 创建计划
+func (r *statusScheduleRepositoryImpl) Create(ctx context.Context, schedule *model.ProductStatusSchedule) error {
+	return r.db.WithContext(ctx).Create(schedule).Error
+}
+
+// 根据ID查找计划
+func (r *statusScheduleRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.ProductStatusSchedule, error) {
+	var schedule model.ProductStatusSchedule
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// 分页查询计划
+func (r *statusScheduleRepositoryImpl) List(ctx context.Context, productID *int64, status *string,
+	page, pageSize int32) ([]*model.ProductStatusSchedule, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.ProductStatusSchedule{})
+	if productID != nil && *productID > 0 {
+		query = query.Where("product_id = ?", *productID)
+	}
+	if status != nil && *status != "" {
+		query = query.Where("status = ?", *status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var schedules []*model.ProductStatusSchedule
+	offset := (page - 1) * pageSize
+	err := query.Offset(int(offset)).
+		Limit(int(pageSize)).
+		Order("execute_at DESC, id DESC").
+		Find(&schedules).Error
+	return schedules, total, err
+}
+
+// 到期待执行的计划，同一商品按执行时间先后处理
+func (r *statusScheduleRepositoryImpl) FindDue(ctx context.Context, now int64, limit int) ([]*model.ProductStatusSchedule, error) {
+	var schedules []*model.ProductStatusSchedule
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND execute_at <= ?", model.StatusScheduleStatusPending, now).
+		Order("execute_at ASC, id ASC").
+		Limit(limit).
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// 执行计划：在同一事务中抢占计划并修改商品状态，多副本并发时只有一个能成功，返回是否由本次调用执行
+func (r *statusScheduleRepositoryImpl) Execute(ctx context.Context, id, now int64) (bool, error) {
+	applied := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var schedule model.ProductStatusSchedule
+		if err := tx.Where("id = ?", id).First(&schedule).Error; err != nil {
+			return err
+		}
+		if schedule.Status != model.StatusScheduleStatusPending {
+			return nil
+		}
+
+		var product model.Product
+		err := tx.Where("id = ?", schedule.ProductID).First(&product).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		status, message := model.StatusScheduleStatusDone, ""
+		if err != nil || product.Status == model.ProductStatusDELETED {
+			status, message = model.StatusScheduleStatusFailed, "商品不存在或已删除"
+		} else if schedule.TargetStatus == model.ProductStatusONLINE {
+			// 创建计划后商品可能被驳回或商家被停用，执行时按上架条件重新校验
+			message, err = checkOnlineAllowed(tx, &product)
+			if err != nil {
+				return err
+			}
+			if message != "" {
+				status = model.StatusScheduleStatusFailed
+			}
+		}
+
+		result := tx.Model(&model.ProductStatusSchedule{}).
+			Where("id = ? AND status = ?", id, model.StatusScheduleStatusPending).
+			Updates(map[string]interface{}{
+				"status":      status,
+				"message":     message,
+				"executed_at": now,
+				"updated_at":  now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 || status != model.StatusScheduleStatusDone {
+			return nil
+		}
+
+		if err := tx.Model(&model.Product{}).
+			Where("id = ?", product.ID).
+			Updates(map[string]interface{}{
+				"status":        schedule.TargetStatus,
+				"auto_delisted": false,
+				"version":       gorm.Expr("version + 1"),
+			}).Error; err != nil {
+			return err
+		}
+		applied = true
+		return nil
+	})
+	return applied, err
+}
+
+// 上架前校验商品已审核通过且所属商家正常经营，不满足时返回原因
+func checkOnlineAllowed(tx *gorm.DB, product *model.Product) (string, error) {
+	if product.ApprovalStatus != model.ApprovalStatusAPPROVED {
+		return "商品未通过审核，不能上架", nil
+	}
+	if product.MerchantID <= 0 {
+		return "", nil
+	}
+	var merchant model.Merchant
+	err := tx.Where("id = ?", product.MerchantID).First(&merchant).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "商家不存在", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if merchant.Status != model.MerchantStatusACTIVE {
+		return "商家已停用", nil
+	}
+	return "", nil
+}
+
+// 取消未执行的计划
+func (r *statusScheduleRepositoryImpl) CancelPending(ctx context.Context, id, now int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.ProductStatusSchedule{}).
+		Where("id = ? AND status = ?", id, model.StatusScheduleStatusPending).
+		Updates(map[string]interface{}{
+			"status":     model.StatusScheduleStatusCancelled,
+			"updated_at": now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
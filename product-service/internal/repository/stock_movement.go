@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// 库存流水查询条件
+type StockMovementFilter struct {
+	ProductID   *int64
+	Reason      *model.StockChangeReason
+	ReferenceID *string
+	StartTime   *int64
+	EndTime     *int64
+}
+
+// 库存流水接口
+type StockMovementRepository interface {
+	List(ctx context.Context, filter StockMovementFilter, page, pageSize int32) ([]*model.StockMovement, int64, error)
+	LedgerBalances(ctx context.Context, productID *int64) (map[int64]int32, error)
+}
+
+type stockMovementRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建库存流水存储实例
+func NewStockMovementRepository(db *gorm.DB) StockMovementRepository {
+	return &stockMovementRepositoryImpl{db: db}
+}
+
+// 分页查询库存流水
+func (r *stockMovementRepositoryImpl) List(ctx context.Context, filter StockMovementFilter,
+	page, pageSize int32) ([]*model.StockMovement, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.StockMovement{})
+	if filter.ProductID != nil && *filter.ProductID > 0 {
+		query = query.Where("product_id = ?", *filter.ProductID)
+	}
+	if filter.Reason != nil {
+		query = query.Where("reason = ?", *filter.Reason)
+	}
+	if filter.ReferenceID != nil && *filter.ReferenceID != "" {
+		query = query.Where("reference_id = ?", *filter.ReferenceID)
+	}
+	if filter.StartTime != nil && *filter.StartTime > 0 {
+		query = query.Where("created_at >= ?", *filter.StartTime)
+	}
+	if filter.EndTime != nil && *filter.EndTime > 0 {
+		query = query.Where("created_at <= ?", *filter.EndTime)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var movements []*model.StockMovement
+	offset := (page - 1) * pageSize
+	err := query.Offset(int(offset)).
+		Limit(int(pageSize)).
+		Order("id DESC").
+		Find(&movements).Error
+	return movements, total, err
+}
+
+// 按流水重算各商品库存
+func (r *stockMovementRepositoryImpl) LedgerBalances(ctx context.Context, productID *int64) (map[int64]int32, error) {
+	var rows []struct {
+		ProductID int64
+		Total     int32
+	}
+	query := r.db.WithContext(ctx).
+		Model(&model.StockMovement{}).
+		Select("product_id, SUM(delta) AS total").
+		Group("product_id")
+	if productID != nil && *productID > 0 {
+		query = query.Where("product_id = ?", *productID)
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	balances := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		balances[row.ProductID] = row.Total
+	}
+	return balances, nil
+}
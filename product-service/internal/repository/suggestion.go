@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 搜索词排序方式
+const (
+	QuerySortByCount      = "count"
+	QuerySortByZeroResult = "zero_result"
+	QuerySortByRecent     = "recent"
+)
+
+// 搜索联想接口
+type SuggestionRepository interface {
+	// 联想词索引，整体重建
+	ListSearchableProducts(ctx context.Context) ([]*model.Product, error)
+	ReplaceSuggestions(ctx context.Context, suggestions []*model.SearchSuggestion) error
+	// 按匹配键或拼音首字母前缀查询，按热度从高到低
+	FindByPrefix(ctx context.Context, prefix string, limit int) ([]*model.SearchSuggestion, error)
+
+	// 搜索词统计
+	RecordQuery(ctx context.Context, query string, resultCount int32, searchedAt int64) error
+	ListQueries(ctx context.Context, zeroResultOnly bool, sortBy string, page, pageSize int32) ([]*model.SearchQueryStat, int64, error)
+	FindQueryCounts(ctx context.Context, queries []string) (map[string]int64, error)
+}
+
+type suggestionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建搜索联想存储实例
+func NewSuggestionRepository(db *gorm.DB) SuggestionRepository {
+	return &suggestionRepositoryImpl{db: db}
+}
+
+// 查询用户可搜到的商品，只取联想词需要的字段
+func (r *suggestionRepositoryImpl) ListSearchableProducts(ctx context.Context) ([]*model.Product, error) {
+	var products []*model.Product
+	err := r.db.WithContext(ctx).
+		Select("id", "name", "category", "brand", "brand_id", "rating_count").
+		Where("status IN ?", []model.ProductStatus{model.ProductStatusONLINE, model.ProductStatusSOLDOUT}).
+		Find(&products).Error
+	return products, err
+}
+
+// 整体替换联想词
+func (r *suggestionRepositoryImpl) ReplaceSuggestions(ctx context.Context, suggestions []*model.SearchSuggestion) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&model.SearchSuggestion{}).Error; err != nil {
+			return err
+		}
+		if len(suggestions) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(suggestions, 500).Error
+	})
+}
+
+// 前缀查询联想词
+func (r *suggestionRepositoryImpl) FindByPrefix(ctx context.Context, prefix string, limit int) ([]*model.SearchSuggestion, error) {
+	var suggestions []*model.SearchSuggestion
+	err := r.db.WithContext(ctx).
+		Where("match_key LIKE ? OR initials LIKE ?", prefix+"%", prefix+"%").
+		Order("weight DESC").
+		Order("id ASC").
+		Limit(limit).
+		Find(&suggestions).Error
+	return suggestions, err
+}
+
+// 累计一次搜索，搜索词不存在时新建
+func (r *suggestionRepositoryImpl) RecordQuery(ctx context.Context, query string, resultCount int32, searchedAt int64) error {
+	var zeroResult int64
+	if resultCount == 0 {
+		zeroResult = 1
+	}
+	stat := &model.SearchQueryStat{
+		Query:           query,
+		SearchCount:     1,
+		ZeroResultCount: zeroResult,
+		LastResultCount: resultCount,
+		LastSearchedAt:  searchedAt,
+		CreatedAt:       searchedAt,
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "query"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"search_count":      gorm.Expr("search_count + 1"),
+				"zero_result_count": gorm.Expr("zero_result_count + ?", zeroResult),
+				"last_result_count": resultCount,
+				"last_searched_at":  searchedAt,
+			}),
+		}).
+		Create(stat).Error
+}
+
+// 分页查询搜索词统计
+func (r *suggestionRepositoryImpl) ListQueries(ctx context.Context, zeroResultOnly bool, sortBy string,
+	page, pageSize int32) ([]*model.SearchQueryStat, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.SearchQueryStat{})
+	if zeroResultOnly {
+		query = query.Where("zero_result_count > 0")
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	switch sortBy {
+	case QuerySortByZeroResult:
+		query = query.Order("zero_result_count DESC")
+	case QuerySortByRecent:
+		query = query.Order("last_searched_at DESC")
+	default:
+		query = query.Order("search_count DESC")
+	}
+	var stats []*model.SearchQueryStat
+	err := query.
+		Order("id ASC").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&stats).Error
+	return stats, total, err
+}
+
+// 查询搜索词的累计搜索次数，不存在的搜索词不返回
+func (r *suggestionRepositoryImpl) FindQueryCounts(ctx context.Context, queries []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(queries))
+	if len(queries) == 0 {
+		return counts, nil
+	}
+	var stats []*model.SearchQueryStat
+	err := r.db.WithContext(ctx).
+		Select("query", "search_count").
+		Where("query IN ?", queries).
+		Find(&stats).Error
+	for _, stat := range stats {
+		counts[stat.Query] = stat.SearchCount
+	}
+	return counts, err
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 多语言翻译接口
+type TranslationRepository interface {
+	// 商品翻译，同一商品同一语言只保留一条
+	UpsertProductTranslation(ctx context.Context, translation *model.ProductTranslation) error
+	DeleteProductTranslation(ctx context.Context, productID int64, locale string) (bool, error)
+	ListProductTranslations(ctx context.Context, productID int64) ([]*model.ProductTranslation, error)
+	// 按语言查询一批商品的翻译：商品ID -> 语言 -> 翻译
+	FindProductTranslations(ctx context.Context, productIDs []int64, locales []string) (map[int64]map[string]*model.ProductTranslation, error)
+
+	// 分类翻译
+	UpsertCategoryTranslation(ctx context.Context, translation *model.CategoryTranslation) error
+	DeleteCategoryTranslation(ctx context.Context, category, locale string) (bool, error)
+	ListCategoryTranslations(ctx context.Context, category, locale *string) ([]*model.CategoryTranslation, error)
+	// 按语言查询一批分类的翻译：分类 -> 语言 -> 翻译
+	FindCategoryTranslations(ctx context.Context, categories []string, locales []string) (map[string]map[string]*model.CategoryTranslation, error)
+}
+
+type translationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建多语言翻译存储实例
+func NewTranslationRepository(db *gorm.DB) TranslationRepository {
+	return &translationRepositoryImpl{db: db}
+}
+
+// 新增或覆盖商品翻译，保存后回填ID和创建时间
+func (r *translationRepositoryImpl) UpsertProductTranslation(ctx context.Context, translation *model.ProductTranslation) error {
+	db := r.db.WithContext(ctx)
+	err := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "product_id"}, {Name: "locale"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"name":        translation.Name,
+			"description": translation.Description,
+			"updated_at":  translation.UpdatedAt,
+		}),
+	}).Create(translation).Error
+	if err != nil {
+		return err
+	}
+	//冲突更新时自增ID不可靠，按唯一键重新读取
+	var saved model.ProductTranslation
+	err = db.Where("product_id = ? AND locale = ?", translation.ProductID, translation.Locale).
+		Take(&saved).Error
+	if err != nil {
+		return err
+	}
+	*translation = saved
+	return nil
+}
+
+// 删除商品翻译，返回是否存在
+func (r *translationRepositoryImpl) DeleteProductTranslation(ctx context.Context, productID int64, locale string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("product_id = ? AND locale = ?", productID, locale).
+		Delete(&model.ProductTranslation{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 查询商品的全部翻译
+func (r *translationRepositoryImpl) ListProductTranslations(ctx context.Context, productID int64) ([]*model.ProductTranslation, error) {
+	var translations []*model.ProductTranslation
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("locale ASC").
+		Find(&translations).Error
+	return translations, err
+}
+
+// 批量查询商品翻译
+func (r *translationRepositoryImpl) FindProductTranslations(ctx context.Context, productIDs []int64,
+	locales []string) (map[int64]map[string]*model.ProductTranslation, error) {
+	result := make(map[int64]map[string]*model.ProductTranslation)
+	if len(productIDs) == 0 || len(locales) == 0 {
+		return result, nil
+	}
+	var translations []*model.ProductTranslation
+	err := r.db.WithContext(ctx).
+		Where("product_id IN ? AND locale IN ?", productIDs, locales).
+		Find(&translations).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range translations {
+		if result[t.ProductID] == nil {
+			result[t.ProductID] = make(map[string]*model.ProductTranslation)
+		}
+		result[t.ProductID][t.Locale] = t
+	}
+	return result, nil
+}
+
+// 新增或覆盖分类翻译，保存后回填ID和创建时间
+func (r *translationRepositoryImpl) UpsertCategoryTranslation(ctx context.Context, translation *model.CategoryTranslation) error {
+	db := r.db.WithContext(ctx)
+	err := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "category"}, {Name: "locale"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"label":      translation.Label,
+			"updated_at": translation.UpdatedAt,
+		}),
+	}).Create(translation).Error
+	if err != nil {
+		return err
+	}
+	var saved model.CategoryTranslation
+	err = db.Where("category = ? AND locale = ?", translation.Category, translation.Locale).
+		Take(&saved).Error
+	if err != nil {
+		return err
+	}
+	*translation = saved
+	return nil
+}
+
+// 删除分类翻译，返回是否存在
+func (r *translationRepositoryImpl) DeleteCategoryTranslation(ctx context.Context, category, locale string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("category = ? AND locale = ?", category, locale).
+		Delete(&model.CategoryTranslation{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 查询分类翻译，可按分类和语言筛选
+func (r *translationRepositoryImpl) ListCategoryTranslations(ctx context.Context, category, locale *string) ([]*model.CategoryTranslation, error) {
+	query := r.db.WithContext(ctx).Model(&model.CategoryTranslation{})
+	if category != nil && *category != "" {
+		query = query.Where("category = ?", *category)
+	}
+	if locale != nil && *locale != "" {
+		query = query.Where("locale = ?", *locale)
+	}
+	var translations []*model.CategoryTranslation
+	err := query.Order("category ASC, locale ASC").Find(&translations).Error
+	return translations, err
+}
+
+// 批量查询分类翻译
+func (r *translationRepositoryImpl) FindCategoryTranslations(ctx context.Context, categories []string,
+	locales []string) (map[string]map[string]*model.CategoryTranslation, error) {
+	result := make(map[string]map[string]*model.CategoryTranslation)
+	if len(categories) == 0 || len(locales) == 0 {
+		return result, nil
+	}
+	var translations []*model.CategoryTranslation
+	err := r.db.WithContext(ctx).
+		Where("category IN ? AND locale IN ?", categories, locales).
+		Find(&translations).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range translations {
+		if result[t.Category] == nil {
+			result[t.Category] = make(map[string]*model.CategoryTranslation)
+		}
+		result[t.Category][t.Locale] = t
+	}
+	return result, nil
+}
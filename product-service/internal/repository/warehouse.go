@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 仓库接口
+type WarehouseRepository interface {
+	Create(ctx context.Context, warehouse *model.Warehouse) error
+	Update(ctx context.Context, warehouse *model.Warehouse) error
+	FindByID(ctx context.Context, id int64) (*model.Warehouse, error)
+	FindByCode(ctx context.Context, code string) (*model.Warehouse, error)
+	List(ctx context.Context, activeOnly bool) ([]*model.Warehouse, error)
+	ListStocks(ctx context.Context, productIDs []int64, warehouseID *int64) ([]*model.WarehouseStock, error)
+}
+
+type warehouseRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 创建仓库存储实例
+func NewWarehouseRepository(db *gorm.DB) WarehouseRepository {
+	return &warehouseRepositoryImpl{db: db}
+}
+
+// 创建仓库
+func (r *warehouseRepositoryImpl) Create(ctx context.Context, warehouse *model.Warehouse) error {
+	return r.db.WithContext(ctx).Create(warehouse).Error
+}
+
+// 更新仓库
+func (r *warehouseRepositoryImpl) Update(ctx context.Context, warehouse *model.Warehouse) error {
+	return r.db.WithContext(ctx).Save(warehouse).Error
+}
+
+// 根据ID查找仓库
+func (r *warehouseRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.Warehouse, error) {
+	var warehouse model.Warehouse
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&warehouse).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &warehouse, nil
+}
+
+// 根据编码查找仓库
+func (r *warehouseRepositoryImpl) FindByCode(ctx context.Context, code string) (*model.Warehouse, error) {
+	var warehouse model.Warehouse
+	err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		First(&warehouse).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &warehouse, nil
+}
+
+// 仓库列表
+func (r *warehouseRepositoryImpl) List(ctx context.Context, activeOnly bool) ([]*model.Warehouse, error) {
+	var warehouses []*model.Warehouse
+	query := r.db.WithContext(ctx).Model(&model.Warehouse{})
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+	err := query.Order("priority ASC, id ASC").Find(&warehouses).Error
+	return warehouses, err
+}
+
+// 查询商品在各仓库的库存
+func (r *warehouseRepositoryImpl) ListStocks(ctx context.Context, productIDs []int64,
+	warehouseID *int64) ([]*model.WarehouseStock, error) {
+	var stocks []*model.WarehouseStock
+	if len(productIDs) == 0 {
+		return stocks, nil
+	}
+	query := r.db.WithContext(ctx).Where("product_id IN ?", productIDs)
+	if warehouseID != nil && *warehouseID > 0 {
+		query = query.Where("warehouse_id = ?", *warehouseID)
+	}
+	err := query.Order("product_id ASC, warehouse_id ASC").Find(&stocks).Error
+	return stocks, err
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// 定时任务
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// 进程内定时调度器，每个任务独立按间隔执行
+type Scheduler struct {
+	mu      sync.Mutex
+	jobs    []Job
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+}
+
+// 创建调度器
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// 注册任务，需在Start之前调用
+func (s *Scheduler) Register(job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job.Interval <= 0 {
+		job.Interval = time.Minute
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// 启动所有任务
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.running = true
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+	log.Printf("💖定时调度器已启动，任务数: %d💖", len(s.jobs))
+}
+
+// 停止所有任务并等待正在执行的任务结束
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.cancel()
+	s.running = false
+	s.mu.Unlock()
+	s.wg.Wait()
+	log.Println("💖定时调度器已停止💖")
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.runOnce(ctx, job)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(ctx, job)
+		}
+	}
+}
+
+// 执行一次任务，panic不影响其他任务
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("😭定时任务%s发生panic: %v😭", job.Name, r)
+		}
+	}()
+	if err := job.Run(ctx); err != nil {
+		log.Printf("😭定时任务%s执行失败: %v😭", job.Name, err)
+	}
+}
@@ -0,0 +1,521 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 审核意见最大长度
+const maxApprovalCommentLength = 500
+
+// 上架和售罄状态对用户可见，需审核通过
+func isListedStatus(status model.ProductStatus) bool {
+	return status == model.ProductStatusONLINE || status == model.ProductStatusSOLDOUT
+}
+
+// 请求中是否有无需审核、直接生效的字段
+func hasDirectUpdateFields(req *api.UpdateProductReq) bool {
+	return req.Price != nil || req.Stock != nil || req.Status != nil ||
+		req.LowStockThreshold != nil || req.SoldOutPolicy != nil
+}
+
+// 提交上架审核
+func (s *productServiceImpl) SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq) (*api.SubmitProductApprovalResp, error) {
+	if req.Remark != nil && utf8.RuneCountInString(*req.Remark) > maxApprovalCommentLength {
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("提交说明不能超过%d个字符", maxApprovalCommentLength)),
+		}, nil
+	}
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	switch product.ApprovalStatus {
+	case model.ApprovalStatusPENDING:
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("商品正在审核中"),
+		}, nil
+	case model.ApprovalStatusAPPROVED:
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("商品已审核通过，修改内容会自动提交审核"),
+		}, nil
+	}
+
+	operator := operatorOrDefault(req.Operator)
+	approval := &model.ProductApproval{
+		ProductID:   product.ID,
+		Kind:        model.ApprovalKindLISTING,
+		Status:      model.ApprovalStatusPENDING,
+		BaseVersion: product.Version,
+		Submitter:   operator,
+		CreatedAt:   time.Now().Unix(),
+	}
+	if req.Remark != nil {
+		approval.Remark = strings.TrimSpace(*req.Remark)
+	}
+	err = s.approvalRepo.SubmitListing(ctx, approval)
+	if errors.Is(err, repository.ErrApprovalConflict) {
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("商品审核状态已变化，请刷新后重试"),
+		}, nil
+	}
+	if err != nil {
+		fmt.Printf("提交上架审核失败: product=%d, err=%v\n", product.ID, err)
+		return &api.SubmitProductApprovalResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("提交审核失败"),
+		}, nil
+	}
+	s.auditReloadedProduct(ctx, model.AuditActionSubmitReview, operator, product,
+		fmt.Sprintf("上架审核#%d", approval.ID))
+	s.invalidateProductCache(ctx, product.ID)
+	return &api.SubmitProductApprovalResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("已提交审核"),
+		Approval: s.convertToAPIApproval(approval),
+	}, nil
+}
+
+// 审核上架申请或内容修改，审核人不能是提交人
+func (s *productServiceImpl) ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq) (*api.ReviewProductApprovalResp, error) {
+	comment := ""
+	if req.Comment != nil {
+		comment = strings.TrimSpace(*req.Comment)
+	}
+	if !req.Approved && comment == "" {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("驳回时必须填写审核意见"),
+		}, nil
+	}
+	if utf8.RuneCountInString(comment) > maxApprovalCommentLength {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("审核意见不能超过%d个字符", maxApprovalCommentLength)),
+		}, nil
+	}
+	approval, err := s.approvalRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询审核记录失败"),
+		}, nil
+	}
+	if approval == nil {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("审核记录不存在"),
+		}, nil
+	}
+	if approval.Status != model.ApprovalStatusPENDING {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("该申请已审核"),
+		}, nil
+	}
+	reviewer := operatorOrDefault(req.Reviewer)
+	if reviewer != defaultStockOperator && reviewer == approval.Submitter {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    403,
+			Message: stringPtr("不能审核自己提交的申请"),
+		}, nil
+	}
+	before, err := s.productRepo.FindByID(ctx, approval.ProductID)
+	if err != nil {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if before == nil {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+
+	approval.Status = model.ApprovalStatusREJECTED
+	action := model.AuditActionReject
+	if req.Approved {
+		approval.Status = model.ApprovalStatusAPPROVED
+		action = model.AuditActionApprove
+	}
+	approval.Reviewer = reviewer
+	approval.Comment = comment
+	approval.ReviewedAt = time.Now().Unix()
+	remark := fmt.Sprintf("上架审核#%d", approval.ID)
+	if approval.Kind == model.ApprovalKindREVISION {
+		remark = fmt.Sprintf("内容修改审核#%d", approval.ID)
+	}
+	if comment != "" {
+		remark += "，意见：" + comment
+	}
+
+	if approval.Kind == model.ApprovalKindLISTING {
+		err = s.approvalRepo.ResolveListing(ctx, approval)
+	} else {
+		err = s.approvalRepo.ResolveRevision(ctx, approval)
+	}
+	if errors.Is(err, repository.ErrApprovalConflict) {
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("该申请已审核"),
+		}, nil
+	}
+	if err != nil {
+		fmt.Printf("保存审核结果失败: approval=%d, err=%v\n", approval.ID, err)
+		return &api.ReviewProductApprovalResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("保存审核结果失败"),
+		}, nil
+	}
+
+	switch {
+	case approval.Kind == model.ApprovalKindLISTING:
+		s.auditReloadedProduct(ctx, action, reviewer, before, remark)
+	case req.Approved:
+		//修改内容以提交人的名义生效，失败时恢复为待审核
+		resp := s.applyRevision(ctx, approval, before, remark)
+		if !resp.Success {
+			if err := s.approvalRepo.Reopen(ctx, approval.ID); err != nil {
+				fmt.Printf("恢复审核状态失败: approval=%d, err=%v\n", approval.ID, err)
+			}
+			return &api.ReviewProductApprovalResp{
+				Success: false,
+				Code:    resp.Code,
+				Message: stringPtr("修改内容无法生效：" + resp.GetMessage()),
+			}, nil
+		}
+		s.recordAudit(ctx, approval.ProductID, action, reviewer, []model.AuditChange{}, remark)
+	default:
+		s.recordAudit(ctx, approval.ProductID, action, reviewer, []model.AuditChange{}, remark)
+	}
+	s.invalidateProductCache(ctx, approval.ProductID)
+
+	resp := &api.ReviewProductApprovalResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("审核完成"),
+		Approval: s.convertToAPIApproval(approval),
+	}
+	if product, err := s.productRepo.FindByID(ctx, approval.ProductID); err == nil && product != nil {
+		resp.Product = s.convertToAPIProduct(product)
+	}
+	return resp, nil
+}
+
+// 查询审核记录
+func (s *productServiceImpl) ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq) (*api.ListProductApprovalsResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	filter := &repository.ApprovalFilter{ProductID: req.ProductId}
+	if req.Status != nil {
+		status := model.ApprovalStatus(*req.Status)
+		filter.Status = &status
+	}
+	if req.Kind != nil {
+		kind := model.ApprovalKind(*req.Kind)
+		filter.Kind = &kind
+	}
+	approvals, total, err := s.approvalRepo.List(ctx, filter, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListProductApprovalsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询审核记录失败"),
+		}, nil
+	}
+	items := make([]*api.ProductApproval, 0, len(approvals))
+	for _, a := range approvals {
+		items = append(items, s.convertToAPIApproval(a))
+	}
+	return &api.ListProductApprovalsResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("查询成功"),
+		Total:     int32(total),
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Approvals: items,
+	}, nil
+}
+
+// 从更新请求中取出有变化的内容字段，取出后请求中只保留直接生效的字段
+func (s *productServiceImpl) extractRevision(ctx context.Context, product *model.Product,
+	req *api.UpdateProductReq) (*model.ProductRevisionFields, error) {
+	fields := &model.ProductRevisionFields{}
+	if req.Name != nil && *req.Name != product.Name {
+		fields.Name = req.Name
+	}
+	if req.Avatar != nil && *req.Avatar != product.Avatar {
+		fields.Avatar = req.Avatar
+	}
+	if req.Category != nil && *req.Category != product.Category {
+		fields.Category = req.Category
+	}
+	if req.BrandId != nil {
+		if *req.BrandId != product.BrandID {
+			fields.BrandID = req.BrandId
+		}
+	} else if req.Brand != nil && strings.TrimSpace(*req.Brand) != product.Brand {
+		fields.Brand = req.Brand
+	}
+	if req.Attributes != nil {
+		current, err := s.retainedAttributes(ctx, product.ID, product.Category)
+		if err != nil {
+			return nil, err
+		}
+		if !maps.Equal(current, req.Attributes) {
+			fields.Attributes = req.Attributes
+		}
+	}
+	req.Name, req.Avatar, req.Category = nil, nil, nil
+	req.Brand, req.BrandId, req.Attributes = nil, nil, nil
+	if fields.Empty() {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// 提交修改前校验，审核通过时会按最新分类再次校验
+func (s *productServiceImpl) checkRevision(ctx context.Context, product *model.Product,
+	fields *model.ProductRevisionFields) (int32, string) {
+	if product.ApprovalStatus == model.ApprovalStatusPENDING {
+		return 409, "商品正在上架审核中，不能修改名称、图片、分类、品牌或属性"
+	}
+	pending, err := s.approvalRepo.FindPendingRevision(ctx, product.ID)
+	if err != nil {
+		return 500, "查询审核记录失败"
+	}
+	if pending != nil {
+		return 409, fmt.Sprintf("商品已有待审核的修改#%d，请等待审核完成", pending.ID)
+	}
+	if fields.Name != nil && strings.TrimSpace(*fields.Name) == "" {
+		return 400, "商品名称不能为空"
+	}
+	if fields.Category != nil || fields.Attributes != nil {
+		category := product.Category
+		if fields.Category != nil {
+			category = *fields.Category
+		}
+		raw := fields.Attributes
+		if raw == nil {
+			raw, err = s.retainedAttributes(ctx, product.ID, category)
+			if err != nil {
+				return 500, "查询商品属性失败"
+			}
+		}
+		_, msg, err := s.resolveProductAttributes(ctx, category, raw)
+		if err != nil {
+			return 500, "查询属性定义失败"
+		}
+		if msg != "" {
+			return 400, msg
+		}
+	}
+	if fields.BrandID != nil {
+		_, msg, err := s.resolveProductBrand(ctx, fields.BrandID, nil)
+		if err != nil {
+			return 500, "查询品牌失败"
+		}
+		if msg != "" {
+			return 400, msg
+		}
+	}
+	if fields.Brand != nil && utf8.RuneCountInString(strings.TrimSpace(*fields.Brand)) > maxBrandNameLength {
+		return 400, fmt.Sprintf("品牌名称不能超过%d个字符", maxBrandNameLength)
+	}
+	return 0, ""
+}
+
+// 保存待审核的内容修改，product为提交时的最新商品
+func (s *productServiceImpl) submitRevision(ctx context.Context, product *model.Product,
+	fields *model.ProductRevisionFields, operator string) (*model.ProductApproval, int32, string) {
+	rawFields, err := json.Marshal(fields)
+	if err != nil {
+		return nil, 500, "序列化修改内容失败"
+	}
+	changes, err := s.revisionChanges(ctx, product, fields)
+	if err != nil {
+		return nil, 500, "查询商品属性失败"
+	}
+	rawChanges, err := json.Marshal(changes)
+	if err != nil {
+		return nil, 500, "序列化修改内容失败"
+	}
+	approval := &model.ProductApproval{
+		ProductID:   product.ID,
+		Kind:        model.ApprovalKindREVISION,
+		Status:      model.ApprovalStatusPENDING,
+		Fields:      string(rawFields),
+		Changes:     string(rawChanges),
+		BaseVersion: product.Version,
+		Submitter:   operator,
+		CreatedAt:   time.Now().Unix(),
+	}
+	err = s.approvalRepo.SubmitRevision(ctx, approval)
+	if errors.Is(err, repository.ErrApprovalConflict) {
+		return nil, 409, "商品已有待审核的修改"
+	}
+	if err != nil {
+		fmt.Printf("保存商品修改审核失败: product=%d, err=%v\n", product.ID, err)
+		return nil, 500, "保存修改内容失败"
+	}
+	s.recordAudit(ctx, product.ID, model.AuditActionSubmitReview, operator, changes,
+		fmt.Sprintf("内容修改审核#%d", approval.ID))
+	return approval, 0, ""
+}
+
+// 待审核修改相对当前商品的字段变化，用于审核时展示
+func (s *productServiceImpl) revisionChanges(ctx context.Context, product *model.Product,
+	fields *model.ProductRevisionFields) ([]model.AuditChange, error) {
+	changes := make([]model.AuditChange, 0, 5)
+	if fields.Name != nil {
+		changes = append(changes, model.AuditChange{Field: "name", Before: product.Name, After: *fields.Name})
+	}
+	if fields.Avatar != nil {
+		changes = append(changes, model.AuditChange{Field: "avatar", Before: product.Avatar, After: *fields.Avatar})
+	}
+	if fields.Category != nil {
+		changes = append(changes, model.AuditChange{Field: "category", Before: product.Category, After: *fields.Category})
+	}
+	switch {
+	case fields.BrandID != nil:
+		after := ""
+		if *fields.BrandID > 0 {
+			after = strconv.FormatInt(*fields.BrandID, 10)
+			if brand, err := s.brandRepo.FindByID(ctx, *fields.BrandID); err == nil && brand != nil {
+				after = brand.Name
+			}
+		}
+		changes = append(changes, model.AuditChange{Field: "brand", Before: product.Brand, After: after})
+	case fields.Brand != nil:
+		changes = append(changes, model.AuditChange{Field: "brand", Before: product.Brand, After: strings.TrimSpace(*fields.Brand)})
+	}
+	if fields.Attributes != nil {
+		current, err := s.retainedAttributes(ctx, product.ID, product.Category)
+		if err != nil {
+			return nil, err
+		}
+		before, _ := json.Marshal(current)
+		after, _ := json.Marshal(fields.Attributes)
+		changes = append(changes, model.AuditChange{Field: "attributes", Before: string(before), After: string(after)})
+	}
+	return changes, nil
+}
+
+// 应用审核通过的内容修改，商品在提交后被编辑过时以最新版本为基础
+func (s *productServiceImpl) applyRevision(ctx context.Context, approval *model.ProductApproval,
+	product *model.Product, remark string) *api.UpdateProductResp {
+	var fields model.ProductRevisionFields
+	if err := json.Unmarshal([]byte(approval.Fields), &fields); err != nil {
+		fmt.Printf("解析修改内容失败: approval=%d, err=%v\n", approval.ID, err)
+		return &api.UpdateProductResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("解析修改内容失败"),
+		}
+	}
+	if product.Status == model.ProductStatusDELETED {
+		return &api.UpdateProductResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品已删除"),
+		}
+	}
+	version := product.Version
+	req := &api.UpdateProductReq{
+		Id:         product.ID,
+		Name:       fields.Name,
+		Avatar:     fields.Avatar,
+		Category:   fields.Category,
+		Brand:      fields.Brand,
+		BrandId:    fields.BrandID,
+		Attributes: fields.Attributes,
+		Version:    &version,
+		Operator:   &approval.Submitter,
+	}
+	return s.applyProductUpdate(ctx, product, req, remark)
+}
+
+// 模型转化
+func (s *productServiceImpl) convertToAPIApproval(a *model.ProductApproval) *api.ProductApproval {
+	var changes []model.AuditChange
+	if a.Changes != "" {
+		if err := json.Unmarshal([]byte(a.Changes), &changes); err != nil {
+			fmt.Printf("解析审核变更失败: approval=%d, err=%v\n", a.ID, err)
+		}
+	}
+	item := &api.ProductApproval{
+		Id:         a.ID,
+		ProductId:  a.ProductID,
+		Kind:       api.ApprovalKind(a.Kind),
+		Status:     api.ApprovalStatus(a.Status),
+		Changes:    make([]*api.ProductAuditChange, 0, len(changes)),
+		Submitter:  a.Submitter,
+		CreatedAt:  a.CreatedAt,
+		ReviewedAt: a.ReviewedAt,
+	}
+	for _, c := range changes {
+		item.Changes = append(item.Changes, &api.ProductAuditChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	if a.Remark != "" {
+		item.Remark = stringPtr(a.Remark)
+	}
+	if a.Reviewer != "" {
+		item.Reviewer = stringPtr(a.Reviewer)
+	}
+	if a.Comment != "" {
+		item.Comment = stringPtr(a.Comment)
+	}
+	return item
+}
@@ -0,0 +1,456 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 创建属性定义
+func (s *productServiceImpl) CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq) (*api.CreateAttributeDefinitionResp, error) {
+	req.Category = strings.TrimSpace(req.Category)
+	req.Code = strings.TrimSpace(req.Code)
+	if req.Category == "" || req.Code == "" || req.Name == "" {
+		return &api.CreateAttributeDefinitionResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("分类、属性编码和名称不能为空"),
+		}, nil
+	}
+	if !isValidAttributeType(req.Type) {
+		return &api.CreateAttributeDefinitionResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("无效的属性类型"),
+		}, nil
+	}
+	if req.Type == api.AttributeType_ENUM && len(req.Options) == 0 {
+		return &api.CreateAttributeDefinitionResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("枚举属性必须提供可选值"),
+		}, nil
+	}
+	existing, err := s.attributeRepo.FindDefinitionByCode(ctx, req.Category, req.Code)
+	if err != nil {
+		return &api.CreateAttributeDefinitionResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	if existing != nil {
+		return &api.CreateAttributeDefinitionResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("该分类下属性编码已存在"),
+		}, nil
+	}
+	now := time.Now().Unix()
+	def := &model.AttributeDefinition{
+		Category:  req.Category,
+		Code:      req.Code,
+		Name:      req.Name,
+		Type:      model.AttributeType(req.Type),
+		Required:  req.Required,
+		Sort:      req.Sort,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if req.Unit != nil {
+		def.Unit = strings.TrimSpace(*req.Unit)
+	}
+	if req.Type == api.AttributeType_ENUM {
+		def.Options = encodeAttributeOptions(req.Options)
+	}
+	if err := s.attributeRepo.CreateDefinition(ctx, def); err != nil {
+		fmt.Printf("创建属性定义失败: %v\n", err)
+		return &api.CreateAttributeDefinitionResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("创建属性定义失败"),
+		}, nil
+	}
+	return &api.CreateAttributeDefinitionResp{
+		Success:    true,
+		Code:       0,
+		Message:    stringPtr("创建成功"),
+		Definition: s.convertToAPIAttributeDefinition(def),
+	}, nil
+}
+
+// 更新属性定义，分类、编码和类型创建后不可修改
+func (s *productServiceImpl) UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq) (*api.UpdateAttributeDefinitionResp, error) {
+	def, err := s.attributeRepo.FindDefinitionByID(ctx, req.Id)
+	if err != nil {
+		return &api.UpdateAttributeDefinitionResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	if def == nil {
+		return &api.UpdateAttributeDefinitionResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("属性定义不存在"),
+		}, nil
+	}
+	if req.Name != nil && *req.Name != "" {
+		def.Name = *req.Name
+	}
+	if req.Required != nil {
+		def.Required = *req.Required
+	}
+	if req.Unit != nil {
+		def.Unit = strings.TrimSpace(*req.Unit)
+	}
+	if req.Options != nil {
+		if def.Type != model.AttributeTypeEnum {
+			return &api.UpdateAttributeDefinitionResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("只有枚举属性可以设置可选值"),
+			}, nil
+		}
+		if len(req.Options) == 0 {
+			return &api.UpdateAttributeDefinitionResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("枚举属性必须提供可选值"),
+			}, nil
+		}
+		def.Options = encodeAttributeOptions(req.Options)
+	}
+	if req.Sort != nil {
+		def.Sort = *req.Sort
+	}
+	def.UpdatedAt = time.Now().Unix()
+	if err := s.attributeRepo.UpdateDefinition(ctx, def); err != nil {
+		return &api.UpdateAttributeDefinitionResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("更新属性定义失败"),
+		}, nil
+	}
+	s.invalidateSearchCache(ctx)
+	return &api.UpdateAttributeDefinitionResp{
+		Success:    true,
+		Code:       0,
+		Message:    stringPtr("更新成功"),
+		Definition: s.convertToAPIAttributeDefinition(def),
+	}, nil
+}
+
+// 查询属性定义
+func (s *productServiceImpl) ListAttributeDefinitions(ctx context.Context, category *string) (*api.ListAttributeDefinitionsResp, error) {
+	defs, err := s.attributeRepo.ListDefinitions(ctx, category)
+	if err != nil {
+		return &api.ListAttributeDefinitionsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	apiDefs := make([]*api.AttributeDefinition, 0, len(defs))
+	for _, def := range defs {
+		apiDefs = append(apiDefs, s.convertToAPIAttributeDefinition(def))
+	}
+	return &api.ListAttributeDefinitionsResp{
+		Success:     true,
+		Code:        0,
+		Message:     stringPtr("查询成功"),
+		Definitions: apiDefs,
+	}, nil
+}
+
+// 删除属性定义，商品上已有的该属性值一并删除
+func (s *productServiceImpl) DeleteAttributeDefinition(ctx context.Context, id int64) (*api.DeleteAttributeDefinitionResp, error) {
+	def, err := s.attributeRepo.FindDefinitionByID(ctx, id)
+	if err != nil {
+		return &api.DeleteAttributeDefinitionResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	if def == nil {
+		return &api.DeleteAttributeDefinitionResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("属性定义不存在"),
+		}, nil
+	}
+	if err := s.attributeRepo.DeleteDefinition(ctx, id); err != nil {
+		return &api.DeleteAttributeDefinitionResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("删除属性定义失败"),
+		}, nil
+	}
+	s.invalidateSearchCache(ctx)
+	return &api.DeleteAttributeDefinitionResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("删除成功"),
+	}, nil
+}
+
+// 按分类属性定义校验商品属性，返回待保存的属性值；校验不通过时返回原因
+func (s *productServiceImpl) resolveProductAttributes(ctx context.Context, category string,
+	raw map[string]string) ([]*model.ProductAttributeValue, string, error) {
+	defs, err := s.attributeRepo.ListDefinitions(ctx, &category)
+	if err != nil {
+		return nil, "", err
+	}
+	defByCode := make(map[string]*model.AttributeDefinition, len(defs))
+	for _, def := range defs {
+		defByCode[def.Code] = def
+	}
+	for code := range raw {
+		if _, ok := defByCode[code]; !ok {
+			return nil, fmt.Sprintf("分类%s下不存在属性%s", category, code), nil
+		}
+	}
+
+	values := make([]*model.ProductAttributeValue, 0, len(raw))
+	for _, def := range defs {
+		input, ok := raw[def.Code]
+		input = strings.TrimSpace(input)
+		if !ok || input == "" {
+			if def.Required {
+				return nil, fmt.Sprintf("属性%s为必填项", def.Name), nil
+			}
+			continue
+		}
+		value, numValue, msg := normalizeAttributeValue(def, input)
+		if msg != "" {
+			return nil, msg, nil
+		}
+		values = append(values, &model.ProductAttributeValue{
+			AttributeID: def.ID,
+			Code:        def.Code,
+			Value:       value,
+			NumValue:    numValue,
+		})
+	}
+	return values, "", nil
+}
+
+// 商品现有属性中在指定分类下仍有定义的部分
+func (s *productServiceImpl) retainedAttributes(ctx context.Context, productID int64, category string) (map[string]string, error) {
+	values, err := s.attributeRepo.ListValues(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.attributeRepo.ListDefinitions(ctx, &category)
+	if err != nil {
+		return nil, err
+	}
+	defined := make(map[string]bool, len(defs))
+	for _, def := range defs {
+		defined[def.Code] = true
+	}
+	raw := make(map[string]string, len(values))
+	for _, v := range values {
+		if defined[v.Code] {
+			raw[v.Code] = v.Value
+		}
+	}
+	return raw, nil
+}
+
+// 查询商品属性，只返回当前分类下仍有定义的属性
+func (s *productServiceImpl) loadProductAttributes(ctx context.Context, product *model.Product) ([]*api.ProductAttribute, error) {
+	values, err := s.attributeRepo.ListValues(ctx, product.ID)
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	defs, err := s.attributeRepo.ListDefinitions(ctx, &product.Category)
+	if err != nil {
+		return nil, err
+	}
+	valueByAttr := make(map[int64]*model.ProductAttributeValue, len(values))
+	for _, v := range values {
+		valueByAttr[v.AttributeID] = v
+	}
+	attributes := make([]*api.ProductAttribute, 0, len(values))
+	for _, def := range defs {
+		v, ok := valueByAttr[def.ID]
+		if !ok {
+			continue
+		}
+		attr := &api.ProductAttribute{
+			Code:  def.Code,
+			Name:  def.Name,
+			Type:  api.AttributeType(def.Type),
+			Value: v.Value,
+		}
+		if def.Unit != "" {
+			attr.Unit = stringPtr(def.Unit)
+		}
+		attributes = append(attributes, attr)
+	}
+	return attributes, nil
+}
+
+// 将属性筛选转换为查询条件，属性类型以指定分类（未指定时任一分类）的定义为准
+func (s *productServiceImpl) buildAttributeConditions(ctx context.Context, category *string,
+	filters []*api.AttributeFilter) ([]repository.AttributeCondition, string, error) {
+	if len(filters) == 0 {
+		return nil, "", nil
+	}
+	conditions := make([]repository.AttributeCondition, 0, len(filters))
+	for _, f := range filters {
+		if f == nil {
+			continue
+		}
+		var def *model.AttributeDefinition
+		if category != nil && *category != "" {
+			found, err := s.attributeRepo.FindDefinitionByCode(ctx, *category, f.Code)
+			if err != nil {
+				return nil, "", err
+			}
+			def = found
+		} else {
+			defs, err := s.attributeRepo.ListDefinitionsByCode(ctx, f.Code)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(defs) > 0 {
+				def = defs[0]
+			}
+		}
+		if def == nil {
+			return nil, fmt.Sprintf("未知属性: %s", f.Code), nil
+		}
+
+		op := strings.ToLower(f.Op)
+		if op == "" {
+			op = "eq"
+		}
+		switch op {
+		case "eq", "ne", "in":
+		case "lt", "lte", "gt", "gte":
+			if def.Type != model.AttributeTypeNumber {
+				return nil, fmt.Sprintf("属性%s不支持大小比较", f.Code), nil
+			}
+		default:
+			return nil, fmt.Sprintf("不支持的筛选操作: %s", f.Op), nil
+		}
+		if len(f.Values) == 0 || (op != "in" && len(f.Values) != 1) {
+			return nil, fmt.Sprintf("属性%s的筛选值数量不正确", f.Code), nil
+		}
+
+		condition := repository.AttributeCondition{
+			Code:    f.Code,
+			Op:      op,
+			Numeric: def.Type == model.AttributeTypeNumber,
+		}
+		for _, raw := range f.Values {
+			value, numValue, msg := normalizeAttributeValue(def, strings.TrimSpace(raw))
+			if msg != "" {
+				return nil, msg, nil
+			}
+			condition.Values = append(condition.Values, value)
+			condition.Numbers = append(condition.Numbers, numValue)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, "", nil
+}
+
+// 按属性类型规范化取值，返回存储值、数值和错误原因
+func normalizeAttributeValue(def *model.AttributeDefinition, input string) (string, float64, string) {
+	switch def.Type {
+	case model.AttributeTypeNumber:
+		number := input
+		if def.Unit != "" && len(number) > len(def.Unit) &&
+			strings.EqualFold(number[len(number)-len(def.Unit):], def.Unit) {
+			number = strings.TrimSpace(number[:len(number)-len(def.Unit)])
+		}
+		n, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return "", 0, fmt.Sprintf("属性%s必须为数值", def.Name)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), n, ""
+	case model.AttributeTypeBool:
+		b, err := strconv.ParseBool(strings.ToLower(input))
+		if err != nil {
+			return "", 0, fmt.Sprintf("属性%s必须为true或false", def.Name)
+		}
+		if b {
+			return "true", 1, ""
+		}
+		return "false", 0, ""
+	case model.AttributeTypeEnum:
+		for _, option := range decodeAttributeOptions(def.Options) {
+			if option == input {
+				return input, 0, ""
+			}
+		}
+		return "", 0, fmt.Sprintf("属性%s的取值不在可选范围内", def.Name)
+	default:
+		if len([]rune(input)) > 255 {
+			return "", 0, fmt.Sprintf("属性%s不能超过255个字符", def.Name)
+		}
+		return input, 0, ""
+	}
+}
+
+// 属性类型是否合法
+func isValidAttributeType(t api.AttributeType) bool {
+	switch t {
+	case api.AttributeType_STRING, api.AttributeType_NUMBER, api.AttributeType_ENUM, api.AttributeType_BOOL:
+		return true
+	}
+	return false
+}
+
+// 枚举可选值编码为JSON
+func encodeAttributeOptions(options []string) string {
+	cleaned := make([]string, 0, len(options))
+	for _, option := range options {
+		if option = strings.TrimSpace(option); option != "" {
+			cleaned = append(cleaned, option)
+		}
+	}
+	data, _ := json.Marshal(cleaned)
+	return string(data)
+}
+
+// 解析枚举可选值
+func decodeAttributeOptions(raw string) []string {
+	var options []string
+	if raw == "" {
+		return options
+	}
+	_ = json.Unmarshal([]byte(raw), &options)
+	return options
+}
+
+// 模型转化
+func (s *productServiceImpl) convertToAPIAttributeDefinition(def *model.AttributeDefinition) *api.AttributeDefinition {
+	item := &api.AttributeDefinition{
+		Id:        def.ID,
+		Category:  def.Category,
+		Code:      def.Code,
+		Name:      def.Name,
+		Type:      api.AttributeType(def.Type),
+		Required:  def.Required,
+		Options:   decodeAttributeOptions(def.Options),
+		Sort:      def.Sort,
+		CreatedAt: def.CreatedAt,
+		UpdatedAt: def.UpdatedAt,
+	}
+	if def.Unit != "" {
+		item.Unit = stringPtr(def.Unit)
+	}
+	return item
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+)
+
+// 只实现按编码查询属性定义，其余方法未用到
+type stubAttributeRepository struct {
+	repository.AttributeRepository
+	defs []*model.AttributeDefinition
+}
+
+func (r *stubAttributeRepository) FindDefinitionByCode(ctx context.Context, category, code string) (*model.AttributeDefinition, error) {
+	for _, def := range r.defs {
+		if def.Category == category && def.Code == code {
+			return def, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *stubAttributeRepository) ListDefinitionsByCode(ctx context.Context, code string) ([]*model.AttributeDefinition, error) {
+	var defs []*model.AttributeDefinition
+	for _, def := range r.defs {
+		if def.Code == code {
+			defs = append(defs, def)
+		}
+	}
+	return defs, nil
+}
+
+func TestBuildAttributeConditions(t *testing.T) {
+	s := &productServiceImpl{attributeRepo: &stubAttributeRepository{defs: []*model.AttributeDefinition{
+		{Category: "手机", Code: "memory", Name: "内存", Type: model.AttributeTypeNumber, Unit: "GB"},
+		{Category: "手机", Code: "color", Name: "颜色", Type: model.AttributeTypeEnum, Options: `["黑色","白色"]`},
+		{Category: "手机", Code: "nfc", Name: "NFC", Type: model.AttributeTypeBool},
+		{Category: "耳机", Code: "wireless", Name: "无线", Type: model.AttributeTypeBool},
+	}}}
+	phone := "手机"
+
+	tests := []struct {
+		name     string
+		category *string
+		filters  []*api.AttributeFilter
+		want     []repository.AttributeCondition
+		wantMsg  string
+	}{
+		{
+			name: "无筛选条件",
+		},
+		{
+			name:     "默认等于，数值去掉单位",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "memory", Values: []string{" 8GB "}}},
+			want:     []repository.AttributeCondition{{Code: "memory", Op: "eq", Numeric: true, Values: []string{"8"}, Numbers: []float64{8}}},
+		},
+		{
+			name:     "操作符不区分大小写",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "memory", Op: "GTE", Values: []string{"12"}}},
+			want:     []repository.AttributeCondition{{Code: "memory", Op: "gte", Numeric: true, Values: []string{"12"}, Numbers: []float64{12}}},
+		},
+		{
+			name:     "枚举多选",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "color", Op: "in", Values: []string{"黑色", "白色"}}},
+			want:     []repository.AttributeCondition{{Code: "color", Op: "in", Values: []string{"黑色", "白色"}, Numbers: []float64{0, 0}}},
+		},
+		{
+			name:    "未指定分类时按任一分类的定义",
+			filters: []*api.AttributeFilter{{Code: "wireless", Values: []string{"TRUE"}}},
+			want:    []repository.AttributeCondition{{Code: "wireless", Op: "eq", Values: []string{"true"}, Numbers: []float64{1}}},
+		},
+		{
+			name:     "跳过空条件",
+			category: &phone,
+			filters:  []*api.AttributeFilter{nil, {Code: "nfc", Op: "ne", Values: []string{"false"}}},
+			want:     []repository.AttributeCondition{{Code: "nfc", Op: "ne", Values: []string{"false"}, Numbers: []float64{0}}},
+		},
+		{
+			name:     "分类下没有该属性",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "wireless", Values: []string{"true"}}},
+			wantMsg:  "未知属性: wireless",
+		},
+		{
+			name:     "非数值属性不支持大小比较",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "color", Op: "gt", Values: []string{"黑色"}}},
+			wantMsg:  "属性color不支持大小比较",
+		},
+		{
+			name:     "不支持的操作",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "color", Op: "like", Values: []string{"黑"}}},
+			wantMsg:  "不支持的筛选操作: like",
+		},
+		{
+			name:     "非in操作只能有一个值",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "memory", Values: []string{"8", "12"}}},
+			wantMsg:  "属性memory的筛选值数量不正确",
+		},
+		{
+			name:     "缺少筛选值",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "memory", Op: "in"}},
+			wantMsg:  "属性memory的筛选值数量不正确",
+		},
+		{
+			name:     "数值格式错误",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "memory", Values: []string{"八"}}},
+			wantMsg:  "属性内存必须为数值",
+		},
+		{
+			name:     "枚举值不在可选范围",
+			category: &phone,
+			filters:  []*api.AttributeFilter{{Code: "color", Values: []string{"红色"}}},
+			wantMsg:  "属性颜色的取值不在可选范围内",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, msg, err := s.buildAttributeConditions(context.Background(), tt.category, tt.filters)
+			if err != nil {
+				t.Fatalf("buildAttributeConditions: %v", err)
+			}
+			if msg != tt.wantMsg {
+				t.Fatalf("msg = %q, want %q", msg, tt.wantMsg)
+			}
+			if tt.wantMsg != "" {
+				return
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("conditions = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type auditSourceKey struct{}
+
+// 标记调用来源，未标记时视为RPC调用
+func WithAuditSource(ctx context.Context, source string) context.Context {
+	return context.WithValue(ctx, auditSourceKey{}, source)
+}
+
+func auditSourceFrom(ctx context.Context) string {
+	if source, ok := ctx.Value(auditSourceKey{}).(string); ok && source != "" {
+		return source
+	}
+	return model.AuditSourceRPC
+}
+
+// 取请求中的操作人，未提供时记为系统
+func operatorOrDefault(operator *string) string {
+	if operator != nil && *operator != "" {
+		return *operator
+	}
+	return defaultStockOperator
+}
+
+// 参与审计的商品字段，按顺序比较
+func auditFields(p *model.Product) [][2]string {
+	externalCode := ""
+	if p.ExternalCode != nil {
+		externalCode = *p.ExternalCode
+	}
+	return [][2]string{
+		{"name", p.Name},
+		{"avatar", p.Avatar},
+		{"category", p.Category},
+		{"brand", p.Brand},
+		{"price", strconv.FormatFloat(p.Price, 'f', 2, 64)},
+		{"original_price", strconv.FormatFloat(p.OriginalPrice, 'f', 2, 64)},
+		{"stock", strconv.FormatInt(int64(p.Stock), 10)},
+		{"status", strconv.FormatInt(int64(p.Status), 10)},
+		{"low_stock_threshold", strconv.FormatInt(int64(p.LowStockThreshold), 10)},
+		{"sold_out_policy", strconv.FormatInt(int64(p.SoldOutPolicy), 10)},
+		{"external_code", externalCode},
+		{"approval_status", strconv.FormatInt(int64(p.ApprovalStatus), 10)},
+		{"merchant_id", strconv.FormatInt(p.MerchantID, 10)},
+		{"product_type", strconv.FormatInt(int64(p.Type), 10)},
+	}
+}
+
+// 比较前后快照，before为nil时表示新建
+func diffProduct(before, after *model.Product) []model.AuditChange {
+	afterFields := auditFields(after)
+	changes := make([]model.AuditChange, 0, len(afterFields))
+	if before == nil {
+		for _, f := range afterFields {
+			changes = append(changes, model.AuditChange{Field: f[0], After: f[1]})
+		}
+		return changes
+	}
+	beforeFields := auditFields(before)
+	for i, f := range afterFields {
+		if beforeFields[i][1] != f[1] {
+			changes = append(changes, model.AuditChange{Field: f[0], Before: beforeFields[i][1], After: f[1]})
+		}
+	}
+	return changes
+}
+
+// 库存变更审计备注：变动原因和关联单号
+func stockAuditRemark(m *model.StockMovement) string {
+	remark := "reason=" + api.StockChangeReason(m.Reason).String()
+	if m.ReferenceID != nil {
+		remark += ", reference=" + *m.ReferenceID
+	}
+	if m.WarehouseID > 0 {
+		remark += fmt.Sprintf(", warehouse=%d", m.WarehouseID)
+	}
+	return remark
+}
+
+// 写入审计日志，写入失败只记录日志，不影响已完成的变更
+func (s *productServiceImpl) recordAudit(ctx context.Context, productID int64, action, operator string,
+	changes []model.AuditChange, remark string) {
+	if s.auditRepo == nil {
+		return
+	}
+	raw, err := json.Marshal(changes)
+	if err != nil {
+		fmt.Printf("序列化审计变更失败: product=%d, err=%v\n", productID, err)
+		return
+	}
+	audit := &model.ProductAudit{
+		ProductID: productID,
+		Action:    action,
+		Operator:  operator,
+		Source:    auditSourceFrom(ctx),
+		Changes:   string(raw),
+		Remark:    remark,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err := s.auditRepo.Create(ctx, audit); err != nil {
+		fmt.Printf("写入审计日志失败: product=%d, action=%s, err=%v\n", productID, action, err)
+	}
+}
+
+// 按前后快照写入审计日志，没有字段变化时不记录
+func (s *productServiceImpl) auditProductChange(ctx context.Context, action, operator string,
+	before, after *model.Product, remark string) {
+	if after == nil {
+		return
+	}
+	changes := diffProduct(before, after)
+	if len(changes) == 0 {
+		return
+	}
+	s.recordAudit(ctx, after.ID, action, operator, changes, remark)
+}
+
+// 重新读取商品并与变更前快照比较，用于仓储层直接修改数据库的场景
+func (s *productServiceImpl) auditReloadedProduct(ctx context.Context, action, operator string,
+	before *model.Product, remark string) {
+	if before == nil {
+		return
+	}
+	after, err := s.productRepo.FindByID(ctx, before.ID)
+	if err != nil || after == nil {
+		fmt.Printf("查询商品失败，跳过审计: product=%d, err=%v\n", before.ID, err)
+		return
+	}
+	s.auditProductChange(ctx, action, operator, before, after, remark)
+}
+
+// 查询商品审计日志
+func (s *productServiceImpl) ListProductAudit(ctx context.Context, req *api.ListProductAuditReq) (*api.ListProductAuditResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	if req.StartTime != nil && req.EndTime != nil && *req.StartTime >= *req.EndTime {
+		return &api.ListProductAuditResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("开始时间必须早于结束时间"),
+		}, nil
+	}
+	filter := &repository.AuditFilter{
+		ProductID: req.ProductId,
+		Operator:  req.Operator,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+	audits, total, err := s.auditRepo.List(ctx, filter, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListProductAuditResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询审计日志失败"),
+		}, nil
+	}
+	items := make([]*api.ProductAudit, 0, len(audits))
+	for _, a := range audits {
+		items = append(items, s.convertToAPIAudit(a))
+	}
+	return &api.ListProductAuditResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Audits:   items,
+	}, nil
+}
+
+// 模型转化
+func (s *productServiceImpl) convertToAPIAudit(a *model.ProductAudit) *api.ProductAudit {
+	var changes []model.AuditChange
+	if a.Changes != "" {
+		if err := json.Unmarshal([]byte(a.Changes), &changes); err != nil {
+			fmt.Printf("解析审计变更失败: audit=%d, err=%v\n", a.ID, err)
+		}
+	}
+	item := &api.ProductAudit{
+		Id:        a.ID,
+		ProductId: a.ProductID,
+		Action:    a.Action,
+		Operator:  a.Operator,
+		Source:    a.Source,
+		Changes:   make([]*api.ProductAuditChange, 0, len(changes)),
+		CreatedAt: a.CreatedAt,
+	}
+	for _, c := range changes {
+		item.Changes = append(item.Changes, &api.ProductAuditChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	if a.Remark != "" {
+		item.Remark = &a.Remark
+	}
+	return item
+}
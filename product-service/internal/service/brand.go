@@ -0,0 +1,569 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// 品牌名和别名的长度上限
+	maxBrandNameLength = 100
+	// 单个品牌的别名数量上限
+	maxBrandAliases = 20
+)
+
+// 创建品牌
+func (s *productServiceImpl) CreateBrand(ctx context.Context, req *api.CreateBrandReq) (*api.CreateBrandResp, error) {
+	name := strings.TrimSpace(req.Name)
+	brand := &model.Brand{
+		Name:   name,
+		Status: model.BrandStatusACTIVE,
+	}
+	if req.Logo != nil {
+		brand.Logo = strings.TrimSpace(*req.Logo)
+	}
+	if req.Description != nil {
+		brand.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Status != nil {
+		brand.Status = model.BrandStatus(*req.Status)
+	}
+	if msg := validateBrand(brand); msg != "" {
+		return &api.CreateBrandResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	aliases, msg := buildBrandAliases(name, req.Aliases)
+	if msg != "" {
+		return &api.CreateBrandResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	conflict, err := s.brandAliasConflict(ctx, aliases, 0)
+	if err != nil {
+		return &api.CreateBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌失败"),
+		}, nil
+	}
+	if conflict != "" {
+		return &api.CreateBrandResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr(conflict),
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	brand.CreatedAt = now
+	brand.UpdatedAt = now
+	if err := s.brandRepo.Create(ctx, brand, aliases); err != nil {
+		fmt.Printf("创建品牌失败: name=%s, err=%v\n", name, err)
+		return &api.CreateBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("创建品牌失败"),
+		}, nil
+	}
+	return &api.CreateBrandResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("创建成功"),
+		Brand:   s.convertToAPIBrand(brand, aliases),
+	}, nil
+}
+
+// 更新品牌，改名时同步商品上的品牌名
+func (s *productServiceImpl) UpdateBrand(ctx context.Context, req *api.UpdateBrandReq) (*api.UpdateBrandResp, error) {
+	brand, err := s.brandRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		return &api.UpdateBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌失败"),
+		}, nil
+	}
+	if brand == nil {
+		return &api.UpdateBrandResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("品牌不存在"),
+		}, nil
+	}
+	existing, err := s.brandRepo.ListAliases(ctx, []int64{brand.ID})
+	if err != nil {
+		return &api.UpdateBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌别名失败"),
+		}, nil
+	}
+	oldName := brand.Name
+	if req.Name != nil {
+		brand.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Logo != nil {
+		brand.Logo = strings.TrimSpace(*req.Logo)
+	}
+	if req.Description != nil {
+		brand.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Status != nil {
+		brand.Status = model.BrandStatus(*req.Status)
+	}
+	if msg := validateBrand(brand); msg != "" {
+		return &api.UpdateBrandResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+
+	renamed := brand.Name != oldName
+	aliases := existing[brand.ID]
+	var replaced []*model.BrandAlias
+	if renamed || req.Aliases != nil {
+		raw := req.Aliases
+		if raw == nil {
+			//只改名时保留原别名，原名称也作为别名保留，已有商品文本仍能匹配
+			raw = []string{oldName}
+			for _, a := range aliases {
+				raw = append(raw, a.Alias)
+			}
+		}
+		var msg string
+		replaced, msg = buildBrandAliases(brand.Name, raw)
+		if msg != "" {
+			return &api.UpdateBrandResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr(msg),
+			}, nil
+		}
+		conflict, err := s.brandAliasConflict(ctx, replaced, brand.ID)
+		if err != nil {
+			return &api.UpdateBrandResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询品牌失败"),
+			}, nil
+		}
+		if conflict != "" {
+			return &api.UpdateBrandResp{
+				Success: false,
+				Code:    409,
+				Message: stringPtr(conflict),
+			}, nil
+		}
+		aliases = replaced
+	}
+
+	var productIDs []int64
+	if renamed {
+		productIDs, err = s.brandRepo.ListProductIDs(ctx, brand.ID)
+		if err != nil {
+			return &api.UpdateBrandResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询品牌商品失败"),
+			}, nil
+		}
+	}
+	brand.UpdatedAt = time.Now().Unix()
+	if err := s.brandRepo.Update(ctx, brand, replaced, renamed); err != nil {
+		fmt.Printf("更新品牌失败: brand=%d, err=%v\n", brand.ID, err)
+		return &api.UpdateBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("更新品牌失败"),
+		}, nil
+	}
+	if renamed {
+		s.auditBrandChange(ctx, productIDs, oldName, brand.Name, operatorOrDefault(req.Operator), "品牌改名")
+		s.invalidateProductCache(ctx, productIDs...)
+	} else {
+		s.invalidateSearchCache(ctx)
+	}
+	return &api.UpdateBrandResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("更新成功"),
+		Brand:   s.convertToAPIBrand(brand, aliases),
+	}, nil
+}
+
+// 查询品牌
+func (s *productServiceImpl) GetBrand(ctx context.Context, id int64) (*api.GetBrandResp, error) {
+	brand, err := s.brandRepo.FindByID(ctx, id)
+	if err != nil {
+		return &api.GetBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌失败"),
+		}, nil
+	}
+	if brand == nil {
+		return &api.GetBrandResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("品牌不存在"),
+		}, nil
+	}
+	aliases, err := s.brandRepo.ListAliases(ctx, []int64{brand.ID})
+	if err != nil {
+		return &api.GetBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌别名失败"),
+		}, nil
+	}
+	return &api.GetBrandResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("查询成功"),
+		Brand:   s.convertToAPIBrand(brand, aliases[brand.ID]),
+	}, nil
+}
+
+// 分页查询品牌
+func (s *productServiceImpl) ListBrands(ctx context.Context, req *api.ListBrandsReq) (*api.ListBrandsResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	var status *model.BrandStatus
+	if req.Status != nil {
+		value := model.BrandStatus(*req.Status)
+		status = &value
+	}
+	brands, total, err := s.brandRepo.List(ctx, req.Keyword, status, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListBrandsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌失败"),
+		}, nil
+	}
+	ids := make([]int64, 0, len(brands))
+	for _, b := range brands {
+		ids = append(ids, b.ID)
+	}
+	aliases, err := s.brandRepo.ListAliases(ctx, ids)
+	if err != nil {
+		return &api.ListBrandsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌别名失败"),
+		}, nil
+	}
+	items := make([]*api.Brand, 0, len(brands))
+	for _, b := range brands {
+		items = append(items, s.convertToAPIBrand(b, aliases[b.ID]))
+	}
+	return &api.ListBrandsResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Brands:   items,
+	}, nil
+}
+
+// 删除品牌，有关联商品时需要指定合并目标
+func (s *productServiceImpl) DeleteBrand(ctx context.Context, req *api.DeleteBrandReq) (*api.DeleteBrandResp, error) {
+	brand, err := s.brandRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		return &api.DeleteBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌失败"),
+		}, nil
+	}
+	if brand == nil {
+		return &api.DeleteBrandResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("品牌不存在"),
+		}, nil
+	}
+	var target *model.Brand
+	if req.MergeIntoId != nil {
+		if *req.MergeIntoId == brand.ID {
+			return &api.DeleteBrandResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("不能合并到自身"),
+			}, nil
+		}
+		target, err = s.brandRepo.FindByID(ctx, *req.MergeIntoId)
+		if err != nil {
+			return &api.DeleteBrandResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询品牌失败"),
+			}, nil
+		}
+		if target == nil {
+			return &api.DeleteBrandResp{
+				Success: false,
+				Code:    404,
+				Message: stringPtr("合并目标品牌不存在"),
+			}, nil
+		}
+	}
+	productIDs, err := s.brandRepo.ListProductIDs(ctx, brand.ID)
+	if err != nil {
+		return &api.DeleteBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌商品失败"),
+		}, nil
+	}
+	if len(productIDs) > 0 && target == nil {
+		return &api.DeleteBrandResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr(fmt.Sprintf("品牌下还有%d个商品，请指定合并目标", len(productIDs))),
+		}, nil
+	}
+	if err := s.brandRepo.Delete(ctx, brand.ID, target); err != nil {
+		fmt.Printf("删除品牌失败: brand=%d, err=%v\n", brand.ID, err)
+		return &api.DeleteBrandResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("删除品牌失败"),
+		}, nil
+	}
+	if target != nil {
+		s.auditBrandChange(ctx, productIDs, brand.Name, target.Name, operatorOrDefault(req.Operator), "品牌合并")
+	}
+	s.invalidateProductCache(ctx, productIDs...)
+	return &api.DeleteBrandResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("删除成功"),
+	}, nil
+}
+
+// 解析商品关联的品牌：优先按ID，其次按名称或别名匹配，都未匹配时新建品牌
+// 返回的品牌为nil表示不关联品牌
+func (s *productServiceImpl) resolveProductBrand(ctx context.Context, brandID *int64, brandName *string) (*model.Brand, string, error) {
+	var brand *model.Brand
+	switch {
+	case brandID != nil:
+		if *brandID == 0 {
+			return nil, "", nil
+		}
+		found, err := s.brandRepo.FindByID(ctx, *brandID)
+		if err != nil {
+			return nil, "", err
+		}
+		if found == nil {
+			return nil, "品牌不存在", nil
+		}
+		brand = found
+	case brandName != nil:
+		name := strings.TrimSpace(*brandName)
+		if name == "" {
+			return nil, "", nil
+		}
+		if utf8.RuneCountInString(name) > maxBrandNameLength {
+			return nil, fmt.Sprintf("品牌名称不能超过%d个字符", maxBrandNameLength), nil
+		}
+		brands, err := s.findOrCreateBrands(ctx, []string{name}, true)
+		if err != nil {
+			return nil, "", err
+		}
+		brand = brands[model.NormalizeBrandKey(name)]
+	default:
+		return nil, "", nil
+	}
+	if brand.Status == model.BrandStatusDISABLED {
+		return nil, fmt.Sprintf("品牌%s已停用", brand.Name), nil
+	}
+	return brand, "", nil
+}
+
+// 按名称或别名批量匹配品牌，匹配键 -> 品牌；create为true时为未匹配的名称新建品牌
+func (s *productServiceImpl) findOrCreateBrands(ctx context.Context, names []string, create bool) (map[string]*model.Brand, error) {
+	pending := make(map[string]string, len(names))
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key := model.NormalizeBrandKey(name)
+		if key == "" {
+			continue
+		}
+		if _, ok := pending[key]; !ok {
+			pending[key] = strings.TrimSpace(name)
+			keys = append(keys, key)
+		}
+	}
+	brands, err := s.brandRepo.FindByKeys(ctx, keys)
+	if err != nil || !create {
+		return brands, err
+	}
+	for _, key := range keys {
+		if _, ok := brands[key]; ok {
+			continue
+		}
+		now := time.Now().Unix()
+		brand := &model.Brand{
+			Name:      pending[key],
+			Status:    model.BrandStatusACTIVE,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		err := s.brandRepo.Create(ctx, brand, []*model.BrandAlias{{Alias: brand.Name, Key: key}})
+		if err != nil {
+			//并发创建了同一品牌时重新查询
+			found, findErr := s.brandRepo.FindByKeys(ctx, []string{key})
+			if findErr != nil || found[key] == nil {
+				return nil, err
+			}
+			brand = found[key]
+		}
+		brands[key] = brand
+	}
+	return brands, nil
+}
+
+// 把品牌分面统计转为接口结构，补充品牌名
+func (s *productServiceImpl) convertToAPIBrandFacets(ctx context.Context, facets []*repository.BrandFacet) ([]*api.BrandFacet, error) {
+	ids := make([]int64, 0, len(facets))
+	for _, f := range facets {
+		ids = append(ids, f.BrandID)
+	}
+	brands, err := s.brandRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*api.BrandFacet, 0, len(facets))
+	for _, f := range facets {
+		brand, ok := brands[f.BrandID]
+		if !ok {
+			continue
+		}
+		result = append(result, &api.BrandFacet{
+			BrandId: f.BrandID,
+			Name:    brand.Name,
+			Count:   int32(f.Count),
+		})
+	}
+	return result, nil
+}
+
+// 品牌改名或合并后为受影响商品写审计日志
+func (s *productServiceImpl) auditBrandChange(ctx context.Context, productIDs []int64, before, after, operator, remark string) {
+	changes := []model.AuditChange{{Field: "brand", Before: before, After: after}}
+	for _, id := range productIDs {
+		s.recordAudit(ctx, id, model.AuditActionUpdate, operator, changes, remark)
+	}
+}
+
+// 检查名称和别名是否已被其他品牌占用，返回冲突说明
+func (s *productServiceImpl) brandAliasConflict(ctx context.Context, aliases []*model.BrandAlias, brandID int64) (string, error) {
+	keys := make([]string, 0, len(aliases))
+	for _, a := range aliases {
+		keys = append(keys, a.Key)
+	}
+	owners, err := s.brandRepo.FindKeyOwners(ctx, keys, brandID)
+	if err != nil || len(owners) == 0 {
+		return "", err
+	}
+	for _, a := range aliases {
+		ownerID, ok := owners[a.Key]
+		if !ok {
+			continue
+		}
+		owner, err := s.brandRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return "", err
+		}
+		ownerName := fmt.Sprintf("%d", ownerID)
+		if owner != nil {
+			ownerName = owner.Name
+		}
+		return fmt.Sprintf("%s已被品牌%s使用", a.Alias, ownerName), nil
+	}
+	return "", nil
+}
+
+// 校验品牌字段
+func validateBrand(brand *model.Brand) string {
+	if brand.Name == "" {
+		return "品牌名称不能为空"
+	}
+	if utf8.RuneCountInString(brand.Name) > maxBrandNameLength {
+		return fmt.Sprintf("品牌名称不能超过%d个字符", maxBrandNameLength)
+	}
+	if utf8.RuneCountInString(brand.Logo) > 500 {
+		return "品牌Logo地址不能超过500个字符"
+	}
+	if utf8.RuneCountInString(brand.Description) > 1000 {
+		return "品牌描述不能超过1000个字符"
+	}
+	switch brand.Status {
+	case model.BrandStatusACTIVE, model.BrandStatusDISABLED:
+	default:
+		return "品牌状态无效"
+	}
+	return ""
+}
+
+// 生成品牌的匹配键，品牌名在前，按归一化结果去重
+func buildBrandAliases(name string, raw []string) ([]*model.BrandAlias, string) {
+	aliases := []*model.BrandAlias{{Alias: name, Key: model.NormalizeBrandKey(name)}}
+	seen := map[string]bool{aliases[0].Key: true}
+	for _, alias := range raw {
+		alias = strings.TrimSpace(alias)
+		key := model.NormalizeBrandKey(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+		if utf8.RuneCountInString(alias) > maxBrandNameLength {
+			return nil, fmt.Sprintf("品牌别名不能超过%d个字符", maxBrandNameLength)
+		}
+		seen[key] = true
+		aliases = append(aliases, &model.BrandAlias{Alias: alias, Key: key})
+	}
+	if len(aliases)-1 > maxBrandAliases {
+		return nil, fmt.Sprintf("品牌别名不能超过%d个", maxBrandAliases)
+	}
+	return aliases, ""
+}
+
+// 模型转化，别名不包含品牌名本身
+func (s *productServiceImpl) convertToAPIBrand(b *model.Brand, aliases []*model.BrandAlias) *api.Brand {
+	brand := &api.Brand{
+		Id:          b.ID,
+		Name:        b.Name,
+		Logo:        b.Logo,
+		Description: b.Description,
+		Status:      api.BrandStatus(b.Status),
+		Aliases:     make([]string, 0, len(aliases)),
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+	nameKey := model.NormalizeBrandKey(b.Name)
+	for _, a := range aliases {
+		if a.Key != nameKey {
+			brand.Aliases = append(brand.Aliases, a.Alias)
+		}
+	}
+	return brand
+}
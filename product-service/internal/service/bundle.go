@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 单个套装的组件数量上限
+const maxBundleComponents = 20
+
+// 校验套装组件：组件须为同一商家未删除的普通商品，同一商品只能出现一次
+func (s *productServiceImpl) resolveBundleComponents(ctx context.Context, bundleID, merchantID int64,
+	components []*api.BundleComponent) ([]*model.ProductBundleItem, int32, string) {
+	if len(components) == 0 {
+		return nil, 400, "套装至少需要一个组件"
+	}
+	if len(components) > maxBundleComponents {
+		return nil, 400, fmt.Sprintf("套装最多包含%d个组件", maxBundleComponents)
+	}
+	items := make([]*model.ProductBundleItem, 0, len(components))
+	ids := make([]int64, 0, len(components))
+	seen := make(map[int64]bool, len(components))
+	for _, c := range components {
+		if c == nil || c.ProductId <= 0 || c.Quantity <= 0 {
+			return nil, 400, "组件商品ID和数量必须大于0"
+		}
+		if c.ProductId == bundleID {
+			return nil, 400, "套装不能包含自身"
+		}
+		if seen[c.ProductId] {
+			return nil, 400, fmt.Sprintf("组件商品重复: %d", c.ProductId)
+		}
+		seen[c.ProductId] = true
+		ids = append(ids, c.ProductId)
+		items = append(items, &model.ProductBundleItem{ComponentID: c.ProductId, Quantity: c.Quantity})
+	}
+	products, err := s.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		fmt.Printf("查询套装组件失败: %v\n", err)
+		return nil, 500, "查询组件商品失败"
+	}
+	byID := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+	for _, id := range ids {
+		p, ok := byID[id]
+		if !ok || p.Status == model.ProductStatusDELETED {
+			return nil, 400, fmt.Sprintf("组件商品不存在: %d", id)
+		}
+		if p.Type != model.ProductTypeNORMAL {
+			return nil, 400, fmt.Sprintf("组件不能是套装: %d", id)
+		}
+		if p.MerchantID != merchantID {
+			return nil, 400, fmt.Sprintf("组件商品须与套装属于同一商家: %d", id)
+		}
+	}
+	return items, 0, ""
+}
+
+// 修改套装组件，只能在套装未上架时修改，修改后按新组件重新计算库存
+func (s *productServiceImpl) UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq) (*api.UpdateBundleComponentsResp, error) {
+	product, err := s.productRepo.FindByID(ctx, req.BundleId)
+	if err != nil {
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	if product.Type != model.ProductTypeBUNDLE {
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品不是套装"),
+		}, nil
+	}
+	if isListedStatus(product.Status) {
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("套装上架期间不能修改组件，请先下架"),
+		}, nil
+	}
+	items, code, msg := s.resolveBundleComponents(ctx, product.ID, product.MerchantID, req.Components)
+	if msg != "" {
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    code,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	existing, err := s.bundleRepo.ListComponents(ctx, []int64{product.ID})
+	if err != nil {
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询套装组件失败"),
+		}, nil
+	}
+	if err := s.bundleRepo.ReplaceComponents(ctx, product.ID, items); err != nil {
+		fmt.Printf("保存套装组件失败: bundle=%d, err=%v\n", product.ID, err)
+		return &api.UpdateBundleComponentsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("保存套装组件失败"),
+		}, nil
+	}
+	s.recordAudit(ctx, product.ID, model.AuditActionUpdate, operatorOrDefault(req.Operator), []model.AuditChange{{
+		Field:  "components",
+		Before: formatBundleItems(existing[product.ID]),
+		After:  formatBundleItems(items),
+	}}, "")
+	s.refreshBundleStocks(ctx, []int64{product.ID})
+	s.invalidateProductCache(ctx, product.ID)
+
+	updated, err := s.productRepo.FindByID(ctx, product.ID)
+	if err != nil || updated == nil {
+		updated = product
+	}
+	apiProduct := s.convertToAPIProduct(updated)
+	components, err := s.loadBundleComponents(ctx, []*model.Product{updated})
+	if err != nil {
+		fmt.Printf("查询套装组件失败: bundle=%d, err=%v\n", product.ID, err)
+	}
+	apiProduct.Components = components[product.ID]
+	return &api.UpdateBundleComponentsResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("修改成功"),
+		Product: apiProduct,
+	}, nil
+}
+
+// 审计日志中的组件格式：组件ID x 数量，按组件ID排列
+func formatBundleItems(items []*model.ProductBundleItem) string {
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		parts = append(parts, strconv.FormatInt(item.ComponentID, 10)+"x"+strconv.FormatInt(int64(item.Quantity), 10))
+	}
+	return strings.Join(parts, ",")
+}
+
+// 查询套装组件：套装ID -> 组件列表，普通商品忽略
+func (s *productServiceImpl) loadBundleComponents(ctx context.Context, products []*model.Product) (map[int64][]*api.BundleComponent, error) {
+	result := make(map[int64][]*api.BundleComponent)
+	bundleIDs := make([]int64, 0)
+	for _, p := range products {
+		if p != nil && p.Type == model.ProductTypeBUNDLE {
+			bundleIDs = append(bundleIDs, p.ID)
+		}
+	}
+	if len(bundleIDs) == 0 {
+		return result, nil
+	}
+	items, err := s.bundleRepo.ListComponents(ctx, bundleIDs)
+	if err != nil {
+		return result, err
+	}
+	componentIDs := make([]int64, 0)
+	for _, list := range items {
+		for _, item := range list {
+			componentIDs = append(componentIDs, item.ComponentID)
+		}
+	}
+	components, err := s.productRepo.FindByIDs(ctx, componentIDs)
+	if err != nil {
+		return result, err
+	}
+	names := make(map[int64]string, len(components))
+	for _, c := range components {
+		names[c.ID] = c.Name
+	}
+	for bundleID, list := range items {
+		for _, item := range list {
+			component := &api.BundleComponent{
+				ProductId: item.ComponentID,
+				Quantity:  item.Quantity,
+			}
+			if name, ok := names[item.ComponentID]; ok {
+				component.ProductName = stringPtr(name)
+			}
+			result[bundleID] = append(result[bundleID], component)
+		}
+	}
+	return result, nil
+}
+
+// 重新计算套装库存，库存变化时和普通商品一样写事件并检查预警和售罄
+func (s *productServiceImpl) refreshBundleStocks(ctx context.Context, bundleIDs []int64) []*repository.BundleStockChange {
+	changes, err := s.bundleRepo.RecalculateStocks(ctx, bundleIDs)
+	if err != nil {
+		fmt.Printf("重新计算套装库存失败: bundles=%v, err=%v\n", bundleIDs, err)
+	}
+	for _, change := range changes {
+		s.publishEvent(ctx, change.BundleID, model.ProductEventStockChanged)
+		s.onStockChanged(ctx, change.BundleID, change.OldStock, change.NewStock)
+		s.invalidateProductCache(ctx, change.BundleID)
+	}
+	return changes
+}
+
+// 组件库存变化后同步包含它的套装
+func (s *productServiceImpl) syncBundleStocks(ctx context.Context, componentID int64) {
+	bundleIDs, err := s.bundleRepo.FindBundleIDs(ctx, componentID)
+	if err != nil {
+		fmt.Printf("查询组件所属套装失败: product=%d, err=%v\n", componentID, err)
+		return
+	}
+	if len(bundleIDs) > 0 {
+		s.refreshBundleStocks(ctx, bundleIDs)
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+)
+
+const (
+	productCacheTTL = 5 * time.Minute  // 商品详情缓存时长
+	nullCacheTTL    = 30 * time.Second // 不存在商品的缓存时长，防止缓存穿透
+	searchCacheTTL  = 30 * time.Second // 搜索结果缓存时长
+
+	// 只缓存前几页，热门查询基本集中在前几页
+	maxCachedSearchPage = 5
+
+	// 搜索缓存版本号，任何商品写操作都会更新版本，旧版本的搜索缓存随之失效
+	searchGenerationKey = "product:search:gen"
+
+	// 延迟双删的间隔，清理写操作期间并发读回填的旧数据
+	cacheDelayedDeleteInterval = 500 * time.Millisecond
+)
+
+func productCacheKey(id int64) string {
+	return fmt.Sprintf("product:detail:%d", id)
+}
+
+// 读穿缓存查询商品，商品不存在时返回nil
+func (s *productServiceImpl) findProductCached(ctx context.Context, id int64) (*model.Product, error) {
+	if s.cacheLoader == nil {
+		return s.productRepo.FindByID(ctx, id)
+	}
+	data, err := s.cacheLoader.Get(ctx, productCacheKey(id), func(ctx context.Context) ([]byte, time.Duration, error) {
+		product, err := s.productRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		ttl := productCacheTTL
+		if product == nil {
+			ttl = nullCacheTTL
+		}
+		data, err := json.Marshal(product)
+		return data, ttl, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var product *model.Product
+	if err := json.Unmarshal(data, &product); err != nil {
+		fmt.Printf("解析商品缓存失败: product=%d, err=%v\n", id, err)
+		s.cache.Delete(ctx, productCacheKey(id))
+		return s.productRepo.FindByID(ctx, id)
+	}
+	return product, nil
+}
+
+// 搜索结果缓存内容
+type cachedSearchResult struct {
+	Products    []*api.SimpleProduct `json:"products"`
+	Total       int64                `json:"total"`
+	BrandFacets []*api.BrandFacet    `json:"brand_facets"`
+}
+
+// 用户搜索，前几页结果走读穿缓存
+func (s *productServiceImpl) searchForUserCached(ctx context.Context, req *api.UserSearchProductsReq,
+	conditions []repository.AttributeCondition) (*cachedSearchResult, error) {
+	load := func(ctx context.Context) (*cachedSearchResult, error) {
+		products, total, err := s.productRepo.SearchForUser(ctx,
+			req.Category,
+			req.MinPrice,
+			req.MaxPrice,
+			req.Keyword,
+			req.BrandIds,
+			conditions,
+			req.GetSortBy(),
+			req.Page,
+			req.PageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		facets, err := s.productRepo.BrandFacetsForUser(ctx, req.Category, req.MinPrice, req.MaxPrice, req.Keyword, conditions)
+		if err != nil {
+			return nil, err
+		}
+		brandFacets, err := s.convertToAPIBrandFacets(ctx, facets)
+		if err != nil {
+			return nil, err
+		}
+		result := &cachedSearchResult{
+			Products:    make([]*api.SimpleProduct, 0, len(products)),
+			Total:       total,
+			BrandFacets: brandFacets,
+		}
+		for _, p := range products {
+			result.Products = append(result.Products, s.convertToAPISimpleProduct(p))
+		}
+		return result, nil
+	}
+
+	if s.cacheLoader == nil || req.Page > maxCachedSearchPage {
+		return load(ctx)
+	}
+
+	key, err := s.searchCacheKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.cacheLoader.Get(ctx, key, func(ctx context.Context) ([]byte, time.Duration, error) {
+		result, err := load(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		data, err := json.Marshal(result)
+		return data, searchCacheTTL, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result cachedSearchResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		fmt.Printf("解析搜索缓存失败: key=%s, err=%v\n", key, err)
+		s.cache.Delete(ctx, key)
+		return load(ctx)
+	}
+	return &result, nil
+}
+
+// 搜索缓存key由当前版本号和查询条件摘要组成
+func (s *productServiceImpl) searchCacheKey(ctx context.Context, req *api.UserSearchProductsReq) (string, error) {
+	generation := "0"
+	if value, err := s.cache.Get(ctx, searchGenerationKey); err == nil {
+		generation = string(value)
+	}
+	//翻译和阶梯价在读取缓存后处理，不同语言和用户共用缓存
+	keyReq := *req
+	keyReq.Locale = nil
+	keyReq.UserId = nil
+	raw, err := json.Marshal(&keyReq)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("product:search:%s:%x", generation, sha1.Sum(raw)), nil
+}
+
+// 商品写操作后失效详情缓存和全部搜索缓存
+func (s *productServiceImpl) invalidateProductCache(ctx context.Context, ids ...int64) {
+	if s.cache == nil {
+		return
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, productCacheKey(id))
+	}
+	if len(keys) > 0 {
+		if err := s.cache.Delete(ctx, keys...); err != nil {
+			fmt.Printf("删除商品缓存失败: products=%v, err=%v\n", ids, err)
+		}
+		// 延迟再删一次，避免写入前已开始的回源把旧数据重新写回缓存
+		time.AfterFunc(cacheDelayedDeleteInterval, func() {
+			if err := s.cache.Delete(context.Background(), keys...); err != nil {
+				fmt.Printf("延迟删除商品缓存失败: products=%v, err=%v\n", ids, err)
+			}
+		})
+	}
+	s.invalidateSearchCache(ctx)
+}
+
+// 更新搜索缓存版本号，旧版本缓存不再命中并自然过期
+func (s *productServiceImpl) invalidateSearchCache(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	generation := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := s.cache.Set(ctx, searchGenerationKey, []byte(generation), 0); err != nil {
+		fmt.Printf("更新搜索缓存版本失败: %v\n", err)
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"time"
+)
+
+// 单个用户的收藏数量上限
+const maxFavoritesPerUser = 500
+
+// 收藏商品，已收藏时直接返回原收藏，保留收藏时的价格
+func (s *productServiceImpl) AddFavorite(ctx context.Context, req *api.AddFavoriteReq) (*api.AddFavoriteResp, error) {
+	if req.UserId <= 0 || req.ProductId <= 0 {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID和商品ID必须大于0"),
+		}, nil
+	}
+	product, err := s.findProductCached(ctx, req.ProductId)
+	if err != nil {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	existing, err := s.favoriteRepo.Find(ctx, req.UserId, req.ProductId)
+	if err != nil {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询收藏失败"),
+		}, nil
+	}
+	if existing != nil {
+		return &api.AddFavoriteResp{
+			Success:  true,
+			Code:     0,
+			Message:  stringPtr("已收藏"),
+			Favorite: s.convertToAPIFavorite(existing, product),
+		}, nil
+	}
+	if !isListedStatus(product.Status) {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品未上架"),
+		}, nil
+	}
+	count, err := s.favoriteRepo.CountByUser(ctx, req.UserId)
+	if err != nil {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询收藏失败"),
+		}, nil
+	}
+	if count >= maxFavoritesPerUser {
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("最多收藏%d个商品", maxFavoritesPerUser)),
+		}, nil
+	}
+
+	favorite := &model.Favorite{
+		UserID:     req.UserId,
+		ProductID:  product.ID,
+		SavedPrice: product.Price,
+		CreatedAt:  time.Now().Unix(),
+	}
+	if _, err := s.favoriteRepo.Add(ctx, favorite); err != nil {
+		fmt.Printf("收藏商品失败: user=%d, product=%d, err=%v\n", req.UserId, product.ID, err)
+		return &api.AddFavoriteResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("收藏失败"),
+		}, nil
+	}
+	return &api.AddFavoriteResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("收藏成功"),
+		Favorite: s.convertToAPIFavorite(favorite, product),
+	}, nil
+}
+
+// 取消收藏
+func (s *productServiceImpl) RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq) (*api.RemoveFavoriteResp, error) {
+	if req.UserId <= 0 || req.ProductId <= 0 {
+		return &api.RemoveFavoriteResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID和商品ID必须大于0"),
+		}, nil
+	}
+	removed, err := s.favoriteRepo.Remove(ctx, req.UserId, req.ProductId)
+	if err != nil {
+		fmt.Printf("取消收藏失败: user=%d, product=%d, err=%v\n", req.UserId, req.ProductId, err)
+		return &api.RemoveFavoriteResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("取消收藏失败"),
+		}, nil
+	}
+	if !removed {
+		return &api.RemoveFavoriteResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("未收藏该商品"),
+		}, nil
+	}
+	return &api.RemoveFavoriteResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("已取消收藏"),
+	}, nil
+}
+
+// 查询用户的收藏，附带商品当前的价格、库存和状态，并标记降价的商品
+func (s *productServiceImpl) ListFavorites(ctx context.Context, req *api.ListFavoritesReq) (*api.ListFavoritesResp, error) {
+	if req.UserId <= 0 {
+		return &api.ListFavoritesResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID无效"),
+		}, nil
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	favorites, total, err := s.favoriteRepo.List(ctx, req.UserId, req.GetPriceDroppedOnly(), req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListFavoritesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询收藏失败"),
+		}, nil
+	}
+	ids := make([]int64, 0, len(favorites))
+	for _, f := range favorites {
+		ids = append(ids, f.ProductID)
+	}
+	products, err := s.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return &api.ListFavoritesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	byID := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+	result := make([]*api.Favorite, 0, len(favorites))
+	simpleProducts := make([]*api.SimpleProduct, 0, len(favorites))
+	for _, f := range favorites {
+		favorite := s.convertToAPIFavorite(f, byID[f.ProductID])
+		if favorite.Product != nil {
+			simpleProducts = append(simpleProducts, favorite.Product)
+		}
+		result = append(result, favorite)
+	}
+	s.localizeSimpleProducts(ctx, simpleProducts)
+	return &api.ListFavoritesResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("查询成功"),
+		Total:     int32(total),
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Favorites: result,
+	}, nil
+}
+
+// 商品已删除时不算降价
+func (s *productServiceImpl) convertToAPIFavorite(f *model.Favorite, product *model.Product) *api.Favorite {
+	favorite := &api.Favorite{
+		ProductId:  f.ProductID,
+		SavedPrice: f.SavedPrice,
+		CreatedAt:  f.CreatedAt,
+	}
+	if product != nil {
+		favorite.Product = s.convertToAPISimpleProduct(product)
+		favorite.PriceDropped = product.Status != model.ProductStatusDELETED && product.Price < f.SavedPrice
+	}
+	return favorite
+}
@@ -0,0 +1,460 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// 单次导入的最大行数
+	maxImportRows = 5000
+	// 每个事务写入的行数
+	importBatchSize = 100
+)
+
+// 批量导入商品，按外部编码新建或更新；dryRun 时只校验不写入
+func (s *productServiceImpl) ImportProducts(ctx context.Context, req *api.ImportProductsReq) (*api.ImportProductsResp, error) {
+	if len(req.Rows) == 0 {
+		return &api.ImportProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("导入数据为空"),
+		}, nil
+	}
+	if len(req.Rows) > maxImportRows {
+		return &api.ImportProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("单次最多导入%d行", maxImportRows)),
+		}, nil
+	}
+	ctx = WithAuditSource(ctx, model.AuditSourceImport)
+	operator := defaultStockOperator
+	if req.Operator != nil && *req.Operator != "" {
+		operator = *req.Operator
+	}
+
+	resp := &api.ImportProductsResp{
+		Success: true,
+		Code:    0,
+		Total:   int32(len(req.Rows)),
+		Errors:  make([]*api.ImportRowError, 0),
+		DryRun:  req.DryRun,
+	}
+
+	// 先逐行校验，同一文件内外部编码重复的以第一行为准
+	seen := make(map[string]int32, len(req.Rows))
+	valid := make([]*api.ProductImportRow, 0, len(req.Rows))
+	for _, row := range req.Rows {
+		rowErrors := validateImportRow(row)
+		if len(rowErrors) == 0 {
+			if first, ok := seen[row.ExternalCode]; ok {
+				rowErrors = append(rowErrors, &api.ImportRowError{
+					Line:    row.Line,
+					Field:   "external_code",
+					Message: fmt.Sprintf("与第%d行外部编码重复", first),
+				})
+			} else {
+				seen[row.ExternalCode] = row.Line
+			}
+		}
+		if len(rowErrors) > 0 {
+			resp.Errors = append(resp.Errors, rowErrors...)
+			resp.Failed++
+			continue
+		}
+		valid = append(valid, row)
+	}
+
+	// 按名称或别名匹配品牌，未匹配的名称新建品牌；dryRun 时不新建
+	brands, err := s.findOrCreateBrands(ctx, importBrandNames(valid), !req.DryRun)
+	if err != nil {
+		fmt.Printf("匹配导入品牌失败: %v\n", err)
+		return &api.ImportProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("匹配品牌失败"),
+		}, nil
+	}
+	enabled := valid[:0]
+	for _, row := range valid {
+		if row.Brand != nil {
+			if brand := brands[model.NormalizeBrandKey(*row.Brand)]; brand != nil && brand.Status == model.BrandStatusDISABLED {
+				resp.Errors = append(resp.Errors, &api.ImportRowError{
+					Line:    row.Line,
+					Field:   "brand",
+					Message: fmt.Sprintf("品牌%s已停用", brand.Name),
+				})
+				resp.Failed++
+				continue
+			}
+		}
+		enabled = append(enabled, row)
+	}
+	valid = enabled
+
+	for start := 0; start < len(valid); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		batch := valid[start:end]
+		created, updated, rowErrors, err := s.importBatch(ctx, batch, brands, operator, req.DryRun)
+		if err != nil {
+			fmt.Printf("批量导入商品失败: lines=%d-%d, err=%v\n", batch[0].Line, batch[len(batch)-1].Line, err)
+			message := "所在批次写入失败，已整体回滚"
+			if errors.Is(err, repository.ErrVersionConflict) {
+				message = "所在批次有商品被并发修改，已整体回滚，请重新导入"
+			}
+			for _, row := range batch {
+				resp.Errors = append(resp.Errors, &api.ImportRowError{
+					Line:    row.Line,
+					Field:   "",
+					Message: message,
+				})
+			}
+			resp.Failed += int32(len(batch))
+			continue
+		}
+		resp.Created += created
+		resp.Updated += updated
+		resp.Errors = append(resp.Errors, rowErrors...)
+		resp.Failed += int32(len(batch)) - created - updated
+	}
+
+	if req.DryRun {
+		resp.Message = stringPtr("校验完成，未写入数据")
+	} else {
+		resp.Message = stringPtr("导入完成")
+	}
+	return resp, nil
+}
+
+// 需要审核后生效的导入修改
+type importRevision struct {
+	line    int32
+	product *model.Product
+	fields  *model.ProductRevisionFields
+}
+
+// 处理一批已校验的行，返回新建和更新的数量，以及因审核限制跳过的行
+// 已审核商品的名称、分类、图片和品牌变化生成待审核修改，其余字段直接写入
+func (s *productServiceImpl) importBatch(ctx context.Context, rows []*api.ProductImportRow,
+	brands map[string]*model.Brand, operator string, dryRun bool) (int32, int32, []*api.ImportRowError, error) {
+	codes := make([]string, 0, len(rows))
+	for _, row := range rows {
+		codes = append(codes, row.ExternalCode)
+	}
+	existing, err := s.productRepo.FindByExternalCodes(ctx, codes)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	now := time.Now().Unix()
+	items := make([]*repository.ProductImportItem, 0, len(rows))
+	oldStocks := make(map[int64]int32)
+	snapshots := make(map[int64]model.Product) //更新前快照，用于审计
+	rowErrors := make([]*api.ImportRowError, 0)
+	revisions := make([]*importRevision, 0)
+	var created, updated int32
+	for _, row := range rows {
+		product, ok := existing[row.ExternalCode]
+		listed := row.Status != nil && isListedStatus(model.ProductStatus(*row.Status))
+		if !ok {
+			if listed {
+				rowErrors = append(rowErrors, &api.ImportRowError{
+					Line:    row.Line,
+					Field:   "status",
+					Message: "新商品需提交审核，审核通过后才能上架",
+				})
+				continue
+			}
+			code := row.ExternalCode
+			product = &model.Product{
+				Name:         row.Name,
+				Category:     row.Category,
+				Price:        row.Price,
+				Stock:        row.Stock,
+				Status:       model.ProductStatusDRAFT,
+				ExternalCode: &code,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+				Version:      1,
+			}
+			applyImportOptionalFields(product, row, brands, nil)
+			item := &repository.ProductImportItem{Product: product, Created: true}
+			if row.Stock > 0 {
+				item.Movement = &model.StockMovement{
+					Delta:     row.Stock,
+					Reason:    model.StockChangeImport,
+					Operator:  operator,
+					CreatedAt: now,
+				}
+			}
+			items = append(items, item)
+			created++
+			continue
+		}
+
+		if listed && product.ApprovalStatus != model.ApprovalStatusAPPROVED {
+			rowErrors = append(rowErrors, &api.ImportRowError{
+				Line:    row.Line,
+				Field:   "status",
+				Message: "商品未通过审核，不能上架",
+			})
+			continue
+		}
+		if row.Stock != product.Stock && product.Type == model.ProductTypeBUNDLE {
+			rowErrors = append(rowErrors, &api.ImportRowError{
+				Line:    row.Line,
+				Field:   "stock",
+				Message: "套装库存由组件库存决定，不能导入",
+			})
+			continue
+		}
+		var revision *importRevision
+		if product.ApprovalStatus == model.ApprovalStatusAPPROVED || product.ApprovalStatus == model.ApprovalStatusPENDING {
+			if fields := importRevisionFields(product, row, brands); fields != nil {
+				code, msg := s.checkRevision(ctx, product, fields)
+				if code == 500 {
+					return 0, 0, nil, errors.New(msg)
+				}
+				if code != 0 {
+					rowErrors = append(rowErrors, &api.ImportRowError{
+						Line:    row.Line,
+						Field:   "",
+						Message: msg,
+					})
+					continue
+				}
+				revision = &importRevision{line: row.Line, product: product, fields: fields}
+				revisions = append(revisions, revision)
+				//内容字段保持不变，其余字段照常写入
+				unchanged := *row
+				unchanged.Name, unchanged.Category = product.Name, product.Category
+				unchanged.Avatar, unchanged.Brand = nil, nil
+				row = &unchanged
+			}
+		}
+
+		if _, ok := snapshots[product.ID]; !ok {
+			snapshots[product.ID] = *product
+		}
+		item := &repository.ProductImportItem{
+			Product: product,
+			Update: &repository.ProductUpdate{
+				ID:       product.ID,
+				Version:  product.Version,
+				Expected: make(map[string]interface{}),
+			},
+		}
+		if row.Price != product.Price {
+			item.Update.Expected["price"] = product.Price
+			item.PriceChange = &model.PriceHistory{
+				OldPrice:  product.Price,
+				NewPrice:  row.Price,
+				Source:    model.PriceSourceImport,
+				Operator:  operator,
+				CreatedAt: now,
+			}
+		}
+		if row.Stock != product.Stock {
+			item.Movement = &model.StockMovement{
+				Delta:     row.Stock - product.Stock,
+				Reason:    model.StockChangeImport,
+				Operator:  operator,
+				CreatedAt: now,
+			}
+			oldStocks[product.ID] = product.Stock
+			item.Update.Expected["stock"] = product.Stock
+		}
+		product.Name = row.Name
+		product.Category = row.Category
+		product.Price = row.Price
+		product.Stock = row.Stock
+		product.UpdatedAt = now
+		product.Version++
+		item.Update.Fields = map[string]interface{}{
+			"name":       product.Name,
+			"category":   product.Category,
+			"price":      product.Price,
+			"stock":      product.Stock,
+			"updated_at": product.UpdatedAt,
+		}
+		applyImportOptionalFields(product, row, brands, item.Update.Fields)
+		items = append(items, item)
+		updated++
+	}
+
+	if dryRun {
+		return created, updated, rowErrors, nil
+	}
+	if len(items) > 0 {
+		if err := s.productRepo.ImportBatch(ctx, items); err != nil {
+			return 0, 0, nil, err
+		}
+	}
+
+	for _, item := range items {
+		if item.Created {
+			s.auditProductChange(ctx, model.AuditActionCreate, operator, nil, item.Product, "批量导入")
+			continue
+		}
+		if before, ok := snapshots[item.Product.ID]; ok {
+			// 同一商品在批次内出现多次时只记录一次完整变更
+			delete(snapshots, item.Product.ID)
+			s.auditProductChange(ctx, model.AuditActionUpdate, operator, &before, item.Product, "批量导入")
+		}
+		s.publishEvent(ctx, item.Product.ID, model.ProductEventUpdated)
+		if oldStock, ok := oldStocks[item.Product.ID]; ok {
+			s.publishEvent(ctx, item.Product.ID, model.ProductEventStockChanged)
+			s.onStockChanged(ctx, item.Product.ID, oldStock, item.Product.Stock)
+		}
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Product.ID)
+	}
+	if len(ids) > 0 {
+		s.invalidateProductCache(ctx, ids...)
+	}
+	for _, revision := range revisions {
+		if _, _, msg := s.submitRevision(ctx, revision.product, revision.fields, operator); msg != "" {
+			rowErrors = append(rowErrors, &api.ImportRowError{
+				Line:    revision.line,
+				Field:   "",
+				Message: "其余字段已更新，内容修改提交审核失败：" + msg,
+			})
+		}
+	}
+	return created, updated, rowErrors, nil
+}
+
+// 导入行相对已审核商品的内容变化，没有变化时返回nil
+func importRevisionFields(product *model.Product, row *api.ProductImportRow,
+	brands map[string]*model.Brand) *model.ProductRevisionFields {
+	fields := &model.ProductRevisionFields{}
+	if row.Name != product.Name {
+		fields.Name = &row.Name
+	}
+	if row.Category != product.Category {
+		fields.Category = &row.Category
+	}
+	if row.Avatar != nil && *row.Avatar != product.Avatar {
+		fields.Avatar = row.Avatar
+	}
+	if row.Brand != nil {
+		name := strings.TrimSpace(*row.Brand)
+		switch brand := brands[model.NormalizeBrandKey(name)]; {
+		case brand != nil:
+			if brand.ID != product.BrandID {
+				fields.BrandID = &brand.ID
+			}
+		case name == "":
+			if product.BrandID != 0 || product.Brand != "" {
+				var none int64
+				fields.BrandID = &none
+			}
+		case name != product.Brand:
+			//dryRun 下未匹配的品牌名
+			fields.Brand = &name
+		}
+	}
+	if fields.Empty() {
+		return nil
+	}
+	return fields
+}
+
+// 导入行中出现的品牌名
+func importBrandNames(rows []*api.ProductImportRow) []string {
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Brand != nil {
+			names = append(names, *row.Brand)
+		}
+	}
+	return names
+}
+
+// 写入导入行中的可选字段
+// fields不为空时同时记录需要更新的列
+func applyImportOptionalFields(product *model.Product, row *api.ProductImportRow,
+	brands map[string]*model.Brand, fields map[string]interface{}) {
+	if row.Brand != nil {
+		//匹配到品牌时统一使用品牌名，dryRun 下未匹配的名称原样保留
+		product.Brand = strings.TrimSpace(*row.Brand)
+		product.BrandID = 0
+		if brand := brands[model.NormalizeBrandKey(*row.Brand)]; brand != nil {
+			product.Brand = brand.Name
+			product.BrandID = brand.ID
+		}
+		if fields != nil {
+			fields["brand"] = product.Brand
+			fields["brand_id"] = product.BrandID
+		}
+	}
+	if row.Avatar != nil {
+		product.Avatar = *row.Avatar
+		if fields != nil {
+			fields["avatar"] = product.Avatar
+		}
+	}
+	if row.Status != nil {
+		product.Status = model.ProductStatus(*row.Status)
+		product.AutoDelisted = false
+		if fields != nil {
+			fields["status"] = product.Status
+			fields["auto_delisted"] = false
+		}
+	}
+}
+
+// 校验导入行
+func validateImportRow(row *api.ProductImportRow) []*api.ImportRowError {
+	var errs []*api.ImportRowError
+	addErr := func(field, message string) {
+		errs = append(errs, &api.ImportRowError{
+			Line:    row.Line,
+			Field:   field,
+			Message: message,
+		})
+	}
+	if row.ExternalCode == "" {
+		addErr("external_code", "外部编码不能为空")
+	} else if utf8.RuneCountInString(row.ExternalCode) > 64 {
+		addErr("external_code", "外部编码不能超过64个字符")
+	}
+	if row.Name == "" {
+		addErr("name", "商品名称不能为空")
+	} else if utf8.RuneCountInString(row.Name) > 255 {
+		addErr("name", "商品名称不能超过255个字符")
+	}
+	if row.Category == "" {
+		addErr("category", "商品分类不能为空")
+	}
+	if row.Price <= 0 {
+		addErr("price", "商品价格必须大于0")
+	}
+	if row.Stock < 0 {
+		addErr("stock", "库存不能为负数")
+	}
+	if row.Status != nil {
+		switch model.ProductStatus(*row.Status) {
+		case model.ProductStatusDRAFT, model.ProductStatusONLINE, model.ProductStatusOFFLINE:
+		default:
+			addErr("status", "导入只支持草稿、上架、下架状态")
+		}
+	}
+	if row.Avatar != nil && utf8.RuneCountInString(*row.Avatar) > 500 {
+		addErr("image_url", "图片地址不能超过500个字符")
+	}
+	return errs
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 商家名称的长度上限
+const maxMerchantNameLength = 100
+
+// 创建商家
+func (s *productServiceImpl) CreateMerchant(ctx context.Context, req *api.CreateMerchantReq) (*api.CreateMerchantResp, error) {
+	merchant := &model.Merchant{
+		Name:   strings.TrimSpace(req.Name),
+		Status: model.MerchantStatusACTIVE,
+	}
+	if req.ContactName != nil {
+		merchant.ContactName = strings.TrimSpace(*req.ContactName)
+	}
+	if req.ContactPhone != nil {
+		merchant.ContactPhone = strings.TrimSpace(*req.ContactPhone)
+	}
+	if msg := validateMerchant(merchant); msg != "" {
+		return &api.CreateMerchantResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	existing, err := s.merchantRepo.FindByName(ctx, merchant.Name)
+	if err != nil {
+		return &api.CreateMerchantResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商家失败"),
+		}, nil
+	}
+	if existing != nil {
+		return &api.CreateMerchantResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("商家名称已存在"),
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	merchant.CreatedAt = now
+	merchant.UpdatedAt = now
+	if err := s.merchantRepo.Create(ctx, merchant); err != nil {
+		fmt.Printf("创建商家失败: name=%s, err=%v\n", merchant.Name, err)
+		return &api.CreateMerchantResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("创建商家失败"),
+		}, nil
+	}
+	return &api.CreateMerchantResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("创建成功"),
+		Merchant: convertToAPIMerchant(merchant),
+	}, nil
+}
+
+// 更新商家，停用后不能再新建商品或上架，已上架商品不受影响
+func (s *productServiceImpl) UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq) (*api.UpdateMerchantResp, error) {
+	merchant, err := s.merchantRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		return &api.UpdateMerchantResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商家失败"),
+		}, nil
+	}
+	if merchant == nil {
+		return &api.UpdateMerchantResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商家不存在"),
+		}, nil
+	}
+	oldName := merchant.Name
+	if req.Name != nil {
+		merchant.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.ContactName != nil {
+		merchant.ContactName = strings.TrimSpace(*req.ContactName)
+	}
+	if req.ContactPhone != nil {
+		merchant.ContactPhone = strings.TrimSpace(*req.ContactPhone)
+	}
+	if req.Status != nil {
+		merchant.Status = model.MerchantStatus(*req.Status)
+	}
+	if msg := validateMerchant(merchant); msg != "" {
+		return &api.UpdateMerchantResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	if merchant.Name != oldName {
+		existing, err := s.merchantRepo.FindByName(ctx, merchant.Name)
+		if err != nil {
+			return &api.UpdateMerchantResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询商家失败"),
+			}, nil
+		}
+		if existing != nil && existing.ID != merchant.ID {
+			return &api.UpdateMerchantResp{
+				Success: false,
+				Code:    409,
+				Message: stringPtr("商家名称已存在"),
+			}, nil
+		}
+	}
+
+	merchant.UpdatedAt = time.Now().Unix()
+	if err := s.merchantRepo.Update(ctx, merchant); err != nil {
+		fmt.Printf("更新商家失败: merchant=%d, err=%v\n", merchant.ID, err)
+		return &api.UpdateMerchantResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("更新商家失败"),
+		}, nil
+	}
+	return &api.UpdateMerchantResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("更新成功"),
+		Merchant: convertToAPIMerchant(merchant),
+	}, nil
+}
+
+// 查询商家
+func (s *productServiceImpl) GetMerchant(ctx context.Context, id int64) (*api.GetMerchantResp, error) {
+	merchant, err := s.merchantRepo.FindByID(ctx, id)
+	if err != nil {
+		return &api.GetMerchantResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商家失败"),
+		}, nil
+	}
+	if merchant == nil {
+		return &api.GetMerchantResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商家不存在"),
+		}, nil
+	}
+	return &api.GetMerchantResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Merchant: convertToAPIMerchant(merchant),
+	}, nil
+}
+
+// 分页查询商家
+func (s *productServiceImpl) ListMerchants(ctx context.Context, req *api.ListMerchantsReq) (*api.ListMerchantsResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	var status *model.MerchantStatus
+	if req.Status != nil {
+		value := model.MerchantStatus(*req.Status)
+		status = &value
+	}
+	merchants, total, err := s.merchantRepo.List(ctx, req.Keyword, status, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListMerchantsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商家失败"),
+		}, nil
+	}
+	items := make([]*api.Merchant, 0, len(merchants))
+	for _, m := range merchants {
+		items = append(items, convertToAPIMerchant(m))
+	}
+	return &api.ListMerchantsResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("查询成功"),
+		Total:     int32(total),
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Merchants: items,
+	}, nil
+}
+
+// 校验商家可以经营商品，不能时返回错误码和原因
+func (s *productServiceImpl) checkMerchantActive(ctx context.Context, merchantID int64) (int32, string) {
+	merchant, err := s.merchantRepo.FindByID(ctx, merchantID)
+	if err != nil {
+		fmt.Printf("查询商家失败: merchant=%d, err=%v\n", merchantID, err)
+		return 500, "查询商家失败"
+	}
+	if merchant == nil {
+		return 400, "商家不存在"
+	}
+	if merchant.Status != model.MerchantStatusACTIVE {
+		return 403, "商家已停用"
+	}
+	return 0, ""
+}
+
+// 校验商家字段
+func validateMerchant(merchant *model.Merchant) string {
+	if merchant.Name == "" {
+		return "商家名称不能为空"
+	}
+	if utf8.RuneCountInString(merchant.Name) > maxMerchantNameLength {
+		return fmt.Sprintf("商家名称不能超过%d个字符", maxMerchantNameLength)
+	}
+	if utf8.RuneCountInString(merchant.ContactName) > 50 {
+		return "联系人不能超过50个字符"
+	}
+	if utf8.RuneCountInString(merchant.ContactPhone) > 20 {
+		return "联系电话不能超过20个字符"
+	}
+	switch merchant.Status {
+	case model.MerchantStatusACTIVE, model.MerchantStatusDISABLED:
+	default:
+		return "商家状态无效"
+	}
+	return ""
+}
+
+// 模型转化
+func convertToAPIMerchant(m *model.Merchant) *api.Merchant {
+	return &api.Merchant{
+		Id:           m.ID,
+		Name:         m.Name,
+		ContactName:  m.ContactName,
+		ContactPhone: m.ContactPhone,
+		Status:       api.MerchantStatus(m.Status),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
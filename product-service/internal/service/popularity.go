@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// 热门商品数量上限
+	defaultTopProductsLimit = 10
+	maxTopProductsLimit     = 50
+
+	// 商品热度字段统计的时间范围，计数桶保留到最长查询窗口
+	popularityScoreWindow = 7 * 24 * time.Hour
+	popularityRetention   = 30 * 24 * time.Hour
+
+	// 热门商品缓存时长，计数本身按批写入，不需要实时
+	topProductsCacheTTL = time.Minute
+)
+
+// 热门商品的查询窗口
+var topProductsWindows = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// 进程内的浏览和销量计数，由定时任务批量写入数据库，进程退出前未写入的计数会丢失
+type popularityCounter struct {
+	mu    sync.Mutex
+	views map[int64]int64
+	sales map[int64]int64
+}
+
+func newPopularityCounter() *popularityCounter {
+	return &popularityCounter{
+		views: make(map[int64]int64),
+		sales: make(map[int64]int64),
+	}
+}
+
+func (c *popularityCounter) add(views, sales map[int64]int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id, count := range views {
+		c.views[id] += count
+	}
+	for id, count := range sales {
+		c.sales[id] += count
+	}
+}
+
+// 取出当前全部计数并清空
+func (c *popularityCounter) drain() (map[int64]int64, map[int64]int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	views, sales := c.views, c.sales
+	c.views = make(map[int64]int64)
+	c.sales = make(map[int64]int64)
+	return views, sales
+}
+
+// 记录一次商品详情浏览
+func (s *productServiceImpl) RecordProductView(productID int64) {
+	s.popularity.add(map[int64]int64{productID: 1}, nil)
+}
+
+// 记录已支付订单的商品销量
+func (s *productServiceImpl) RecordProductSales(ctx context.Context, req *api.RecordProductSalesReq) (*api.RecordProductSalesResp, error) {
+	sales := make(map[int64]int64, len(req.Sales))
+	for _, sale := range req.Sales {
+		if sale.ProductId <= 0 || sale.Quantity <= 0 {
+			return &api.RecordProductSalesResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("商品ID和销量必须大于0"),
+			}, nil
+		}
+		sales[sale.ProductId] += int64(sale.Quantity)
+	}
+	s.popularity.add(nil, sales)
+	return &api.RecordProductSalesResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("记录成功"),
+	}, nil
+}
+
+// 将内存中的计数写入当前小时的计数桶，由定时任务调用
+func (s *productServiceImpl) FlushPopularityCounters(ctx context.Context) error {
+	views, sales := s.popularity.drain()
+	if len(views) == 0 && len(sales) == 0 {
+		return nil
+	}
+	hour := time.Now().Truncate(time.Hour).Unix()
+	buckets := make(map[int64]*model.ProductPopularityBucket, len(views))
+	bucketOf := func(id int64) *model.ProductPopularityBucket {
+		bucket, ok := buckets[id]
+		if !ok {
+			bucket = &model.ProductPopularityBucket{ProductID: id, BucketHour: hour}
+			buckets[id] = bucket
+		}
+		return bucket
+	}
+	for id, count := range views {
+		bucketOf(id).ViewCount += count
+	}
+	for id, count := range sales {
+		bucketOf(id).SalesCount += count
+	}
+	rows := make([]*model.ProductPopularityBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		rows = append(rows, bucket)
+	}
+	if err := s.popularityRepo.IncrementBuckets(ctx, rows); err != nil {
+		//写入失败时计数放回，下次一起写入
+		s.popularity.add(views, sales)
+		return fmt.Errorf("写入商品热度计数失败: %w", err)
+	}
+	return nil
+}
+
+// 重新计算商品热度字段并清理过期计数，由定时任务调用
+func (s *productServiceImpl) RefreshPopularity(ctx context.Context) error {
+	now := time.Now()
+	since := now.Add(-popularityScoreWindow).Truncate(time.Hour).Unix()
+	stats, err := s.popularityRepo.TopProducts(ctx, since, nil, repository.PopularitySortByScore, 0)
+	if err != nil {
+		return fmt.Errorf("汇总商品热度失败: %w", err)
+	}
+	scores := make(map[int64]float64, len(stats))
+	for _, stat := range stats {
+		scores[stat.ProductID] = stat.Score
+	}
+	if err := s.popularityRepo.UpdateProductPopularity(ctx, scores); err != nil {
+		return fmt.Errorf("更新商品热度失败: %w", err)
+	}
+	if err := s.popularityRepo.PurgeBuckets(ctx, now.Add(-popularityRetention).Truncate(time.Hour).Unix()); err != nil {
+		return fmt.Errorf("清理过期热度计数失败: %w", err)
+	}
+	return nil
+}
+
+// 查询时间窗口内的热门商品
+func (s *productServiceImpl) GetTopProducts(ctx context.Context, req *api.GetTopProductsReq) (*api.GetTopProductsResp, error) {
+	if req.Window == "" {
+		req.Window = "24h"
+	}
+	window, ok := topProductsWindows[req.Window]
+	if !ok {
+		return &api.GetTopProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("不支持的时间窗口"),
+		}, nil
+	}
+	sortBy := req.GetSortBy()
+	switch sortBy {
+	case "", repository.PopularitySortByScore, repository.PopularitySortByViews, repository.PopularitySortBySales:
+	default:
+		return &api.GetTopProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("不支持的排序方式"),
+		}, nil
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultTopProductsLimit
+	}
+	if req.Limit > maxTopProductsLimit {
+		req.Limit = maxTopProductsLimit
+	}
+
+	products, err := s.findTopProductsCached(ctx, req, window)
+	if err != nil {
+		fmt.Printf("查询热门商品失败: window=%s, err=%v\n", req.Window, err)
+		return &api.GetTopProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询热门商品失败"),
+		}, nil
+	}
+	simpleProducts := make([]*api.SimpleProduct, 0, len(products))
+	for _, p := range products {
+		simpleProducts = append(simpleProducts, p.Product)
+	}
+	s.localizeSimpleProducts(ctx, simpleProducts)
+	return &api.GetTopProductsResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Window:   req.Window,
+		Products: products,
+	}, nil
+}
+
+// 读穿缓存查询热门商品
+func (s *productServiceImpl) findTopProductsCached(ctx context.Context, req *api.GetTopProductsReq, window time.Duration) ([]*api.TopProduct, error) {
+	load := func(ctx context.Context) ([]*api.TopProduct, error) {
+		since := time.Now().Add(-window).Truncate(time.Hour).Unix()
+		stats, err := s.popularityRepo.TopProducts(ctx, since, req.Category, req.GetSortBy(), int(req.Limit))
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int64, 0, len(stats))
+		for _, stat := range stats {
+			ids = append(ids, stat.ProductID)
+		}
+		products, err := s.productRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[int64]*model.Product, len(products))
+		for _, p := range products {
+			byID[p.ID] = p
+		}
+		result := make([]*api.TopProduct, 0, len(stats))
+		for _, stat := range stats {
+			p, ok := byID[stat.ProductID]
+			if !ok {
+				continue
+			}
+			result = append(result, &api.TopProduct{
+				Product:    s.convertToAPISimpleProduct(p),
+				ViewCount:  stat.ViewCount,
+				SalesCount: stat.SalesCount,
+				Score:      stat.Score,
+			})
+		}
+		return result, nil
+	}
+
+	if s.cacheLoader == nil {
+		return load(ctx)
+	}
+	key := fmt.Sprintf("product:top:%s:%s:%s:%d", req.Window, req.GetSortBy(), req.GetCategory(), req.Limit)
+	data, err := s.cacheLoader.Get(ctx, key, func(ctx context.Context) ([]byte, time.Duration, error) {
+		result, err := load(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		data, err := json.Marshal(result)
+		return data, topProductsCacheTTL, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []*api.TopProduct
+	if err := json.Unmarshal(data, &result); err != nil {
+		fmt.Printf("解析热门商品缓存失败: key=%s, err=%v\n", key, err)
+		s.cache.Delete(ctx, key)
+		return load(ctx)
+	}
+	return result, nil
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"time"
+)
+
+// 每轮处理的调价计划数量
+const priceScheduleBatchSize = 100
+
+// 创建调价计划
+func (s *productServiceImpl) CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq) (*api.CreatePriceScheduleResp, error) {
+	if req.Price <= 0 {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品价格必须大于0"),
+		}, nil
+	}
+	now := time.Now().Unix()
+	if req.StartAt <= 0 {
+		req.StartAt = now
+	}
+	endAt := req.GetEndAt()
+	if endAt > 0 && endAt <= req.StartAt {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("结束时间必须晚于开始时间"),
+		}, nil
+	}
+	if endAt > 0 && endAt <= now {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("结束时间已过"),
+		}, nil
+	}
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	overlap, err := s.priceRepo.HasOverlap(ctx, req.ProductId, req.StartAt, endAt)
+	if err != nil {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询调价计划失败"),
+		}, nil
+	}
+	if overlap {
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("该时间段已有调价计划"),
+		}, nil
+	}
+	schedule := &model.PriceSchedule{
+		ProductID: req.ProductId,
+		Price:     req.Price,
+		StartAt:   req.StartAt,
+		EndAt:     endAt,
+		Status:    model.PriceScheduleStatusPending,
+		Operator:  defaultStockOperator,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if req.Operator != nil && *req.Operator != "" {
+		schedule.Operator = *req.Operator
+	}
+	if err := s.priceRepo.CreateSchedule(ctx, schedule); err != nil {
+		fmt.Printf("创建调价计划失败: %v\n", err)
+		return &api.CreatePriceScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("创建调价计划失败"),
+		}, nil
+	}
+	return &api.CreatePriceScheduleResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("创建成功"),
+		Schedule: s.convertToAPIPriceSchedule(schedule),
+	}, nil
+}
+
+// 查询调价计划
+func (s *productServiceImpl) ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq) (*api.ListPriceSchedulesResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	schedules, total, err := s.priceRepo.ListSchedules(ctx, req.ProductId, req.Status, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListPriceSchedulesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询调价计划失败"),
+		}, nil
+	}
+	apiSchedules := make([]*api.PriceSchedule, 0, len(schedules))
+	for _, sc := range schedules {
+		apiSchedules = append(apiSchedules, s.convertToAPIPriceSchedule(sc))
+	}
+	return &api.ListPriceSchedulesResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("查询成功"),
+		Total:     int32(total),
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Schedules: apiSchedules,
+	}, nil
+}
+
+// 取消调价计划，生效中的计划会立即恢复原价
+func (s *productServiceImpl) CancelPriceSchedule(ctx context.Context, id int64, operator *string) (*api.CancelPriceScheduleResp, error) {
+	schedule, err := s.priceRepo.FindScheduleByID(ctx, id)
+	if err != nil {
+		return &api.CancelPriceScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询调价计划失败"),
+		}, nil
+	}
+	if schedule == nil {
+		return &api.CancelPriceScheduleResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("调价计划不存在"),
+		}, nil
+	}
+	now := time.Now().Unix()
+	var cancelled bool
+	switch schedule.Status {
+	case model.PriceScheduleStatusPending:
+		cancelled, err = s.priceRepo.CancelPending(ctx, id, now)
+	case model.PriceScheduleStatusActive:
+		op := defaultStockOperator
+		if operator != nil && *operator != "" {
+			op = *operator
+		}
+		before, _ := s.productRepo.FindByID(ctx, schedule.ProductID)
+		cancelled, err = s.priceRepo.RevertSchedule(ctx, id, now, model.PriceScheduleStatusCancelled, op)
+		if err == nil && cancelled {
+			s.publishEvent(ctx, schedule.ProductID, model.ProductEventUpdated)
+			s.auditReloadedProduct(ctx, model.AuditActionPriceChange, op, before,
+				fmt.Sprintf("取消调价计划#%d并恢复原价", schedule.ID))
+			s.invalidateProductCache(ctx, schedule.ProductID)
+		}
+	}
+	if err != nil {
+		return &api.CancelPriceScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("取消调价计划失败"),
+		}, nil
+	}
+	if !cancelled {
+		return &api.CancelPriceScheduleResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("调价计划已结束，无法取消"),
+		}, nil
+	}
+	return &api.CancelPriceScheduleResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("取消成功"),
+	}, nil
+}
+
+// 查询价格历史
+func (s *productServiceImpl) GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq) (*api.GetPriceHistoryResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	history, total, err := s.priceRepo.ListHistory(ctx, req.ProductId, req.Page, req.PageSize)
+	if err != nil {
+		return &api.GetPriceHistoryResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询价格历史失败"),
+		}, nil
+	}
+	apiHistory := make([]*api.PriceHistory, 0, len(history))
+	for _, h := range history {
+		item := &api.PriceHistory{
+			Id:        h.ID,
+			ProductId: h.ProductID,
+			OldPrice:  h.OldPrice,
+			NewPrice_: h.NewPrice,
+			Source:    h.Source,
+			Operator:  h.Operator,
+			CreatedAt: h.CreatedAt,
+		}
+		if h.ScheduleID > 0 {
+			scheduleID := h.ScheduleID
+			item.ScheduleId = &scheduleID
+		}
+		apiHistory = append(apiHistory, item)
+	}
+	return &api.GetPriceHistoryResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		History:  apiHistory,
+	}, nil
+}
+
+// 执行到期的调价计划：先恢复已结束的，再使新计划生效
+func (s *productServiceImpl) ProcessPriceSchedules(ctx context.Context) error {
+	ctx = WithAuditSource(ctx, model.AuditSourceScheduler)
+	now := time.Now().Unix()
+
+	reverts, err := s.priceRepo.FindDueReverts(ctx, now, priceScheduleBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range reverts {
+		before, _ := s.productRepo.FindByID(ctx, schedule.ProductID)
+		applied, err := s.priceRepo.RevertSchedule(ctx, schedule.ID, now, model.PriceScheduleStatusCompleted, "scheduler")
+		if err != nil {
+			fmt.Printf("恢复调价计划失败: schedule=%d, err=%v\n", schedule.ID, err)
+			continue
+		}
+		if applied {
+			s.publishEvent(ctx, schedule.ProductID, model.ProductEventUpdated)
+			s.auditReloadedProduct(ctx, model.AuditActionPriceChange, "scheduler", before,
+				fmt.Sprintf("调价计划#%d到期恢复原价", schedule.ID))
+			s.invalidateProductCache(ctx, schedule.ProductID)
+		}
+	}
+
+	activations, err := s.priceRepo.FindDueActivations(ctx, now, priceScheduleBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range activations {
+		before, _ := s.productRepo.FindByID(ctx, schedule.ProductID)
+		applied, err := s.priceRepo.ActivateSchedule(ctx, schedule.ID, now)
+		if err != nil {
+			fmt.Printf("执行调价计划失败: schedule=%d, err=%v\n", schedule.ID, err)
+			continue
+		}
+		if applied {
+			s.publishEvent(ctx, schedule.ProductID, model.ProductEventUpdated)
+			s.auditReloadedProduct(ctx, model.AuditActionPriceChange, operatorOrDefault(&schedule.Operator), before,
+				fmt.Sprintf("调价计划#%d生效", schedule.ID))
+			s.invalidateProductCache(ctx, schedule.ProductID)
+		}
+	}
+	return nil
+}
+
+// 模型转化
+func (s *productServiceImpl) convertToAPIPriceSchedule(sc *model.PriceSchedule) *api.PriceSchedule {
+	return &api.PriceSchedule{
+		Id:            sc.ID,
+		ProductId:     sc.ProductID,
+		Price:         sc.Price,
+		StartAt:       sc.StartAt,
+		EndAt:         sc.EndAt,
+		Status:        sc.Status,
+		OriginalPrice: sc.OriginalPrice,
+		Operator:      sc.Operator,
+		CreatedAt:     sc.CreatedAt,
+		UpdatedAt:     sc.UpdatedAt,
+	}
+}
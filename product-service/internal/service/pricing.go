@@ -0,0 +1,484 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// 单个商品的阶梯价数量上限
+	maxPriceTiersPerProduct = 20
+	// 价格组名称的长度上限
+	maxPriceGroupLength = 50
+)
+
+type priceUserKey struct{}
+
+// 标记查看价格的用户，展示阶梯价时计入用户所在价格组的价格
+func WithPriceUser(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, priceUserKey{}, userID)
+}
+
+func priceUserFrom(ctx context.Context) int64 {
+	if userID, ok := ctx.Value(priceUserKey{}).(int64); ok {
+		return userID
+	}
+	return 0
+}
+
+// 计算购买quantity件时的单价：取商品价格和所有适用阶梯价中的最低价，返回命中的阶梯价，未命中时为nil
+// 阶梯价适用于所有用户（价格组为空）或userGroup所在价格组，且购买数量达到起购数量；搜索展示和下单计价共用
+func tieredUnitPrice(basePrice float64, tiers []*model.ProductPriceTier, quantity int32,
+	userGroup string) (float64, *model.ProductPriceTier) {
+	price := basePrice
+	var applied *model.ProductPriceTier
+	for _, tier := range tiers {
+		if tier.UserGroup != "" && tier.UserGroup != userGroup {
+			continue
+		}
+		if quantity < tier.MinQuantity || tier.Price >= price {
+			continue
+		}
+		price = tier.Price
+		applied = tier
+	}
+	return price, applied
+}
+
+// 用户在各起购数量下实际适用的单价，只保留比上一档更便宜的档位
+func effectivePriceTiers(basePrice float64, tiers []*model.ProductPriceTier, userGroup string) []*api.PriceTier {
+	quantities := make([]int32, 0, len(tiers))
+	for _, tier := range tiers {
+		if tier.UserGroup == "" || tier.UserGroup == userGroup {
+			quantities = append(quantities, tier.MinQuantity)
+		}
+	}
+	sort.Slice(quantities, func(i, j int) bool { return quantities[i] < quantities[j] })
+
+	var result []*api.PriceTier
+	last := basePrice
+	for i, quantity := range quantities {
+		if i > 0 && quantity == quantities[i-1] {
+			continue
+		}
+		price, applied := tieredUnitPrice(basePrice, tiers, quantity, userGroup)
+		if applied == nil || price >= last {
+			continue
+		}
+		result = append(result, convertToAPIPriceTier(&model.ProductPriceTier{
+			MinQuantity: quantity,
+			Price:       price,
+			UserGroup:   applied.UserGroup,
+		}))
+		last = price
+	}
+	return result
+}
+
+// 查询用户所在价格组，未登录或查询失败时按普通用户处理
+func (s *productServiceImpl) userPriceGroup(ctx context.Context, userID int64) string {
+	if userID <= 0 || s.pricingRepo == nil {
+		return ""
+	}
+	group, err := s.pricingRepo.FindUserGroup(ctx, userID)
+	if err != nil {
+		fmt.Printf("查询用户价格组失败: user=%d, err=%v\n", userID, err)
+		return ""
+	}
+	return group
+}
+
+// 查询商品阶梯价和当前用户的价格组，查询失败时不展示阶梯价
+func (s *productServiceImpl) loadPriceTiers(ctx context.Context, productIDs []int64) (map[int64][]*model.ProductPriceTier, string) {
+	if len(productIDs) == 0 || s.pricingRepo == nil {
+		return nil, ""
+	}
+	tiers, err := s.pricingRepo.FindTiersByProductIDs(ctx, productIDs)
+	if err != nil {
+		fmt.Printf("查询阶梯价失败: err=%v\n", err)
+		return nil, ""
+	}
+	if len(tiers) == 0 {
+		return nil, ""
+	}
+	return tiers, s.userPriceGroup(ctx, priceUserFrom(ctx))
+}
+
+// 为商品详情附加当前用户适用的阶梯价
+func (s *productServiceImpl) applyPriceTiers(ctx context.Context, products []*api.Product) {
+	ids := make([]int64, 0, len(products))
+	for _, p := range products {
+		if p != nil {
+			ids = append(ids, p.Id)
+		}
+	}
+	tiers, group := s.loadPriceTiers(ctx, ids)
+	if len(tiers) == 0 {
+		return
+	}
+	for _, p := range products {
+		if p != nil {
+			p.PriceTiers = effectivePriceTiers(p.Price, tiers[p.Id], group)
+		}
+	}
+}
+
+// 为商品列表附加当前用户适用的阶梯价
+func (s *productServiceImpl) applySimplePriceTiers(ctx context.Context, products []*api.SimpleProduct) {
+	ids := make([]int64, 0, len(products))
+	for _, p := range products {
+		if p != nil {
+			ids = append(ids, p.Id)
+		}
+	}
+	tiers, group := s.loadPriceTiers(ctx, ids)
+	if len(tiers) == 0 {
+		return
+	}
+	for _, p := range products {
+		if p != nil {
+			p.PriceTiers = effectivePriceTiers(p.Price, tiers[p.Id], group)
+		}
+	}
+}
+
+// 校验阶梯价：通用阶梯价的起购数量至少为2，价格组的起购数量为1时即该组的专属价；同一价格组内数量越多单价越低
+func validatePriceTiers(basePrice float64, tiers []*api.PriceTier) ([]*model.ProductPriceTier, string) {
+	if len(tiers) > maxPriceTiersPerProduct {
+		return nil, fmt.Sprintf("单个商品最多设置%d个阶梯价", maxPriceTiersPerProduct)
+	}
+	result := make([]*model.ProductPriceTier, 0, len(tiers))
+	seen := make(map[string]map[int32]bool)
+	for _, t := range tiers {
+		if t == nil {
+			continue
+		}
+		group := strings.TrimSpace(t.GetUserGroup())
+		if utf8.RuneCountInString(group) > maxPriceGroupLength {
+			return nil, fmt.Sprintf("价格组名称不能超过%d个字符", maxPriceGroupLength)
+		}
+		if group == "" && t.MinQuantity < 2 {
+			return nil, "通用阶梯价的起购数量至少为2"
+		}
+		if t.MinQuantity < 1 {
+			return nil, "起购数量必须大于0"
+		}
+		if t.Price <= 0 {
+			return nil, "阶梯价必须大于0"
+		}
+		if t.Price >= basePrice {
+			return nil, "阶梯价必须低于商品价格"
+		}
+		if seen[group] == nil {
+			seen[group] = make(map[int32]bool)
+		}
+		if seen[group][t.MinQuantity] {
+			return nil, fmt.Sprintf("起购数量重复: %d", t.MinQuantity)
+		}
+		seen[group][t.MinQuantity] = true
+		result = append(result, &model.ProductPriceTier{
+			UserGroup:   group,
+			MinQuantity: t.MinQuantity,
+			Price:       t.Price,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].UserGroup != result[j].UserGroup {
+			return result[i].UserGroup < result[j].UserGroup
+		}
+		return result[i].MinQuantity < result[j].MinQuantity
+	})
+	for i := 1; i < len(result); i++ {
+		if result[i].UserGroup == result[i-1].UserGroup && result[i].Price >= result[i-1].Price {
+			return nil, "同一价格组内起购数量越多，阶梯价应越低"
+		}
+	}
+	return result, ""
+}
+
+// 整体替换商品的阶梯价
+func (s *productServiceImpl) SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq) (*api.SetProductPriceTiersResp, error) {
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return &api.SetProductPriceTiersResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.SetProductPriceTiersResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	tiers, msg := validatePriceTiers(product.Price, req.Tiers)
+	if msg != "" {
+		return &api.SetProductPriceTiersResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	before, err := s.pricingRepo.FindTiers(ctx, product.ID)
+	if err != nil {
+		return &api.SetProductPriceTiersResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询阶梯价失败"),
+		}, nil
+	}
+	if err := s.pricingRepo.ReplaceTiers(ctx, product.ID, tiers); err != nil {
+		fmt.Printf("保存阶梯价失败: product=%d, err=%v\n", product.ID, err)
+		return &api.SetProductPriceTiersResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("保存阶梯价失败"),
+		}, nil
+	}
+	beforeText, afterText := formatPriceTiers(before), formatPriceTiers(tiers)
+	if beforeText != afterText {
+		s.recordAudit(ctx, product.ID, model.AuditActionUpdate, operatorOrDefault(req.Operator),
+			[]model.AuditChange{{Field: "price_tiers", Before: beforeText, After: afterText}}, "")
+	}
+	return &api.SetProductPriceTiersResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("设置成功"),
+		Tiers:   convertToAPIPriceTiers(tiers),
+	}, nil
+}
+
+// 查询商品设置的全部阶梯价
+func (s *productServiceImpl) GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq) (*api.GetProductPriceTiersResp, error) {
+	product, err := s.findProductCached(ctx, req.ProductId)
+	if err != nil {
+		return &api.GetProductPriceTiersResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.GetProductPriceTiersResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	tiers, err := s.pricingRepo.FindTiers(ctx, product.ID)
+	if err != nil {
+		return &api.GetProductPriceTiersResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询阶梯价失败"),
+		}, nil
+	}
+	return &api.GetProductPriceTiersResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("查询成功"),
+		Tiers:   convertToAPIPriceTiers(tiers),
+	}, nil
+}
+
+// 设置用户的价格组，group为空时移出价格组
+func (s *productServiceImpl) SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq) (*api.SetUserPriceGroupResp, error) {
+	if req.UserId <= 0 {
+		return &api.SetUserPriceGroupResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID无效"),
+		}, nil
+	}
+	group := strings.TrimSpace(req.Group)
+	if utf8.RuneCountInString(group) > maxPriceGroupLength {
+		return &api.SetUserPriceGroupResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("价格组名称不能超过%d个字符", maxPriceGroupLength)),
+		}, nil
+	}
+	if err := s.pricingRepo.SetUserGroup(ctx, req.UserId, group, time.Now().Unix()); err != nil {
+		fmt.Printf("设置用户价格组失败: user=%d, group=%s, err=%v\n", req.UserId, group, err)
+		return &api.SetUserPriceGroupResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("设置用户价格组失败"),
+		}, nil
+	}
+	message := "设置成功"
+	if group == "" {
+		message = "已移出价格组"
+	}
+	return &api.SetUserPriceGroupResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr(message),
+	}, nil
+}
+
+// 分页查询价格组成员
+func (s *productServiceImpl) ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq) (*api.ListUserPriceGroupsResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	members, total, err := s.pricingRepo.ListUserGroups(ctx, strings.TrimSpace(req.GetGroup()), req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListUserPriceGroupsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询价格组失败"),
+		}, nil
+	}
+	result := make([]*api.UserPriceGroup, 0, len(members))
+	for _, m := range members {
+		result = append(result, &api.UserPriceGroup{
+			UserId:    m.UserID,
+			Group:     m.Group,
+			UpdatedAt: m.UpdatedAt,
+		})
+	}
+	return &api.ListUserPriceGroupsResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Members:  result,
+	}, nil
+}
+
+// 按数量和用户价格组计算成交单价，结果与请求的商品项一一对应，下单时使用
+func (s *productServiceImpl) QuotePrices(ctx context.Context, req *api.QuotePricesReq) (*api.QuotePricesResp, error) {
+	if len(req.Items) == 0 {
+		return &api.QuotePricesResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品项不能为空"),
+		}, nil
+	}
+	if len(req.Items) > maxBatchGetProducts {
+		return &api.QuotePricesResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("单次最多计算%d个商品", maxBatchGetProducts)),
+		}, nil
+	}
+	ids := make([]int64, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item == nil || item.ProductId <= 0 || item.Quantity <= 0 {
+			return &api.QuotePricesResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("商品ID和数量必须大于0"),
+			}, nil
+		}
+		ids = append(ids, item.ProductId)
+	}
+	products, err := s.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return &api.QuotePricesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	byID := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		if p.Status != model.ProductStatusDELETED {
+			byID[p.ID] = p
+		}
+	}
+	tiers, err := s.pricingRepo.FindTiersByProductIDs(ctx, ids)
+	if err != nil {
+		return &api.QuotePricesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询阶梯价失败"),
+		}, nil
+	}
+	group := ""
+	if len(tiers) > 0 {
+		group, err = s.pricingRepo.FindUserGroup(ctx, req.UserId)
+		if err != nil {
+			return &api.QuotePricesResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询用户价格组失败"),
+			}, nil
+		}
+	}
+
+	quotes := make([]*api.PriceQuote, 0, len(req.Items))
+	for _, item := range req.Items {
+		product, ok := byID[item.ProductId]
+		if !ok {
+			return &api.QuotePricesResp{
+				Success: false,
+				Code:    404,
+				Message: stringPtr(fmt.Sprintf("商品不存在: %d", item.ProductId)),
+			}, nil
+		}
+		price, applied := tieredUnitPrice(product.Price, tiers[product.ID], item.Quantity, group)
+		quote := &api.PriceQuote{
+			ProductId: product.ID,
+			Quantity:  item.Quantity,
+			BasePrice: product.Price,
+			UnitPrice: price,
+		}
+		if applied != nil {
+			quote.AppliedTier = convertToAPIPriceTier(applied)
+		}
+		quotes = append(quotes, quote)
+	}
+	return &api.QuotePricesResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("计算成功"),
+		Quotes:  quotes,
+	}, nil
+}
+
+// 审计记录中的阶梯价，如 10:90.00,wholesale/1:80.00
+func formatPriceTiers(tiers []*model.ProductPriceTier) string {
+	parts := make([]string, 0, len(tiers))
+	for _, t := range tiers {
+		part := fmt.Sprintf("%d:%.2f", t.MinQuantity, t.Price)
+		if t.UserGroup != "" {
+			part = t.UserGroup + "/" + part
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ",")
+}
+
+func convertToAPIPriceTier(t *model.ProductPriceTier) *api.PriceTier {
+	tier := &api.PriceTier{
+		MinQuantity: t.MinQuantity,
+		Price:       t.Price,
+	}
+	if t.UserGroup != "" {
+		tier.UserGroup = stringPtr(t.UserGroup)
+	}
+	return tier
+}
+
+func convertToAPIPriceTiers(tiers []*model.ProductPriceTier) []*api.PriceTier {
+	result := make([]*api.PriceTier, 0, len(tiers))
+	for _, t := range tiers {
+		result = append(result, convertToAPIPriceTier(t))
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 限购周期最长天数
+const maxPurchaseLimitDays = 3650
+
+// 设置商品每人限购，限购数量由订单服务在下单时按用户未取消的订单校验
+func (s *productServiceImpl) SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq) (*api.SetProductPurchaseLimitResp, error) {
+	if req.Limit < 0 || req.WindowDays < 0 || req.WindowDays > maxPurchaseLimitDays {
+		return &api.SetProductPurchaseLimitResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("限购数量不能为负数，限购周期为0-%d天", maxPurchaseLimitDays)),
+		}, nil
+	}
+	if req.Limit == 0 && req.WindowDays > 0 {
+		return &api.SetProductPurchaseLimitResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("取消限购时不能设置限购周期"),
+		}, nil
+	}
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return &api.SetProductPurchaseLimitResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.SetProductPurchaseLimitResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+
+	before := formatPurchaseLimit(product.PurchaseLimit, product.PurchaseLimitDays)
+	update := &repository.ProductUpdate{
+		ID:      product.ID,
+		Version: product.Version,
+		Fields: map[string]interface{}{
+			"purchase_limit":      req.Limit,
+			"purchase_limit_days": req.WindowDays,
+			"updated_at":          time.Now().Unix(),
+		},
+	}
+	err = s.productRepo.Update(ctx, update, nil, nil, nil)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return &api.SetProductPurchaseLimitResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("商品已被修改，请重试"),
+		}, nil
+	}
+	if err != nil {
+		fmt.Printf("保存每人限购失败: product=%d, err=%v\n", product.ID, err)
+		return &api.SetProductPurchaseLimitResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("保存每人限购失败"),
+		}, nil
+	}
+	product.PurchaseLimit, product.PurchaseLimitDays = req.Limit, req.WindowDays
+	product.Version++
+	if after := formatPurchaseLimit(req.Limit, req.WindowDays); after != before {
+		s.recordAudit(ctx, product.ID, model.AuditActionUpdate, operatorOrDefault(req.Operator),
+			[]model.AuditChange{{Field: "purchase_limit", Before: before, After: after}}, "")
+	}
+	s.publishEvent(ctx, product.ID, model.ProductEventUpdated)
+	s.invalidateProductCache(ctx, product.ID)
+	return &api.SetProductPurchaseLimitResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("设置成功"),
+		Product: s.convertToAPIProduct(product),
+	}, nil
+}
+
+// 审计日志中的限购设置，如 2/30d，终身限购为 2，不限购为空
+func formatPurchaseLimit(limit, windowDays int32) string {
+	if limit <= 0 {
+		return ""
+	}
+	if windowDays <= 0 {
+		return fmt.Sprintf("%d", limit)
+	}
+	return fmt.Sprintf("%d/%dd", limit, windowDays)
+}
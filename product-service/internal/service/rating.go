@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+)
+
+// 更新商品评分汇总，由订单服务在评价审核状态变化后按全量结果写入
+func (s *productServiceImpl) UpdateProductRating(ctx context.Context, req *api.UpdateProductRatingReq) (*api.UpdateProductRatingResp, error) {
+	if req.RatingAvg < 0 || req.RatingAvg > 5 || req.RatingCount < 0 {
+		return &api.UpdateProductRatingResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("评分数据无效"),
+		}, nil
+	}
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return &api.UpdateProductRatingResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil {
+		return &api.UpdateProductRatingResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	if err := s.productRepo.UpdateRating(ctx, req.ProductId, req.RatingAvg, req.RatingCount); err != nil {
+		fmt.Printf("更新商品评分失败: product=%d, err=%v\n", req.ProductId, err)
+		return &api.UpdateProductRatingResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("更新商品评分失败"),
+		}, nil
+	}
+	s.publishEvent(ctx, req.ProductId, model.ProductEventUpdated)
+	s.invalidateProductCache(ctx, req.ProductId)
+	return &api.UpdateProductRatingResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("更新成功"),
+	}, nil
+}
+
+// 排序方式是否支持，为空时按最新排序
+func isValidSortBy(sortBy string) bool {
+	switch sortBy {
+	case "", repository.SortByNewest, repository.SortByRating, repository.SortByRatingCount,
+		repository.SortByPriceAsc, repository.SortByPriceDesc, repository.SortByPopularity:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// 每个商品每类推荐保存的数量，读取时过滤掉无库存商品后再截断
+	maxStoredRecommendations = 30
+
+	// 同类目/同品牌候选只取销量靠前的部分，避免大类目下两两计算
+	maxCandidatesPerGroup = 200
+
+	// 共同购买订单数达到该值才作为"经常一起购买"
+	minBoughtTogetherOrders = 2
+
+	// 相关商品打分权重
+	relatedWeightCategory   = 1.0
+	relatedWeightBrand      = 0.6
+	relatedWeightCoPurchase = 1.0
+	relatedWeightPopularity = 0.4
+
+	// 经常一起购买的热度权重，只用于置信度相同时区分先后
+	togetherWeightPopularity = 0.01
+)
+
+// 写入订单服务同步的购买统计，同一批次号可分多次写入
+func (s *productServiceImpl) SyncPurchaseStats(ctx context.Context, req *api.SyncPurchaseStatsReq) (*api.SyncPurchaseStatsResp, error) {
+	if req.Generation <= 0 {
+		return &api.SyncPurchaseStatsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("同步批次号无效"),
+		}, nil
+	}
+
+	//共同购买是无序的商品对，双向各存一条
+	pairs := make([]*model.ProductCoPurchase, 0, len(req.Pairs)*2)
+	for _, p := range req.Pairs {
+		if p.ProductId <= 0 || p.RelatedProductId <= 0 || p.ProductId == p.RelatedProductId || p.OrderCount <= 0 {
+			continue
+		}
+		pairs = append(pairs,
+			&model.ProductCoPurchase{ProductID: p.ProductId, RelatedProductID: p.RelatedProductId, OrderCount: p.OrderCount, Generation: req.Generation},
+			&model.ProductCoPurchase{ProductID: p.RelatedProductId, RelatedProductID: p.ProductId, OrderCount: p.OrderCount, Generation: req.Generation},
+		)
+	}
+	stats := make([]*model.ProductSalesStat, 0, len(req.Sales))
+	for _, sale := range req.Sales {
+		if sale.ProductId <= 0 {
+			continue
+		}
+		stats = append(stats, &model.ProductSalesStat{
+			ProductID:  sale.ProductId,
+			OrderCount: sale.OrderCount,
+			Quantity:   sale.Quantity,
+			Generation: req.Generation,
+		})
+	}
+
+	if err := s.recommendRepo.SaveCoPurchases(ctx, pairs); err != nil {
+		fmt.Printf("写入共同购买统计失败: generation=%d, err=%v\n", req.Generation, err)
+		return &api.SyncPurchaseStatsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("写入共同购买统计失败"),
+		}, nil
+	}
+	if err := s.recommendRepo.SaveSalesStats(ctx, stats); err != nil {
+		fmt.Printf("写入销量统计失败: generation=%d, err=%v\n", req.Generation, err)
+		return &api.SyncPurchaseStatsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("写入销量统计失败"),
+		}, nil
+	}
+	//最后一批写入后清理旧批次，推荐结果由定时任务重新计算
+	if req.Final {
+		if err := s.recommendRepo.PurgeStaleStats(ctx, req.Generation); err != nil {
+			fmt.Printf("清理旧购买统计失败: generation=%d, err=%v\n", req.Generation, err)
+			return &api.SyncPurchaseStatsResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("清理旧购买统计失败"),
+			}, nil
+		}
+	}
+	return &api.SyncPurchaseStatsResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("同步成功"),
+	}, nil
+}
+
+// 查询相关商品
+func (s *productServiceImpl) GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq) (*api.GetRelatedProductsResp, error) {
+	limit := normalizeRecommendLimit(req.Limit, 10)
+	products, code, message := s.listRecommendedProducts(ctx, req.ProductId, model.RecommendTypeRelated, limit)
+	if code != 0 {
+		return &api.GetRelatedProductsResp{
+			Success: false,
+			Code:    code,
+			Message: stringPtr(message),
+		}, nil
+	}
+	s.localizeSimpleProducts(ctx, products)
+	return &api.GetRelatedProductsResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Products: products,
+	}, nil
+}
+
+// 查询经常一起购买的商品
+func (s *productServiceImpl) GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq) (*api.GetFrequentlyBoughtTogetherResp, error) {
+	limit := normalizeRecommendLimit(req.Limit, 5)
+	products, code, message := s.listRecommendedProducts(ctx, req.ProductId, model.RecommendTypeBoughtTogether, limit)
+	if code != 0 {
+		return &api.GetFrequentlyBoughtTogetherResp{
+			Success: false,
+			Code:    code,
+			Message: stringPtr(message),
+		}, nil
+	}
+	s.localizeSimpleProducts(ctx, products)
+	return &api.GetFrequentlyBoughtTogetherResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Products: products,
+	}, nil
+}
+
+func normalizeRecommendLimit(limit, defaultLimit int32) int32 {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	if limit > maxStoredRecommendations {
+		return maxStoredRecommendations
+	}
+	return limit
+}
+
+// 读取预计算的推荐结果，只返回在售且有库存的商品
+func (s *productServiceImpl) listRecommendedProducts(ctx context.Context, productID int64, recType string,
+	limit int32) ([]*api.SimpleProduct, int32, string) {
+	product, err := s.findProductCached(ctx, productID)
+	if err != nil {
+		return nil, 500, "查询商品失败"
+	}
+	if product == nil {
+		return nil, 404, "商品不存在"
+	}
+
+	recs, err := s.recommendRepo.ListRecommendations(ctx, productID, recType)
+	if err != nil {
+		fmt.Printf("查询推荐结果失败: product=%d, type=%s, err=%v\n", productID, recType, err)
+		return nil, 500, "查询推荐商品失败"
+	}
+	result := make([]*api.SimpleProduct, 0, limit)
+	if len(recs) == 0 {
+		return result, 0, ""
+	}
+	ids := make([]int64, 0, len(recs))
+	for _, rec := range recs {
+		ids = append(ids, rec.RecommendedID)
+	}
+	products, err := s.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, 500, "查询推荐商品失败"
+	}
+	byID := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+	//推荐结果按批计算，读取时以商品当前状态为准
+	for _, rec := range recs {
+		p, ok := byID[rec.RecommendedID]
+		if !ok || p.Status != model.ProductStatusONLINE || p.Stock <= 0 {
+			continue
+		}
+		result = append(result, s.convertToAPISimpleProduct(p))
+		if int32(len(result)) >= limit {
+			break
+		}
+	}
+	return result, 0, ""
+}
+
+// 重新计算全部推荐结果，由定时任务调用
+func (s *productServiceImpl) RefreshRecommendations(ctx context.Context) error {
+	products, err := s.recommendRepo.ListOnlineProducts(ctx)
+	if err != nil {
+		return fmt.Errorf("查询在售商品失败: %w", err)
+	}
+	coPurchases, err := s.recommendRepo.ListCoPurchases(ctx)
+	if err != nil {
+		return fmt.Errorf("查询共同购买统计失败: %w", err)
+	}
+	salesStats, err := s.recommendRepo.ListSalesStats(ctx)
+	if err != nil {
+		return fmt.Errorf("查询销量统计失败: %w", err)
+	}
+
+	online := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		online[p.ID] = p
+	}
+	sales := make(map[int64]int32, len(salesStats))
+	var maxSales int32
+	for _, stat := range salesStats {
+		sales[stat.ProductID] = stat.OrderCount
+		if _, ok := online[stat.ProductID]; ok && stat.OrderCount > maxSales {
+			maxSales = stat.OrderCount
+		}
+	}
+	//销量取对数归一化，避免爆款商品压过相似度
+	popularity := func(id int64) float64 {
+		if maxSales <= 0 {
+			return 0
+		}
+		return math.Log1p(float64(sales[id])) / math.Log1p(float64(maxSales))
+	}
+	coPurchased := make(map[int64]map[int64]int32)
+	for _, pair := range coPurchases {
+		if _, ok := online[pair.RelatedProductID]; !ok {
+			continue
+		}
+		if coPurchased[pair.ProductID] == nil {
+			coPurchased[pair.ProductID] = make(map[int64]int32)
+		}
+		coPurchased[pair.ProductID][pair.RelatedProductID] = pair.OrderCount
+	}
+
+	//按类目和品牌分组，组内按销量取前若干个作为候选
+	byCategory := make(map[string][]*model.Product)
+	byBrand := make(map[int64][]*model.Product)
+	for _, p := range products {
+		byCategory[p.Category] = append(byCategory[p.Category], p)
+		if p.BrandID > 0 {
+			byBrand[p.BrandID] = append(byBrand[p.BrandID], p)
+		}
+	}
+	topCandidates := func(group []*model.Product) []*model.Product {
+		sort.SliceStable(group, func(i, j int) bool {
+			if sales[group[i].ID] != sales[group[j].ID] {
+				return sales[group[i].ID] > sales[group[j].ID]
+			}
+			return group[i].RatingAvg > group[j].RatingAvg
+		})
+		if len(group) > maxCandidatesPerGroup {
+			return group[:maxCandidatesPerGroup]
+		}
+		return group
+	}
+	for key, group := range byCategory {
+		byCategory[key] = topCandidates(group)
+	}
+	for key, group := range byBrand {
+		byBrand[key] = topCandidates(group)
+	}
+
+	now := time.Now().Unix()
+	related := make([]*model.ProductRecommendation, 0)
+	together := make([]*model.ProductRecommendation, 0)
+	for _, p := range products {
+		co := coPurchased[p.ID]
+		var maxCo int32
+		for _, count := range co {
+			if count > maxCo {
+				maxCo = count
+			}
+		}
+
+		//相关商品：同类目、同品牌、共同购买和热度加权
+		scores := make(map[int64]float64)
+		for _, q := range byCategory[p.Category] {
+			scores[q.ID] += relatedWeightCategory
+		}
+		if p.BrandID > 0 {
+			for _, q := range byBrand[p.BrandID] {
+				scores[q.ID] += relatedWeightBrand
+			}
+		}
+		for id, count := range co {
+			scores[id] += relatedWeightCoPurchase * float64(count) / float64(maxCo)
+		}
+		delete(scores, p.ID)
+		for id := range scores {
+			scores[id] += relatedWeightPopularity * popularity(id)
+		}
+		related = append(related, rankRecommendations(p.ID, model.RecommendTypeRelated, scores, now)...)
+
+		//经常一起购买：购买该商品的订单中同时购买对方的比例
+		togetherScores := make(map[int64]float64)
+		base := sales[p.ID]
+		for id, count := range co {
+			if count < minBoughtTogetherOrders {
+				continue
+			}
+			confidence := 1.0
+			if base > count {
+				confidence = float64(count) / float64(base)
+			}
+			togetherScores[id] = confidence + togetherWeightPopularity*popularity(id)
+		}
+		together = append(together, rankRecommendations(p.ID, model.RecommendTypeBoughtTogether, togetherScores, now)...)
+	}
+
+	if err := s.recommendRepo.ReplaceRecommendations(ctx, model.RecommendTypeRelated, related); err != nil {
+		return fmt.Errorf("保存相关商品推荐失败: %w", err)
+	}
+	if err := s.recommendRepo.ReplaceRecommendations(ctx, model.RecommendTypeBoughtTogether, together); err != nil {
+		return fmt.Errorf("保存共同购买推荐失败: %w", err)
+	}
+	return nil
+}
+
+// 按分数从高到低取前若干个，分数相同时按商品ID排序保证结果稳定
+func rankRecommendations(productID int64, recType string, scores map[int64]float64, now int64) []*model.ProductRecommendation {
+	ids := make([]int64, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > maxStoredRecommendations {
+		ids = ids[:maxStoredRecommendations]
+	}
+	recs := make([]*model.ProductRecommendation, 0, len(ids))
+	for i, id := range ids {
+		recs = append(recs, &model.ProductRecommendation{
+			ProductID:     productID,
+			Type:          recType,
+			RecommendedID: id,
+			Score:         math.Round(scores[id]*10000) / 10000,
+			Position:      int32(i + 1),
+			CreatedAt:     now,
+		})
+	}
+	return recs
+}
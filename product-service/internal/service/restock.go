@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/notifier"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"time"
+)
+
+const (
+	// 单个用户的到货订阅数量上限
+	maxRestockSubscriptionsPerUser = 100
+	// 单个商品一次处理的订阅数，其余由定时任务继续处理
+	restockNotifyBatchSize = 500
+	// 定时任务一次处理的商品数
+	restockProductBatchSize = 100
+	// 到货通知的超时时间
+	restockNotifyTimeout = 30 * time.Second
+)
+
+// 设置每个用户每小时最多收到的到货通知数，超出的通知保持等待，由定时任务稍后发送
+func (s *productServiceImpl) SetRestockLimit(maxPerUserHour int) {
+	s.restockLimit = maxPerUserHour
+}
+
+// 订阅商品到货通知，商品有货在售时无需订阅
+func (s *productServiceImpl) SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq) (*api.SubscribeRestockResp, error) {
+	if req.UserId <= 0 || req.ProductId <= 0 {
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID和商品ID必须大于0"),
+		}, nil
+	}
+	product, err := s.findProductCached(ctx, req.ProductId)
+	if err != nil {
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	if product.Stock > 0 && isListedStatus(product.Status) {
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品有货，无需订阅到货通知"),
+		}, nil
+	}
+	count, err := s.restockRepo.CountByUser(ctx, req.UserId)
+	if err != nil {
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询到货订阅失败"),
+		}, nil
+	}
+	if count >= maxRestockSubscriptionsPerUser {
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("最多订阅%d个商品的到货通知", maxRestockSubscriptionsPerUser)),
+		}, nil
+	}
+
+	subscription := &model.RestockSubscription{
+		UserID:    req.UserId,
+		ProductID: product.ID,
+		Status:    model.RestockSubscriptionPENDING,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err := s.restockRepo.Subscribe(ctx, subscription); err != nil {
+		fmt.Printf("订阅到货通知失败: user=%d, product=%d, err=%v\n", req.UserId, product.ID, err)
+		return &api.SubscribeRestockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("订阅到货通知失败"),
+		}, nil
+	}
+	return &api.SubscribeRestockResp{
+		Success:      true,
+		Code:         0,
+		Message:      stringPtr("订阅成功"),
+		Subscription: s.convertToAPIRestockSubscription(subscription, product),
+	}, nil
+}
+
+// 取消到货通知订阅
+func (s *productServiceImpl) UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq) (*api.UnsubscribeRestockResp, error) {
+	if req.UserId <= 0 || req.ProductId <= 0 {
+		return &api.UnsubscribeRestockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID和商品ID必须大于0"),
+		}, nil
+	}
+	removed, err := s.restockRepo.Unsubscribe(ctx, req.UserId, req.ProductId)
+	if err != nil {
+		fmt.Printf("取消到货订阅失败: user=%d, product=%d, err=%v\n", req.UserId, req.ProductId, err)
+		return &api.UnsubscribeRestockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("取消到货订阅失败"),
+		}, nil
+	}
+	if !removed {
+		return &api.UnsubscribeRestockResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("未订阅该商品的到货通知"),
+		}, nil
+	}
+	return &api.UnsubscribeRestockResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("已取消订阅"),
+	}, nil
+}
+
+// 查询用户的到货通知订阅
+func (s *productServiceImpl) ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq) (*api.ListRestockSubscriptionsResp, error) {
+	if req.UserId <= 0 {
+		return &api.ListRestockSubscriptionsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("用户ID无效"),
+		}, nil
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	subscriptions, total, err := s.restockRepo.ListByUser(ctx, req.UserId, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListRestockSubscriptionsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询到货订阅失败"),
+		}, nil
+	}
+	ids := make([]int64, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		ids = append(ids, sub.ProductID)
+	}
+	products, err := s.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return &api.ListRestockSubscriptionsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	byID := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+	result := make([]*api.RestockSubscription, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		result = append(result, s.convertToAPIRestockSubscription(sub, byID[sub.ProductID]))
+	}
+	return &api.ListRestockSubscriptionsResp{
+		Success:       true,
+		Code:          0,
+		Message:       stringPtr("查询成功"),
+		Total:         int32(total),
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		Subscriptions: result,
+	}, nil
+}
+
+// 发送有货在售商品的到货通知，由定时任务调用，补发因限流或发送失败而等待的通知
+func (s *productServiceImpl) ProcessRestockNotifications(ctx context.Context) error {
+	productIDs, err := s.restockRepo.FindRestockedProductIDs(ctx, restockProductBatchSize)
+	if err != nil {
+		return fmt.Errorf("查询待通知商品失败: %w", err)
+	}
+	for _, productID := range productIDs {
+		s.notifyRestock(ctx, productID)
+	}
+	return nil
+}
+
+// 库存从0恢复后异步发送到货通知，通知失败不影响库存变更
+func (s *productServiceImpl) notifyRestockAsync(productID int64) {
+	if s.restockRepo == nil || s.restockNotifier == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), restockNotifyTimeout)
+		defer cancel()
+		s.notifyRestock(ctx, productID)
+	}()
+}
+
+// 向等待中的订阅者发送到货通知：先标记已通知再发送，同一订阅只会通知一次；超过限流的用户本次跳过
+func (s *productServiceImpl) notifyRestock(ctx context.Context, productID int64) {
+	if s.restockRepo == nil || s.restockNotifier == nil {
+		return
+	}
+	product, err := s.productRepo.FindByID(ctx, productID)
+	if err != nil || product == nil {
+		return
+	}
+	if product.Stock <= 0 || !isListedStatus(product.Status) {
+		return
+	}
+	s.restockMu.Lock()
+	defer s.restockMu.Unlock()
+	subscriptions, err := s.restockRepo.ListPending(ctx, productID, restockNotifyBatchSize)
+	if err != nil {
+		fmt.Printf("查询到货订阅失败: product=%d, err=%v\n", productID, err)
+		return
+	}
+	for _, sub := range subscriptions {
+		now := time.Now().Unix()
+		if s.restockLimit > 0 {
+			sent, err := s.restockRepo.CountNotifiedSince(ctx, sub.UserID, now-int64(time.Hour/time.Second))
+			if err != nil {
+				fmt.Printf("查询到货通知次数失败: user=%d, err=%v\n", sub.UserID, err)
+				continue
+			}
+			if sent >= int64(s.restockLimit) {
+				continue
+			}
+		}
+		marked, err := s.restockRepo.MarkNotified(ctx, sub.ID, now)
+		if err != nil {
+			fmt.Printf("标记到货通知失败: subscription=%d, err=%v\n", sub.ID, err)
+			continue
+		}
+		if !marked {
+			continue
+		}
+		notice := &notifier.RestockNotice{
+			UserID:      sub.UserID,
+			ProductID:   product.ID,
+			ProductName: product.Name,
+			Price:       product.Price,
+			Stock:       product.Stock,
+			CreatedAt:   now,
+		}
+		if err := s.restockNotifier.NotifyRestock(ctx, notice); err != nil {
+			fmt.Printf("发送到货通知失败: user=%d, product=%d, err=%v\n", sub.UserID, product.ID, err)
+			if err := s.restockRepo.ResetPending(ctx, sub.ID, sub.NotifiedAt); err != nil {
+				fmt.Printf("恢复到货订阅失败: subscription=%d, err=%v\n", sub.ID, err)
+			}
+		}
+	}
+}
+
+func (s *productServiceImpl) convertToAPIRestockSubscription(sub *model.RestockSubscription, product *model.Product) *api.RestockSubscription {
+	result := &api.RestockSubscription{
+		ProductId:  sub.ProductID,
+		Status:     api.RestockSubscriptionStatus(sub.Status),
+		CreatedAt:  sub.CreatedAt,
+		NotifiedAt: sub.NotifiedAt,
+	}
+	if product != nil {
+		result.Product = s.convertToAPISimpleProduct(product)
+	}
+	return result
+}
@@ -2,11 +2,16 @@ package service
 
 import (
 	"context"
+	"ecommerce/product-service/internal/cache"
 	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/notifier"
 	"ecommerce/product-service/internal/repository"
 	"ecommerce/product-service/kitex_gen/api"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -14,26 +19,216 @@ type ProductService interface {
 	CreateProduct(ctx context.Context, req *api.CreateProductReq) (*api.CreateProductResp, error)
 	GetProduct(ctx context.Context, id int64) (*api.GetProductResp, error)
 	UpdateProduct(ctx context.Context, req *api.UpdateProductReq) (*api.UpdateProductResp, error)
-	DeleteProduct(ctx context.Context, id int64) (*api.DeleteProductResp, error)
+	DeleteProduct(ctx context.Context, req *api.DeleteProductReq) (*api.DeleteProductResp, error)
 
-	OnlineProduct(ctx context.Context, id int64) (*api.OnlineProductResp, error)
-	OfflineProduct(ctx context.Context, id int64) (*api.OfflineProductResp, error)
+	OnlineProduct(ctx context.Context, req *api.OnlineProductReq) (*api.OnlineProductResp, error)
+	OfflineProduct(ctx context.Context, req *api.OfflineProductReq) (*api.OfflineProductResp, error)
+	ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq) (*api.ListProductStatusSchedulesResp, error)
+	CancelProductStatusSchedule(ctx context.Context, id int64) (*api.CancelProductStatusScheduleResp, error)
+	ProcessStatusSchedules(ctx context.Context) error
+
+	ImportProducts(ctx context.Context, req *api.ImportProductsReq) (*api.ImportProductsResp, error)
+
+	CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq) (*api.CreateAttributeDefinitionResp, error)
+	UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq) (*api.UpdateAttributeDefinitionResp, error)
+	ListAttributeDefinitions(ctx context.Context, category *string) (*api.ListAttributeDefinitionsResp, error)
+	DeleteAttributeDefinition(ctx context.Context, id int64) (*api.DeleteAttributeDefinitionResp, error)
+
+	UpdateProductRating(ctx context.Context, req *api.UpdateProductRatingReq) (*api.UpdateProductRatingResp, error)
+
+	//审计日志
+	ListProductAudit(ctx context.Context, req *api.ListProductAuditReq) (*api.ListProductAuditResp, error)
+
+	//推荐
+	SyncPurchaseStats(ctx context.Context, req *api.SyncPurchaseStatsReq) (*api.SyncPurchaseStatsResp, error)
+	GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq) (*api.GetRelatedProductsResp, error)
+	GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq) (*api.GetFrequentlyBoughtTogetherResp, error)
+	RefreshRecommendations(ctx context.Context) error
+
+	//品牌
+	CreateBrand(ctx context.Context, req *api.CreateBrandReq) (*api.CreateBrandResp, error)
+	UpdateBrand(ctx context.Context, req *api.UpdateBrandReq) (*api.UpdateBrandResp, error)
+	GetBrand(ctx context.Context, id int64) (*api.GetBrandResp, error)
+	ListBrands(ctx context.Context, req *api.ListBrandsReq) (*api.ListBrandsResp, error)
+	DeleteBrand(ctx context.Context, req *api.DeleteBrandReq) (*api.DeleteBrandResp, error)
+
+	//商品审核
+	SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq) (*api.SubmitProductApprovalResp, error)
+	ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq) (*api.ReviewProductApprovalResp, error)
+	ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq) (*api.ListProductApprovalsResp, error)
+
+	//商家管理
+	CreateMerchant(ctx context.Context, req *api.CreateMerchantReq) (*api.CreateMerchantResp, error)
+	UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq) (*api.UpdateMerchantResp, error)
+	GetMerchant(ctx context.Context, id int64) (*api.GetMerchantResp, error)
+	ListMerchants(ctx context.Context, req *api.ListMerchantsReq) (*api.ListMerchantsResp, error)
+
+	//搜索联想
+	SuggestProducts(ctx context.Context, req *api.SuggestProductsReq) (*api.SuggestProductsResp, error)
+	ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq) (*api.ListSearchQueriesResp, error)
+	RefreshSuggestions(ctx context.Context) error
+
+	//商品热度
+	RecordProductView(productID int64)
+	RecordProductSales(ctx context.Context, req *api.RecordProductSalesReq) (*api.RecordProductSalesResp, error)
+	GetTopProducts(ctx context.Context, req *api.GetTopProductsReq) (*api.GetTopProductsResp, error)
+	FlushPopularityCounters(ctx context.Context) error
+	RefreshPopularity(ctx context.Context) error
+
+	//套装
+	UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq) (*api.UpdateBundleComponentsResp, error)
+
+	//多语言
+	SetDefaultLocale(locale string)
+	SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq) (*api.SetProductTranslationResp, error)
+	DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq) (*api.DeleteProductTranslationResp, error)
+	ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq) (*api.ListProductTranslationsResp, error)
+	SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq) (*api.SetCategoryTranslationResp, error)
+	DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq) (*api.DeleteCategoryTranslationResp, error)
+	ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq) (*api.ListCategoryTranslationsResp, error)
+
+	//收藏
+	AddFavorite(ctx context.Context, req *api.AddFavoriteReq) (*api.AddFavoriteResp, error)
+	RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq) (*api.RemoveFavoriteResp, error)
+	ListFavorites(ctx context.Context, req *api.ListFavoritesReq) (*api.ListFavoritesResp, error)
+
+	//到货通知
+	SetRestockLimit(maxPerUserHour int)
+	SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq) (*api.SubscribeRestockResp, error)
+	UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq) (*api.UnsubscribeRestockResp, error)
+	ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq) (*api.ListRestockSubscriptionsResp, error)
+	ProcessRestockNotifications(ctx context.Context) error
+
+	//阶梯价和价格组
+	SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq) (*api.SetProductPriceTiersResp, error)
+	GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq) (*api.GetProductPriceTiersResp, error)
+	SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq) (*api.SetUserPriceGroupResp, error)
+	ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq) (*api.ListUserPriceGroupsResp, error)
+	QuotePrices(ctx context.Context, req *api.QuotePricesReq) (*api.QuotePricesResp, error)
+
+	//每人限购
+	SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq) (*api.SetProductPurchaseLimitResp, error)
 
 	UserSearchProducts(ctx context.Context, req *api.UserSearchProductsReq) (*api.UserSearchProductsResp, error)
 	AdminSearchProducts(ctx context.Context, req *api.AdminSearchProductsReq) (*api.AdminSearchProductsResp, error)
 
-	UpdateStock(ctx context.Context, id int64, delta int32) error
+	AdjustStock(ctx context.Context, req *api.AdjustStockReq) (*api.AdjustStockResp, error)
 	CheckStock(cttx context.Context, id int64, quantity int32) (bool, error)
+	ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq) (*api.ListStockMovementsResp, error)
+	ReconcileStock(ctx context.Context, productID *int64) (*api.ReconcileStockResp, error)
+
+	CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq) (*api.CreateWarehouseResp, error)
+	UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq) (*api.UpdateWarehouseResp, error)
+	ListWarehouses(ctx context.Context, activeOnly bool) (*api.ListWarehousesResp, error)
+	GetWarehouseStocks(ctx context.Context, productIDs []int64, warehouseID *int64) (*api.GetWarehouseStocksResp, error)
+
+	ListLowStockProducts(ctx context.Context, page, pageSize int32) (*api.ListLowStockProductsResp, error)
+
+	CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq) (*api.CreatePriceScheduleResp, error)
+	ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq) (*api.ListPriceSchedulesResp, error)
+	CancelPriceSchedule(ctx context.Context, id int64, operator *string) (*api.CancelPriceScheduleResp, error)
+	GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq) (*api.GetPriceHistoryResp, error)
+	ProcessPriceSchedules(ctx context.Context) error
+
+	BatchGetProducts(ctx context.Context, ids []int64) (*api.BatchGetProductsResp, error)
+	ListProductEvents(ctx context.Context, afterID int64, limit int32) (*api.ListProductEventsResp, error)
+	PruneProductEvents(ctx context.Context) error
 }
 
+// 单次批量查询的商品数量上限
+const maxBatchGetProducts = 100
+
+// 未指定操作人时记录的默认值
+const defaultStockOperator = "system"
+
+// 商品事件保留时长，超过后由定时任务清理
+const productEventRetention = 7 * 24 * time.Hour
+
+// 每批清理的商品事件数量
+const productEventPruneBatchSize = 1000
+
 type productServiceImpl struct {
-	productRepo repository.ProductRepository
+	productRepo     repository.ProductRepository
+	eventRepo       repository.EventRepository
+	movementRepo    repository.StockMovementRepository
+	warehouseRepo   repository.WarehouseRepository
+	priceRepo       repository.PriceRepository
+	scheduleRepo    repository.StatusScheduleRepository
+	attributeRepo   repository.AttributeRepository
+	auditRepo       repository.AuditRepository
+	recommendRepo   repository.RecommendationRepository
+	brandRepo       repository.BrandRepository
+	approvalRepo    repository.ApprovalRepository
+	merchantRepo    repository.MerchantRepository
+	suggestionRepo  repository.SuggestionRepository
+	popularityRepo  repository.PopularityRepository
+	bundleRepo      repository.BundleRepository
+	translationRepo repository.TranslationRepository
+	favoriteRepo    repository.FavoriteRepository
+	restockRepo     repository.RestockRepository
+	pricingRepo     repository.PricingRepository
+	defaultLocale   string
+	popularity      *popularityCounter
+	notifier        notifier.Notifier
+	restockNotifier notifier.RestockNotifier
+	restockLimit    int
+	restockMu       sync.Mutex //串行发送到货通知，保证按用户限流的计数准确
+	cache           cache.Cache
+	cacheLoader     *cache.Loader
 }
 
-func NewProductService(productRepo repository.ProductRepository) ProductService {
-	return &productServiceImpl{
-		productRepo: productRepo,
+func NewProductService(productRepo repository.ProductRepository,
+	eventRepo repository.EventRepository,
+	movementRepo repository.StockMovementRepository,
+	warehouseRepo repository.WarehouseRepository,
+	priceRepo repository.PriceRepository,
+	scheduleRepo repository.StatusScheduleRepository,
+	attributeRepo repository.AttributeRepository,
+	auditRepo repository.AuditRepository,
+	recommendRepo repository.RecommendationRepository,
+	brandRepo repository.BrandRepository,
+	approvalRepo repository.ApprovalRepository,
+	merchantRepo repository.MerchantRepository,
+	suggestionRepo repository.SuggestionRepository,
+	popularityRepo repository.PopularityRepository,
+	bundleRepo repository.BundleRepository,
+	translationRepo repository.TranslationRepository,
+	favoriteRepo repository.FavoriteRepository,
+	restockRepo repository.RestockRepository,
+	pricingRepo repository.PricingRepository,
+	alertNotifier notifier.Notifier,
+	restockNotifier notifier.RestockNotifier,
+	productCache cache.Cache) ProductService {
+	s := &productServiceImpl{
+		productRepo:     productRepo,
+		eventRepo:       eventRepo,
+		movementRepo:    movementRepo,
+		warehouseRepo:   warehouseRepo,
+		priceRepo:       priceRepo,
+		scheduleRepo:    scheduleRepo,
+		attributeRepo:   attributeRepo,
+		auditRepo:       auditRepo,
+		recommendRepo:   recommendRepo,
+		brandRepo:       brandRepo,
+		approvalRepo:    approvalRepo,
+		merchantRepo:    merchantRepo,
+		suggestionRepo:  suggestionRepo,
+		popularityRepo:  popularityRepo,
+		bundleRepo:      bundleRepo,
+		translationRepo: translationRepo,
+		favoriteRepo:    favoriteRepo,
+		restockRepo:     restockRepo,
+		pricingRepo:     pricingRepo,
+		defaultLocale:   fallbackDefaultLocale,
+		popularity:      newPopularityCounter(),
+		notifier:        alertNotifier,
+		restockNotifier: restockNotifier,
 	}
+	if productCache != nil {
+		s.cache = productCache
+		s.cacheLoader = cache.NewLoader(productCache)
+	}
+	return s
 }
 
 // 创建商品
@@ -59,6 +254,60 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *api.CreateP
 			Message: stringPtr("库存不能为负数"),
 		}, nil
 	}
+	productType := model.ProductType(req.GetType())
+	switch productType {
+	case model.ProductTypeNORMAL:
+		if len(req.Components) > 0 {
+			return &api.CreateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("普通商品不能设置套装组件"),
+			}, nil
+		}
+	case model.ProductTypeBUNDLE:
+		if req.Stock > 0 {
+			return &api.CreateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("套装库存由组件库存决定，不能直接设置"),
+			}, nil
+		}
+	default:
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("不支持的商品类型"),
+		}, nil
+	}
+	if req.LowStockThreshold != nil && *req.LowStockThreshold < 0 {
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("低库存阈值不能为负数"),
+		}, nil
+	}
+	if isListedStatus(model.ProductStatus(req.Status)) {
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    403,
+			Message: stringPtr("新商品需提交审核，审核通过后才能上架"),
+		}, nil
+	}
+	attributes, msg, err := s.resolveProductAttributes(ctx, req.Category, req.Attributes)
+	if err != nil {
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	if msg != "" {
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
 	now := time.Now().Unix()
 	product := &model.Product{
 		Name:      req.Name,
@@ -69,11 +318,59 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *api.CreateP
 		Status:    model.ProductStatus(req.Status),
 		CreatedAt: now,
 		UpdatedAt: now,
+		Version:   1,
+		Type:      productType,
 	}
-	if req.Brand != nil && *req.Brand != "" {
-		product.Brand = *req.Brand
+	brand, msg, err := s.resolveProductBrand(ctx, req.BrandId, req.Brand)
+	if err != nil {
+		fmt.Printf("解析商品品牌失败: %v\n", err)
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询品牌失败"),
+		}, nil
 	}
-	err := s.productRepo.Create(ctx, product)
+	if msg != "" {
+		return &api.CreateProductResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	if brand != nil {
+		product.Brand = brand.Name
+		product.BrandID = brand.ID
+	}
+	if req.MerchantId != nil && *req.MerchantId > 0 {
+		if code, msg := s.checkMerchantActive(ctx, *req.MerchantId); msg != "" {
+			return &api.CreateProductResp{
+				Success: false,
+				Code:    code,
+				Message: stringPtr(msg),
+			}, nil
+		}
+		product.MerchantID = *req.MerchantId
+	}
+	var bundleItems []*model.ProductBundleItem
+	if productType == model.ProductTypeBUNDLE {
+		var code int32
+		bundleItems, code, msg = s.resolveBundleComponents(ctx, 0, product.MerchantID, req.Components)
+		if msg != "" {
+			return &api.CreateProductResp{
+				Success: false,
+				Code:    code,
+				Message: stringPtr(msg),
+			}, nil
+		}
+	}
+	if req.LowStockThreshold != nil {
+		product.LowStockThreshold = *req.LowStockThreshold
+	}
+	if req.SoldOutPolicy != nil {
+		product.SoldOutPolicy = model.SoldOutPolicy(*req.SoldOutPolicy)
+	}
+	operator := operatorOrDefault(req.Operator)
+	err = s.productRepo.Create(ctx, product, operator, attributes, bundleItems)
 	if err != nil {
 		fmt.Printf("创建商品失败: %v\n", err)
 		return &api.CreateProductResp{
@@ -82,17 +379,30 @@ func (s *productServiceImpl) CreateProduct(ctx context.Context, req *api.CreateP
 			Message: stringPtr("创建商品失败，请稍后重试"),
 		}, nil
 	}
+	s.auditProductChange(ctx, model.AuditActionCreate, operator, nil, product, "")
+	if product.Type == model.ProductTypeBUNDLE {
+		for _, change := range s.refreshBundleStocks(ctx, []int64{product.ID}) {
+			product.Stock = change.NewStock
+		}
+	}
+	s.invalidateProductCache(ctx, product.ID)
+	apiProduct := s.convertToAPIProduct(product)
+	apiProduct.Attributes, _ = s.loadProductAttributes(ctx, product)
+	if product.Type == model.ProductTypeBUNDLE {
+		components, _ := s.loadBundleComponents(ctx, []*model.Product{product})
+		apiProduct.Components = components[product.ID]
+	}
 	return &api.CreateProductResp{
 		Success: true,
 		Code:    0,
 		Message: stringPtr("创建成功"),
-		Product: s.convertToAPIProduct(product),
+		Product: apiProduct,
 	}, nil
 }
 
 // 获取商品
 func (s *productServiceImpl) GetProduct(ctx context.Context, id int64) (*api.GetProductResp, error) {
-	product, err := s.productRepo.FindByID(ctx, id)
+	product, err := s.findProductCached(ctx, id)
 	if err != nil {
 		return &api.GetProductResp{
 			Success: false,
@@ -109,16 +419,43 @@ func (s *productServiceImpl) GetProduct(ctx context.Context, id int64) (*api.Get
 		}, nil
 	}
 
+	apiProduct := s.convertToAPIProduct(product)
+	attributes, err := s.loadProductAttributes(ctx, product)
+	if err != nil {
+		fmt.Printf("查询商品属性失败: product=%d, err=%v\n", id, err)
+	}
+	apiProduct.Attributes = attributes
+	if product.Type == model.ProductTypeBUNDLE {
+		components, err := s.loadBundleComponents(ctx, []*model.Product{product})
+		if err != nil {
+			fmt.Printf("查询套装组件失败: product=%d, err=%v\n", id, err)
+		}
+		apiProduct.Components = components[product.ID]
+	}
+	s.localizeProducts(ctx, []*api.Product{apiProduct})
+	s.applyPriceTiers(ctx, []*api.Product{apiProduct})
+	favoriteCount, err := s.favoriteRepo.CountByProduct(ctx, product.ID)
+	if err != nil {
+		fmt.Printf("查询收藏人数失败: product=%d, err=%v\n", id, err)
+	}
+	apiProduct.FavoriteCount = int32(favoriteCount)
 	return &api.GetProductResp{
 		Success: true,
 		Code:    0,
 		Message: stringPtr("查询成功"),
-		Product: s.convertToAPIProduct(product),
+		Product: apiProduct,
 	}, nil
 }
 
 // 更新商品
 func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *api.UpdateProductReq) (*api.UpdateProductResp, error) {
+	if req.Version == nil {
+		return &api.UpdateProductResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("缺少商品版本号"),
+		}, nil
+	}
 	product, err := s.productRepo.FindByID(ctx, req.GetId())
 	if err != nil {
 		return &api.UpdateProductResp{
@@ -134,46 +471,288 @@ func (s *productServiceImpl) UpdateProduct(ctx context.Context, req *api.UpdateP
 			Message: stringPtr("商品不存在"),
 		}, nil
 	}
+	if product.Version != *req.Version {
+		return s.versionConflictResp(product), nil
+	}
+
+	//已审核商品的名称、图片、分类、品牌和属性修改需审核后生效，其余字段直接更新
+	var revision *model.ProductRevisionFields
+	if product.ApprovalStatus == model.ApprovalStatusAPPROVED || product.ApprovalStatus == model.ApprovalStatusPENDING {
+		revision, err = s.extractRevision(ctx, product, req)
+		if err != nil {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询商品属性失败"),
+			}, nil
+		}
+	}
+	if revision != nil {
+		code, msg := s.checkRevision(ctx, product, revision)
+		if code != 0 {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    code,
+				Message: stringPtr(msg),
+			}, nil
+		}
+	}
+
+	var resp *api.UpdateProductResp
+	if revision == nil || hasDirectUpdateFields(req) {
+		resp = s.applyProductUpdate(ctx, product, req, "")
+		if !resp.Success {
+			return resp, nil
+		}
+	} else {
+		apiProduct := s.convertToAPIProduct(product)
+		apiProduct.Attributes, _ = s.loadProductAttributes(ctx, product)
+		resp = &api.UpdateProductResp{
+			Success: true,
+			Code:    0,
+			Message: stringPtr("更新成功"),
+			Product: apiProduct,
+		}
+	}
+	if revision != nil {
+		approval, code, msg := s.submitRevision(ctx, product, revision, operatorOrDefault(req.Operator))
+		if approval == nil {
+			//其余字段已更新成功，只提示修改提交失败
+			resp.Message = stringPtr("更新成功，但内容修改提交审核失败：" + msg)
+			fmt.Printf("提交商品修改审核失败: product=%d, code=%d, msg=%s\n", product.ID, code, msg)
+			return resp, nil
+		}
+		resp.Message = stringPtr("更新成功，内容修改已提交审核")
+		resp.Revision = s.convertToAPIApproval(approval)
+	}
+	return resp, nil
+}
+
+// 按请求更新商品，调用方已校验版本号；审核通过的内容修改也经由此处生效
+func (s *productServiceImpl) applyProductUpdate(ctx context.Context, product *model.Product,
+	req *api.UpdateProductReq, remark string) *api.UpdateProductResp {
+	var err error
+	before := *product
+	operator := operatorOrDefault(req.Operator)
+	//只更新请求中提供的字段
+	update := &repository.ProductUpdate{
+		ID:       product.ID,
+		Version:  product.Version,
+		Fields:   make(map[string]interface{}),
+		Expected: make(map[string]interface{}),
+	}
 	if req.Name != nil {
 		product.Name = *req.Name
+		update.Fields["name"] = product.Name
 	}
 	if req.Avatar != nil {
 		product.Avatar = *req.Avatar
+		update.Fields["avatar"] = product.Avatar
+	}
+	//属性或分类变化时按新分类的属性定义重新校验
+	var attributes []*model.ProductAttributeValue
+	categoryChanged := req.Category != nil && *req.Category != product.Category
+	if req.Attributes != nil || categoryChanged {
+		category := product.Category
+		if req.Category != nil {
+			category = *req.Category
+		}
+		raw := req.Attributes
+		if raw == nil {
+			//未传属性时保留新分类下仍有定义的原属性
+			raw, err = s.retainedAttributes(ctx, product.ID, category)
+			if err != nil {
+				return &api.UpdateProductResp{
+					Success: false,
+					Code:    500,
+					Message: stringPtr("查询商品属性失败"),
+				}
+			}
+		}
+		var msg string
+		attributes, msg, err = s.resolveProductAttributes(ctx, category, raw)
+		if err != nil {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询属性定义失败"),
+			}
+		}
+		if msg != "" {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr(msg),
+			}
+		}
+		if attributes == nil {
+			attributes = []*model.ProductAttributeValue{}
+		}
 	}
 	if req.Category != nil {
 		product.Category = *req.Category
+		update.Fields["category"] = product.Category
 	}
-	if req.Price != nil {
+	//价格变化记录历史
+	var priceChange *model.PriceHistory
+	if req.Price != nil && *req.Price != product.Price {
+		if *req.Price <= 0 {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("商品价格必须大于0"),
+			}
+		}
+		priceChange = &model.PriceHistory{
+			OldPrice:  product.Price,
+			NewPrice:  *req.Price,
+			Source:    model.PriceSourceManual,
+			Operator:  operator,
+			CreatedAt: time.Now().Unix(),
+		}
+		update.Expected["price"] = product.Price
 		product.Price = *req.Price
+		update.Fields["price"] = product.Price
 	}
-	if req.Stock != nil {
+	//直接修改库存视为人工调整，记录差额
+	oldStock := product.Stock
+	var movement *model.StockMovement
+	if req.Stock != nil && *req.Stock != product.Stock {
+		if *req.Stock < 0 {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("库存不能为负数"),
+			}
+		}
+		if product.Type == model.ProductTypeBUNDLE {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("套装库存由组件库存决定，不能直接修改"),
+			}
+		}
+		movement = &model.StockMovement{
+			Delta:     *req.Stock - product.Stock,
+			Balance:   *req.Stock,
+			Reason:    model.StockChangeManualAdjust,
+			Operator:  operator,
+			CreatedAt: time.Now().Unix(),
+		}
+		//库存扣减不递增版本号，需确认库存未被并发修改
+		update.Expected["stock"] = product.Stock
 		product.Stock = *req.Stock
+		update.Fields["stock"] = product.Stock
 	}
 	if req.Status != nil {
+		status := model.ProductStatus(*req.Status)
+		if isListedStatus(status) && product.ApprovalStatus != model.ApprovalStatusAPPROVED {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    403,
+				Message: stringPtr("商品未通过审核，不能上架"),
+			}
+		}
+		update.Expected["status"] = product.Status
 		product.Status = model.ProductStatus(*req.Status)
+		product.AutoDelisted = false
+		update.Fields["status"] = product.Status
+		update.Fields["auto_delisted"] = false
+	}
+	if req.BrandId != nil || req.Brand != nil {
+		brand, msg, err := s.resolveProductBrand(ctx, req.BrandId, req.Brand)
+		if err != nil {
+			fmt.Printf("解析商品品牌失败: %v\n", err)
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询品牌失败"),
+			}
+		}
+		if msg != "" {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr(msg),
+			}
+		}
+		product.Brand, product.BrandID = "", 0
+		if brand != nil {
+			product.Brand, product.BrandID = brand.Name, brand.ID
+		}
+		update.Fields["brand"] = product.Brand
+		update.Fields["brand_id"] = product.BrandID
 	}
-	if req.Brand != nil {
-		product.Brand = *req.Brand
+	if req.LowStockThreshold != nil {
+		if *req.LowStockThreshold < 0 {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("低库存阈值不能为负数"),
+			}
+		}
+		product.LowStockThreshold = *req.LowStockThreshold
+		update.Fields["low_stock_threshold"] = product.LowStockThreshold
+	}
+	if req.SoldOutPolicy != nil {
+		product.SoldOutPolicy = model.SoldOutPolicy(*req.SoldOutPolicy)
+		update.Fields["sold_out_policy"] = product.SoldOutPolicy
 	}
 	product.UpdatedAt = time.Now().Unix()
-	err = s.productRepo.Update(ctx, product)
+	update.Fields["updated_at"] = product.UpdatedAt
+	err = s.productRepo.Update(ctx, update, movement, priceChange, attributes)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		latest, findErr := s.productRepo.FindByID(ctx, product.ID)
+		if findErr != nil || latest == nil {
+			return &api.UpdateProductResp{
+				Success: false,
+				Code:    409,
+				Message: stringPtr("商品已被修改，请刷新后重试"),
+			}
+		}
+		return s.versionConflictResp(latest)
+	}
 	if err != nil {
 		return &api.UpdateProductResp{
 			Success: false,
 			Code:    500,
 			Message: stringPtr("更新商品失败"),
-		}, nil
+		}
+	}
+	product.Version++
+	s.auditProductChange(ctx, model.AuditActionUpdate, operator, &before, product, remark)
+	s.publishEvent(ctx, product.ID, model.ProductEventUpdated)
+	if movement != nil {
+		s.publishEvent(ctx, product.ID, model.ProductEventStockChanged)
+		s.onStockChanged(ctx, product.ID, oldStock, product.Stock)
+		if refreshed, err := s.productRepo.FindByID(ctx, product.ID); err == nil && refreshed != nil {
+			product = refreshed
+		}
 	}
+	s.invalidateProductCache(ctx, product.ID)
+	apiProduct := s.convertToAPIProduct(product)
+	apiProduct.Attributes, _ = s.loadProductAttributes(ctx, product)
 	return &api.UpdateProductResp{
 		Success: true,
 		Code:    0,
 		Message: stringPtr("更新成功"),
-		Product: s.convertToAPIProduct(product),
-	}, nil
+		Product: apiProduct,
+	}
+}
+
+// 版本冲突时返回最新商品，便于调用方刷新后重试
+func (s *productServiceImpl) versionConflictResp(latest *model.Product) *api.UpdateProductResp {
+	return &api.UpdateProductResp{
+		Success: false,
+		Code:    409,
+		Message: stringPtr(fmt.Sprintf("商品已被修改，当前版本为%d，请刷新后重试", latest.Version)),
+		Product: s.convertToAPIProduct(latest),
+	}
 }
 
 // 删除商品
-func (s *productServiceImpl) DeleteProduct(ctx context.Context, id int64) (*api.DeleteProductResp, error) {
+func (s *productServiceImpl) DeleteProduct(ctx context.Context, req *api.DeleteProductReq) (*api.DeleteProductResp, error) {
+	id := req.Id
 	product, err := s.productRepo.FindByID(ctx, id)
 	if err != nil {
 		return &api.DeleteProductResp{
@@ -189,6 +768,21 @@ func (s *productServiceImpl) DeleteProduct(ctx context.Context, id int64) (*api.
 			Message: stringPtr("商品不存在"),
 		}, nil
 	}
+	bundleIDs, err := s.bundleRepo.FindBundleIDs(ctx, id)
+	if err != nil {
+		return &api.DeleteProductResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品所属套装失败"),
+		}, nil
+	}
+	if len(bundleIDs) > 0 {
+		return &api.DeleteProductResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("商品是套装%v的组件，请先修改套装组件", bundleIDs)),
+		}, nil
+	}
 	err = s.productRepo.UpdateStatus(ctx, id, model.ProductStatusDELETED)
 	if err != nil {
 		return &api.DeleteProductResp{
@@ -197,6 +791,9 @@ func (s *productServiceImpl) DeleteProduct(ctx context.Context, id int64) (*api.
 			Message: stringPtr("删除商品失败"),
 		}, nil
 	}
+	s.publishEvent(ctx, id, model.ProductEventDeleted)
+	s.auditReloadedProduct(ctx, model.AuditActionDelete, operatorOrDefault(req.Operator), product, "")
+	s.invalidateProductCache(ctx, id)
 	return &api.DeleteProductResp{
 		Success: true,
 		Code:    0,
@@ -204,8 +801,9 @@ func (s *productServiceImpl) DeleteProduct(ctx context.Context, id int64) (*api.
 	}, nil
 }
 
-// 上架商品
-func (s *productServiceImpl) OnlineProduct(ctx context.Context, id int64) (*api.OnlineProductResp, error) {
+// 上架商品，指定未来的执行时间时创建定时上架计划
+func (s *productServiceImpl) OnlineProduct(ctx context.Context, req *api.OnlineProductReq) (*api.OnlineProductResp, error) {
+	id := req.Id
 	product, err := s.productRepo.FindByID(ctx, id)
 	if err != nil {
 		return &api.OnlineProductResp{
@@ -221,7 +819,42 @@ func (s *productServiceImpl) OnlineProduct(ctx context.Context, id int64) (*api.
 			Message: stringPtr("商品不存在"),
 		}, nil
 	}
+	if product.ApprovalStatus != model.ApprovalStatusAPPROVED {
+		return &api.OnlineProductResp{
+			Success: false,
+			Code:    403,
+			Message: stringPtr("商品未通过审核，不能上架"),
+		}, nil
+	}
+	if product.MerchantID > 0 {
+		if code, msg := s.checkMerchantActive(ctx, product.MerchantID); msg != "" {
+			return &api.OnlineProductResp{
+				Success: false,
+				Code:    code,
+				Message: stringPtr(msg),
+			}, nil
+		}
+	}
 	oldStatus := product.Status
+	if isFutureExecution(req.ExecuteAt) {
+		schedule, code, msg := s.createStatusSchedule(ctx, product, model.ProductStatusONLINE, *req.ExecuteAt, req.Operator)
+		if schedule == nil {
+			return &api.OnlineProductResp{
+				Success: false,
+				Code:    code,
+				Message: stringPtr(msg),
+			}, nil
+		}
+		return &api.OnlineProductResp{
+			Success:    true,
+			Code:       0,
+			Message:    stringPtr("已创建定时上架计划"),
+			OldStatus:  api.ProductStatus(oldStatus),
+			NewStatus_: api.ProductStatus(model.ProductStatusONLINE),
+			OperatedAt: schedule.ExecuteAt,
+			ScheduleId: &schedule.ID,
+		}, nil
+	}
 	err = s.productRepo.Online(ctx, id)
 	if err != nil {
 		return &api.OnlineProductResp{
@@ -230,6 +863,9 @@ func (s *productServiceImpl) OnlineProduct(ctx context.Context, id int64) (*api.
 			Message: stringPtr("上架商品失败"),
 		}, nil
 	}
+	s.publishEvent(ctx, id, model.ProductEventStatusChanged)
+	s.auditReloadedProduct(ctx, model.AuditActionOnline, operatorOrDefault(req.Operator), product, "")
+	s.invalidateProductCache(ctx, id)
 	return &api.OnlineProductResp{
 		Success:    true,
 		Code:       0,
@@ -240,8 +876,9 @@ func (s *productServiceImpl) OnlineProduct(ctx context.Context, id int64) (*api.
 	}, nil
 }
 
-// 下架
-func (s *productServiceImpl) OfflineProduct(ctx context.Context, id int64) (*api.OfflineProductResp, error) {
+// 下架，指定未来的执行时间时创建定时下架计划
+func (s *productServiceImpl) OfflineProduct(ctx context.Context, req *api.OfflineProductReq) (*api.OfflineProductResp, error) {
+	id := req.Id
 	product, err := s.productRepo.FindByID(ctx, id)
 	if err != nil {
 		return &api.OfflineProductResp{
@@ -259,6 +896,25 @@ func (s *productServiceImpl) OfflineProduct(ctx context.Context, id int64) (*api
 		}, nil
 	}
 	oldStatus := product.Status
+	if isFutureExecution(req.ExecuteAt) {
+		schedule, code, msg := s.createStatusSchedule(ctx, product, model.ProductStatusOFFLINE, *req.ExecuteAt, req.Operator)
+		if schedule == nil {
+			return &api.OfflineProductResp{
+				Success: false,
+				Code:    code,
+				Message: stringPtr(msg),
+			}, nil
+		}
+		return &api.OfflineProductResp{
+			Success:    true,
+			Code:       0,
+			Message:    stringPtr("已创建定时下架计划"),
+			OldStatus:  api.ProductStatus(oldStatus),
+			NewStatus_: api.ProductStatus(model.ProductStatusOFFLINE),
+			OperatedAt: schedule.ExecuteAt,
+			ScheduleId: &schedule.ID,
+		}, nil
+	}
 	err = s.productRepo.Offline(ctx, id)
 	if err != nil {
 		return &api.OfflineProductResp{
@@ -267,6 +923,9 @@ func (s *productServiceImpl) OfflineProduct(ctx context.Context, id int64) (*api
 			Message: stringPtr("下架商品失败"),
 		}, nil
 	}
+	s.publishEvent(ctx, id, model.ProductEventStatusChanged)
+	s.auditReloadedProduct(ctx, model.AuditActionOffline, operatorOrDefault(req.Operator), product, "")
+	s.invalidateProductCache(ctx, id)
 	return &api.OfflineProductResp{
 		Success:    true,
 		Code:       0,
@@ -285,14 +944,29 @@ func (s *productServiceImpl) UserSearchProducts(ctx context.Context, req *api.Us
 	if req.PageSize <= 0 || req.PageSize > 100 {
 		req.PageSize = 20
 	}
-	products, total, err := s.productRepo.SearchForUser(ctx,
-		req.Category,
-		req.MinPrice,
-		req.MaxPrice,
-		req.Keyword,
-		req.Page,
-		req.PageSize,
-	)
+	if !isValidSortBy(req.GetSortBy()) {
+		return &api.UserSearchProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("不支持的排序方式"),
+		}, nil
+	}
+	conditions, msg, err := s.buildAttributeConditions(ctx, req.Category, req.AttributeFilters)
+	if err != nil {
+		return &api.UserSearchProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	if msg != "" {
+		return &api.UserSearchProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	result, err := s.searchForUserCached(ctx, req, conditions)
 	if err != nil {
 		return &api.UserSearchProductsResp{
 			Success: false,
@@ -300,18 +974,21 @@ func (s *productServiceImpl) UserSearchProducts(ctx context.Context, req *api.Us
 			Message: stringPtr("搜索商品失败"),
 		}, nil
 	}
-	apiProducts := make([]*api.SimpleProduct, 0, len(products))
-	for _, p := range products {
-		apiProducts = append(apiProducts, s.convertToAPISimpleProduct(p))
+	//只在首页记录搜索词，翻页不重复计数
+	if req.Keyword != nil && req.Page == 1 {
+		s.recordSearchQuery(ctx, *req.Keyword, result.Total)
 	}
+	s.localizeSimpleProducts(ctx, result.Products)
+	s.applySimplePriceTiers(ctx, result.Products)
 	return &api.UserSearchProductsResp{
-		Success:  true,
-		Code:     0,
-		Message:  stringPtr("搜索成功"),
-		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
-		Products: apiProducts,
+		Success:     true,
+		Code:        0,
+		Message:     stringPtr("搜索成功"),
+		Total:       int32(result.Total),
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Products:    result.Products,
+		BrandFacets: result.BrandFacets,
 	}, nil
 }
 
@@ -323,12 +1000,38 @@ func (s *productServiceImpl) AdminSearchProducts(ctx context.Context, req *api.A
 	if req.PageSize <= 0 || req.PageSize > 100 {
 		req.PageSize = 20
 	}
+	if !isValidSortBy(req.GetSortBy()) {
+		return &api.AdminSearchProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("不支持的排序方式"),
+		}, nil
+	}
+	conditions, msg, err := s.buildAttributeConditions(ctx, req.Category, req.AttributeFilters)
+	if err != nil {
+		return &api.AdminSearchProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询属性定义失败"),
+		}, nil
+	}
+	if msg != "" {
+		return &api.AdminSearchProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
 	products, total, err := s.productRepo.SearchForAdmin(ctx,
 		req.Id,
+		req.MerchantId,
 		req.Category,
 		req.MinPrice,
 		req.MaxPrice,
 		req.Keyword,
+		req.BrandIds,
+		conditions,
+		req.GetSortBy(),
 		req.Page,
 		req.PageSize,
 	)
@@ -340,39 +1043,364 @@ func (s *productServiceImpl) AdminSearchProducts(ctx context.Context, req *api.A
 			Message: stringPtr("搜索商品失败"),
 		}, nil
 	}
+	facets, err := s.productRepo.BrandFacetsForAdmin(ctx, req.Id, req.MerchantId, req.Category, req.MinPrice, req.MaxPrice, req.Keyword, conditions)
+	var brandFacets []*api.BrandFacet
+	if err == nil {
+		brandFacets, err = s.convertToAPIBrandFacets(ctx, facets)
+	}
+	if err != nil {
+		return &api.AdminSearchProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("统计品牌分面失败"),
+		}, nil
+	}
 	apiProducts := make([]*api.Product, 0, len(products))
 	for _, p := range products {
 		apiProducts = append(apiProducts, s.convertToAPIProduct(p))
 	}
 	return &api.AdminSearchProductsResp{
+		Success:     true,
+		Code:        0,
+		Message:     stringPtr("搜索成功"),
+		Total:       int32(total),
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Products:    apiProducts,
+		BrandFacets: brandFacets,
+	}, nil
+}
+
+// 调整库存
+func (s *productServiceImpl) AdjustStock(ctx context.Context, req *api.AdjustStockReq) (*api.AdjustStockResp, error) {
+	if req.ProductId <= 0 {
+		return &api.AdjustStockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品ID无效"),
+		}, nil
+	}
+	if req.Delta == 0 {
+		return &api.AdjustStockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("库存变动数量不能为0"),
+		}, nil
+	}
+	if req.Reason == api.StockChangeReason_INITIAL {
+		return &api.AdjustStockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("初始库存只能在创建商品时记录"),
+		}, nil
+	}
+	product, err := s.findProductCached(ctx, req.ProductId)
+	if err != nil {
+		return &api.AdjustStockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product != nil && product.Type == model.ProductTypeBUNDLE {
+		return &api.AdjustStockResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("套装库存由组件库存决定，请调整组件库存"),
+		}, nil
+	}
+	movement := &model.StockMovement{
+		ProductID: req.ProductId,
+		Delta:     req.Delta,
+		Reason:    model.StockChangeReason(req.Reason),
+		Operator:  defaultStockOperator,
+	}
+	if req.ReferenceId != nil && *req.ReferenceId != "" {
+		movement.ReferenceID = req.ReferenceId
+	}
+	if req.Operator != nil && *req.Operator != "" {
+		movement.Operator = *req.Operator
+	}
+	if req.WarehouseId != nil {
+		movement.WarehouseID = *req.WarehouseId
+	}
+	duplicated, err := s.productRepo.AdjustStock(ctx, movement)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			return &api.AdjustStockResp{
+				Success: false,
+				Code:    404,
+				Message: stringPtr("商品不存在"),
+			}, nil
+		case errors.Is(err, repository.ErrWarehouseNotFound):
+			return &api.AdjustStockResp{
+				Success: false,
+				Code:    404,
+				Message: stringPtr("仓库不存在"),
+			}, nil
+		case errors.Is(err, repository.ErrStockNotEnough):
+			return &api.AdjustStockResp{
+				Success: false,
+				Code:    409,
+				Message: stringPtr("库存不足"),
+			}, nil
+		}
+		fmt.Printf("调整库存失败: product=%d, delta=%d, err=%v\n", req.ProductId, req.Delta, err)
+		return &api.AdjustStockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("调整库存失败"),
+		}, nil
+	}
+	if !duplicated {
+		s.recordAudit(ctx, req.ProductId, model.AuditActionStockChange, movement.Operator, []model.AuditChange{{
+			Field:  "stock",
+			Before: strconv.FormatInt(int64(movement.Balance-movement.Delta), 10),
+			After:  strconv.FormatInt(int64(movement.Balance), 10),
+		}}, stockAuditRemark(movement))
+		s.publishEvent(ctx, req.ProductId, model.ProductEventStockChanged)
+		s.onStockChanged(ctx, req.ProductId, movement.Balance-movement.Delta, movement.Balance)
+		s.invalidateProductCache(ctx, req.ProductId)
+	}
+	return &api.AdjustStockResp{
+		Success:    true,
+		Code:       0,
+		Message:    stringPtr("调整成功"),
+		Balance:    movement.Balance,
+		MovementId: movement.ID,
+	}, nil
+}
+
+// 检查库存
+func (s *productServiceImpl) CheckStock(ctx context.Context, id int64, quantity int32) (bool, error) {
+	return s.productRepo.CheckStock(ctx, id, quantity)
+}
+
+// 查询库存流水
+func (s *productServiceImpl) ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq) (*api.ListStockMovementsResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	filter := repository.StockMovementFilter{
+		ProductID:   req.ProductId,
+		ReferenceID: req.ReferenceId,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+	}
+	if req.Reason != nil {
+		reason := model.StockChangeReason(*req.Reason)
+		filter.Reason = &reason
+	}
+	movements, total, err := s.movementRepo.List(ctx, filter, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListStockMovementsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询库存流水失败"),
+		}, nil
+	}
+	apiMovements := make([]*api.StockMovement, 0, len(movements))
+	for _, m := range movements {
+		apiMovements = append(apiMovements, s.convertToAPIStockMovement(m))
+	}
+	return &api.ListStockMovementsResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("查询成功"),
+		Total:     int32(total),
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Movements: apiMovements,
+	}, nil
+}
+
+// 对账：用流水重算库存并与商品表比对
+func (s *productServiceImpl) ReconcileStock(ctx context.Context, productID *int64) (*api.ReconcileStockResp, error) {
+	stocks, err := s.productRepo.StockSnapshot(ctx, productID)
+	if err != nil {
+		return &api.ReconcileStockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品库存失败"),
+		}, nil
+	}
+	balances, err := s.movementRepo.LedgerBalances(ctx, productID)
+	if err != nil {
+		return &api.ReconcileStockResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询库存流水失败"),
+		}, nil
+	}
+	discrepancies := make([]*api.StockDiscrepancy, 0)
+	for id, stock := range stocks {
+		ledgerStock := balances[id]
+		if ledgerStock == stock {
+			continue
+		}
+		discrepancies = append(discrepancies, &api.StockDiscrepancy{
+			ProductId:   id,
+			Stock:       stock,
+			LedgerStock: ledgerStock,
+			Difference:  stock - ledgerStock,
+		})
+	}
+	sort.Slice(discrepancies, func(i, j int) bool {
+		return discrepancies[i].ProductId < discrepancies[j].ProductId
+	})
+	message := "库存与流水一致"
+	if len(discrepancies) > 0 {
+		message = fmt.Sprintf("发现%d个商品库存与流水不一致", len(discrepancies))
+	}
+	return &api.ReconcileStockResp{
+		Success:       true,
+		Code:          0,
+		Message:       stringPtr(message),
+		Checked:       int32(len(stocks)),
+		Discrepancies: discrepancies,
+	}, nil
+}
+
+// 批量获取商品
+func (s *productServiceImpl) BatchGetProducts(ctx context.Context, ids []int64) (*api.BatchGetProductsResp, error) {
+	if len(ids) == 0 {
+		return &api.BatchGetProductsResp{
+			Success:  true,
+			Code:     0,
+			Message:  stringPtr("查询成功"),
+			Products: []*api.Product{},
+		}, nil
+	}
+	//去重
+	seen := make(map[int64]struct{}, len(ids))
+	uniqueIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		uniqueIDs = append(uniqueIDs, id)
+	}
+	if len(uniqueIDs) > maxBatchGetProducts {
+		return &api.BatchGetProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("单次最多查询%d个商品", maxBatchGetProducts)),
+		}, nil
+	}
+	products, err := s.productRepo.FindByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return &api.BatchGetProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	components, err := s.loadBundleComponents(ctx, products)
+	if err != nil {
+		return &api.BatchGetProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询套装组件失败"),
+		}, nil
+	}
+	apiProducts := make([]*api.Product, 0, len(products))
+	for _, p := range products {
+		apiProduct := s.convertToAPIProduct(p)
+		apiProduct.Components = components[p.ID]
+		apiProducts = append(apiProducts, apiProduct)
+	}
+	s.localizeProducts(ctx, apiProducts)
+	s.applyPriceTiers(ctx, apiProducts)
+	return &api.BatchGetProductsResp{
 		Success:  true,
 		Code:     0,
-		Message:  stringPtr("搜索成功"),
-		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Message:  stringPtr("查询成功"),
 		Products: apiProducts,
 	}, nil
 }
 
-// 更新库存
-func (s *productServiceImpl) UpdateStock(ctx context.Context, id int64, delta int32) error {
-
-	success, err := s.productRepo.UpdateStock(ctx, id, delta)
+// 查询商品变更事件
+func (s *productServiceImpl) ListProductEvents(ctx context.Context, afterID int64, limit int32) (*api.ListProductEventsResp, error) {
+	if afterID < 0 {
+		lastID, err := s.eventRepo.LastID(ctx)
+		if err != nil {
+			return &api.ListProductEventsResp{
+				Success: false,
+				Code:    500,
+				Message: stringPtr("查询事件失败"),
+			}, nil
+		}
+		return &api.ListProductEventsResp{
+			Success: true,
+			Code:    0,
+			Message: stringPtr("查询成功"),
+			Events:  []*api.ProductEvent{},
+			LastId:  lastID,
+		}, nil
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	events, err := s.eventRepo.ListAfter(ctx, afterID, int(limit))
 	if err != nil {
-		return err
+		return &api.ListProductEventsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询事件失败"),
+		}, nil
 	}
-
-	if !success {
-		return errors.New("更新库存失败")
+	lastID := afterID
+	apiEvents := make([]*api.ProductEvent, 0, len(events))
+	for _, e := range events {
+		apiEvents = append(apiEvents, &api.ProductEvent{
+			Id:        e.ID,
+			ProductId: e.ProductID,
+			Type:      e.Type,
+			CreatedAt: e.CreatedAt,
+		})
+		lastID = e.ID
 	}
+	return &api.ListProductEventsResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("查询成功"),
+		Events:  apiEvents,
+		LastId:  lastID,
+	}, nil
+}
 
-	return nil
+// 清理超过保留期的商品事件；订阅方游标落后于保留期时会重新以最新事件ID为起点，保留期需远大于订阅方缓存有效期
+func (s *productServiceImpl) PruneProductEvents(ctx context.Context) error {
+	before := time.Now().Add(-productEventRetention).Unix()
+	for {
+		deleted, err := s.eventRepo.DeleteBefore(ctx, before, productEventPruneBatchSize)
+		if err != nil {
+			return fmt.Errorf("清理商品事件失败: %w", err)
+		}
+		if deleted < productEventPruneBatchSize {
+			return nil
+		}
+	}
 }
 
-// 检查库存
-func (s *productServiceImpl) CheckStock(ctx context.Context, id int64, quantity int32) (bool, error) {
-	return s.productRepo.CheckStock(ctx, id, quantity)
+// 发布商品变更事件，失败只记录日志
+func (s *productServiceImpl) publishEvent(ctx context.Context, productID int64, eventType string) {
+	if s.eventRepo == nil {
+		return
+	}
+	event := &model.ProductEvent{
+		ProductID: productID,
+		Type:      eventType,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		fmt.Printf("写入商品事件失败: product=%d, type=%s, err=%v\n", productID, eventType, err)
+	}
 }
 
 // 模型转化
@@ -392,20 +1420,60 @@ func (s *productServiceImpl) convertToAPIProduct(p *model.Product) *api.Product
 	if p.Brand != "" {
 		product.Brand = &p.Brand
 	}
+	product.LowStockThreshold = p.LowStockThreshold
+	product.SoldOutPolicy = api.SoldOutPolicy(p.SoldOutPolicy)
+	if p.OriginalPrice > 0 {
+		product.OriginalPrice = &p.OriginalPrice
+	}
+	product.ExternalCode = p.ExternalCode
+	product.RatingAvg = p.RatingAvg
+	product.RatingCount = p.RatingCount
+	product.Version = p.Version
+	product.BrandId = p.BrandID
+	product.ApprovalStatus = api.ApprovalStatus(p.ApprovalStatus)
+	product.MerchantId = p.MerchantID
+	product.Type = api.ProductType(p.Type)
+	product.PurchaseLimit = p.PurchaseLimit
+	product.PurchaseLimitDays = p.PurchaseLimitDays
 
 	return product
 }
 
+// 模型转化
+func (s *productServiceImpl) convertToAPIStockMovement(m *model.StockMovement) *api.StockMovement {
+	movement := &api.StockMovement{
+		Id:        m.ID,
+		ProductId: m.ProductID,
+		Delta:     m.Delta,
+		Balance:   m.Balance,
+		Reason:    api.StockChangeReason(m.Reason),
+		Operator:  m.Operator,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.ReferenceID != nil {
+		movement.ReferenceId = m.ReferenceID
+	}
+	if m.WarehouseID > 0 {
+		movement.WarehouseId = &m.WarehouseID
+	}
+	return movement
+}
+
 // 模型转化
 func (s *productServiceImpl) convertToAPISimpleProduct(p *model.Product) *api.SimpleProduct {
 	simpleProduct := &api.SimpleProduct{
-		Id:       p.ID,
-		Category: p.Category,
-		Price:    p.Price,
-		Stock:    p.Stock,
-		Status:   api.ProductStatus(p.Status),
-		Name:     p.Name,
-		Avatar:   p.Avatar,
+		Id:          p.ID,
+		Category:    p.Category,
+		Price:       p.Price,
+		Stock:       p.Stock,
+		Status:      api.ProductStatus(p.Status),
+		Name:        p.Name,
+		Avatar:      p.Avatar,
+		RatingAvg:   p.RatingAvg,
+		RatingCount: p.RatingCount,
+		BrandId:     p.BrandID,
+		MerchantId:  p.MerchantID,
+		Type:        api.ProductType(p.Type),
 	}
 
 	if p.Brand != "" {
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"time"
+)
+
+// 每轮处理的定时上下架计划数量
+const statusScheduleBatchSize = 100
+
+// 执行时间晚于当前时间才需要定时执行
+func isFutureExecution(executeAt *int64) bool {
+	return executeAt != nil && *executeAt > time.Now().Unix()
+}
+
+// 创建定时上下架计划，失败时返回错误码和原因
+func (s *productServiceImpl) createStatusSchedule(ctx context.Context, product *model.Product,
+	target model.ProductStatus, executeAt int64, operator *string) (*model.ProductStatusSchedule, int32, string) {
+	if product.Status == model.ProductStatusDELETED {
+		return nil, 400, "商品已删除"
+	}
+	now := time.Now().Unix()
+	schedule := &model.ProductStatusSchedule{
+		ProductID:    product.ID,
+		TargetStatus: target,
+		ExecuteAt:    executeAt,
+		Status:       model.StatusScheduleStatusPending,
+		Operator:     defaultStockOperator,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if operator != nil && *operator != "" {
+		schedule.Operator = *operator
+	}
+	if err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+		fmt.Printf("创建定时上下架计划失败: %v\n", err)
+		return nil, 500, "创建定时计划失败"
+	}
+	return schedule, 0, ""
+}
+
+// 查询定时上下架计划
+func (s *productServiceImpl) ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq) (*api.ListProductStatusSchedulesResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	schedules, total, err := s.scheduleRepo.List(ctx, req.ProductId, req.Status, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListProductStatusSchedulesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询定时计划失败"),
+		}, nil
+	}
+	apiSchedules := make([]*api.ProductStatusSchedule, 0, len(schedules))
+	for _, sc := range schedules {
+		apiSchedules = append(apiSchedules, s.convertToAPIStatusSchedule(sc))
+	}
+	return &api.ListProductStatusSchedulesResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("查询成功"),
+		Total:     int32(total),
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Schedules: apiSchedules,
+	}, nil
+}
+
+// 取消未执行的定时上下架计划
+func (s *productServiceImpl) CancelProductStatusSchedule(ctx context.Context, id int64) (*api.CancelProductStatusScheduleResp, error) {
+	schedule, err := s.scheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		return &api.CancelProductStatusScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询定时计划失败"),
+		}, nil
+	}
+	if schedule == nil {
+		return &api.CancelProductStatusScheduleResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("定时计划不存在"),
+		}, nil
+	}
+	cancelled, err := s.scheduleRepo.CancelPending(ctx, id, time.Now().Unix())
+	if err != nil {
+		return &api.CancelProductStatusScheduleResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("取消定时计划失败"),
+		}, nil
+	}
+	if !cancelled {
+		return &api.CancelProductStatusScheduleResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("定时计划已执行或已取消"),
+		}, nil
+	}
+	return &api.CancelProductStatusScheduleResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("取消成功"),
+	}, nil
+}
+
+// 执行到期的定时上下架计划
+func (s *productServiceImpl) ProcessStatusSchedules(ctx context.Context) error {
+	ctx = WithAuditSource(ctx, model.AuditSourceScheduler)
+	now := time.Now().Unix()
+	schedules, err := s.scheduleRepo.FindDue(ctx, now, statusScheduleBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range schedules {
+		before, _ := s.productRepo.FindByID(ctx, schedule.ProductID)
+		applied, err := s.scheduleRepo.Execute(ctx, schedule.ID, now)
+		if err != nil {
+			fmt.Printf("执行定时上下架计划失败: schedule=%d, err=%v\n", schedule.ID, err)
+			continue
+		}
+		if applied {
+			s.publishEvent(ctx, schedule.ProductID, model.ProductEventStatusChanged)
+			action := model.AuditActionOffline
+			if schedule.TargetStatus == model.ProductStatusONLINE {
+				action = model.AuditActionOnline
+			}
+			s.auditReloadedProduct(ctx, action, operatorOrDefault(&schedule.Operator), before,
+				fmt.Sprintf("定时上下架计划#%d", schedule.ID))
+			s.invalidateProductCache(ctx, schedule.ProductID)
+		}
+	}
+	return nil
+}
+
+// 模型转化
+func (s *productServiceImpl) convertToAPIStatusSchedule(sc *model.ProductStatusSchedule) *api.ProductStatusSchedule {
+	item := &api.ProductStatusSchedule{
+		Id:           sc.ID,
+		ProductId:    sc.ProductID,
+		TargetStatus: api.ProductStatus(sc.TargetStatus),
+		ExecuteAt:    sc.ExecuteAt,
+		Status:       sc.Status,
+		Operator:     sc.Operator,
+		ExecutedAt:   sc.ExecutedAt,
+		CreatedAt:    sc.CreatedAt,
+		UpdatedAt:    sc.UpdatedAt,
+	}
+	if sc.Message != "" {
+		item.Message = stringPtr(sc.Message)
+	}
+	return item
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/notifier"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"time"
+)
+
+// 预警通知的超时时间
+const alertNotifyTimeout = 5 * time.Second
+
+// 库存变化后同步所属套装库存，并检查预警阈值、售罄策略、自动恢复上架和到货通知
+func (s *productServiceImpl) onStockChanged(ctx context.Context, productID int64, oldStock, newStock int32) {
+	if oldStock == newStock {
+		return
+	}
+	s.syncBundleStocks(ctx, productID)
+	product, err := s.productRepo.FindByID(ctx, productID)
+	if err != nil || product == nil {
+		return
+	}
+
+	switch {
+	case newStock <= 0 && oldStock > 0:
+		s.handleSoldOut(ctx, product)
+	case newStock > 0 && oldStock <= 0:
+		s.handleRestocked(ctx, product)
+		s.notifyRestockAsync(productID)
+	}
+
+	threshold := product.LowStockThreshold
+	if threshold > 0 && newStock > 0 && newStock <= threshold && oldStock > threshold {
+		s.publishEvent(ctx, productID, model.ProductEventLowStock)
+		s.sendAlert(product, notifier.AlertLowStock, "")
+	}
+}
+
+// 售罄：按商品策略标记售罄或自动下架
+func (s *productServiceImpl) handleSoldOut(ctx context.Context, product *model.Product) {
+	s.publishEvent(ctx, product.ID, model.ProductEventSoldOut)
+
+	action := ""
+	var status model.ProductStatus
+	switch product.SoldOutPolicy {
+	case model.SoldOutPolicySOLDOUT:
+		status, action = model.ProductStatusSOLDOUT, "sold_out"
+	case model.SoldOutPolicyOFFLINE:
+		status, action = model.ProductStatusOFFLINE, "offline"
+	}
+	if action != "" {
+		changed, err := s.productRepo.MarkSoldOut(ctx, product.ID, status)
+		if err != nil {
+			fmt.Printf("售罄处理失败: product=%d, err=%v\n", product.ID, err)
+			action = ""
+		} else if changed {
+			s.publishEvent(ctx, product.ID, model.ProductEventStatusChanged)
+			s.auditReloadedProduct(ctx, model.AuditActionStatusChange, defaultStockOperator, product, "库存售罄自动处理")
+		} else {
+			// 商品本就不在售，无需处理
+			action = ""
+		}
+	}
+	product.Stock = 0
+	s.sendAlert(product, notifier.AlertSoldOut, action)
+}
+
+// 补货：恢复被系统自动售罄/下架的商品
+func (s *productServiceImpl) handleRestocked(ctx context.Context, product *model.Product) {
+	if !product.AutoDelisted {
+		return
+	}
+	relisted, err := s.productRepo.Relist(ctx, product.ID)
+	if err != nil {
+		fmt.Printf("自动恢复上架失败: product=%d, err=%v\n", product.ID, err)
+		return
+	}
+	if !relisted {
+		return
+	}
+	s.publishEvent(ctx, product.ID, model.ProductEventRestocked)
+	s.publishEvent(ctx, product.ID, model.ProductEventStatusChanged)
+	s.auditReloadedProduct(ctx, model.AuditActionStatusChange, defaultStockOperator, product, "补货自动恢复上架")
+	s.sendAlert(product, notifier.AlertRestocked, "relisted")
+}
+
+// 异步发送预警，通知失败不影响库存变更
+func (s *productServiceImpl) sendAlert(product *model.Product, alertType, action string) {
+	if s.notifier == nil {
+		return
+	}
+	alert := &notifier.Alert{
+		Type:        alertType,
+		ProductID:   product.ID,
+		ProductName: product.Name,
+		Stock:       product.Stock,
+		Threshold:   product.LowStockThreshold,
+		Action:      action,
+		CreatedAt:   time.Now().Unix(),
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), alertNotifyTimeout)
+		defer cancel()
+		if err := s.notifier.Notify(ctx, alert); err != nil {
+			fmt.Printf("发送库存预警失败: product=%d, type=%s, err=%v\n", alert.ProductID, alert.Type, err)
+		}
+	}()
+}
+
+// 低库存商品列表
+func (s *productServiceImpl) ListLowStockProducts(ctx context.Context, page, pageSize int32) (*api.ListLowStockProductsResp, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	products, total, err := s.productRepo.FindLowStock(ctx, page, pageSize)
+	if err != nil {
+		return &api.ListLowStockProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询低库存商品失败"),
+		}, nil
+	}
+	apiProducts := make([]*api.Product, 0, len(products))
+	for _, p := range products {
+		apiProducts = append(apiProducts, s.convertToAPIProduct(p))
+	}
+	return &api.ListLowStockProductsResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Total:    int32(total),
+		Page:     page,
+		PageSize: pageSize,
+		Products: apiProducts,
+	}, nil
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/kitex_gen/api"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// 联想词数量上限
+	defaultSuggestionLimit = 10
+	maxSuggestionLimit     = 20
+
+	// 前缀和搜索词的长度上限，超长的搜索词不记录
+	maxSuggestionPrefixLength = 50
+	maxRecordedQueryLength    = 100
+
+	// 联想结果缓存时长，联想词由定时任务重建，短时间内不会变化
+	suggestionCacheTTL = time.Minute
+
+	// 商品热度中评价数的权重，销量为主
+	suggestionWeightRating = 0.3
+)
+
+func suggestionCacheKey(prefix string, limit int32) string {
+	return fmt.Sprintf("product:suggest:%d:%s", limit, prefix)
+}
+
+// 搜索联想，按名称前缀或拼音首字母匹配商品、品牌和类目
+func (s *productServiceImpl) SuggestProducts(ctx context.Context, req *api.SuggestProductsReq) (*api.SuggestProductsResp, error) {
+	prefix := model.NormalizeSearchTerm(req.Prefix)
+	if prefix == "" {
+		return &api.SuggestProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("搜索前缀不能为空"),
+		}, nil
+	}
+	if utf8.RuneCountInString(prefix) > maxSuggestionPrefixLength {
+		return &api.SuggestProductsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("搜索前缀不能超过%d个字符", maxSuggestionPrefixLength)),
+		}, nil
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSuggestionLimit
+	}
+	if limit > maxSuggestionLimit {
+		limit = maxSuggestionLimit
+	}
+
+	suggestions, err := s.findSuggestionsCached(ctx, prefix, limit)
+	if err != nil {
+		fmt.Printf("查询联想词失败: prefix=%s, err=%v\n", prefix, err)
+		return &api.SuggestProductsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询联想词失败"),
+		}, nil
+	}
+	return &api.SuggestProductsResp{
+		Success:     true,
+		Code:        0,
+		Message:     stringPtr("查询成功"),
+		Suggestions: suggestions,
+	}, nil
+}
+
+// 读穿缓存查询联想词
+func (s *productServiceImpl) findSuggestionsCached(ctx context.Context, prefix string, limit int32) ([]*api.SearchSuggestion, error) {
+	load := func(ctx context.Context) ([]*api.SearchSuggestion, error) {
+		//品牌别名和同名商品会命中多条，多取一些去重后再截断
+		rows, err := s.suggestionRepo.FindByPrefix(ctx, prefix, int(limit)*3)
+		if err != nil {
+			return nil, err
+		}
+		type suggestionKey struct {
+			kind model.SuggestionKind
+			text string
+		}
+		seen := make(map[suggestionKey]bool, len(rows))
+		result := make([]*api.SearchSuggestion, 0, limit)
+		for _, row := range rows {
+			key := suggestionKey{kind: row.Kind, text: row.Text}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, &api.SearchSuggestion{
+				Text:   row.Text,
+				Kind:   api.SuggestionKind(row.Kind),
+				RefId:  row.RefID,
+				Weight: row.Weight,
+			})
+			if int32(len(result)) >= limit {
+				break
+			}
+		}
+		return result, nil
+	}
+
+	if s.cacheLoader == nil {
+		return load(ctx)
+	}
+	key := suggestionCacheKey(prefix, limit)
+	data, err := s.cacheLoader.Get(ctx, key, func(ctx context.Context) ([]byte, time.Duration, error) {
+		result, err := load(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		data, err := json.Marshal(result)
+		return data, suggestionCacheTTL, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []*api.SearchSuggestion
+	if err := json.Unmarshal(data, &result); err != nil {
+		fmt.Printf("解析联想词缓存失败: key=%s, err=%v\n", key, err)
+		s.cache.Delete(ctx, key)
+		return load(ctx)
+	}
+	return result, nil
+}
+
+// 重建联想词索引，由定时任务调用
+func (s *productServiceImpl) RefreshSuggestions(ctx context.Context) error {
+	products, err := s.suggestionRepo.ListSearchableProducts(ctx)
+	if err != nil {
+		return fmt.Errorf("查询可搜索商品失败: %w", err)
+	}
+	salesStats, err := s.recommendRepo.ListSalesStats(ctx)
+	if err != nil {
+		return fmt.Errorf("查询销量统计失败: %w", err)
+	}
+	sales := make(map[int64]int32, len(salesStats))
+	for _, stat := range salesStats {
+		sales[stat.ProductID] = stat.OrderCount
+	}
+
+	//商品热度取销量和评价数的对数，品牌和类目累加旗下商品的热度
+	suggestions := make([]*model.SearchSuggestion, 0, len(products))
+	brandWeights := make(map[int64]float64)
+	brandNames := make(map[int64]string)
+	legacyBrandWeights := make(map[string]float64)
+	categoryWeights := make(map[string]float64)
+	for _, p := range products {
+		weight := math.Log1p(float64(sales[p.ID])) + suggestionWeightRating*math.Log1p(float64(p.RatingCount))
+		suggestions = append(suggestions, newSearchSuggestion(p.Name, model.SuggestionKindPRODUCT, p.ID, weight))
+		if p.BrandID > 0 {
+			brandWeights[p.BrandID] += weight
+			brandNames[p.BrandID] = p.Brand
+		} else if p.Brand != "" {
+			legacyBrandWeights[p.Brand] += weight
+		}
+		if p.Category != "" {
+			categoryWeights[p.Category] += weight
+		}
+	}
+
+	//用户搜索过的品牌和类目额外加权
+	terms := make([]string, 0, len(brandNames)+len(legacyBrandWeights)+len(categoryWeights))
+	for _, name := range brandNames {
+		terms = append(terms, model.NormalizeSearchTerm(name))
+	}
+	for name := range legacyBrandWeights {
+		terms = append(terms, model.NormalizeSearchTerm(name))
+	}
+	for category := range categoryWeights {
+		terms = append(terms, model.NormalizeSearchTerm(category))
+	}
+	queryCounts, err := s.suggestionRepo.FindQueryCounts(ctx, terms)
+	if err != nil {
+		return fmt.Errorf("查询搜索词统计失败: %w", err)
+	}
+	queryWeight := func(term string) float64 {
+		return math.Log1p(float64(queryCounts[model.NormalizeSearchTerm(term)]))
+	}
+
+	//品牌名和别名都可以联想出品牌
+	brandIDs := make([]int64, 0, len(brandNames))
+	for id := range brandNames {
+		brandIDs = append(brandIDs, id)
+	}
+	aliases, err := s.brandRepo.ListAliases(ctx, brandIDs)
+	if err != nil {
+		return fmt.Errorf("查询品牌别名失败: %w", err)
+	}
+	for id, name := range brandNames {
+		weight := brandWeights[id] + queryWeight(name)
+		suggestions = append(suggestions, newSearchSuggestion(name, model.SuggestionKindBRAND, id, weight))
+		for _, alias := range aliases[id] {
+			if alias.Key == model.NormalizeBrandKey(name) {
+				continue
+			}
+			suggestion := newSearchSuggestion(name, model.SuggestionKindBRAND, id, weight)
+			suggestion.Key = model.NormalizeSearchTerm(alias.Alias)
+			suggestion.Initials = ""
+			if model.ContainsHan(alias.Alias) {
+				suggestion.Initials = model.PinyinInitials(alias.Alias)
+			}
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	for name, weight := range legacyBrandWeights {
+		suggestions = append(suggestions, newSearchSuggestion(name, model.SuggestionKindBRAND, 0, weight+queryWeight(name)))
+	}
+	for category, weight := range categoryWeights {
+		suggestions = append(suggestions, newSearchSuggestion(category, model.SuggestionKindCATEGORY, 0, weight+queryWeight(category)))
+	}
+
+	if err := s.suggestionRepo.ReplaceSuggestions(ctx, suggestions); err != nil {
+		return fmt.Errorf("保存联想词失败: %w", err)
+	}
+	return nil
+}
+
+func newSearchSuggestion(text string, kind model.SuggestionKind, refID int64, weight float64) *model.SearchSuggestion {
+	suggestion := &model.SearchSuggestion{
+		Text:   text,
+		Kind:   kind,
+		RefID:  refID,
+		Key:    model.NormalizeSearchTerm(text),
+		Weight: weight,
+	}
+	//纯字母数字的名称拼音首字母与匹配键相同，不重复存储
+	if model.ContainsHan(text) {
+		suggestion.Initials = model.PinyinInitials(text)
+	}
+	return suggestion
+}
+
+// 记录一次用户搜索，失败不影响搜索结果
+func (s *productServiceImpl) recordSearchQuery(ctx context.Context, keyword string, total int64) {
+	query := model.NormalizeSearchTerm(keyword)
+	if query == "" || utf8.RuneCountInString(query) > maxRecordedQueryLength {
+		return
+	}
+	if err := s.suggestionRepo.RecordQuery(ctx, query, int32(total), time.Now().Unix()); err != nil {
+		fmt.Printf("记录搜索词失败: query=%s, err=%v\n", query, err)
+	}
+}
+
+// 查询搜索词统计，供运营查看热门搜索和无结果搜索
+func (s *productServiceImpl) ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq) (*api.ListSearchQueriesResp, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	sortBy := req.GetSortBy()
+	switch sortBy {
+	case "", repository.QuerySortByCount, repository.QuerySortByZeroResult, repository.QuerySortByRecent:
+	default:
+		return &api.ListSearchQueriesResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("不支持的排序方式"),
+		}, nil
+	}
+	stats, total, err := s.suggestionRepo.ListQueries(ctx, req.GetZeroResultOnly(), sortBy, req.Page, req.PageSize)
+	if err != nil {
+		return &api.ListSearchQueriesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询搜索词统计失败"),
+		}, nil
+	}
+	queries := make([]*api.SearchQueryStat, 0, len(stats))
+	for _, stat := range stats {
+		queries = append(queries, &api.SearchQueryStat{
+			Query:           stat.Query,
+			SearchCount:     stat.SearchCount,
+			ZeroResultCount: stat.ZeroResultCount,
+			LastResultCount: stat.LastResultCount,
+			LastSearchedAt:  stat.LastSearchedAt,
+		})
+	}
+	return &api.ListSearchQueriesResp{
+		Success:  true,
+		Code:     0,
+		Message:  stringPtr("查询成功"),
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Queries:  queries,
+	}, nil
+}
@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// 未配置时的默认语言
+	fallbackDefaultLocale = "zh-CN"
+	// 翻译后商品名称的长度上限
+	maxTranslatedNameLength = 255
+	// 分类名称翻译的长度上限
+	maxCategoryLabelLength = 100
+)
+
+type localeKey struct{}
+
+// 标记请求的展示语言，未标记或无法识别时返回默认语言的内容
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+func localeFrom(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok {
+		return locale
+	}
+	return ""
+}
+
+// 规范化语言标签，如en-us转为en-US，无法识别时返回空串
+func normalizeLocale(locale string) string {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return ""
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return ""
+	}
+	return tag.String()
+}
+
+// 设置默认语言，商品表中的内容视为该语言
+func (s *productServiceImpl) SetDefaultLocale(locale string) {
+	normalized := normalizeLocale(locale)
+	if normalized == "" {
+		fmt.Printf("默认语言无效，使用%s: %q\n", fallbackDefaultLocale, locale)
+		normalized = fallbackDefaultLocale
+	}
+	s.defaultLocale = normalized
+}
+
+// 请求语言的翻译查找顺序：完整标签、只含语言的标签，如en-GB、en；请求默认语言时返回nil
+func (s *productServiceImpl) localeCandidates(ctx context.Context) []string {
+	locale := normalizeLocale(localeFrom(ctx))
+	if locale == "" || locale == s.defaultLocale {
+		return nil
+	}
+	candidates := []string{locale}
+	base, _ := language.Make(locale).Base()
+	if base.String() != locale && base.String() != s.defaultLocale {
+		candidates = append(candidates, base.String())
+	}
+	return candidates
+}
+
+// 查询请求语言下的商品和分类翻译，缺失的翻译不返回，调用方保留默认语言的内容
+func (s *productServiceImpl) loadTranslations(ctx context.Context, productIDs []int64,
+	categories []string) (map[int64]*model.ProductTranslation, map[string]string) {
+	candidates := s.localeCandidates(ctx)
+	if len(candidates) == 0 || s.translationRepo == nil {
+		return nil, nil
+	}
+	products, err := s.translationRepo.FindProductTranslations(ctx, productIDs, candidates)
+	if err != nil {
+		fmt.Printf("查询商品翻译失败: locale=%s, err=%v\n", candidates[0], err)
+	}
+	labels, err := s.translationRepo.FindCategoryTranslations(ctx, categories, candidates)
+	if err != nil {
+		fmt.Printf("查询分类翻译失败: locale=%s, err=%v\n", candidates[0], err)
+	}
+	productResult := make(map[int64]*model.ProductTranslation, len(products))
+	for id, byLocale := range products {
+		for _, locale := range candidates {
+			if t, ok := byLocale[locale]; ok {
+				productResult[id] = t
+				break
+			}
+		}
+	}
+	labelResult := make(map[string]string, len(labels))
+	for category, byLocale := range labels {
+		for _, locale := range candidates {
+			if t, ok := byLocale[locale]; ok {
+				labelResult[category] = t.Label
+				break
+			}
+		}
+	}
+	return productResult, labelResult
+}
+
+// 按请求语言替换商品名称、描述和分类名称
+func (s *productServiceImpl) localizeProducts(ctx context.Context, products []*api.Product) {
+	if len(products) == 0 || len(s.localeCandidates(ctx)) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(products))
+	categories := make([]string, 0, len(products))
+	for _, p := range products {
+		if p != nil {
+			ids = append(ids, p.Id)
+			categories = append(categories, p.Category)
+		}
+	}
+	translations, labels := s.loadTranslations(ctx, ids, categories)
+	for _, p := range products {
+		if p == nil {
+			continue
+		}
+		if t, ok := translations[p.Id]; ok {
+			p.Name = t.Name
+			if t.Description != "" {
+				p.Description = stringPtr(t.Description)
+			}
+		}
+		if label, ok := labels[p.Category]; ok {
+			p.CategoryLabel = stringPtr(label)
+		}
+	}
+}
+
+// 按请求语言替换商品列表中的名称和分类名称
+func (s *productServiceImpl) localizeSimpleProducts(ctx context.Context, products []*api.SimpleProduct) {
+	if len(products) == 0 || len(s.localeCandidates(ctx)) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(products))
+	categories := make([]string, 0, len(products))
+	for _, p := range products {
+		if p != nil {
+			ids = append(ids, p.Id)
+			categories = append(categories, p.Category)
+		}
+	}
+	translations, labels := s.loadTranslations(ctx, ids, categories)
+	for _, p := range products {
+		if p == nil {
+			continue
+		}
+		if t, ok := translations[p.Id]; ok {
+			p.Name = t.Name
+		}
+		if label, ok := labels[p.Category]; ok {
+			p.CategoryLabel = stringPtr(label)
+		}
+	}
+}
+
+// 校验翻译的语言标签，返回规范化后的标签
+func (s *productServiceImpl) validateTranslationLocale(locale string) (string, string) {
+	normalized := normalizeLocale(locale)
+	if normalized == "" {
+		return "", "语言标签无效"
+	}
+	if normalized == s.defaultLocale {
+		return "", "默认语言的内容请直接修改商品"
+	}
+	return normalized, ""
+}
+
+// 新增或覆盖商品翻译
+func (s *productServiceImpl) SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq) (*api.SetProductTranslationResp, error) {
+	locale, msg := s.validateTranslationLocale(req.Locale)
+	name := strings.TrimSpace(req.Name)
+	if msg == "" && name == "" {
+		msg = "翻译后的商品名称不能为空"
+	}
+	if msg == "" && utf8.RuneCountInString(name) > maxTranslatedNameLength {
+		msg = fmt.Sprintf("商品名称不能超过%d个字符", maxTranslatedNameLength)
+	}
+	if msg != "" {
+		return &api.SetProductTranslationResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	product, err := s.productRepo.FindByID(ctx, req.ProductId)
+	if err != nil {
+		return &api.SetProductTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品失败"),
+		}, nil
+	}
+	if product == nil || product.Status == model.ProductStatusDELETED {
+		return &api.SetProductTranslationResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("商品不存在"),
+		}, nil
+	}
+	existing, err := s.translationRepo.FindProductTranslations(ctx, []int64{product.ID}, []string{locale})
+	if err != nil {
+		return &api.SetProductTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品翻译失败"),
+		}, nil
+	}
+	before := existing[product.ID][locale]
+
+	now := time.Now().Unix()
+	translation := &model.ProductTranslation{
+		ProductID: product.ID,
+		Locale:    locale,
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if req.Description != nil {
+		translation.Description = strings.TrimSpace(*req.Description)
+	}
+	if err := s.translationRepo.UpsertProductTranslation(ctx, translation); err != nil {
+		fmt.Printf("保存商品翻译失败: product=%d, locale=%s, err=%v\n", product.ID, locale, err)
+		return &api.SetProductTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("保存商品翻译失败"),
+		}, nil
+	}
+	beforeName, beforeDescription := "", ""
+	if before != nil {
+		beforeName, beforeDescription = before.Name, before.Description
+	}
+	var changes []model.AuditChange
+	if beforeName != translation.Name {
+		changes = append(changes, model.AuditChange{Field: "name@" + locale, Before: beforeName, After: translation.Name})
+	}
+	if beforeDescription != translation.Description {
+		changes = append(changes, model.AuditChange{Field: "description@" + locale, Before: beforeDescription, After: translation.Description})
+	}
+	if len(changes) > 0 {
+		s.recordAudit(ctx, product.ID, model.AuditActionUpdate, operatorOrDefault(req.Operator), changes, "")
+	}
+	return &api.SetProductTranslationResp{
+		Success:     true,
+		Code:        0,
+		Message:     stringPtr("保存成功"),
+		Translation: convertToAPIProductTranslation(translation),
+	}, nil
+}
+
+// 删除商品翻译，删除后该语言显示默认语言的内容
+func (s *productServiceImpl) DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq) (*api.DeleteProductTranslationResp, error) {
+	locale := normalizeLocale(req.Locale)
+	if locale == "" {
+		return &api.DeleteProductTranslationResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("语言标签无效"),
+		}, nil
+	}
+	existing, err := s.translationRepo.FindProductTranslations(ctx, []int64{req.ProductId}, []string{locale})
+	if err != nil {
+		return &api.DeleteProductTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品翻译失败"),
+		}, nil
+	}
+	before := existing[req.ProductId][locale]
+	if before == nil {
+		return &api.DeleteProductTranslationResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("翻译不存在"),
+		}, nil
+	}
+	deleted, err := s.translationRepo.DeleteProductTranslation(ctx, req.ProductId, locale)
+	if err != nil {
+		fmt.Printf("删除商品翻译失败: product=%d, locale=%s, err=%v\n", req.ProductId, locale, err)
+		return &api.DeleteProductTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("删除商品翻译失败"),
+		}, nil
+	}
+	if deleted {
+		changes := []model.AuditChange{{Field: "name@" + locale, Before: before.Name}}
+		if before.Description != "" {
+			changes = append(changes, model.AuditChange{Field: "description@" + locale, Before: before.Description})
+		}
+		s.recordAudit(ctx, req.ProductId, model.AuditActionUpdate, operatorOrDefault(req.Operator), changes, "")
+	}
+	return &api.DeleteProductTranslationResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("删除成功"),
+	}, nil
+}
+
+// 查询商品的全部翻译
+func (s *productServiceImpl) ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq) (*api.ListProductTranslationsResp, error) {
+	if req.ProductId <= 0 {
+		return &api.ListProductTranslationsResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("商品ID无效"),
+		}, nil
+	}
+	translations, err := s.translationRepo.ListProductTranslations(ctx, req.ProductId)
+	if err != nil {
+		return &api.ListProductTranslationsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询商品翻译失败"),
+		}, nil
+	}
+	result := make([]*api.ProductTranslation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, convertToAPIProductTranslation(t))
+	}
+	return &api.ListProductTranslationsResp{
+		Success:      true,
+		Code:         0,
+		Message:      stringPtr("查询成功"),
+		Translations: result,
+	}, nil
+}
+
+// 新增或覆盖分类名称翻译
+func (s *productServiceImpl) SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq) (*api.SetCategoryTranslationResp, error) {
+	category := strings.TrimSpace(req.Category)
+	label := strings.TrimSpace(req.Label)
+	locale, msg := s.validateTranslationLocale(req.Locale)
+	if msg == "" && category == "" {
+		msg = "分类不能为空"
+	}
+	if msg == "" && label == "" {
+		msg = "翻译后的分类名称不能为空"
+	}
+	if msg == "" && utf8.RuneCountInString(label) > maxCategoryLabelLength {
+		msg = fmt.Sprintf("分类名称不能超过%d个字符", maxCategoryLabelLength)
+	}
+	if msg != "" {
+		return &api.SetCategoryTranslationResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(msg),
+		}, nil
+	}
+	now := time.Now().Unix()
+	translation := &model.CategoryTranslation{
+		Category:  category,
+		Locale:    locale,
+		Label:     label,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.translationRepo.UpsertCategoryTranslation(ctx, translation); err != nil {
+		fmt.Printf("保存分类翻译失败: category=%s, locale=%s, err=%v\n", category, locale, err)
+		return &api.SetCategoryTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("保存分类翻译失败"),
+		}, nil
+	}
+	return &api.SetCategoryTranslationResp{
+		Success:     true,
+		Code:        0,
+		Message:     stringPtr("保存成功"),
+		Translation: convertToAPICategoryTranslation(translation),
+	}, nil
+}
+
+// 删除分类名称翻译
+func (s *productServiceImpl) DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq) (*api.DeleteCategoryTranslationResp, error) {
+	locale := normalizeLocale(req.Locale)
+	if locale == "" {
+		return &api.DeleteCategoryTranslationResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("语言标签无效"),
+		}, nil
+	}
+	deleted, err := s.translationRepo.DeleteCategoryTranslation(ctx, strings.TrimSpace(req.Category), locale)
+	if err != nil {
+		fmt.Printf("删除分类翻译失败: category=%s, locale=%s, err=%v\n", req.Category, locale, err)
+		return &api.DeleteCategoryTranslationResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("删除分类翻译失败"),
+		}, nil
+	}
+	if !deleted {
+		return &api.DeleteCategoryTranslationResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("翻译不存在"),
+		}, nil
+	}
+	return &api.DeleteCategoryTranslationResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("删除成功"),
+	}, nil
+}
+
+// 查询分类名称翻译，可按分类和语言筛选
+func (s *productServiceImpl) ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq) (*api.ListCategoryTranslationsResp, error) {
+	var locale *string
+	if req.Locale != nil && strings.TrimSpace(*req.Locale) != "" {
+		normalized := normalizeLocale(*req.Locale)
+		if normalized == "" {
+			return &api.ListCategoryTranslationsResp{
+				Success: false,
+				Code:    400,
+				Message: stringPtr("语言标签无效"),
+			}, nil
+		}
+		locale = &normalized
+	}
+	translations, err := s.translationRepo.ListCategoryTranslations(ctx, req.Category, locale)
+	if err != nil {
+		return &api.ListCategoryTranslationsResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询分类翻译失败"),
+		}, nil
+	}
+	result := make([]*api.CategoryTranslation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, convertToAPICategoryTranslation(t))
+	}
+	return &api.ListCategoryTranslationsResp{
+		Success:      true,
+		Code:         0,
+		Message:      stringPtr("查询成功"),
+		Translations: result,
+	}, nil
+}
+
+func convertToAPIProductTranslation(t *model.ProductTranslation) *api.ProductTranslation {
+	result := &api.ProductTranslation{
+		ProductId: t.ProductID,
+		Locale:    t.Locale,
+		Name:      t.Name,
+		UpdatedAt: t.UpdatedAt,
+	}
+	if t.Description != "" {
+		result.Description = stringPtr(t.Description)
+	}
+	return result
+}
+
+func convertToAPICategoryTranslation(t *model.CategoryTranslation) *api.CategoryTranslation {
+	return &api.CategoryTranslation{
+		Category:  t.Category,
+		Locale:    t.Locale,
+		Label:     t.Label,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
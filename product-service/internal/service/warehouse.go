@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"ecommerce/product-service/internal/model"
+	"ecommerce/product-service/kitex_gen/api"
+	"fmt"
+	"time"
+)
+
+// 创建仓库
+func (s *productServiceImpl) CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq) (*api.CreateWarehouseResp, error) {
+	if req.Code == "" || req.Name == "" {
+		return &api.CreateWarehouseResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("仓库编码和名称不能为空"),
+		}, nil
+	}
+	if req.Province == "" {
+		return &api.CreateWarehouseResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr("仓库所在省份不能为空"),
+		}, nil
+	}
+	existing, err := s.warehouseRepo.FindByCode(ctx, req.Code)
+	if err != nil {
+		return &api.CreateWarehouseResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询仓库失败"),
+		}, nil
+	}
+	if existing != nil {
+		return &api.CreateWarehouseResp{
+			Success: false,
+			Code:    409,
+			Message: stringPtr("仓库编码已存在"),
+		}, nil
+	}
+	now := time.Now().Unix()
+	warehouse := &model.Warehouse{
+		Code:      req.Code,
+		Name:      req.Name,
+		Province:  req.Province,
+		Priority:  req.Priority,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if req.City != nil {
+		warehouse.City = *req.City
+	}
+	if req.Address != nil {
+		warehouse.Address = *req.Address
+	}
+	if err := s.warehouseRepo.Create(ctx, warehouse); err != nil {
+		fmt.Printf("创建仓库失败: %v\n", err)
+		return &api.CreateWarehouseResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("创建仓库失败"),
+		}, nil
+	}
+	return &api.CreateWarehouseResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("创建成功"),
+		Warehouse: s.convertToAPIWarehouse(warehouse),
+	}, nil
+}
+
+// 更新仓库
+func (s *productServiceImpl) UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq) (*api.UpdateWarehouseResp, error) {
+	warehouse, err := s.warehouseRepo.FindByID(ctx, req.Id)
+	if err != nil {
+		return &api.UpdateWarehouseResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询仓库失败"),
+		}, nil
+	}
+	if warehouse == nil {
+		return &api.UpdateWarehouseResp{
+			Success: false,
+			Code:    404,
+			Message: stringPtr("仓库不存在"),
+		}, nil
+	}
+	if req.Name != nil && *req.Name != "" {
+		warehouse.Name = *req.Name
+	}
+	if req.Province != nil && *req.Province != "" {
+		warehouse.Province = *req.Province
+	}
+	if req.City != nil {
+		warehouse.City = *req.City
+	}
+	if req.Address != nil {
+		warehouse.Address = *req.Address
+	}
+	if req.Priority != nil {
+		warehouse.Priority = *req.Priority
+	}
+	if req.Active != nil {
+		warehouse.Active = *req.Active
+	}
+	warehouse.UpdatedAt = time.Now().Unix()
+	if err := s.warehouseRepo.Update(ctx, warehouse); err != nil {
+		return &api.UpdateWarehouseResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("更新仓库失败"),
+		}, nil
+	}
+	return &api.UpdateWarehouseResp{
+		Success:   true,
+		Code:      0,
+		Message:   stringPtr("更新成功"),
+		Warehouse: s.convertToAPIWarehouse(warehouse),
+	}, nil
+}
+
+// 仓库列表
+func (s *productServiceImpl) ListWarehouses(ctx context.Context, activeOnly bool) (*api.ListWarehousesResp, error) {
+	warehouses, err := s.warehouseRepo.List(ctx, activeOnly)
+	if err != nil {
+		return &api.ListWarehousesResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询仓库失败"),
+		}, nil
+	}
+	apiWarehouses := make([]*api.Warehouse, 0, len(warehouses))
+	for _, w := range warehouses {
+		apiWarehouses = append(apiWarehouses, s.convertToAPIWarehouse(w))
+	}
+	return &api.ListWarehousesResp{
+		Success:    true,
+		Code:       0,
+		Message:    stringPtr("查询成功"),
+		Warehouses: apiWarehouses,
+	}, nil
+}
+
+// 查询商品分仓库存
+func (s *productServiceImpl) GetWarehouseStocks(ctx context.Context, productIDs []int64, warehouseID *int64) (*api.GetWarehouseStocksResp, error) {
+	if len(productIDs) > maxBatchGetProducts {
+		return &api.GetWarehouseStocksResp{
+			Success: false,
+			Code:    400,
+			Message: stringPtr(fmt.Sprintf("单次最多查询%d个商品", maxBatchGetProducts)),
+		}, nil
+	}
+	stocks, err := s.warehouseRepo.ListStocks(ctx, productIDs, warehouseID)
+	if err != nil {
+		return &api.GetWarehouseStocksResp{
+			Success: false,
+			Code:    500,
+			Message: stringPtr("查询仓库库存失败"),
+		}, nil
+	}
+	apiStocks := make([]*api.WarehouseStock, 0, len(stocks))
+	for _, st := range stocks {
+		apiStocks = append(apiStocks, &api.WarehouseStock{
+			WarehouseId: st.WarehouseID,
+			ProductId:   st.ProductID,
+			Stock:       st.Stock,
+		})
+	}
+	return &api.GetWarehouseStocksResp{
+		Success: true,
+		Code:    0,
+		Message: stringPtr("查询成功"),
+		Stocks:  apiStocks,
+	}, nil
+}
+
+// 模型转化
+func (s *productServiceImpl) convertToAPIWarehouse(w *model.Warehouse) *api.Warehouse {
+	return &api.Warehouse{
+		Id:        w.ID,
+		Code:      w.Code,
+		Name:      w.Name,
+		Province:  w.Province,
+		City:      w.City,
+		Address:   w.Address,
+		Priority:  w.Priority,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
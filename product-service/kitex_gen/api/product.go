@@ -12,10 +12,11 @@ import (
 type ProductStatus int64
 
 const (
-	ProductStatus_DRAFT   ProductStatus = 0
-	ProductStatus_ONLINE  ProductStatus = 1
-	ProductStatus_OFFLINE ProductStatus = 2
-	ProductStatus_DELETED ProductStatus = 3
+	ProductStatus_DRAFT    ProductStatus = 0
+	ProductStatus_ONLINE   ProductStatus = 1
+	ProductStatus_OFFLINE  ProductStatus = 2
+	ProductStatus_DELETED  ProductStatus = 3
+	ProductStatus_SOLD_OUT ProductStatus = 4
 )
 
 func (p ProductStatus) String() string {
@@ -28,6 +29,8 @@ func (p ProductStatus) String() string {
 		return "OFFLINE"
 	case ProductStatus_DELETED:
 		return "DELETED"
+	case ProductStatus_SOLD_OUT:
+		return "SOLD_OUT"
 	}
 	return "<UNSET>"
 }
@@ -42,6 +45,8 @@ func ProductStatusFromString(s string) (ProductStatus, error) {
 		return ProductStatus_OFFLINE, nil
 	case "DELETED":
 		return ProductStatus_DELETED, nil
+	case "SOLD_OUT":
+		return ProductStatus_SOLD_OUT, nil
 	}
 	return ProductStatus(0), fmt.Errorf("not a valid ProductStatus string")
 }
@@ -61,2129 +66,19416 @@ func (p *ProductStatus) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type SoldOutPolicy int64
+
+const (
+	SoldOutPolicy_NONE     SoldOutPolicy = 0
+	SoldOutPolicy_SOLD_OUT SoldOutPolicy = 1
+	SoldOutPolicy_OFFLINE  SoldOutPolicy = 2
+)
+
+func (p SoldOutPolicy) String() string {
+	switch p {
+	case SoldOutPolicy_NONE:
+		return "NONE"
+	case SoldOutPolicy_SOLD_OUT:
+		return "SOLD_OUT"
+	case SoldOutPolicy_OFFLINE:
+		return "OFFLINE"
+	}
+	return "<UNSET>"
+}
+
+func SoldOutPolicyFromString(s string) (SoldOutPolicy, error) {
+	switch s {
+	case "NONE":
+		return SoldOutPolicy_NONE, nil
+	case "SOLD_OUT":
+		return SoldOutPolicy_SOLD_OUT, nil
+	case "OFFLINE":
+		return SoldOutPolicy_OFFLINE, nil
+	}
+	return SoldOutPolicy(0), fmt.Errorf("not a valid SoldOutPolicy string")
+}
+
+func SoldOutPolicyPtr(v SoldOutPolicy) *SoldOutPolicy { return &v }
+func (p *SoldOutPolicy) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = SoldOutPolicy(result.Int64)
+	return
+}
+
+func (p *SoldOutPolicy) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type StockChangeReason int64
+
+const (
+	StockChangeReason_MANUAL_ADJUST       StockChangeReason = 0
+	StockChangeReason_ORDER_DEDUCT        StockChangeReason = 1
+	StockChangeReason_CANCEL_RESTORE      StockChangeReason = 2
+	StockChangeReason_REFUND_RETURN       StockChangeReason = 3
+	StockChangeReason_IMPORT              StockChangeReason = 4
+	StockChangeReason_INITIAL             StockChangeReason = 5
+	StockChangeReason_FLASH_SALE_ALLOCATE StockChangeReason = 6
+	StockChangeReason_FLASH_SALE_RETURN   StockChangeReason = 7
+)
+
+func (p StockChangeReason) String() string {
+	switch p {
+	case StockChangeReason_MANUAL_ADJUST:
+		return "MANUAL_ADJUST"
+	case StockChangeReason_ORDER_DEDUCT:
+		return "ORDER_DEDUCT"
+	case StockChangeReason_CANCEL_RESTORE:
+		return "CANCEL_RESTORE"
+	case StockChangeReason_REFUND_RETURN:
+		return "REFUND_RETURN"
+	case StockChangeReason_IMPORT:
+		return "IMPORT"
+	case StockChangeReason_INITIAL:
+		return "INITIAL"
+	case StockChangeReason_FLASH_SALE_ALLOCATE:
+		return "FLASH_SALE_ALLOCATE"
+	case StockChangeReason_FLASH_SALE_RETURN:
+		return "FLASH_SALE_RETURN"
+	}
+	return "<UNSET>"
+}
+
+func StockChangeReasonFromString(s string) (StockChangeReason, error) {
+	switch s {
+	case "MANUAL_ADJUST":
+		return StockChangeReason_MANUAL_ADJUST, nil
+	case "ORDER_DEDUCT":
+		return StockChangeReason_ORDER_DEDUCT, nil
+	case "CANCEL_RESTORE":
+		return StockChangeReason_CANCEL_RESTORE, nil
+	case "REFUND_RETURN":
+		return StockChangeReason_REFUND_RETURN, nil
+	case "IMPORT":
+		return StockChangeReason_IMPORT, nil
+	case "INITIAL":
+		return StockChangeReason_INITIAL, nil
+	case "FLASH_SALE_ALLOCATE":
+		return StockChangeReason_FLASH_SALE_ALLOCATE, nil
+	case "FLASH_SALE_RETURN":
+		return StockChangeReason_FLASH_SALE_RETURN, nil
+	}
+	return StockChangeReason(0), fmt.Errorf("not a valid StockChangeReason string")
+}
+
+func StockChangeReasonPtr(v StockChangeReason) *StockChangeReason { return &v }
+func (p *StockChangeReason) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = StockChangeReason(result.Int64)
+	return
+}
+
+func (p *StockChangeReason) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type AttributeType int64
+
+const (
+	AttributeType_STRING AttributeType = 0
+	AttributeType_NUMBER AttributeType = 1
+	AttributeType_ENUM   AttributeType = 2
+	AttributeType_BOOL   AttributeType = 3
+)
+
+func (p AttributeType) String() string {
+	switch p {
+	case AttributeType_STRING:
+		return "STRING"
+	case AttributeType_NUMBER:
+		return "NUMBER"
+	case AttributeType_ENUM:
+		return "ENUM"
+	case AttributeType_BOOL:
+		return "BOOL"
+	}
+	return "<UNSET>"
+}
+
+func AttributeTypeFromString(s string) (AttributeType, error) {
+	switch s {
+	case "STRING":
+		return AttributeType_STRING, nil
+	case "NUMBER":
+		return AttributeType_NUMBER, nil
+	case "ENUM":
+		return AttributeType_ENUM, nil
+	case "BOOL":
+		return AttributeType_BOOL, nil
+	}
+	return AttributeType(0), fmt.Errorf("not a valid AttributeType string")
+}
+
+func AttributeTypePtr(v AttributeType) *AttributeType { return &v }
+func (p *AttributeType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = AttributeType(result.Int64)
+	return
+}
+
+func (p *AttributeType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type BrandStatus int64
+
+const (
+	BrandStatus_ACTIVE   BrandStatus = 0
+	BrandStatus_DISABLED BrandStatus = 1
+)
+
+func (p BrandStatus) String() string {
+	switch p {
+	case BrandStatus_ACTIVE:
+		return "ACTIVE"
+	case BrandStatus_DISABLED:
+		return "DISABLED"
+	}
+	return "<UNSET>"
+}
+
+func BrandStatusFromString(s string) (BrandStatus, error) {
+	switch s {
+	case "ACTIVE":
+		return BrandStatus_ACTIVE, nil
+	case "DISABLED":
+		return BrandStatus_DISABLED, nil
+	}
+	return BrandStatus(0), fmt.Errorf("not a valid BrandStatus string")
+}
+
+func BrandStatusPtr(v BrandStatus) *BrandStatus { return &v }
+func (p *BrandStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = BrandStatus(result.Int64)
+	return
+}
+
+func (p *BrandStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type MerchantStatus int64
+
+const (
+	MerchantStatus_ACTIVE   MerchantStatus = 0
+	MerchantStatus_DISABLED MerchantStatus = 1
+)
+
+func (p MerchantStatus) String() string {
+	switch p {
+	case MerchantStatus_ACTIVE:
+		return "ACTIVE"
+	case MerchantStatus_DISABLED:
+		return "DISABLED"
+	}
+	return "<UNSET>"
+}
+
+func MerchantStatusFromString(s string) (MerchantStatus, error) {
+	switch s {
+	case "ACTIVE":
+		return MerchantStatus_ACTIVE, nil
+	case "DISABLED":
+		return MerchantStatus_DISABLED, nil
+	}
+	return MerchantStatus(0), fmt.Errorf("not a valid MerchantStatus string")
+}
+
+func MerchantStatusPtr(v MerchantStatus) *MerchantStatus { return &v }
+func (p *MerchantStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = MerchantStatus(result.Int64)
+	return
+}
+
+func (p *MerchantStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type SuggestionKind int64
+
+const (
+	SuggestionKind_PRODUCT  SuggestionKind = 0
+	SuggestionKind_BRAND    SuggestionKind = 1
+	SuggestionKind_CATEGORY SuggestionKind = 2
+)
+
+func (p SuggestionKind) String() string {
+	switch p {
+	case SuggestionKind_PRODUCT:
+		return "PRODUCT"
+	case SuggestionKind_BRAND:
+		return "BRAND"
+	case SuggestionKind_CATEGORY:
+		return "CATEGORY"
+	}
+	return "<UNSET>"
+}
+
+func SuggestionKindFromString(s string) (SuggestionKind, error) {
+	switch s {
+	case "PRODUCT":
+		return SuggestionKind_PRODUCT, nil
+	case "BRAND":
+		return SuggestionKind_BRAND, nil
+	case "CATEGORY":
+		return SuggestionKind_CATEGORY, nil
+	}
+	return SuggestionKind(0), fmt.Errorf("not a valid SuggestionKind string")
+}
+
+func SuggestionKindPtr(v SuggestionKind) *SuggestionKind { return &v }
+func (p *SuggestionKind) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = SuggestionKind(result.Int64)
+	return
+}
+
+func (p *SuggestionKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ApprovalStatus int64
+
+const (
+	ApprovalStatus_UNSUBMITTED ApprovalStatus = 0
+	ApprovalStatus_PENDING     ApprovalStatus = 1
+	ApprovalStatus_APPROVED    ApprovalStatus = 2
+	ApprovalStatus_REJECTED    ApprovalStatus = 3
+)
+
+func (p ApprovalStatus) String() string {
+	switch p {
+	case ApprovalStatus_UNSUBMITTED:
+		return "UNSUBMITTED"
+	case ApprovalStatus_PENDING:
+		return "PENDING"
+	case ApprovalStatus_APPROVED:
+		return "APPROVED"
+	case ApprovalStatus_REJECTED:
+		return "REJECTED"
+	}
+	return "<UNSET>"
+}
+
+func ApprovalStatusFromString(s string) (ApprovalStatus, error) {
+	switch s {
+	case "UNSUBMITTED":
+		return ApprovalStatus_UNSUBMITTED, nil
+	case "PENDING":
+		return ApprovalStatus_PENDING, nil
+	case "APPROVED":
+		return ApprovalStatus_APPROVED, nil
+	case "REJECTED":
+		return ApprovalStatus_REJECTED, nil
+	}
+	return ApprovalStatus(0), fmt.Errorf("not a valid ApprovalStatus string")
+}
+
+func ApprovalStatusPtr(v ApprovalStatus) *ApprovalStatus { return &v }
+func (p *ApprovalStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ApprovalStatus(result.Int64)
+	return
+}
+
+func (p *ApprovalStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ApprovalKind int64
+
+const (
+	ApprovalKind_LISTING  ApprovalKind = 0
+	ApprovalKind_REVISION ApprovalKind = 1
+)
+
+func (p ApprovalKind) String() string {
+	switch p {
+	case ApprovalKind_LISTING:
+		return "LISTING"
+	case ApprovalKind_REVISION:
+		return "REVISION"
+	}
+	return "<UNSET>"
+}
+
+func ApprovalKindFromString(s string) (ApprovalKind, error) {
+	switch s {
+	case "LISTING":
+		return ApprovalKind_LISTING, nil
+	case "REVISION":
+		return ApprovalKind_REVISION, nil
+	}
+	return ApprovalKind(0), fmt.Errorf("not a valid ApprovalKind string")
+}
+
+func ApprovalKindPtr(v ApprovalKind) *ApprovalKind { return &v }
+func (p *ApprovalKind) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ApprovalKind(result.Int64)
+	return
+}
+
+func (p *ApprovalKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ProductType int64
+
+const (
+	ProductType_NORMAL ProductType = 0
+	ProductType_BUNDLE ProductType = 1
+)
+
+func (p ProductType) String() string {
+	switch p {
+	case ProductType_NORMAL:
+		return "NORMAL"
+	case ProductType_BUNDLE:
+		return "BUNDLE"
+	}
+	return "<UNSET>"
+}
+
+func ProductTypeFromString(s string) (ProductType, error) {
+	switch s {
+	case "NORMAL":
+		return ProductType_NORMAL, nil
+	case "BUNDLE":
+		return ProductType_BUNDLE, nil
+	}
+	return ProductType(0), fmt.Errorf("not a valid ProductType string")
+}
+
+func ProductTypePtr(v ProductType) *ProductType { return &v }
+func (p *ProductType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ProductType(result.Int64)
+	return
+}
+
+func (p *ProductType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type RestockSubscriptionStatus int64
+
+const (
+	RestockSubscriptionStatus_PENDING  RestockSubscriptionStatus = 0
+	RestockSubscriptionStatus_NOTIFIED RestockSubscriptionStatus = 1
+)
+
+func (p RestockSubscriptionStatus) String() string {
+	switch p {
+	case RestockSubscriptionStatus_PENDING:
+		return "PENDING"
+	case RestockSubscriptionStatus_NOTIFIED:
+		return "NOTIFIED"
+	}
+	return "<UNSET>"
+}
+
+func RestockSubscriptionStatusFromString(s string) (RestockSubscriptionStatus, error) {
+	switch s {
+	case "PENDING":
+		return RestockSubscriptionStatus_PENDING, nil
+	case "NOTIFIED":
+		return RestockSubscriptionStatus_NOTIFIED, nil
+	}
+	return RestockSubscriptionStatus(0), fmt.Errorf("not a valid RestockSubscriptionStatus string")
+}
+
+func RestockSubscriptionStatusPtr(v RestockSubscriptionStatus) *RestockSubscriptionStatus { return &v }
+func (p *RestockSubscriptionStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = RestockSubscriptionStatus(result.Int64)
+	return
+}
+
+func (p *RestockSubscriptionStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type PriceTier struct {
+	MinQuantity int32   `thrift:"minQuantity,1" frugal:"1,default,i32" json:"minQuantity"`
+	Price       float64 `thrift:"price,2" frugal:"2,default,double" json:"price"`
+	UserGroup   *string `thrift:"userGroup,3,optional" frugal:"3,optional,string" json:"userGroup,omitempty"`
+}
+
+func NewPriceTier() *PriceTier {
+	return &PriceTier{}
+}
+
+func (p *PriceTier) InitDefault() {
+}
+
+func (p *PriceTier) GetMinQuantity() (v int32) {
+	return p.MinQuantity
+}
+
+func (p *PriceTier) GetPrice() (v float64) {
+	return p.Price
+}
+
+var PriceTier_UserGroup_DEFAULT string
+
+func (p *PriceTier) GetUserGroup() (v string) {
+	if !p.IsSetUserGroup() {
+		return PriceTier_UserGroup_DEFAULT
+	}
+	return *p.UserGroup
+}
+func (p *PriceTier) SetMinQuantity(val int32) {
+	p.MinQuantity = val
+}
+func (p *PriceTier) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *PriceTier) SetUserGroup(val *string) {
+	p.UserGroup = val
+}
+
+func (p *PriceTier) IsSetUserGroup() bool {
+	return p.UserGroup != nil
+}
+
+func (p *PriceTier) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PriceTier(%+v)", *p)
+}
+
+var fieldIDToName_PriceTier = map[int16]string{
+	1: "minQuantity",
+	2: "price",
+	3: "userGroup",
+}
+
+type ProductAttribute struct {
+	Code  string        `thrift:"code,1" frugal:"1,default,string" json:"code"`
+	Name  string        `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Type  AttributeType `thrift:"type,3" frugal:"3,default,AttributeType" json:"type"`
+	Value string        `thrift:"value,4" frugal:"4,default,string" json:"value"`
+	Unit  *string       `thrift:"unit,5,optional" frugal:"5,optional,string" json:"unit,omitempty"`
+}
+
+func NewProductAttribute() *ProductAttribute {
+	return &ProductAttribute{}
+}
+
+func (p *ProductAttribute) InitDefault() {
+}
+
+func (p *ProductAttribute) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *ProductAttribute) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ProductAttribute) GetType() (v AttributeType) {
+	return p.Type
+}
+
+func (p *ProductAttribute) GetValue() (v string) {
+	return p.Value
+}
+
+var ProductAttribute_Unit_DEFAULT string
+
+func (p *ProductAttribute) GetUnit() (v string) {
+	if !p.IsSetUnit() {
+		return ProductAttribute_Unit_DEFAULT
+	}
+	return *p.Unit
+}
+func (p *ProductAttribute) SetCode(val string) {
+	p.Code = val
+}
+func (p *ProductAttribute) SetName(val string) {
+	p.Name = val
+}
+func (p *ProductAttribute) SetType(val AttributeType) {
+	p.Type = val
+}
+func (p *ProductAttribute) SetValue(val string) {
+	p.Value = val
+}
+func (p *ProductAttribute) SetUnit(val *string) {
+	p.Unit = val
+}
+
+func (p *ProductAttribute) IsSetUnit() bool {
+	return p.Unit != nil
+}
+
+func (p *ProductAttribute) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductAttribute(%+v)", *p)
+}
+
+var fieldIDToName_ProductAttribute = map[int16]string{
+	1: "code",
+	2: "name",
+	3: "type",
+	4: "value",
+	5: "unit",
+}
+
+type BundleComponent struct {
+	ProductId   int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Quantity    int32   `thrift:"quantity,2" frugal:"2,default,i32" json:"quantity"`
+	ProductName *string `thrift:"productName,3,optional" frugal:"3,optional,string" json:"productName,omitempty"`
+}
+
+func NewBundleComponent() *BundleComponent {
+	return &BundleComponent{}
+}
+
+func (p *BundleComponent) InitDefault() {
+}
+
+func (p *BundleComponent) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *BundleComponent) GetQuantity() (v int32) {
+	return p.Quantity
+}
+
+var BundleComponent_ProductName_DEFAULT string
+
+func (p *BundleComponent) GetProductName() (v string) {
+	if !p.IsSetProductName() {
+		return BundleComponent_ProductName_DEFAULT
+	}
+	return *p.ProductName
+}
+func (p *BundleComponent) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *BundleComponent) SetQuantity(val int32) {
+	p.Quantity = val
+}
+func (p *BundleComponent) SetProductName(val *string) {
+	p.ProductName = val
+}
+
+func (p *BundleComponent) IsSetProductName() bool {
+	return p.ProductName != nil
+}
+
+func (p *BundleComponent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BundleComponent(%+v)", *p)
+}
+
+var fieldIDToName_BundleComponent = map[int16]string{
+	1: "productId",
+	2: "quantity",
+	3: "productName",
+}
+
 type Product struct {
+	Id                        int64               `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name                      string              `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Avatar                    string              `thrift:"avatar,3" frugal:"3,default,string" json:"avatar"`
+	Category                  string              `thrift:"category,4" frugal:"4,default,string" json:"category"`
+	Price                     float64             `thrift:"price,5" frugal:"5,default,double" json:"price"`
+	Stock                     int32               `thrift:"stock,6" frugal:"6,default,i32" json:"stock"`
+	Status                    ProductStatus       `thrift:"status,7" frugal:"7,default,ProductStatus" json:"status"`
+	CreatedAt                 int64               `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
+	UpdatedAt                 int64               `thrift:"updatedAt,9" frugal:"9,default,i64" json:"updatedAt"`
+	Brand                     *string             `thrift:"brand,10,optional" frugal:"10,optional,string" json:"brand,omitempty"`
+	LowStockThreshold         int32               `thrift:"lowStockThreshold,11" frugal:"11,default,i32" json:"lowStockThreshold"`
+	SoldOutPolicy             SoldOutPolicy       `thrift:"soldOutPolicy,12" frugal:"12,default,SoldOutPolicy" json:"soldOutPolicy"`
+	OriginalPrice             *float64            `thrift:"originalPrice,13,optional" frugal:"13,optional,double" json:"originalPrice,omitempty"`
+	ExternalCode              *string             `thrift:"externalCode,14,optional" frugal:"14,optional,string" json:"externalCode,omitempty"`
+	Attributes                []*ProductAttribute `thrift:"attributes,15,optional" frugal:"15,optional,list<ProductAttribute>" json:"attributes,omitempty"`
+	RatingAvg                 float64             `thrift:"ratingAvg,16" frugal:"16,default,double" json:"ratingAvg"`
+	RatingCount               int32               `thrift:"ratingCount,17" frugal:"17,default,i32" json:"ratingCount"`
+	Version                   int64               `thrift:"version,18" frugal:"18,default,i64" json:"version"`
+	BrandId                   int64               `thrift:"brandId,19" frugal:"19,default,i64" json:"brandId"`
+	ApprovalStatus            ApprovalStatus      `thrift:"approvalStatus,20" frugal:"20,default,ApprovalStatus" json:"approvalStatus"`
+	MerchantId                int64               `thrift:"merchantId,21" frugal:"21,default,i64" json:"merchantId"`
+	Type                      ProductType         `thrift:"type,22" frugal:"22,default,ProductType" json:"type"`
+	Components                []*BundleComponent  `thrift:"components,23,optional" frugal:"23,optional,list<BundleComponent>" json:"components,omitempty"`
+	Description               *string             `thrift:"description,24,optional" frugal:"24,optional,string" json:"description,omitempty"`
+	CategoryLabel             *string             `thrift:"categoryLabel,25,optional" frugal:"25,optional,string" json:"categoryLabel,omitempty"`
+	FavoriteCount             int32               `thrift:"favoriteCount,26" frugal:"26,default,i32" json:"favoriteCount"`
+	PriceTiers                []*PriceTier        `thrift:"priceTiers,27,optional" frugal:"27,optional,list<PriceTier>" json:"priceTiers,omitempty"`
+	PurchaseLimit             int32               `thrift:"purchaseLimit,28" frugal:"28,default,i32" json:"purchaseLimit"`
+	PurchaseLimitDays         int32               `thrift:"purchaseLimitDays,29" frugal:"29,default,i32" json:"purchaseLimitDays"`
+	RemainingPurchaseQuantity *int32              `thrift:"remainingPurchaseQuantity,30,optional" frugal:"30,optional,i32" json:"remainingPurchaseQuantity,omitempty"`
+}
+
+func NewProduct() *Product {
+	return &Product{
+		Status:         ProductStatus_DRAFT,
+		SoldOutPolicy:  SoldOutPolicy_NONE,
+		ApprovalStatus: ApprovalStatus_UNSUBMITTED,
+		Type:           ProductType_NORMAL,
+	}
+}
+
+func (p *Product) InitDefault() {
+	p.Status = ProductStatus_DRAFT
+	p.SoldOutPolicy = SoldOutPolicy_NONE
+	p.ApprovalStatus = ApprovalStatus_UNSUBMITTED
+	p.Type = ProductType_NORMAL
+}
+
+func (p *Product) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Product) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Product) GetAvatar() (v string) {
+	return p.Avatar
+}
+
+func (p *Product) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *Product) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *Product) GetStock() (v int32) {
+	return p.Stock
+}
+
+func (p *Product) GetStatus() (v ProductStatus) {
+	return p.Status
+}
+
+func (p *Product) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Product) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var Product_Brand_DEFAULT string
+
+func (p *Product) GetBrand() (v string) {
+	if !p.IsSetBrand() {
+		return Product_Brand_DEFAULT
+	}
+	return *p.Brand
+}
+
+func (p *Product) GetLowStockThreshold() (v int32) {
+	return p.LowStockThreshold
+}
+
+func (p *Product) GetSoldOutPolicy() (v SoldOutPolicy) {
+	return p.SoldOutPolicy
+}
+
+var Product_OriginalPrice_DEFAULT float64
+
+func (p *Product) GetOriginalPrice() (v float64) {
+	if !p.IsSetOriginalPrice() {
+		return Product_OriginalPrice_DEFAULT
+	}
+	return *p.OriginalPrice
+}
+
+var Product_ExternalCode_DEFAULT string
+
+func (p *Product) GetExternalCode() (v string) {
+	if !p.IsSetExternalCode() {
+		return Product_ExternalCode_DEFAULT
+	}
+	return *p.ExternalCode
+}
+
+var Product_Attributes_DEFAULT []*ProductAttribute
+
+func (p *Product) GetAttributes() (v []*ProductAttribute) {
+	if !p.IsSetAttributes() {
+		return Product_Attributes_DEFAULT
+	}
+	return p.Attributes
+}
+
+func (p *Product) GetRatingAvg() (v float64) {
+	return p.RatingAvg
+}
+
+func (p *Product) GetRatingCount() (v int32) {
+	return p.RatingCount
+}
+
+func (p *Product) GetVersion() (v int64) {
+	return p.Version
+}
+
+func (p *Product) GetBrandId() (v int64) {
+	return p.BrandId
+}
+
+func (p *Product) GetApprovalStatus() (v ApprovalStatus) {
+	return p.ApprovalStatus
+}
+
+func (p *Product) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *Product) GetType() (v ProductType) {
+	return p.Type
+}
+
+var Product_Components_DEFAULT []*BundleComponent
+
+func (p *Product) GetComponents() (v []*BundleComponent) {
+	if !p.IsSetComponents() {
+		return Product_Components_DEFAULT
+	}
+	return p.Components
+}
+
+var Product_Description_DEFAULT string
+
+func (p *Product) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return Product_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var Product_CategoryLabel_DEFAULT string
+
+func (p *Product) GetCategoryLabel() (v string) {
+	if !p.IsSetCategoryLabel() {
+		return Product_CategoryLabel_DEFAULT
+	}
+	return *p.CategoryLabel
+}
+
+func (p *Product) GetFavoriteCount() (v int32) {
+	return p.FavoriteCount
+}
+
+var Product_PriceTiers_DEFAULT []*PriceTier
+
+func (p *Product) GetPriceTiers() (v []*PriceTier) {
+	if !p.IsSetPriceTiers() {
+		return Product_PriceTiers_DEFAULT
+	}
+	return p.PriceTiers
+}
+
+func (p *Product) GetPurchaseLimit() (v int32) {
+	return p.PurchaseLimit
+}
+
+func (p *Product) GetPurchaseLimitDays() (v int32) {
+	return p.PurchaseLimitDays
+}
+
+var Product_RemainingPurchaseQuantity_DEFAULT int32
+
+func (p *Product) GetRemainingPurchaseQuantity() (v int32) {
+	if !p.IsSetRemainingPurchaseQuantity() {
+		return Product_RemainingPurchaseQuantity_DEFAULT
+	}
+	return *p.RemainingPurchaseQuantity
+}
+func (p *Product) SetId(val int64) {
+	p.Id = val
+}
+func (p *Product) SetName(val string) {
+	p.Name = val
+}
+func (p *Product) SetAvatar(val string) {
+	p.Avatar = val
+}
+func (p *Product) SetCategory(val string) {
+	p.Category = val
+}
+func (p *Product) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *Product) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *Product) SetStatus(val ProductStatus) {
+	p.Status = val
+}
+func (p *Product) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Product) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+func (p *Product) SetBrand(val *string) {
+	p.Brand = val
+}
+func (p *Product) SetLowStockThreshold(val int32) {
+	p.LowStockThreshold = val
+}
+func (p *Product) SetSoldOutPolicy(val SoldOutPolicy) {
+	p.SoldOutPolicy = val
+}
+func (p *Product) SetOriginalPrice(val *float64) {
+	p.OriginalPrice = val
+}
+func (p *Product) SetExternalCode(val *string) {
+	p.ExternalCode = val
+}
+func (p *Product) SetAttributes(val []*ProductAttribute) {
+	p.Attributes = val
+}
+func (p *Product) SetRatingAvg(val float64) {
+	p.RatingAvg = val
+}
+func (p *Product) SetRatingCount(val int32) {
+	p.RatingCount = val
+}
+func (p *Product) SetVersion(val int64) {
+	p.Version = val
+}
+func (p *Product) SetBrandId(val int64) {
+	p.BrandId = val
+}
+func (p *Product) SetApprovalStatus(val ApprovalStatus) {
+	p.ApprovalStatus = val
+}
+func (p *Product) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *Product) SetType(val ProductType) {
+	p.Type = val
+}
+func (p *Product) SetComponents(val []*BundleComponent) {
+	p.Components = val
+}
+func (p *Product) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *Product) SetCategoryLabel(val *string) {
+	p.CategoryLabel = val
+}
+func (p *Product) SetFavoriteCount(val int32) {
+	p.FavoriteCount = val
+}
+func (p *Product) SetPriceTiers(val []*PriceTier) {
+	p.PriceTiers = val
+}
+func (p *Product) SetPurchaseLimit(val int32) {
+	p.PurchaseLimit = val
+}
+func (p *Product) SetPurchaseLimitDays(val int32) {
+	p.PurchaseLimitDays = val
+}
+func (p *Product) SetRemainingPurchaseQuantity(val *int32) {
+	p.RemainingPurchaseQuantity = val
+}
+
+func (p *Product) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *Product) IsSetOriginalPrice() bool {
+	return p.OriginalPrice != nil
+}
+
+func (p *Product) IsSetExternalCode() bool {
+	return p.ExternalCode != nil
+}
+
+func (p *Product) IsSetAttributes() bool {
+	return p.Attributes != nil
+}
+
+func (p *Product) IsSetComponents() bool {
+	return p.Components != nil
+}
+
+func (p *Product) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *Product) IsSetCategoryLabel() bool {
+	return p.CategoryLabel != nil
+}
+
+func (p *Product) IsSetPriceTiers() bool {
+	return p.PriceTiers != nil
+}
+
+func (p *Product) IsSetRemainingPurchaseQuantity() bool {
+	return p.RemainingPurchaseQuantity != nil
+}
+
+func (p *Product) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Product(%+v)", *p)
+}
+
+var fieldIDToName_Product = map[int16]string{
+	1:  "id",
+	2:  "name",
+	3:  "avatar",
+	4:  "category",
+	5:  "price",
+	6:  "stock",
+	7:  "status",
+	8:  "createdAt",
+	9:  "updatedAt",
+	10: "brand",
+	11: "lowStockThreshold",
+	12: "soldOutPolicy",
+	13: "originalPrice",
+	14: "externalCode",
+	15: "attributes",
+	16: "ratingAvg",
+	17: "ratingCount",
+	18: "version",
+	19: "brandId",
+	20: "approvalStatus",
+	21: "merchantId",
+	22: "type",
+	23: "components",
+	24: "description",
+	25: "categoryLabel",
+	26: "favoriteCount",
+	27: "priceTiers",
+	28: "purchaseLimit",
+	29: "purchaseLimitDays",
+	30: "remainingPurchaseQuantity",
+}
+
+type SimpleProduct struct {
+	Id            int64         `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Category      string        `thrift:"category,2" frugal:"2,default,string" json:"category"`
+	Price         float64       `thrift:"price,3" frugal:"3,default,double" json:"price"`
+	Stock         int32         `thrift:"stock,4" frugal:"4,default,i32" json:"stock"`
+	Status        ProductStatus `thrift:"status,5" frugal:"5,default,ProductStatus" json:"status"`
+	Brand         *string       `thrift:"brand,6,optional" frugal:"6,optional,string" json:"brand,omitempty"`
+	Name          string        `thrift:"name,7" frugal:"7,default,string" json:"name"`
+	Avatar        string        `thrift:"avatar,8" frugal:"8,default,string" json:"avatar"`
+	RatingAvg     float64       `thrift:"ratingAvg,9" frugal:"9,default,double" json:"ratingAvg"`
+	RatingCount   int32         `thrift:"ratingCount,10" frugal:"10,default,i32" json:"ratingCount"`
+	BrandId       int64         `thrift:"brandId,11" frugal:"11,default,i64" json:"brandId"`
+	MerchantId    int64         `thrift:"merchantId,12" frugal:"12,default,i64" json:"merchantId"`
+	Type          ProductType   `thrift:"type,13" frugal:"13,default,ProductType" json:"type"`
+	CategoryLabel *string       `thrift:"categoryLabel,14,optional" frugal:"14,optional,string" json:"categoryLabel,omitempty"`
+	PriceTiers    []*PriceTier  `thrift:"priceTiers,15,optional" frugal:"15,optional,list<PriceTier>" json:"priceTiers,omitempty"`
+}
+
+func NewSimpleProduct() *SimpleProduct {
+	return &SimpleProduct{
+		Status: ProductStatus_ONLINE,
+		Type:   ProductType_NORMAL,
+	}
+}
+
+func (p *SimpleProduct) InitDefault() {
+	p.Status = ProductStatus_ONLINE
+	p.Type = ProductType_NORMAL
+}
+
+func (p *SimpleProduct) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *SimpleProduct) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *SimpleProduct) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *SimpleProduct) GetStock() (v int32) {
+	return p.Stock
+}
+
+func (p *SimpleProduct) GetStatus() (v ProductStatus) {
+	return p.Status
+}
+
+var SimpleProduct_Brand_DEFAULT string
+
+func (p *SimpleProduct) GetBrand() (v string) {
+	if !p.IsSetBrand() {
+		return SimpleProduct_Brand_DEFAULT
+	}
+	return *p.Brand
+}
+
+func (p *SimpleProduct) GetName() (v string) {
+	return p.Name
+}
+
+func (p *SimpleProduct) GetAvatar() (v string) {
+	return p.Avatar
+}
+
+func (p *SimpleProduct) GetRatingAvg() (v float64) {
+	return p.RatingAvg
+}
+
+func (p *SimpleProduct) GetRatingCount() (v int32) {
+	return p.RatingCount
+}
+
+func (p *SimpleProduct) GetBrandId() (v int64) {
+	return p.BrandId
+}
+
+func (p *SimpleProduct) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *SimpleProduct) GetType() (v ProductType) {
+	return p.Type
+}
+
+var SimpleProduct_CategoryLabel_DEFAULT string
+
+func (p *SimpleProduct) GetCategoryLabel() (v string) {
+	if !p.IsSetCategoryLabel() {
+		return SimpleProduct_CategoryLabel_DEFAULT
+	}
+	return *p.CategoryLabel
+}
+
+var SimpleProduct_PriceTiers_DEFAULT []*PriceTier
+
+func (p *SimpleProduct) GetPriceTiers() (v []*PriceTier) {
+	if !p.IsSetPriceTiers() {
+		return SimpleProduct_PriceTiers_DEFAULT
+	}
+	return p.PriceTiers
+}
+func (p *SimpleProduct) SetId(val int64) {
+	p.Id = val
+}
+func (p *SimpleProduct) SetCategory(val string) {
+	p.Category = val
+}
+func (p *SimpleProduct) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *SimpleProduct) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *SimpleProduct) SetStatus(val ProductStatus) {
+	p.Status = val
+}
+func (p *SimpleProduct) SetBrand(val *string) {
+	p.Brand = val
+}
+func (p *SimpleProduct) SetName(val string) {
+	p.Name = val
+}
+func (p *SimpleProduct) SetAvatar(val string) {
+	p.Avatar = val
+}
+func (p *SimpleProduct) SetRatingAvg(val float64) {
+	p.RatingAvg = val
+}
+func (p *SimpleProduct) SetRatingCount(val int32) {
+	p.RatingCount = val
+}
+func (p *SimpleProduct) SetBrandId(val int64) {
+	p.BrandId = val
+}
+func (p *SimpleProduct) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *SimpleProduct) SetType(val ProductType) {
+	p.Type = val
+}
+func (p *SimpleProduct) SetCategoryLabel(val *string) {
+	p.CategoryLabel = val
+}
+func (p *SimpleProduct) SetPriceTiers(val []*PriceTier) {
+	p.PriceTiers = val
+}
+
+func (p *SimpleProduct) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *SimpleProduct) IsSetCategoryLabel() bool {
+	return p.CategoryLabel != nil
+}
+
+func (p *SimpleProduct) IsSetPriceTiers() bool {
+	return p.PriceTiers != nil
+}
+
+func (p *SimpleProduct) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SimpleProduct(%+v)", *p)
+}
+
+var fieldIDToName_SimpleProduct = map[int16]string{
+	1:  "id",
+	2:  "category",
+	3:  "price",
+	4:  "stock",
+	5:  "status",
+	6:  "brand",
+	7:  "name",
+	8:  "avatar",
+	9:  "ratingAvg",
+	10: "ratingCount",
+	11: "brandId",
+	12: "merchantId",
+	13: "type",
+	14: "categoryLabel",
+	15: "priceTiers",
+}
+
+type CreateProductReq struct {
+	Name              string             `thrift:"name,1" frugal:"1,default,string" json:"name"`
+	Avatar            string             `thrift:"avatar,2" frugal:"2,default,string" json:"avatar"`
+	Category          string             `thrift:"category,3" frugal:"3,default,string" json:"category"`
+	Price             float64            `thrift:"price,4" frugal:"4,default,double" json:"price"`
+	Stock             int32              `thrift:"stock,5" frugal:"5,default,i32" json:"stock"`
+	Brand             *string            `thrift:"brand,6,optional" frugal:"6,optional,string" json:"brand,omitempty"`
+	Status            ProductStatus      `thrift:"status,7,optional" frugal:"7,optional,ProductStatus" json:"status,omitempty"`
+	LowStockThreshold *int32             `thrift:"lowStockThreshold,8,optional" frugal:"8,optional,i32" json:"lowStockThreshold,omitempty"`
+	SoldOutPolicy     *SoldOutPolicy     `thrift:"soldOutPolicy,9,optional" frugal:"9,optional,SoldOutPolicy" json:"soldOutPolicy,omitempty"`
+	Attributes        map[string]string  `thrift:"attributes,10,optional" frugal:"10,optional,map<string:string>" json:"attributes,omitempty"`
+	Operator          *string            `thrift:"operator,11,optional" frugal:"11,optional,string" json:"operator,omitempty"`
+	BrandId           *int64             `thrift:"brandId,12,optional" frugal:"12,optional,i64" json:"brandId,omitempty"`
+	MerchantId        *int64             `thrift:"merchantId,13,optional" frugal:"13,optional,i64" json:"merchantId,omitempty"`
+	Type              ProductType        `thrift:"type,14,optional" frugal:"14,optional,ProductType" json:"type,omitempty"`
+	Components        []*BundleComponent `thrift:"components,15,optional" frugal:"15,optional,list<BundleComponent>" json:"components,omitempty"`
+}
+
+func NewCreateProductReq() *CreateProductReq {
+	return &CreateProductReq{
+		Status: ProductStatus_DRAFT,
+		Type:   ProductType_NORMAL,
+	}
+}
+
+func (p *CreateProductReq) InitDefault() {
+	p.Status = ProductStatus_DRAFT
+	p.Type = ProductType_NORMAL
+}
+
+func (p *CreateProductReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateProductReq) GetAvatar() (v string) {
+	return p.Avatar
+}
+
+func (p *CreateProductReq) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *CreateProductReq) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *CreateProductReq) GetStock() (v int32) {
+	return p.Stock
+}
+
+var CreateProductReq_Brand_DEFAULT string
+
+func (p *CreateProductReq) GetBrand() (v string) {
+	if !p.IsSetBrand() {
+		return CreateProductReq_Brand_DEFAULT
+	}
+	return *p.Brand
+}
+
+var CreateProductReq_Status_DEFAULT ProductStatus = ProductStatus_DRAFT
+
+func (p *CreateProductReq) GetStatus() (v ProductStatus) {
+	if !p.IsSetStatus() {
+		return CreateProductReq_Status_DEFAULT
+	}
+	return p.Status
+}
+
+var CreateProductReq_LowStockThreshold_DEFAULT int32
+
+func (p *CreateProductReq) GetLowStockThreshold() (v int32) {
+	if !p.IsSetLowStockThreshold() {
+		return CreateProductReq_LowStockThreshold_DEFAULT
+	}
+	return *p.LowStockThreshold
+}
+
+var CreateProductReq_SoldOutPolicy_DEFAULT SoldOutPolicy
+
+func (p *CreateProductReq) GetSoldOutPolicy() (v SoldOutPolicy) {
+	if !p.IsSetSoldOutPolicy() {
+		return CreateProductReq_SoldOutPolicy_DEFAULT
+	}
+	return *p.SoldOutPolicy
+}
+
+var CreateProductReq_Attributes_DEFAULT map[string]string
+
+func (p *CreateProductReq) GetAttributes() (v map[string]string) {
+	if !p.IsSetAttributes() {
+		return CreateProductReq_Attributes_DEFAULT
+	}
+	return p.Attributes
+}
+
+var CreateProductReq_Operator_DEFAULT string
+
+func (p *CreateProductReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return CreateProductReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+
+var CreateProductReq_BrandId_DEFAULT int64
+
+func (p *CreateProductReq) GetBrandId() (v int64) {
+	if !p.IsSetBrandId() {
+		return CreateProductReq_BrandId_DEFAULT
+	}
+	return *p.BrandId
+}
+
+var CreateProductReq_MerchantId_DEFAULT int64
+
+func (p *CreateProductReq) GetMerchantId() (v int64) {
+	if !p.IsSetMerchantId() {
+		return CreateProductReq_MerchantId_DEFAULT
+	}
+	return *p.MerchantId
+}
+
+var CreateProductReq_Type_DEFAULT ProductType = ProductType_NORMAL
+
+func (p *CreateProductReq) GetType() (v ProductType) {
+	if !p.IsSetType() {
+		return CreateProductReq_Type_DEFAULT
+	}
+	return p.Type
+}
+
+var CreateProductReq_Components_DEFAULT []*BundleComponent
+
+func (p *CreateProductReq) GetComponents() (v []*BundleComponent) {
+	if !p.IsSetComponents() {
+		return CreateProductReq_Components_DEFAULT
+	}
+	return p.Components
+}
+func (p *CreateProductReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateProductReq) SetAvatar(val string) {
+	p.Avatar = val
+}
+func (p *CreateProductReq) SetCategory(val string) {
+	p.Category = val
+}
+func (p *CreateProductReq) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *CreateProductReq) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *CreateProductReq) SetBrand(val *string) {
+	p.Brand = val
+}
+func (p *CreateProductReq) SetStatus(val ProductStatus) {
+	p.Status = val
+}
+func (p *CreateProductReq) SetLowStockThreshold(val *int32) {
+	p.LowStockThreshold = val
+}
+func (p *CreateProductReq) SetSoldOutPolicy(val *SoldOutPolicy) {
+	p.SoldOutPolicy = val
+}
+func (p *CreateProductReq) SetAttributes(val map[string]string) {
+	p.Attributes = val
+}
+func (p *CreateProductReq) SetOperator(val *string) {
+	p.Operator = val
+}
+func (p *CreateProductReq) SetBrandId(val *int64) {
+	p.BrandId = val
+}
+func (p *CreateProductReq) SetMerchantId(val *int64) {
+	p.MerchantId = val
+}
+func (p *CreateProductReq) SetType(val ProductType) {
+	p.Type = val
+}
+func (p *CreateProductReq) SetComponents(val []*BundleComponent) {
+	p.Components = val
+}
+
+func (p *CreateProductReq) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *CreateProductReq) IsSetStatus() bool {
+	return p.Status != CreateProductReq_Status_DEFAULT
+}
+
+func (p *CreateProductReq) IsSetLowStockThreshold() bool {
+	return p.LowStockThreshold != nil
+}
+
+func (p *CreateProductReq) IsSetSoldOutPolicy() bool {
+	return p.SoldOutPolicy != nil
+}
+
+func (p *CreateProductReq) IsSetAttributes() bool {
+	return p.Attributes != nil
+}
+
+func (p *CreateProductReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *CreateProductReq) IsSetBrandId() bool {
+	return p.BrandId != nil
+}
+
+func (p *CreateProductReq) IsSetMerchantId() bool {
+	return p.MerchantId != nil
+}
+
+func (p *CreateProductReq) IsSetType() bool {
+	return p.Type != CreateProductReq_Type_DEFAULT
+}
+
+func (p *CreateProductReq) IsSetComponents() bool {
+	return p.Components != nil
+}
+
+func (p *CreateProductReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateProductReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateProductReq = map[int16]string{
+	1:  "name",
+	2:  "avatar",
+	3:  "category",
+	4:  "price",
+	5:  "stock",
+	6:  "brand",
+	7:  "status",
+	8:  "lowStockThreshold",
+	9:  "soldOutPolicy",
+	10: "attributes",
+	11: "operator",
+	12: "brandId",
+	13: "merchantId",
+	14: "type",
+	15: "components",
+}
+
+type CreateProductResp struct {
+	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+}
+
+func NewCreateProductResp() *CreateProductResp {
+	return &CreateProductResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateProductResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateProductResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateProductResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CreateProductResp_Message_DEFAULT string
+
+func (p *CreateProductResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CreateProductResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var CreateProductResp_Product_DEFAULT *Product
+
+func (p *CreateProductResp) GetProduct() (v *Product) {
+	if !p.IsSetProduct() {
+		return CreateProductResp_Product_DEFAULT
+	}
+	return p.Product
+}
+func (p *CreateProductResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateProductResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateProductResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *CreateProductResp) SetProduct(val *Product) {
+	p.Product = val
+}
+
+func (p *CreateProductResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CreateProductResp) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *CreateProductResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateProductResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateProductResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "product",
+}
+
+type GetProductReq struct {
+	Id        int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	CountView *bool   `thrift:"countView,2,optional" frugal:"2,optional,bool" json:"countView,omitempty"`
+	Locale    *string `thrift:"locale,3,optional" frugal:"3,optional,string" json:"locale,omitempty"`
+	UserId    *int64  `thrift:"userId,4,optional" frugal:"4,optional,i64" json:"userId,omitempty"`
+}
+
+func NewGetProductReq() *GetProductReq {
+	return &GetProductReq{}
+}
+
+func (p *GetProductReq) InitDefault() {
+}
+
+func (p *GetProductReq) GetId() (v int64) {
+	return p.Id
+}
+
+var GetProductReq_CountView_DEFAULT bool
+
+func (p *GetProductReq) GetCountView() (v bool) {
+	if !p.IsSetCountView() {
+		return GetProductReq_CountView_DEFAULT
+	}
+	return *p.CountView
+}
+
+var GetProductReq_Locale_DEFAULT string
+
+func (p *GetProductReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return GetProductReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+
+var GetProductReq_UserId_DEFAULT int64
+
+func (p *GetProductReq) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return GetProductReq_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+func (p *GetProductReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *GetProductReq) SetCountView(val *bool) {
+	p.CountView = val
+}
+func (p *GetProductReq) SetLocale(val *string) {
+	p.Locale = val
+}
+func (p *GetProductReq) SetUserId(val *int64) {
+	p.UserId = val
+}
+
+func (p *GetProductReq) IsSetCountView() bool {
+	return p.CountView != nil
+}
+
+func (p *GetProductReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *GetProductReq) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *GetProductReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetProductReq(%+v)", *p)
+}
+
+var fieldIDToName_GetProductReq = map[int16]string{
+	1: "id",
+	2: "countView",
+	3: "locale",
+	4: "userId",
+}
+
+type GetProductResp struct {
+	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+}
+
+func NewGetProductResp() *GetProductResp {
+	return &GetProductResp{
+		Code: 0,
+	}
+}
+
+func (p *GetProductResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetProductResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetProductResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetProductResp_Message_DEFAULT string
+
+func (p *GetProductResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetProductResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var GetProductResp_Product_DEFAULT *Product
+
+func (p *GetProductResp) GetProduct() (v *Product) {
+	if !p.IsSetProduct() {
+		return GetProductResp_Product_DEFAULT
+	}
+	return p.Product
+}
+func (p *GetProductResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetProductResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetProductResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetProductResp) SetProduct(val *Product) {
+	p.Product = val
+}
+
+func (p *GetProductResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetProductResp) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *GetProductResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetProductResp(%+v)", *p)
+}
+
+var fieldIDToName_GetProductResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "product",
+}
+
+type UpdateProductReq struct {
+	Id                int64             `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name              *string           `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Avatar            *string           `thrift:"avatar,3,optional" frugal:"3,optional,string" json:"avatar,omitempty"`
+	Category          *string           `thrift:"category,4,optional" frugal:"4,optional,string" json:"category,omitempty"`
+	Price             *float64          `thrift:"price,5,optional" frugal:"5,optional,double" json:"price,omitempty"`
+	Stock             *int32            `thrift:"stock,6,optional" frugal:"6,optional,i32" json:"stock,omitempty"`
+	Status            *ProductStatus    `thrift:"status,7,optional" frugal:"7,optional,ProductStatus" json:"status,omitempty"`
+	Brand             *string           `thrift:"brand,8,optional" frugal:"8,optional,string" json:"brand,omitempty"`
+	LowStockThreshold *int32            `thrift:"lowStockThreshold,9,optional" frugal:"9,optional,i32" json:"lowStockThreshold,omitempty"`
+	SoldOutPolicy     *SoldOutPolicy    `thrift:"soldOutPolicy,10,optional" frugal:"10,optional,SoldOutPolicy" json:"soldOutPolicy,omitempty"`
+	Attributes        map[string]string `thrift:"attributes,11,optional" frugal:"11,optional,map<string:string>" json:"attributes,omitempty"`
+	Version           *int64            `thrift:"version,12,optional" frugal:"12,optional,i64" json:"version,omitempty"`
+	Operator          *string           `thrift:"operator,13,optional" frugal:"13,optional,string" json:"operator,omitempty"`
+	BrandId           *int64            `thrift:"brandId,14,optional" frugal:"14,optional,i64" json:"brandId,omitempty"`
+}
+
+func NewUpdateProductReq() *UpdateProductReq {
+	return &UpdateProductReq{}
+}
+
+func (p *UpdateProductReq) InitDefault() {
+}
+
+func (p *UpdateProductReq) GetId() (v int64) {
+	return p.Id
+}
+
+var UpdateProductReq_Name_DEFAULT string
+
+func (p *UpdateProductReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateProductReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateProductReq_Avatar_DEFAULT string
+
+func (p *UpdateProductReq) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return UpdateProductReq_Avatar_DEFAULT
+	}
+	return *p.Avatar
+}
+
+var UpdateProductReq_Category_DEFAULT string
+
+func (p *UpdateProductReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return UpdateProductReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var UpdateProductReq_Price_DEFAULT float64
+
+func (p *UpdateProductReq) GetPrice() (v float64) {
+	if !p.IsSetPrice() {
+		return UpdateProductReq_Price_DEFAULT
+	}
+	return *p.Price
+}
+
+var UpdateProductReq_Stock_DEFAULT int32
+
+func (p *UpdateProductReq) GetStock() (v int32) {
+	if !p.IsSetStock() {
+		return UpdateProductReq_Stock_DEFAULT
+	}
+	return *p.Stock
+}
+
+var UpdateProductReq_Status_DEFAULT ProductStatus
+
+func (p *UpdateProductReq) GetStatus() (v ProductStatus) {
+	if !p.IsSetStatus() {
+		return UpdateProductReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var UpdateProductReq_Brand_DEFAULT string
+
+func (p *UpdateProductReq) GetBrand() (v string) {
+	if !p.IsSetBrand() {
+		return UpdateProductReq_Brand_DEFAULT
+	}
+	return *p.Brand
+}
+
+var UpdateProductReq_LowStockThreshold_DEFAULT int32
+
+func (p *UpdateProductReq) GetLowStockThreshold() (v int32) {
+	if !p.IsSetLowStockThreshold() {
+		return UpdateProductReq_LowStockThreshold_DEFAULT
+	}
+	return *p.LowStockThreshold
+}
+
+var UpdateProductReq_SoldOutPolicy_DEFAULT SoldOutPolicy
+
+func (p *UpdateProductReq) GetSoldOutPolicy() (v SoldOutPolicy) {
+	if !p.IsSetSoldOutPolicy() {
+		return UpdateProductReq_SoldOutPolicy_DEFAULT
+	}
+	return *p.SoldOutPolicy
+}
+
+var UpdateProductReq_Attributes_DEFAULT map[string]string
+
+func (p *UpdateProductReq) GetAttributes() (v map[string]string) {
+	if !p.IsSetAttributes() {
+		return UpdateProductReq_Attributes_DEFAULT
+	}
+	return p.Attributes
+}
+
+var UpdateProductReq_Version_DEFAULT int64
+
+func (p *UpdateProductReq) GetVersion() (v int64) {
+	if !p.IsSetVersion() {
+		return UpdateProductReq_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var UpdateProductReq_Operator_DEFAULT string
+
+func (p *UpdateProductReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return UpdateProductReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+
+var UpdateProductReq_BrandId_DEFAULT int64
+
+func (p *UpdateProductReq) GetBrandId() (v int64) {
+	if !p.IsSetBrandId() {
+		return UpdateProductReq_BrandId_DEFAULT
+	}
+	return *p.BrandId
+}
+func (p *UpdateProductReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *UpdateProductReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateProductReq) SetAvatar(val *string) {
+	p.Avatar = val
+}
+func (p *UpdateProductReq) SetCategory(val *string) {
+	p.Category = val
+}
+func (p *UpdateProductReq) SetPrice(val *float64) {
+	p.Price = val
+}
+func (p *UpdateProductReq) SetStock(val *int32) {
+	p.Stock = val
+}
+func (p *UpdateProductReq) SetStatus(val *ProductStatus) {
+	p.Status = val
+}
+func (p *UpdateProductReq) SetBrand(val *string) {
+	p.Brand = val
+}
+func (p *UpdateProductReq) SetLowStockThreshold(val *int32) {
+	p.LowStockThreshold = val
+}
+func (p *UpdateProductReq) SetSoldOutPolicy(val *SoldOutPolicy) {
+	p.SoldOutPolicy = val
+}
+func (p *UpdateProductReq) SetAttributes(val map[string]string) {
+	p.Attributes = val
+}
+func (p *UpdateProductReq) SetVersion(val *int64) {
+	p.Version = val
+}
+func (p *UpdateProductReq) SetOperator(val *string) {
+	p.Operator = val
+}
+func (p *UpdateProductReq) SetBrandId(val *int64) {
+	p.BrandId = val
+}
+
+func (p *UpdateProductReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateProductReq) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *UpdateProductReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *UpdateProductReq) IsSetPrice() bool {
+	return p.Price != nil
+}
+
+func (p *UpdateProductReq) IsSetStock() bool {
+	return p.Stock != nil
+}
+
+func (p *UpdateProductReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateProductReq) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *UpdateProductReq) IsSetLowStockThreshold() bool {
+	return p.LowStockThreshold != nil
+}
+
+func (p *UpdateProductReq) IsSetSoldOutPolicy() bool {
+	return p.SoldOutPolicy != nil
+}
+
+func (p *UpdateProductReq) IsSetAttributes() bool {
+	return p.Attributes != nil
+}
+
+func (p *UpdateProductReq) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *UpdateProductReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *UpdateProductReq) IsSetBrandId() bool {
+	return p.BrandId != nil
+}
+
+func (p *UpdateProductReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProductReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateProductReq = map[int16]string{
+	1:  "id",
+	2:  "name",
+	3:  "avatar",
+	4:  "category",
+	5:  "price",
+	6:  "stock",
+	7:  "status",
+	8:  "brand",
+	9:  "lowStockThreshold",
+	10: "soldOutPolicy",
+	11: "attributes",
+	12: "version",
+	13: "operator",
+	14: "brandId",
+}
+
+type UpdateProductResp struct {
+	Success  bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Product  *Product         `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+	Revision *ProductApproval `thrift:"revision,5,optional" frugal:"5,optional,ProductApproval" json:"revision,omitempty"`
+}
+
+func NewUpdateProductResp() *UpdateProductResp {
+	return &UpdateProductResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateProductResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateProductResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateProductResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateProductResp_Message_DEFAULT string
+
+func (p *UpdateProductResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateProductResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var UpdateProductResp_Product_DEFAULT *Product
+
+func (p *UpdateProductResp) GetProduct() (v *Product) {
+	if !p.IsSetProduct() {
+		return UpdateProductResp_Product_DEFAULT
+	}
+	return p.Product
+}
+
+var UpdateProductResp_Revision_DEFAULT *ProductApproval
+
+func (p *UpdateProductResp) GetRevision() (v *ProductApproval) {
+	if !p.IsSetRevision() {
+		return UpdateProductResp_Revision_DEFAULT
+	}
+	return p.Revision
+}
+func (p *UpdateProductResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateProductResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateProductResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UpdateProductResp) SetProduct(val *Product) {
+	p.Product = val
+}
+func (p *UpdateProductResp) SetRevision(val *ProductApproval) {
+	p.Revision = val
+}
+
+func (p *UpdateProductResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateProductResp) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *UpdateProductResp) IsSetRevision() bool {
+	return p.Revision != nil
+}
+
+func (p *UpdateProductResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProductResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateProductResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "product",
+	5: "revision",
+}
+
+type DeleteProductReq struct {
+	Id       int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Operator *string `thrift:"operator,2,optional" frugal:"2,optional,string" json:"operator,omitempty"`
+}
+
+func NewDeleteProductReq() *DeleteProductReq {
+	return &DeleteProductReq{}
+}
+
+func (p *DeleteProductReq) InitDefault() {
+}
+
+func (p *DeleteProductReq) GetId() (v int64) {
+	return p.Id
+}
+
+var DeleteProductReq_Operator_DEFAULT string
+
+func (p *DeleteProductReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return DeleteProductReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *DeleteProductReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *DeleteProductReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *DeleteProductReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *DeleteProductReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteProductReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteProductReq = map[int16]string{
+	1: "id",
+	2: "operator",
+}
+
+type DeleteProductResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewDeleteProductResp() *DeleteProductResp {
+	return &DeleteProductResp{
+		Code: 0,
+	}
+}
+
+func (p *DeleteProductResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *DeleteProductResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DeleteProductResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var DeleteProductResp_Message_DEFAULT string
+
+func (p *DeleteProductResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return DeleteProductResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *DeleteProductResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DeleteProductResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *DeleteProductResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *DeleteProductResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *DeleteProductResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteProductResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteProductResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type OnlineProductReq struct {
+	Id        int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ExecuteAt *int64  `thrift:"executeAt,2,optional" frugal:"2,optional,i64" json:"executeAt,omitempty"`
+	Operator  *string `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewOnlineProductReq() *OnlineProductReq {
+	return &OnlineProductReq{}
+}
+
+func (p *OnlineProductReq) InitDefault() {
+}
+
+func (p *OnlineProductReq) GetId() (v int64) {
+	return p.Id
+}
+
+var OnlineProductReq_ExecuteAt_DEFAULT int64
+
+func (p *OnlineProductReq) GetExecuteAt() (v int64) {
+	if !p.IsSetExecuteAt() {
+		return OnlineProductReq_ExecuteAt_DEFAULT
+	}
+	return *p.ExecuteAt
+}
+
+var OnlineProductReq_Operator_DEFAULT string
+
+func (p *OnlineProductReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return OnlineProductReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *OnlineProductReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *OnlineProductReq) SetExecuteAt(val *int64) {
+	p.ExecuteAt = val
+}
+func (p *OnlineProductReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *OnlineProductReq) IsSetExecuteAt() bool {
+	return p.ExecuteAt != nil
+}
+
+func (p *OnlineProductReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *OnlineProductReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OnlineProductReq(%+v)", *p)
+}
+
+var fieldIDToName_OnlineProductReq = map[int16]string{
+	1: "id",
+	2: "executeAt",
+	3: "operator",
+}
+
+type OnlineProductResp struct {
+	Success    bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	OldStatus  ProductStatus `thrift:"oldStatus,4" frugal:"4,default,ProductStatus" json:"oldStatus"`
+	NewStatus_ ProductStatus `thrift:"newStatus,5" frugal:"5,default,ProductStatus" json:"newStatus"`
+	OperatedAt int64         `thrift:"operatedAt,6" frugal:"6,default,i64" json:"operatedAt"`
+	ScheduleId *int64        `thrift:"scheduleId,7,optional" frugal:"7,optional,i64" json:"scheduleId,omitempty"`
+}
+
+func NewOnlineProductResp() *OnlineProductResp {
+	return &OnlineProductResp{
+		Code: 0,
+	}
+}
+
+func (p *OnlineProductResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *OnlineProductResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *OnlineProductResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var OnlineProductResp_Message_DEFAULT string
+
+func (p *OnlineProductResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return OnlineProductResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *OnlineProductResp) GetOldStatus() (v ProductStatus) {
+	return p.OldStatus
+}
+
+func (p *OnlineProductResp) GetNewStatus_() (v ProductStatus) {
+	return p.NewStatus_
+}
+
+func (p *OnlineProductResp) GetOperatedAt() (v int64) {
+	return p.OperatedAt
+}
+
+var OnlineProductResp_ScheduleId_DEFAULT int64
+
+func (p *OnlineProductResp) GetScheduleId() (v int64) {
+	if !p.IsSetScheduleId() {
+		return OnlineProductResp_ScheduleId_DEFAULT
+	}
+	return *p.ScheduleId
+}
+func (p *OnlineProductResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *OnlineProductResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *OnlineProductResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *OnlineProductResp) SetOldStatus(val ProductStatus) {
+	p.OldStatus = val
+}
+func (p *OnlineProductResp) SetNewStatus_(val ProductStatus) {
+	p.NewStatus_ = val
+}
+func (p *OnlineProductResp) SetOperatedAt(val int64) {
+	p.OperatedAt = val
+}
+func (p *OnlineProductResp) SetScheduleId(val *int64) {
+	p.ScheduleId = val
+}
+
+func (p *OnlineProductResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *OnlineProductResp) IsSetScheduleId() bool {
+	return p.ScheduleId != nil
+}
+
+func (p *OnlineProductResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OnlineProductResp(%+v)", *p)
+}
+
+var fieldIDToName_OnlineProductResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "oldStatus",
+	5: "newStatus",
+	6: "operatedAt",
+	7: "scheduleId",
+}
+
+type OfflineProductReq struct {
+	Id        int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ExecuteAt *int64  `thrift:"executeAt,2,optional" frugal:"2,optional,i64" json:"executeAt,omitempty"`
+	Operator  *string `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewOfflineProductReq() *OfflineProductReq {
+	return &OfflineProductReq{}
+}
+
+func (p *OfflineProductReq) InitDefault() {
+}
+
+func (p *OfflineProductReq) GetId() (v int64) {
+	return p.Id
+}
+
+var OfflineProductReq_ExecuteAt_DEFAULT int64
+
+func (p *OfflineProductReq) GetExecuteAt() (v int64) {
+	if !p.IsSetExecuteAt() {
+		return OfflineProductReq_ExecuteAt_DEFAULT
+	}
+	return *p.ExecuteAt
+}
+
+var OfflineProductReq_Operator_DEFAULT string
+
+func (p *OfflineProductReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return OfflineProductReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *OfflineProductReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *OfflineProductReq) SetExecuteAt(val *int64) {
+	p.ExecuteAt = val
+}
+func (p *OfflineProductReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *OfflineProductReq) IsSetExecuteAt() bool {
+	return p.ExecuteAt != nil
+}
+
+func (p *OfflineProductReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *OfflineProductReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OfflineProductReq(%+v)", *p)
+}
+
+var fieldIDToName_OfflineProductReq = map[int16]string{
+	1: "id",
+	2: "executeAt",
+	3: "operator",
+}
+
+type OfflineProductResp struct {
+	Success    bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	OldStatus  ProductStatus `thrift:"oldStatus,4" frugal:"4,default,ProductStatus" json:"oldStatus"`
+	NewStatus_ ProductStatus `thrift:"newStatus,5" frugal:"5,default,ProductStatus" json:"newStatus"`
+	OperatedAt int64         `thrift:"operatedAt,6" frugal:"6,default,i64" json:"operatedAt"`
+	ScheduleId *int64        `thrift:"scheduleId,7,optional" frugal:"7,optional,i64" json:"scheduleId,omitempty"`
+}
+
+func NewOfflineProductResp() *OfflineProductResp {
+	return &OfflineProductResp{
+		Code: 0,
+	}
+}
+
+func (p *OfflineProductResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *OfflineProductResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *OfflineProductResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var OfflineProductResp_Message_DEFAULT string
+
+func (p *OfflineProductResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return OfflineProductResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *OfflineProductResp) GetOldStatus() (v ProductStatus) {
+	return p.OldStatus
+}
+
+func (p *OfflineProductResp) GetNewStatus_() (v ProductStatus) {
+	return p.NewStatus_
+}
+
+func (p *OfflineProductResp) GetOperatedAt() (v int64) {
+	return p.OperatedAt
+}
+
+var OfflineProductResp_ScheduleId_DEFAULT int64
+
+func (p *OfflineProductResp) GetScheduleId() (v int64) {
+	if !p.IsSetScheduleId() {
+		return OfflineProductResp_ScheduleId_DEFAULT
+	}
+	return *p.ScheduleId
+}
+func (p *OfflineProductResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *OfflineProductResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *OfflineProductResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *OfflineProductResp) SetOldStatus(val ProductStatus) {
+	p.OldStatus = val
+}
+func (p *OfflineProductResp) SetNewStatus_(val ProductStatus) {
+	p.NewStatus_ = val
+}
+func (p *OfflineProductResp) SetOperatedAt(val int64) {
+	p.OperatedAt = val
+}
+func (p *OfflineProductResp) SetScheduleId(val *int64) {
+	p.ScheduleId = val
+}
+
+func (p *OfflineProductResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *OfflineProductResp) IsSetScheduleId() bool {
+	return p.ScheduleId != nil
+}
+
+func (p *OfflineProductResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OfflineProductResp(%+v)", *p)
+}
+
+var fieldIDToName_OfflineProductResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "oldStatus",
+	5: "newStatus",
+	6: "operatedAt",
+	7: "scheduleId",
+}
+
+type AttributeFilter struct {
+	Code   string   `thrift:"code,1" frugal:"1,default,string" json:"code"`
+	Op     string   `thrift:"op,2" frugal:"2,default,string" json:"op"`
+	Values []string `thrift:"values,3" frugal:"3,default,list<string>" json:"values"`
+}
+
+func NewAttributeFilter() *AttributeFilter {
+	return &AttributeFilter{}
+}
+
+func (p *AttributeFilter) InitDefault() {
+}
+
+func (p *AttributeFilter) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *AttributeFilter) GetOp() (v string) {
+	return p.Op
+}
+
+func (p *AttributeFilter) GetValues() (v []string) {
+	return p.Values
+}
+func (p *AttributeFilter) SetCode(val string) {
+	p.Code = val
+}
+func (p *AttributeFilter) SetOp(val string) {
+	p.Op = val
+}
+func (p *AttributeFilter) SetValues(val []string) {
+	p.Values = val
+}
+
+func (p *AttributeFilter) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttributeFilter(%+v)", *p)
+}
+
+var fieldIDToName_AttributeFilter = map[int16]string{
+	1: "code",
+	2: "op",
+	3: "values",
+}
+
+type UserSearchProductsReq struct {
+	Category         *string            `thrift:"category,1,optional" frugal:"1,optional,string" json:"category,omitempty"`
+	MinPrice         *float64           `thrift:"minPrice,2,optional" frugal:"2,optional,double" json:"minPrice,omitempty"`
+	MaxPrice         *float64           `thrift:"maxPrice,3,optional" frugal:"3,optional,double" json:"maxPrice,omitempty"`
+	Keyword          *string            `thrift:"keyword,4,optional" frugal:"4,optional,string" json:"keyword,omitempty"`
+	Page             int32              `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize         int32              `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	AttributeFilters []*AttributeFilter `thrift:"attributeFilters,7,optional" frugal:"7,optional,list<AttributeFilter>" json:"attributeFilters,omitempty"`
+	SortBy           *string            `thrift:"sortBy,8,optional" frugal:"8,optional,string" json:"sortBy,omitempty"`
+	BrandIds         []int64            `thrift:"brandIds,9,optional" frugal:"9,optional,list<i64>" json:"brandIds,omitempty"`
+	Locale           *string            `thrift:"locale,10,optional" frugal:"10,optional,string" json:"locale,omitempty"`
+	UserId           *int64             `thrift:"userId,11,optional" frugal:"11,optional,i64" json:"userId,omitempty"`
+}
+
+func NewUserSearchProductsReq() *UserSearchProductsReq {
+	return &UserSearchProductsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *UserSearchProductsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var UserSearchProductsReq_Category_DEFAULT string
+
+func (p *UserSearchProductsReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return UserSearchProductsReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var UserSearchProductsReq_MinPrice_DEFAULT float64
+
+func (p *UserSearchProductsReq) GetMinPrice() (v float64) {
+	if !p.IsSetMinPrice() {
+		return UserSearchProductsReq_MinPrice_DEFAULT
+	}
+	return *p.MinPrice
+}
+
+var UserSearchProductsReq_MaxPrice_DEFAULT float64
+
+func (p *UserSearchProductsReq) GetMaxPrice() (v float64) {
+	if !p.IsSetMaxPrice() {
+		return UserSearchProductsReq_MaxPrice_DEFAULT
+	}
+	return *p.MaxPrice
+}
+
+var UserSearchProductsReq_Keyword_DEFAULT string
+
+func (p *UserSearchProductsReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return UserSearchProductsReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+func (p *UserSearchProductsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *UserSearchProductsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var UserSearchProductsReq_AttributeFilters_DEFAULT []*AttributeFilter
+
+func (p *UserSearchProductsReq) GetAttributeFilters() (v []*AttributeFilter) {
+	if !p.IsSetAttributeFilters() {
+		return UserSearchProductsReq_AttributeFilters_DEFAULT
+	}
+	return p.AttributeFilters
+}
+
+var UserSearchProductsReq_SortBy_DEFAULT string
+
+func (p *UserSearchProductsReq) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return UserSearchProductsReq_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
+
+var UserSearchProductsReq_BrandIds_DEFAULT []int64
+
+func (p *UserSearchProductsReq) GetBrandIds() (v []int64) {
+	if !p.IsSetBrandIds() {
+		return UserSearchProductsReq_BrandIds_DEFAULT
+	}
+	return p.BrandIds
+}
+
+var UserSearchProductsReq_Locale_DEFAULT string
+
+func (p *UserSearchProductsReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return UserSearchProductsReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+
+var UserSearchProductsReq_UserId_DEFAULT int64
+
+func (p *UserSearchProductsReq) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return UserSearchProductsReq_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+func (p *UserSearchProductsReq) SetCategory(val *string) {
+	p.Category = val
+}
+func (p *UserSearchProductsReq) SetMinPrice(val *float64) {
+	p.MinPrice = val
+}
+func (p *UserSearchProductsReq) SetMaxPrice(val *float64) {
+	p.MaxPrice = val
+}
+func (p *UserSearchProductsReq) SetKeyword(val *string) {
+	p.Keyword = val
+}
+func (p *UserSearchProductsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *UserSearchProductsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *UserSearchProductsReq) SetAttributeFilters(val []*AttributeFilter) {
+	p.AttributeFilters = val
+}
+func (p *UserSearchProductsReq) SetSortBy(val *string) {
+	p.SortBy = val
+}
+func (p *UserSearchProductsReq) SetBrandIds(val []int64) {
+	p.BrandIds = val
+}
+func (p *UserSearchProductsReq) SetLocale(val *string) {
+	p.Locale = val
+}
+func (p *UserSearchProductsReq) SetUserId(val *int64) {
+	p.UserId = val
+}
+
+func (p *UserSearchProductsReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *UserSearchProductsReq) IsSetMinPrice() bool {
+	return p.MinPrice != nil
+}
+
+func (p *UserSearchProductsReq) IsSetMaxPrice() bool {
+	return p.MaxPrice != nil
+}
+
+func (p *UserSearchProductsReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *UserSearchProductsReq) IsSetAttributeFilters() bool {
+	return p.AttributeFilters != nil
+}
+
+func (p *UserSearchProductsReq) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
+
+func (p *UserSearchProductsReq) IsSetBrandIds() bool {
+	return p.BrandIds != nil
+}
+
+func (p *UserSearchProductsReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *UserSearchProductsReq) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *UserSearchProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserSearchProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_UserSearchProductsReq = map[int16]string{
+	1:  "category",
+	2:  "minPrice",
+	3:  "maxPrice",
+	4:  "keyword",
+	5:  "page",
+	6:  "pageSize",
+	7:  "attributeFilters",
+	8:  "sortBy",
+	9:  "brandIds",
+	10: "locale",
+	11: "userId",
+}
+
+type UserSearchProductsResp struct {
+	Success     bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total       int32            `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page        int32            `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize    int32            `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Products    []*SimpleProduct `thrift:"products,7" frugal:"7,default,list<SimpleProduct>" json:"products"`
+	BrandFacets []*BrandFacet    `thrift:"brandFacets,8" frugal:"8,default,list<BrandFacet>" json:"brandFacets"`
+}
+
+func NewUserSearchProductsResp() *UserSearchProductsResp {
+	return &UserSearchProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *UserSearchProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UserSearchProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UserSearchProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UserSearchProductsResp_Message_DEFAULT string
+
+func (p *UserSearchProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UserSearchProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *UserSearchProductsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *UserSearchProductsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *UserSearchProductsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *UserSearchProductsResp) GetProducts() (v []*SimpleProduct) {
+	return p.Products
+}
+
+func (p *UserSearchProductsResp) GetBrandFacets() (v []*BrandFacet) {
+	return p.BrandFacets
+}
+func (p *UserSearchProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UserSearchProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UserSearchProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UserSearchProductsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *UserSearchProductsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *UserSearchProductsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *UserSearchProductsResp) SetProducts(val []*SimpleProduct) {
+	p.Products = val
+}
+func (p *UserSearchProductsResp) SetBrandFacets(val []*BrandFacet) {
+	p.BrandFacets = val
+}
+
+func (p *UserSearchProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UserSearchProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserSearchProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_UserSearchProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "products",
+	8: "brandFacets",
+}
+
+type AdminSearchProductsReq struct {
+	Id               *int64             `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id,omitempty"`
+	Category         *string            `thrift:"category,2,optional" frugal:"2,optional,string" json:"category,omitempty"`
+	MinPrice         *float64           `thrift:"minPrice,3,optional" frugal:"3,optional,double" json:"minPrice,omitempty"`
+	MaxPrice         *float64           `thrift:"maxPrice,4,optional" frugal:"4,optional,double" json:"maxPrice,omitempty"`
+	Keyword          *string            `thrift:"keyword,5,optional" frugal:"5,optional,string" json:"keyword,omitempty"`
+	Page             int32              `thrift:"page,6" frugal:"6,default,i32" json:"page"`
+	PageSize         int32              `thrift:"pageSize,7" frugal:"7,default,i32" json:"pageSize"`
+	AttributeFilters []*AttributeFilter `thrift:"attributeFilters,8,optional" frugal:"8,optional,list<AttributeFilter>" json:"attributeFilters,omitempty"`
+	SortBy           *string            `thrift:"sortBy,9,optional" frugal:"9,optional,string" json:"sortBy,omitempty"`
+	BrandIds         []int64            `thrift:"brandIds,10,optional" frugal:"10,optional,list<i64>" json:"brandIds,omitempty"`
+	MerchantId       *int64             `thrift:"merchantId,11,optional" frugal:"11,optional,i64" json:"merchantId,omitempty"`
+}
+
+func NewAdminSearchProductsReq() *AdminSearchProductsReq {
+	return &AdminSearchProductsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *AdminSearchProductsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var AdminSearchProductsReq_Id_DEFAULT int64
+
+func (p *AdminSearchProductsReq) GetId() (v int64) {
+	if !p.IsSetId() {
+		return AdminSearchProductsReq_Id_DEFAULT
+	}
+	return *p.Id
+}
+
+var AdminSearchProductsReq_Category_DEFAULT string
+
+func (p *AdminSearchProductsReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return AdminSearchProductsReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var AdminSearchProductsReq_MinPrice_DEFAULT float64
+
+func (p *AdminSearchProductsReq) GetMinPrice() (v float64) {
+	if !p.IsSetMinPrice() {
+		return AdminSearchProductsReq_MinPrice_DEFAULT
+	}
+	return *p.MinPrice
+}
+
+var AdminSearchProductsReq_MaxPrice_DEFAULT float64
+
+func (p *AdminSearchProductsReq) GetMaxPrice() (v float64) {
+	if !p.IsSetMaxPrice() {
+		return AdminSearchProductsReq_MaxPrice_DEFAULT
+	}
+	return *p.MaxPrice
+}
+
+var AdminSearchProductsReq_Keyword_DEFAULT string
+
+func (p *AdminSearchProductsReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return AdminSearchProductsReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+func (p *AdminSearchProductsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *AdminSearchProductsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var AdminSearchProductsReq_AttributeFilters_DEFAULT []*AttributeFilter
+
+func (p *AdminSearchProductsReq) GetAttributeFilters() (v []*AttributeFilter) {
+	if !p.IsSetAttributeFilters() {
+		return AdminSearchProductsReq_AttributeFilters_DEFAULT
+	}
+	return p.AttributeFilters
+}
+
+var AdminSearchProductsReq_SortBy_DEFAULT string
+
+func (p *AdminSearchProductsReq) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return AdminSearchProductsReq_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
+
+var AdminSearchProductsReq_BrandIds_DEFAULT []int64
+
+func (p *AdminSearchProductsReq) GetBrandIds() (v []int64) {
+	if !p.IsSetBrandIds() {
+		return AdminSearchProductsReq_BrandIds_DEFAULT
+	}
+	return p.BrandIds
+}
+
+var AdminSearchProductsReq_MerchantId_DEFAULT int64
+
+func (p *AdminSearchProductsReq) GetMerchantId() (v int64) {
+	if !p.IsSetMerchantId() {
+		return AdminSearchProductsReq_MerchantId_DEFAULT
+	}
+	return *p.MerchantId
+}
+func (p *AdminSearchProductsReq) SetId(val *int64) {
+	p.Id = val
+}
+func (p *AdminSearchProductsReq) SetCategory(val *string) {
+	p.Category = val
+}
+func (p *AdminSearchProductsReq) SetMinPrice(val *float64) {
+	p.MinPrice = val
+}
+func (p *AdminSearchProductsReq) SetMaxPrice(val *float64) {
+	p.MaxPrice = val
+}
+func (p *AdminSearchProductsReq) SetKeyword(val *string) {
+	p.Keyword = val
+}
+func (p *AdminSearchProductsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *AdminSearchProductsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *AdminSearchProductsReq) SetAttributeFilters(val []*AttributeFilter) {
+	p.AttributeFilters = val
+}
+func (p *AdminSearchProductsReq) SetSortBy(val *string) {
+	p.SortBy = val
+}
+func (p *AdminSearchProductsReq) SetBrandIds(val []int64) {
+	p.BrandIds = val
+}
+func (p *AdminSearchProductsReq) SetMerchantId(val *int64) {
+	p.MerchantId = val
+}
+
+func (p *AdminSearchProductsReq) IsSetId() bool {
+	return p.Id != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetMinPrice() bool {
+	return p.MinPrice != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetMaxPrice() bool {
+	return p.MaxPrice != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetAttributeFilters() bool {
+	return p.AttributeFilters != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetBrandIds() bool {
+	return p.BrandIds != nil
+}
+
+func (p *AdminSearchProductsReq) IsSetMerchantId() bool {
+	return p.MerchantId != nil
+}
+
+func (p *AdminSearchProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminSearchProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_AdminSearchProductsReq = map[int16]string{
+	1:  "id",
+	2:  "category",
+	3:  "minPrice",
+	4:  "maxPrice",
+	5:  "keyword",
+	6:  "page",
+	7:  "pageSize",
+	8:  "attributeFilters",
+	9:  "sortBy",
+	10: "brandIds",
+	11: "merchantId",
+}
+
+type AdminSearchProductsResp struct {
+	Success     bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total       int32         `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page        int32         `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize    int32         `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Products    []*Product    `thrift:"products,7" frugal:"7,default,list<Product>" json:"products"`
+	BrandFacets []*BrandFacet `thrift:"brandFacets,8" frugal:"8,default,list<BrandFacet>" json:"brandFacets"`
+}
+
+func NewAdminSearchProductsResp() *AdminSearchProductsResp {
+	return &AdminSearchProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *AdminSearchProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *AdminSearchProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *AdminSearchProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var AdminSearchProductsResp_Message_DEFAULT string
+
+func (p *AdminSearchProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return AdminSearchProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *AdminSearchProductsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *AdminSearchProductsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *AdminSearchProductsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *AdminSearchProductsResp) GetProducts() (v []*Product) {
+	return p.Products
+}
+
+func (p *AdminSearchProductsResp) GetBrandFacets() (v []*BrandFacet) {
+	return p.BrandFacets
+}
+func (p *AdminSearchProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *AdminSearchProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *AdminSearchProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *AdminSearchProductsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *AdminSearchProductsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *AdminSearchProductsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *AdminSearchProductsResp) SetProducts(val []*Product) {
+	p.Products = val
+}
+func (p *AdminSearchProductsResp) SetBrandFacets(val []*BrandFacet) {
+	p.BrandFacets = val
+}
+
+func (p *AdminSearchProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *AdminSearchProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminSearchProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_AdminSearchProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "products",
+	8: "brandFacets",
+}
+
+type BatchGetProductsReq struct {
+	Ids    []int64 `thrift:"ids,1" frugal:"1,default,list<i64>" json:"ids"`
+	Locale *string `thrift:"locale,2,optional" frugal:"2,optional,string" json:"locale,omitempty"`
+}
+
+func NewBatchGetProductsReq() *BatchGetProductsReq {
+	return &BatchGetProductsReq{}
+}
+
+func (p *BatchGetProductsReq) InitDefault() {
+}
+
+func (p *BatchGetProductsReq) GetIds() (v []int64) {
+	return p.Ids
+}
+
+var BatchGetProductsReq_Locale_DEFAULT string
+
+func (p *BatchGetProductsReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return BatchGetProductsReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+func (p *BatchGetProductsReq) SetIds(val []int64) {
+	p.Ids = val
+}
+func (p *BatchGetProductsReq) SetLocale(val *string) {
+	p.Locale = val
+}
+
+func (p *BatchGetProductsReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *BatchGetProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_BatchGetProductsReq = map[int16]string{
+	1: "ids",
+	2: "locale",
+}
+
+type BatchGetProductsResp struct {
+	Success  bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string    `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Products []*Product `thrift:"products,4" frugal:"4,default,list<Product>" json:"products"`
+}
+
+func NewBatchGetProductsResp() *BatchGetProductsResp {
+	return &BatchGetProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *BatchGetProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *BatchGetProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *BatchGetProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var BatchGetProductsResp_Message_DEFAULT string
+
+func (p *BatchGetProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return BatchGetProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *BatchGetProductsResp) GetProducts() (v []*Product) {
+	return p.Products
+}
+func (p *BatchGetProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *BatchGetProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *BatchGetProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *BatchGetProductsResp) SetProducts(val []*Product) {
+	p.Products = val
+}
+
+func (p *BatchGetProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *BatchGetProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_BatchGetProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "products",
+}
+
+type ProductEvent struct {
+	Id        int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId int64  `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	Type      string `thrift:"type,3" frugal:"3,default,string" json:"type"`
+	CreatedAt int64  `thrift:"createdAt,4" frugal:"4,default,i64" json:"createdAt"`
+}
+
+func NewProductEvent() *ProductEvent {
+	return &ProductEvent{}
+}
+
+func (p *ProductEvent) InitDefault() {
+}
+
+func (p *ProductEvent) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ProductEvent) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductEvent) GetType() (v string) {
+	return p.Type
+}
+
+func (p *ProductEvent) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *ProductEvent) SetId(val int64) {
+	p.Id = val
+}
+func (p *ProductEvent) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductEvent) SetType(val string) {
+	p.Type = val
+}
+func (p *ProductEvent) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *ProductEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductEvent(%+v)", *p)
+}
+
+var fieldIDToName_ProductEvent = map[int16]string{
+	1: "id",
+	2: "productId",
+	3: "type",
+	4: "createdAt",
+}
+
+type ListProductEventsReq struct {
+	AfterId int64 `thrift:"afterId,1" frugal:"1,default,i64" json:"afterId"`
+	Limit   int32 `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+}
+
+func NewListProductEventsReq() *ListProductEventsReq {
+	return &ListProductEventsReq{
+		Limit: 100,
+	}
+}
+
+func (p *ListProductEventsReq) InitDefault() {
+	p.Limit = 100
+}
+
+func (p *ListProductEventsReq) GetAfterId() (v int64) {
+	return p.AfterId
+}
+
+func (p *ListProductEventsReq) GetLimit() (v int32) {
+	return p.Limit
+}
+func (p *ListProductEventsReq) SetAfterId(val int64) {
+	p.AfterId = val
+}
+func (p *ListProductEventsReq) SetLimit(val int32) {
+	p.Limit = val
+}
+
+func (p *ListProductEventsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductEventsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListProductEventsReq = map[int16]string{
+	1: "afterId",
+	2: "limit",
+}
+
+type ListProductEventsResp struct {
+	Success bool            `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32           `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string         `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Events  []*ProductEvent `thrift:"events,4" frugal:"4,default,list<ProductEvent>" json:"events"`
+	LastId  int64           `thrift:"lastId,5" frugal:"5,default,i64" json:"lastId"`
+}
+
+func NewListProductEventsResp() *ListProductEventsResp {
+	return &ListProductEventsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListProductEventsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListProductEventsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListProductEventsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListProductEventsResp_Message_DEFAULT string
+
+func (p *ListProductEventsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListProductEventsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListProductEventsResp) GetEvents() (v []*ProductEvent) {
+	return p.Events
+}
+
+func (p *ListProductEventsResp) GetLastId() (v int64) {
+	return p.LastId
+}
+func (p *ListProductEventsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListProductEventsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListProductEventsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListProductEventsResp) SetEvents(val []*ProductEvent) {
+	p.Events = val
+}
+func (p *ListProductEventsResp) SetLastId(val int64) {
+	p.LastId = val
+}
+
+func (p *ListProductEventsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListProductEventsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductEventsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListProductEventsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "events",
+	5: "lastId",
+}
+
+type StockMovement struct {
+	Id          int64             `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId   int64             `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	Delta       int32             `thrift:"delta,3" frugal:"3,default,i32" json:"delta"`
+	Balance     int32             `thrift:"balance,4" frugal:"4,default,i32" json:"balance"`
+	Reason      StockChangeReason `thrift:"reason,5" frugal:"5,default,StockChangeReason" json:"reason"`
+	ReferenceId *string           `thrift:"referenceId,6,optional" frugal:"6,optional,string" json:"referenceId,omitempty"`
+	Operator    string            `thrift:"operator,7" frugal:"7,default,string" json:"operator"`
+	CreatedAt   int64             `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
+	WarehouseId *int64            `thrift:"warehouseId,9,optional" frugal:"9,optional,i64" json:"warehouseId,omitempty"`
+}
+
+func NewStockMovement() *StockMovement {
+	return &StockMovement{}
+}
+
+func (p *StockMovement) InitDefault() {
+}
+
+func (p *StockMovement) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *StockMovement) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *StockMovement) GetDelta() (v int32) {
+	return p.Delta
+}
+
+func (p *StockMovement) GetBalance() (v int32) {
+	return p.Balance
+}
+
+func (p *StockMovement) GetReason() (v StockChangeReason) {
+	return p.Reason
+}
+
+var StockMovement_ReferenceId_DEFAULT string
+
+func (p *StockMovement) GetReferenceId() (v string) {
+	if !p.IsSetReferenceId() {
+		return StockMovement_ReferenceId_DEFAULT
+	}
+	return *p.ReferenceId
+}
+
+func (p *StockMovement) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *StockMovement) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var StockMovement_WarehouseId_DEFAULT int64
+
+func (p *StockMovement) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return StockMovement_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
+func (p *StockMovement) SetId(val int64) {
+	p.Id = val
+}
+func (p *StockMovement) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *StockMovement) SetDelta(val int32) {
+	p.Delta = val
+}
+func (p *StockMovement) SetBalance(val int32) {
+	p.Balance = val
+}
+func (p *StockMovement) SetReason(val StockChangeReason) {
+	p.Reason = val
+}
+func (p *StockMovement) SetReferenceId(val *string) {
+	p.ReferenceId = val
+}
+func (p *StockMovement) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *StockMovement) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *StockMovement) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+
+func (p *StockMovement) IsSetReferenceId() bool {
+	return p.ReferenceId != nil
+}
+
+func (p *StockMovement) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
+
+func (p *StockMovement) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StockMovement(%+v)", *p)
+}
+
+var fieldIDToName_StockMovement = map[int16]string{
+	1: "id",
+	2: "productId",
+	3: "delta",
+	4: "balance",
+	5: "reason",
+	6: "referenceId",
+	7: "operator",
+	8: "createdAt",
+	9: "warehouseId",
+}
+
+type AdjustStockReq struct {
+	ProductId   int64             `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Delta       int32             `thrift:"delta,2" frugal:"2,default,i32" json:"delta"`
+	Reason      StockChangeReason `thrift:"reason,3" frugal:"3,default,StockChangeReason" json:"reason"`
+	ReferenceId *string           `thrift:"referenceId,4,optional" frugal:"4,optional,string" json:"referenceId,omitempty"`
+	Operator    *string           `thrift:"operator,5,optional" frugal:"5,optional,string" json:"operator,omitempty"`
+	WarehouseId *int64            `thrift:"warehouseId,6,optional" frugal:"6,optional,i64" json:"warehouseId,omitempty"`
+}
+
+func NewAdjustStockReq() *AdjustStockReq {
+	return &AdjustStockReq{}
+}
+
+func (p *AdjustStockReq) InitDefault() {
+}
+
+func (p *AdjustStockReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *AdjustStockReq) GetDelta() (v int32) {
+	return p.Delta
+}
+
+func (p *AdjustStockReq) GetReason() (v StockChangeReason) {
+	return p.Reason
+}
+
+var AdjustStockReq_ReferenceId_DEFAULT string
+
+func (p *AdjustStockReq) GetReferenceId() (v string) {
+	if !p.IsSetReferenceId() {
+		return AdjustStockReq_ReferenceId_DEFAULT
+	}
+	return *p.ReferenceId
+}
+
+var AdjustStockReq_Operator_DEFAULT string
+
+func (p *AdjustStockReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return AdjustStockReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+
+var AdjustStockReq_WarehouseId_DEFAULT int64
+
+func (p *AdjustStockReq) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return AdjustStockReq_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
+func (p *AdjustStockReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *AdjustStockReq) SetDelta(val int32) {
+	p.Delta = val
+}
+func (p *AdjustStockReq) SetReason(val StockChangeReason) {
+	p.Reason = val
+}
+func (p *AdjustStockReq) SetReferenceId(val *string) {
+	p.ReferenceId = val
+}
+func (p *AdjustStockReq) SetOperator(val *string) {
+	p.Operator = val
+}
+func (p *AdjustStockReq) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+
+func (p *AdjustStockReq) IsSetReferenceId() bool {
+	return p.ReferenceId != nil
+}
+
+func (p *AdjustStockReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *AdjustStockReq) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
+
+func (p *AdjustStockReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdjustStockReq(%+v)", *p)
+}
+
+var fieldIDToName_AdjustStockReq = map[int16]string{
+	1: "productId",
+	2: "delta",
+	3: "reason",
+	4: "referenceId",
+	5: "operator",
+	6: "warehouseId",
+}
+
+type AdjustStockResp struct {
+	Success    bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Balance    int32   `thrift:"balance,4" frugal:"4,default,i32" json:"balance"`
+	MovementId int64   `thrift:"movementId,5" frugal:"5,default,i64" json:"movementId"`
+}
+
+func NewAdjustStockResp() *AdjustStockResp {
+	return &AdjustStockResp{
+		Code: 0,
+	}
+}
+
+func (p *AdjustStockResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *AdjustStockResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *AdjustStockResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var AdjustStockResp_Message_DEFAULT string
+
+func (p *AdjustStockResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return AdjustStockResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *AdjustStockResp) GetBalance() (v int32) {
+	return p.Balance
+}
+
+func (p *AdjustStockResp) GetMovementId() (v int64) {
+	return p.MovementId
+}
+func (p *AdjustStockResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *AdjustStockResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *AdjustStockResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *AdjustStockResp) SetBalance(val int32) {
+	p.Balance = val
+}
+func (p *AdjustStockResp) SetMovementId(val int64) {
+	p.MovementId = val
+}
+
+func (p *AdjustStockResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *AdjustStockResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdjustStockResp(%+v)", *p)
+}
+
+var fieldIDToName_AdjustStockResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "balance",
+	5: "movementId",
+}
+
+type ListStockMovementsReq struct {
+	ProductId   *int64             `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	Reason      *StockChangeReason `thrift:"reason,2,optional" frugal:"2,optional,StockChangeReason" json:"reason,omitempty"`
+	ReferenceId *string            `thrift:"referenceId,3,optional" frugal:"3,optional,string" json:"referenceId,omitempty"`
+	StartTime   *int64             `thrift:"startTime,4,optional" frugal:"4,optional,i64" json:"startTime,omitempty"`
+	EndTime     *int64             `thrift:"endTime,5,optional" frugal:"5,optional,i64" json:"endTime,omitempty"`
+	Page        int32              `thrift:"page,6" frugal:"6,default,i32" json:"page"`
+	PageSize    int32              `thrift:"pageSize,7" frugal:"7,default,i32" json:"pageSize"`
+}
+
+func NewListStockMovementsReq() *ListStockMovementsReq {
+	return &ListStockMovementsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListStockMovementsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListStockMovementsReq_ProductId_DEFAULT int64
+
+func (p *ListStockMovementsReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListStockMovementsReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListStockMovementsReq_Reason_DEFAULT StockChangeReason
+
+func (p *ListStockMovementsReq) GetReason() (v StockChangeReason) {
+	if !p.IsSetReason() {
+		return ListStockMovementsReq_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+
+var ListStockMovementsReq_ReferenceId_DEFAULT string
+
+func (p *ListStockMovementsReq) GetReferenceId() (v string) {
+	if !p.IsSetReferenceId() {
+		return ListStockMovementsReq_ReferenceId_DEFAULT
+	}
+	return *p.ReferenceId
+}
+
+var ListStockMovementsReq_StartTime_DEFAULT int64
+
+func (p *ListStockMovementsReq) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return ListStockMovementsReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ListStockMovementsReq_EndTime_DEFAULT int64
+
+func (p *ListStockMovementsReq) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return ListStockMovementsReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+func (p *ListStockMovementsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListStockMovementsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListStockMovementsReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListStockMovementsReq) SetReason(val *StockChangeReason) {
+	p.Reason = val
+}
+func (p *ListStockMovementsReq) SetReferenceId(val *string) {
+	p.ReferenceId = val
+}
+func (p *ListStockMovementsReq) SetStartTime(val *int64) {
+	p.StartTime = val
+}
+func (p *ListStockMovementsReq) SetEndTime(val *int64) {
+	p.EndTime = val
+}
+func (p *ListStockMovementsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListStockMovementsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListStockMovementsReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListStockMovementsReq) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *ListStockMovementsReq) IsSetReferenceId() bool {
+	return p.ReferenceId != nil
+}
+
+func (p *ListStockMovementsReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ListStockMovementsReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ListStockMovementsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListStockMovementsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListStockMovementsReq = map[int16]string{
+	1: "productId",
+	2: "reason",
+	3: "referenceId",
+	4: "startTime",
+	5: "endTime",
+	6: "page",
+	7: "pageSize",
+}
+
+type ListStockMovementsResp struct {
+	Success   bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total     int32            `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page      int32            `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32            `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Movements []*StockMovement `thrift:"movements,7" frugal:"7,default,list<StockMovement>" json:"movements"`
+}
+
+func NewListStockMovementsResp() *ListStockMovementsResp {
+	return &ListStockMovementsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListStockMovementsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListStockMovementsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListStockMovementsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListStockMovementsResp_Message_DEFAULT string
+
+func (p *ListStockMovementsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListStockMovementsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListStockMovementsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListStockMovementsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListStockMovementsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListStockMovementsResp) GetMovements() (v []*StockMovement) {
+	return p.Movements
+}
+func (p *ListStockMovementsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListStockMovementsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListStockMovementsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListStockMovementsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListStockMovementsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListStockMovementsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListStockMovementsResp) SetMovements(val []*StockMovement) {
+	p.Movements = val
+}
+
+func (p *ListStockMovementsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListStockMovementsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListStockMovementsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListStockMovementsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "movements",
+}
+
+type StockDiscrepancy struct {
+	ProductId   int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Stock       int32 `thrift:"stock,2" frugal:"2,default,i32" json:"stock"`
+	LedgerStock int32 `thrift:"ledgerStock,3" frugal:"3,default,i32" json:"ledgerStock"`
+	Difference  int32 `thrift:"difference,4" frugal:"4,default,i32" json:"difference"`
+}
+
+func NewStockDiscrepancy() *StockDiscrepancy {
+	return &StockDiscrepancy{}
+}
+
+func (p *StockDiscrepancy) InitDefault() {
+}
+
+func (p *StockDiscrepancy) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *StockDiscrepancy) GetStock() (v int32) {
+	return p.Stock
+}
+
+func (p *StockDiscrepancy) GetLedgerStock() (v int32) {
+	return p.LedgerStock
+}
+
+func (p *StockDiscrepancy) GetDifference() (v int32) {
+	return p.Difference
+}
+func (p *StockDiscrepancy) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *StockDiscrepancy) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *StockDiscrepancy) SetLedgerStock(val int32) {
+	p.LedgerStock = val
+}
+func (p *StockDiscrepancy) SetDifference(val int32) {
+	p.Difference = val
+}
+
+func (p *StockDiscrepancy) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StockDiscrepancy(%+v)", *p)
+}
+
+var fieldIDToName_StockDiscrepancy = map[int16]string{
+	1: "productId",
+	2: "stock",
+	3: "ledgerStock",
+	4: "difference",
+}
+
+type ReconcileStockReq struct {
+	ProductId *int64 `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+}
+
+func NewReconcileStockReq() *ReconcileStockReq {
+	return &ReconcileStockReq{}
+}
+
+func (p *ReconcileStockReq) InitDefault() {
+}
+
+var ReconcileStockReq_ProductId_DEFAULT int64
+
+func (p *ReconcileStockReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ReconcileStockReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+func (p *ReconcileStockReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+
+func (p *ReconcileStockReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ReconcileStockReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReconcileStockReq(%+v)", *p)
+}
+
+var fieldIDToName_ReconcileStockReq = map[int16]string{
+	1: "productId",
+}
+
+type ReconcileStockResp struct {
+	Success       bool                `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code          int32               `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message       *string             `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Checked       int32               `thrift:"checked,4" frugal:"4,default,i32" json:"checked"`
+	Discrepancies []*StockDiscrepancy `thrift:"discrepancies,5" frugal:"5,default,list<StockDiscrepancy>" json:"discrepancies"`
+}
+
+func NewReconcileStockResp() *ReconcileStockResp {
+	return &ReconcileStockResp{
+		Code: 0,
+	}
+}
+
+func (p *ReconcileStockResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ReconcileStockResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ReconcileStockResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ReconcileStockResp_Message_DEFAULT string
+
+func (p *ReconcileStockResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ReconcileStockResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ReconcileStockResp) GetChecked() (v int32) {
+	return p.Checked
+}
+
+func (p *ReconcileStockResp) GetDiscrepancies() (v []*StockDiscrepancy) {
+	return p.Discrepancies
+}
+func (p *ReconcileStockResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ReconcileStockResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ReconcileStockResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ReconcileStockResp) SetChecked(val int32) {
+	p.Checked = val
+}
+func (p *ReconcileStockResp) SetDiscrepancies(val []*StockDiscrepancy) {
+	p.Discrepancies = val
+}
+
+func (p *ReconcileStockResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ReconcileStockResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReconcileStockResp(%+v)", *p)
+}
+
+var fieldIDToName_ReconcileStockResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "checked",
+	5: "discrepancies",
+}
+
+type Warehouse struct {
+	Id        int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Code      string `thrift:"code,2" frugal:"2,default,string" json:"code"`
+	Name      string `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	Province  string `thrift:"province,4" frugal:"4,default,string" json:"province"`
+	City      string `thrift:"city,5" frugal:"5,default,string" json:"city"`
+	Address   string `thrift:"address,6" frugal:"6,default,string" json:"address"`
+	Priority  int32  `thrift:"priority,7" frugal:"7,default,i32" json:"priority"`
+	Active    bool   `thrift:"active,8" frugal:"8,default,bool" json:"active"`
+	CreatedAt int64  `thrift:"createdAt,9" frugal:"9,default,i64" json:"createdAt"`
+	UpdatedAt int64  `thrift:"updatedAt,10" frugal:"10,default,i64" json:"updatedAt"`
+}
+
+func NewWarehouse() *Warehouse {
+	return &Warehouse{}
+}
+
+func (p *Warehouse) InitDefault() {
+}
+
+func (p *Warehouse) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Warehouse) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *Warehouse) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Warehouse) GetProvince() (v string) {
+	return p.Province
+}
+
+func (p *Warehouse) GetCity() (v string) {
+	return p.City
+}
+
+func (p *Warehouse) GetAddress() (v string) {
+	return p.Address
+}
+
+func (p *Warehouse) GetPriority() (v int32) {
+	return p.Priority
+}
+
+func (p *Warehouse) GetActive() (v bool) {
+	return p.Active
+}
+
+func (p *Warehouse) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Warehouse) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Warehouse) SetId(val int64) {
+	p.Id = val
+}
+func (p *Warehouse) SetCode(val string) {
+	p.Code = val
+}
+func (p *Warehouse) SetName(val string) {
+	p.Name = val
+}
+func (p *Warehouse) SetProvince(val string) {
+	p.Province = val
+}
+func (p *Warehouse) SetCity(val string) {
+	p.City = val
+}
+func (p *Warehouse) SetAddress(val string) {
+	p.Address = val
+}
+func (p *Warehouse) SetPriority(val int32) {
+	p.Priority = val
+}
+func (p *Warehouse) SetActive(val bool) {
+	p.Active = val
+}
+func (p *Warehouse) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Warehouse) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Warehouse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Warehouse(%+v)", *p)
+}
+
+var fieldIDToName_Warehouse = map[int16]string{
+	1:  "id",
+	2:  "code",
+	3:  "name",
+	4:  "province",
+	5:  "city",
+	6:  "address",
+	7:  "priority",
+	8:  "active",
+	9:  "createdAt",
+	10: "updatedAt",
+}
+
+type WarehouseStock struct {
+	WarehouseId int64 `thrift:"warehouseId,1" frugal:"1,default,i64" json:"warehouseId"`
+	ProductId   int64 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	Stock       int32 `thrift:"stock,3" frugal:"3,default,i32" json:"stock"`
+}
+
+func NewWarehouseStock() *WarehouseStock {
+	return &WarehouseStock{}
+}
+
+func (p *WarehouseStock) InitDefault() {
+}
+
+func (p *WarehouseStock) GetWarehouseId() (v int64) {
+	return p.WarehouseId
+}
+
+func (p *WarehouseStock) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *WarehouseStock) GetStock() (v int32) {
+	return p.Stock
+}
+func (p *WarehouseStock) SetWarehouseId(val int64) {
+	p.WarehouseId = val
+}
+func (p *WarehouseStock) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *WarehouseStock) SetStock(val int32) {
+	p.Stock = val
+}
+
+func (p *WarehouseStock) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WarehouseStock(%+v)", *p)
+}
+
+var fieldIDToName_WarehouseStock = map[int16]string{
+	1: "warehouseId",
+	2: "productId",
+	3: "stock",
+}
+
+type CreateWarehouseReq struct {
+	Code     string  `thrift:"code,1" frugal:"1,default,string" json:"code"`
+	Name     string  `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Province string  `thrift:"province,3" frugal:"3,default,string" json:"province"`
+	City     *string `thrift:"city,4,optional" frugal:"4,optional,string" json:"city,omitempty"`
+	Address  *string `thrift:"address,5,optional" frugal:"5,optional,string" json:"address,omitempty"`
+	Priority int32   `thrift:"priority,6" frugal:"6,default,i32" json:"priority"`
+}
+
+func NewCreateWarehouseReq() *CreateWarehouseReq {
+	return &CreateWarehouseReq{
+		Priority: 0,
+	}
+}
+
+func (p *CreateWarehouseReq) InitDefault() {
+	p.Priority = 0
+}
+
+func (p *CreateWarehouseReq) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *CreateWarehouseReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateWarehouseReq) GetProvince() (v string) {
+	return p.Province
+}
+
+var CreateWarehouseReq_City_DEFAULT string
+
+func (p *CreateWarehouseReq) GetCity() (v string) {
+	if !p.IsSetCity() {
+		return CreateWarehouseReq_City_DEFAULT
+	}
+	return *p.City
+}
+
+var CreateWarehouseReq_Address_DEFAULT string
+
+func (p *CreateWarehouseReq) GetAddress() (v string) {
+	if !p.IsSetAddress() {
+		return CreateWarehouseReq_Address_DEFAULT
+	}
+	return *p.Address
+}
+
+func (p *CreateWarehouseReq) GetPriority() (v int32) {
+	return p.Priority
+}
+func (p *CreateWarehouseReq) SetCode(val string) {
+	p.Code = val
+}
+func (p *CreateWarehouseReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateWarehouseReq) SetProvince(val string) {
+	p.Province = val
+}
+func (p *CreateWarehouseReq) SetCity(val *string) {
+	p.City = val
+}
+func (p *CreateWarehouseReq) SetAddress(val *string) {
+	p.Address = val
+}
+func (p *CreateWarehouseReq) SetPriority(val int32) {
+	p.Priority = val
+}
+
+func (p *CreateWarehouseReq) IsSetCity() bool {
+	return p.City != nil
+}
+
+func (p *CreateWarehouseReq) IsSetAddress() bool {
+	return p.Address != nil
+}
+
+func (p *CreateWarehouseReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateWarehouseReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateWarehouseReq = map[int16]string{
+	1: "code",
+	2: "name",
+	3: "province",
+	4: "city",
+	5: "address",
+	6: "priority",
+}
+
+type CreateWarehouseResp struct {
+	Success   bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string    `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Warehouse *Warehouse `thrift:"warehouse,4" frugal:"4,default,Warehouse" json:"warehouse"`
+}
+
+func NewCreateWarehouseResp() *CreateWarehouseResp {
+	return &CreateWarehouseResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateWarehouseResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateWarehouseResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateWarehouseResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CreateWarehouseResp_Message_DEFAULT string
+
+func (p *CreateWarehouseResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CreateWarehouseResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var CreateWarehouseResp_Warehouse_DEFAULT *Warehouse
+
+func (p *CreateWarehouseResp) GetWarehouse() (v *Warehouse) {
+	if !p.IsSetWarehouse() {
+		return CreateWarehouseResp_Warehouse_DEFAULT
+	}
+	return p.Warehouse
+}
+func (p *CreateWarehouseResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateWarehouseResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateWarehouseResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *CreateWarehouseResp) SetWarehouse(val *Warehouse) {
+	p.Warehouse = val
+}
+
+func (p *CreateWarehouseResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CreateWarehouseResp) IsSetWarehouse() bool {
+	return p.Warehouse != nil
+}
+
+func (p *CreateWarehouseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateWarehouseResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateWarehouseResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "warehouse",
+}
+
+type UpdateWarehouseReq struct {
+	Id       int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name     *string `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Province *string `thrift:"province,3,optional" frugal:"3,optional,string" json:"province,omitempty"`
+	City     *string `thrift:"city,4,optional" frugal:"4,optional,string" json:"city,omitempty"`
+	Address  *string `thrift:"address,5,optional" frugal:"5,optional,string" json:"address,omitempty"`
+	Priority *int32  `thrift:"priority,6,optional" frugal:"6,optional,i32" json:"priority,omitempty"`
+	Active   *bool   `thrift:"active,7,optional" frugal:"7,optional,bool" json:"active,omitempty"`
+}
+
+func NewUpdateWarehouseReq() *UpdateWarehouseReq {
+	return &UpdateWarehouseReq{}
+}
+
+func (p *UpdateWarehouseReq) InitDefault() {
+}
+
+func (p *UpdateWarehouseReq) GetId() (v int64) {
+	return p.Id
+}
+
+var UpdateWarehouseReq_Name_DEFAULT string
+
+func (p *UpdateWarehouseReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateWarehouseReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateWarehouseReq_Province_DEFAULT string
+
+func (p *UpdateWarehouseReq) GetProvince() (v string) {
+	if !p.IsSetProvince() {
+		return UpdateWarehouseReq_Province_DEFAULT
+	}
+	return *p.Province
+}
+
+var UpdateWarehouseReq_City_DEFAULT string
+
+func (p *UpdateWarehouseReq) GetCity() (v string) {
+	if !p.IsSetCity() {
+		return UpdateWarehouseReq_City_DEFAULT
+	}
+	return *p.City
+}
+
+var UpdateWarehouseReq_Address_DEFAULT string
+
+func (p *UpdateWarehouseReq) GetAddress() (v string) {
+	if !p.IsSetAddress() {
+		return UpdateWarehouseReq_Address_DEFAULT
+	}
+	return *p.Address
+}
+
+var UpdateWarehouseReq_Priority_DEFAULT int32
+
+func (p *UpdateWarehouseReq) GetPriority() (v int32) {
+	if !p.IsSetPriority() {
+		return UpdateWarehouseReq_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+var UpdateWarehouseReq_Active_DEFAULT bool
+
+func (p *UpdateWarehouseReq) GetActive() (v bool) {
+	if !p.IsSetActive() {
+		return UpdateWarehouseReq_Active_DEFAULT
+	}
+	return *p.Active
+}
+func (p *UpdateWarehouseReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *UpdateWarehouseReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateWarehouseReq) SetProvince(val *string) {
+	p.Province = val
+}
+func (p *UpdateWarehouseReq) SetCity(val *string) {
+	p.City = val
+}
+func (p *UpdateWarehouseReq) SetAddress(val *string) {
+	p.Address = val
+}
+func (p *UpdateWarehouseReq) SetPriority(val *int32) {
+	p.Priority = val
+}
+func (p *UpdateWarehouseReq) SetActive(val *bool) {
+	p.Active = val
+}
+
+func (p *UpdateWarehouseReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateWarehouseReq) IsSetProvince() bool {
+	return p.Province != nil
+}
+
+func (p *UpdateWarehouseReq) IsSetCity() bool {
+	return p.City != nil
+}
+
+func (p *UpdateWarehouseReq) IsSetAddress() bool {
+	return p.Address != nil
+}
+
+func (p *UpdateWarehouseReq) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *UpdateWarehouseReq) IsSetActive() bool {
+	return p.Active != nil
+}
+
+func (p *UpdateWarehouseReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateWarehouseReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateWarehouseReq = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "province",
+	4: "city",
+	5: "address",
+	6: "priority",
+	7: "active",
+}
+
+type UpdateWarehouseResp struct {
+	Success   bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string    `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Warehouse *Warehouse `thrift:"warehouse,4" frugal:"4,default,Warehouse" json:"warehouse"`
+}
+
+func NewUpdateWarehouseResp() *UpdateWarehouseResp {
+	return &UpdateWarehouseResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateWarehouseResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateWarehouseResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateWarehouseResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateWarehouseResp_Message_DEFAULT string
+
+func (p *UpdateWarehouseResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateWarehouseResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var UpdateWarehouseResp_Warehouse_DEFAULT *Warehouse
+
+func (p *UpdateWarehouseResp) GetWarehouse() (v *Warehouse) {
+	if !p.IsSetWarehouse() {
+		return UpdateWarehouseResp_Warehouse_DEFAULT
+	}
+	return p.Warehouse
+}
+func (p *UpdateWarehouseResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateWarehouseResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateWarehouseResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UpdateWarehouseResp) SetWarehouse(val *Warehouse) {
+	p.Warehouse = val
+}
+
+func (p *UpdateWarehouseResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateWarehouseResp) IsSetWarehouse() bool {
+	return p.Warehouse != nil
+}
+
+func (p *UpdateWarehouseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateWarehouseResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateWarehouseResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "warehouse",
+}
+
+type ListWarehousesReq struct {
+	ActiveOnly bool `thrift:"activeOnly,1" frugal:"1,default,bool" json:"activeOnly"`
+}
+
+func NewListWarehousesReq() *ListWarehousesReq {
+	return &ListWarehousesReq{
+		ActiveOnly: false,
+	}
+}
+
+func (p *ListWarehousesReq) InitDefault() {
+	p.ActiveOnly = false
+}
+
+func (p *ListWarehousesReq) GetActiveOnly() (v bool) {
+	return p.ActiveOnly
+}
+func (p *ListWarehousesReq) SetActiveOnly(val bool) {
+	p.ActiveOnly = val
+}
+
+func (p *ListWarehousesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWarehousesReq(%+v)", *p)
+}
+
+var fieldIDToName_ListWarehousesReq = map[int16]string{
+	1: "activeOnly",
+}
+
+type ListWarehousesResp struct {
+	Success    bool         `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32        `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    *string      `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Warehouses []*Warehouse `thrift:"warehouses,4" frugal:"4,default,list<Warehouse>" json:"warehouses"`
+}
+
+func NewListWarehousesResp() *ListWarehousesResp {
+	return &ListWarehousesResp{
+		Code: 0,
+	}
+}
+
+func (p *ListWarehousesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListWarehousesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListWarehousesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListWarehousesResp_Message_DEFAULT string
+
+func (p *ListWarehousesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListWarehousesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListWarehousesResp) GetWarehouses() (v []*Warehouse) {
+	return p.Warehouses
+}
+func (p *ListWarehousesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListWarehousesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListWarehousesResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListWarehousesResp) SetWarehouses(val []*Warehouse) {
+	p.Warehouses = val
+}
+
+func (p *ListWarehousesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListWarehousesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWarehousesResp(%+v)", *p)
+}
+
+var fieldIDToName_ListWarehousesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "warehouses",
+}
+
+type GetWarehouseStocksReq struct {
+	ProductIds  []int64 `thrift:"productIds,1" frugal:"1,default,list<i64>" json:"productIds"`
+	WarehouseId *int64  `thrift:"warehouseId,2,optional" frugal:"2,optional,i64" json:"warehouseId,omitempty"`
+}
+
+func NewGetWarehouseStocksReq() *GetWarehouseStocksReq {
+	return &GetWarehouseStocksReq{}
+}
+
+func (p *GetWarehouseStocksReq) InitDefault() {
+}
+
+func (p *GetWarehouseStocksReq) GetProductIds() (v []int64) {
+	return p.ProductIds
+}
+
+var GetWarehouseStocksReq_WarehouseId_DEFAULT int64
+
+func (p *GetWarehouseStocksReq) GetWarehouseId() (v int64) {
+	if !p.IsSetWarehouseId() {
+		return GetWarehouseStocksReq_WarehouseId_DEFAULT
+	}
+	return *p.WarehouseId
+}
+func (p *GetWarehouseStocksReq) SetProductIds(val []int64) {
+	p.ProductIds = val
+}
+func (p *GetWarehouseStocksReq) SetWarehouseId(val *int64) {
+	p.WarehouseId = val
+}
+
+func (p *GetWarehouseStocksReq) IsSetWarehouseId() bool {
+	return p.WarehouseId != nil
+}
+
+func (p *GetWarehouseStocksReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetWarehouseStocksReq(%+v)", *p)
+}
+
+var fieldIDToName_GetWarehouseStocksReq = map[int16]string{
+	1: "productIds",
+	2: "warehouseId",
+}
+
+type GetWarehouseStocksResp struct {
+	Success bool              `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32             `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string           `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Stocks  []*WarehouseStock `thrift:"stocks,4" frugal:"4,default,list<WarehouseStock>" json:"stocks"`
+}
+
+func NewGetWarehouseStocksResp() *GetWarehouseStocksResp {
+	return &GetWarehouseStocksResp{
+		Code: 0,
+	}
+}
+
+func (p *GetWarehouseStocksResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetWarehouseStocksResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetWarehouseStocksResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetWarehouseStocksResp_Message_DEFAULT string
+
+func (p *GetWarehouseStocksResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetWarehouseStocksResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *GetWarehouseStocksResp) GetStocks() (v []*WarehouseStock) {
+	return p.Stocks
+}
+func (p *GetWarehouseStocksResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetWarehouseStocksResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetWarehouseStocksResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetWarehouseStocksResp) SetStocks(val []*WarehouseStock) {
+	p.Stocks = val
+}
+
+func (p *GetWarehouseStocksResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetWarehouseStocksResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetWarehouseStocksResp(%+v)", *p)
+}
+
+var fieldIDToName_GetWarehouseStocksResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "stocks",
+}
+
+type ListLowStockProductsReq struct {
+	Page     int32 `thrift:"page,1" frugal:"1,default,i32" json:"page"`
+	PageSize int32 `thrift:"pageSize,2" frugal:"2,default,i32" json:"pageSize"`
+}
+
+func NewListLowStockProductsReq() *ListLowStockProductsReq {
+	return &ListLowStockProductsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListLowStockProductsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+func (p *ListLowStockProductsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListLowStockProductsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListLowStockProductsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListLowStockProductsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListLowStockProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListLowStockProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListLowStockProductsReq = map[int16]string{
+	1: "page",
+	2: "pageSize",
+}
+
+type ListLowStockProductsResp struct {
+	Success  bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string    `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total    int32      `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32      `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32      `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Products []*Product `thrift:"products,7" frugal:"7,default,list<Product>" json:"products"`
+}
+
+func NewListLowStockProductsResp() *ListLowStockProductsResp {
+	return &ListLowStockProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListLowStockProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListLowStockProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListLowStockProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListLowStockProductsResp_Message_DEFAULT string
+
+func (p *ListLowStockProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListLowStockProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListLowStockProductsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListLowStockProductsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListLowStockProductsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListLowStockProductsResp) GetProducts() (v []*Product) {
+	return p.Products
+}
+func (p *ListLowStockProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListLowStockProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListLowStockProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListLowStockProductsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListLowStockProductsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListLowStockProductsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListLowStockProductsResp) SetProducts(val []*Product) {
+	p.Products = val
+}
+
+func (p *ListLowStockProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListLowStockProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListLowStockProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListLowStockProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "products",
+}
+
+type PriceSchedule struct {
+	Id            int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId     int64   `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	Price         float64 `thrift:"price,3" frugal:"3,default,double" json:"price"`
+	StartAt       int64   `thrift:"startAt,4" frugal:"4,default,i64" json:"startAt"`
+	EndAt         int64   `thrift:"endAt,5" frugal:"5,default,i64" json:"endAt"`
+	Status        string  `thrift:"status,6" frugal:"6,default,string" json:"status"`
+	OriginalPrice float64 `thrift:"originalPrice,7" frugal:"7,default,double" json:"originalPrice"`
+	Operator      string  `thrift:"operator,8" frugal:"8,default,string" json:"operator"`
+	CreatedAt     int64   `thrift:"createdAt,9" frugal:"9,default,i64" json:"createdAt"`
+	UpdatedAt     int64   `thrift:"updatedAt,10" frugal:"10,default,i64" json:"updatedAt"`
+}
+
+func NewPriceSchedule() *PriceSchedule {
+	return &PriceSchedule{}
+}
+
+func (p *PriceSchedule) InitDefault() {
+}
+
+func (p *PriceSchedule) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *PriceSchedule) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *PriceSchedule) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *PriceSchedule) GetStartAt() (v int64) {
+	return p.StartAt
+}
+
+func (p *PriceSchedule) GetEndAt() (v int64) {
+	return p.EndAt
+}
+
+func (p *PriceSchedule) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *PriceSchedule) GetOriginalPrice() (v float64) {
+	return p.OriginalPrice
+}
+
+func (p *PriceSchedule) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *PriceSchedule) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *PriceSchedule) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *PriceSchedule) SetId(val int64) {
+	p.Id = val
+}
+func (p *PriceSchedule) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *PriceSchedule) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *PriceSchedule) SetStartAt(val int64) {
+	p.StartAt = val
+}
+func (p *PriceSchedule) SetEndAt(val int64) {
+	p.EndAt = val
+}
+func (p *PriceSchedule) SetStatus(val string) {
+	p.Status = val
+}
+func (p *PriceSchedule) SetOriginalPrice(val float64) {
+	p.OriginalPrice = val
+}
+func (p *PriceSchedule) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *PriceSchedule) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *PriceSchedule) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *PriceSchedule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PriceSchedule(%+v)", *p)
+}
+
+var fieldIDToName_PriceSchedule = map[int16]string{
+	1:  "id",
+	2:  "productId",
+	3:  "price",
+	4:  "startAt",
+	5:  "endAt",
+	6:  "status",
+	7:  "originalPrice",
+	8:  "operator",
+	9:  "createdAt",
+	10: "updatedAt",
+}
+
+type PriceHistory struct {
+	Id         int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId  int64   `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	OldPrice   float64 `thrift:"oldPrice,3" frugal:"3,default,double" json:"oldPrice"`
+	NewPrice_  float64 `thrift:"newPrice,4" frugal:"4,default,double" json:"newPrice"`
+	Source     string  `thrift:"source,5" frugal:"5,default,string" json:"source"`
+	ScheduleId *int64  `thrift:"scheduleId,6,optional" frugal:"6,optional,i64" json:"scheduleId,omitempty"`
+	Operator   string  `thrift:"operator,7" frugal:"7,default,string" json:"operator"`
+	CreatedAt  int64   `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
+}
+
+func NewPriceHistory() *PriceHistory {
+	return &PriceHistory{}
+}
+
+func (p *PriceHistory) InitDefault() {
+}
+
+func (p *PriceHistory) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *PriceHistory) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *PriceHistory) GetOldPrice() (v float64) {
+	return p.OldPrice
+}
+
+func (p *PriceHistory) GetNewPrice_() (v float64) {
+	return p.NewPrice_
+}
+
+func (p *PriceHistory) GetSource() (v string) {
+	return p.Source
+}
+
+var PriceHistory_ScheduleId_DEFAULT int64
+
+func (p *PriceHistory) GetScheduleId() (v int64) {
+	if !p.IsSetScheduleId() {
+		return PriceHistory_ScheduleId_DEFAULT
+	}
+	return *p.ScheduleId
+}
+
+func (p *PriceHistory) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *PriceHistory) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *PriceHistory) SetId(val int64) {
+	p.Id = val
+}
+func (p *PriceHistory) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *PriceHistory) SetOldPrice(val float64) {
+	p.OldPrice = val
+}
+func (p *PriceHistory) SetNewPrice_(val float64) {
+	p.NewPrice_ = val
+}
+func (p *PriceHistory) SetSource(val string) {
+	p.Source = val
+}
+func (p *PriceHistory) SetScheduleId(val *int64) {
+	p.ScheduleId = val
+}
+func (p *PriceHistory) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *PriceHistory) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *PriceHistory) IsSetScheduleId() bool {
+	return p.ScheduleId != nil
+}
+
+func (p *PriceHistory) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PriceHistory(%+v)", *p)
+}
+
+var fieldIDToName_PriceHistory = map[int16]string{
+	1: "id",
+	2: "productId",
+	3: "oldPrice",
+	4: "newPrice",
+	5: "source",
+	6: "scheduleId",
+	7: "operator",
+	8: "createdAt",
+}
+
+type CreatePriceScheduleReq struct {
+	ProductId int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Price     float64 `thrift:"price,2" frugal:"2,default,double" json:"price"`
+	StartAt   int64   `thrift:"startAt,3" frugal:"3,default,i64" json:"startAt"`
+	EndAt     *int64  `thrift:"endAt,4,optional" frugal:"4,optional,i64" json:"endAt,omitempty"`
+	Operator  *string `thrift:"operator,5,optional" frugal:"5,optional,string" json:"operator,omitempty"`
+}
+
+func NewCreatePriceScheduleReq() *CreatePriceScheduleReq {
+	return &CreatePriceScheduleReq{}
+}
+
+func (p *CreatePriceScheduleReq) InitDefault() {
+}
+
+func (p *CreatePriceScheduleReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *CreatePriceScheduleReq) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *CreatePriceScheduleReq) GetStartAt() (v int64) {
+	return p.StartAt
+}
+
+var CreatePriceScheduleReq_EndAt_DEFAULT int64
+
+func (p *CreatePriceScheduleReq) GetEndAt() (v int64) {
+	if !p.IsSetEndAt() {
+		return CreatePriceScheduleReq_EndAt_DEFAULT
+	}
+	return *p.EndAt
+}
+
+var CreatePriceScheduleReq_Operator_DEFAULT string
+
+func (p *CreatePriceScheduleReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return CreatePriceScheduleReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *CreatePriceScheduleReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *CreatePriceScheduleReq) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *CreatePriceScheduleReq) SetStartAt(val int64) {
+	p.StartAt = val
+}
+func (p *CreatePriceScheduleReq) SetEndAt(val *int64) {
+	p.EndAt = val
+}
+func (p *CreatePriceScheduleReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *CreatePriceScheduleReq) IsSetEndAt() bool {
+	return p.EndAt != nil
+}
+
+func (p *CreatePriceScheduleReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *CreatePriceScheduleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreatePriceScheduleReq(%+v)", *p)
+}
+
+var fieldIDToName_CreatePriceScheduleReq = map[int16]string{
+	1: "productId",
+	2: "price",
+	3: "startAt",
+	4: "endAt",
+	5: "operator",
+}
+
+type CreatePriceScheduleResp struct {
+	Success  bool           `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32          `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string        `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Schedule *PriceSchedule `thrift:"schedule,4" frugal:"4,default,PriceSchedule" json:"schedule"`
+}
+
+func NewCreatePriceScheduleResp() *CreatePriceScheduleResp {
+	return &CreatePriceScheduleResp{
+		Code: 0,
+	}
+}
+
+func (p *CreatePriceScheduleResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreatePriceScheduleResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreatePriceScheduleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CreatePriceScheduleResp_Message_DEFAULT string
+
+func (p *CreatePriceScheduleResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CreatePriceScheduleResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var CreatePriceScheduleResp_Schedule_DEFAULT *PriceSchedule
+
+func (p *CreatePriceScheduleResp) GetSchedule() (v *PriceSchedule) {
+	if !p.IsSetSchedule() {
+		return CreatePriceScheduleResp_Schedule_DEFAULT
+	}
+	return p.Schedule
+}
+func (p *CreatePriceScheduleResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreatePriceScheduleResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreatePriceScheduleResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *CreatePriceScheduleResp) SetSchedule(val *PriceSchedule) {
+	p.Schedule = val
+}
+
+func (p *CreatePriceScheduleResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CreatePriceScheduleResp) IsSetSchedule() bool {
+	return p.Schedule != nil
+}
+
+func (p *CreatePriceScheduleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreatePriceScheduleResp(%+v)", *p)
+}
+
+var fieldIDToName_CreatePriceScheduleResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "schedule",
+}
+
+type ListPriceSchedulesReq struct {
+	ProductId *int64  `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	Status    *string `thrift:"status,2,optional" frugal:"2,optional,string" json:"status,omitempty"`
+	Page      int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize  int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewListPriceSchedulesReq() *ListPriceSchedulesReq {
+	return &ListPriceSchedulesReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListPriceSchedulesReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListPriceSchedulesReq_ProductId_DEFAULT int64
+
+func (p *ListPriceSchedulesReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListPriceSchedulesReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListPriceSchedulesReq_Status_DEFAULT string
+
+func (p *ListPriceSchedulesReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return ListPriceSchedulesReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+func (p *ListPriceSchedulesReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListPriceSchedulesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListPriceSchedulesReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListPriceSchedulesReq) SetStatus(val *string) {
+	p.Status = val
+}
+func (p *ListPriceSchedulesReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListPriceSchedulesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListPriceSchedulesReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListPriceSchedulesReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListPriceSchedulesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPriceSchedulesReq(%+v)", *p)
+}
+
+var fieldIDToName_ListPriceSchedulesReq = map[int16]string{
+	1: "productId",
+	2: "status",
+	3: "page",
+	4: "pageSize",
+}
+
+type ListPriceSchedulesResp struct {
+	Success   bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total     int32            `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page      int32            `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32            `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Schedules []*PriceSchedule `thrift:"schedules,7" frugal:"7,default,list<PriceSchedule>" json:"schedules"`
+}
+
+func NewListPriceSchedulesResp() *ListPriceSchedulesResp {
+	return &ListPriceSchedulesResp{
+		Code: 0,
+	}
+}
+
+func (p *ListPriceSchedulesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListPriceSchedulesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListPriceSchedulesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListPriceSchedulesResp_Message_DEFAULT string
+
+func (p *ListPriceSchedulesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListPriceSchedulesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListPriceSchedulesResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListPriceSchedulesResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListPriceSchedulesResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListPriceSchedulesResp) GetSchedules() (v []*PriceSchedule) {
+	return p.Schedules
+}
+func (p *ListPriceSchedulesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListPriceSchedulesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListPriceSchedulesResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListPriceSchedulesResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListPriceSchedulesResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListPriceSchedulesResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListPriceSchedulesResp) SetSchedules(val []*PriceSchedule) {
+	p.Schedules = val
+}
+
+func (p *ListPriceSchedulesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListPriceSchedulesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPriceSchedulesResp(%+v)", *p)
+}
+
+var fieldIDToName_ListPriceSchedulesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "schedules",
+}
+
+type CancelPriceScheduleReq struct {
+	Id       int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Operator *string `thrift:"operator,2,optional" frugal:"2,optional,string" json:"operator,omitempty"`
+}
+
+func NewCancelPriceScheduleReq() *CancelPriceScheduleReq {
+	return &CancelPriceScheduleReq{}
+}
+
+func (p *CancelPriceScheduleReq) InitDefault() {
+}
+
+func (p *CancelPriceScheduleReq) GetId() (v int64) {
+	return p.Id
+}
+
+var CancelPriceScheduleReq_Operator_DEFAULT string
+
+func (p *CancelPriceScheduleReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return CancelPriceScheduleReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *CancelPriceScheduleReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *CancelPriceScheduleReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *CancelPriceScheduleReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *CancelPriceScheduleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelPriceScheduleReq(%+v)", *p)
+}
+
+var fieldIDToName_CancelPriceScheduleReq = map[int16]string{
+	1: "id",
+	2: "operator",
+}
+
+type CancelPriceScheduleResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewCancelPriceScheduleResp() *CancelPriceScheduleResp {
+	return &CancelPriceScheduleResp{
+		Code: 0,
+	}
+}
+
+func (p *CancelPriceScheduleResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CancelPriceScheduleResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CancelPriceScheduleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CancelPriceScheduleResp_Message_DEFAULT string
+
+func (p *CancelPriceScheduleResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CancelPriceScheduleResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *CancelPriceScheduleResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CancelPriceScheduleResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CancelPriceScheduleResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *CancelPriceScheduleResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CancelPriceScheduleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelPriceScheduleResp(%+v)", *p)
+}
+
+var fieldIDToName_CancelPriceScheduleResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type GetPriceHistoryReq struct {
+	ProductId int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Page      int32 `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize  int32 `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+}
+
+func NewGetPriceHistoryReq() *GetPriceHistoryReq {
+	return &GetPriceHistoryReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *GetPriceHistoryReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+func (p *GetPriceHistoryReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *GetPriceHistoryReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetPriceHistoryReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *GetPriceHistoryReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *GetPriceHistoryReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetPriceHistoryReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *GetPriceHistoryReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPriceHistoryReq(%+v)", *p)
+}
+
+var fieldIDToName_GetPriceHistoryReq = map[int16]string{
+	1: "productId",
+	2: "page",
+	3: "pageSize",
+}
+
+type GetPriceHistoryResp struct {
+	Success  bool            `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32           `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string         `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total    int32           `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32           `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32           `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	History  []*PriceHistory `thrift:"history,7" frugal:"7,default,list<PriceHistory>" json:"history"`
+}
+
+func NewGetPriceHistoryResp() *GetPriceHistoryResp {
+	return &GetPriceHistoryResp{
+		Code: 0,
+	}
+}
+
+func (p *GetPriceHistoryResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetPriceHistoryResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetPriceHistoryResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetPriceHistoryResp_Message_DEFAULT string
+
+func (p *GetPriceHistoryResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetPriceHistoryResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *GetPriceHistoryResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *GetPriceHistoryResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetPriceHistoryResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *GetPriceHistoryResp) GetHistory() (v []*PriceHistory) {
+	return p.History
+}
+func (p *GetPriceHistoryResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetPriceHistoryResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetPriceHistoryResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetPriceHistoryResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *GetPriceHistoryResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetPriceHistoryResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *GetPriceHistoryResp) SetHistory(val []*PriceHistory) {
+	p.History = val
+}
+
+func (p *GetPriceHistoryResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetPriceHistoryResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPriceHistoryResp(%+v)", *p)
+}
+
+var fieldIDToName_GetPriceHistoryResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "history",
+}
+
+type ProductStatusSchedule struct {
+	Id           int64         `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId    int64         `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	TargetStatus ProductStatus `thrift:"targetStatus,3" frugal:"3,default,ProductStatus" json:"targetStatus"`
+	ExecuteAt    int64         `thrift:"executeAt,4" frugal:"4,default,i64" json:"executeAt"`
+	Status       string        `thrift:"status,5" frugal:"5,default,string" json:"status"`
+	Message      *string       `thrift:"message,6,optional" frugal:"6,optional,string" json:"message,omitempty"`
+	Operator     string        `thrift:"operator,7" frugal:"7,default,string" json:"operator"`
+	ExecutedAt   int64         `thrift:"executedAt,8" frugal:"8,default,i64" json:"executedAt"`
+	CreatedAt    int64         `thrift:"createdAt,9" frugal:"9,default,i64" json:"createdAt"`
+	UpdatedAt    int64         `thrift:"updatedAt,10" frugal:"10,default,i64" json:"updatedAt"`
+}
+
+func NewProductStatusSchedule() *ProductStatusSchedule {
+	return &ProductStatusSchedule{}
+}
+
+func (p *ProductStatusSchedule) InitDefault() {
+}
+
+func (p *ProductStatusSchedule) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ProductStatusSchedule) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductStatusSchedule) GetTargetStatus() (v ProductStatus) {
+	return p.TargetStatus
+}
+
+func (p *ProductStatusSchedule) GetExecuteAt() (v int64) {
+	return p.ExecuteAt
+}
+
+func (p *ProductStatusSchedule) GetStatus() (v string) {
+	return p.Status
+}
+
+var ProductStatusSchedule_Message_DEFAULT string
+
+func (p *ProductStatusSchedule) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ProductStatusSchedule_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ProductStatusSchedule) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *ProductStatusSchedule) GetExecutedAt() (v int64) {
+	return p.ExecutedAt
+}
+
+func (p *ProductStatusSchedule) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *ProductStatusSchedule) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *ProductStatusSchedule) SetId(val int64) {
+	p.Id = val
+}
+func (p *ProductStatusSchedule) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductStatusSchedule) SetTargetStatus(val ProductStatus) {
+	p.TargetStatus = val
+}
+func (p *ProductStatusSchedule) SetExecuteAt(val int64) {
+	p.ExecuteAt = val
+}
+func (p *ProductStatusSchedule) SetStatus(val string) {
+	p.Status = val
+}
+func (p *ProductStatusSchedule) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ProductStatusSchedule) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *ProductStatusSchedule) SetExecutedAt(val int64) {
+	p.ExecutedAt = val
+}
+func (p *ProductStatusSchedule) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *ProductStatusSchedule) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *ProductStatusSchedule) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ProductStatusSchedule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductStatusSchedule(%+v)", *p)
+}
+
+var fieldIDToName_ProductStatusSchedule = map[int16]string{
+	1:  "id",
+	2:  "productId",
+	3:  "targetStatus",
+	4:  "executeAt",
+	5:  "status",
+	6:  "message",
+	7:  "operator",
+	8:  "executedAt",
+	9:  "createdAt",
+	10: "updatedAt",
+}
+
+type ListProductStatusSchedulesReq struct {
+	ProductId *int64  `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	Status    *string `thrift:"status,2,optional" frugal:"2,optional,string" json:"status,omitempty"`
+	Page      int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize  int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewListProductStatusSchedulesReq() *ListProductStatusSchedulesReq {
+	return &ListProductStatusSchedulesReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListProductStatusSchedulesReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListProductStatusSchedulesReq_ProductId_DEFAULT int64
+
+func (p *ListProductStatusSchedulesReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListProductStatusSchedulesReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListProductStatusSchedulesReq_Status_DEFAULT string
+
+func (p *ListProductStatusSchedulesReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return ListProductStatusSchedulesReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+func (p *ListProductStatusSchedulesReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListProductStatusSchedulesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListProductStatusSchedulesReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListProductStatusSchedulesReq) SetStatus(val *string) {
+	p.Status = val
+}
+func (p *ListProductStatusSchedulesReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListProductStatusSchedulesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListProductStatusSchedulesReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListProductStatusSchedulesReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListProductStatusSchedulesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductStatusSchedulesReq(%+v)", *p)
+}
+
+var fieldIDToName_ListProductStatusSchedulesReq = map[int16]string{
+	1: "productId",
+	2: "status",
+	3: "page",
+	4: "pageSize",
+}
+
+type ListProductStatusSchedulesResp struct {
+	Success   bool                     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32                    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string                  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total     int32                    `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page      int32                    `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32                    `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Schedules []*ProductStatusSchedule `thrift:"schedules,7" frugal:"7,default,list<ProductStatusSchedule>" json:"schedules"`
+}
+
+func NewListProductStatusSchedulesResp() *ListProductStatusSchedulesResp {
+	return &ListProductStatusSchedulesResp{
+		Code: 0,
+	}
+}
+
+func (p *ListProductStatusSchedulesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListProductStatusSchedulesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListProductStatusSchedulesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListProductStatusSchedulesResp_Message_DEFAULT string
+
+func (p *ListProductStatusSchedulesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListProductStatusSchedulesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListProductStatusSchedulesResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListProductStatusSchedulesResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListProductStatusSchedulesResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListProductStatusSchedulesResp) GetSchedules() (v []*ProductStatusSchedule) {
+	return p.Schedules
+}
+func (p *ListProductStatusSchedulesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListProductStatusSchedulesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListProductStatusSchedulesResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListProductStatusSchedulesResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListProductStatusSchedulesResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListProductStatusSchedulesResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListProductStatusSchedulesResp) SetSchedules(val []*ProductStatusSchedule) {
+	p.Schedules = val
+}
+
+func (p *ListProductStatusSchedulesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListProductStatusSchedulesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductStatusSchedulesResp(%+v)", *p)
+}
+
+var fieldIDToName_ListProductStatusSchedulesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "schedules",
+}
+
+type CancelProductStatusScheduleReq struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewCancelProductStatusScheduleReq() *CancelProductStatusScheduleReq {
+	return &CancelProductStatusScheduleReq{}
+}
+
+func (p *CancelProductStatusScheduleReq) InitDefault() {
+}
+
+func (p *CancelProductStatusScheduleReq) GetId() (v int64) {
+	return p.Id
+}
+func (p *CancelProductStatusScheduleReq) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *CancelProductStatusScheduleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelProductStatusScheduleReq(%+v)", *p)
+}
+
+var fieldIDToName_CancelProductStatusScheduleReq = map[int16]string{
+	1: "id",
+}
+
+type CancelProductStatusScheduleResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewCancelProductStatusScheduleResp() *CancelProductStatusScheduleResp {
+	return &CancelProductStatusScheduleResp{
+		Code: 0,
+	}
+}
+
+func (p *CancelProductStatusScheduleResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CancelProductStatusScheduleResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CancelProductStatusScheduleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CancelProductStatusScheduleResp_Message_DEFAULT string
+
+func (p *CancelProductStatusScheduleResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CancelProductStatusScheduleResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *CancelProductStatusScheduleResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CancelProductStatusScheduleResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CancelProductStatusScheduleResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *CancelProductStatusScheduleResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CancelProductStatusScheduleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelProductStatusScheduleResp(%+v)", *p)
+}
+
+var fieldIDToName_CancelProductStatusScheduleResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ProductImportRow struct {
+	Line         int32          `thrift:"line,1" frugal:"1,default,i32" json:"line"`
+	ExternalCode string         `thrift:"externalCode,2" frugal:"2,default,string" json:"externalCode"`
+	Name         string         `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	Category     string         `thrift:"category,4" frugal:"4,default,string" json:"category"`
+	Price        float64        `thrift:"price,5" frugal:"5,default,double" json:"price"`
+	Stock        int32          `thrift:"stock,6" frugal:"6,default,i32" json:"stock"`
+	Brand        *string        `thrift:"brand,7,optional" frugal:"7,optional,string" json:"brand,omitempty"`
+	Status       *ProductStatus `thrift:"status,8,optional" frugal:"8,optional,ProductStatus" json:"status,omitempty"`
+	Avatar       *string        `thrift:"avatar,9,optional" frugal:"9,optional,string" json:"avatar,omitempty"`
+}
+
+func NewProductImportRow() *ProductImportRow {
+	return &ProductImportRow{}
+}
+
+func (p *ProductImportRow) InitDefault() {
+}
+
+func (p *ProductImportRow) GetLine() (v int32) {
+	return p.Line
+}
+
+func (p *ProductImportRow) GetExternalCode() (v string) {
+	return p.ExternalCode
+}
+
+func (p *ProductImportRow) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ProductImportRow) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *ProductImportRow) GetPrice() (v float64) {
+	return p.Price
+}
+
+func (p *ProductImportRow) GetStock() (v int32) {
+	return p.Stock
+}
+
+var ProductImportRow_Brand_DEFAULT string
+
+func (p *ProductImportRow) GetBrand() (v string) {
+	if !p.IsSetBrand() {
+		return ProductImportRow_Brand_DEFAULT
+	}
+	return *p.Brand
+}
+
+var ProductImportRow_Status_DEFAULT ProductStatus
+
+func (p *ProductImportRow) GetStatus() (v ProductStatus) {
+	if !p.IsSetStatus() {
+		return ProductImportRow_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ProductImportRow_Avatar_DEFAULT string
+
+func (p *ProductImportRow) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return ProductImportRow_Avatar_DEFAULT
+	}
+	return *p.Avatar
+}
+func (p *ProductImportRow) SetLine(val int32) {
+	p.Line = val
+}
+func (p *ProductImportRow) SetExternalCode(val string) {
+	p.ExternalCode = val
+}
+func (p *ProductImportRow) SetName(val string) {
+	p.Name = val
+}
+func (p *ProductImportRow) SetCategory(val string) {
+	p.Category = val
+}
+func (p *ProductImportRow) SetPrice(val float64) {
+	p.Price = val
+}
+func (p *ProductImportRow) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *ProductImportRow) SetBrand(val *string) {
+	p.Brand = val
+}
+func (p *ProductImportRow) SetStatus(val *ProductStatus) {
+	p.Status = val
+}
+func (p *ProductImportRow) SetAvatar(val *string) {
+	p.Avatar = val
+}
+
+func (p *ProductImportRow) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *ProductImportRow) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ProductImportRow) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *ProductImportRow) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductImportRow(%+v)", *p)
+}
+
+var fieldIDToName_ProductImportRow = map[int16]string{
+	1: "line",
+	2: "externalCode",
+	3: "name",
+	4: "category",
+	5: "price",
+	6: "stock",
+	7: "brand",
+	8: "status",
+	9: "avatar",
+}
+
+type ImportRowError struct {
+	Line    int32  `thrift:"line,1" frugal:"1,default,i32" json:"line"`
+	Field   string `thrift:"field,2" frugal:"2,default,string" json:"field"`
+	Message string `thrift:"message,3" frugal:"3,default,string" json:"message"`
+}
+
+func NewImportRowError() *ImportRowError {
+	return &ImportRowError{}
+}
+
+func (p *ImportRowError) InitDefault() {
+}
+
+func (p *ImportRowError) GetLine() (v int32) {
+	return p.Line
+}
+
+func (p *ImportRowError) GetField() (v string) {
+	return p.Field
+}
+
+func (p *ImportRowError) GetMessage() (v string) {
+	return p.Message
+}
+func (p *ImportRowError) SetLine(val int32) {
+	p.Line = val
+}
+func (p *ImportRowError) SetField(val string) {
+	p.Field = val
+}
+func (p *ImportRowError) SetMessage(val string) {
+	p.Message = val
+}
+
+func (p *ImportRowError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImportRowError(%+v)", *p)
+}
+
+var fieldIDToName_ImportRowError = map[int16]string{
+	1: "line",
+	2: "field",
+	3: "message",
+}
+
+type ImportProductsReq struct {
+	Rows     []*ProductImportRow `thrift:"rows,1" frugal:"1,default,list<ProductImportRow>" json:"rows"`
+	DryRun   bool                `thrift:"dryRun,2" frugal:"2,default,bool" json:"dryRun"`
+	Operator *string             `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewImportProductsReq() *ImportProductsReq {
+	return &ImportProductsReq{
+		DryRun: false,
+	}
+}
+
+func (p *ImportProductsReq) InitDefault() {
+	p.DryRun = false
+}
+
+func (p *ImportProductsReq) GetRows() (v []*ProductImportRow) {
+	return p.Rows
+}
+
+func (p *ImportProductsReq) GetDryRun() (v bool) {
+	return p.DryRun
+}
+
+var ImportProductsReq_Operator_DEFAULT string
+
+func (p *ImportProductsReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return ImportProductsReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *ImportProductsReq) SetRows(val []*ProductImportRow) {
+	p.Rows = val
+}
+func (p *ImportProductsReq) SetDryRun(val bool) {
+	p.DryRun = val
+}
+func (p *ImportProductsReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *ImportProductsReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *ImportProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImportProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_ImportProductsReq = map[int16]string{
+	1: "rows",
+	2: "dryRun",
+	3: "operator",
+}
+
+type ImportProductsResp struct {
+	Success bool              `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32             `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string           `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total   int32             `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Created int32             `thrift:"created,5" frugal:"5,default,i32" json:"created"`
+	Updated int32             `thrift:"updated,6" frugal:"6,default,i32" json:"updated"`
+	Failed  int32             `thrift:"failed,7" frugal:"7,default,i32" json:"failed"`
+	Errors  []*ImportRowError `thrift:"errors,8" frugal:"8,default,list<ImportRowError>" json:"errors"`
+	DryRun  bool              `thrift:"dryRun,9" frugal:"9,default,bool" json:"dryRun"`
+}
+
+func NewImportProductsResp() *ImportProductsResp {
+	return &ImportProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *ImportProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ImportProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ImportProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ImportProductsResp_Message_DEFAULT string
+
+func (p *ImportProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ImportProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ImportProductsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ImportProductsResp) GetCreated() (v int32) {
+	return p.Created
+}
+
+func (p *ImportProductsResp) GetUpdated() (v int32) {
+	return p.Updated
+}
+
+func (p *ImportProductsResp) GetFailed() (v int32) {
+	return p.Failed
+}
+
+func (p *ImportProductsResp) GetErrors() (v []*ImportRowError) {
+	return p.Errors
+}
+
+func (p *ImportProductsResp) GetDryRun() (v bool) {
+	return p.DryRun
+}
+func (p *ImportProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ImportProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ImportProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ImportProductsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ImportProductsResp) SetCreated(val int32) {
+	p.Created = val
+}
+func (p *ImportProductsResp) SetUpdated(val int32) {
+	p.Updated = val
+}
+func (p *ImportProductsResp) SetFailed(val int32) {
+	p.Failed = val
+}
+func (p *ImportProductsResp) SetErrors(val []*ImportRowError) {
+	p.Errors = val
+}
+func (p *ImportProductsResp) SetDryRun(val bool) {
+	p.DryRun = val
+}
+
+func (p *ImportProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ImportProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImportProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_ImportProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "created",
+	6: "updated",
+	7: "failed",
+	8: "errors",
+	9: "dryRun",
+}
+
+type AttributeDefinition struct {
 	Id        int64         `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	Name      string        `thrift:"name,2" frugal:"2,default,string" json:"name"`
-	Avatar    string        `thrift:"avatar,3" frugal:"3,default,string" json:"avatar"`
-	Category  string        `thrift:"category,4" frugal:"4,default,string" json:"category"`
-	Price     float64       `thrift:"price,5" frugal:"5,default,double" json:"price"`
-	Stock     int32         `thrift:"stock,6" frugal:"6,default,i32" json:"stock"`
-	Status    ProductStatus `thrift:"status,7" frugal:"7,default,ProductStatus" json:"status"`
-	CreatedAt int64         `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
-	UpdatedAt int64         `thrift:"updatedAt,9" frugal:"9,default,i64" json:"updatedAt"`
-	Brand     *string       `thrift:"brand,10,optional" frugal:"10,optional,string" json:"brand,omitempty"`
+	Category  string        `thrift:"category,2" frugal:"2,default,string" json:"category"`
+	Code      string        `thrift:"code,3" frugal:"3,default,string" json:"code"`
+	Name      string        `thrift:"name,4" frugal:"4,default,string" json:"name"`
+	Type      AttributeType `thrift:"type,5" frugal:"5,default,AttributeType" json:"type"`
+	Required  bool          `thrift:"required,6" frugal:"6,default,bool" json:"required"`
+	Unit      *string       `thrift:"unit,7,optional" frugal:"7,optional,string" json:"unit,omitempty"`
+	Options   []string      `thrift:"options,8" frugal:"8,default,list<string>" json:"options"`
+	Sort      int32         `thrift:"sort,9" frugal:"9,default,i32" json:"sort"`
+	CreatedAt int64         `thrift:"createdAt,10" frugal:"10,default,i64" json:"createdAt"`
+	UpdatedAt int64         `thrift:"updatedAt,11" frugal:"11,default,i64" json:"updatedAt"`
+}
+
+func NewAttributeDefinition() *AttributeDefinition {
+	return &AttributeDefinition{}
+}
+
+func (p *AttributeDefinition) InitDefault() {
+}
+
+func (p *AttributeDefinition) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *AttributeDefinition) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *AttributeDefinition) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *AttributeDefinition) GetName() (v string) {
+	return p.Name
+}
+
+func (p *AttributeDefinition) GetType() (v AttributeType) {
+	return p.Type
+}
+
+func (p *AttributeDefinition) GetRequired() (v bool) {
+	return p.Required
+}
+
+var AttributeDefinition_Unit_DEFAULT string
+
+func (p *AttributeDefinition) GetUnit() (v string) {
+	if !p.IsSetUnit() {
+		return AttributeDefinition_Unit_DEFAULT
+	}
+	return *p.Unit
+}
+
+func (p *AttributeDefinition) GetOptions() (v []string) {
+	return p.Options
+}
+
+func (p *AttributeDefinition) GetSort() (v int32) {
+	return p.Sort
+}
+
+func (p *AttributeDefinition) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *AttributeDefinition) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *AttributeDefinition) SetId(val int64) {
+	p.Id = val
+}
+func (p *AttributeDefinition) SetCategory(val string) {
+	p.Category = val
+}
+func (p *AttributeDefinition) SetCode(val string) {
+	p.Code = val
+}
+func (p *AttributeDefinition) SetName(val string) {
+	p.Name = val
+}
+func (p *AttributeDefinition) SetType(val AttributeType) {
+	p.Type = val
+}
+func (p *AttributeDefinition) SetRequired(val bool) {
+	p.Required = val
+}
+func (p *AttributeDefinition) SetUnit(val *string) {
+	p.Unit = val
+}
+func (p *AttributeDefinition) SetOptions(val []string) {
+	p.Options = val
+}
+func (p *AttributeDefinition) SetSort(val int32) {
+	p.Sort = val
+}
+func (p *AttributeDefinition) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *AttributeDefinition) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *AttributeDefinition) IsSetUnit() bool {
+	return p.Unit != nil
+}
+
+func (p *AttributeDefinition) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttributeDefinition(%+v)", *p)
+}
+
+var fieldIDToName_AttributeDefinition = map[int16]string{
+	1:  "id",
+	2:  "category",
+	3:  "code",
+	4:  "name",
+	5:  "type",
+	6:  "required",
+	7:  "unit",
+	8:  "options",
+	9:  "sort",
+	10: "createdAt",
+	11: "updatedAt",
+}
+
+type CreateAttributeDefinitionReq struct {
+	Category string        `thrift:"category,1" frugal:"1,default,string" json:"category"`
+	Code     string        `thrift:"code,2" frugal:"2,default,string" json:"code"`
+	Name     string        `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	Type     AttributeType `thrift:"type,4" frugal:"4,default,AttributeType" json:"type"`
+	Required bool          `thrift:"required,5" frugal:"5,default,bool" json:"required"`
+	Unit     *string       `thrift:"unit,6,optional" frugal:"6,optional,string" json:"unit,omitempty"`
+	Options  []string      `thrift:"options,7,optional" frugal:"7,optional,list<string>" json:"options,omitempty"`
+	Sort     int32         `thrift:"sort,8" frugal:"8,default,i32" json:"sort"`
+}
+
+func NewCreateAttributeDefinitionReq() *CreateAttributeDefinitionReq {
+	return &CreateAttributeDefinitionReq{
+		Required: false,
+		Sort:     0,
+	}
+}
+
+func (p *CreateAttributeDefinitionReq) InitDefault() {
+	p.Required = false
+	p.Sort = 0
+}
+
+func (p *CreateAttributeDefinitionReq) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *CreateAttributeDefinitionReq) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *CreateAttributeDefinitionReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateAttributeDefinitionReq) GetType() (v AttributeType) {
+	return p.Type
+}
+
+func (p *CreateAttributeDefinitionReq) GetRequired() (v bool) {
+	return p.Required
+}
+
+var CreateAttributeDefinitionReq_Unit_DEFAULT string
+
+func (p *CreateAttributeDefinitionReq) GetUnit() (v string) {
+	if !p.IsSetUnit() {
+		return CreateAttributeDefinitionReq_Unit_DEFAULT
+	}
+	return *p.Unit
+}
+
+var CreateAttributeDefinitionReq_Options_DEFAULT []string
+
+func (p *CreateAttributeDefinitionReq) GetOptions() (v []string) {
+	if !p.IsSetOptions() {
+		return CreateAttributeDefinitionReq_Options_DEFAULT
+	}
+	return p.Options
+}
+
+func (p *CreateAttributeDefinitionReq) GetSort() (v int32) {
+	return p.Sort
+}
+func (p *CreateAttributeDefinitionReq) SetCategory(val string) {
+	p.Category = val
+}
+func (p *CreateAttributeDefinitionReq) SetCode(val string) {
+	p.Code = val
+}
+func (p *CreateAttributeDefinitionReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateAttributeDefinitionReq) SetType(val AttributeType) {
+	p.Type = val
+}
+func (p *CreateAttributeDefinitionReq) SetRequired(val bool) {
+	p.Required = val
+}
+func (p *CreateAttributeDefinitionReq) SetUnit(val *string) {
+	p.Unit = val
+}
+func (p *CreateAttributeDefinitionReq) SetOptions(val []string) {
+	p.Options = val
+}
+func (p *CreateAttributeDefinitionReq) SetSort(val int32) {
+	p.Sort = val
+}
+
+func (p *CreateAttributeDefinitionReq) IsSetUnit() bool {
+	return p.Unit != nil
+}
+
+func (p *CreateAttributeDefinitionReq) IsSetOptions() bool {
+	return p.Options != nil
+}
+
+func (p *CreateAttributeDefinitionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateAttributeDefinitionReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateAttributeDefinitionReq = map[int16]string{
+	1: "category",
+	2: "code",
+	3: "name",
+	4: "type",
+	5: "required",
+	6: "unit",
+	7: "options",
+	8: "sort",
+}
+
+type CreateAttributeDefinitionResp struct {
+	Success    bool                 `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32                `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    *string              `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Definition *AttributeDefinition `thrift:"definition,4" frugal:"4,default,AttributeDefinition" json:"definition"`
+}
+
+func NewCreateAttributeDefinitionResp() *CreateAttributeDefinitionResp {
+	return &CreateAttributeDefinitionResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateAttributeDefinitionResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateAttributeDefinitionResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateAttributeDefinitionResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CreateAttributeDefinitionResp_Message_DEFAULT string
+
+func (p *CreateAttributeDefinitionResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CreateAttributeDefinitionResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var CreateAttributeDefinitionResp_Definition_DEFAULT *AttributeDefinition
+
+func (p *CreateAttributeDefinitionResp) GetDefinition() (v *AttributeDefinition) {
+	if !p.IsSetDefinition() {
+		return CreateAttributeDefinitionResp_Definition_DEFAULT
+	}
+	return p.Definition
+}
+func (p *CreateAttributeDefinitionResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateAttributeDefinitionResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateAttributeDefinitionResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *CreateAttributeDefinitionResp) SetDefinition(val *AttributeDefinition) {
+	p.Definition = val
+}
+
+func (p *CreateAttributeDefinitionResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CreateAttributeDefinitionResp) IsSetDefinition() bool {
+	return p.Definition != nil
+}
+
+func (p *CreateAttributeDefinitionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateAttributeDefinitionResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateAttributeDefinitionResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "definition",
+}
+
+type UpdateAttributeDefinitionReq struct {
+	Id       int64    `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name     *string  `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Required *bool    `thrift:"required,3,optional" frugal:"3,optional,bool" json:"required,omitempty"`
+	Unit     *string  `thrift:"unit,4,optional" frugal:"4,optional,string" json:"unit,omitempty"`
+	Options  []string `thrift:"options,5,optional" frugal:"5,optional,list<string>" json:"options,omitempty"`
+	Sort     *int32   `thrift:"sort,6,optional" frugal:"6,optional,i32" json:"sort,omitempty"`
+}
+
+func NewUpdateAttributeDefinitionReq() *UpdateAttributeDefinitionReq {
+	return &UpdateAttributeDefinitionReq{}
+}
+
+func (p *UpdateAttributeDefinitionReq) InitDefault() {
+}
+
+func (p *UpdateAttributeDefinitionReq) GetId() (v int64) {
+	return p.Id
+}
+
+var UpdateAttributeDefinitionReq_Name_DEFAULT string
+
+func (p *UpdateAttributeDefinitionReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateAttributeDefinitionReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateAttributeDefinitionReq_Required_DEFAULT bool
+
+func (p *UpdateAttributeDefinitionReq) GetRequired() (v bool) {
+	if !p.IsSetRequired() {
+		return UpdateAttributeDefinitionReq_Required_DEFAULT
+	}
+	return *p.Required
+}
+
+var UpdateAttributeDefinitionReq_Unit_DEFAULT string
+
+func (p *UpdateAttributeDefinitionReq) GetUnit() (v string) {
+	if !p.IsSetUnit() {
+		return UpdateAttributeDefinitionReq_Unit_DEFAULT
+	}
+	return *p.Unit
+}
+
+var UpdateAttributeDefinitionReq_Options_DEFAULT []string
+
+func (p *UpdateAttributeDefinitionReq) GetOptions() (v []string) {
+	if !p.IsSetOptions() {
+		return UpdateAttributeDefinitionReq_Options_DEFAULT
+	}
+	return p.Options
+}
+
+var UpdateAttributeDefinitionReq_Sort_DEFAULT int32
+
+func (p *UpdateAttributeDefinitionReq) GetSort() (v int32) {
+	if !p.IsSetSort() {
+		return UpdateAttributeDefinitionReq_Sort_DEFAULT
+	}
+	return *p.Sort
+}
+func (p *UpdateAttributeDefinitionReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *UpdateAttributeDefinitionReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateAttributeDefinitionReq) SetRequired(val *bool) {
+	p.Required = val
+}
+func (p *UpdateAttributeDefinitionReq) SetUnit(val *string) {
+	p.Unit = val
+}
+func (p *UpdateAttributeDefinitionReq) SetOptions(val []string) {
+	p.Options = val
+}
+func (p *UpdateAttributeDefinitionReq) SetSort(val *int32) {
+	p.Sort = val
+}
+
+func (p *UpdateAttributeDefinitionReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateAttributeDefinitionReq) IsSetRequired() bool {
+	return p.Required != nil
+}
+
+func (p *UpdateAttributeDefinitionReq) IsSetUnit() bool {
+	return p.Unit != nil
+}
+
+func (p *UpdateAttributeDefinitionReq) IsSetOptions() bool {
+	return p.Options != nil
+}
+
+func (p *UpdateAttributeDefinitionReq) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *UpdateAttributeDefinitionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAttributeDefinitionReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateAttributeDefinitionReq = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "required",
+	4: "unit",
+	5: "options",
+	6: "sort",
+}
+
+type UpdateAttributeDefinitionResp struct {
+	Success    bool                 `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code       int32                `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message    *string              `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Definition *AttributeDefinition `thrift:"definition,4" frugal:"4,default,AttributeDefinition" json:"definition"`
+}
+
+func NewUpdateAttributeDefinitionResp() *UpdateAttributeDefinitionResp {
+	return &UpdateAttributeDefinitionResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateAttributeDefinitionResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateAttributeDefinitionResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateAttributeDefinitionResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateAttributeDefinitionResp_Message_DEFAULT string
+
+func (p *UpdateAttributeDefinitionResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateAttributeDefinitionResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var UpdateAttributeDefinitionResp_Definition_DEFAULT *AttributeDefinition
+
+func (p *UpdateAttributeDefinitionResp) GetDefinition() (v *AttributeDefinition) {
+	if !p.IsSetDefinition() {
+		return UpdateAttributeDefinitionResp_Definition_DEFAULT
+	}
+	return p.Definition
+}
+func (p *UpdateAttributeDefinitionResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateAttributeDefinitionResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateAttributeDefinitionResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UpdateAttributeDefinitionResp) SetDefinition(val *AttributeDefinition) {
+	p.Definition = val
+}
+
+func (p *UpdateAttributeDefinitionResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateAttributeDefinitionResp) IsSetDefinition() bool {
+	return p.Definition != nil
+}
+
+func (p *UpdateAttributeDefinitionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAttributeDefinitionResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateAttributeDefinitionResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "definition",
+}
+
+type ListAttributeDefinitionsReq struct {
+	Category *string `thrift:"category,1,optional" frugal:"1,optional,string" json:"category,omitempty"`
+}
+
+func NewListAttributeDefinitionsReq() *ListAttributeDefinitionsReq {
+	return &ListAttributeDefinitionsReq{}
+}
+
+func (p *ListAttributeDefinitionsReq) InitDefault() {
+}
+
+var ListAttributeDefinitionsReq_Category_DEFAULT string
+
+func (p *ListAttributeDefinitionsReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return ListAttributeDefinitionsReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+func (p *ListAttributeDefinitionsReq) SetCategory(val *string) {
+	p.Category = val
+}
+
+func (p *ListAttributeDefinitionsReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *ListAttributeDefinitionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAttributeDefinitionsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListAttributeDefinitionsReq = map[int16]string{
+	1: "category",
+}
+
+type ListAttributeDefinitionsResp struct {
+	Success     bool                   `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32                  `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     *string                `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Definitions []*AttributeDefinition `thrift:"definitions,4" frugal:"4,default,list<AttributeDefinition>" json:"definitions"`
+}
+
+func NewListAttributeDefinitionsResp() *ListAttributeDefinitionsResp {
+	return &ListAttributeDefinitionsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListAttributeDefinitionsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListAttributeDefinitionsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListAttributeDefinitionsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListAttributeDefinitionsResp_Message_DEFAULT string
+
+func (p *ListAttributeDefinitionsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListAttributeDefinitionsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListAttributeDefinitionsResp) GetDefinitions() (v []*AttributeDefinition) {
+	return p.Definitions
+}
+func (p *ListAttributeDefinitionsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListAttributeDefinitionsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListAttributeDefinitionsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListAttributeDefinitionsResp) SetDefinitions(val []*AttributeDefinition) {
+	p.Definitions = val
+}
+
+func (p *ListAttributeDefinitionsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListAttributeDefinitionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAttributeDefinitionsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListAttributeDefinitionsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "definitions",
+}
+
+type DeleteAttributeDefinitionReq struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewDeleteAttributeDefinitionReq() *DeleteAttributeDefinitionReq {
+	return &DeleteAttributeDefinitionReq{}
+}
+
+func (p *DeleteAttributeDefinitionReq) InitDefault() {
+}
+
+func (p *DeleteAttributeDefinitionReq) GetId() (v int64) {
+	return p.Id
+}
+func (p *DeleteAttributeDefinitionReq) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *DeleteAttributeDefinitionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAttributeDefinitionReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteAttributeDefinitionReq = map[int16]string{
+	1: "id",
+}
+
+type DeleteAttributeDefinitionResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewDeleteAttributeDefinitionResp() *DeleteAttributeDefinitionResp {
+	return &DeleteAttributeDefinitionResp{
+		Code: 0,
+	}
+}
+
+func (p *DeleteAttributeDefinitionResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *DeleteAttributeDefinitionResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DeleteAttributeDefinitionResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var DeleteAttributeDefinitionResp_Message_DEFAULT string
+
+func (p *DeleteAttributeDefinitionResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return DeleteAttributeDefinitionResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *DeleteAttributeDefinitionResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DeleteAttributeDefinitionResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *DeleteAttributeDefinitionResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *DeleteAttributeDefinitionResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *DeleteAttributeDefinitionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAttributeDefinitionResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteAttributeDefinitionResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type UpdateProductRatingReq struct {
+	ProductId   int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	RatingAvg   float64 `thrift:"ratingAvg,2" frugal:"2,default,double" json:"ratingAvg"`
+	RatingCount int32   `thrift:"ratingCount,3" frugal:"3,default,i32" json:"ratingCount"`
+}
+
+func NewUpdateProductRatingReq() *UpdateProductRatingReq {
+	return &UpdateProductRatingReq{}
+}
+
+func (p *UpdateProductRatingReq) InitDefault() {
+}
+
+func (p *UpdateProductRatingReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *UpdateProductRatingReq) GetRatingAvg() (v float64) {
+	return p.RatingAvg
+}
+
+func (p *UpdateProductRatingReq) GetRatingCount() (v int32) {
+	return p.RatingCount
+}
+func (p *UpdateProductRatingReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *UpdateProductRatingReq) SetRatingAvg(val float64) {
+	p.RatingAvg = val
+}
+func (p *UpdateProductRatingReq) SetRatingCount(val int32) {
+	p.RatingCount = val
+}
+
+func (p *UpdateProductRatingReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProductRatingReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateProductRatingReq = map[int16]string{
+	1: "productId",
+	2: "ratingAvg",
+	3: "ratingCount",
+}
+
+type UpdateProductRatingResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewUpdateProductRatingResp() *UpdateProductRatingResp {
+	return &UpdateProductRatingResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateProductRatingResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateProductRatingResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateProductRatingResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateProductRatingResp_Message_DEFAULT string
+
+func (p *UpdateProductRatingResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateProductRatingResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *UpdateProductRatingResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateProductRatingResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateProductRatingResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *UpdateProductRatingResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateProductRatingResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProductRatingResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateProductRatingResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ProductAuditChange struct {
+	Field  string `thrift:"field,1" frugal:"1,default,string" json:"field"`
+	Before string `thrift:"before,2" frugal:"2,default,string" json:"before"`
+	After  string `thrift:"after,3" frugal:"3,default,string" json:"after"`
+}
+
+func NewProductAuditChange() *ProductAuditChange {
+	return &ProductAuditChange{}
+}
+
+func (p *ProductAuditChange) InitDefault() {
+}
+
+func (p *ProductAuditChange) GetField() (v string) {
+	return p.Field
+}
+
+func (p *ProductAuditChange) GetBefore() (v string) {
+	return p.Before
+}
+
+func (p *ProductAuditChange) GetAfter() (v string) {
+	return p.After
+}
+func (p *ProductAuditChange) SetField(val string) {
+	p.Field = val
+}
+func (p *ProductAuditChange) SetBefore(val string) {
+	p.Before = val
+}
+func (p *ProductAuditChange) SetAfter(val string) {
+	p.After = val
+}
+
+func (p *ProductAuditChange) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductAuditChange(%+v)", *p)
+}
+
+var fieldIDToName_ProductAuditChange = map[int16]string{
+	1: "field",
+	2: "before",
+	3: "after",
+}
+
+type ProductAudit struct {
+	Id        int64                 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId int64                 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	Action    string                `thrift:"action,3" frugal:"3,default,string" json:"action"`
+	Operator  string                `thrift:"operator,4" frugal:"4,default,string" json:"operator"`
+	Source    string                `thrift:"source,5" frugal:"5,default,string" json:"source"`
+	Changes   []*ProductAuditChange `thrift:"changes,6" frugal:"6,default,list<ProductAuditChange>" json:"changes"`
+	Remark    *string               `thrift:"remark,7,optional" frugal:"7,optional,string" json:"remark,omitempty"`
+	CreatedAt int64                 `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
+}
+
+func NewProductAudit() *ProductAudit {
+	return &ProductAudit{}
+}
+
+func (p *ProductAudit) InitDefault() {
+}
+
+func (p *ProductAudit) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ProductAudit) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductAudit) GetAction() (v string) {
+	return p.Action
+}
+
+func (p *ProductAudit) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *ProductAudit) GetSource() (v string) {
+	return p.Source
+}
+
+func (p *ProductAudit) GetChanges() (v []*ProductAuditChange) {
+	return p.Changes
+}
+
+var ProductAudit_Remark_DEFAULT string
+
+func (p *ProductAudit) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return ProductAudit_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+func (p *ProductAudit) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *ProductAudit) SetId(val int64) {
+	p.Id = val
+}
+func (p *ProductAudit) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductAudit) SetAction(val string) {
+	p.Action = val
+}
+func (p *ProductAudit) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *ProductAudit) SetSource(val string) {
+	p.Source = val
+}
+func (p *ProductAudit) SetChanges(val []*ProductAuditChange) {
+	p.Changes = val
+}
+func (p *ProductAudit) SetRemark(val *string) {
+	p.Remark = val
+}
+func (p *ProductAudit) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *ProductAudit) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *ProductAudit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductAudit(%+v)", *p)
+}
+
+var fieldIDToName_ProductAudit = map[int16]string{
+	1: "id",
+	2: "productId",
+	3: "action",
+	4: "operator",
+	5: "source",
+	6: "changes",
+	7: "remark",
+	8: "createdAt",
+}
+
+type ListProductAuditReq struct {
+	ProductId *int64  `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	Operator  *string `thrift:"operator,2,optional" frugal:"2,optional,string" json:"operator,omitempty"`
+	StartTime *int64  `thrift:"startTime,3,optional" frugal:"3,optional,i64" json:"startTime,omitempty"`
+	EndTime   *int64  `thrift:"endTime,4,optional" frugal:"4,optional,i64" json:"endTime,omitempty"`
+	Page      int32   `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32   `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+}
+
+func NewListProductAuditReq() *ListProductAuditReq {
+	return &ListProductAuditReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListProductAuditReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListProductAuditReq_ProductId_DEFAULT int64
+
+func (p *ListProductAuditReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListProductAuditReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListProductAuditReq_Operator_DEFAULT string
+
+func (p *ListProductAuditReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return ListProductAuditReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+
+var ListProductAuditReq_StartTime_DEFAULT int64
+
+func (p *ListProductAuditReq) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return ListProductAuditReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ListProductAuditReq_EndTime_DEFAULT int64
+
+func (p *ListProductAuditReq) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return ListProductAuditReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+func (p *ListProductAuditReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListProductAuditReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListProductAuditReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListProductAuditReq) SetOperator(val *string) {
+	p.Operator = val
+}
+func (p *ListProductAuditReq) SetStartTime(val *int64) {
+	p.StartTime = val
+}
+func (p *ListProductAuditReq) SetEndTime(val *int64) {
+	p.EndTime = val
+}
+func (p *ListProductAuditReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListProductAuditReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListProductAuditReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListProductAuditReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *ListProductAuditReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ListProductAuditReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ListProductAuditReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductAuditReq(%+v)", *p)
+}
+
+var fieldIDToName_ListProductAuditReq = map[int16]string{
+	1: "productId",
+	2: "operator",
+	3: "startTime",
+	4: "endTime",
+	5: "page",
+	6: "pageSize",
+}
+
+type ListProductAuditResp struct {
+	Success  bool            `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32           `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string         `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total    int32           `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32           `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32           `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Audits   []*ProductAudit `thrift:"audits,7" frugal:"7,default,list<ProductAudit>" json:"audits"`
+}
+
+func NewListProductAuditResp() *ListProductAuditResp {
+	return &ListProductAuditResp{
+		Code: 0,
+	}
+}
+
+func (p *ListProductAuditResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListProductAuditResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListProductAuditResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListProductAuditResp_Message_DEFAULT string
+
+func (p *ListProductAuditResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListProductAuditResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListProductAuditResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListProductAuditResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListProductAuditResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListProductAuditResp) GetAudits() (v []*ProductAudit) {
+	return p.Audits
+}
+func (p *ListProductAuditResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListProductAuditResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListProductAuditResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListProductAuditResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListProductAuditResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListProductAuditResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListProductAuditResp) SetAudits(val []*ProductAudit) {
+	p.Audits = val
+}
+
+func (p *ListProductAuditResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListProductAuditResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductAuditResp(%+v)", *p)
+}
+
+var fieldIDToName_ListProductAuditResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "audits",
+}
+
+type PurchasePair struct {
+	ProductId        int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	RelatedProductId int64 `thrift:"relatedProductId,2" frugal:"2,default,i64" json:"relatedProductId"`
+	OrderCount       int32 `thrift:"orderCount,3" frugal:"3,default,i32" json:"orderCount"`
+}
+
+func NewPurchasePair() *PurchasePair {
+	return &PurchasePair{}
+}
+
+func (p *PurchasePair) InitDefault() {
+}
+
+func (p *PurchasePair) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *PurchasePair) GetRelatedProductId() (v int64) {
+	return p.RelatedProductId
+}
+
+func (p *PurchasePair) GetOrderCount() (v int32) {
+	return p.OrderCount
+}
+func (p *PurchasePair) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *PurchasePair) SetRelatedProductId(val int64) {
+	p.RelatedProductId = val
+}
+func (p *PurchasePair) SetOrderCount(val int32) {
+	p.OrderCount = val
+}
+
+func (p *PurchasePair) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PurchasePair(%+v)", *p)
+}
+
+var fieldIDToName_PurchasePair = map[int16]string{
+	1: "productId",
+	2: "relatedProductId",
+	3: "orderCount",
+}
+
+type ProductSales struct {
+	ProductId  int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	OrderCount int32 `thrift:"orderCount,2" frugal:"2,default,i32" json:"orderCount"`
+	Quantity   int32 `thrift:"quantity,3" frugal:"3,default,i32" json:"quantity"`
+}
+
+func NewProductSales() *ProductSales {
+	return &ProductSales{}
+}
+
+func (p *ProductSales) InitDefault() {
+}
+
+func (p *ProductSales) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductSales) GetOrderCount() (v int32) {
+	return p.OrderCount
+}
+
+func (p *ProductSales) GetQuantity() (v int32) {
+	return p.Quantity
+}
+func (p *ProductSales) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductSales) SetOrderCount(val int32) {
+	p.OrderCount = val
+}
+func (p *ProductSales) SetQuantity(val int32) {
+	p.Quantity = val
+}
+
+func (p *ProductSales) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductSales(%+v)", *p)
+}
+
+var fieldIDToName_ProductSales = map[int16]string{
+	1: "productId",
+	2: "orderCount",
+	3: "quantity",
+}
+
+type SyncPurchaseStatsReq struct {
+	Generation int64           `thrift:"generation,1" frugal:"1,default,i64" json:"generation"`
+	Pairs      []*PurchasePair `thrift:"pairs,2" frugal:"2,default,list<PurchasePair>" json:"pairs"`
+	Sales      []*ProductSales `thrift:"sales,3" frugal:"3,default,list<ProductSales>" json:"sales"`
+	Final      bool            `thrift:"final,4" frugal:"4,default,bool" json:"final"`
+}
+
+func NewSyncPurchaseStatsReq() *SyncPurchaseStatsReq {
+	return &SyncPurchaseStatsReq{}
+}
+
+func (p *SyncPurchaseStatsReq) InitDefault() {
+}
+
+func (p *SyncPurchaseStatsReq) GetGeneration() (v int64) {
+	return p.Generation
+}
+
+func (p *SyncPurchaseStatsReq) GetPairs() (v []*PurchasePair) {
+	return p.Pairs
+}
+
+func (p *SyncPurchaseStatsReq) GetSales() (v []*ProductSales) {
+	return p.Sales
+}
+
+func (p *SyncPurchaseStatsReq) GetFinal() (v bool) {
+	return p.Final
+}
+func (p *SyncPurchaseStatsReq) SetGeneration(val int64) {
+	p.Generation = val
+}
+func (p *SyncPurchaseStatsReq) SetPairs(val []*PurchasePair) {
+	p.Pairs = val
+}
+func (p *SyncPurchaseStatsReq) SetSales(val []*ProductSales) {
+	p.Sales = val
+}
+func (p *SyncPurchaseStatsReq) SetFinal(val bool) {
+	p.Final = val
+}
+
+func (p *SyncPurchaseStatsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SyncPurchaseStatsReq(%+v)", *p)
+}
+
+var fieldIDToName_SyncPurchaseStatsReq = map[int16]string{
+	1: "generation",
+	2: "pairs",
+	3: "sales",
+	4: "final",
+}
+
+type SyncPurchaseStatsResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewSyncPurchaseStatsResp() *SyncPurchaseStatsResp {
+	return &SyncPurchaseStatsResp{
+		Code: 0,
+	}
+}
+
+func (p *SyncPurchaseStatsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SyncPurchaseStatsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SyncPurchaseStatsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SyncPurchaseStatsResp_Message_DEFAULT string
+
+func (p *SyncPurchaseStatsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SyncPurchaseStatsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *SyncPurchaseStatsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SyncPurchaseStatsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SyncPurchaseStatsResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *SyncPurchaseStatsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SyncPurchaseStatsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SyncPurchaseStatsResp(%+v)", *p)
+}
+
+var fieldIDToName_SyncPurchaseStatsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type GetRelatedProductsReq struct {
+	ProductId int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Limit     int32   `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+	Locale    *string `thrift:"locale,3,optional" frugal:"3,optional,string" json:"locale,omitempty"`
+}
+
+func NewGetRelatedProductsReq() *GetRelatedProductsReq {
+	return &GetRelatedProductsReq{
+		Limit: 10,
+	}
+}
+
+func (p *GetRelatedProductsReq) InitDefault() {
+	p.Limit = 10
+}
+
+func (p *GetRelatedProductsReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *GetRelatedProductsReq) GetLimit() (v int32) {
+	return p.Limit
+}
+
+var GetRelatedProductsReq_Locale_DEFAULT string
+
+func (p *GetRelatedProductsReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return GetRelatedProductsReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+func (p *GetRelatedProductsReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *GetRelatedProductsReq) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *GetRelatedProductsReq) SetLocale(val *string) {
+	p.Locale = val
+}
+
+func (p *GetRelatedProductsReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *GetRelatedProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRelatedProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_GetRelatedProductsReq = map[int16]string{
+	1: "productId",
+	2: "limit",
+	3: "locale",
+}
+
+type GetRelatedProductsResp struct {
+	Success  bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Products []*SimpleProduct `thrift:"products,4" frugal:"4,default,list<SimpleProduct>" json:"products"`
+}
+
+func NewGetRelatedProductsResp() *GetRelatedProductsResp {
+	return &GetRelatedProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *GetRelatedProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetRelatedProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetRelatedProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetRelatedProductsResp_Message_DEFAULT string
+
+func (p *GetRelatedProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetRelatedProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *GetRelatedProductsResp) GetProducts() (v []*SimpleProduct) {
+	return p.Products
+}
+func (p *GetRelatedProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetRelatedProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetRelatedProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetRelatedProductsResp) SetProducts(val []*SimpleProduct) {
+	p.Products = val
+}
+
+func (p *GetRelatedProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetRelatedProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRelatedProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_GetRelatedProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "products",
+}
+
+type GetFrequentlyBoughtTogetherReq struct {
+	ProductId int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Limit     int32   `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+	Locale    *string `thrift:"locale,3,optional" frugal:"3,optional,string" json:"locale,omitempty"`
+}
+
+func NewGetFrequentlyBoughtTogetherReq() *GetFrequentlyBoughtTogetherReq {
+	return &GetFrequentlyBoughtTogetherReq{
+		Limit: 5,
+	}
+}
+
+func (p *GetFrequentlyBoughtTogetherReq) InitDefault() {
+	p.Limit = 5
+}
+
+func (p *GetFrequentlyBoughtTogetherReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *GetFrequentlyBoughtTogetherReq) GetLimit() (v int32) {
+	return p.Limit
+}
+
+var GetFrequentlyBoughtTogetherReq_Locale_DEFAULT string
+
+func (p *GetFrequentlyBoughtTogetherReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return GetFrequentlyBoughtTogetherReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+func (p *GetFrequentlyBoughtTogetherReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *GetFrequentlyBoughtTogetherReq) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *GetFrequentlyBoughtTogetherReq) SetLocale(val *string) {
+	p.Locale = val
+}
+
+func (p *GetFrequentlyBoughtTogetherReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *GetFrequentlyBoughtTogetherReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFrequentlyBoughtTogetherReq(%+v)", *p)
+}
+
+var fieldIDToName_GetFrequentlyBoughtTogetherReq = map[int16]string{
+	1: "productId",
+	2: "limit",
+	3: "locale",
+}
+
+type GetFrequentlyBoughtTogetherResp struct {
+	Success  bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Products []*SimpleProduct `thrift:"products,4" frugal:"4,default,list<SimpleProduct>" json:"products"`
+}
+
+func NewGetFrequentlyBoughtTogetherResp() *GetFrequentlyBoughtTogetherResp {
+	return &GetFrequentlyBoughtTogetherResp{
+		Code: 0,
+	}
+}
+
+func (p *GetFrequentlyBoughtTogetherResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetFrequentlyBoughtTogetherResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetFrequentlyBoughtTogetherResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetFrequentlyBoughtTogetherResp_Message_DEFAULT string
+
+func (p *GetFrequentlyBoughtTogetherResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetFrequentlyBoughtTogetherResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *GetFrequentlyBoughtTogetherResp) GetProducts() (v []*SimpleProduct) {
+	return p.Products
+}
+func (p *GetFrequentlyBoughtTogetherResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetFrequentlyBoughtTogetherResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetFrequentlyBoughtTogetherResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetFrequentlyBoughtTogetherResp) SetProducts(val []*SimpleProduct) {
+	p.Products = val
+}
+
+func (p *GetFrequentlyBoughtTogetherResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetFrequentlyBoughtTogetherResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFrequentlyBoughtTogetherResp(%+v)", *p)
+}
+
+var fieldIDToName_GetFrequentlyBoughtTogetherResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "products",
+}
+
+type Brand struct {
+	Id          int64       `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name        string      `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Logo        string      `thrift:"logo,3" frugal:"3,default,string" json:"logo"`
+	Description string      `thrift:"description,4" frugal:"4,default,string" json:"description"`
+	Status      BrandStatus `thrift:"status,5" frugal:"5,default,BrandStatus" json:"status"`
+	Aliases     []string    `thrift:"aliases,6" frugal:"6,default,list<string>" json:"aliases"`
+	CreatedAt   int64       `thrift:"createdAt,7" frugal:"7,default,i64" json:"createdAt"`
+	UpdatedAt   int64       `thrift:"updatedAt,8" frugal:"8,default,i64" json:"updatedAt"`
+}
+
+func NewBrand() *Brand {
+	return &Brand{}
+}
+
+func (p *Brand) InitDefault() {
+}
+
+func (p *Brand) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Brand) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Brand) GetLogo() (v string) {
+	return p.Logo
+}
+
+func (p *Brand) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *Brand) GetStatus() (v BrandStatus) {
+	return p.Status
+}
+
+func (p *Brand) GetAliases() (v []string) {
+	return p.Aliases
+}
+
+func (p *Brand) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Brand) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Brand) SetId(val int64) {
+	p.Id = val
+}
+func (p *Brand) SetName(val string) {
+	p.Name = val
+}
+func (p *Brand) SetLogo(val string) {
+	p.Logo = val
+}
+func (p *Brand) SetDescription(val string) {
+	p.Description = val
+}
+func (p *Brand) SetStatus(val BrandStatus) {
+	p.Status = val
+}
+func (p *Brand) SetAliases(val []string) {
+	p.Aliases = val
+}
+func (p *Brand) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Brand) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Brand) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Brand(%+v)", *p)
+}
+
+var fieldIDToName_Brand = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "logo",
+	4: "description",
+	5: "status",
+	6: "aliases",
+	7: "createdAt",
+	8: "updatedAt",
+}
+
+type BrandFacet struct {
+	BrandId int64  `thrift:"brandId,1" frugal:"1,default,i64" json:"brandId"`
+	Name    string `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	Count   int32  `thrift:"count,3" frugal:"3,default,i32" json:"count"`
+}
+
+func NewBrandFacet() *BrandFacet {
+	return &BrandFacet{}
+}
+
+func (p *BrandFacet) InitDefault() {
+}
+
+func (p *BrandFacet) GetBrandId() (v int64) {
+	return p.BrandId
+}
+
+func (p *BrandFacet) GetName() (v string) {
+	return p.Name
+}
+
+func (p *BrandFacet) GetCount() (v int32) {
+	return p.Count
+}
+func (p *BrandFacet) SetBrandId(val int64) {
+	p.BrandId = val
+}
+func (p *BrandFacet) SetName(val string) {
+	p.Name = val
+}
+func (p *BrandFacet) SetCount(val int32) {
+	p.Count = val
+}
+
+func (p *BrandFacet) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BrandFacet(%+v)", *p)
+}
+
+var fieldIDToName_BrandFacet = map[int16]string{
+	1: "brandId",
+	2: "name",
+	3: "count",
+}
+
+type CreateBrandReq struct {
+	Name        string       `thrift:"name,1" frugal:"1,default,string" json:"name"`
+	Logo        *string      `thrift:"logo,2,optional" frugal:"2,optional,string" json:"logo,omitempty"`
+	Description *string      `thrift:"description,3,optional" frugal:"3,optional,string" json:"description,omitempty"`
+	Aliases     []string     `thrift:"aliases,4,optional" frugal:"4,optional,list<string>" json:"aliases,omitempty"`
+	Status      *BrandStatus `thrift:"status,5,optional" frugal:"5,optional,BrandStatus" json:"status,omitempty"`
+}
+
+func NewCreateBrandReq() *CreateBrandReq {
+	return &CreateBrandReq{}
+}
+
+func (p *CreateBrandReq) InitDefault() {
+}
+
+func (p *CreateBrandReq) GetName() (v string) {
+	return p.Name
+}
+
+var CreateBrandReq_Logo_DEFAULT string
+
+func (p *CreateBrandReq) GetLogo() (v string) {
+	if !p.IsSetLogo() {
+		return CreateBrandReq_Logo_DEFAULT
+	}
+	return *p.Logo
+}
+
+var CreateBrandReq_Description_DEFAULT string
+
+func (p *CreateBrandReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return CreateBrandReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var CreateBrandReq_Aliases_DEFAULT []string
+
+func (p *CreateBrandReq) GetAliases() (v []string) {
+	if !p.IsSetAliases() {
+		return CreateBrandReq_Aliases_DEFAULT
+	}
+	return p.Aliases
+}
+
+var CreateBrandReq_Status_DEFAULT BrandStatus
+
+func (p *CreateBrandReq) GetStatus() (v BrandStatus) {
+	if !p.IsSetStatus() {
+		return CreateBrandReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+func (p *CreateBrandReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateBrandReq) SetLogo(val *string) {
+	p.Logo = val
+}
+func (p *CreateBrandReq) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *CreateBrandReq) SetAliases(val []string) {
+	p.Aliases = val
+}
+func (p *CreateBrandReq) SetStatus(val *BrandStatus) {
+	p.Status = val
+}
+
+func (p *CreateBrandReq) IsSetLogo() bool {
+	return p.Logo != nil
+}
+
+func (p *CreateBrandReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateBrandReq) IsSetAliases() bool {
+	return p.Aliases != nil
+}
+
+func (p *CreateBrandReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *CreateBrandReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateBrandReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateBrandReq = map[int16]string{
+	1: "name",
+	2: "logo",
+	3: "description",
+	4: "aliases",
+	5: "status",
+}
+
+type CreateBrandResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Brand   *Brand  `thrift:"brand,4,optional" frugal:"4,optional,Brand" json:"brand,omitempty"`
+}
+
+func NewCreateBrandResp() *CreateBrandResp {
+	return &CreateBrandResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateBrandResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateBrandResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateBrandResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CreateBrandResp_Message_DEFAULT string
+
+func (p *CreateBrandResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CreateBrandResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var CreateBrandResp_Brand_DEFAULT *Brand
+
+func (p *CreateBrandResp) GetBrand() (v *Brand) {
+	if !p.IsSetBrand() {
+		return CreateBrandResp_Brand_DEFAULT
+	}
+	return p.Brand
+}
+func (p *CreateBrandResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateBrandResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateBrandResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *CreateBrandResp) SetBrand(val *Brand) {
+	p.Brand = val
+}
+
+func (p *CreateBrandResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CreateBrandResp) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *CreateBrandResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateBrandResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateBrandResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "brand",
+}
+
+type UpdateBrandReq struct {
+	Id          int64        `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name        *string      `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Logo        *string      `thrift:"logo,3,optional" frugal:"3,optional,string" json:"logo,omitempty"`
+	Description *string      `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	Aliases     []string     `thrift:"aliases,5,optional" frugal:"5,optional,list<string>" json:"aliases,omitempty"`
+	Status      *BrandStatus `thrift:"status,6,optional" frugal:"6,optional,BrandStatus" json:"status,omitempty"`
+	Operator    *string      `thrift:"operator,7,optional" frugal:"7,optional,string" json:"operator,omitempty"`
+}
+
+func NewUpdateBrandReq() *UpdateBrandReq {
+	return &UpdateBrandReq{}
+}
+
+func (p *UpdateBrandReq) InitDefault() {
+}
+
+func (p *UpdateBrandReq) GetId() (v int64) {
+	return p.Id
+}
+
+var UpdateBrandReq_Name_DEFAULT string
+
+func (p *UpdateBrandReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateBrandReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateBrandReq_Logo_DEFAULT string
+
+func (p *UpdateBrandReq) GetLogo() (v string) {
+	if !p.IsSetLogo() {
+		return UpdateBrandReq_Logo_DEFAULT
+	}
+	return *p.Logo
+}
+
+var UpdateBrandReq_Description_DEFAULT string
+
+func (p *UpdateBrandReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return UpdateBrandReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var UpdateBrandReq_Aliases_DEFAULT []string
+
+func (p *UpdateBrandReq) GetAliases() (v []string) {
+	if !p.IsSetAliases() {
+		return UpdateBrandReq_Aliases_DEFAULT
+	}
+	return p.Aliases
+}
+
+var UpdateBrandReq_Status_DEFAULT BrandStatus
+
+func (p *UpdateBrandReq) GetStatus() (v BrandStatus) {
+	if !p.IsSetStatus() {
+		return UpdateBrandReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var UpdateBrandReq_Operator_DEFAULT string
+
+func (p *UpdateBrandReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return UpdateBrandReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *UpdateBrandReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *UpdateBrandReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateBrandReq) SetLogo(val *string) {
+	p.Logo = val
+}
+func (p *UpdateBrandReq) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *UpdateBrandReq) SetAliases(val []string) {
+	p.Aliases = val
+}
+func (p *UpdateBrandReq) SetStatus(val *BrandStatus) {
+	p.Status = val
+}
+func (p *UpdateBrandReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *UpdateBrandReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateBrandReq) IsSetLogo() bool {
+	return p.Logo != nil
+}
+
+func (p *UpdateBrandReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *UpdateBrandReq) IsSetAliases() bool {
+	return p.Aliases != nil
+}
+
+func (p *UpdateBrandReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateBrandReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *UpdateBrandReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateBrandReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateBrandReq = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "logo",
+	4: "description",
+	5: "aliases",
+	6: "status",
+	7: "operator",
+}
+
+type UpdateBrandResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Brand   *Brand  `thrift:"brand,4,optional" frugal:"4,optional,Brand" json:"brand,omitempty"`
+}
+
+func NewUpdateBrandResp() *UpdateBrandResp {
+	return &UpdateBrandResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateBrandResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateBrandResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateBrandResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateBrandResp_Message_DEFAULT string
+
+func (p *UpdateBrandResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateBrandResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var UpdateBrandResp_Brand_DEFAULT *Brand
+
+func (p *UpdateBrandResp) GetBrand() (v *Brand) {
+	if !p.IsSetBrand() {
+		return UpdateBrandResp_Brand_DEFAULT
+	}
+	return p.Brand
+}
+func (p *UpdateBrandResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateBrandResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateBrandResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UpdateBrandResp) SetBrand(val *Brand) {
+	p.Brand = val
+}
+
+func (p *UpdateBrandResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateBrandResp) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *UpdateBrandResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateBrandResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateBrandResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "brand",
+}
+
+type GetBrandReq struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewGetBrandReq() *GetBrandReq {
+	return &GetBrandReq{}
+}
+
+func (p *GetBrandReq) InitDefault() {
+}
+
+func (p *GetBrandReq) GetId() (v int64) {
+	return p.Id
+}
+func (p *GetBrandReq) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *GetBrandReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetBrandReq(%+v)", *p)
+}
+
+var fieldIDToName_GetBrandReq = map[int16]string{
+	1: "id",
+}
+
+type GetBrandResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Brand   *Brand  `thrift:"brand,4,optional" frugal:"4,optional,Brand" json:"brand,omitempty"`
+}
+
+func NewGetBrandResp() *GetBrandResp {
+	return &GetBrandResp{
+		Code: 0,
+	}
+}
+
+func (p *GetBrandResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetBrandResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetBrandResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetBrandResp_Message_DEFAULT string
+
+func (p *GetBrandResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetBrandResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var GetBrandResp_Brand_DEFAULT *Brand
+
+func (p *GetBrandResp) GetBrand() (v *Brand) {
+	if !p.IsSetBrand() {
+		return GetBrandResp_Brand_DEFAULT
+	}
+	return p.Brand
+}
+func (p *GetBrandResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetBrandResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetBrandResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetBrandResp) SetBrand(val *Brand) {
+	p.Brand = val
+}
+
+func (p *GetBrandResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetBrandResp) IsSetBrand() bool {
+	return p.Brand != nil
+}
+
+func (p *GetBrandResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetBrandResp(%+v)", *p)
+}
+
+var fieldIDToName_GetBrandResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "brand",
+}
+
+type ListBrandsReq struct {
+	Keyword  *string      `thrift:"keyword,1,optional" frugal:"1,optional,string" json:"keyword,omitempty"`
+	Status   *BrandStatus `thrift:"status,2,optional" frugal:"2,optional,BrandStatus" json:"status,omitempty"`
+	Page     int32        `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize int32        `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewListBrandsReq() *ListBrandsReq {
+	return &ListBrandsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListBrandsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListBrandsReq_Keyword_DEFAULT string
+
+func (p *ListBrandsReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return ListBrandsReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+var ListBrandsReq_Status_DEFAULT BrandStatus
+
+func (p *ListBrandsReq) GetStatus() (v BrandStatus) {
+	if !p.IsSetStatus() {
+		return ListBrandsReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+func (p *ListBrandsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListBrandsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListBrandsReq) SetKeyword(val *string) {
+	p.Keyword = val
+}
+func (p *ListBrandsReq) SetStatus(val *BrandStatus) {
+	p.Status = val
+}
+func (p *ListBrandsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListBrandsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListBrandsReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListBrandsReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListBrandsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListBrandsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListBrandsReq = map[int16]string{
+	1: "keyword",
+	2: "status",
+	3: "page",
+	4: "pageSize",
+}
+
+type ListBrandsResp struct {
+	Success  bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total    int32    `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32    `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32    `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Brands   []*Brand `thrift:"brands,7" frugal:"7,default,list<Brand>" json:"brands"`
+}
+
+func NewListBrandsResp() *ListBrandsResp {
+	return &ListBrandsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListBrandsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListBrandsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListBrandsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListBrandsResp_Message_DEFAULT string
+
+func (p *ListBrandsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListBrandsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListBrandsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListBrandsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListBrandsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListBrandsResp) GetBrands() (v []*Brand) {
+	return p.Brands
+}
+func (p *ListBrandsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListBrandsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListBrandsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListBrandsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListBrandsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListBrandsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListBrandsResp) SetBrands(val []*Brand) {
+	p.Brands = val
+}
+
+func (p *ListBrandsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListBrandsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListBrandsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListBrandsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "brands",
+}
+
+type DeleteBrandReq struct {
+	Id          int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	MergeIntoId *int64  `thrift:"mergeIntoId,2,optional" frugal:"2,optional,i64" json:"mergeIntoId,omitempty"`
+	Operator    *string `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewDeleteBrandReq() *DeleteBrandReq {
+	return &DeleteBrandReq{}
+}
+
+func (p *DeleteBrandReq) InitDefault() {
+}
+
+func (p *DeleteBrandReq) GetId() (v int64) {
+	return p.Id
+}
+
+var DeleteBrandReq_MergeIntoId_DEFAULT int64
+
+func (p *DeleteBrandReq) GetMergeIntoId() (v int64) {
+	if !p.IsSetMergeIntoId() {
+		return DeleteBrandReq_MergeIntoId_DEFAULT
+	}
+	return *p.MergeIntoId
+}
+
+var DeleteBrandReq_Operator_DEFAULT string
+
+func (p *DeleteBrandReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return DeleteBrandReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *DeleteBrandReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *DeleteBrandReq) SetMergeIntoId(val *int64) {
+	p.MergeIntoId = val
+}
+func (p *DeleteBrandReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *DeleteBrandReq) IsSetMergeIntoId() bool {
+	return p.MergeIntoId != nil
+}
+
+func (p *DeleteBrandReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *DeleteBrandReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteBrandReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteBrandReq = map[int16]string{
+	1: "id",
+	2: "mergeIntoId",
+	3: "operator",
+}
+
+type DeleteBrandResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewDeleteBrandResp() *DeleteBrandResp {
+	return &DeleteBrandResp{
+		Code: 0,
+	}
+}
+
+func (p *DeleteBrandResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *DeleteBrandResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DeleteBrandResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var DeleteBrandResp_Message_DEFAULT string
+
+func (p *DeleteBrandResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return DeleteBrandResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *DeleteBrandResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DeleteBrandResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *DeleteBrandResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *DeleteBrandResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *DeleteBrandResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteBrandResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteBrandResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ProductApproval struct {
+	Id         int64                 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	ProductId  int64                 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+	Kind       ApprovalKind          `thrift:"kind,3" frugal:"3,default,ApprovalKind" json:"kind"`
+	Status     ApprovalStatus        `thrift:"status,4" frugal:"4,default,ApprovalStatus" json:"status"`
+	Changes    []*ProductAuditChange `thrift:"changes,5" frugal:"5,default,list<ProductAuditChange>" json:"changes"`
+	Submitter  string                `thrift:"submitter,6" frugal:"6,default,string" json:"submitter"`
+	Remark     *string               `thrift:"remark,7,optional" frugal:"7,optional,string" json:"remark,omitempty"`
+	Reviewer   *string               `thrift:"reviewer,8,optional" frugal:"8,optional,string" json:"reviewer,omitempty"`
+	Comment    *string               `thrift:"comment,9,optional" frugal:"9,optional,string" json:"comment,omitempty"`
+	CreatedAt  int64                 `thrift:"createdAt,10" frugal:"10,default,i64" json:"createdAt"`
+	ReviewedAt int64                 `thrift:"reviewedAt,11" frugal:"11,default,i64" json:"reviewedAt"`
+}
+
+func NewProductApproval() *ProductApproval {
+	return &ProductApproval{}
+}
+
+func (p *ProductApproval) InitDefault() {
+}
+
+func (p *ProductApproval) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ProductApproval) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductApproval) GetKind() (v ApprovalKind) {
+	return p.Kind
+}
+
+func (p *ProductApproval) GetStatus() (v ApprovalStatus) {
+	return p.Status
+}
+
+func (p *ProductApproval) GetChanges() (v []*ProductAuditChange) {
+	return p.Changes
+}
+
+func (p *ProductApproval) GetSubmitter() (v string) {
+	return p.Submitter
+}
+
+var ProductApproval_Remark_DEFAULT string
+
+func (p *ProductApproval) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return ProductApproval_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+var ProductApproval_Reviewer_DEFAULT string
+
+func (p *ProductApproval) GetReviewer() (v string) {
+	if !p.IsSetReviewer() {
+		return ProductApproval_Reviewer_DEFAULT
+	}
+	return *p.Reviewer
+}
+
+var ProductApproval_Comment_DEFAULT string
+
+func (p *ProductApproval) GetComment() (v string) {
+	if !p.IsSetComment() {
+		return ProductApproval_Comment_DEFAULT
+	}
+	return *p.Comment
+}
+
+func (p *ProductApproval) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *ProductApproval) GetReviewedAt() (v int64) {
+	return p.ReviewedAt
+}
+func (p *ProductApproval) SetId(val int64) {
+	p.Id = val
+}
+func (p *ProductApproval) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductApproval) SetKind(val ApprovalKind) {
+	p.Kind = val
+}
+func (p *ProductApproval) SetStatus(val ApprovalStatus) {
+	p.Status = val
+}
+func (p *ProductApproval) SetChanges(val []*ProductAuditChange) {
+	p.Changes = val
+}
+func (p *ProductApproval) SetSubmitter(val string) {
+	p.Submitter = val
+}
+func (p *ProductApproval) SetRemark(val *string) {
+	p.Remark = val
+}
+func (p *ProductApproval) SetReviewer(val *string) {
+	p.Reviewer = val
+}
+func (p *ProductApproval) SetComment(val *string) {
+	p.Comment = val
+}
+func (p *ProductApproval) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *ProductApproval) SetReviewedAt(val int64) {
+	p.ReviewedAt = val
+}
+
+func (p *ProductApproval) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *ProductApproval) IsSetReviewer() bool {
+	return p.Reviewer != nil
+}
+
+func (p *ProductApproval) IsSetComment() bool {
+	return p.Comment != nil
+}
+
+func (p *ProductApproval) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductApproval(%+v)", *p)
+}
+
+var fieldIDToName_ProductApproval = map[int16]string{
+	1:  "id",
+	2:  "productId",
+	3:  "kind",
+	4:  "status",
+	5:  "changes",
+	6:  "submitter",
+	7:  "remark",
+	8:  "reviewer",
+	9:  "comment",
+	10: "createdAt",
+	11: "reviewedAt",
+}
+
+type SubmitProductApprovalReq struct {
+	ProductId int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Remark    *string `thrift:"remark,2,optional" frugal:"2,optional,string" json:"remark,omitempty"`
+	Operator  *string `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewSubmitProductApprovalReq() *SubmitProductApprovalReq {
+	return &SubmitProductApprovalReq{}
+}
+
+func (p *SubmitProductApprovalReq) InitDefault() {
+}
+
+func (p *SubmitProductApprovalReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+var SubmitProductApprovalReq_Remark_DEFAULT string
+
+func (p *SubmitProductApprovalReq) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return SubmitProductApprovalReq_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+var SubmitProductApprovalReq_Operator_DEFAULT string
+
+func (p *SubmitProductApprovalReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return SubmitProductApprovalReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *SubmitProductApprovalReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *SubmitProductApprovalReq) SetRemark(val *string) {
+	p.Remark = val
+}
+func (p *SubmitProductApprovalReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *SubmitProductApprovalReq) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *SubmitProductApprovalReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *SubmitProductApprovalReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitProductApprovalReq(%+v)", *p)
+}
+
+var fieldIDToName_SubmitProductApprovalReq = map[int16]string{
+	1: "productId",
+	2: "remark",
+	3: "operator",
+}
+
+type SubmitProductApprovalResp struct {
+	Success  bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Approval *ProductApproval `thrift:"approval,4,optional" frugal:"4,optional,ProductApproval" json:"approval,omitempty"`
+}
+
+func NewSubmitProductApprovalResp() *SubmitProductApprovalResp {
+	return &SubmitProductApprovalResp{
+		Code: 0,
+	}
+}
+
+func (p *SubmitProductApprovalResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SubmitProductApprovalResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SubmitProductApprovalResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SubmitProductApprovalResp_Message_DEFAULT string
+
+func (p *SubmitProductApprovalResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SubmitProductApprovalResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var SubmitProductApprovalResp_Approval_DEFAULT *ProductApproval
+
+func (p *SubmitProductApprovalResp) GetApproval() (v *ProductApproval) {
+	if !p.IsSetApproval() {
+		return SubmitProductApprovalResp_Approval_DEFAULT
+	}
+	return p.Approval
+}
+func (p *SubmitProductApprovalResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SubmitProductApprovalResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SubmitProductApprovalResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SubmitProductApprovalResp) SetApproval(val *ProductApproval) {
+	p.Approval = val
+}
+
+func (p *SubmitProductApprovalResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SubmitProductApprovalResp) IsSetApproval() bool {
+	return p.Approval != nil
+}
+
+func (p *SubmitProductApprovalResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitProductApprovalResp(%+v)", *p)
+}
+
+var fieldIDToName_SubmitProductApprovalResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "approval",
+}
+
+type ReviewProductApprovalReq struct {
+	Id       int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Approved bool    `thrift:"approved,2" frugal:"2,default,bool" json:"approved"`
+	Comment  *string `thrift:"comment,3,optional" frugal:"3,optional,string" json:"comment,omitempty"`
+	Reviewer *string `thrift:"reviewer,4,optional" frugal:"4,optional,string" json:"reviewer,omitempty"`
+}
+
+func NewReviewProductApprovalReq() *ReviewProductApprovalReq {
+	return &ReviewProductApprovalReq{}
+}
+
+func (p *ReviewProductApprovalReq) InitDefault() {
+}
+
+func (p *ReviewProductApprovalReq) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *ReviewProductApprovalReq) GetApproved() (v bool) {
+	return p.Approved
+}
+
+var ReviewProductApprovalReq_Comment_DEFAULT string
+
+func (p *ReviewProductApprovalReq) GetComment() (v string) {
+	if !p.IsSetComment() {
+		return ReviewProductApprovalReq_Comment_DEFAULT
+	}
+	return *p.Comment
+}
+
+var ReviewProductApprovalReq_Reviewer_DEFAULT string
+
+func (p *ReviewProductApprovalReq) GetReviewer() (v string) {
+	if !p.IsSetReviewer() {
+		return ReviewProductApprovalReq_Reviewer_DEFAULT
+	}
+	return *p.Reviewer
+}
+func (p *ReviewProductApprovalReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *ReviewProductApprovalReq) SetApproved(val bool) {
+	p.Approved = val
+}
+func (p *ReviewProductApprovalReq) SetComment(val *string) {
+	p.Comment = val
+}
+func (p *ReviewProductApprovalReq) SetReviewer(val *string) {
+	p.Reviewer = val
+}
+
+func (p *ReviewProductApprovalReq) IsSetComment() bool {
+	return p.Comment != nil
+}
+
+func (p *ReviewProductApprovalReq) IsSetReviewer() bool {
+	return p.Reviewer != nil
+}
+
+func (p *ReviewProductApprovalReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewProductApprovalReq(%+v)", *p)
+}
+
+var fieldIDToName_ReviewProductApprovalReq = map[int16]string{
+	1: "id",
+	2: "approved",
+	3: "comment",
+	4: "reviewer",
+}
+
+type ReviewProductApprovalResp struct {
+	Success  bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Approval *ProductApproval `thrift:"approval,4,optional" frugal:"4,optional,ProductApproval" json:"approval,omitempty"`
+	Product  *Product         `thrift:"product,5,optional" frugal:"5,optional,Product" json:"product,omitempty"`
+}
+
+func NewReviewProductApprovalResp() *ReviewProductApprovalResp {
+	return &ReviewProductApprovalResp{
+		Code: 0,
+	}
+}
+
+func (p *ReviewProductApprovalResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ReviewProductApprovalResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ReviewProductApprovalResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ReviewProductApprovalResp_Message_DEFAULT string
+
+func (p *ReviewProductApprovalResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ReviewProductApprovalResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var ReviewProductApprovalResp_Approval_DEFAULT *ProductApproval
+
+func (p *ReviewProductApprovalResp) GetApproval() (v *ProductApproval) {
+	if !p.IsSetApproval() {
+		return ReviewProductApprovalResp_Approval_DEFAULT
+	}
+	return p.Approval
+}
+
+var ReviewProductApprovalResp_Product_DEFAULT *Product
+
+func (p *ReviewProductApprovalResp) GetProduct() (v *Product) {
+	if !p.IsSetProduct() {
+		return ReviewProductApprovalResp_Product_DEFAULT
+	}
+	return p.Product
+}
+func (p *ReviewProductApprovalResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ReviewProductApprovalResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ReviewProductApprovalResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ReviewProductApprovalResp) SetApproval(val *ProductApproval) {
+	p.Approval = val
+}
+func (p *ReviewProductApprovalResp) SetProduct(val *Product) {
+	p.Product = val
+}
+
+func (p *ReviewProductApprovalResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ReviewProductApprovalResp) IsSetApproval() bool {
+	return p.Approval != nil
+}
+
+func (p *ReviewProductApprovalResp) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *ReviewProductApprovalResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewProductApprovalResp(%+v)", *p)
+}
+
+var fieldIDToName_ReviewProductApprovalResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "approval",
+	5: "product",
+}
+
+type ListProductApprovalsReq struct {
+	ProductId *int64          `thrift:"productId,1,optional" frugal:"1,optional,i64" json:"productId,omitempty"`
+	Status    *ApprovalStatus `thrift:"status,2,optional" frugal:"2,optional,ApprovalStatus" json:"status,omitempty"`
+	Kind      *ApprovalKind   `thrift:"kind,3,optional" frugal:"3,optional,ApprovalKind" json:"kind,omitempty"`
+	Page      int32           `thrift:"page,4" frugal:"4,default,i32" json:"page"`
+	PageSize  int32           `thrift:"pageSize,5" frugal:"5,default,i32" json:"pageSize"`
+}
+
+func NewListProductApprovalsReq() *ListProductApprovalsReq {
+	return &ListProductApprovalsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListProductApprovalsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListProductApprovalsReq_ProductId_DEFAULT int64
+
+func (p *ListProductApprovalsReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return ListProductApprovalsReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var ListProductApprovalsReq_Status_DEFAULT ApprovalStatus
+
+func (p *ListProductApprovalsReq) GetStatus() (v ApprovalStatus) {
+	if !p.IsSetStatus() {
+		return ListProductApprovalsReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ListProductApprovalsReq_Kind_DEFAULT ApprovalKind
+
+func (p *ListProductApprovalsReq) GetKind() (v ApprovalKind) {
+	if !p.IsSetKind() {
+		return ListProductApprovalsReq_Kind_DEFAULT
+	}
+	return *p.Kind
+}
+
+func (p *ListProductApprovalsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListProductApprovalsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListProductApprovalsReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *ListProductApprovalsReq) SetStatus(val *ApprovalStatus) {
+	p.Status = val
+}
+func (p *ListProductApprovalsReq) SetKind(val *ApprovalKind) {
+	p.Kind = val
+}
+func (p *ListProductApprovalsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListProductApprovalsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListProductApprovalsReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *ListProductApprovalsReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListProductApprovalsReq) IsSetKind() bool {
+	return p.Kind != nil
+}
+
+func (p *ListProductApprovalsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductApprovalsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListProductApprovalsReq = map[int16]string{
+	1: "productId",
+	2: "status",
+	3: "kind",
+	4: "page",
+	5: "pageSize",
+}
+
+type ListProductApprovalsResp struct {
+	Success   bool               `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32              `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string            `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total     int32              `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page      int32              `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32              `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Approvals []*ProductApproval `thrift:"approvals,7" frugal:"7,default,list<ProductApproval>" json:"approvals"`
+}
+
+func NewListProductApprovalsResp() *ListProductApprovalsResp {
+	return &ListProductApprovalsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListProductApprovalsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListProductApprovalsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListProductApprovalsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListProductApprovalsResp_Message_DEFAULT string
+
+func (p *ListProductApprovalsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListProductApprovalsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListProductApprovalsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListProductApprovalsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListProductApprovalsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListProductApprovalsResp) GetApprovals() (v []*ProductApproval) {
+	return p.Approvals
+}
+func (p *ListProductApprovalsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListProductApprovalsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListProductApprovalsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListProductApprovalsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListProductApprovalsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListProductApprovalsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListProductApprovalsResp) SetApprovals(val []*ProductApproval) {
+	p.Approvals = val
+}
+
+func (p *ListProductApprovalsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListProductApprovalsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductApprovalsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListProductApprovalsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "approvals",
+}
+
+type Merchant struct {
+	Id           int64          `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name         string         `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	ContactName  string         `thrift:"contactName,3" frugal:"3,default,string" json:"contactName"`
+	ContactPhone string         `thrift:"contactPhone,4" frugal:"4,default,string" json:"contactPhone"`
+	Status       MerchantStatus `thrift:"status,5" frugal:"5,default,MerchantStatus" json:"status"`
+	CreatedAt    int64          `thrift:"createdAt,6" frugal:"6,default,i64" json:"createdAt"`
+	UpdatedAt    int64          `thrift:"updatedAt,7" frugal:"7,default,i64" json:"updatedAt"`
+}
+
+func NewMerchant() *Merchant {
+	return &Merchant{}
+}
+
+func (p *Merchant) InitDefault() {
+}
+
+func (p *Merchant) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Merchant) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Merchant) GetContactName() (v string) {
+	return p.ContactName
+}
+
+func (p *Merchant) GetContactPhone() (v string) {
+	return p.ContactPhone
+}
+
+func (p *Merchant) GetStatus() (v MerchantStatus) {
+	return p.Status
+}
+
+func (p *Merchant) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Merchant) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Merchant) SetId(val int64) {
+	p.Id = val
+}
+func (p *Merchant) SetName(val string) {
+	p.Name = val
+}
+func (p *Merchant) SetContactName(val string) {
+	p.ContactName = val
+}
+func (p *Merchant) SetContactPhone(val string) {
+	p.ContactPhone = val
+}
+func (p *Merchant) SetStatus(val MerchantStatus) {
+	p.Status = val
+}
+func (p *Merchant) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Merchant) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Merchant) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Merchant(%+v)", *p)
+}
+
+var fieldIDToName_Merchant = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "contactName",
+	4: "contactPhone",
+	5: "status",
+	6: "createdAt",
+	7: "updatedAt",
+}
+
+type CreateMerchantReq struct {
+	Name         string  `thrift:"name,1" frugal:"1,default,string" json:"name"`
+	ContactName  *string `thrift:"contactName,2,optional" frugal:"2,optional,string" json:"contactName,omitempty"`
+	ContactPhone *string `thrift:"contactPhone,3,optional" frugal:"3,optional,string" json:"contactPhone,omitempty"`
+}
+
+func NewCreateMerchantReq() *CreateMerchantReq {
+	return &CreateMerchantReq{}
+}
+
+func (p *CreateMerchantReq) InitDefault() {
+}
+
+func (p *CreateMerchantReq) GetName() (v string) {
+	return p.Name
+}
+
+var CreateMerchantReq_ContactName_DEFAULT string
+
+func (p *CreateMerchantReq) GetContactName() (v string) {
+	if !p.IsSetContactName() {
+		return CreateMerchantReq_ContactName_DEFAULT
+	}
+	return *p.ContactName
+}
+
+var CreateMerchantReq_ContactPhone_DEFAULT string
+
+func (p *CreateMerchantReq) GetContactPhone() (v string) {
+	if !p.IsSetContactPhone() {
+		return CreateMerchantReq_ContactPhone_DEFAULT
+	}
+	return *p.ContactPhone
+}
+func (p *CreateMerchantReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateMerchantReq) SetContactName(val *string) {
+	p.ContactName = val
+}
+func (p *CreateMerchantReq) SetContactPhone(val *string) {
+	p.ContactPhone = val
+}
+
+func (p *CreateMerchantReq) IsSetContactName() bool {
+	return p.ContactName != nil
+}
+
+func (p *CreateMerchantReq) IsSetContactPhone() bool {
+	return p.ContactPhone != nil
+}
+
+func (p *CreateMerchantReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateMerchantReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateMerchantReq = map[int16]string{
+	1: "name",
+	2: "contactName",
+	3: "contactPhone",
+}
+
+type CreateMerchantResp struct {
+	Success  bool      `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32     `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string   `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Merchant *Merchant `thrift:"merchant,4,optional" frugal:"4,optional,Merchant" json:"merchant,omitempty"`
+}
+
+func NewCreateMerchantResp() *CreateMerchantResp {
+	return &CreateMerchantResp{
+		Code: 0,
+	}
+}
+
+func (p *CreateMerchantResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *CreateMerchantResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateMerchantResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var CreateMerchantResp_Message_DEFAULT string
+
+func (p *CreateMerchantResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return CreateMerchantResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var CreateMerchantResp_Merchant_DEFAULT *Merchant
+
+func (p *CreateMerchantResp) GetMerchant() (v *Merchant) {
+	if !p.IsSetMerchant() {
+		return CreateMerchantResp_Merchant_DEFAULT
+	}
+	return p.Merchant
+}
+func (p *CreateMerchantResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *CreateMerchantResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *CreateMerchantResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *CreateMerchantResp) SetMerchant(val *Merchant) {
+	p.Merchant = val
+}
+
+func (p *CreateMerchantResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *CreateMerchantResp) IsSetMerchant() bool {
+	return p.Merchant != nil
+}
+
+func (p *CreateMerchantResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateMerchantResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateMerchantResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "merchant",
+}
+
+type UpdateMerchantReq struct {
+	Id           int64           `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name         *string         `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	ContactName  *string         `thrift:"contactName,3,optional" frugal:"3,optional,string" json:"contactName,omitempty"`
+	ContactPhone *string         `thrift:"contactPhone,4,optional" frugal:"4,optional,string" json:"contactPhone,omitempty"`
+	Status       *MerchantStatus `thrift:"status,5,optional" frugal:"5,optional,MerchantStatus" json:"status,omitempty"`
+}
+
+func NewUpdateMerchantReq() *UpdateMerchantReq {
+	return &UpdateMerchantReq{}
+}
+
+func (p *UpdateMerchantReq) InitDefault() {
+}
+
+func (p *UpdateMerchantReq) GetId() (v int64) {
+	return p.Id
+}
+
+var UpdateMerchantReq_Name_DEFAULT string
+
+func (p *UpdateMerchantReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateMerchantReq_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateMerchantReq_ContactName_DEFAULT string
+
+func (p *UpdateMerchantReq) GetContactName() (v string) {
+	if !p.IsSetContactName() {
+		return UpdateMerchantReq_ContactName_DEFAULT
+	}
+	return *p.ContactName
+}
+
+var UpdateMerchantReq_ContactPhone_DEFAULT string
+
+func (p *UpdateMerchantReq) GetContactPhone() (v string) {
+	if !p.IsSetContactPhone() {
+		return UpdateMerchantReq_ContactPhone_DEFAULT
+	}
+	return *p.ContactPhone
+}
+
+var UpdateMerchantReq_Status_DEFAULT MerchantStatus
+
+func (p *UpdateMerchantReq) GetStatus() (v MerchantStatus) {
+	if !p.IsSetStatus() {
+		return UpdateMerchantReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+func (p *UpdateMerchantReq) SetId(val int64) {
+	p.Id = val
+}
+func (p *UpdateMerchantReq) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateMerchantReq) SetContactName(val *string) {
+	p.ContactName = val
+}
+func (p *UpdateMerchantReq) SetContactPhone(val *string) {
+	p.ContactPhone = val
+}
+func (p *UpdateMerchantReq) SetStatus(val *MerchantStatus) {
+	p.Status = val
+}
+
+func (p *UpdateMerchantReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateMerchantReq) IsSetContactName() bool {
+	return p.ContactName != nil
+}
+
+func (p *UpdateMerchantReq) IsSetContactPhone() bool {
+	return p.ContactPhone != nil
+}
+
+func (p *UpdateMerchantReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateMerchantReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateMerchantReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateMerchantReq = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "contactName",
+	4: "contactPhone",
+	5: "status",
+}
+
+type UpdateMerchantResp struct {
+	Success  bool      `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32     `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string   `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Merchant *Merchant `thrift:"merchant,4,optional" frugal:"4,optional,Merchant" json:"merchant,omitempty"`
+}
+
+func NewUpdateMerchantResp() *UpdateMerchantResp {
+	return &UpdateMerchantResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateMerchantResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateMerchantResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateMerchantResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateMerchantResp_Message_DEFAULT string
+
+func (p *UpdateMerchantResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateMerchantResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var UpdateMerchantResp_Merchant_DEFAULT *Merchant
+
+func (p *UpdateMerchantResp) GetMerchant() (v *Merchant) {
+	if !p.IsSetMerchant() {
+		return UpdateMerchantResp_Merchant_DEFAULT
+	}
+	return p.Merchant
+}
+func (p *UpdateMerchantResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateMerchantResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateMerchantResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UpdateMerchantResp) SetMerchant(val *Merchant) {
+	p.Merchant = val
+}
+
+func (p *UpdateMerchantResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateMerchantResp) IsSetMerchant() bool {
+	return p.Merchant != nil
+}
+
+func (p *UpdateMerchantResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateMerchantResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateMerchantResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "merchant",
+}
+
+type GetMerchantReq struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewGetMerchantReq() *GetMerchantReq {
+	return &GetMerchantReq{}
+}
+
+func (p *GetMerchantReq) InitDefault() {
+}
+
+func (p *GetMerchantReq) GetId() (v int64) {
+	return p.Id
+}
+func (p *GetMerchantReq) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *GetMerchantReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetMerchantReq(%+v)", *p)
+}
+
+var fieldIDToName_GetMerchantReq = map[int16]string{
+	1: "id",
+}
+
+type GetMerchantResp struct {
+	Success  bool      `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32     `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string   `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Merchant *Merchant `thrift:"merchant,4,optional" frugal:"4,optional,Merchant" json:"merchant,omitempty"`
+}
+
+func NewGetMerchantResp() *GetMerchantResp {
+	return &GetMerchantResp{
+		Code: 0,
+	}
+}
+
+func (p *GetMerchantResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetMerchantResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetMerchantResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetMerchantResp_Message_DEFAULT string
+
+func (p *GetMerchantResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetMerchantResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var GetMerchantResp_Merchant_DEFAULT *Merchant
+
+func (p *GetMerchantResp) GetMerchant() (v *Merchant) {
+	if !p.IsSetMerchant() {
+		return GetMerchantResp_Merchant_DEFAULT
+	}
+	return p.Merchant
+}
+func (p *GetMerchantResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetMerchantResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetMerchantResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetMerchantResp) SetMerchant(val *Merchant) {
+	p.Merchant = val
+}
+
+func (p *GetMerchantResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetMerchantResp) IsSetMerchant() bool {
+	return p.Merchant != nil
+}
+
+func (p *GetMerchantResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetMerchantResp(%+v)", *p)
+}
+
+var fieldIDToName_GetMerchantResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "merchant",
+}
+
+type ListMerchantsReq struct {
+	Keyword  *string         `thrift:"keyword,1,optional" frugal:"1,optional,string" json:"keyword,omitempty"`
+	Status   *MerchantStatus `thrift:"status,2,optional" frugal:"2,optional,MerchantStatus" json:"status,omitempty"`
+	Page     int32           `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize int32           `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewListMerchantsReq() *ListMerchantsReq {
+	return &ListMerchantsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListMerchantsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListMerchantsReq_Keyword_DEFAULT string
+
+func (p *ListMerchantsReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return ListMerchantsReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+var ListMerchantsReq_Status_DEFAULT MerchantStatus
+
+func (p *ListMerchantsReq) GetStatus() (v MerchantStatus) {
+	if !p.IsSetStatus() {
+		return ListMerchantsReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+func (p *ListMerchantsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListMerchantsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListMerchantsReq) SetKeyword(val *string) {
+	p.Keyword = val
+}
+func (p *ListMerchantsReq) SetStatus(val *MerchantStatus) {
+	p.Status = val
+}
+func (p *ListMerchantsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListMerchantsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListMerchantsReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListMerchantsReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListMerchantsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMerchantsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListMerchantsReq = map[int16]string{
+	1: "keyword",
+	2: "status",
+	3: "page",
+	4: "pageSize",
+}
+
+type ListMerchantsResp struct {
+	Success   bool        `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32       `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string     `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total     int32       `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page      int32       `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32       `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Merchants []*Merchant `thrift:"merchants,7" frugal:"7,default,list<Merchant>" json:"merchants"`
+}
+
+func NewListMerchantsResp() *ListMerchantsResp {
+	return &ListMerchantsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListMerchantsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListMerchantsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListMerchantsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListMerchantsResp_Message_DEFAULT string
+
+func (p *ListMerchantsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListMerchantsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListMerchantsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListMerchantsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListMerchantsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListMerchantsResp) GetMerchants() (v []*Merchant) {
+	return p.Merchants
+}
+func (p *ListMerchantsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListMerchantsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListMerchantsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListMerchantsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListMerchantsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListMerchantsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListMerchantsResp) SetMerchants(val []*Merchant) {
+	p.Merchants = val
+}
+
+func (p *ListMerchantsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListMerchantsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMerchantsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListMerchantsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "merchants",
+}
+
+type SearchSuggestion struct {
+	Text   string         `thrift:"text,1" frugal:"1,default,string" json:"text"`
+	Kind   SuggestionKind `thrift:"kind,2" frugal:"2,default,SuggestionKind" json:"kind"`
+	RefId  int64          `thrift:"refId,3" frugal:"3,default,i64" json:"refId"`
+	Weight float64        `thrift:"weight,4" frugal:"4,default,double" json:"weight"`
+}
+
+func NewSearchSuggestion() *SearchSuggestion {
+	return &SearchSuggestion{}
+}
+
+func (p *SearchSuggestion) InitDefault() {
+}
+
+func (p *SearchSuggestion) GetText() (v string) {
+	return p.Text
+}
+
+func (p *SearchSuggestion) GetKind() (v SuggestionKind) {
+	return p.Kind
+}
+
+func (p *SearchSuggestion) GetRefId() (v int64) {
+	return p.RefId
+}
+
+func (p *SearchSuggestion) GetWeight() (v float64) {
+	return p.Weight
+}
+func (p *SearchSuggestion) SetText(val string) {
+	p.Text = val
+}
+func (p *SearchSuggestion) SetKind(val SuggestionKind) {
+	p.Kind = val
+}
+func (p *SearchSuggestion) SetRefId(val int64) {
+	p.RefId = val
+}
+func (p *SearchSuggestion) SetWeight(val float64) {
+	p.Weight = val
+}
+
+func (p *SearchSuggestion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchSuggestion(%+v)", *p)
+}
+
+var fieldIDToName_SearchSuggestion = map[int16]string{
+	1: "text",
+	2: "kind",
+	3: "refId",
+	4: "weight",
+}
+
+type SuggestProductsReq struct {
+	Prefix string `thrift:"prefix,1" frugal:"1,default,string" json:"prefix"`
+	Limit  int32  `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+}
+
+func NewSuggestProductsReq() *SuggestProductsReq {
+	return &SuggestProductsReq{
+		Limit: 10,
+	}
+}
+
+func (p *SuggestProductsReq) InitDefault() {
+	p.Limit = 10
+}
+
+func (p *SuggestProductsReq) GetPrefix() (v string) {
+	return p.Prefix
+}
+
+func (p *SuggestProductsReq) GetLimit() (v int32) {
+	return p.Limit
+}
+func (p *SuggestProductsReq) SetPrefix(val string) {
+	p.Prefix = val
+}
+func (p *SuggestProductsReq) SetLimit(val int32) {
+	p.Limit = val
+}
+
+func (p *SuggestProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SuggestProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_SuggestProductsReq = map[int16]string{
+	1: "prefix",
+	2: "limit",
+}
+
+type SuggestProductsResp struct {
+	Success     bool                `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32               `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     *string             `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Suggestions []*SearchSuggestion `thrift:"suggestions,4" frugal:"4,default,list<SearchSuggestion>" json:"suggestions"`
+}
+
+func NewSuggestProductsResp() *SuggestProductsResp {
+	return &SuggestProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *SuggestProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SuggestProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SuggestProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SuggestProductsResp_Message_DEFAULT string
+
+func (p *SuggestProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SuggestProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *SuggestProductsResp) GetSuggestions() (v []*SearchSuggestion) {
+	return p.Suggestions
+}
+func (p *SuggestProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SuggestProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SuggestProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SuggestProductsResp) SetSuggestions(val []*SearchSuggestion) {
+	p.Suggestions = val
+}
+
+func (p *SuggestProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SuggestProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SuggestProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_SuggestProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "suggestions",
+}
+
+type SearchQueryStat struct {
+	Query           string `thrift:"query,1" frugal:"1,default,string" json:"query"`
+	SearchCount     int64  `thrift:"searchCount,2" frugal:"2,default,i64" json:"searchCount"`
+	ZeroResultCount int64  `thrift:"zeroResultCount,3" frugal:"3,default,i64" json:"zeroResultCount"`
+	LastResultCount int32  `thrift:"lastResultCount,4" frugal:"4,default,i32" json:"lastResultCount"`
+	LastSearchedAt  int64  `thrift:"lastSearchedAt,5" frugal:"5,default,i64" json:"lastSearchedAt"`
+}
+
+func NewSearchQueryStat() *SearchQueryStat {
+	return &SearchQueryStat{}
+}
+
+func (p *SearchQueryStat) InitDefault() {
+}
+
+func (p *SearchQueryStat) GetQuery() (v string) {
+	return p.Query
+}
+
+func (p *SearchQueryStat) GetSearchCount() (v int64) {
+	return p.SearchCount
+}
+
+func (p *SearchQueryStat) GetZeroResultCount() (v int64) {
+	return p.ZeroResultCount
+}
+
+func (p *SearchQueryStat) GetLastResultCount() (v int32) {
+	return p.LastResultCount
+}
+
+func (p *SearchQueryStat) GetLastSearchedAt() (v int64) {
+	return p.LastSearchedAt
+}
+func (p *SearchQueryStat) SetQuery(val string) {
+	p.Query = val
+}
+func (p *SearchQueryStat) SetSearchCount(val int64) {
+	p.SearchCount = val
+}
+func (p *SearchQueryStat) SetZeroResultCount(val int64) {
+	p.ZeroResultCount = val
+}
+func (p *SearchQueryStat) SetLastResultCount(val int32) {
+	p.LastResultCount = val
+}
+func (p *SearchQueryStat) SetLastSearchedAt(val int64) {
+	p.LastSearchedAt = val
+}
+
+func (p *SearchQueryStat) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchQueryStat(%+v)", *p)
+}
+
+var fieldIDToName_SearchQueryStat = map[int16]string{
+	1: "query",
+	2: "searchCount",
+	3: "zeroResultCount",
+	4: "lastResultCount",
+	5: "lastSearchedAt",
+}
+
+type ListSearchQueriesReq struct {
+	ZeroResultOnly *bool   `thrift:"zeroResultOnly,1,optional" frugal:"1,optional,bool" json:"zeroResultOnly,omitempty"`
+	SortBy         *string `thrift:"sortBy,2,optional" frugal:"2,optional,string" json:"sortBy,omitempty"`
+	Page           int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize       int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewListSearchQueriesReq() *ListSearchQueriesReq {
+	return &ListSearchQueriesReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListSearchQueriesReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListSearchQueriesReq_ZeroResultOnly_DEFAULT bool
+
+func (p *ListSearchQueriesReq) GetZeroResultOnly() (v bool) {
+	if !p.IsSetZeroResultOnly() {
+		return ListSearchQueriesReq_ZeroResultOnly_DEFAULT
+	}
+	return *p.ZeroResultOnly
+}
+
+var ListSearchQueriesReq_SortBy_DEFAULT string
+
+func (p *ListSearchQueriesReq) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return ListSearchQueriesReq_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
+
+func (p *ListSearchQueriesReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListSearchQueriesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListSearchQueriesReq) SetZeroResultOnly(val *bool) {
+	p.ZeroResultOnly = val
+}
+func (p *ListSearchQueriesReq) SetSortBy(val *string) {
+	p.SortBy = val
+}
+func (p *ListSearchQueriesReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListSearchQueriesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListSearchQueriesReq) IsSetZeroResultOnly() bool {
+	return p.ZeroResultOnly != nil
+}
+
+func (p *ListSearchQueriesReq) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
+
+func (p *ListSearchQueriesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSearchQueriesReq(%+v)", *p)
+}
+
+var fieldIDToName_ListSearchQueriesReq = map[int16]string{
+	1: "zeroResultOnly",
+	2: "sortBy",
+	3: "page",
+	4: "pageSize",
+}
+
+type ListSearchQueriesResp struct {
+	Success  bool               `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32              `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string            `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total    int32              `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32              `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32              `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Queries  []*SearchQueryStat `thrift:"queries,7" frugal:"7,default,list<SearchQueryStat>" json:"queries"`
+}
+
+func NewListSearchQueriesResp() *ListSearchQueriesResp {
+	return &ListSearchQueriesResp{
+		Code: 0,
+	}
+}
+
+func (p *ListSearchQueriesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListSearchQueriesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListSearchQueriesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListSearchQueriesResp_Message_DEFAULT string
+
+func (p *ListSearchQueriesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListSearchQueriesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListSearchQueriesResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListSearchQueriesResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListSearchQueriesResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListSearchQueriesResp) GetQueries() (v []*SearchQueryStat) {
+	return p.Queries
+}
+func (p *ListSearchQueriesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListSearchQueriesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListSearchQueriesResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListSearchQueriesResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListSearchQueriesResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListSearchQueriesResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListSearchQueriesResp) SetQueries(val []*SearchQueryStat) {
+	p.Queries = val
+}
+
+func (p *ListSearchQueriesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListSearchQueriesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSearchQueriesResp(%+v)", *p)
+}
+
+var fieldIDToName_ListSearchQueriesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "queries",
+}
+
+type ProductSaleDelta struct {
+	ProductId int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Quantity  int32 `thrift:"quantity,2" frugal:"2,default,i32" json:"quantity"`
+}
+
+func NewProductSaleDelta() *ProductSaleDelta {
+	return &ProductSaleDelta{}
+}
+
+func (p *ProductSaleDelta) InitDefault() {
+}
+
+func (p *ProductSaleDelta) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductSaleDelta) GetQuantity() (v int32) {
+	return p.Quantity
+}
+func (p *ProductSaleDelta) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductSaleDelta) SetQuantity(val int32) {
+	p.Quantity = val
+}
+
+func (p *ProductSaleDelta) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductSaleDelta(%+v)", *p)
+}
+
+var fieldIDToName_ProductSaleDelta = map[int16]string{
+	1: "productId",
+	2: "quantity",
+}
+
+type RecordProductSalesReq struct {
+	Sales []*ProductSaleDelta `thrift:"sales,1" frugal:"1,default,list<ProductSaleDelta>" json:"sales"`
+}
+
+func NewRecordProductSalesReq() *RecordProductSalesReq {
+	return &RecordProductSalesReq{}
+}
+
+func (p *RecordProductSalesReq) InitDefault() {
+}
+
+func (p *RecordProductSalesReq) GetSales() (v []*ProductSaleDelta) {
+	return p.Sales
+}
+func (p *RecordProductSalesReq) SetSales(val []*ProductSaleDelta) {
+	p.Sales = val
+}
+
+func (p *RecordProductSalesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecordProductSalesReq(%+v)", *p)
+}
+
+var fieldIDToName_RecordProductSalesReq = map[int16]string{
+	1: "sales",
+}
+
+type RecordProductSalesResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewRecordProductSalesResp() *RecordProductSalesResp {
+	return &RecordProductSalesResp{
+		Code: 0,
+	}
+}
+
+func (p *RecordProductSalesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *RecordProductSalesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *RecordProductSalesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var RecordProductSalesResp_Message_DEFAULT string
+
+func (p *RecordProductSalesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return RecordProductSalesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *RecordProductSalesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *RecordProductSalesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *RecordProductSalesResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *RecordProductSalesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *RecordProductSalesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecordProductSalesResp(%+v)", *p)
+}
+
+var fieldIDToName_RecordProductSalesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type GetTopProductsReq struct {
+	Window   string  `thrift:"window,1" frugal:"1,default,string" json:"window"`
+	Category *string `thrift:"category,2,optional" frugal:"2,optional,string" json:"category,omitempty"`
+	SortBy   *string `thrift:"sortBy,3,optional" frugal:"3,optional,string" json:"sortBy,omitempty"`
+	Limit    int32   `thrift:"limit,4" frugal:"4,default,i32" json:"limit"`
+	Locale   *string `thrift:"locale,5,optional" frugal:"5,optional,string" json:"locale,omitempty"`
+}
+
+func NewGetTopProductsReq() *GetTopProductsReq {
+	return &GetTopProductsReq{
+		Window: "24h",
+		Limit:  10,
+	}
+}
+
+func (p *GetTopProductsReq) InitDefault() {
+	p.Window = "24h"
+	p.Limit = 10
+}
+
+func (p *GetTopProductsReq) GetWindow() (v string) {
+	return p.Window
+}
+
+var GetTopProductsReq_Category_DEFAULT string
+
+func (p *GetTopProductsReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return GetTopProductsReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var GetTopProductsReq_SortBy_DEFAULT string
+
+func (p *GetTopProductsReq) GetSortBy() (v string) {
+	if !p.IsSetSortBy() {
+		return GetTopProductsReq_SortBy_DEFAULT
+	}
+	return *p.SortBy
+}
+
+func (p *GetTopProductsReq) GetLimit() (v int32) {
+	return p.Limit
+}
+
+var GetTopProductsReq_Locale_DEFAULT string
+
+func (p *GetTopProductsReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return GetTopProductsReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+func (p *GetTopProductsReq) SetWindow(val string) {
+	p.Window = val
+}
+func (p *GetTopProductsReq) SetCategory(val *string) {
+	p.Category = val
+}
+func (p *GetTopProductsReq) SetSortBy(val *string) {
+	p.SortBy = val
+}
+func (p *GetTopProductsReq) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *GetTopProductsReq) SetLocale(val *string) {
+	p.Locale = val
+}
+
+func (p *GetTopProductsReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *GetTopProductsReq) IsSetSortBy() bool {
+	return p.SortBy != nil
+}
+
+func (p *GetTopProductsReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *GetTopProductsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTopProductsReq(%+v)", *p)
+}
+
+var fieldIDToName_GetTopProductsReq = map[int16]string{
+	1: "window",
+	2: "category",
+	3: "sortBy",
+	4: "limit",
+	5: "locale",
+}
+
+type TopProduct struct {
+	Product    *SimpleProduct `thrift:"product,1" frugal:"1,default,SimpleProduct" json:"product"`
+	ViewCount  int64          `thrift:"viewCount,2" frugal:"2,default,i64" json:"viewCount"`
+	SalesCount int64          `thrift:"salesCount,3" frugal:"3,default,i64" json:"salesCount"`
+	Score      float64        `thrift:"score,4" frugal:"4,default,double" json:"score"`
+}
+
+func NewTopProduct() *TopProduct {
+	return &TopProduct{}
+}
+
+func (p *TopProduct) InitDefault() {
+}
+
+var TopProduct_Product_DEFAULT *SimpleProduct
+
+func (p *TopProduct) GetProduct() (v *SimpleProduct) {
+	if !p.IsSetProduct() {
+		return TopProduct_Product_DEFAULT
+	}
+	return p.Product
+}
+
+func (p *TopProduct) GetViewCount() (v int64) {
+	return p.ViewCount
+}
+
+func (p *TopProduct) GetSalesCount() (v int64) {
+	return p.SalesCount
+}
+
+func (p *TopProduct) GetScore() (v float64) {
+	return p.Score
+}
+func (p *TopProduct) SetProduct(val *SimpleProduct) {
+	p.Product = val
+}
+func (p *TopProduct) SetViewCount(val int64) {
+	p.ViewCount = val
+}
+func (p *TopProduct) SetSalesCount(val int64) {
+	p.SalesCount = val
+}
+func (p *TopProduct) SetScore(val float64) {
+	p.Score = val
+}
+
+func (p *TopProduct) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *TopProduct) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TopProduct(%+v)", *p)
+}
+
+var fieldIDToName_TopProduct = map[int16]string{
+	1: "product",
+	2: "viewCount",
+	3: "salesCount",
+	4: "score",
+}
+
+type GetTopProductsResp struct {
+	Success  bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Window   string        `thrift:"window,4" frugal:"4,default,string" json:"window"`
+	Products []*TopProduct `thrift:"products,5" frugal:"5,default,list<TopProduct>" json:"products"`
+}
+
+func NewGetTopProductsResp() *GetTopProductsResp {
+	return &GetTopProductsResp{
+		Code: 0,
+	}
+}
+
+func (p *GetTopProductsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetTopProductsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetTopProductsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetTopProductsResp_Message_DEFAULT string
+
+func (p *GetTopProductsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetTopProductsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *GetTopProductsResp) GetWindow() (v string) {
+	return p.Window
+}
+
+func (p *GetTopProductsResp) GetProducts() (v []*TopProduct) {
+	return p.Products
+}
+func (p *GetTopProductsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetTopProductsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetTopProductsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetTopProductsResp) SetWindow(val string) {
+	p.Window = val
+}
+func (p *GetTopProductsResp) SetProducts(val []*TopProduct) {
+	p.Products = val
+}
+
+func (p *GetTopProductsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetTopProductsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTopProductsResp(%+v)", *p)
+}
+
+var fieldIDToName_GetTopProductsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "window",
+	5: "products",
+}
+
+type UpdateBundleComponentsReq struct {
+	BundleId   int64              `thrift:"bundleId,1" frugal:"1,default,i64" json:"bundleId"`
+	Components []*BundleComponent `thrift:"components,2" frugal:"2,default,list<BundleComponent>" json:"components"`
+	Operator   *string            `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewUpdateBundleComponentsReq() *UpdateBundleComponentsReq {
+	return &UpdateBundleComponentsReq{}
+}
+
+func (p *UpdateBundleComponentsReq) InitDefault() {
+}
+
+func (p *UpdateBundleComponentsReq) GetBundleId() (v int64) {
+	return p.BundleId
+}
+
+func (p *UpdateBundleComponentsReq) GetComponents() (v []*BundleComponent) {
+	return p.Components
+}
+
+var UpdateBundleComponentsReq_Operator_DEFAULT string
+
+func (p *UpdateBundleComponentsReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return UpdateBundleComponentsReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *UpdateBundleComponentsReq) SetBundleId(val int64) {
+	p.BundleId = val
+}
+func (p *UpdateBundleComponentsReq) SetComponents(val []*BundleComponent) {
+	p.Components = val
+}
+func (p *UpdateBundleComponentsReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *UpdateBundleComponentsReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *UpdateBundleComponentsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateBundleComponentsReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateBundleComponentsReq = map[int16]string{
+	1: "bundleId",
+	2: "components",
+	3: "operator",
+}
+
+type UpdateBundleComponentsResp struct {
+	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+}
+
+func NewUpdateBundleComponentsResp() *UpdateBundleComponentsResp {
+	return &UpdateBundleComponentsResp{
+		Code: 0,
+	}
+}
+
+func (p *UpdateBundleComponentsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UpdateBundleComponentsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateBundleComponentsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UpdateBundleComponentsResp_Message_DEFAULT string
+
+func (p *UpdateBundleComponentsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UpdateBundleComponentsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var UpdateBundleComponentsResp_Product_DEFAULT *Product
+
+func (p *UpdateBundleComponentsResp) GetProduct() (v *Product) {
+	if !p.IsSetProduct() {
+		return UpdateBundleComponentsResp_Product_DEFAULT
+	}
+	return p.Product
+}
+func (p *UpdateBundleComponentsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UpdateBundleComponentsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UpdateBundleComponentsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *UpdateBundleComponentsResp) SetProduct(val *Product) {
+	p.Product = val
+}
+
+func (p *UpdateBundleComponentsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UpdateBundleComponentsResp) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *UpdateBundleComponentsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateBundleComponentsResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateBundleComponentsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "product",
+}
+
+type ProductTranslation struct {
+	ProductId   int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Locale      string  `thrift:"locale,2" frugal:"2,default,string" json:"locale"`
+	Name        string  `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	Description *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	UpdatedAt   int64   `thrift:"updatedAt,5" frugal:"5,default,i64" json:"updatedAt"`
+}
+
+func NewProductTranslation() *ProductTranslation {
+	return &ProductTranslation{}
+}
+
+func (p *ProductTranslation) InitDefault() {
+}
+
+func (p *ProductTranslation) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *ProductTranslation) GetLocale() (v string) {
+	return p.Locale
+}
+
+func (p *ProductTranslation) GetName() (v string) {
+	return p.Name
+}
+
+var ProductTranslation_Description_DEFAULT string
+
+func (p *ProductTranslation) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return ProductTranslation_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+func (p *ProductTranslation) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *ProductTranslation) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ProductTranslation) SetLocale(val string) {
+	p.Locale = val
+}
+func (p *ProductTranslation) SetName(val string) {
+	p.Name = val
+}
+func (p *ProductTranslation) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *ProductTranslation) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *ProductTranslation) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *ProductTranslation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductTranslation(%+v)", *p)
+}
+
+var fieldIDToName_ProductTranslation = map[int16]string{
+	1: "productId",
+	2: "locale",
+	3: "name",
+	4: "description",
+	5: "updatedAt",
+}
+
+type CategoryTranslation struct {
+	Category  string `thrift:"category,1" frugal:"1,default,string" json:"category"`
+	Locale    string `thrift:"locale,2" frugal:"2,default,string" json:"locale"`
+	Label     string `thrift:"label,3" frugal:"3,default,string" json:"label"`
+	UpdatedAt int64  `thrift:"updatedAt,4" frugal:"4,default,i64" json:"updatedAt"`
+}
+
+func NewCategoryTranslation() *CategoryTranslation {
+	return &CategoryTranslation{}
+}
+
+func (p *CategoryTranslation) InitDefault() {
+}
+
+func (p *CategoryTranslation) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *CategoryTranslation) GetLocale() (v string) {
+	return p.Locale
+}
+
+func (p *CategoryTranslation) GetLabel() (v string) {
+	return p.Label
+}
+
+func (p *CategoryTranslation) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *CategoryTranslation) SetCategory(val string) {
+	p.Category = val
+}
+func (p *CategoryTranslation) SetLocale(val string) {
+	p.Locale = val
+}
+func (p *CategoryTranslation) SetLabel(val string) {
+	p.Label = val
+}
+func (p *CategoryTranslation) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *CategoryTranslation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CategoryTranslation(%+v)", *p)
+}
+
+var fieldIDToName_CategoryTranslation = map[int16]string{
+	1: "category",
+	2: "locale",
+	3: "label",
+	4: "updatedAt",
+}
+
+type SetProductTranslationReq struct {
+	ProductId   int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Locale      string  `thrift:"locale,2" frugal:"2,default,string" json:"locale"`
+	Name        string  `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	Description *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	Operator    *string `thrift:"operator,5,optional" frugal:"5,optional,string" json:"operator,omitempty"`
+}
+
+func NewSetProductTranslationReq() *SetProductTranslationReq {
+	return &SetProductTranslationReq{}
+}
+
+func (p *SetProductTranslationReq) InitDefault() {
+}
+
+func (p *SetProductTranslationReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *SetProductTranslationReq) GetLocale() (v string) {
+	return p.Locale
+}
+
+func (p *SetProductTranslationReq) GetName() (v string) {
+	return p.Name
+}
+
+var SetProductTranslationReq_Description_DEFAULT string
+
+func (p *SetProductTranslationReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return SetProductTranslationReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var SetProductTranslationReq_Operator_DEFAULT string
+
+func (p *SetProductTranslationReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return SetProductTranslationReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *SetProductTranslationReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *SetProductTranslationReq) SetLocale(val string) {
+	p.Locale = val
+}
+func (p *SetProductTranslationReq) SetName(val string) {
+	p.Name = val
+}
+func (p *SetProductTranslationReq) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *SetProductTranslationReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *SetProductTranslationReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *SetProductTranslationReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *SetProductTranslationReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetProductTranslationReq(%+v)", *p)
+}
+
+var fieldIDToName_SetProductTranslationReq = map[int16]string{
+	1: "productId",
+	2: "locale",
+	3: "name",
+	4: "description",
+	5: "operator",
+}
+
+type SetProductTranslationResp struct {
+	Success     bool                `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32               `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     *string             `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Translation *ProductTranslation `thrift:"translation,4" frugal:"4,default,ProductTranslation" json:"translation"`
+}
+
+func NewSetProductTranslationResp() *SetProductTranslationResp {
+	return &SetProductTranslationResp{
+		Code: 0,
+	}
+}
+
+func (p *SetProductTranslationResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SetProductTranslationResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SetProductTranslationResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SetProductTranslationResp_Message_DEFAULT string
+
+func (p *SetProductTranslationResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SetProductTranslationResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var SetProductTranslationResp_Translation_DEFAULT *ProductTranslation
+
+func (p *SetProductTranslationResp) GetTranslation() (v *ProductTranslation) {
+	if !p.IsSetTranslation() {
+		return SetProductTranslationResp_Translation_DEFAULT
+	}
+	return p.Translation
+}
+func (p *SetProductTranslationResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SetProductTranslationResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SetProductTranslationResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SetProductTranslationResp) SetTranslation(val *ProductTranslation) {
+	p.Translation = val
+}
+
+func (p *SetProductTranslationResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SetProductTranslationResp) IsSetTranslation() bool {
+	return p.Translation != nil
+}
+
+func (p *SetProductTranslationResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetProductTranslationResp(%+v)", *p)
+}
+
+var fieldIDToName_SetProductTranslationResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "translation",
+}
+
+type DeleteProductTranslationReq struct {
+	ProductId int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Locale    string  `thrift:"locale,2" frugal:"2,default,string" json:"locale"`
+	Operator  *string `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewDeleteProductTranslationReq() *DeleteProductTranslationReq {
+	return &DeleteProductTranslationReq{}
+}
+
+func (p *DeleteProductTranslationReq) InitDefault() {
+}
+
+func (p *DeleteProductTranslationReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *DeleteProductTranslationReq) GetLocale() (v string) {
+	return p.Locale
+}
+
+var DeleteProductTranslationReq_Operator_DEFAULT string
+
+func (p *DeleteProductTranslationReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return DeleteProductTranslationReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *DeleteProductTranslationReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *DeleteProductTranslationReq) SetLocale(val string) {
+	p.Locale = val
+}
+func (p *DeleteProductTranslationReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *DeleteProductTranslationReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *DeleteProductTranslationReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteProductTranslationReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteProductTranslationReq = map[int16]string{
+	1: "productId",
+	2: "locale",
+	3: "operator",
+}
+
+type DeleteProductTranslationResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewDeleteProductTranslationResp() *DeleteProductTranslationResp {
+	return &DeleteProductTranslationResp{
+		Code: 0,
+	}
+}
+
+func (p *DeleteProductTranslationResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *DeleteProductTranslationResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DeleteProductTranslationResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var DeleteProductTranslationResp_Message_DEFAULT string
+
+func (p *DeleteProductTranslationResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return DeleteProductTranslationResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *DeleteProductTranslationResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DeleteProductTranslationResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *DeleteProductTranslationResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *DeleteProductTranslationResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *DeleteProductTranslationResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteProductTranslationResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteProductTranslationResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ListProductTranslationsReq struct {
+	ProductId int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+}
+
+func NewListProductTranslationsReq() *ListProductTranslationsReq {
+	return &ListProductTranslationsReq{}
+}
+
+func (p *ListProductTranslationsReq) InitDefault() {
+}
+
+func (p *ListProductTranslationsReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+func (p *ListProductTranslationsReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+
+func (p *ListProductTranslationsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductTranslationsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListProductTranslationsReq = map[int16]string{
+	1: "productId",
+}
+
+type ListProductTranslationsResp struct {
+	Success      bool                  `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code         int32                 `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message      *string               `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Translations []*ProductTranslation `thrift:"translations,4" frugal:"4,default,list<ProductTranslation>" json:"translations"`
+}
+
+func NewListProductTranslationsResp() *ListProductTranslationsResp {
+	return &ListProductTranslationsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListProductTranslationsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListProductTranslationsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListProductTranslationsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListProductTranslationsResp_Message_DEFAULT string
+
+func (p *ListProductTranslationsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListProductTranslationsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListProductTranslationsResp) GetTranslations() (v []*ProductTranslation) {
+	return p.Translations
+}
+func (p *ListProductTranslationsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListProductTranslationsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListProductTranslationsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListProductTranslationsResp) SetTranslations(val []*ProductTranslation) {
+	p.Translations = val
+}
+
+func (p *ListProductTranslationsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListProductTranslationsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListProductTranslationsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListProductTranslationsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "translations",
+}
+
+type SetCategoryTranslationReq struct {
+	Category string `thrift:"category,1" frugal:"1,default,string" json:"category"`
+	Locale   string `thrift:"locale,2" frugal:"2,default,string" json:"locale"`
+	Label    string `thrift:"label,3" frugal:"3,default,string" json:"label"`
+}
+
+func NewSetCategoryTranslationReq() *SetCategoryTranslationReq {
+	return &SetCategoryTranslationReq{}
+}
+
+func (p *SetCategoryTranslationReq) InitDefault() {
+}
+
+func (p *SetCategoryTranslationReq) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *SetCategoryTranslationReq) GetLocale() (v string) {
+	return p.Locale
+}
+
+func (p *SetCategoryTranslationReq) GetLabel() (v string) {
+	return p.Label
+}
+func (p *SetCategoryTranslationReq) SetCategory(val string) {
+	p.Category = val
+}
+func (p *SetCategoryTranslationReq) SetLocale(val string) {
+	p.Locale = val
+}
+func (p *SetCategoryTranslationReq) SetLabel(val string) {
+	p.Label = val
+}
+
+func (p *SetCategoryTranslationReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetCategoryTranslationReq(%+v)", *p)
+}
+
+var fieldIDToName_SetCategoryTranslationReq = map[int16]string{
+	1: "category",
+	2: "locale",
+	3: "label",
+}
+
+type SetCategoryTranslationResp struct {
+	Success     bool                 `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code        int32                `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message     *string              `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Translation *CategoryTranslation `thrift:"translation,4" frugal:"4,default,CategoryTranslation" json:"translation"`
+}
+
+func NewSetCategoryTranslationResp() *SetCategoryTranslationResp {
+	return &SetCategoryTranslationResp{
+		Code: 0,
+	}
+}
+
+func (p *SetCategoryTranslationResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SetCategoryTranslationResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SetCategoryTranslationResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SetCategoryTranslationResp_Message_DEFAULT string
+
+func (p *SetCategoryTranslationResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SetCategoryTranslationResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var SetCategoryTranslationResp_Translation_DEFAULT *CategoryTranslation
+
+func (p *SetCategoryTranslationResp) GetTranslation() (v *CategoryTranslation) {
+	if !p.IsSetTranslation() {
+		return SetCategoryTranslationResp_Translation_DEFAULT
+	}
+	return p.Translation
+}
+func (p *SetCategoryTranslationResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SetCategoryTranslationResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SetCategoryTranslationResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SetCategoryTranslationResp) SetTranslation(val *CategoryTranslation) {
+	p.Translation = val
+}
+
+func (p *SetCategoryTranslationResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SetCategoryTranslationResp) IsSetTranslation() bool {
+	return p.Translation != nil
+}
+
+func (p *SetCategoryTranslationResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetCategoryTranslationResp(%+v)", *p)
+}
+
+var fieldIDToName_SetCategoryTranslationResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "translation",
+}
+
+type DeleteCategoryTranslationReq struct {
+	Category string `thrift:"category,1" frugal:"1,default,string" json:"category"`
+	Locale   string `thrift:"locale,2" frugal:"2,default,string" json:"locale"`
+}
+
+func NewDeleteCategoryTranslationReq() *DeleteCategoryTranslationReq {
+	return &DeleteCategoryTranslationReq{}
+}
+
+func (p *DeleteCategoryTranslationReq) InitDefault() {
+}
+
+func (p *DeleteCategoryTranslationReq) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *DeleteCategoryTranslationReq) GetLocale() (v string) {
+	return p.Locale
+}
+func (p *DeleteCategoryTranslationReq) SetCategory(val string) {
+	p.Category = val
+}
+func (p *DeleteCategoryTranslationReq) SetLocale(val string) {
+	p.Locale = val
+}
+
+func (p *DeleteCategoryTranslationReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCategoryTranslationReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCategoryTranslationReq = map[int16]string{
+	1: "category",
+	2: "locale",
+}
+
+type DeleteCategoryTranslationResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewDeleteCategoryTranslationResp() *DeleteCategoryTranslationResp {
+	return &DeleteCategoryTranslationResp{
+		Code: 0,
+	}
+}
+
+func (p *DeleteCategoryTranslationResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *DeleteCategoryTranslationResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DeleteCategoryTranslationResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var DeleteCategoryTranslationResp_Message_DEFAULT string
+
+func (p *DeleteCategoryTranslationResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return DeleteCategoryTranslationResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *DeleteCategoryTranslationResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *DeleteCategoryTranslationResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *DeleteCategoryTranslationResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *DeleteCategoryTranslationResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *DeleteCategoryTranslationResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCategoryTranslationResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCategoryTranslationResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ListCategoryTranslationsReq struct {
+	Category *string `thrift:"category,1,optional" frugal:"1,optional,string" json:"category,omitempty"`
+	Locale   *string `thrift:"locale,2,optional" frugal:"2,optional,string" json:"locale,omitempty"`
+}
+
+func NewListCategoryTranslationsReq() *ListCategoryTranslationsReq {
+	return &ListCategoryTranslationsReq{}
+}
+
+func (p *ListCategoryTranslationsReq) InitDefault() {
+}
+
+var ListCategoryTranslationsReq_Category_DEFAULT string
+
+func (p *ListCategoryTranslationsReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return ListCategoryTranslationsReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var ListCategoryTranslationsReq_Locale_DEFAULT string
+
+func (p *ListCategoryTranslationsReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return ListCategoryTranslationsReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+func (p *ListCategoryTranslationsReq) SetCategory(val *string) {
+	p.Category = val
+}
+func (p *ListCategoryTranslationsReq) SetLocale(val *string) {
+	p.Locale = val
+}
+
+func (p *ListCategoryTranslationsReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *ListCategoryTranslationsReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *ListCategoryTranslationsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCategoryTranslationsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListCategoryTranslationsReq = map[int16]string{
+	1: "category",
+	2: "locale",
+}
+
+type ListCategoryTranslationsResp struct {
+	Success      bool                   `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code         int32                  `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message      *string                `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Translations []*CategoryTranslation `thrift:"translations,4" frugal:"4,default,list<CategoryTranslation>" json:"translations"`
+}
+
+func NewListCategoryTranslationsResp() *ListCategoryTranslationsResp {
+	return &ListCategoryTranslationsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListCategoryTranslationsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListCategoryTranslationsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListCategoryTranslationsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListCategoryTranslationsResp_Message_DEFAULT string
+
+func (p *ListCategoryTranslationsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListCategoryTranslationsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListCategoryTranslationsResp) GetTranslations() (v []*CategoryTranslation) {
+	return p.Translations
+}
+func (p *ListCategoryTranslationsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListCategoryTranslationsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListCategoryTranslationsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListCategoryTranslationsResp) SetTranslations(val []*CategoryTranslation) {
+	p.Translations = val
+}
+
+func (p *ListCategoryTranslationsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListCategoryTranslationsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCategoryTranslationsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListCategoryTranslationsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "translations",
+}
+
+type Favorite struct {
+	ProductId    int64          `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	SavedPrice   float64        `thrift:"savedPrice,2" frugal:"2,default,double" json:"savedPrice"`
+	CreatedAt    int64          `thrift:"createdAt,3" frugal:"3,default,i64" json:"createdAt"`
+	Product      *SimpleProduct `thrift:"product,4,optional" frugal:"4,optional,SimpleProduct" json:"product,omitempty"`
+	PriceDropped bool           `thrift:"priceDropped,5" frugal:"5,default,bool" json:"priceDropped"`
+}
+
+func NewFavorite() *Favorite {
+	return &Favorite{}
+}
+
+func (p *Favorite) InitDefault() {
+}
+
+func (p *Favorite) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *Favorite) GetSavedPrice() (v float64) {
+	return p.SavedPrice
+}
+
+func (p *Favorite) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var Favorite_Product_DEFAULT *SimpleProduct
+
+func (p *Favorite) GetProduct() (v *SimpleProduct) {
+	if !p.IsSetProduct() {
+		return Favorite_Product_DEFAULT
+	}
+	return p.Product
+}
+
+func (p *Favorite) GetPriceDropped() (v bool) {
+	return p.PriceDropped
+}
+func (p *Favorite) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *Favorite) SetSavedPrice(val float64) {
+	p.SavedPrice = val
+}
+func (p *Favorite) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Favorite) SetProduct(val *SimpleProduct) {
+	p.Product = val
+}
+func (p *Favorite) SetPriceDropped(val bool) {
+	p.PriceDropped = val
+}
+
+func (p *Favorite) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *Favorite) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Favorite(%+v)", *p)
+}
+
+var fieldIDToName_Favorite = map[int16]string{
+	1: "productId",
+	2: "savedPrice",
+	3: "createdAt",
+	4: "product",
+	5: "priceDropped",
+}
+
+type AddFavoriteReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ProductId int64 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+}
+
+func NewAddFavoriteReq() *AddFavoriteReq {
+	return &AddFavoriteReq{}
+}
+
+func (p *AddFavoriteReq) InitDefault() {
+}
+
+func (p *AddFavoriteReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *AddFavoriteReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+func (p *AddFavoriteReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *AddFavoriteReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+
+func (p *AddFavoriteReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddFavoriteReq(%+v)", *p)
+}
+
+var fieldIDToName_AddFavoriteReq = map[int16]string{
+	1: "userId",
+	2: "productId",
+}
+
+type AddFavoriteResp struct {
+	Success  bool      `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32     `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string   `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Favorite *Favorite `thrift:"favorite,4" frugal:"4,default,Favorite" json:"favorite"`
+}
+
+func NewAddFavoriteResp() *AddFavoriteResp {
+	return &AddFavoriteResp{
+		Code: 0,
+	}
+}
+
+func (p *AddFavoriteResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *AddFavoriteResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *AddFavoriteResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var AddFavoriteResp_Message_DEFAULT string
+
+func (p *AddFavoriteResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return AddFavoriteResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var AddFavoriteResp_Favorite_DEFAULT *Favorite
+
+func (p *AddFavoriteResp) GetFavorite() (v *Favorite) {
+	if !p.IsSetFavorite() {
+		return AddFavoriteResp_Favorite_DEFAULT
+	}
+	return p.Favorite
+}
+func (p *AddFavoriteResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *AddFavoriteResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *AddFavoriteResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *AddFavoriteResp) SetFavorite(val *Favorite) {
+	p.Favorite = val
+}
+
+func (p *AddFavoriteResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *AddFavoriteResp) IsSetFavorite() bool {
+	return p.Favorite != nil
+}
+
+func (p *AddFavoriteResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddFavoriteResp(%+v)", *p)
+}
+
+var fieldIDToName_AddFavoriteResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "favorite",
+}
+
+type RemoveFavoriteReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ProductId int64 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+}
+
+func NewRemoveFavoriteReq() *RemoveFavoriteReq {
+	return &RemoveFavoriteReq{}
+}
+
+func (p *RemoveFavoriteReq) InitDefault() {
+}
+
+func (p *RemoveFavoriteReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RemoveFavoriteReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+func (p *RemoveFavoriteReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RemoveFavoriteReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+
+func (p *RemoveFavoriteReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveFavoriteReq(%+v)", *p)
+}
+
+var fieldIDToName_RemoveFavoriteReq = map[int16]string{
+	1: "userId",
+	2: "productId",
+}
+
+type RemoveFavoriteResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewRemoveFavoriteResp() *RemoveFavoriteResp {
+	return &RemoveFavoriteResp{
+		Code: 0,
+	}
+}
+
+func (p *RemoveFavoriteResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *RemoveFavoriteResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *RemoveFavoriteResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var RemoveFavoriteResp_Message_DEFAULT string
+
+func (p *RemoveFavoriteResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return RemoveFavoriteResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *RemoveFavoriteResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *RemoveFavoriteResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *RemoveFavoriteResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *RemoveFavoriteResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *RemoveFavoriteResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveFavoriteResp(%+v)", *p)
+}
+
+var fieldIDToName_RemoveFavoriteResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ListFavoritesReq struct {
+	UserId           int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Page             int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize         int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	PriceDroppedOnly *bool   `thrift:"priceDroppedOnly,4,optional" frugal:"4,optional,bool" json:"priceDroppedOnly,omitempty"`
+	Locale           *string `thrift:"locale,5,optional" frugal:"5,optional,string" json:"locale,omitempty"`
+}
+
+func NewListFavoritesReq() *ListFavoritesReq {
+	return &ListFavoritesReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListFavoritesReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+func (p *ListFavoritesReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ListFavoritesReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListFavoritesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var ListFavoritesReq_PriceDroppedOnly_DEFAULT bool
+
+func (p *ListFavoritesReq) GetPriceDroppedOnly() (v bool) {
+	if !p.IsSetPriceDroppedOnly() {
+		return ListFavoritesReq_PriceDroppedOnly_DEFAULT
+	}
+	return *p.PriceDroppedOnly
+}
+
+var ListFavoritesReq_Locale_DEFAULT string
+
+func (p *ListFavoritesReq) GetLocale() (v string) {
+	if !p.IsSetLocale() {
+		return ListFavoritesReq_Locale_DEFAULT
+	}
+	return *p.Locale
+}
+func (p *ListFavoritesReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ListFavoritesReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListFavoritesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListFavoritesReq) SetPriceDroppedOnly(val *bool) {
+	p.PriceDroppedOnly = val
+}
+func (p *ListFavoritesReq) SetLocale(val *string) {
+	p.Locale = val
+}
+
+func (p *ListFavoritesReq) IsSetPriceDroppedOnly() bool {
+	return p.PriceDroppedOnly != nil
+}
+
+func (p *ListFavoritesReq) IsSetLocale() bool {
+	return p.Locale != nil
+}
+
+func (p *ListFavoritesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFavoritesReq(%+v)", *p)
+}
+
+var fieldIDToName_ListFavoritesReq = map[int16]string{
+	1: "userId",
+	2: "page",
+	3: "pageSize",
+	4: "priceDroppedOnly",
+	5: "locale",
+}
+
+type ListFavoritesResp struct {
+	Success   bool        `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code      int32       `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message   *string     `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total     int32       `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page      int32       `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize  int32       `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Favorites []*Favorite `thrift:"favorites,7" frugal:"7,default,list<Favorite>" json:"favorites"`
+}
+
+func NewListFavoritesResp() *ListFavoritesResp {
+	return &ListFavoritesResp{
+		Code: 0,
+	}
+}
+
+func (p *ListFavoritesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListFavoritesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListFavoritesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListFavoritesResp_Message_DEFAULT string
+
+func (p *ListFavoritesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListFavoritesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListFavoritesResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListFavoritesResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListFavoritesResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListFavoritesResp) GetFavorites() (v []*Favorite) {
+	return p.Favorites
+}
+func (p *ListFavoritesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListFavoritesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListFavoritesResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListFavoritesResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListFavoritesResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListFavoritesResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListFavoritesResp) SetFavorites(val []*Favorite) {
+	p.Favorites = val
+}
+
+func (p *ListFavoritesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListFavoritesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFavoritesResp(%+v)", *p)
+}
+
+var fieldIDToName_ListFavoritesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "favorites",
+}
+
+type RestockSubscription struct {
+	ProductId  int64                     `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Status     RestockSubscriptionStatus `thrift:"status,2" frugal:"2,default,RestockSubscriptionStatus" json:"status"`
+	CreatedAt  int64                     `thrift:"createdAt,3" frugal:"3,default,i64" json:"createdAt"`
+	NotifiedAt int64                     `thrift:"notifiedAt,4" frugal:"4,default,i64" json:"notifiedAt"`
+	Product    *SimpleProduct            `thrift:"product,5,optional" frugal:"5,optional,SimpleProduct" json:"product,omitempty"`
+}
+
+func NewRestockSubscription() *RestockSubscription {
+	return &RestockSubscription{}
+}
+
+func (p *RestockSubscription) InitDefault() {
+}
+
+func (p *RestockSubscription) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *RestockSubscription) GetStatus() (v RestockSubscriptionStatus) {
+	return p.Status
+}
+
+func (p *RestockSubscription) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *RestockSubscription) GetNotifiedAt() (v int64) {
+	return p.NotifiedAt
+}
+
+var RestockSubscription_Product_DEFAULT *SimpleProduct
+
+func (p *RestockSubscription) GetProduct() (v *SimpleProduct) {
+	if !p.IsSetProduct() {
+		return RestockSubscription_Product_DEFAULT
+	}
+	return p.Product
+}
+func (p *RestockSubscription) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *RestockSubscription) SetStatus(val RestockSubscriptionStatus) {
+	p.Status = val
+}
+func (p *RestockSubscription) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *RestockSubscription) SetNotifiedAt(val int64) {
+	p.NotifiedAt = val
+}
+func (p *RestockSubscription) SetProduct(val *SimpleProduct) {
+	p.Product = val
+}
+
+func (p *RestockSubscription) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *RestockSubscription) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestockSubscription(%+v)", *p)
+}
+
+var fieldIDToName_RestockSubscription = map[int16]string{
+	1: "productId",
+	2: "status",
+	3: "createdAt",
+	4: "notifiedAt",
+	5: "product",
+}
+
+type SubscribeRestockReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ProductId int64 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+}
+
+func NewSubscribeRestockReq() *SubscribeRestockReq {
+	return &SubscribeRestockReq{}
+}
+
+func (p *SubscribeRestockReq) InitDefault() {
+}
+
+func (p *SubscribeRestockReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SubscribeRestockReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+func (p *SubscribeRestockReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SubscribeRestockReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+
+func (p *SubscribeRestockReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubscribeRestockReq(%+v)", *p)
+}
+
+var fieldIDToName_SubscribeRestockReq = map[int16]string{
+	1: "userId",
+	2: "productId",
+}
+
+type SubscribeRestockResp struct {
+	Success      bool                 `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code         int32                `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message      *string              `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Subscription *RestockSubscription `thrift:"subscription,4" frugal:"4,default,RestockSubscription" json:"subscription"`
+}
+
+func NewSubscribeRestockResp() *SubscribeRestockResp {
+	return &SubscribeRestockResp{
+		Code: 0,
+	}
+}
+
+func (p *SubscribeRestockResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SubscribeRestockResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SubscribeRestockResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SubscribeRestockResp_Message_DEFAULT string
+
+func (p *SubscribeRestockResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SubscribeRestockResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var SubscribeRestockResp_Subscription_DEFAULT *RestockSubscription
+
+func (p *SubscribeRestockResp) GetSubscription() (v *RestockSubscription) {
+	if !p.IsSetSubscription() {
+		return SubscribeRestockResp_Subscription_DEFAULT
+	}
+	return p.Subscription
+}
+func (p *SubscribeRestockResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SubscribeRestockResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SubscribeRestockResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SubscribeRestockResp) SetSubscription(val *RestockSubscription) {
+	p.Subscription = val
+}
+
+func (p *SubscribeRestockResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SubscribeRestockResp) IsSetSubscription() bool {
+	return p.Subscription != nil
+}
+
+func (p *SubscribeRestockResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubscribeRestockResp(%+v)", *p)
+}
+
+var fieldIDToName_SubscribeRestockResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "subscription",
+}
+
+type UnsubscribeRestockReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ProductId int64 `thrift:"productId,2" frugal:"2,default,i64" json:"productId"`
+}
+
+func NewUnsubscribeRestockReq() *UnsubscribeRestockReq {
+	return &UnsubscribeRestockReq{}
+}
+
+func (p *UnsubscribeRestockReq) InitDefault() {
+}
+
+func (p *UnsubscribeRestockReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UnsubscribeRestockReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+func (p *UnsubscribeRestockReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UnsubscribeRestockReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+
+func (p *UnsubscribeRestockReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnsubscribeRestockReq(%+v)", *p)
+}
+
+var fieldIDToName_UnsubscribeRestockReq = map[int16]string{
+	1: "userId",
+	2: "productId",
+}
+
+type UnsubscribeRestockResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewUnsubscribeRestockResp() *UnsubscribeRestockResp {
+	return &UnsubscribeRestockResp{
+		Code: 0,
+	}
+}
+
+func (p *UnsubscribeRestockResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *UnsubscribeRestockResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UnsubscribeRestockResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var UnsubscribeRestockResp_Message_DEFAULT string
+
+func (p *UnsubscribeRestockResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return UnsubscribeRestockResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *UnsubscribeRestockResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *UnsubscribeRestockResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *UnsubscribeRestockResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *UnsubscribeRestockResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *UnsubscribeRestockResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnsubscribeRestockResp(%+v)", *p)
+}
+
+var fieldIDToName_UnsubscribeRestockResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ListRestockSubscriptionsReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Page     int32 `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize int32 `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+}
+
+func NewListRestockSubscriptionsReq() *ListRestockSubscriptionsReq {
+	return &ListRestockSubscriptionsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListRestockSubscriptionsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+func (p *ListRestockSubscriptionsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ListRestockSubscriptionsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListRestockSubscriptionsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListRestockSubscriptionsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ListRestockSubscriptionsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListRestockSubscriptionsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListRestockSubscriptionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListRestockSubscriptionsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListRestockSubscriptionsReq = map[int16]string{
+	1: "userId",
+	2: "page",
+	3: "pageSize",
+}
+
+type ListRestockSubscriptionsResp struct {
+	Success       bool                   `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code          int32                  `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message       *string                `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total         int32                  `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page          int32                  `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize      int32                  `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Subscriptions []*RestockSubscription `thrift:"subscriptions,7" frugal:"7,default,list<RestockSubscription>" json:"subscriptions"`
+}
+
+func NewListRestockSubscriptionsResp() *ListRestockSubscriptionsResp {
+	return &ListRestockSubscriptionsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListRestockSubscriptionsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListRestockSubscriptionsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListRestockSubscriptionsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListRestockSubscriptionsResp_Message_DEFAULT string
+
+func (p *ListRestockSubscriptionsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListRestockSubscriptionsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListRestockSubscriptionsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListRestockSubscriptionsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListRestockSubscriptionsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListRestockSubscriptionsResp) GetSubscriptions() (v []*RestockSubscription) {
+	return p.Subscriptions
+}
+func (p *ListRestockSubscriptionsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListRestockSubscriptionsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListRestockSubscriptionsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListRestockSubscriptionsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListRestockSubscriptionsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListRestockSubscriptionsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListRestockSubscriptionsResp) SetSubscriptions(val []*RestockSubscription) {
+	p.Subscriptions = val
+}
+
+func (p *ListRestockSubscriptionsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListRestockSubscriptionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListRestockSubscriptionsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListRestockSubscriptionsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "subscriptions",
+}
+
+type SetProductPriceTiersReq struct {
+	ProductId int64        `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Tiers     []*PriceTier `thrift:"tiers,2" frugal:"2,default,list<PriceTier>" json:"tiers"`
+	Operator  *string      `thrift:"operator,3,optional" frugal:"3,optional,string" json:"operator,omitempty"`
+}
+
+func NewSetProductPriceTiersReq() *SetProductPriceTiersReq {
+	return &SetProductPriceTiersReq{}
+}
+
+func (p *SetProductPriceTiersReq) InitDefault() {
+}
+
+func (p *SetProductPriceTiersReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *SetProductPriceTiersReq) GetTiers() (v []*PriceTier) {
+	return p.Tiers
+}
+
+var SetProductPriceTiersReq_Operator_DEFAULT string
+
+func (p *SetProductPriceTiersReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return SetProductPriceTiersReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *SetProductPriceTiersReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *SetProductPriceTiersReq) SetTiers(val []*PriceTier) {
+	p.Tiers = val
+}
+func (p *SetProductPriceTiersReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *SetProductPriceTiersReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *SetProductPriceTiersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetProductPriceTiersReq(%+v)", *p)
+}
+
+var fieldIDToName_SetProductPriceTiersReq = map[int16]string{
+	1: "productId",
+	2: "tiers",
+	3: "operator",
+}
+
+type SetProductPriceTiersResp struct {
+	Success bool         `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32        `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string      `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Tiers   []*PriceTier `thrift:"tiers,4" frugal:"4,default,list<PriceTier>" json:"tiers"`
+}
+
+func NewSetProductPriceTiersResp() *SetProductPriceTiersResp {
+	return &SetProductPriceTiersResp{
+		Code: 0,
+	}
+}
+
+func (p *SetProductPriceTiersResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SetProductPriceTiersResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SetProductPriceTiersResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SetProductPriceTiersResp_Message_DEFAULT string
+
+func (p *SetProductPriceTiersResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SetProductPriceTiersResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *SetProductPriceTiersResp) GetTiers() (v []*PriceTier) {
+	return p.Tiers
+}
+func (p *SetProductPriceTiersResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SetProductPriceTiersResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SetProductPriceTiersResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SetProductPriceTiersResp) SetTiers(val []*PriceTier) {
+	p.Tiers = val
+}
+
+func (p *SetProductPriceTiersResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SetProductPriceTiersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetProductPriceTiersResp(%+v)", *p)
+}
+
+var fieldIDToName_SetProductPriceTiersResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "tiers",
+}
+
+type GetProductPriceTiersReq struct {
+	ProductId int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+}
+
+func NewGetProductPriceTiersReq() *GetProductPriceTiersReq {
+	return &GetProductPriceTiersReq{}
+}
+
+func (p *GetProductPriceTiersReq) InitDefault() {
+}
+
+func (p *GetProductPriceTiersReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+func (p *GetProductPriceTiersReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+
+func (p *GetProductPriceTiersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetProductPriceTiersReq(%+v)", *p)
+}
+
+var fieldIDToName_GetProductPriceTiersReq = map[int16]string{
+	1: "productId",
+}
+
+type GetProductPriceTiersResp struct {
+	Success bool         `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32        `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string      `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Tiers   []*PriceTier `thrift:"tiers,4" frugal:"4,default,list<PriceTier>" json:"tiers"`
+}
+
+func NewGetProductPriceTiersResp() *GetProductPriceTiersResp {
+	return &GetProductPriceTiersResp{
+		Code: 0,
+	}
+}
+
+func (p *GetProductPriceTiersResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *GetProductPriceTiersResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetProductPriceTiersResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var GetProductPriceTiersResp_Message_DEFAULT string
+
+func (p *GetProductPriceTiersResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return GetProductPriceTiersResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *GetProductPriceTiersResp) GetTiers() (v []*PriceTier) {
+	return p.Tiers
+}
+func (p *GetProductPriceTiersResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *GetProductPriceTiersResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetProductPriceTiersResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *GetProductPriceTiersResp) SetTiers(val []*PriceTier) {
+	p.Tiers = val
+}
+
+func (p *GetProductPriceTiersResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetProductPriceTiersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetProductPriceTiersResp(%+v)", *p)
+}
+
+var fieldIDToName_GetProductPriceTiersResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "tiers",
+}
+
+type UserPriceGroup struct {
+	UserId    int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Group     string `thrift:"group,2" frugal:"2,default,string" json:"group"`
+	UpdatedAt int64  `thrift:"updatedAt,3" frugal:"3,default,i64" json:"updatedAt"`
+}
+
+func NewUserPriceGroup() *UserPriceGroup {
+	return &UserPriceGroup{}
+}
+
+func (p *UserPriceGroup) InitDefault() {
+}
+
+func (p *UserPriceGroup) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UserPriceGroup) GetGroup() (v string) {
+	return p.Group
+}
+
+func (p *UserPriceGroup) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *UserPriceGroup) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UserPriceGroup) SetGroup(val string) {
+	p.Group = val
+}
+func (p *UserPriceGroup) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *UserPriceGroup) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserPriceGroup(%+v)", *p)
+}
+
+var fieldIDToName_UserPriceGroup = map[int16]string{
+	1: "userId",
+	2: "group",
+	3: "updatedAt",
+}
+
+type SetUserPriceGroupReq struct {
+	UserId int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Group  string `thrift:"group,2" frugal:"2,default,string" json:"group"`
+}
+
+func NewSetUserPriceGroupReq() *SetUserPriceGroupReq {
+	return &SetUserPriceGroupReq{}
+}
+
+func (p *SetUserPriceGroupReq) InitDefault() {
+}
+
+func (p *SetUserPriceGroupReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SetUserPriceGroupReq) GetGroup() (v string) {
+	return p.Group
+}
+func (p *SetUserPriceGroupReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SetUserPriceGroupReq) SetGroup(val string) {
+	p.Group = val
+}
+
+func (p *SetUserPriceGroupReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetUserPriceGroupReq(%+v)", *p)
+}
+
+var fieldIDToName_SetUserPriceGroupReq = map[int16]string{
+	1: "userId",
+	2: "group",
+}
+
+type SetUserPriceGroupResp struct {
+	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+}
+
+func NewSetUserPriceGroupResp() *SetUserPriceGroupResp {
+	return &SetUserPriceGroupResp{
+		Code: 0,
+	}
+}
+
+func (p *SetUserPriceGroupResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SetUserPriceGroupResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SetUserPriceGroupResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SetUserPriceGroupResp_Message_DEFAULT string
+
+func (p *SetUserPriceGroupResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SetUserPriceGroupResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *SetUserPriceGroupResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SetUserPriceGroupResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SetUserPriceGroupResp) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *SetUserPriceGroupResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SetUserPriceGroupResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetUserPriceGroupResp(%+v)", *p)
+}
+
+var fieldIDToName_SetUserPriceGroupResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+}
+
+type ListUserPriceGroupsReq struct {
+	Group    *string `thrift:"group,1,optional" frugal:"1,optional,string" json:"group,omitempty"`
+	Page     int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+}
+
+func NewListUserPriceGroupsReq() *ListUserPriceGroupsReq {
+	return &ListUserPriceGroupsReq{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *ListUserPriceGroupsReq) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+var ListUserPriceGroupsReq_Group_DEFAULT string
+
+func (p *ListUserPriceGroupsReq) GetGroup() (v string) {
+	if !p.IsSetGroup() {
+		return ListUserPriceGroupsReq_Group_DEFAULT
+	}
+	return *p.Group
+}
+
+func (p *ListUserPriceGroupsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListUserPriceGroupsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListUserPriceGroupsReq) SetGroup(val *string) {
+	p.Group = val
+}
+func (p *ListUserPriceGroupsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListUserPriceGroupsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListUserPriceGroupsReq) IsSetGroup() bool {
+	return p.Group != nil
+}
+
+func (p *ListUserPriceGroupsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListUserPriceGroupsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListUserPriceGroupsReq = map[int16]string{
+	1: "group",
+	2: "page",
+	3: "pageSize",
+}
+
+type ListUserPriceGroupsResp struct {
+	Success  bool              `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code     int32             `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message  *string           `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Total    int32             `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	Page     int32             `thrift:"page,5" frugal:"5,default,i32" json:"page"`
+	PageSize int32             `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+	Members  []*UserPriceGroup `thrift:"members,7" frugal:"7,default,list<UserPriceGroup>" json:"members"`
+}
+
+func NewListUserPriceGroupsResp() *ListUserPriceGroupsResp {
+	return &ListUserPriceGroupsResp{
+		Code: 0,
+	}
+}
+
+func (p *ListUserPriceGroupsResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *ListUserPriceGroupsResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListUserPriceGroupsResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var ListUserPriceGroupsResp_Message_DEFAULT string
+
+func (p *ListUserPriceGroupsResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ListUserPriceGroupsResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *ListUserPriceGroupsResp) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ListUserPriceGroupsResp) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListUserPriceGroupsResp) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListUserPriceGroupsResp) GetMembers() (v []*UserPriceGroup) {
+	return p.Members
+}
+func (p *ListUserPriceGroupsResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *ListUserPriceGroupsResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *ListUserPriceGroupsResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *ListUserPriceGroupsResp) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ListUserPriceGroupsResp) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListUserPriceGroupsResp) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListUserPriceGroupsResp) SetMembers(val []*UserPriceGroup) {
+	p.Members = val
+}
+
+func (p *ListUserPriceGroupsResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ListUserPriceGroupsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListUserPriceGroupsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListUserPriceGroupsResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "total",
+	5: "page",
+	6: "pageSize",
+	7: "members",
+}
+
+type PriceQuoteItem struct {
+	ProductId int64 `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Quantity  int32 `thrift:"quantity,2" frugal:"2,default,i32" json:"quantity"`
+}
+
+func NewPriceQuoteItem() *PriceQuoteItem {
+	return &PriceQuoteItem{}
+}
+
+func (p *PriceQuoteItem) InitDefault() {
+}
+
+func (p *PriceQuoteItem) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *PriceQuoteItem) GetQuantity() (v int32) {
+	return p.Quantity
+}
+func (p *PriceQuoteItem) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *PriceQuoteItem) SetQuantity(val int32) {
+	p.Quantity = val
+}
+
+func (p *PriceQuoteItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PriceQuoteItem(%+v)", *p)
+}
+
+var fieldIDToName_PriceQuoteItem = map[int16]string{
+	1: "productId",
+	2: "quantity",
+}
+
+type PriceQuote struct {
+	ProductId   int64      `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Quantity    int32      `thrift:"quantity,2" frugal:"2,default,i32" json:"quantity"`
+	BasePrice   float64    `thrift:"basePrice,3" frugal:"3,default,double" json:"basePrice"`
+	UnitPrice   float64    `thrift:"unitPrice,4" frugal:"4,default,double" json:"unitPrice"`
+	AppliedTier *PriceTier `thrift:"appliedTier,5,optional" frugal:"5,optional,PriceTier" json:"appliedTier,omitempty"`
+}
+
+func NewPriceQuote() *PriceQuote {
+	return &PriceQuote{}
+}
+
+func (p *PriceQuote) InitDefault() {
+}
+
+func (p *PriceQuote) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *PriceQuote) GetQuantity() (v int32) {
+	return p.Quantity
+}
+
+func (p *PriceQuote) GetBasePrice() (v float64) {
+	return p.BasePrice
+}
+
+func (p *PriceQuote) GetUnitPrice() (v float64) {
+	return p.UnitPrice
+}
+
+var PriceQuote_AppliedTier_DEFAULT *PriceTier
+
+func (p *PriceQuote) GetAppliedTier() (v *PriceTier) {
+	if !p.IsSetAppliedTier() {
+		return PriceQuote_AppliedTier_DEFAULT
+	}
+	return p.AppliedTier
+}
+func (p *PriceQuote) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *PriceQuote) SetQuantity(val int32) {
+	p.Quantity = val
+}
+func (p *PriceQuote) SetBasePrice(val float64) {
+	p.BasePrice = val
+}
+func (p *PriceQuote) SetUnitPrice(val float64) {
+	p.UnitPrice = val
+}
+func (p *PriceQuote) SetAppliedTier(val *PriceTier) {
+	p.AppliedTier = val
+}
+
+func (p *PriceQuote) IsSetAppliedTier() bool {
+	return p.AppliedTier != nil
+}
+
+func (p *PriceQuote) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PriceQuote(%+v)", *p)
+}
+
+var fieldIDToName_PriceQuote = map[int16]string{
+	1: "productId",
+	2: "quantity",
+	3: "basePrice",
+	4: "unitPrice",
+	5: "appliedTier",
+}
+
+type QuotePricesReq struct {
+	UserId int64             `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Items  []*PriceQuoteItem `thrift:"items,2" frugal:"2,default,list<PriceQuoteItem>" json:"items"`
+}
+
+func NewQuotePricesReq() *QuotePricesReq {
+	return &QuotePricesReq{}
+}
+
+func (p *QuotePricesReq) InitDefault() {
+}
+
+func (p *QuotePricesReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *QuotePricesReq) GetItems() (v []*PriceQuoteItem) {
+	return p.Items
+}
+func (p *QuotePricesReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *QuotePricesReq) SetItems(val []*PriceQuoteItem) {
+	p.Items = val
+}
+
+func (p *QuotePricesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QuotePricesReq(%+v)", *p)
+}
+
+var fieldIDToName_QuotePricesReq = map[int16]string{
+	1: "userId",
+	2: "items",
+}
+
+type QuotePricesResp struct {
+	Success bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Quotes  []*PriceQuote `thrift:"quotes,4" frugal:"4,default,list<PriceQuote>" json:"quotes"`
+}
+
+func NewQuotePricesResp() *QuotePricesResp {
+	return &QuotePricesResp{
+		Code: 0,
+	}
+}
+
+func (p *QuotePricesResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *QuotePricesResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *QuotePricesResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var QuotePricesResp_Message_DEFAULT string
+
+func (p *QuotePricesResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return QuotePricesResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *QuotePricesResp) GetQuotes() (v []*PriceQuote) {
+	return p.Quotes
+}
+func (p *QuotePricesResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *QuotePricesResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *QuotePricesResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *QuotePricesResp) SetQuotes(val []*PriceQuote) {
+	p.Quotes = val
+}
+
+func (p *QuotePricesResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *QuotePricesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QuotePricesResp(%+v)", *p)
+}
+
+var fieldIDToName_QuotePricesResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "quotes",
+}
+
+type SetProductPurchaseLimitReq struct {
+	ProductId  int64   `thrift:"productId,1" frugal:"1,default,i64" json:"productId"`
+	Limit      int32   `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+	WindowDays int32   `thrift:"windowDays,3" frugal:"3,default,i32" json:"windowDays"`
+	Operator   *string `thrift:"operator,4,optional" frugal:"4,optional,string" json:"operator,omitempty"`
+}
+
+func NewSetProductPurchaseLimitReq() *SetProductPurchaseLimitReq {
+	return &SetProductPurchaseLimitReq{}
+}
+
+func (p *SetProductPurchaseLimitReq) InitDefault() {
+}
+
+func (p *SetProductPurchaseLimitReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *SetProductPurchaseLimitReq) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *SetProductPurchaseLimitReq) GetWindowDays() (v int32) {
+	return p.WindowDays
+}
+
+var SetProductPurchaseLimitReq_Operator_DEFAULT string
+
+func (p *SetProductPurchaseLimitReq) GetOperator() (v string) {
+	if !p.IsSetOperator() {
+		return SetProductPurchaseLimitReq_Operator_DEFAULT
+	}
+	return *p.Operator
+}
+func (p *SetProductPurchaseLimitReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *SetProductPurchaseLimitReq) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *SetProductPurchaseLimitReq) SetWindowDays(val int32) {
+	p.WindowDays = val
+}
+func (p *SetProductPurchaseLimitReq) SetOperator(val *string) {
+	p.Operator = val
+}
+
+func (p *SetProductPurchaseLimitReq) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *SetProductPurchaseLimitReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetProductPurchaseLimitReq(%+v)", *p)
+}
+
+var fieldIDToName_SetProductPurchaseLimitReq = map[int16]string{
+	1: "productId",
+	2: "limit",
+	3: "windowDays",
+	4: "operator",
+}
+
+type SetProductPurchaseLimitResp struct {
+	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
+	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
+	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+}
+
+func NewSetProductPurchaseLimitResp() *SetProductPurchaseLimitResp {
+	return &SetProductPurchaseLimitResp{
+		Code: 0,
+	}
+}
+
+func (p *SetProductPurchaseLimitResp) InitDefault() {
+	p.Code = 0
+}
+
+func (p *SetProductPurchaseLimitResp) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SetProductPurchaseLimitResp) GetCode() (v int32) {
+	return p.Code
+}
+
+var SetProductPurchaseLimitResp_Message_DEFAULT string
+
+func (p *SetProductPurchaseLimitResp) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return SetProductPurchaseLimitResp_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var SetProductPurchaseLimitResp_Product_DEFAULT *Product
+
+func (p *SetProductPurchaseLimitResp) GetProduct() (v *Product) {
+	if !p.IsSetProduct() {
+		return SetProductPurchaseLimitResp_Product_DEFAULT
+	}
+	return p.Product
+}
+func (p *SetProductPurchaseLimitResp) SetSuccess(val bool) {
+	p.Success = val
+}
+func (p *SetProductPurchaseLimitResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *SetProductPurchaseLimitResp) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *SetProductPurchaseLimitResp) SetProduct(val *Product) {
+	p.Product = val
+}
+
+func (p *SetProductPurchaseLimitResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *SetProductPurchaseLimitResp) IsSetProduct() bool {
+	return p.Product != nil
+}
+
+func (p *SetProductPurchaseLimitResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetProductPurchaseLimitResp(%+v)", *p)
+}
+
+var fieldIDToName_SetProductPurchaseLimitResp = map[int16]string{
+	1: "success",
+	2: "code",
+	3: "message",
+	4: "product",
+}
+
+type ProductService interface {
+	CreateProduct(ctx context.Context, req *CreateProductReq) (r *CreateProductResp, err error)
+
+	GetProduct(ctx context.Context, req *GetProductReq) (r *GetProductResp, err error)
+
+	UpdateProduct(ctx context.Context, req *UpdateProductReq) (r *UpdateProductResp, err error)
+
+	OnlineProduct(ctx context.Context, req *OnlineProductReq) (r *OnlineProductResp, err error)
+
+	OfflineProduct(ctx context.Context, req *OfflineProductReq) (r *OfflineProductResp, err error)
+
+	DeleteProduct(ctx context.Context, req *DeleteProductReq) (r *DeleteProductResp, err error)
+
+	UserSearchProducts(ctx context.Context, req *UserSearchProductsReq) (r *UserSearchProductsResp, err error)
+
+	AdminSearchProducts(ctx context.Context, req *AdminSearchProductsReq) (r *AdminSearchProductsResp, err error)
+
+	BatchGetProducts(ctx context.Context, req *BatchGetProductsReq) (r *BatchGetProductsResp, err error)
+
+	ListProductEvents(ctx context.Context, req *ListProductEventsReq) (r *ListProductEventsResp, err error)
+
+	AdjustStock(ctx context.Context, req *AdjustStockReq) (r *AdjustStockResp, err error)
+
+	ListStockMovements(ctx context.Context, req *ListStockMovementsReq) (r *ListStockMovementsResp, err error)
+
+	ReconcileStock(ctx context.Context, req *ReconcileStockReq) (r *ReconcileStockResp, err error)
+
+	CreateWarehouse(ctx context.Context, req *CreateWarehouseReq) (r *CreateWarehouseResp, err error)
+
+	UpdateWarehouse(ctx context.Context, req *UpdateWarehouseReq) (r *UpdateWarehouseResp, err error)
+
+	ListWarehouses(ctx context.Context, req *ListWarehousesReq) (r *ListWarehousesResp, err error)
+
+	GetWarehouseStocks(ctx context.Context, req *GetWarehouseStocksReq) (r *GetWarehouseStocksResp, err error)
+
+	ListLowStockProducts(ctx context.Context, req *ListLowStockProductsReq) (r *ListLowStockProductsResp, err error)
+
+	CreatePriceSchedule(ctx context.Context, req *CreatePriceScheduleReq) (r *CreatePriceScheduleResp, err error)
+
+	ListPriceSchedules(ctx context.Context, req *ListPriceSchedulesReq) (r *ListPriceSchedulesResp, err error)
+
+	CancelPriceSchedule(ctx context.Context, req *CancelPriceScheduleReq) (r *CancelPriceScheduleResp, err error)
+
+	GetPriceHistory(ctx context.Context, req *GetPriceHistoryReq) (r *GetPriceHistoryResp, err error)
+
+	ListProductStatusSchedules(ctx context.Context, req *ListProductStatusSchedulesReq) (r *ListProductStatusSchedulesResp, err error)
+
+	CancelProductStatusSchedule(ctx context.Context, req *CancelProductStatusScheduleReq) (r *CancelProductStatusScheduleResp, err error)
+
+	ImportProducts(ctx context.Context, req *ImportProductsReq) (r *ImportProductsResp, err error)
+
+	CreateAttributeDefinition(ctx context.Context, req *CreateAttributeDefinitionReq) (r *CreateAttributeDefinitionResp, err error)
+
+	UpdateAttributeDefinition(ctx context.Context, req *UpdateAttributeDefinitionReq) (r *UpdateAttributeDefinitionResp, err error)
+
+	ListAttributeDefinitions(ctx context.Context, req *ListAttributeDefinitionsReq) (r *ListAttributeDefinitionsResp, err error)
+
+	DeleteAttributeDefinition(ctx context.Context, req *DeleteAttributeDefinitionReq) (r *DeleteAttributeDefinitionResp, err error)
+
+	UpdateProductRating(ctx context.Context, req *UpdateProductRatingReq) (r *UpdateProductRatingResp, err error)
+
+	ListProductAudit(ctx context.Context, req *ListProductAuditReq) (r *ListProductAuditResp, err error)
+
+	SyncPurchaseStats(ctx context.Context, req *SyncPurchaseStatsReq) (r *SyncPurchaseStatsResp, err error)
+
+	GetRelatedProducts(ctx context.Context, req *GetRelatedProductsReq) (r *GetRelatedProductsResp, err error)
+
+	GetFrequentlyBoughtTogether(ctx context.Context, req *GetFrequentlyBoughtTogetherReq) (r *GetFrequentlyBoughtTogetherResp, err error)
+
+	CreateBrand(ctx context.Context, req *CreateBrandReq) (r *CreateBrandResp, err error)
+
+	UpdateBrand(ctx context.Context, req *UpdateBrandReq) (r *UpdateBrandResp, err error)
+
+	GetBrand(ctx context.Context, req *GetBrandReq) (r *GetBrandResp, err error)
+
+	ListBrands(ctx context.Context, req *ListBrandsReq) (r *ListBrandsResp, err error)
+
+	DeleteBrand(ctx context.Context, req *DeleteBrandReq) (r *DeleteBrandResp, err error)
+
+	SubmitProductApproval(ctx context.Context, req *SubmitProductApprovalReq) (r *SubmitProductApprovalResp, err error)
+
+	ReviewProductApproval(ctx context.Context, req *ReviewProductApprovalReq) (r *ReviewProductApprovalResp, err error)
+
+	ListProductApprovals(ctx context.Context, req *ListProductApprovalsReq) (r *ListProductApprovalsResp, err error)
+
+	CreateMerchant(ctx context.Context, req *CreateMerchantReq) (r *CreateMerchantResp, err error)
+
+	UpdateMerchant(ctx context.Context, req *UpdateMerchantReq) (r *UpdateMerchantResp, err error)
+
+	GetMerchant(ctx context.Context, req *GetMerchantReq) (r *GetMerchantResp, err error)
+
+	ListMerchants(ctx context.Context, req *ListMerchantsReq) (r *ListMerchantsResp, err error)
+
+	SuggestProducts(ctx context.Context, req *SuggestProductsReq) (r *SuggestProductsResp, err error)
+
+	ListSearchQueries(ctx context.Context, req *ListSearchQueriesReq) (r *ListSearchQueriesResp, err error)
+
+	RecordProductSales(ctx context.Context, req *RecordProductSalesReq) (r *RecordProductSalesResp, err error)
+
+	GetTopProducts(ctx context.Context, req *GetTopProductsReq) (r *GetTopProductsResp, err error)
+
+	UpdateBundleComponents(ctx context.Context, req *UpdateBundleComponentsReq) (r *UpdateBundleComponentsResp, err error)
+
+	SetProductTranslation(ctx context.Context, req *SetProductTranslationReq) (r *SetProductTranslationResp, err error)
+
+	DeleteProductTranslation(ctx context.Context, req *DeleteProductTranslationReq) (r *DeleteProductTranslationResp, err error)
+
+	ListProductTranslations(ctx context.Context, req *ListProductTranslationsReq) (r *ListProductTranslationsResp, err error)
+
+	SetCategoryTranslation(ctx context.Context, req *SetCategoryTranslationReq) (r *SetCategoryTranslationResp, err error)
+
+	DeleteCategoryTranslation(ctx context.Context, req *DeleteCategoryTranslationReq) (r *DeleteCategoryTranslationResp, err error)
+
+	ListCategoryTranslations(ctx context.Context, req *ListCategoryTranslationsReq) (r *ListCategoryTranslationsResp, err error)
+
+	AddFavorite(ctx context.Context, req *AddFavoriteReq) (r *AddFavoriteResp, err error)
+
+	RemoveFavorite(ctx context.Context, req *RemoveFavoriteReq) (r *RemoveFavoriteResp, err error)
+
+	ListFavorites(ctx context.Context, req *ListFavoritesReq) (r *ListFavoritesResp, err error)
+
+	SubscribeRestock(ctx context.Context, req *SubscribeRestockReq) (r *SubscribeRestockResp, err error)
+
+	UnsubscribeRestock(ctx context.Context, req *UnsubscribeRestockReq) (r *UnsubscribeRestockResp, err error)
+
+	ListRestockSubscriptions(ctx context.Context, req *ListRestockSubscriptionsReq) (r *ListRestockSubscriptionsResp, err error)
+
+	SetProductPriceTiers(ctx context.Context, req *SetProductPriceTiersReq) (r *SetProductPriceTiersResp, err error)
+
+	GetProductPriceTiers(ctx context.Context, req *GetProductPriceTiersReq) (r *GetProductPriceTiersResp, err error)
+
+	SetUserPriceGroup(ctx context.Context, req *SetUserPriceGroupReq) (r *SetUserPriceGroupResp, err error)
+
+	ListUserPriceGroups(ctx context.Context, req *ListUserPriceGroupsReq) (r *ListUserPriceGroupsResp, err error)
+
+	QuotePrices(ctx context.Context, req *QuotePricesReq) (r *QuotePricesResp, err error)
+
+	SetProductPurchaseLimit(ctx context.Context, req *SetProductPurchaseLimitReq) (r *SetProductPurchaseLimitResp, err error)
+}
+
+type ProductServiceCreateProductArgs struct {
+	Req *CreateProductReq `thrift:"req,1" frugal:"1,default,CreateProductReq" json:"req"`
+}
+
+func NewProductServiceCreateProductArgs() *ProductServiceCreateProductArgs {
+	return &ProductServiceCreateProductArgs{}
+}
+
+func (p *ProductServiceCreateProductArgs) InitDefault() {
+}
+
+var ProductServiceCreateProductArgs_Req_DEFAULT *CreateProductReq
+
+func (p *ProductServiceCreateProductArgs) GetReq() (v *CreateProductReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCreateProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCreateProductArgs) SetReq(val *CreateProductReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCreateProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCreateProductArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateProductArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateProductArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCreateProductResult struct {
+	Success *CreateProductResp `thrift:"success,0,optional" frugal:"0,optional,CreateProductResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCreateProductResult() *ProductServiceCreateProductResult {
+	return &ProductServiceCreateProductResult{}
+}
+
+func (p *ProductServiceCreateProductResult) InitDefault() {
+}
+
+var ProductServiceCreateProductResult_Success_DEFAULT *CreateProductResp
+
+func (p *ProductServiceCreateProductResult) GetSuccess() (v *CreateProductResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCreateProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCreateProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateProductResp)
+}
+
+func (p *ProductServiceCreateProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCreateProductResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateProductResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateProductResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetProductArgs struct {
+	Req *GetProductReq `thrift:"req,1" frugal:"1,default,GetProductReq" json:"req"`
+}
+
+func NewProductServiceGetProductArgs() *ProductServiceGetProductArgs {
+	return &ProductServiceGetProductArgs{}
+}
+
+func (p *ProductServiceGetProductArgs) InitDefault() {
+}
+
+var ProductServiceGetProductArgs_Req_DEFAULT *GetProductReq
+
+func (p *ProductServiceGetProductArgs) GetReq() (v *GetProductReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetProductArgs) SetReq(val *GetProductReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceGetProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceGetProductArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetProductArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetProductArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceGetProductResult struct {
+	Success *GetProductResp `thrift:"success,0,optional" frugal:"0,optional,GetProductResp" json:"success,omitempty"`
+}
+
+func NewProductServiceGetProductResult() *ProductServiceGetProductResult {
+	return &ProductServiceGetProductResult{}
+}
+
+func (p *ProductServiceGetProductResult) InitDefault() {
+}
+
+var ProductServiceGetProductResult_Success_DEFAULT *GetProductResp
+
+func (p *ProductServiceGetProductResult) GetSuccess() (v *GetProductResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetProductResp)
+}
+
+func (p *ProductServiceGetProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceGetProductResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetProductResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetProductResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceUpdateProductArgs struct {
+	Req *UpdateProductReq `thrift:"req,1" frugal:"1,default,UpdateProductReq" json:"req"`
+}
+
+func NewProductServiceUpdateProductArgs() *ProductServiceUpdateProductArgs {
+	return &ProductServiceUpdateProductArgs{}
+}
+
+func (p *ProductServiceUpdateProductArgs) InitDefault() {
+}
+
+var ProductServiceUpdateProductArgs_Req_DEFAULT *UpdateProductReq
+
+func (p *ProductServiceUpdateProductArgs) GetReq() (v *UpdateProductReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceUpdateProductArgs) SetReq(val *UpdateProductReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceUpdateProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUpdateProductArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateProductArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateProductArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceUpdateProductResult struct {
+	Success *UpdateProductResp `thrift:"success,0,optional" frugal:"0,optional,UpdateProductResp" json:"success,omitempty"`
+}
+
+func NewProductServiceUpdateProductResult() *ProductServiceUpdateProductResult {
+	return &ProductServiceUpdateProductResult{}
+}
+
+func (p *ProductServiceUpdateProductResult) InitDefault() {
+}
+
+var ProductServiceUpdateProductResult_Success_DEFAULT *UpdateProductResp
+
+func (p *ProductServiceUpdateProductResult) GetSuccess() (v *UpdateProductResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceUpdateProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateProductResp)
+}
+
+func (p *ProductServiceUpdateProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceUpdateProductResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateProductResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateProductResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceOnlineProductArgs struct {
+	Req *OnlineProductReq `thrift:"req,1" frugal:"1,default,OnlineProductReq" json:"req"`
+}
+
+func NewProductServiceOnlineProductArgs() *ProductServiceOnlineProductArgs {
+	return &ProductServiceOnlineProductArgs{}
+}
+
+func (p *ProductServiceOnlineProductArgs) InitDefault() {
+}
+
+var ProductServiceOnlineProductArgs_Req_DEFAULT *OnlineProductReq
+
+func (p *ProductServiceOnlineProductArgs) GetReq() (v *OnlineProductReq) {
+	if !p.IsSetReq() {
+		return ProductServiceOnlineProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceOnlineProductArgs) SetReq(val *OnlineProductReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceOnlineProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceOnlineProductArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceOnlineProductArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceOnlineProductArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceOnlineProductResult struct {
+	Success *OnlineProductResp `thrift:"success,0,optional" frugal:"0,optional,OnlineProductResp" json:"success,omitempty"`
+}
+
+func NewProductServiceOnlineProductResult() *ProductServiceOnlineProductResult {
+	return &ProductServiceOnlineProductResult{}
+}
+
+func (p *ProductServiceOnlineProductResult) InitDefault() {
+}
+
+var ProductServiceOnlineProductResult_Success_DEFAULT *OnlineProductResp
+
+func (p *ProductServiceOnlineProductResult) GetSuccess() (v *OnlineProductResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceOnlineProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceOnlineProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*OnlineProductResp)
+}
+
+func (p *ProductServiceOnlineProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceOnlineProductResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceOnlineProductResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceOnlineProductResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceOfflineProductArgs struct {
+	Req *OfflineProductReq `thrift:"req,1" frugal:"1,default,OfflineProductReq" json:"req"`
+}
+
+func NewProductServiceOfflineProductArgs() *ProductServiceOfflineProductArgs {
+	return &ProductServiceOfflineProductArgs{}
+}
+
+func (p *ProductServiceOfflineProductArgs) InitDefault() {
+}
+
+var ProductServiceOfflineProductArgs_Req_DEFAULT *OfflineProductReq
+
+func (p *ProductServiceOfflineProductArgs) GetReq() (v *OfflineProductReq) {
+	if !p.IsSetReq() {
+		return ProductServiceOfflineProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceOfflineProductArgs) SetReq(val *OfflineProductReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceOfflineProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceOfflineProductArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceOfflineProductArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceOfflineProductArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceOfflineProductResult struct {
+	Success *OfflineProductResp `thrift:"success,0,optional" frugal:"0,optional,OfflineProductResp" json:"success,omitempty"`
+}
+
+func NewProductServiceOfflineProductResult() *ProductServiceOfflineProductResult {
+	return &ProductServiceOfflineProductResult{}
+}
+
+func (p *ProductServiceOfflineProductResult) InitDefault() {
+}
+
+var ProductServiceOfflineProductResult_Success_DEFAULT *OfflineProductResp
+
+func (p *ProductServiceOfflineProductResult) GetSuccess() (v *OfflineProductResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceOfflineProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceOfflineProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*OfflineProductResp)
+}
+
+func (p *ProductServiceOfflineProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceOfflineProductResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceOfflineProductResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceOfflineProductResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceDeleteProductArgs struct {
+	Req *DeleteProductReq `thrift:"req,1" frugal:"1,default,DeleteProductReq" json:"req"`
+}
+
+func NewProductServiceDeleteProductArgs() *ProductServiceDeleteProductArgs {
+	return &ProductServiceDeleteProductArgs{}
+}
+
+func (p *ProductServiceDeleteProductArgs) InitDefault() {
+}
+
+var ProductServiceDeleteProductArgs_Req_DEFAULT *DeleteProductReq
+
+func (p *ProductServiceDeleteProductArgs) GetReq() (v *DeleteProductReq) {
+	if !p.IsSetReq() {
+		return ProductServiceDeleteProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceDeleteProductArgs) SetReq(val *DeleteProductReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceDeleteProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceDeleteProductArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteProductArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceDeleteProductArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceDeleteProductResult struct {
+	Success *DeleteProductResp `thrift:"success,0,optional" frugal:"0,optional,DeleteProductResp" json:"success,omitempty"`
+}
+
+func NewProductServiceDeleteProductResult() *ProductServiceDeleteProductResult {
+	return &ProductServiceDeleteProductResult{}
+}
+
+func (p *ProductServiceDeleteProductResult) InitDefault() {
+}
+
+var ProductServiceDeleteProductResult_Success_DEFAULT *DeleteProductResp
+
+func (p *ProductServiceDeleteProductResult) GetSuccess() (v *DeleteProductResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceDeleteProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceDeleteProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteProductResp)
+}
+
+func (p *ProductServiceDeleteProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceDeleteProductResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteProductResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceDeleteProductResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceUserSearchProductsArgs struct {
+	Req *UserSearchProductsReq `thrift:"req,1" frugal:"1,default,UserSearchProductsReq" json:"req"`
+}
+
+func NewProductServiceUserSearchProductsArgs() *ProductServiceUserSearchProductsArgs {
+	return &ProductServiceUserSearchProductsArgs{}
+}
+
+func (p *ProductServiceUserSearchProductsArgs) InitDefault() {
+}
+
+var ProductServiceUserSearchProductsArgs_Req_DEFAULT *UserSearchProductsReq
+
+func (p *ProductServiceUserSearchProductsArgs) GetReq() (v *UserSearchProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUserSearchProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceUserSearchProductsArgs) SetReq(val *UserSearchProductsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceUserSearchProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUserSearchProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUserSearchProductsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUserSearchProductsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceUserSearchProductsResult struct {
+	Success *UserSearchProductsResp `thrift:"success,0,optional" frugal:"0,optional,UserSearchProductsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceUserSearchProductsResult() *ProductServiceUserSearchProductsResult {
+	return &ProductServiceUserSearchProductsResult{}
+}
+
+func (p *ProductServiceUserSearchProductsResult) InitDefault() {
+}
+
+var ProductServiceUserSearchProductsResult_Success_DEFAULT *UserSearchProductsResp
+
+func (p *ProductServiceUserSearchProductsResult) GetSuccess() (v *UserSearchProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUserSearchProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceUserSearchProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserSearchProductsResp)
+}
+
+func (p *ProductServiceUserSearchProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceUserSearchProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUserSearchProductsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUserSearchProductsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceAdminSearchProductsArgs struct {
+	Req *AdminSearchProductsReq `thrift:"req,1" frugal:"1,default,AdminSearchProductsReq" json:"req"`
+}
+
+func NewProductServiceAdminSearchProductsArgs() *ProductServiceAdminSearchProductsArgs {
+	return &ProductServiceAdminSearchProductsArgs{}
+}
+
+func (p *ProductServiceAdminSearchProductsArgs) InitDefault() {
+}
+
+var ProductServiceAdminSearchProductsArgs_Req_DEFAULT *AdminSearchProductsReq
+
+func (p *ProductServiceAdminSearchProductsArgs) GetReq() (v *AdminSearchProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceAdminSearchProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceAdminSearchProductsArgs) SetReq(val *AdminSearchProductsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceAdminSearchProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceAdminSearchProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceAdminSearchProductsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceAdminSearchProductsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceAdminSearchProductsResult struct {
+	Success *AdminSearchProductsResp `thrift:"success,0,optional" frugal:"0,optional,AdminSearchProductsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceAdminSearchProductsResult() *ProductServiceAdminSearchProductsResult {
+	return &ProductServiceAdminSearchProductsResult{}
+}
+
+func (p *ProductServiceAdminSearchProductsResult) InitDefault() {
+}
+
+var ProductServiceAdminSearchProductsResult_Success_DEFAULT *AdminSearchProductsResp
+
+func (p *ProductServiceAdminSearchProductsResult) GetSuccess() (v *AdminSearchProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceAdminSearchProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceAdminSearchProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AdminSearchProductsResp)
+}
+
+func (p *ProductServiceAdminSearchProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceAdminSearchProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceAdminSearchProductsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceAdminSearchProductsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceBatchGetProductsArgs struct {
+	Req *BatchGetProductsReq `thrift:"req,1" frugal:"1,default,BatchGetProductsReq" json:"req"`
+}
+
+func NewProductServiceBatchGetProductsArgs() *ProductServiceBatchGetProductsArgs {
+	return &ProductServiceBatchGetProductsArgs{}
+}
+
+func (p *ProductServiceBatchGetProductsArgs) InitDefault() {
+}
+
+var ProductServiceBatchGetProductsArgs_Req_DEFAULT *BatchGetProductsReq
+
+func (p *ProductServiceBatchGetProductsArgs) GetReq() (v *BatchGetProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceBatchGetProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceBatchGetProductsArgs) SetReq(val *BatchGetProductsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceBatchGetProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceBatchGetProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceBatchGetProductsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceBatchGetProductsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceBatchGetProductsResult struct {
+	Success *BatchGetProductsResp `thrift:"success,0,optional" frugal:"0,optional,BatchGetProductsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceBatchGetProductsResult() *ProductServiceBatchGetProductsResult {
+	return &ProductServiceBatchGetProductsResult{}
+}
+
+func (p *ProductServiceBatchGetProductsResult) InitDefault() {
+}
+
+var ProductServiceBatchGetProductsResult_Success_DEFAULT *BatchGetProductsResp
+
+func (p *ProductServiceBatchGetProductsResult) GetSuccess() (v *BatchGetProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceBatchGetProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceBatchGetProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetProductsResp)
+}
+
+func (p *ProductServiceBatchGetProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceBatchGetProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceBatchGetProductsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceBatchGetProductsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListProductEventsArgs struct {
+	Req *ListProductEventsReq `thrift:"req,1" frugal:"1,default,ListProductEventsReq" json:"req"`
+}
+
+func NewProductServiceListProductEventsArgs() *ProductServiceListProductEventsArgs {
+	return &ProductServiceListProductEventsArgs{}
+}
+
+func (p *ProductServiceListProductEventsArgs) InitDefault() {
+}
+
+var ProductServiceListProductEventsArgs_Req_DEFAULT *ListProductEventsReq
+
+func (p *ProductServiceListProductEventsArgs) GetReq() (v *ListProductEventsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListProductEventsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListProductEventsArgs) SetReq(val *ListProductEventsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListProductEventsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListProductEventsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductEventsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListProductEventsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListProductEventsResult struct {
+	Success *ListProductEventsResp `thrift:"success,0,optional" frugal:"0,optional,ListProductEventsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListProductEventsResult() *ProductServiceListProductEventsResult {
+	return &ProductServiceListProductEventsResult{}
+}
+
+func (p *ProductServiceListProductEventsResult) InitDefault() {
+}
+
+var ProductServiceListProductEventsResult_Success_DEFAULT *ListProductEventsResp
+
+func (p *ProductServiceListProductEventsResult) GetSuccess() (v *ListProductEventsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListProductEventsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListProductEventsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListProductEventsResp)
+}
+
+func (p *ProductServiceListProductEventsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListProductEventsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductEventsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListProductEventsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceAdjustStockArgs struct {
+	Req *AdjustStockReq `thrift:"req,1" frugal:"1,default,AdjustStockReq" json:"req"`
+}
+
+func NewProductServiceAdjustStockArgs() *ProductServiceAdjustStockArgs {
+	return &ProductServiceAdjustStockArgs{}
+}
+
+func (p *ProductServiceAdjustStockArgs) InitDefault() {
+}
+
+var ProductServiceAdjustStockArgs_Req_DEFAULT *AdjustStockReq
+
+func (p *ProductServiceAdjustStockArgs) GetReq() (v *AdjustStockReq) {
+	if !p.IsSetReq() {
+		return ProductServiceAdjustStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceAdjustStockArgs) SetReq(val *AdjustStockReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceAdjustStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceAdjustStockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceAdjustStockArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceAdjustStockArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceAdjustStockResult struct {
+	Success *AdjustStockResp `thrift:"success,0,optional" frugal:"0,optional,AdjustStockResp" json:"success,omitempty"`
+}
+
+func NewProductServiceAdjustStockResult() *ProductServiceAdjustStockResult {
+	return &ProductServiceAdjustStockResult{}
+}
+
+func (p *ProductServiceAdjustStockResult) InitDefault() {
+}
+
+var ProductServiceAdjustStockResult_Success_DEFAULT *AdjustStockResp
+
+func (p *ProductServiceAdjustStockResult) GetSuccess() (v *AdjustStockResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceAdjustStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceAdjustStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AdjustStockResp)
+}
+
+func (p *ProductServiceAdjustStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceAdjustStockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceAdjustStockResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceAdjustStockResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListStockMovementsArgs struct {
+	Req *ListStockMovementsReq `thrift:"req,1" frugal:"1,default,ListStockMovementsReq" json:"req"`
+}
+
+func NewProductServiceListStockMovementsArgs() *ProductServiceListStockMovementsArgs {
+	return &ProductServiceListStockMovementsArgs{}
+}
+
+func (p *ProductServiceListStockMovementsArgs) InitDefault() {
+}
+
+var ProductServiceListStockMovementsArgs_Req_DEFAULT *ListStockMovementsReq
+
+func (p *ProductServiceListStockMovementsArgs) GetReq() (v *ListStockMovementsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListStockMovementsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListStockMovementsArgs) SetReq(val *ListStockMovementsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListStockMovementsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListStockMovementsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListStockMovementsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListStockMovementsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListStockMovementsResult struct {
+	Success *ListStockMovementsResp `thrift:"success,0,optional" frugal:"0,optional,ListStockMovementsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListStockMovementsResult() *ProductServiceListStockMovementsResult {
+	return &ProductServiceListStockMovementsResult{}
+}
+
+func (p *ProductServiceListStockMovementsResult) InitDefault() {
+}
+
+var ProductServiceListStockMovementsResult_Success_DEFAULT *ListStockMovementsResp
+
+func (p *ProductServiceListStockMovementsResult) GetSuccess() (v *ListStockMovementsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListStockMovementsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListStockMovementsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListStockMovementsResp)
+}
+
+func (p *ProductServiceListStockMovementsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListStockMovementsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListStockMovementsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListStockMovementsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceReconcileStockArgs struct {
+	Req *ReconcileStockReq `thrift:"req,1" frugal:"1,default,ReconcileStockReq" json:"req"`
+}
+
+func NewProductServiceReconcileStockArgs() *ProductServiceReconcileStockArgs {
+	return &ProductServiceReconcileStockArgs{}
+}
+
+func (p *ProductServiceReconcileStockArgs) InitDefault() {
+}
+
+var ProductServiceReconcileStockArgs_Req_DEFAULT *ReconcileStockReq
+
+func (p *ProductServiceReconcileStockArgs) GetReq() (v *ReconcileStockReq) {
+	if !p.IsSetReq() {
+		return ProductServiceReconcileStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceReconcileStockArgs) SetReq(val *ReconcileStockReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceReconcileStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceReconcileStockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceReconcileStockArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceReconcileStockArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceReconcileStockResult struct {
+	Success *ReconcileStockResp `thrift:"success,0,optional" frugal:"0,optional,ReconcileStockResp" json:"success,omitempty"`
+}
+
+func NewProductServiceReconcileStockResult() *ProductServiceReconcileStockResult {
+	return &ProductServiceReconcileStockResult{}
+}
+
+func (p *ProductServiceReconcileStockResult) InitDefault() {
+}
+
+var ProductServiceReconcileStockResult_Success_DEFAULT *ReconcileStockResp
+
+func (p *ProductServiceReconcileStockResult) GetSuccess() (v *ReconcileStockResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceReconcileStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceReconcileStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReconcileStockResp)
+}
+
+func (p *ProductServiceReconcileStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceReconcileStockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceReconcileStockResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceReconcileStockResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceCreateWarehouseArgs struct {
+	Req *CreateWarehouseReq `thrift:"req,1" frugal:"1,default,CreateWarehouseReq" json:"req"`
+}
+
+func NewProductServiceCreateWarehouseArgs() *ProductServiceCreateWarehouseArgs {
+	return &ProductServiceCreateWarehouseArgs{}
+}
+
+func (p *ProductServiceCreateWarehouseArgs) InitDefault() {
+}
+
+var ProductServiceCreateWarehouseArgs_Req_DEFAULT *CreateWarehouseReq
+
+func (p *ProductServiceCreateWarehouseArgs) GetReq() (v *CreateWarehouseReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCreateWarehouseArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCreateWarehouseArgs) SetReq(val *CreateWarehouseReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCreateWarehouseArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCreateWarehouseArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateWarehouseArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateWarehouseArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCreateWarehouseResult struct {
+	Success *CreateWarehouseResp `thrift:"success,0,optional" frugal:"0,optional,CreateWarehouseResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCreateWarehouseResult() *ProductServiceCreateWarehouseResult {
+	return &ProductServiceCreateWarehouseResult{}
+}
+
+func (p *ProductServiceCreateWarehouseResult) InitDefault() {
+}
+
+var ProductServiceCreateWarehouseResult_Success_DEFAULT *CreateWarehouseResp
+
+func (p *ProductServiceCreateWarehouseResult) GetSuccess() (v *CreateWarehouseResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCreateWarehouseResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCreateWarehouseResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateWarehouseResp)
+}
+
+func (p *ProductServiceCreateWarehouseResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCreateWarehouseResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateWarehouseResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateWarehouseResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceUpdateWarehouseArgs struct {
+	Req *UpdateWarehouseReq `thrift:"req,1" frugal:"1,default,UpdateWarehouseReq" json:"req"`
+}
+
+func NewProductServiceUpdateWarehouseArgs() *ProductServiceUpdateWarehouseArgs {
+	return &ProductServiceUpdateWarehouseArgs{}
+}
+
+func (p *ProductServiceUpdateWarehouseArgs) InitDefault() {
+}
+
+var ProductServiceUpdateWarehouseArgs_Req_DEFAULT *UpdateWarehouseReq
+
+func (p *ProductServiceUpdateWarehouseArgs) GetReq() (v *UpdateWarehouseReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateWarehouseArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceUpdateWarehouseArgs) SetReq(val *UpdateWarehouseReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceUpdateWarehouseArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUpdateWarehouseArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateWarehouseArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateWarehouseArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceUpdateWarehouseResult struct {
+	Success *UpdateWarehouseResp `thrift:"success,0,optional" frugal:"0,optional,UpdateWarehouseResp" json:"success,omitempty"`
+}
+
+func NewProductServiceUpdateWarehouseResult() *ProductServiceUpdateWarehouseResult {
+	return &ProductServiceUpdateWarehouseResult{}
+}
+
+func (p *ProductServiceUpdateWarehouseResult) InitDefault() {
+}
+
+var ProductServiceUpdateWarehouseResult_Success_DEFAULT *UpdateWarehouseResp
+
+func (p *ProductServiceUpdateWarehouseResult) GetSuccess() (v *UpdateWarehouseResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateWarehouseResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceUpdateWarehouseResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateWarehouseResp)
+}
+
+func (p *ProductServiceUpdateWarehouseResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceUpdateWarehouseResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateWarehouseResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateWarehouseResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListWarehousesArgs struct {
+	Req *ListWarehousesReq `thrift:"req,1" frugal:"1,default,ListWarehousesReq" json:"req"`
+}
+
+func NewProductServiceListWarehousesArgs() *ProductServiceListWarehousesArgs {
+	return &ProductServiceListWarehousesArgs{}
+}
+
+func (p *ProductServiceListWarehousesArgs) InitDefault() {
+}
+
+var ProductServiceListWarehousesArgs_Req_DEFAULT *ListWarehousesReq
+
+func (p *ProductServiceListWarehousesArgs) GetReq() (v *ListWarehousesReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListWarehousesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListWarehousesArgs) SetReq(val *ListWarehousesReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListWarehousesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListWarehousesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListWarehousesArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListWarehousesArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListWarehousesResult struct {
+	Success *ListWarehousesResp `thrift:"success,0,optional" frugal:"0,optional,ListWarehousesResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListWarehousesResult() *ProductServiceListWarehousesResult {
+	return &ProductServiceListWarehousesResult{}
+}
+
+func (p *ProductServiceListWarehousesResult) InitDefault() {
+}
+
+var ProductServiceListWarehousesResult_Success_DEFAULT *ListWarehousesResp
+
+func (p *ProductServiceListWarehousesResult) GetSuccess() (v *ListWarehousesResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListWarehousesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListWarehousesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListWarehousesResp)
+}
+
+func (p *ProductServiceListWarehousesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListWarehousesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListWarehousesResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListWarehousesResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetWarehouseStocksArgs struct {
+	Req *GetWarehouseStocksReq `thrift:"req,1" frugal:"1,default,GetWarehouseStocksReq" json:"req"`
+}
+
+func NewProductServiceGetWarehouseStocksArgs() *ProductServiceGetWarehouseStocksArgs {
+	return &ProductServiceGetWarehouseStocksArgs{}
+}
+
+func (p *ProductServiceGetWarehouseStocksArgs) InitDefault() {
+}
+
+var ProductServiceGetWarehouseStocksArgs_Req_DEFAULT *GetWarehouseStocksReq
+
+func (p *ProductServiceGetWarehouseStocksArgs) GetReq() (v *GetWarehouseStocksReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetWarehouseStocksArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetWarehouseStocksArgs) SetReq(val *GetWarehouseStocksReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceGetWarehouseStocksArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceGetWarehouseStocksArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetWarehouseStocksArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetWarehouseStocksArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceGetWarehouseStocksResult struct {
+	Success *GetWarehouseStocksResp `thrift:"success,0,optional" frugal:"0,optional,GetWarehouseStocksResp" json:"success,omitempty"`
+}
+
+func NewProductServiceGetWarehouseStocksResult() *ProductServiceGetWarehouseStocksResult {
+	return &ProductServiceGetWarehouseStocksResult{}
+}
+
+func (p *ProductServiceGetWarehouseStocksResult) InitDefault() {
+}
+
+var ProductServiceGetWarehouseStocksResult_Success_DEFAULT *GetWarehouseStocksResp
+
+func (p *ProductServiceGetWarehouseStocksResult) GetSuccess() (v *GetWarehouseStocksResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetWarehouseStocksResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetWarehouseStocksResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetWarehouseStocksResp)
+}
+
+func (p *ProductServiceGetWarehouseStocksResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceGetWarehouseStocksResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetWarehouseStocksResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetWarehouseStocksResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListLowStockProductsArgs struct {
+	Req *ListLowStockProductsReq `thrift:"req,1" frugal:"1,default,ListLowStockProductsReq" json:"req"`
+}
+
+func NewProductServiceListLowStockProductsArgs() *ProductServiceListLowStockProductsArgs {
+	return &ProductServiceListLowStockProductsArgs{}
+}
+
+func (p *ProductServiceListLowStockProductsArgs) InitDefault() {
+}
+
+var ProductServiceListLowStockProductsArgs_Req_DEFAULT *ListLowStockProductsReq
+
+func (p *ProductServiceListLowStockProductsArgs) GetReq() (v *ListLowStockProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListLowStockProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListLowStockProductsArgs) SetReq(val *ListLowStockProductsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListLowStockProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListLowStockProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListLowStockProductsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListLowStockProductsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListLowStockProductsResult struct {
+	Success *ListLowStockProductsResp `thrift:"success,0,optional" frugal:"0,optional,ListLowStockProductsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListLowStockProductsResult() *ProductServiceListLowStockProductsResult {
+	return &ProductServiceListLowStockProductsResult{}
+}
+
+func (p *ProductServiceListLowStockProductsResult) InitDefault() {
+}
+
+var ProductServiceListLowStockProductsResult_Success_DEFAULT *ListLowStockProductsResp
+
+func (p *ProductServiceListLowStockProductsResult) GetSuccess() (v *ListLowStockProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListLowStockProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListLowStockProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListLowStockProductsResp)
+}
+
+func (p *ProductServiceListLowStockProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListLowStockProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListLowStockProductsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListLowStockProductsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceCreatePriceScheduleArgs struct {
+	Req *CreatePriceScheduleReq `thrift:"req,1" frugal:"1,default,CreatePriceScheduleReq" json:"req"`
+}
+
+func NewProductServiceCreatePriceScheduleArgs() *ProductServiceCreatePriceScheduleArgs {
+	return &ProductServiceCreatePriceScheduleArgs{}
+}
+
+func (p *ProductServiceCreatePriceScheduleArgs) InitDefault() {
+}
+
+var ProductServiceCreatePriceScheduleArgs_Req_DEFAULT *CreatePriceScheduleReq
+
+func (p *ProductServiceCreatePriceScheduleArgs) GetReq() (v *CreatePriceScheduleReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCreatePriceScheduleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCreatePriceScheduleArgs) SetReq(val *CreatePriceScheduleReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCreatePriceScheduleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCreatePriceScheduleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreatePriceScheduleArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreatePriceScheduleArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCreatePriceScheduleResult struct {
+	Success *CreatePriceScheduleResp `thrift:"success,0,optional" frugal:"0,optional,CreatePriceScheduleResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCreatePriceScheduleResult() *ProductServiceCreatePriceScheduleResult {
+	return &ProductServiceCreatePriceScheduleResult{}
+}
+
+func (p *ProductServiceCreatePriceScheduleResult) InitDefault() {
+}
+
+var ProductServiceCreatePriceScheduleResult_Success_DEFAULT *CreatePriceScheduleResp
+
+func (p *ProductServiceCreatePriceScheduleResult) GetSuccess() (v *CreatePriceScheduleResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCreatePriceScheduleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCreatePriceScheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreatePriceScheduleResp)
+}
+
+func (p *ProductServiceCreatePriceScheduleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCreatePriceScheduleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreatePriceScheduleResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreatePriceScheduleResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListPriceSchedulesArgs struct {
+	Req *ListPriceSchedulesReq `thrift:"req,1" frugal:"1,default,ListPriceSchedulesReq" json:"req"`
+}
+
+func NewProductServiceListPriceSchedulesArgs() *ProductServiceListPriceSchedulesArgs {
+	return &ProductServiceListPriceSchedulesArgs{}
+}
+
+func (p *ProductServiceListPriceSchedulesArgs) InitDefault() {
+}
+
+var ProductServiceListPriceSchedulesArgs_Req_DEFAULT *ListPriceSchedulesReq
+
+func (p *ProductServiceListPriceSchedulesArgs) GetReq() (v *ListPriceSchedulesReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListPriceSchedulesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListPriceSchedulesArgs) SetReq(val *ListPriceSchedulesReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListPriceSchedulesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListPriceSchedulesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListPriceSchedulesArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListPriceSchedulesArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListPriceSchedulesResult struct {
+	Success *ListPriceSchedulesResp `thrift:"success,0,optional" frugal:"0,optional,ListPriceSchedulesResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListPriceSchedulesResult() *ProductServiceListPriceSchedulesResult {
+	return &ProductServiceListPriceSchedulesResult{}
+}
+
+func (p *ProductServiceListPriceSchedulesResult) InitDefault() {
+}
+
+var ProductServiceListPriceSchedulesResult_Success_DEFAULT *ListPriceSchedulesResp
+
+func (p *ProductServiceListPriceSchedulesResult) GetSuccess() (v *ListPriceSchedulesResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListPriceSchedulesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListPriceSchedulesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListPriceSchedulesResp)
+}
+
+func (p *ProductServiceListPriceSchedulesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListPriceSchedulesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListPriceSchedulesResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListPriceSchedulesResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceCancelPriceScheduleArgs struct {
+	Req *CancelPriceScheduleReq `thrift:"req,1" frugal:"1,default,CancelPriceScheduleReq" json:"req"`
+}
+
+func NewProductServiceCancelPriceScheduleArgs() *ProductServiceCancelPriceScheduleArgs {
+	return &ProductServiceCancelPriceScheduleArgs{}
+}
+
+func (p *ProductServiceCancelPriceScheduleArgs) InitDefault() {
+}
+
+var ProductServiceCancelPriceScheduleArgs_Req_DEFAULT *CancelPriceScheduleReq
+
+func (p *ProductServiceCancelPriceScheduleArgs) GetReq() (v *CancelPriceScheduleReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCancelPriceScheduleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCancelPriceScheduleArgs) SetReq(val *CancelPriceScheduleReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCancelPriceScheduleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCancelPriceScheduleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCancelPriceScheduleArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCancelPriceScheduleArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCancelPriceScheduleResult struct {
+	Success *CancelPriceScheduleResp `thrift:"success,0,optional" frugal:"0,optional,CancelPriceScheduleResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCancelPriceScheduleResult() *ProductServiceCancelPriceScheduleResult {
+	return &ProductServiceCancelPriceScheduleResult{}
+}
+
+func (p *ProductServiceCancelPriceScheduleResult) InitDefault() {
+}
+
+var ProductServiceCancelPriceScheduleResult_Success_DEFAULT *CancelPriceScheduleResp
+
+func (p *ProductServiceCancelPriceScheduleResult) GetSuccess() (v *CancelPriceScheduleResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCancelPriceScheduleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCancelPriceScheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelPriceScheduleResp)
+}
+
+func (p *ProductServiceCancelPriceScheduleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCancelPriceScheduleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCancelPriceScheduleResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCancelPriceScheduleResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetPriceHistoryArgs struct {
+	Req *GetPriceHistoryReq `thrift:"req,1" frugal:"1,default,GetPriceHistoryReq" json:"req"`
+}
+
+func NewProductServiceGetPriceHistoryArgs() *ProductServiceGetPriceHistoryArgs {
+	return &ProductServiceGetPriceHistoryArgs{}
+}
+
+func (p *ProductServiceGetPriceHistoryArgs) InitDefault() {
+}
+
+var ProductServiceGetPriceHistoryArgs_Req_DEFAULT *GetPriceHistoryReq
+
+func (p *ProductServiceGetPriceHistoryArgs) GetReq() (v *GetPriceHistoryReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetPriceHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetPriceHistoryArgs) SetReq(val *GetPriceHistoryReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceGetPriceHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceGetPriceHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetPriceHistoryArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetPriceHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceGetPriceHistoryResult struct {
+	Success *GetPriceHistoryResp `thrift:"success,0,optional" frugal:"0,optional,GetPriceHistoryResp" json:"success,omitempty"`
+}
+
+func NewProductServiceGetPriceHistoryResult() *ProductServiceGetPriceHistoryResult {
+	return &ProductServiceGetPriceHistoryResult{}
+}
+
+func (p *ProductServiceGetPriceHistoryResult) InitDefault() {
+}
+
+var ProductServiceGetPriceHistoryResult_Success_DEFAULT *GetPriceHistoryResp
+
+func (p *ProductServiceGetPriceHistoryResult) GetSuccess() (v *GetPriceHistoryResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetPriceHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetPriceHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPriceHistoryResp)
+}
+
+func (p *ProductServiceGetPriceHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceGetPriceHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetPriceHistoryResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetPriceHistoryResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListProductStatusSchedulesArgs struct {
+	Req *ListProductStatusSchedulesReq `thrift:"req,1" frugal:"1,default,ListProductStatusSchedulesReq" json:"req"`
+}
+
+func NewProductServiceListProductStatusSchedulesArgs() *ProductServiceListProductStatusSchedulesArgs {
+	return &ProductServiceListProductStatusSchedulesArgs{}
+}
+
+func (p *ProductServiceListProductStatusSchedulesArgs) InitDefault() {
+}
+
+var ProductServiceListProductStatusSchedulesArgs_Req_DEFAULT *ListProductStatusSchedulesReq
+
+func (p *ProductServiceListProductStatusSchedulesArgs) GetReq() (v *ListProductStatusSchedulesReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListProductStatusSchedulesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListProductStatusSchedulesArgs) SetReq(val *ListProductStatusSchedulesReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListProductStatusSchedulesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListProductStatusSchedulesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductStatusSchedulesArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListProductStatusSchedulesArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListProductStatusSchedulesResult struct {
+	Success *ListProductStatusSchedulesResp `thrift:"success,0,optional" frugal:"0,optional,ListProductStatusSchedulesResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListProductStatusSchedulesResult() *ProductServiceListProductStatusSchedulesResult {
+	return &ProductServiceListProductStatusSchedulesResult{}
+}
+
+func (p *ProductServiceListProductStatusSchedulesResult) InitDefault() {
+}
+
+var ProductServiceListProductStatusSchedulesResult_Success_DEFAULT *ListProductStatusSchedulesResp
+
+func (p *ProductServiceListProductStatusSchedulesResult) GetSuccess() (v *ListProductStatusSchedulesResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListProductStatusSchedulesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListProductStatusSchedulesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListProductStatusSchedulesResp)
+}
+
+func (p *ProductServiceListProductStatusSchedulesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListProductStatusSchedulesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductStatusSchedulesResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListProductStatusSchedulesResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceCancelProductStatusScheduleArgs struct {
+	Req *CancelProductStatusScheduleReq `thrift:"req,1" frugal:"1,default,CancelProductStatusScheduleReq" json:"req"`
+}
+
+func NewProductServiceCancelProductStatusScheduleArgs() *ProductServiceCancelProductStatusScheduleArgs {
+	return &ProductServiceCancelProductStatusScheduleArgs{}
+}
+
+func (p *ProductServiceCancelProductStatusScheduleArgs) InitDefault() {
+}
+
+var ProductServiceCancelProductStatusScheduleArgs_Req_DEFAULT *CancelProductStatusScheduleReq
+
+func (p *ProductServiceCancelProductStatusScheduleArgs) GetReq() (v *CancelProductStatusScheduleReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCancelProductStatusScheduleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCancelProductStatusScheduleArgs) SetReq(val *CancelProductStatusScheduleReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCancelProductStatusScheduleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCancelProductStatusScheduleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCancelProductStatusScheduleArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCancelProductStatusScheduleArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCancelProductStatusScheduleResult struct {
+	Success *CancelProductStatusScheduleResp `thrift:"success,0,optional" frugal:"0,optional,CancelProductStatusScheduleResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCancelProductStatusScheduleResult() *ProductServiceCancelProductStatusScheduleResult {
+	return &ProductServiceCancelProductStatusScheduleResult{}
+}
+
+func (p *ProductServiceCancelProductStatusScheduleResult) InitDefault() {
+}
+
+var ProductServiceCancelProductStatusScheduleResult_Success_DEFAULT *CancelProductStatusScheduleResp
+
+func (p *ProductServiceCancelProductStatusScheduleResult) GetSuccess() (v *CancelProductStatusScheduleResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCancelProductStatusScheduleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCancelProductStatusScheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelProductStatusScheduleResp)
+}
+
+func (p *ProductServiceCancelProductStatusScheduleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCancelProductStatusScheduleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCancelProductStatusScheduleResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCancelProductStatusScheduleResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceImportProductsArgs struct {
+	Req *ImportProductsReq `thrift:"req,1" frugal:"1,default,ImportProductsReq" json:"req"`
+}
+
+func NewProductServiceImportProductsArgs() *ProductServiceImportProductsArgs {
+	return &ProductServiceImportProductsArgs{}
+}
+
+func (p *ProductServiceImportProductsArgs) InitDefault() {
+}
+
+var ProductServiceImportProductsArgs_Req_DEFAULT *ImportProductsReq
+
+func (p *ProductServiceImportProductsArgs) GetReq() (v *ImportProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceImportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceImportProductsArgs) SetReq(val *ImportProductsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceImportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceImportProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceImportProductsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceImportProductsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceImportProductsResult struct {
+	Success *ImportProductsResp `thrift:"success,0,optional" frugal:"0,optional,ImportProductsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceImportProductsResult() *ProductServiceImportProductsResult {
+	return &ProductServiceImportProductsResult{}
+}
+
+func (p *ProductServiceImportProductsResult) InitDefault() {
+}
+
+var ProductServiceImportProductsResult_Success_DEFAULT *ImportProductsResp
+
+func (p *ProductServiceImportProductsResult) GetSuccess() (v *ImportProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceImportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceImportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ImportProductsResp)
+}
+
+func (p *ProductServiceImportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceImportProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceImportProductsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceImportProductsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceCreateAttributeDefinitionArgs struct {
+	Req *CreateAttributeDefinitionReq `thrift:"req,1" frugal:"1,default,CreateAttributeDefinitionReq" json:"req"`
+}
+
+func NewProductServiceCreateAttributeDefinitionArgs() *ProductServiceCreateAttributeDefinitionArgs {
+	return &ProductServiceCreateAttributeDefinitionArgs{}
+}
+
+func (p *ProductServiceCreateAttributeDefinitionArgs) InitDefault() {
+}
+
+var ProductServiceCreateAttributeDefinitionArgs_Req_DEFAULT *CreateAttributeDefinitionReq
+
+func (p *ProductServiceCreateAttributeDefinitionArgs) GetReq() (v *CreateAttributeDefinitionReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCreateAttributeDefinitionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCreateAttributeDefinitionArgs) SetReq(val *CreateAttributeDefinitionReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCreateAttributeDefinitionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCreateAttributeDefinitionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateAttributeDefinitionArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateAttributeDefinitionArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCreateAttributeDefinitionResult struct {
+	Success *CreateAttributeDefinitionResp `thrift:"success,0,optional" frugal:"0,optional,CreateAttributeDefinitionResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCreateAttributeDefinitionResult() *ProductServiceCreateAttributeDefinitionResult {
+	return &ProductServiceCreateAttributeDefinitionResult{}
+}
+
+func (p *ProductServiceCreateAttributeDefinitionResult) InitDefault() {
+}
+
+var ProductServiceCreateAttributeDefinitionResult_Success_DEFAULT *CreateAttributeDefinitionResp
+
+func (p *ProductServiceCreateAttributeDefinitionResult) GetSuccess() (v *CreateAttributeDefinitionResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCreateAttributeDefinitionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCreateAttributeDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateAttributeDefinitionResp)
+}
+
+func (p *ProductServiceCreateAttributeDefinitionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCreateAttributeDefinitionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateAttributeDefinitionResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateAttributeDefinitionResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceUpdateAttributeDefinitionArgs struct {
+	Req *UpdateAttributeDefinitionReq `thrift:"req,1" frugal:"1,default,UpdateAttributeDefinitionReq" json:"req"`
+}
+
+func NewProductServiceUpdateAttributeDefinitionArgs() *ProductServiceUpdateAttributeDefinitionArgs {
+	return &ProductServiceUpdateAttributeDefinitionArgs{}
+}
+
+func (p *ProductServiceUpdateAttributeDefinitionArgs) InitDefault() {
+}
+
+var ProductServiceUpdateAttributeDefinitionArgs_Req_DEFAULT *UpdateAttributeDefinitionReq
+
+func (p *ProductServiceUpdateAttributeDefinitionArgs) GetReq() (v *UpdateAttributeDefinitionReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateAttributeDefinitionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceUpdateAttributeDefinitionArgs) SetReq(val *UpdateAttributeDefinitionReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceUpdateAttributeDefinitionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUpdateAttributeDefinitionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateAttributeDefinitionArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateAttributeDefinitionArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceUpdateAttributeDefinitionResult struct {
+	Success *UpdateAttributeDefinitionResp `thrift:"success,0,optional" frugal:"0,optional,UpdateAttributeDefinitionResp" json:"success,omitempty"`
+}
+
+func NewProductServiceUpdateAttributeDefinitionResult() *ProductServiceUpdateAttributeDefinitionResult {
+	return &ProductServiceUpdateAttributeDefinitionResult{}
+}
+
+func (p *ProductServiceUpdateAttributeDefinitionResult) InitDefault() {
+}
+
+var ProductServiceUpdateAttributeDefinitionResult_Success_DEFAULT *UpdateAttributeDefinitionResp
+
+func (p *ProductServiceUpdateAttributeDefinitionResult) GetSuccess() (v *UpdateAttributeDefinitionResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateAttributeDefinitionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceUpdateAttributeDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateAttributeDefinitionResp)
+}
+
+func (p *ProductServiceUpdateAttributeDefinitionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceUpdateAttributeDefinitionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateAttributeDefinitionResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateAttributeDefinitionResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListAttributeDefinitionsArgs struct {
+	Req *ListAttributeDefinitionsReq `thrift:"req,1" frugal:"1,default,ListAttributeDefinitionsReq" json:"req"`
+}
+
+func NewProductServiceListAttributeDefinitionsArgs() *ProductServiceListAttributeDefinitionsArgs {
+	return &ProductServiceListAttributeDefinitionsArgs{}
+}
+
+func (p *ProductServiceListAttributeDefinitionsArgs) InitDefault() {
+}
+
+var ProductServiceListAttributeDefinitionsArgs_Req_DEFAULT *ListAttributeDefinitionsReq
+
+func (p *ProductServiceListAttributeDefinitionsArgs) GetReq() (v *ListAttributeDefinitionsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListAttributeDefinitionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListAttributeDefinitionsArgs) SetReq(val *ListAttributeDefinitionsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListAttributeDefinitionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListAttributeDefinitionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListAttributeDefinitionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListAttributeDefinitionsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListAttributeDefinitionsResult struct {
+	Success *ListAttributeDefinitionsResp `thrift:"success,0,optional" frugal:"0,optional,ListAttributeDefinitionsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListAttributeDefinitionsResult() *ProductServiceListAttributeDefinitionsResult {
+	return &ProductServiceListAttributeDefinitionsResult{}
+}
+
+func (p *ProductServiceListAttributeDefinitionsResult) InitDefault() {
+}
+
+var ProductServiceListAttributeDefinitionsResult_Success_DEFAULT *ListAttributeDefinitionsResp
+
+func (p *ProductServiceListAttributeDefinitionsResult) GetSuccess() (v *ListAttributeDefinitionsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListAttributeDefinitionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListAttributeDefinitionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAttributeDefinitionsResp)
+}
+
+func (p *ProductServiceListAttributeDefinitionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListAttributeDefinitionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListAttributeDefinitionsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListAttributeDefinitionsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceDeleteAttributeDefinitionArgs struct {
+	Req *DeleteAttributeDefinitionReq `thrift:"req,1" frugal:"1,default,DeleteAttributeDefinitionReq" json:"req"`
+}
+
+func NewProductServiceDeleteAttributeDefinitionArgs() *ProductServiceDeleteAttributeDefinitionArgs {
+	return &ProductServiceDeleteAttributeDefinitionArgs{}
+}
+
+func (p *ProductServiceDeleteAttributeDefinitionArgs) InitDefault() {
+}
+
+var ProductServiceDeleteAttributeDefinitionArgs_Req_DEFAULT *DeleteAttributeDefinitionReq
+
+func (p *ProductServiceDeleteAttributeDefinitionArgs) GetReq() (v *DeleteAttributeDefinitionReq) {
+	if !p.IsSetReq() {
+		return ProductServiceDeleteAttributeDefinitionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceDeleteAttributeDefinitionArgs) SetReq(val *DeleteAttributeDefinitionReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceDeleteAttributeDefinitionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceDeleteAttributeDefinitionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteAttributeDefinitionArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceDeleteAttributeDefinitionArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceDeleteAttributeDefinitionResult struct {
+	Success *DeleteAttributeDefinitionResp `thrift:"success,0,optional" frugal:"0,optional,DeleteAttributeDefinitionResp" json:"success,omitempty"`
+}
+
+func NewProductServiceDeleteAttributeDefinitionResult() *ProductServiceDeleteAttributeDefinitionResult {
+	return &ProductServiceDeleteAttributeDefinitionResult{}
+}
+
+func (p *ProductServiceDeleteAttributeDefinitionResult) InitDefault() {
+}
+
+var ProductServiceDeleteAttributeDefinitionResult_Success_DEFAULT *DeleteAttributeDefinitionResp
+
+func (p *ProductServiceDeleteAttributeDefinitionResult) GetSuccess() (v *DeleteAttributeDefinitionResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceDeleteAttributeDefinitionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceDeleteAttributeDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteAttributeDefinitionResp)
+}
+
+func (p *ProductServiceDeleteAttributeDefinitionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceDeleteAttributeDefinitionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteAttributeDefinitionResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceDeleteAttributeDefinitionResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceUpdateProductRatingArgs struct {
+	Req *UpdateProductRatingReq `thrift:"req,1" frugal:"1,default,UpdateProductRatingReq" json:"req"`
+}
+
+func NewProductServiceUpdateProductRatingArgs() *ProductServiceUpdateProductRatingArgs {
+	return &ProductServiceUpdateProductRatingArgs{}
+}
+
+func (p *ProductServiceUpdateProductRatingArgs) InitDefault() {
+}
+
+var ProductServiceUpdateProductRatingArgs_Req_DEFAULT *UpdateProductRatingReq
+
+func (p *ProductServiceUpdateProductRatingArgs) GetReq() (v *UpdateProductRatingReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateProductRatingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceUpdateProductRatingArgs) SetReq(val *UpdateProductRatingReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceUpdateProductRatingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUpdateProductRatingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateProductRatingArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateProductRatingArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceUpdateProductRatingResult struct {
+	Success *UpdateProductRatingResp `thrift:"success,0,optional" frugal:"0,optional,UpdateProductRatingResp" json:"success,omitempty"`
+}
+
+func NewProductServiceUpdateProductRatingResult() *ProductServiceUpdateProductRatingResult {
+	return &ProductServiceUpdateProductRatingResult{}
+}
+
+func (p *ProductServiceUpdateProductRatingResult) InitDefault() {
+}
+
+var ProductServiceUpdateProductRatingResult_Success_DEFAULT *UpdateProductRatingResp
+
+func (p *ProductServiceUpdateProductRatingResult) GetSuccess() (v *UpdateProductRatingResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateProductRatingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceUpdateProductRatingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateProductRatingResp)
+}
+
+func (p *ProductServiceUpdateProductRatingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceUpdateProductRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateProductRatingResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateProductRatingResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListProductAuditArgs struct {
+	Req *ListProductAuditReq `thrift:"req,1" frugal:"1,default,ListProductAuditReq" json:"req"`
+}
+
+func NewProductServiceListProductAuditArgs() *ProductServiceListProductAuditArgs {
+	return &ProductServiceListProductAuditArgs{}
+}
+
+func (p *ProductServiceListProductAuditArgs) InitDefault() {
+}
+
+var ProductServiceListProductAuditArgs_Req_DEFAULT *ListProductAuditReq
+
+func (p *ProductServiceListProductAuditArgs) GetReq() (v *ListProductAuditReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListProductAuditArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListProductAuditArgs) SetReq(val *ListProductAuditReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListProductAuditArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListProductAuditArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductAuditArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListProductAuditArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListProductAuditResult struct {
+	Success *ListProductAuditResp `thrift:"success,0,optional" frugal:"0,optional,ListProductAuditResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListProductAuditResult() *ProductServiceListProductAuditResult {
+	return &ProductServiceListProductAuditResult{}
+}
+
+func (p *ProductServiceListProductAuditResult) InitDefault() {
+}
+
+var ProductServiceListProductAuditResult_Success_DEFAULT *ListProductAuditResp
+
+func (p *ProductServiceListProductAuditResult) GetSuccess() (v *ListProductAuditResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListProductAuditResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListProductAuditResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListProductAuditResp)
+}
+
+func (p *ProductServiceListProductAuditResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListProductAuditResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductAuditResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListProductAuditResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceSyncPurchaseStatsArgs struct {
+	Req *SyncPurchaseStatsReq `thrift:"req,1" frugal:"1,default,SyncPurchaseStatsReq" json:"req"`
+}
+
+func NewProductServiceSyncPurchaseStatsArgs() *ProductServiceSyncPurchaseStatsArgs {
+	return &ProductServiceSyncPurchaseStatsArgs{}
+}
+
+func (p *ProductServiceSyncPurchaseStatsArgs) InitDefault() {
+}
+
+var ProductServiceSyncPurchaseStatsArgs_Req_DEFAULT *SyncPurchaseStatsReq
+
+func (p *ProductServiceSyncPurchaseStatsArgs) GetReq() (v *SyncPurchaseStatsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceSyncPurchaseStatsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceSyncPurchaseStatsArgs) SetReq(val *SyncPurchaseStatsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceSyncPurchaseStatsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceSyncPurchaseStatsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceSyncPurchaseStatsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceSyncPurchaseStatsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceSyncPurchaseStatsResult struct {
+	Success *SyncPurchaseStatsResp `thrift:"success,0,optional" frugal:"0,optional,SyncPurchaseStatsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceSyncPurchaseStatsResult() *ProductServiceSyncPurchaseStatsResult {
+	return &ProductServiceSyncPurchaseStatsResult{}
+}
+
+func (p *ProductServiceSyncPurchaseStatsResult) InitDefault() {
+}
+
+var ProductServiceSyncPurchaseStatsResult_Success_DEFAULT *SyncPurchaseStatsResp
+
+func (p *ProductServiceSyncPurchaseStatsResult) GetSuccess() (v *SyncPurchaseStatsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceSyncPurchaseStatsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceSyncPurchaseStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SyncPurchaseStatsResp)
+}
+
+func (p *ProductServiceSyncPurchaseStatsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceSyncPurchaseStatsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceSyncPurchaseStatsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceSyncPurchaseStatsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetRelatedProductsArgs struct {
+	Req *GetRelatedProductsReq `thrift:"req,1" frugal:"1,default,GetRelatedProductsReq" json:"req"`
+}
+
+func NewProductServiceGetRelatedProductsArgs() *ProductServiceGetRelatedProductsArgs {
+	return &ProductServiceGetRelatedProductsArgs{}
+}
+
+func (p *ProductServiceGetRelatedProductsArgs) InitDefault() {
+}
+
+var ProductServiceGetRelatedProductsArgs_Req_DEFAULT *GetRelatedProductsReq
+
+func (p *ProductServiceGetRelatedProductsArgs) GetReq() (v *GetRelatedProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetRelatedProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetRelatedProductsArgs) SetReq(val *GetRelatedProductsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceGetRelatedProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceGetRelatedProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetRelatedProductsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetRelatedProductsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceGetRelatedProductsResult struct {
+	Success *GetRelatedProductsResp `thrift:"success,0,optional" frugal:"0,optional,GetRelatedProductsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceGetRelatedProductsResult() *ProductServiceGetRelatedProductsResult {
+	return &ProductServiceGetRelatedProductsResult{}
+}
+
+func (p *ProductServiceGetRelatedProductsResult) InitDefault() {
+}
+
+var ProductServiceGetRelatedProductsResult_Success_DEFAULT *GetRelatedProductsResp
+
+func (p *ProductServiceGetRelatedProductsResult) GetSuccess() (v *GetRelatedProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetRelatedProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetRelatedProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetRelatedProductsResp)
+}
+
+func (p *ProductServiceGetRelatedProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceGetRelatedProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetRelatedProductsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetRelatedProductsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetFrequentlyBoughtTogetherArgs struct {
+	Req *GetFrequentlyBoughtTogetherReq `thrift:"req,1" frugal:"1,default,GetFrequentlyBoughtTogetherReq" json:"req"`
+}
+
+func NewProductServiceGetFrequentlyBoughtTogetherArgs() *ProductServiceGetFrequentlyBoughtTogetherArgs {
+	return &ProductServiceGetFrequentlyBoughtTogetherArgs{}
+}
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherArgs) InitDefault() {
+}
+
+var ProductServiceGetFrequentlyBoughtTogetherArgs_Req_DEFAULT *GetFrequentlyBoughtTogetherReq
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherArgs) GetReq() (v *GetFrequentlyBoughtTogetherReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetFrequentlyBoughtTogetherArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetFrequentlyBoughtTogetherArgs) SetReq(val *GetFrequentlyBoughtTogetherReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetFrequentlyBoughtTogetherArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetFrequentlyBoughtTogetherArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceGetFrequentlyBoughtTogetherResult struct {
+	Success *GetFrequentlyBoughtTogetherResp `thrift:"success,0,optional" frugal:"0,optional,GetFrequentlyBoughtTogetherResp" json:"success,omitempty"`
+}
+
+func NewProductServiceGetFrequentlyBoughtTogetherResult() *ProductServiceGetFrequentlyBoughtTogetherResult {
+	return &ProductServiceGetFrequentlyBoughtTogetherResult{}
+}
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherResult) InitDefault() {
+}
+
+var ProductServiceGetFrequentlyBoughtTogetherResult_Success_DEFAULT *GetFrequentlyBoughtTogetherResp
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherResult) GetSuccess() (v *GetFrequentlyBoughtTogetherResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetFrequentlyBoughtTogetherResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetFrequentlyBoughtTogetherResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFrequentlyBoughtTogetherResp)
+}
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceGetFrequentlyBoughtTogetherResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetFrequentlyBoughtTogetherResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetFrequentlyBoughtTogetherResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceCreateBrandArgs struct {
+	Req *CreateBrandReq `thrift:"req,1" frugal:"1,default,CreateBrandReq" json:"req"`
+}
+
+func NewProductServiceCreateBrandArgs() *ProductServiceCreateBrandArgs {
+	return &ProductServiceCreateBrandArgs{}
+}
+
+func (p *ProductServiceCreateBrandArgs) InitDefault() {
+}
+
+var ProductServiceCreateBrandArgs_Req_DEFAULT *CreateBrandReq
+
+func (p *ProductServiceCreateBrandArgs) GetReq() (v *CreateBrandReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCreateBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCreateBrandArgs) SetReq(val *CreateBrandReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceCreateBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceCreateBrandArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateBrandArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateBrandArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceCreateBrandResult struct {
+	Success *CreateBrandResp `thrift:"success,0,optional" frugal:"0,optional,CreateBrandResp" json:"success,omitempty"`
+}
+
+func NewProductServiceCreateBrandResult() *ProductServiceCreateBrandResult {
+	return &ProductServiceCreateBrandResult{}
+}
+
+func (p *ProductServiceCreateBrandResult) InitDefault() {
+}
+
+var ProductServiceCreateBrandResult_Success_DEFAULT *CreateBrandResp
+
+func (p *ProductServiceCreateBrandResult) GetSuccess() (v *CreateBrandResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCreateBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceCreateBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateBrandResp)
+}
+
+func (p *ProductServiceCreateBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceCreateBrandResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateBrandResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceCreateBrandResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceUpdateBrandArgs struct {
+	Req *UpdateBrandReq `thrift:"req,1" frugal:"1,default,UpdateBrandReq" json:"req"`
+}
+
+func NewProductServiceUpdateBrandArgs() *ProductServiceUpdateBrandArgs {
+	return &ProductServiceUpdateBrandArgs{}
+}
+
+func (p *ProductServiceUpdateBrandArgs) InitDefault() {
+}
+
+var ProductServiceUpdateBrandArgs_Req_DEFAULT *UpdateBrandReq
+
+func (p *ProductServiceUpdateBrandArgs) GetReq() (v *UpdateBrandReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceUpdateBrandArgs) SetReq(val *UpdateBrandReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceUpdateBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUpdateBrandArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateBrandArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateBrandArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceUpdateBrandResult struct {
+	Success *UpdateBrandResp `thrift:"success,0,optional" frugal:"0,optional,UpdateBrandResp" json:"success,omitempty"`
+}
+
+func NewProductServiceUpdateBrandResult() *ProductServiceUpdateBrandResult {
+	return &ProductServiceUpdateBrandResult{}
+}
+
+func (p *ProductServiceUpdateBrandResult) InitDefault() {
+}
+
+var ProductServiceUpdateBrandResult_Success_DEFAULT *UpdateBrandResp
+
+func (p *ProductServiceUpdateBrandResult) GetSuccess() (v *UpdateBrandResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceUpdateBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateBrandResp)
+}
+
+func (p *ProductServiceUpdateBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceUpdateBrandResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceUpdateBrandResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceUpdateBrandResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetBrandArgs struct {
+	Req *GetBrandReq `thrift:"req,1" frugal:"1,default,GetBrandReq" json:"req"`
+}
+
+func NewProductServiceGetBrandArgs() *ProductServiceGetBrandArgs {
+	return &ProductServiceGetBrandArgs{}
+}
+
+func (p *ProductServiceGetBrandArgs) InitDefault() {
+}
+
+var ProductServiceGetBrandArgs_Req_DEFAULT *GetBrandReq
+
+func (p *ProductServiceGetBrandArgs) GetReq() (v *GetBrandReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetBrandArgs) SetReq(val *GetBrandReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceGetBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceGetBrandArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetBrandArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetBrandArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceGetBrandResult struct {
+	Success *GetBrandResp `thrift:"success,0,optional" frugal:"0,optional,GetBrandResp" json:"success,omitempty"`
+}
+
+func NewProductServiceGetBrandResult() *ProductServiceGetBrandResult {
+	return &ProductServiceGetBrandResult{}
+}
+
+func (p *ProductServiceGetBrandResult) InitDefault() {
+}
+
+var ProductServiceGetBrandResult_Success_DEFAULT *GetBrandResp
+
+func (p *ProductServiceGetBrandResult) GetSuccess() (v *GetBrandResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetBrandResp)
+}
+
+func (p *ProductServiceGetBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceGetBrandResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceGetBrandResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceGetBrandResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceListBrandsArgs struct {
+	Req *ListBrandsReq `thrift:"req,1" frugal:"1,default,ListBrandsReq" json:"req"`
+}
+
+func NewProductServiceListBrandsArgs() *ProductServiceListBrandsArgs {
+	return &ProductServiceListBrandsArgs{}
+}
+
+func (p *ProductServiceListBrandsArgs) InitDefault() {
+}
+
+var ProductServiceListBrandsArgs_Req_DEFAULT *ListBrandsReq
+
+func (p *ProductServiceListBrandsArgs) GetReq() (v *ListBrandsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListBrandsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListBrandsArgs) SetReq(val *ListBrandsReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceListBrandsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListBrandsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListBrandsArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListBrandsArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListBrandsResult struct {
+	Success *ListBrandsResp `thrift:"success,0,optional" frugal:"0,optional,ListBrandsResp" json:"success,omitempty"`
+}
+
+func NewProductServiceListBrandsResult() *ProductServiceListBrandsResult {
+	return &ProductServiceListBrandsResult{}
+}
+
+func (p *ProductServiceListBrandsResult) InitDefault() {
+}
+
+var ProductServiceListBrandsResult_Success_DEFAULT *ListBrandsResp
+
+func (p *ProductServiceListBrandsResult) GetSuccess() (v *ListBrandsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListBrandsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListBrandsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListBrandsResp)
+}
+
+func (p *ProductServiceListBrandsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceListBrandsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListBrandsResult(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListBrandsResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceDeleteBrandArgs struct {
+	Req *DeleteBrandReq `thrift:"req,1" frugal:"1,default,DeleteBrandReq" json:"req"`
+}
+
+func NewProductServiceDeleteBrandArgs() *ProductServiceDeleteBrandArgs {
+	return &ProductServiceDeleteBrandArgs{}
+}
+
+func (p *ProductServiceDeleteBrandArgs) InitDefault() {
+}
+
+var ProductServiceDeleteBrandArgs_Req_DEFAULT *DeleteBrandReq
+
+func (p *ProductServiceDeleteBrandArgs) GetReq() (v *DeleteBrandReq) {
+	if !p.IsSetReq() {
+		return ProductServiceDeleteBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceDeleteBrandArgs) SetReq(val *DeleteBrandReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceDeleteBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceDeleteBrandArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteBrandArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceDeleteBrandArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceDeleteBrandResult struct {
+	Success *DeleteBrandResp `thrift:"success,0,optional" frugal:"0,optional,DeleteBrandResp" json:"success,omitempty"`
+}
+
+func NewProductServiceDeleteBrandResult() *ProductServiceDeleteBrandResult {
+	return &ProductServiceDeleteBrandResult{}
+}
+
+func (p *ProductServiceDeleteBrandResult) InitDefault() {
+}
+
+var ProductServiceDeleteBrandResult_Success_DEFAULT *DeleteBrandResp
+
+func (p *ProductServiceDeleteBrandResult) GetSuccess() (v *DeleteBrandResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceDeleteBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceDeleteBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteBrandResp)
+}
+
+func (p *ProductServiceDeleteBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceDeleteBrandResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteBrandResult(%+v)", *p)
 }
 
-func NewProduct() *Product {
-	return &Product{
-		Status: ProductStatus_DRAFT,
-	}
+var fieldIDToName_ProductServiceDeleteBrandResult = map[int16]string{
+	0: "success",
 }
 
-func (p *Product) InitDefault() {
-	p.Status = ProductStatus_DRAFT
+type ProductServiceSubmitProductApprovalArgs struct {
+	Req *SubmitProductApprovalReq `thrift:"req,1" frugal:"1,default,SubmitProductApprovalReq" json:"req"`
 }
 
-func (p *Product) GetId() (v int64) {
-	return p.Id
+func NewProductServiceSubmitProductApprovalArgs() *ProductServiceSubmitProductApprovalArgs {
+	return &ProductServiceSubmitProductApprovalArgs{}
 }
 
-func (p *Product) GetName() (v string) {
-	return p.Name
+func (p *ProductServiceSubmitProductApprovalArgs) InitDefault() {
 }
 
-func (p *Product) GetAvatar() (v string) {
-	return p.Avatar
+var ProductServiceSubmitProductApprovalArgs_Req_DEFAULT *SubmitProductApprovalReq
+
+func (p *ProductServiceSubmitProductApprovalArgs) GetReq() (v *SubmitProductApprovalReq) {
+	if !p.IsSetReq() {
+		return ProductServiceSubmitProductApprovalArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceSubmitProductApprovalArgs) SetReq(val *SubmitProductApprovalReq) {
+	p.Req = val
 }
 
-func (p *Product) GetCategory() (v string) {
-	return p.Category
+func (p *ProductServiceSubmitProductApprovalArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *Product) GetPrice() (v float64) {
-	return p.Price
+func (p *ProductServiceSubmitProductApprovalArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceSubmitProductApprovalArgs(%+v)", *p)
 }
 
-func (p *Product) GetStock() (v int32) {
-	return p.Stock
+var fieldIDToName_ProductServiceSubmitProductApprovalArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *Product) GetStatus() (v ProductStatus) {
-	return p.Status
+type ProductServiceSubmitProductApprovalResult struct {
+	Success *SubmitProductApprovalResp `thrift:"success,0,optional" frugal:"0,optional,SubmitProductApprovalResp" json:"success,omitempty"`
 }
 
-func (p *Product) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func NewProductServiceSubmitProductApprovalResult() *ProductServiceSubmitProductApprovalResult {
+	return &ProductServiceSubmitProductApprovalResult{}
 }
 
-func (p *Product) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func (p *ProductServiceSubmitProductApprovalResult) InitDefault() {
 }
 
-var Product_Brand_DEFAULT string
+var ProductServiceSubmitProductApprovalResult_Success_DEFAULT *SubmitProductApprovalResp
 
-func (p *Product) GetBrand() (v string) {
-	if !p.IsSetBrand() {
-		return Product_Brand_DEFAULT
+func (p *ProductServiceSubmitProductApprovalResult) GetSuccess() (v *SubmitProductApprovalResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceSubmitProductApprovalResult_Success_DEFAULT
 	}
-	return *p.Brand
-}
-func (p *Product) SetId(val int64) {
-	p.Id = val
+	return p.Success
 }
-func (p *Product) SetName(val string) {
-	p.Name = val
+func (p *ProductServiceSubmitProductApprovalResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitProductApprovalResp)
 }
-func (p *Product) SetAvatar(val string) {
-	p.Avatar = val
+
+func (p *ProductServiceSubmitProductApprovalResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
-func (p *Product) SetCategory(val string) {
-	p.Category = val
+
+func (p *ProductServiceSubmitProductApprovalResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceSubmitProductApprovalResult(%+v)", *p)
 }
-func (p *Product) SetPrice(val float64) {
-	p.Price = val
+
+var fieldIDToName_ProductServiceSubmitProductApprovalResult = map[int16]string{
+	0: "success",
 }
-func (p *Product) SetStock(val int32) {
-	p.Stock = val
+
+type ProductServiceReviewProductApprovalArgs struct {
+	Req *ReviewProductApprovalReq `thrift:"req,1" frugal:"1,default,ReviewProductApprovalReq" json:"req"`
 }
-func (p *Product) SetStatus(val ProductStatus) {
-	p.Status = val
+
+func NewProductServiceReviewProductApprovalArgs() *ProductServiceReviewProductApprovalArgs {
+	return &ProductServiceReviewProductApprovalArgs{}
 }
-func (p *Product) SetCreatedAt(val int64) {
-	p.CreatedAt = val
+
+func (p *ProductServiceReviewProductApprovalArgs) InitDefault() {
 }
-func (p *Product) SetUpdatedAt(val int64) {
-	p.UpdatedAt = val
+
+var ProductServiceReviewProductApprovalArgs_Req_DEFAULT *ReviewProductApprovalReq
+
+func (p *ProductServiceReviewProductApprovalArgs) GetReq() (v *ReviewProductApprovalReq) {
+	if !p.IsSetReq() {
+		return ProductServiceReviewProductApprovalArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *Product) SetBrand(val *string) {
-	p.Brand = val
+func (p *ProductServiceReviewProductApprovalArgs) SetReq(val *ReviewProductApprovalReq) {
+	p.Req = val
 }
 
-func (p *Product) IsSetBrand() bool {
-	return p.Brand != nil
+func (p *ProductServiceReviewProductApprovalArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *Product) String() string {
+func (p *ProductServiceReviewProductApprovalArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Product(%+v)", *p)
+	return fmt.Sprintf("ProductServiceReviewProductApprovalArgs(%+v)", *p)
 }
 
-var fieldIDToName_Product = map[int16]string{
-	1:  "id",
-	2:  "name",
-	3:  "avatar",
-	4:  "category",
-	5:  "price",
-	6:  "stock",
-	7:  "status",
-	8:  "createdAt",
-	9:  "updatedAt",
-	10: "brand",
+var fieldIDToName_ProductServiceReviewProductApprovalArgs = map[int16]string{
+	1: "req",
 }
 
-type SimpleProduct struct {
-	Id       int64         `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	Category string        `thrift:"category,2" frugal:"2,default,string" json:"category"`
-	Price    float64       `thrift:"price,3" frugal:"3,default,double" json:"price"`
-	Stock    int32         `thrift:"stock,4" frugal:"4,default,i32" json:"stock"`
-	Status   ProductStatus `thrift:"status,5" frugal:"5,default,ProductStatus" json:"status"`
-	Brand    *string       `thrift:"brand,6,optional" frugal:"6,optional,string" json:"brand,omitempty"`
-	Name     string        `thrift:"name,7" frugal:"7,default,string" json:"name"`
-	Avatar   string        `thrift:"avatar,8" frugal:"8,default,string" json:"avatar"`
+type ProductServiceReviewProductApprovalResult struct {
+	Success *ReviewProductApprovalResp `thrift:"success,0,optional" frugal:"0,optional,ReviewProductApprovalResp" json:"success,omitempty"`
 }
 
-func NewSimpleProduct() *SimpleProduct {
-	return &SimpleProduct{
-		Status: ProductStatus_ONLINE,
+func NewProductServiceReviewProductApprovalResult() *ProductServiceReviewProductApprovalResult {
+	return &ProductServiceReviewProductApprovalResult{}
+}
+
+func (p *ProductServiceReviewProductApprovalResult) InitDefault() {
+}
+
+var ProductServiceReviewProductApprovalResult_Success_DEFAULT *ReviewProductApprovalResp
+
+func (p *ProductServiceReviewProductApprovalResult) GetSuccess() (v *ReviewProductApprovalResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceReviewProductApprovalResult_Success_DEFAULT
 	}
+	return p.Success
+}
+func (p *ProductServiceReviewProductApprovalResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReviewProductApprovalResp)
 }
 
-func (p *SimpleProduct) InitDefault() {
-	p.Status = ProductStatus_ONLINE
+func (p *ProductServiceReviewProductApprovalResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *SimpleProduct) GetId() (v int64) {
-	return p.Id
+func (p *ProductServiceReviewProductApprovalResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceReviewProductApprovalResult(%+v)", *p)
 }
 
-func (p *SimpleProduct) GetCategory() (v string) {
-	return p.Category
+var fieldIDToName_ProductServiceReviewProductApprovalResult = map[int16]string{
+	0: "success",
 }
 
-func (p *SimpleProduct) GetPrice() (v float64) {
-	return p.Price
+type ProductServiceListProductApprovalsArgs struct {
+	Req *ListProductApprovalsReq `thrift:"req,1" frugal:"1,default,ListProductApprovalsReq" json:"req"`
 }
 
-func (p *SimpleProduct) GetStock() (v int32) {
-	return p.Stock
+func NewProductServiceListProductApprovalsArgs() *ProductServiceListProductApprovalsArgs {
+	return &ProductServiceListProductApprovalsArgs{}
 }
 
-func (p *SimpleProduct) GetStatus() (v ProductStatus) {
-	return p.Status
+func (p *ProductServiceListProductApprovalsArgs) InitDefault() {
 }
 
-var SimpleProduct_Brand_DEFAULT string
+var ProductServiceListProductApprovalsArgs_Req_DEFAULT *ListProductApprovalsReq
 
-func (p *SimpleProduct) GetBrand() (v string) {
-	if !p.IsSetBrand() {
-		return SimpleProduct_Brand_DEFAULT
+func (p *ProductServiceListProductApprovalsArgs) GetReq() (v *ListProductApprovalsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListProductApprovalsArgs_Req_DEFAULT
 	}
-	return *p.Brand
+	return p.Req
 }
-
-func (p *SimpleProduct) GetName() (v string) {
-	return p.Name
+func (p *ProductServiceListProductApprovalsArgs) SetReq(val *ListProductApprovalsReq) {
+	p.Req = val
 }
 
-func (p *SimpleProduct) GetAvatar() (v string) {
-	return p.Avatar
-}
-func (p *SimpleProduct) SetId(val int64) {
-	p.Id = val
+func (p *ProductServiceListProductApprovalsArgs) IsSetReq() bool {
+	return p.Req != nil
 }
-func (p *SimpleProduct) SetCategory(val string) {
-	p.Category = val
+
+func (p *ProductServiceListProductApprovalsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListProductApprovalsArgs(%+v)", *p)
 }
-func (p *SimpleProduct) SetPrice(val float64) {
-	p.Price = val
+
+var fieldIDToName_ProductServiceListProductApprovalsArgs = map[int16]string{
+	1: "req",
 }
-func (p *SimpleProduct) SetStock(val int32) {
-	p.Stock = val
+
+type ProductServiceListProductApprovalsResult struct {
+	Success *ListProductApprovalsResp `thrift:"success,0,optional" frugal:"0,optional,ListProductApprovalsResp" json:"success,omitempty"`
 }
-func (p *SimpleProduct) SetStatus(val ProductStatus) {
-	p.Status = val
+
+func NewProductServiceListProductApprovalsResult() *ProductServiceListProductApprovalsResult {
+	return &ProductServiceListProductApprovalsResult{}
 }
-func (p *SimpleProduct) SetBrand(val *string) {
-	p.Brand = val
+
+func (p *ProductServiceListProductApprovalsResult) InitDefault() {
 }
-func (p *SimpleProduct) SetName(val string) {
-	p.Name = val
+
+var ProductServiceListProductApprovalsResult_Success_DEFAULT *ListProductApprovalsResp
+
+func (p *ProductServiceListProductApprovalsResult) GetSuccess() (v *ListProductApprovalsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListProductApprovalsResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *SimpleProduct) SetAvatar(val string) {
-	p.Avatar = val
+func (p *ProductServiceListProductApprovalsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListProductApprovalsResp)
 }
 
-func (p *SimpleProduct) IsSetBrand() bool {
-	return p.Brand != nil
+func (p *ProductServiceListProductApprovalsResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *SimpleProduct) String() string {
+func (p *ProductServiceListProductApprovalsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SimpleProduct(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListProductApprovalsResult(%+v)", *p)
 }
 
-var fieldIDToName_SimpleProduct = map[int16]string{
-	1: "id",
-	2: "category",
-	3: "price",
-	4: "stock",
-	5: "status",
-	6: "brand",
-	7: "name",
-	8: "avatar",
+var fieldIDToName_ProductServiceListProductApprovalsResult = map[int16]string{
+	0: "success",
 }
 
-type CreateProductReq struct {
-	Name     string        `thrift:"name,1" frugal:"1,default,string" json:"name"`
-	Avatar   string        `thrift:"avatar,2" frugal:"2,default,string" json:"avatar"`
-	Category string        `thrift:"category,3" frugal:"3,default,string" json:"category"`
-	Price    float64       `thrift:"price,4" frugal:"4,default,double" json:"price"`
-	Stock    int32         `thrift:"stock,5" frugal:"5,default,i32" json:"stock"`
-	Brand    *string       `thrift:"brand,6,optional" frugal:"6,optional,string" json:"brand,omitempty"`
-	Status   ProductStatus `thrift:"status,7,optional" frugal:"7,optional,ProductStatus" json:"status,omitempty"`
+type ProductServiceCreateMerchantArgs struct {
+	Req *CreateMerchantReq `thrift:"req,1" frugal:"1,default,CreateMerchantReq" json:"req"`
 }
 
-func NewCreateProductReq() *CreateProductReq {
-	return &CreateProductReq{
-		Status: ProductStatus_DRAFT,
-	}
+func NewProductServiceCreateMerchantArgs() *ProductServiceCreateMerchantArgs {
+	return &ProductServiceCreateMerchantArgs{}
 }
 
-func (p *CreateProductReq) InitDefault() {
-	p.Status = ProductStatus_DRAFT
+func (p *ProductServiceCreateMerchantArgs) InitDefault() {
 }
 
-func (p *CreateProductReq) GetName() (v string) {
-	return p.Name
+var ProductServiceCreateMerchantArgs_Req_DEFAULT *CreateMerchantReq
+
+func (p *ProductServiceCreateMerchantArgs) GetReq() (v *CreateMerchantReq) {
+	if !p.IsSetReq() {
+		return ProductServiceCreateMerchantArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceCreateMerchantArgs) SetReq(val *CreateMerchantReq) {
+	p.Req = val
 }
 
-func (p *CreateProductReq) GetAvatar() (v string) {
-	return p.Avatar
+func (p *ProductServiceCreateMerchantArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CreateProductReq) GetCategory() (v string) {
-	return p.Category
+func (p *ProductServiceCreateMerchantArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateMerchantArgs(%+v)", *p)
 }
 
-func (p *CreateProductReq) GetPrice() (v float64) {
-	return p.Price
+var fieldIDToName_ProductServiceCreateMerchantArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *CreateProductReq) GetStock() (v int32) {
-	return p.Stock
+type ProductServiceCreateMerchantResult struct {
+	Success *CreateMerchantResp `thrift:"success,0,optional" frugal:"0,optional,CreateMerchantResp" json:"success,omitempty"`
 }
 
-var CreateProductReq_Brand_DEFAULT string
+func NewProductServiceCreateMerchantResult() *ProductServiceCreateMerchantResult {
+	return &ProductServiceCreateMerchantResult{}
+}
 
-func (p *CreateProductReq) GetBrand() (v string) {
-	if !p.IsSetBrand() {
-		return CreateProductReq_Brand_DEFAULT
-	}
-	return *p.Brand
+func (p *ProductServiceCreateMerchantResult) InitDefault() {
 }
 
-var CreateProductReq_Status_DEFAULT ProductStatus = ProductStatus_DRAFT
+var ProductServiceCreateMerchantResult_Success_DEFAULT *CreateMerchantResp
 
-func (p *CreateProductReq) GetStatus() (v ProductStatus) {
-	if !p.IsSetStatus() {
-		return CreateProductReq_Status_DEFAULT
+func (p *ProductServiceCreateMerchantResult) GetSuccess() (v *CreateMerchantResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceCreateMerchantResult_Success_DEFAULT
 	}
-	return p.Status
+	return p.Success
 }
-func (p *CreateProductReq) SetName(val string) {
-	p.Name = val
+func (p *ProductServiceCreateMerchantResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateMerchantResp)
 }
-func (p *CreateProductReq) SetAvatar(val string) {
-	p.Avatar = val
+
+func (p *ProductServiceCreateMerchantResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
-func (p *CreateProductReq) SetCategory(val string) {
-	p.Category = val
+
+func (p *ProductServiceCreateMerchantResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceCreateMerchantResult(%+v)", *p)
 }
-func (p *CreateProductReq) SetPrice(val float64) {
-	p.Price = val
+
+var fieldIDToName_ProductServiceCreateMerchantResult = map[int16]string{
+	0: "success",
 }
-func (p *CreateProductReq) SetStock(val int32) {
-	p.Stock = val
+
+type ProductServiceUpdateMerchantArgs struct {
+	Req *UpdateMerchantReq `thrift:"req,1" frugal:"1,default,UpdateMerchantReq" json:"req"`
 }
-func (p *CreateProductReq) SetBrand(val *string) {
-	p.Brand = val
+
+func NewProductServiceUpdateMerchantArgs() *ProductServiceUpdateMerchantArgs {
+	return &ProductServiceUpdateMerchantArgs{}
 }
-func (p *CreateProductReq) SetStatus(val ProductStatus) {
-	p.Status = val
+
+func (p *ProductServiceUpdateMerchantArgs) InitDefault() {
+}
+
+var ProductServiceUpdateMerchantArgs_Req_DEFAULT *UpdateMerchantReq
+
+func (p *ProductServiceUpdateMerchantArgs) GetReq() (v *UpdateMerchantReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateMerchantArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-
-func (p *CreateProductReq) IsSetBrand() bool {
-	return p.Brand != nil
+func (p *ProductServiceUpdateMerchantArgs) SetReq(val *UpdateMerchantReq) {
+	p.Req = val
 }
 
-func (p *CreateProductReq) IsSetStatus() bool {
-	return p.Status != CreateProductReq_Status_DEFAULT
+func (p *ProductServiceUpdateMerchantArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CreateProductReq) String() string {
+func (p *ProductServiceUpdateMerchantArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateProductReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceUpdateMerchantArgs(%+v)", *p)
 }
 
-var fieldIDToName_CreateProductReq = map[int16]string{
-	1: "name",
-	2: "avatar",
-	3: "category",
-	4: "price",
-	5: "stock",
-	6: "brand",
-	7: "status",
+var fieldIDToName_ProductServiceUpdateMerchantArgs = map[int16]string{
+	1: "req",
 }
 
-type CreateProductResp struct {
-	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+type ProductServiceUpdateMerchantResult struct {
+	Success *UpdateMerchantResp `thrift:"success,0,optional" frugal:"0,optional,UpdateMerchantResp" json:"success,omitempty"`
 }
 
-func NewCreateProductResp() *CreateProductResp {
-	return &CreateProductResp{
-		Code: 0,
-	}
+func NewProductServiceUpdateMerchantResult() *ProductServiceUpdateMerchantResult {
+	return &ProductServiceUpdateMerchantResult{}
 }
 
-func (p *CreateProductResp) InitDefault() {
-	p.Code = 0
+func (p *ProductServiceUpdateMerchantResult) InitDefault() {
 }
 
-func (p *CreateProductResp) GetSuccess() (v bool) {
+var ProductServiceUpdateMerchantResult_Success_DEFAULT *UpdateMerchantResp
+
+func (p *ProductServiceUpdateMerchantResult) GetSuccess() (v *UpdateMerchantResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateMerchantResult_Success_DEFAULT
+	}
 	return p.Success
 }
-
-func (p *CreateProductResp) GetCode() (v int32) {
-	return p.Code
+func (p *ProductServiceUpdateMerchantResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateMerchantResp)
 }
 
-var CreateProductResp_Message_DEFAULT string
-
-func (p *CreateProductResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return CreateProductResp_Message_DEFAULT
-	}
-	return *p.Message
+func (p *ProductServiceUpdateMerchantResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-var CreateProductResp_Product_DEFAULT *Product
-
-func (p *CreateProductResp) GetProduct() (v *Product) {
-	if !p.IsSetProduct() {
-		return CreateProductResp_Product_DEFAULT
+func (p *ProductServiceUpdateMerchantResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Product
+	return fmt.Sprintf("ProductServiceUpdateMerchantResult(%+v)", *p)
 }
-func (p *CreateProductResp) SetSuccess(val bool) {
-	p.Success = val
+
+var fieldIDToName_ProductServiceUpdateMerchantResult = map[int16]string{
+	0: "success",
 }
-func (p *CreateProductResp) SetCode(val int32) {
-	p.Code = val
+
+type ProductServiceGetMerchantArgs struct {
+	Req *GetMerchantReq `thrift:"req,1" frugal:"1,default,GetMerchantReq" json:"req"`
 }
-func (p *CreateProductResp) SetMessage(val *string) {
-	p.Message = val
+
+func NewProductServiceGetMerchantArgs() *ProductServiceGetMerchantArgs {
+	return &ProductServiceGetMerchantArgs{}
 }
-func (p *CreateProductResp) SetProduct(val *Product) {
-	p.Product = val
+
+func (p *ProductServiceGetMerchantArgs) InitDefault() {
 }
 
-func (p *CreateProductResp) IsSetMessage() bool {
-	return p.Message != nil
+var ProductServiceGetMerchantArgs_Req_DEFAULT *GetMerchantReq
+
+func (p *ProductServiceGetMerchantArgs) GetReq() (v *GetMerchantReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetMerchantArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceGetMerchantArgs) SetReq(val *GetMerchantReq) {
+	p.Req = val
 }
 
-func (p *CreateProductResp) IsSetProduct() bool {
-	return p.Product != nil
+func (p *ProductServiceGetMerchantArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CreateProductResp) String() string {
+func (p *ProductServiceGetMerchantArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateProductResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceGetMerchantArgs(%+v)", *p)
 }
 
-var fieldIDToName_CreateProductResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "product",
+var fieldIDToName_ProductServiceGetMerchantArgs = map[int16]string{
+	1: "req",
 }
 
-type GetProductReq struct {
-	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+type ProductServiceGetMerchantResult struct {
+	Success *GetMerchantResp `thrift:"success,0,optional" frugal:"0,optional,GetMerchantResp" json:"success,omitempty"`
 }
 
-func NewGetProductReq() *GetProductReq {
-	return &GetProductReq{}
+func NewProductServiceGetMerchantResult() *ProductServiceGetMerchantResult {
+	return &ProductServiceGetMerchantResult{}
 }
 
-func (p *GetProductReq) InitDefault() {
+func (p *ProductServiceGetMerchantResult) InitDefault() {
 }
 
-func (p *GetProductReq) GetId() (v int64) {
-	return p.Id
-}
-func (p *GetProductReq) SetId(val int64) {
-	p.Id = val
-}
+var ProductServiceGetMerchantResult_Success_DEFAULT *GetMerchantResp
 
-func (p *GetProductReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ProductServiceGetMerchantResult) GetSuccess() (v *GetMerchantResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetMerchantResult_Success_DEFAULT
 	}
-	return fmt.Sprintf("GetProductReq(%+v)", *p)
+	return p.Success
 }
-
-var fieldIDToName_GetProductReq = map[int16]string{
-	1: "id",
+func (p *ProductServiceGetMerchantResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetMerchantResp)
 }
 
-type GetProductResp struct {
-	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+func (p *ProductServiceGetMerchantResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func NewGetProductResp() *GetProductResp {
-	return &GetProductResp{
-		Code: 0,
+func (p *ProductServiceGetMerchantResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ProductServiceGetMerchantResult(%+v)", *p)
 }
 
-func (p *GetProductResp) InitDefault() {
-	p.Code = 0
+var fieldIDToName_ProductServiceGetMerchantResult = map[int16]string{
+	0: "success",
 }
 
-func (p *GetProductResp) GetSuccess() (v bool) {
-	return p.Success
+type ProductServiceListMerchantsArgs struct {
+	Req *ListMerchantsReq `thrift:"req,1" frugal:"1,default,ListMerchantsReq" json:"req"`
 }
 
-func (p *GetProductResp) GetCode() (v int32) {
-	return p.Code
+func NewProductServiceListMerchantsArgs() *ProductServiceListMerchantsArgs {
+	return &ProductServiceListMerchantsArgs{}
 }
 
-var GetProductResp_Message_DEFAULT string
+func (p *ProductServiceListMerchantsArgs) InitDefault() {
+}
 
-func (p *GetProductResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return GetProductResp_Message_DEFAULT
+var ProductServiceListMerchantsArgs_Req_DEFAULT *ListMerchantsReq
+
+func (p *ProductServiceListMerchantsArgs) GetReq() (v *ListMerchantsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListMerchantsArgs_Req_DEFAULT
 	}
-	return *p.Message
+	return p.Req
+}
+func (p *ProductServiceListMerchantsArgs) SetReq(val *ListMerchantsReq) {
+	p.Req = val
 }
 
-var GetProductResp_Product_DEFAULT *Product
+func (p *ProductServiceListMerchantsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *GetProductResp) GetProduct() (v *Product) {
-	if !p.IsSetProduct() {
-		return GetProductResp_Product_DEFAULT
+func (p *ProductServiceListMerchantsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Product
+	return fmt.Sprintf("ProductServiceListMerchantsArgs(%+v)", *p)
 }
-func (p *GetProductResp) SetSuccess(val bool) {
-	p.Success = val
+
+var fieldIDToName_ProductServiceListMerchantsArgs = map[int16]string{
+	1: "req",
 }
-func (p *GetProductResp) SetCode(val int32) {
-	p.Code = val
+
+type ProductServiceListMerchantsResult struct {
+	Success *ListMerchantsResp `thrift:"success,0,optional" frugal:"0,optional,ListMerchantsResp" json:"success,omitempty"`
 }
-func (p *GetProductResp) SetMessage(val *string) {
-	p.Message = val
+
+func NewProductServiceListMerchantsResult() *ProductServiceListMerchantsResult {
+	return &ProductServiceListMerchantsResult{}
 }
-func (p *GetProductResp) SetProduct(val *Product) {
-	p.Product = val
+
+func (p *ProductServiceListMerchantsResult) InitDefault() {
 }
 
-func (p *GetProductResp) IsSetMessage() bool {
-	return p.Message != nil
+var ProductServiceListMerchantsResult_Success_DEFAULT *ListMerchantsResp
+
+func (p *ProductServiceListMerchantsResult) GetSuccess() (v *ListMerchantsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListMerchantsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceListMerchantsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListMerchantsResp)
 }
 
-func (p *GetProductResp) IsSetProduct() bool {
-	return p.Product != nil
+func (p *ProductServiceListMerchantsResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *GetProductResp) String() string {
+func (p *ProductServiceListMerchantsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetProductResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListMerchantsResult(%+v)", *p)
 }
 
-var fieldIDToName_GetProductResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "product",
+var fieldIDToName_ProductServiceListMerchantsResult = map[int16]string{
+	0: "success",
 }
 
-type UpdateProductReq struct {
-	Id       int64          `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	Name     *string        `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
-	Avatar   *string        `thrift:"avatar,3,optional" frugal:"3,optional,string" json:"avatar,omitempty"`
-	Category *string        `thrift:"category,4,optional" frugal:"4,optional,string" json:"category,omitempty"`
-	Price    *float64       `thrift:"price,5,optional" frugal:"5,optional,double" json:"price,omitempty"`
-	Stock    *int32         `thrift:"stock,6,optional" frugal:"6,optional,i32" json:"stock,omitempty"`
-	Status   *ProductStatus `thrift:"status,7,optional" frugal:"7,optional,ProductStatus" json:"status,omitempty"`
-	Brand    *string        `thrift:"brand,8,optional" frugal:"8,optional,string" json:"brand,omitempty"`
+type ProductServiceSuggestProductsArgs struct {
+	Req *SuggestProductsReq `thrift:"req,1" frugal:"1,default,SuggestProductsReq" json:"req"`
 }
 
-func NewUpdateProductReq() *UpdateProductReq {
-	return &UpdateProductReq{}
+func NewProductServiceSuggestProductsArgs() *ProductServiceSuggestProductsArgs {
+	return &ProductServiceSuggestProductsArgs{}
 }
 
-func (p *UpdateProductReq) InitDefault() {
+func (p *ProductServiceSuggestProductsArgs) InitDefault() {
 }
 
-func (p *UpdateProductReq) GetId() (v int64) {
-	return p.Id
+var ProductServiceSuggestProductsArgs_Req_DEFAULT *SuggestProductsReq
+
+func (p *ProductServiceSuggestProductsArgs) GetReq() (v *SuggestProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceSuggestProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceSuggestProductsArgs) SetReq(val *SuggestProductsReq) {
+	p.Req = val
 }
 
-var UpdateProductReq_Name_DEFAULT string
+func (p *ProductServiceSuggestProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *UpdateProductReq) GetName() (v string) {
-	if !p.IsSetName() {
-		return UpdateProductReq_Name_DEFAULT
+func (p *ProductServiceSuggestProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Name
+	return fmt.Sprintf("ProductServiceSuggestProductsArgs(%+v)", *p)
 }
 
-var UpdateProductReq_Avatar_DEFAULT string
+var fieldIDToName_ProductServiceSuggestProductsArgs = map[int16]string{
+	1: "req",
+}
 
-func (p *UpdateProductReq) GetAvatar() (v string) {
-	if !p.IsSetAvatar() {
-		return UpdateProductReq_Avatar_DEFAULT
-	}
-	return *p.Avatar
+type ProductServiceSuggestProductsResult struct {
+	Success *SuggestProductsResp `thrift:"success,0,optional" frugal:"0,optional,SuggestProductsResp" json:"success,omitempty"`
 }
 
-var UpdateProductReq_Category_DEFAULT string
+func NewProductServiceSuggestProductsResult() *ProductServiceSuggestProductsResult {
+	return &ProductServiceSuggestProductsResult{}
+}
 
-func (p *UpdateProductReq) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return UpdateProductReq_Category_DEFAULT
-	}
-	return *p.Category
+func (p *ProductServiceSuggestProductsResult) InitDefault() {
 }
 
-var UpdateProductReq_Price_DEFAULT float64
+var ProductServiceSuggestProductsResult_Success_DEFAULT *SuggestProductsResp
 
-func (p *UpdateProductReq) GetPrice() (v float64) {
-	if !p.IsSetPrice() {
-		return UpdateProductReq_Price_DEFAULT
+func (p *ProductServiceSuggestProductsResult) GetSuccess() (v *SuggestProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceSuggestProductsResult_Success_DEFAULT
 	}
-	return *p.Price
+	return p.Success
+}
+func (p *ProductServiceSuggestProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SuggestProductsResp)
 }
 
-var UpdateProductReq_Stock_DEFAULT int32
+func (p *ProductServiceSuggestProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-func (p *UpdateProductReq) GetStock() (v int32) {
-	if !p.IsSetStock() {
-		return UpdateProductReq_Stock_DEFAULT
+func (p *ProductServiceSuggestProductsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Stock
+	return fmt.Sprintf("ProductServiceSuggestProductsResult(%+v)", *p)
 }
 
-var UpdateProductReq_Status_DEFAULT ProductStatus
+var fieldIDToName_ProductServiceSuggestProductsResult = map[int16]string{
+	0: "success",
+}
 
-func (p *UpdateProductReq) GetStatus() (v ProductStatus) {
-	if !p.IsSetStatus() {
-		return UpdateProductReq_Status_DEFAULT
-	}
-	return *p.Status
+type ProductServiceListSearchQueriesArgs struct {
+	Req *ListSearchQueriesReq `thrift:"req,1" frugal:"1,default,ListSearchQueriesReq" json:"req"`
 }
 
-var UpdateProductReq_Brand_DEFAULT string
+func NewProductServiceListSearchQueriesArgs() *ProductServiceListSearchQueriesArgs {
+	return &ProductServiceListSearchQueriesArgs{}
+}
 
-func (p *UpdateProductReq) GetBrand() (v string) {
-	if !p.IsSetBrand() {
-		return UpdateProductReq_Brand_DEFAULT
+func (p *ProductServiceListSearchQueriesArgs) InitDefault() {
+}
+
+var ProductServiceListSearchQueriesArgs_Req_DEFAULT *ListSearchQueriesReq
+
+func (p *ProductServiceListSearchQueriesArgs) GetReq() (v *ListSearchQueriesReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListSearchQueriesArgs_Req_DEFAULT
 	}
-	return *p.Brand
+	return p.Req
 }
-func (p *UpdateProductReq) SetId(val int64) {
-	p.Id = val
+func (p *ProductServiceListSearchQueriesArgs) SetReq(val *ListSearchQueriesReq) {
+	p.Req = val
 }
-func (p *UpdateProductReq) SetName(val *string) {
-	p.Name = val
+
+func (p *ProductServiceListSearchQueriesArgs) IsSetReq() bool {
+	return p.Req != nil
 }
-func (p *UpdateProductReq) SetAvatar(val *string) {
-	p.Avatar = val
+
+func (p *ProductServiceListSearchQueriesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListSearchQueriesArgs(%+v)", *p)
+}
+
+var fieldIDToName_ProductServiceListSearchQueriesArgs = map[int16]string{
+	1: "req",
+}
+
+type ProductServiceListSearchQueriesResult struct {
+	Success *ListSearchQueriesResp `thrift:"success,0,optional" frugal:"0,optional,ListSearchQueriesResp" json:"success,omitempty"`
 }
-func (p *UpdateProductReq) SetCategory(val *string) {
-	p.Category = val
+
+func NewProductServiceListSearchQueriesResult() *ProductServiceListSearchQueriesResult {
+	return &ProductServiceListSearchQueriesResult{}
 }
-func (p *UpdateProductReq) SetPrice(val *float64) {
-	p.Price = val
+
+func (p *ProductServiceListSearchQueriesResult) InitDefault() {
 }
-func (p *UpdateProductReq) SetStock(val *int32) {
-	p.Stock = val
+
+var ProductServiceListSearchQueriesResult_Success_DEFAULT *ListSearchQueriesResp
+
+func (p *ProductServiceListSearchQueriesResult) GetSuccess() (v *ListSearchQueriesResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListSearchQueriesResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *UpdateProductReq) SetStatus(val *ProductStatus) {
-	p.Status = val
+func (p *ProductServiceListSearchQueriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSearchQueriesResp)
 }
-func (p *UpdateProductReq) SetBrand(val *string) {
-	p.Brand = val
+
+func (p *ProductServiceListSearchQueriesResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *UpdateProductReq) IsSetName() bool {
-	return p.Name != nil
+func (p *ProductServiceListSearchQueriesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListSearchQueriesResult(%+v)", *p)
 }
 
-func (p *UpdateProductReq) IsSetAvatar() bool {
-	return p.Avatar != nil
+var fieldIDToName_ProductServiceListSearchQueriesResult = map[int16]string{
+	0: "success",
 }
 
-func (p *UpdateProductReq) IsSetCategory() bool {
-	return p.Category != nil
+type ProductServiceRecordProductSalesArgs struct {
+	Req *RecordProductSalesReq `thrift:"req,1" frugal:"1,default,RecordProductSalesReq" json:"req"`
 }
 
-func (p *UpdateProductReq) IsSetPrice() bool {
-	return p.Price != nil
+func NewProductServiceRecordProductSalesArgs() *ProductServiceRecordProductSalesArgs {
+	return &ProductServiceRecordProductSalesArgs{}
 }
 
-func (p *UpdateProductReq) IsSetStock() bool {
-	return p.Stock != nil
+func (p *ProductServiceRecordProductSalesArgs) InitDefault() {
 }
 
-func (p *UpdateProductReq) IsSetStatus() bool {
-	return p.Status != nil
+var ProductServiceRecordProductSalesArgs_Req_DEFAULT *RecordProductSalesReq
+
+func (p *ProductServiceRecordProductSalesArgs) GetReq() (v *RecordProductSalesReq) {
+	if !p.IsSetReq() {
+		return ProductServiceRecordProductSalesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceRecordProductSalesArgs) SetReq(val *RecordProductSalesReq) {
+	p.Req = val
 }
 
-func (p *UpdateProductReq) IsSetBrand() bool {
-	return p.Brand != nil
+func (p *ProductServiceRecordProductSalesArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *UpdateProductReq) String() string {
+func (p *ProductServiceRecordProductSalesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateProductReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceRecordProductSalesArgs(%+v)", *p)
 }
 
-var fieldIDToName_UpdateProductReq = map[int16]string{
-	1: "id",
-	2: "name",
-	3: "avatar",
-	4: "category",
-	5: "price",
-	6: "stock",
-	7: "status",
-	8: "brand",
+var fieldIDToName_ProductServiceRecordProductSalesArgs = map[int16]string{
+	1: "req",
 }
 
-type UpdateProductResp struct {
-	Success bool     `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code    int32    `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message *string  `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	Product *Product `thrift:"product,4" frugal:"4,default,Product" json:"product"`
+type ProductServiceRecordProductSalesResult struct {
+	Success *RecordProductSalesResp `thrift:"success,0,optional" frugal:"0,optional,RecordProductSalesResp" json:"success,omitempty"`
 }
 
-func NewUpdateProductResp() *UpdateProductResp {
-	return &UpdateProductResp{
-		Code: 0,
-	}
+func NewProductServiceRecordProductSalesResult() *ProductServiceRecordProductSalesResult {
+	return &ProductServiceRecordProductSalesResult{}
 }
 
-func (p *UpdateProductResp) InitDefault() {
-	p.Code = 0
+func (p *ProductServiceRecordProductSalesResult) InitDefault() {
 }
 
-func (p *UpdateProductResp) GetSuccess() (v bool) {
+var ProductServiceRecordProductSalesResult_Success_DEFAULT *RecordProductSalesResp
+
+func (p *ProductServiceRecordProductSalesResult) GetSuccess() (v *RecordProductSalesResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceRecordProductSalesResult_Success_DEFAULT
+	}
 	return p.Success
 }
+func (p *ProductServiceRecordProductSalesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RecordProductSalesResp)
+}
 
-func (p *UpdateProductResp) GetCode() (v int32) {
-	return p.Code
+func (p *ProductServiceRecordProductSalesResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-var UpdateProductResp_Message_DEFAULT string
+func (p *ProductServiceRecordProductSalesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceRecordProductSalesResult(%+v)", *p)
+}
 
-func (p *UpdateProductResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return UpdateProductResp_Message_DEFAULT
+var fieldIDToName_ProductServiceRecordProductSalesResult = map[int16]string{
+	0: "success",
+}
+
+type ProductServiceGetTopProductsArgs struct {
+	Req *GetTopProductsReq `thrift:"req,1" frugal:"1,default,GetTopProductsReq" json:"req"`
+}
+
+func NewProductServiceGetTopProductsArgs() *ProductServiceGetTopProductsArgs {
+	return &ProductServiceGetTopProductsArgs{}
+}
+
+func (p *ProductServiceGetTopProductsArgs) InitDefault() {
+}
+
+var ProductServiceGetTopProductsArgs_Req_DEFAULT *GetTopProductsReq
+
+func (p *ProductServiceGetTopProductsArgs) GetReq() (v *GetTopProductsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceGetTopProductsArgs_Req_DEFAULT
 	}
-	return *p.Message
+	return p.Req
+}
+func (p *ProductServiceGetTopProductsArgs) SetReq(val *GetTopProductsReq) {
+	p.Req = val
 }
 
-var UpdateProductResp_Product_DEFAULT *Product
+func (p *ProductServiceGetTopProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *UpdateProductResp) GetProduct() (v *Product) {
-	if !p.IsSetProduct() {
-		return UpdateProductResp_Product_DEFAULT
+func (p *ProductServiceGetTopProductsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Product
+	return fmt.Sprintf("ProductServiceGetTopProductsArgs(%+v)", *p)
 }
-func (p *UpdateProductResp) SetSuccess(val bool) {
-	p.Success = val
+
+var fieldIDToName_ProductServiceGetTopProductsArgs = map[int16]string{
+	1: "req",
 }
-func (p *UpdateProductResp) SetCode(val int32) {
-	p.Code = val
+
+type ProductServiceGetTopProductsResult struct {
+	Success *GetTopProductsResp `thrift:"success,0,optional" frugal:"0,optional,GetTopProductsResp" json:"success,omitempty"`
 }
-func (p *UpdateProductResp) SetMessage(val *string) {
-	p.Message = val
+
+func NewProductServiceGetTopProductsResult() *ProductServiceGetTopProductsResult {
+	return &ProductServiceGetTopProductsResult{}
 }
-func (p *UpdateProductResp) SetProduct(val *Product) {
-	p.Product = val
+
+func (p *ProductServiceGetTopProductsResult) InitDefault() {
 }
 
-func (p *UpdateProductResp) IsSetMessage() bool {
-	return p.Message != nil
+var ProductServiceGetTopProductsResult_Success_DEFAULT *GetTopProductsResp
+
+func (p *ProductServiceGetTopProductsResult) GetSuccess() (v *GetTopProductsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceGetTopProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceGetTopProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTopProductsResp)
 }
 
-func (p *UpdateProductResp) IsSetProduct() bool {
-	return p.Product != nil
+func (p *ProductServiceGetTopProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *UpdateProductResp) String() string {
+func (p *ProductServiceGetTopProductsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateProductResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceGetTopProductsResult(%+v)", *p)
 }
 
-var fieldIDToName_UpdateProductResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "product",
+var fieldIDToName_ProductServiceGetTopProductsResult = map[int16]string{
+	0: "success",
 }
 
-type DeleteProductReq struct {
-	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+type ProductServiceUpdateBundleComponentsArgs struct {
+	Req *UpdateBundleComponentsReq `thrift:"req,1" frugal:"1,default,UpdateBundleComponentsReq" json:"req"`
 }
 
-func NewDeleteProductReq() *DeleteProductReq {
-	return &DeleteProductReq{}
+func NewProductServiceUpdateBundleComponentsArgs() *ProductServiceUpdateBundleComponentsArgs {
+	return &ProductServiceUpdateBundleComponentsArgs{}
 }
 
-func (p *DeleteProductReq) InitDefault() {
+func (p *ProductServiceUpdateBundleComponentsArgs) InitDefault() {
 }
 
-func (p *DeleteProductReq) GetId() (v int64) {
-	return p.Id
+var ProductServiceUpdateBundleComponentsArgs_Req_DEFAULT *UpdateBundleComponentsReq
+
+func (p *ProductServiceUpdateBundleComponentsArgs) GetReq() (v *UpdateBundleComponentsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceUpdateBundleComponentsArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *DeleteProductReq) SetId(val int64) {
-	p.Id = val
+func (p *ProductServiceUpdateBundleComponentsArgs) SetReq(val *UpdateBundleComponentsReq) {
+	p.Req = val
 }
 
-func (p *DeleteProductReq) String() string {
+func (p *ProductServiceUpdateBundleComponentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceUpdateBundleComponentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteProductReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceUpdateBundleComponentsArgs(%+v)", *p)
 }
 
-var fieldIDToName_DeleteProductReq = map[int16]string{
-	1: "id",
+var fieldIDToName_ProductServiceUpdateBundleComponentsArgs = map[int16]string{
+	1: "req",
 }
 
-type DeleteProductResp struct {
-	Success bool    `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code    int32   `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
+type ProductServiceUpdateBundleComponentsResult struct {
+	Success *UpdateBundleComponentsResp `thrift:"success,0,optional" frugal:"0,optional,UpdateBundleComponentsResp" json:"success,omitempty"`
 }
 
-func NewDeleteProductResp() *DeleteProductResp {
-	return &DeleteProductResp{
-		Code: 0,
-	}
+func NewProductServiceUpdateBundleComponentsResult() *ProductServiceUpdateBundleComponentsResult {
+	return &ProductServiceUpdateBundleComponentsResult{}
 }
 
-func (p *DeleteProductResp) InitDefault() {
-	p.Code = 0
+func (p *ProductServiceUpdateBundleComponentsResult) InitDefault() {
 }
 
-func (p *DeleteProductResp) GetSuccess() (v bool) {
+var ProductServiceUpdateBundleComponentsResult_Success_DEFAULT *UpdateBundleComponentsResp
+
+func (p *ProductServiceUpdateBundleComponentsResult) GetSuccess() (v *UpdateBundleComponentsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceUpdateBundleComponentsResult_Success_DEFAULT
+	}
 	return p.Success
 }
-
-func (p *DeleteProductResp) GetCode() (v int32) {
-	return p.Code
+func (p *ProductServiceUpdateBundleComponentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateBundleComponentsResp)
 }
 
-var DeleteProductResp_Message_DEFAULT string
+func (p *ProductServiceUpdateBundleComponentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-func (p *DeleteProductResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return DeleteProductResp_Message_DEFAULT
+func (p *ProductServiceUpdateBundleComponentsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Message
+	return fmt.Sprintf("ProductServiceUpdateBundleComponentsResult(%+v)", *p)
 }
-func (p *DeleteProductResp) SetSuccess(val bool) {
-	p.Success = val
+
+var fieldIDToName_ProductServiceUpdateBundleComponentsResult = map[int16]string{
+	0: "success",
 }
-func (p *DeleteProductResp) SetCode(val int32) {
-	p.Code = val
+
+type ProductServiceSetProductTranslationArgs struct {
+	Req *SetProductTranslationReq `thrift:"req,1" frugal:"1,default,SetProductTranslationReq" json:"req"`
 }
-func (p *DeleteProductResp) SetMessage(val *string) {
-	p.Message = val
+
+func NewProductServiceSetProductTranslationArgs() *ProductServiceSetProductTranslationArgs {
+	return &ProductServiceSetProductTranslationArgs{}
 }
 
-func (p *DeleteProductResp) IsSetMessage() bool {
-	return p.Message != nil
+func (p *ProductServiceSetProductTranslationArgs) InitDefault() {
 }
 
-func (p *DeleteProductResp) String() string {
+var ProductServiceSetProductTranslationArgs_Req_DEFAULT *SetProductTranslationReq
+
+func (p *ProductServiceSetProductTranslationArgs) GetReq() (v *SetProductTranslationReq) {
+	if !p.IsSetReq() {
+		return ProductServiceSetProductTranslationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceSetProductTranslationArgs) SetReq(val *SetProductTranslationReq) {
+	p.Req = val
+}
+
+func (p *ProductServiceSetProductTranslationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceSetProductTranslationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteProductResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetProductTranslationArgs(%+v)", *p)
 }
 
-var fieldIDToName_DeleteProductResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
+var fieldIDToName_ProductServiceSetProductTranslationArgs = map[int16]string{
+	1: "req",
 }
 
-type OnlineProductReq struct {
-	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+type ProductServiceSetProductTranslationResult struct {
+	Success *SetProductTranslationResp `thrift:"success,0,optional" frugal:"0,optional,SetProductTranslationResp" json:"success,omitempty"`
 }
 
-func NewOnlineProductReq() *OnlineProductReq {
-	return &OnlineProductReq{}
+func NewProductServiceSetProductTranslationResult() *ProductServiceSetProductTranslationResult {
+	return &ProductServiceSetProductTranslationResult{}
 }
 
-func (p *OnlineProductReq) InitDefault() {
+func (p *ProductServiceSetProductTranslationResult) InitDefault() {
 }
 
-func (p *OnlineProductReq) GetId() (v int64) {
-	return p.Id
+var ProductServiceSetProductTranslationResult_Success_DEFAULT *SetProductTranslationResp
+
+func (p *ProductServiceSetProductTranslationResult) GetSuccess() (v *SetProductTranslationResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceSetProductTranslationResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *OnlineProductReq) SetId(val int64) {
-	p.Id = val
+func (p *ProductServiceSetProductTranslationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetProductTranslationResp)
 }
 
-func (p *OnlineProductReq) String() string {
+func (p *ProductServiceSetProductTranslationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ProductServiceSetProductTranslationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OnlineProductReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetProductTranslationResult(%+v)", *p)
 }
 
-var fieldIDToName_OnlineProductReq = map[int16]string{
-	1: "id",
+var fieldIDToName_ProductServiceSetProductTranslationResult = map[int16]string{
+	0: "success",
 }
 
-type OnlineProductResp struct {
-	Success    bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code       int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message    *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	OldStatus  ProductStatus `thrift:"oldStatus,4" frugal:"4,default,ProductStatus" json:"oldStatus"`
-	NewStatus_ ProductStatus `thrift:"newStatus,5" frugal:"5,default,ProductStatus" json:"newStatus"`
-	OperatedAt int64         `thrift:"operatedAt,6" frugal:"6,default,i64" json:"operatedAt"`
+type ProductServiceDeleteProductTranslationArgs struct {
+	Req *DeleteProductTranslationReq `thrift:"req,1" frugal:"1,default,DeleteProductTranslationReq" json:"req"`
 }
 
-func NewOnlineProductResp() *OnlineProductResp {
-	return &OnlineProductResp{
-		Code: 0,
-	}
+func NewProductServiceDeleteProductTranslationArgs() *ProductServiceDeleteProductTranslationArgs {
+	return &ProductServiceDeleteProductTranslationArgs{}
 }
 
-func (p *OnlineProductResp) InitDefault() {
-	p.Code = 0
+func (p *ProductServiceDeleteProductTranslationArgs) InitDefault() {
 }
 
-func (p *OnlineProductResp) GetSuccess() (v bool) {
-	return p.Success
+var ProductServiceDeleteProductTranslationArgs_Req_DEFAULT *DeleteProductTranslationReq
+
+func (p *ProductServiceDeleteProductTranslationArgs) GetReq() (v *DeleteProductTranslationReq) {
+	if !p.IsSetReq() {
+		return ProductServiceDeleteProductTranslationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceDeleteProductTranslationArgs) SetReq(val *DeleteProductTranslationReq) {
+	p.Req = val
 }
 
-func (p *OnlineProductResp) GetCode() (v int32) {
-	return p.Code
+func (p *ProductServiceDeleteProductTranslationArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-var OnlineProductResp_Message_DEFAULT string
-
-func (p *OnlineProductResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return OnlineProductResp_Message_DEFAULT
+func (p *ProductServiceDeleteProductTranslationArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Message
+	return fmt.Sprintf("ProductServiceDeleteProductTranslationArgs(%+v)", *p)
 }
 
-func (p *OnlineProductResp) GetOldStatus() (v ProductStatus) {
-	return p.OldStatus
+var fieldIDToName_ProductServiceDeleteProductTranslationArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *OnlineProductResp) GetNewStatus_() (v ProductStatus) {
-	return p.NewStatus_
+type ProductServiceDeleteProductTranslationResult struct {
+	Success *DeleteProductTranslationResp `thrift:"success,0,optional" frugal:"0,optional,DeleteProductTranslationResp" json:"success,omitempty"`
 }
 
-func (p *OnlineProductResp) GetOperatedAt() (v int64) {
-	return p.OperatedAt
-}
-func (p *OnlineProductResp) SetSuccess(val bool) {
-	p.Success = val
-}
-func (p *OnlineProductResp) SetCode(val int32) {
-	p.Code = val
+func NewProductServiceDeleteProductTranslationResult() *ProductServiceDeleteProductTranslationResult {
+	return &ProductServiceDeleteProductTranslationResult{}
 }
-func (p *OnlineProductResp) SetMessage(val *string) {
-	p.Message = val
-}
-func (p *OnlineProductResp) SetOldStatus(val ProductStatus) {
-	p.OldStatus = val
+
+func (p *ProductServiceDeleteProductTranslationResult) InitDefault() {
 }
-func (p *OnlineProductResp) SetNewStatus_(val ProductStatus) {
-	p.NewStatus_ = val
+
+var ProductServiceDeleteProductTranslationResult_Success_DEFAULT *DeleteProductTranslationResp
+
+func (p *ProductServiceDeleteProductTranslationResult) GetSuccess() (v *DeleteProductTranslationResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceDeleteProductTranslationResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *OnlineProductResp) SetOperatedAt(val int64) {
-	p.OperatedAt = val
+func (p *ProductServiceDeleteProductTranslationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteProductTranslationResp)
 }
 
-func (p *OnlineProductResp) IsSetMessage() bool {
-	return p.Message != nil
+func (p *ProductServiceDeleteProductTranslationResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *OnlineProductResp) String() string {
+func (p *ProductServiceDeleteProductTranslationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OnlineProductResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceDeleteProductTranslationResult(%+v)", *p)
 }
 
-var fieldIDToName_OnlineProductResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "oldStatus",
-	5: "newStatus",
-	6: "operatedAt",
+var fieldIDToName_ProductServiceDeleteProductTranslationResult = map[int16]string{
+	0: "success",
 }
 
-type OfflineProductReq struct {
-	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+type ProductServiceListProductTranslationsArgs struct {
+	Req *ListProductTranslationsReq `thrift:"req,1" frugal:"1,default,ListProductTranslationsReq" json:"req"`
 }
 
-func NewOfflineProductReq() *OfflineProductReq {
-	return &OfflineProductReq{}
+func NewProductServiceListProductTranslationsArgs() *ProductServiceListProductTranslationsArgs {
+	return &ProductServiceListProductTranslationsArgs{}
 }
 
-func (p *OfflineProductReq) InitDefault() {
+func (p *ProductServiceListProductTranslationsArgs) InitDefault() {
 }
 
-func (p *OfflineProductReq) GetId() (v int64) {
-	return p.Id
+var ProductServiceListProductTranslationsArgs_Req_DEFAULT *ListProductTranslationsReq
+
+func (p *ProductServiceListProductTranslationsArgs) GetReq() (v *ListProductTranslationsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListProductTranslationsArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *OfflineProductReq) SetId(val int64) {
-	p.Id = val
+func (p *ProductServiceListProductTranslationsArgs) SetReq(val *ListProductTranslationsReq) {
+	p.Req = val
 }
 
-func (p *OfflineProductReq) String() string {
+func (p *ProductServiceListProductTranslationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListProductTranslationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OfflineProductReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListProductTranslationsArgs(%+v)", *p)
 }
 
-var fieldIDToName_OfflineProductReq = map[int16]string{
-	1: "id",
+var fieldIDToName_ProductServiceListProductTranslationsArgs = map[int16]string{
+	1: "req",
 }
 
-type OfflineProductResp struct {
-	Success    bool          `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code       int32         `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message    *string       `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	OldStatus  ProductStatus `thrift:"oldStatus,4" frugal:"4,default,ProductStatus" json:"oldStatus"`
-	NewStatus_ ProductStatus `thrift:"newStatus,5" frugal:"5,default,ProductStatus" json:"newStatus"`
-	OperatedAt int64         `thrift:"operatedAt,6" frugal:"6,default,i64" json:"operatedAt"`
+type ProductServiceListProductTranslationsResult struct {
+	Success *ListProductTranslationsResp `thrift:"success,0,optional" frugal:"0,optional,ListProductTranslationsResp" json:"success,omitempty"`
 }
 
-func NewOfflineProductResp() *OfflineProductResp {
-	return &OfflineProductResp{
-		Code: 0,
-	}
+func NewProductServiceListProductTranslationsResult() *ProductServiceListProductTranslationsResult {
+	return &ProductServiceListProductTranslationsResult{}
 }
 
-func (p *OfflineProductResp) InitDefault() {
-	p.Code = 0
+func (p *ProductServiceListProductTranslationsResult) InitDefault() {
 }
 
-func (p *OfflineProductResp) GetSuccess() (v bool) {
+var ProductServiceListProductTranslationsResult_Success_DEFAULT *ListProductTranslationsResp
+
+func (p *ProductServiceListProductTranslationsResult) GetSuccess() (v *ListProductTranslationsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListProductTranslationsResult_Success_DEFAULT
+	}
 	return p.Success
 }
-
-func (p *OfflineProductResp) GetCode() (v int32) {
-	return p.Code
+func (p *ProductServiceListProductTranslationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListProductTranslationsResp)
 }
 
-var OfflineProductResp_Message_DEFAULT string
+func (p *ProductServiceListProductTranslationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-func (p *OfflineProductResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return OfflineProductResp_Message_DEFAULT
+func (p *ProductServiceListProductTranslationsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Message
+	return fmt.Sprintf("ProductServiceListProductTranslationsResult(%+v)", *p)
 }
 
-func (p *OfflineProductResp) GetOldStatus() (v ProductStatus) {
-	return p.OldStatus
+var fieldIDToName_ProductServiceListProductTranslationsResult = map[int16]string{
+	0: "success",
 }
 
-func (p *OfflineProductResp) GetNewStatus_() (v ProductStatus) {
-	return p.NewStatus_
+type ProductServiceSetCategoryTranslationArgs struct {
+	Req *SetCategoryTranslationReq `thrift:"req,1" frugal:"1,default,SetCategoryTranslationReq" json:"req"`
 }
 
-func (p *OfflineProductResp) GetOperatedAt() (v int64) {
-	return p.OperatedAt
-}
-func (p *OfflineProductResp) SetSuccess(val bool) {
-	p.Success = val
-}
-func (p *OfflineProductResp) SetCode(val int32) {
-	p.Code = val
+func NewProductServiceSetCategoryTranslationArgs() *ProductServiceSetCategoryTranslationArgs {
+	return &ProductServiceSetCategoryTranslationArgs{}
 }
-func (p *OfflineProductResp) SetMessage(val *string) {
-	p.Message = val
-}
-func (p *OfflineProductResp) SetOldStatus(val ProductStatus) {
-	p.OldStatus = val
+
+func (p *ProductServiceSetCategoryTranslationArgs) InitDefault() {
 }
-func (p *OfflineProductResp) SetNewStatus_(val ProductStatus) {
-	p.NewStatus_ = val
+
+var ProductServiceSetCategoryTranslationArgs_Req_DEFAULT *SetCategoryTranslationReq
+
+func (p *ProductServiceSetCategoryTranslationArgs) GetReq() (v *SetCategoryTranslationReq) {
+	if !p.IsSetReq() {
+		return ProductServiceSetCategoryTranslationArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *OfflineProductResp) SetOperatedAt(val int64) {
-	p.OperatedAt = val
+func (p *ProductServiceSetCategoryTranslationArgs) SetReq(val *SetCategoryTranslationReq) {
+	p.Req = val
 }
 
-func (p *OfflineProductResp) IsSetMessage() bool {
-	return p.Message != nil
+func (p *ProductServiceSetCategoryTranslationArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *OfflineProductResp) String() string {
+func (p *ProductServiceSetCategoryTranslationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OfflineProductResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetCategoryTranslationArgs(%+v)", *p)
 }
 
-var fieldIDToName_OfflineProductResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "oldStatus",
-	5: "newStatus",
-	6: "operatedAt",
+var fieldIDToName_ProductServiceSetCategoryTranslationArgs = map[int16]string{
+	1: "req",
 }
 
-type UserSearchProductsReq struct {
-	Category *string  `thrift:"category,1,optional" frugal:"1,optional,string" json:"category,omitempty"`
-	MinPrice *float64 `thrift:"minPrice,2,optional" frugal:"2,optional,double" json:"minPrice,omitempty"`
-	MaxPrice *float64 `thrift:"maxPrice,3,optional" frugal:"3,optional,double" json:"maxPrice,omitempty"`
-	Keyword  *string  `thrift:"keyword,4,optional" frugal:"4,optional,string" json:"keyword,omitempty"`
-	Page     int32    `thrift:"page,5" frugal:"5,default,i32" json:"page"`
-	PageSize int32    `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
+type ProductServiceSetCategoryTranslationResult struct {
+	Success *SetCategoryTranslationResp `thrift:"success,0,optional" frugal:"0,optional,SetCategoryTranslationResp" json:"success,omitempty"`
 }
 
-func NewUserSearchProductsReq() *UserSearchProductsReq {
-	return &UserSearchProductsReq{
-		Page:     1,
-		PageSize: 20,
-	}
+func NewProductServiceSetCategoryTranslationResult() *ProductServiceSetCategoryTranslationResult {
+	return &ProductServiceSetCategoryTranslationResult{}
 }
 
-func (p *UserSearchProductsReq) InitDefault() {
-	p.Page = 1
-	p.PageSize = 20
+func (p *ProductServiceSetCategoryTranslationResult) InitDefault() {
 }
 
-var UserSearchProductsReq_Category_DEFAULT string
+var ProductServiceSetCategoryTranslationResult_Success_DEFAULT *SetCategoryTranslationResp
 
-func (p *UserSearchProductsReq) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return UserSearchProductsReq_Category_DEFAULT
+func (p *ProductServiceSetCategoryTranslationResult) GetSuccess() (v *SetCategoryTranslationResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceSetCategoryTranslationResult_Success_DEFAULT
 	}
-	return *p.Category
+	return p.Success
 }
-
-var UserSearchProductsReq_MinPrice_DEFAULT float64
-
-func (p *UserSearchProductsReq) GetMinPrice() (v float64) {
-	if !p.IsSetMinPrice() {
-		return UserSearchProductsReq_MinPrice_DEFAULT
-	}
-	return *p.MinPrice
+func (p *ProductServiceSetCategoryTranslationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetCategoryTranslationResp)
 }
 
-var UserSearchProductsReq_MaxPrice_DEFAULT float64
+func (p *ProductServiceSetCategoryTranslationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-func (p *UserSearchProductsReq) GetMaxPrice() (v float64) {
-	if !p.IsSetMaxPrice() {
-		return UserSearchProductsReq_MaxPrice_DEFAULT
+func (p *ProductServiceSetCategoryTranslationResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.MaxPrice
+	return fmt.Sprintf("ProductServiceSetCategoryTranslationResult(%+v)", *p)
 }
 
-var UserSearchProductsReq_Keyword_DEFAULT string
+var fieldIDToName_ProductServiceSetCategoryTranslationResult = map[int16]string{
+	0: "success",
+}
 
-func (p *UserSearchProductsReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return UserSearchProductsReq_Keyword_DEFAULT
-	}
-	return *p.Keyword
+type ProductServiceDeleteCategoryTranslationArgs struct {
+	Req *DeleteCategoryTranslationReq `thrift:"req,1" frugal:"1,default,DeleteCategoryTranslationReq" json:"req"`
 }
 
-func (p *UserSearchProductsReq) GetPage() (v int32) {
-	return p.Page
+func NewProductServiceDeleteCategoryTranslationArgs() *ProductServiceDeleteCategoryTranslationArgs {
+	return &ProductServiceDeleteCategoryTranslationArgs{}
 }
 
-func (p *UserSearchProductsReq) GetPageSize() (v int32) {
-	return p.PageSize
+func (p *ProductServiceDeleteCategoryTranslationArgs) InitDefault() {
 }
-func (p *UserSearchProductsReq) SetCategory(val *string) {
-	p.Category = val
+
+var ProductServiceDeleteCategoryTranslationArgs_Req_DEFAULT *DeleteCategoryTranslationReq
+
+func (p *ProductServiceDeleteCategoryTranslationArgs) GetReq() (v *DeleteCategoryTranslationReq) {
+	if !p.IsSetReq() {
+		return ProductServiceDeleteCategoryTranslationArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *UserSearchProductsReq) SetMinPrice(val *float64) {
-	p.MinPrice = val
+func (p *ProductServiceDeleteCategoryTranslationArgs) SetReq(val *DeleteCategoryTranslationReq) {
+	p.Req = val
 }
-func (p *UserSearchProductsReq) SetMaxPrice(val *float64) {
-	p.MaxPrice = val
+
+func (p *ProductServiceDeleteCategoryTranslationArgs) IsSetReq() bool {
+	return p.Req != nil
 }
-func (p *UserSearchProductsReq) SetKeyword(val *string) {
-	p.Keyword = val
+
+func (p *ProductServiceDeleteCategoryTranslationArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceDeleteCategoryTranslationArgs(%+v)", *p)
 }
-func (p *UserSearchProductsReq) SetPage(val int32) {
-	p.Page = val
+
+var fieldIDToName_ProductServiceDeleteCategoryTranslationArgs = map[int16]string{
+	1: "req",
 }
-func (p *UserSearchProductsReq) SetPageSize(val int32) {
-	p.PageSize = val
+
+type ProductServiceDeleteCategoryTranslationResult struct {
+	Success *DeleteCategoryTranslationResp `thrift:"success,0,optional" frugal:"0,optional,DeleteCategoryTranslationResp" json:"success,omitempty"`
 }
 
-func (p *UserSearchProductsReq) IsSetCategory() bool {
-	return p.Category != nil
+func NewProductServiceDeleteCategoryTranslationResult() *ProductServiceDeleteCategoryTranslationResult {
+	return &ProductServiceDeleteCategoryTranslationResult{}
 }
 
-func (p *UserSearchProductsReq) IsSetMinPrice() bool {
-	return p.MinPrice != nil
+func (p *ProductServiceDeleteCategoryTranslationResult) InitDefault() {
 }
 
-func (p *UserSearchProductsReq) IsSetMaxPrice() bool {
-	return p.MaxPrice != nil
+var ProductServiceDeleteCategoryTranslationResult_Success_DEFAULT *DeleteCategoryTranslationResp
+
+func (p *ProductServiceDeleteCategoryTranslationResult) GetSuccess() (v *DeleteCategoryTranslationResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceDeleteCategoryTranslationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceDeleteCategoryTranslationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteCategoryTranslationResp)
 }
 
-func (p *UserSearchProductsReq) IsSetKeyword() bool {
-	return p.Keyword != nil
+func (p *ProductServiceDeleteCategoryTranslationResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *UserSearchProductsReq) String() string {
+func (p *ProductServiceDeleteCategoryTranslationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserSearchProductsReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceDeleteCategoryTranslationResult(%+v)", *p)
 }
 
-var fieldIDToName_UserSearchProductsReq = map[int16]string{
-	1: "category",
-	2: "minPrice",
-	3: "maxPrice",
-	4: "keyword",
-	5: "page",
-	6: "pageSize",
+var fieldIDToName_ProductServiceDeleteCategoryTranslationResult = map[int16]string{
+	0: "success",
 }
 
-type UserSearchProductsResp struct {
-	Success  bool             `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code     int32            `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message  *string          `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	Total    int32            `thrift:"total,4" frugal:"4,default,i32" json:"total"`
-	Page     int32            `thrift:"page,5" frugal:"5,default,i32" json:"page"`
-	PageSize int32            `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
-	Products []*SimpleProduct `thrift:"products,7" frugal:"7,default,list<SimpleProduct>" json:"products"`
+type ProductServiceListCategoryTranslationsArgs struct {
+	Req *ListCategoryTranslationsReq `thrift:"req,1" frugal:"1,default,ListCategoryTranslationsReq" json:"req"`
+}
+
+func NewProductServiceListCategoryTranslationsArgs() *ProductServiceListCategoryTranslationsArgs {
+	return &ProductServiceListCategoryTranslationsArgs{}
+}
+
+func (p *ProductServiceListCategoryTranslationsArgs) InitDefault() {
+}
+
+var ProductServiceListCategoryTranslationsArgs_Req_DEFAULT *ListCategoryTranslationsReq
+
+func (p *ProductServiceListCategoryTranslationsArgs) GetReq() (v *ListCategoryTranslationsReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListCategoryTranslationsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListCategoryTranslationsArgs) SetReq(val *ListCategoryTranslationsReq) {
+	p.Req = val
 }
 
-func NewUserSearchProductsResp() *UserSearchProductsResp {
-	return &UserSearchProductsResp{
-		Code: 0,
+func (p *ProductServiceListCategoryTranslationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ProductServiceListCategoryTranslationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ProductServiceListCategoryTranslationsArgs(%+v)", *p)
 }
 
-func (p *UserSearchProductsResp) InitDefault() {
-	p.Code = 0
+var fieldIDToName_ProductServiceListCategoryTranslationsArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *UserSearchProductsResp) GetSuccess() (v bool) {
-	return p.Success
+type ProductServiceListCategoryTranslationsResult struct {
+	Success *ListCategoryTranslationsResp `thrift:"success,0,optional" frugal:"0,optional,ListCategoryTranslationsResp" json:"success,omitempty"`
 }
 
-func (p *UserSearchProductsResp) GetCode() (v int32) {
-	return p.Code
+func NewProductServiceListCategoryTranslationsResult() *ProductServiceListCategoryTranslationsResult {
+	return &ProductServiceListCategoryTranslationsResult{}
 }
 
-var UserSearchProductsResp_Message_DEFAULT string
+func (p *ProductServiceListCategoryTranslationsResult) InitDefault() {
+}
 
-func (p *UserSearchProductsResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return UserSearchProductsResp_Message_DEFAULT
+var ProductServiceListCategoryTranslationsResult_Success_DEFAULT *ListCategoryTranslationsResp
+
+func (p *ProductServiceListCategoryTranslationsResult) GetSuccess() (v *ListCategoryTranslationsResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListCategoryTranslationsResult_Success_DEFAULT
 	}
-	return *p.Message
+	return p.Success
 }
-
-func (p *UserSearchProductsResp) GetTotal() (v int32) {
-	return p.Total
+func (p *ProductServiceListCategoryTranslationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCategoryTranslationsResp)
 }
 
-func (p *UserSearchProductsResp) GetPage() (v int32) {
-	return p.Page
+func (p *ProductServiceListCategoryTranslationsResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *UserSearchProductsResp) GetPageSize() (v int32) {
-	return p.PageSize
+func (p *ProductServiceListCategoryTranslationsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceListCategoryTranslationsResult(%+v)", *p)
 }
 
-func (p *UserSearchProductsResp) GetProducts() (v []*SimpleProduct) {
-	return p.Products
-}
-func (p *UserSearchProductsResp) SetSuccess(val bool) {
-	p.Success = val
-}
-func (p *UserSearchProductsResp) SetCode(val int32) {
-	p.Code = val
+var fieldIDToName_ProductServiceListCategoryTranslationsResult = map[int16]string{
+	0: "success",
 }
-func (p *UserSearchProductsResp) SetMessage(val *string) {
-	p.Message = val
+
+type ProductServiceAddFavoriteArgs struct {
+	Req *AddFavoriteReq `thrift:"req,1" frugal:"1,default,AddFavoriteReq" json:"req"`
 }
-func (p *UserSearchProductsResp) SetTotal(val int32) {
-	p.Total = val
+
+func NewProductServiceAddFavoriteArgs() *ProductServiceAddFavoriteArgs {
+	return &ProductServiceAddFavoriteArgs{}
 }
-func (p *UserSearchProductsResp) SetPage(val int32) {
-	p.Page = val
+
+func (p *ProductServiceAddFavoriteArgs) InitDefault() {
 }
-func (p *UserSearchProductsResp) SetPageSize(val int32) {
-	p.PageSize = val
+
+var ProductServiceAddFavoriteArgs_Req_DEFAULT *AddFavoriteReq
+
+func (p *ProductServiceAddFavoriteArgs) GetReq() (v *AddFavoriteReq) {
+	if !p.IsSetReq() {
+		return ProductServiceAddFavoriteArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *UserSearchProductsResp) SetProducts(val []*SimpleProduct) {
-	p.Products = val
+func (p *ProductServiceAddFavoriteArgs) SetReq(val *AddFavoriteReq) {
+	p.Req = val
 }
 
-func (p *UserSearchProductsResp) IsSetMessage() bool {
-	return p.Message != nil
+func (p *ProductServiceAddFavoriteArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *UserSearchProductsResp) String() string {
+func (p *ProductServiceAddFavoriteArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserSearchProductsResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceAddFavoriteArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserSearchProductsResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "total",
-	5: "page",
-	6: "pageSize",
-	7: "products",
+var fieldIDToName_ProductServiceAddFavoriteArgs = map[int16]string{
+	1: "req",
 }
 
-type AdminSearchProductsReq struct {
-	Id       *int64   `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id,omitempty"`
-	Category *string  `thrift:"category,2,optional" frugal:"2,optional,string" json:"category,omitempty"`
-	MinPrice *float64 `thrift:"minPrice,3,optional" frugal:"3,optional,double" json:"minPrice,omitempty"`
-	MaxPrice *float64 `thrift:"maxPrice,4,optional" frugal:"4,optional,double" json:"maxPrice,omitempty"`
-	Keyword  *string  `thrift:"keyword,5,optional" frugal:"5,optional,string" json:"keyword,omitempty"`
-	Page     int32    `thrift:"page,6" frugal:"6,default,i32" json:"page"`
-	PageSize int32    `thrift:"pageSize,7" frugal:"7,default,i32" json:"pageSize"`
+type ProductServiceAddFavoriteResult struct {
+	Success *AddFavoriteResp `thrift:"success,0,optional" frugal:"0,optional,AddFavoriteResp" json:"success,omitempty"`
 }
 
-func NewAdminSearchProductsReq() *AdminSearchProductsReq {
-	return &AdminSearchProductsReq{
-		Page:     1,
-		PageSize: 20,
-	}
+func NewProductServiceAddFavoriteResult() *ProductServiceAddFavoriteResult {
+	return &ProductServiceAddFavoriteResult{}
 }
 
-func (p *AdminSearchProductsReq) InitDefault() {
-	p.Page = 1
-	p.PageSize = 20
+func (p *ProductServiceAddFavoriteResult) InitDefault() {
 }
 
-var AdminSearchProductsReq_Id_DEFAULT int64
+var ProductServiceAddFavoriteResult_Success_DEFAULT *AddFavoriteResp
 
-func (p *AdminSearchProductsReq) GetId() (v int64) {
-	if !p.IsSetId() {
-		return AdminSearchProductsReq_Id_DEFAULT
+func (p *ProductServiceAddFavoriteResult) GetSuccess() (v *AddFavoriteResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceAddFavoriteResult_Success_DEFAULT
 	}
-	return *p.Id
+	return p.Success
+}
+func (p *ProductServiceAddFavoriteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddFavoriteResp)
 }
 
-var AdminSearchProductsReq_Category_DEFAULT string
+func (p *ProductServiceAddFavoriteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-func (p *AdminSearchProductsReq) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return AdminSearchProductsReq_Category_DEFAULT
+func (p *ProductServiceAddFavoriteResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Category
+	return fmt.Sprintf("ProductServiceAddFavoriteResult(%+v)", *p)
 }
 
-var AdminSearchProductsReq_MinPrice_DEFAULT float64
+var fieldIDToName_ProductServiceAddFavoriteResult = map[int16]string{
+	0: "success",
+}
 
-func (p *AdminSearchProductsReq) GetMinPrice() (v float64) {
-	if !p.IsSetMinPrice() {
-		return AdminSearchProductsReq_MinPrice_DEFAULT
-	}
-	return *p.MinPrice
+type ProductServiceRemoveFavoriteArgs struct {
+	Req *RemoveFavoriteReq `thrift:"req,1" frugal:"1,default,RemoveFavoriteReq" json:"req"`
 }
 
-var AdminSearchProductsReq_MaxPrice_DEFAULT float64
+func NewProductServiceRemoveFavoriteArgs() *ProductServiceRemoveFavoriteArgs {
+	return &ProductServiceRemoveFavoriteArgs{}
+}
 
-func (p *AdminSearchProductsReq) GetMaxPrice() (v float64) {
-	if !p.IsSetMaxPrice() {
-		return AdminSearchProductsReq_MaxPrice_DEFAULT
+func (p *ProductServiceRemoveFavoriteArgs) InitDefault() {
+}
+
+var ProductServiceRemoveFavoriteArgs_Req_DEFAULT *RemoveFavoriteReq
+
+func (p *ProductServiceRemoveFavoriteArgs) GetReq() (v *RemoveFavoriteReq) {
+	if !p.IsSetReq() {
+		return ProductServiceRemoveFavoriteArgs_Req_DEFAULT
 	}
-	return *p.MaxPrice
+	return p.Req
+}
+func (p *ProductServiceRemoveFavoriteArgs) SetReq(val *RemoveFavoriteReq) {
+	p.Req = val
 }
 
-var AdminSearchProductsReq_Keyword_DEFAULT string
+func (p *ProductServiceRemoveFavoriteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *AdminSearchProductsReq) GetKeyword() (v string) {
-	if !p.IsSetKeyword() {
-		return AdminSearchProductsReq_Keyword_DEFAULT
+func (p *ProductServiceRemoveFavoriteArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Keyword
+	return fmt.Sprintf("ProductServiceRemoveFavoriteArgs(%+v)", *p)
 }
 
-func (p *AdminSearchProductsReq) GetPage() (v int32) {
-	return p.Page
+var fieldIDToName_ProductServiceRemoveFavoriteArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *AdminSearchProductsReq) GetPageSize() (v int32) {
-	return p.PageSize
+type ProductServiceRemoveFavoriteResult struct {
+	Success *RemoveFavoriteResp `thrift:"success,0,optional" frugal:"0,optional,RemoveFavoriteResp" json:"success,omitempty"`
 }
-func (p *AdminSearchProductsReq) SetId(val *int64) {
-	p.Id = val
+
+func NewProductServiceRemoveFavoriteResult() *ProductServiceRemoveFavoriteResult {
+	return &ProductServiceRemoveFavoriteResult{}
 }
-func (p *AdminSearchProductsReq) SetCategory(val *string) {
-	p.Category = val
+
+func (p *ProductServiceRemoveFavoriteResult) InitDefault() {
 }
-func (p *AdminSearchProductsReq) SetMinPrice(val *float64) {
-	p.MinPrice = val
+
+var ProductServiceRemoveFavoriteResult_Success_DEFAULT *RemoveFavoriteResp
+
+func (p *ProductServiceRemoveFavoriteResult) GetSuccess() (v *RemoveFavoriteResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceRemoveFavoriteResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *AdminSearchProductsReq) SetMaxPrice(val *float64) {
-	p.MaxPrice = val
+func (p *ProductServiceRemoveFavoriteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveFavoriteResp)
 }
-func (p *AdminSearchProductsReq) SetKeyword(val *string) {
-	p.Keyword = val
+
+func (p *ProductServiceRemoveFavoriteResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
-func (p *AdminSearchProductsReq) SetPage(val int32) {
-	p.Page = val
+
+func (p *ProductServiceRemoveFavoriteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceRemoveFavoriteResult(%+v)", *p)
 }
-func (p *AdminSearchProductsReq) SetPageSize(val int32) {
-	p.PageSize = val
+
+var fieldIDToName_ProductServiceRemoveFavoriteResult = map[int16]string{
+	0: "success",
 }
 
-func (p *AdminSearchProductsReq) IsSetId() bool {
-	return p.Id != nil
+type ProductServiceListFavoritesArgs struct {
+	Req *ListFavoritesReq `thrift:"req,1" frugal:"1,default,ListFavoritesReq" json:"req"`
 }
 
-func (p *AdminSearchProductsReq) IsSetCategory() bool {
-	return p.Category != nil
+func NewProductServiceListFavoritesArgs() *ProductServiceListFavoritesArgs {
+	return &ProductServiceListFavoritesArgs{}
 }
 
-func (p *AdminSearchProductsReq) IsSetMinPrice() bool {
-	return p.MinPrice != nil
+func (p *ProductServiceListFavoritesArgs) InitDefault() {
 }
 
-func (p *AdminSearchProductsReq) IsSetMaxPrice() bool {
-	return p.MaxPrice != nil
+var ProductServiceListFavoritesArgs_Req_DEFAULT *ListFavoritesReq
+
+func (p *ProductServiceListFavoritesArgs) GetReq() (v *ListFavoritesReq) {
+	if !p.IsSetReq() {
+		return ProductServiceListFavoritesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceListFavoritesArgs) SetReq(val *ListFavoritesReq) {
+	p.Req = val
 }
 
-func (p *AdminSearchProductsReq) IsSetKeyword() bool {
-	return p.Keyword != nil
+func (p *ProductServiceListFavoritesArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *AdminSearchProductsReq) String() string {
+func (p *ProductServiceListFavoritesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminSearchProductsReq(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListFavoritesArgs(%+v)", *p)
 }
 
-var fieldIDToName_AdminSearchProductsReq = map[int16]string{
-	1: "id",
-	2: "category",
-	3: "minPrice",
-	4: "maxPrice",
-	5: "keyword",
-	6: "page",
-	7: "pageSize",
+var fieldIDToName_ProductServiceListFavoritesArgs = map[int16]string{
+	1: "req",
 }
 
-type AdminSearchProductsResp struct {
-	Success  bool       `thrift:"success,1" frugal:"1,default,bool" json:"success"`
-	Code     int32      `thrift:"code,2" frugal:"2,default,i32" json:"code"`
-	Message  *string    `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
-	Total    int32      `thrift:"total,4" frugal:"4,default,i32" json:"total"`
-	Page     int32      `thrift:"page,5" frugal:"5,default,i32" json:"page"`
-	PageSize int32      `thrift:"pageSize,6" frugal:"6,default,i32" json:"pageSize"`
-	Products []*Product `thrift:"products,7" frugal:"7,default,list<Product>" json:"products"`
+type ProductServiceListFavoritesResult struct {
+	Success *ListFavoritesResp `thrift:"success,0,optional" frugal:"0,optional,ListFavoritesResp" json:"success,omitempty"`
 }
 
-func NewAdminSearchProductsResp() *AdminSearchProductsResp {
-	return &AdminSearchProductsResp{
-		Code: 0,
-	}
+func NewProductServiceListFavoritesResult() *ProductServiceListFavoritesResult {
+	return &ProductServiceListFavoritesResult{}
 }
 
-func (p *AdminSearchProductsResp) InitDefault() {
-	p.Code = 0
+func (p *ProductServiceListFavoritesResult) InitDefault() {
 }
 
-func (p *AdminSearchProductsResp) GetSuccess() (v bool) {
+var ProductServiceListFavoritesResult_Success_DEFAULT *ListFavoritesResp
+
+func (p *ProductServiceListFavoritesResult) GetSuccess() (v *ListFavoritesResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceListFavoritesResult_Success_DEFAULT
+	}
 	return p.Success
 }
-
-func (p *AdminSearchProductsResp) GetCode() (v int32) {
-	return p.Code
+func (p *ProductServiceListFavoritesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListFavoritesResp)
 }
 
-var AdminSearchProductsResp_Message_DEFAULT string
+func (p *ProductServiceListFavoritesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-func (p *AdminSearchProductsResp) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return AdminSearchProductsResp_Message_DEFAULT
+func (p *ProductServiceListFavoritesResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Message
+	return fmt.Sprintf("ProductServiceListFavoritesResult(%+v)", *p)
 }
 
-func (p *AdminSearchProductsResp) GetTotal() (v int32) {
-	return p.Total
+var fieldIDToName_ProductServiceListFavoritesResult = map[int16]string{
+	0: "success",
 }
 
-func (p *AdminSearchProductsResp) GetPage() (v int32) {
-	return p.Page
+type ProductServiceSubscribeRestockArgs struct {
+	Req *SubscribeRestockReq `thrift:"req,1" frugal:"1,default,SubscribeRestockReq" json:"req"`
 }
 
-func (p *AdminSearchProductsResp) GetPageSize() (v int32) {
-	return p.PageSize
+func NewProductServiceSubscribeRestockArgs() *ProductServiceSubscribeRestockArgs {
+	return &ProductServiceSubscribeRestockArgs{}
 }
 
-func (p *AdminSearchProductsResp) GetProducts() (v []*Product) {
-	return p.Products
-}
-func (p *AdminSearchProductsResp) SetSuccess(val bool) {
-	p.Success = val
-}
-func (p *AdminSearchProductsResp) SetCode(val int32) {
-	p.Code = val
-}
-func (p *AdminSearchProductsResp) SetMessage(val *string) {
-	p.Message = val
+func (p *ProductServiceSubscribeRestockArgs) InitDefault() {
 }
-func (p *AdminSearchProductsResp) SetTotal(val int32) {
-	p.Total = val
-}
-func (p *AdminSearchProductsResp) SetPage(val int32) {
-	p.Page = val
-}
-func (p *AdminSearchProductsResp) SetPageSize(val int32) {
-	p.PageSize = val
-}
-func (p *AdminSearchProductsResp) SetProducts(val []*Product) {
-	p.Products = val
+
+var ProductServiceSubscribeRestockArgs_Req_DEFAULT *SubscribeRestockReq
+
+func (p *ProductServiceSubscribeRestockArgs) GetReq() (v *SubscribeRestockReq) {
+	if !p.IsSetReq() {
+		return ProductServiceSubscribeRestockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ProductServiceSubscribeRestockArgs) SetReq(val *SubscribeRestockReq) {
+	p.Req = val
 }
 
-func (p *AdminSearchProductsResp) IsSetMessage() bool {
-	return p.Message != nil
+func (p *ProductServiceSubscribeRestockArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *AdminSearchProductsResp) String() string {
+func (p *ProductServiceSubscribeRestockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminSearchProductsResp(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSubscribeRestockArgs(%+v)", *p)
 }
 
-var fieldIDToName_AdminSearchProductsResp = map[int16]string{
-	1: "success",
-	2: "code",
-	3: "message",
-	4: "total",
-	5: "page",
-	6: "pageSize",
-	7: "products",
+var fieldIDToName_ProductServiceSubscribeRestockArgs = map[int16]string{
+	1: "req",
 }
 
-type ProductService interface {
-	CreateProduct(ctx context.Context, req *CreateProductReq) (r *CreateProductResp, err error)
+type ProductServiceSubscribeRestockResult struct {
+	Success *SubscribeRestockResp `thrift:"success,0,optional" frugal:"0,optional,SubscribeRestockResp" json:"success,omitempty"`
+}
 
-	GetProduct(ctx context.Context, req *GetProductReq) (r *GetProductResp, err error)
+func NewProductServiceSubscribeRestockResult() *ProductServiceSubscribeRestockResult {
+	return &ProductServiceSubscribeRestockResult{}
+}
 
-	UpdateProduct(ctx context.Context, req *UpdateProductReq) (r *UpdateProductResp, err error)
+func (p *ProductServiceSubscribeRestockResult) InitDefault() {
+}
 
-	OnlineProduct(ctx context.Context, req *OnlineProductReq) (r *OnlineProductResp, err error)
+var ProductServiceSubscribeRestockResult_Success_DEFAULT *SubscribeRestockResp
 
-	OfflineProduct(ctx context.Context, req *OfflineProductReq) (r *OfflineProductResp, err error)
+func (p *ProductServiceSubscribeRestockResult) GetSuccess() (v *SubscribeRestockResp) {
+	if !p.IsSetSuccess() {
+		return ProductServiceSubscribeRestockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ProductServiceSubscribeRestockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubscribeRestockResp)
+}
 
-	DeleteProduct(ctx context.Context, req *DeleteProductReq) (r *DeleteProductResp, err error)
+func (p *ProductServiceSubscribeRestockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	UserSearchProducts(ctx context.Context, req *UserSearchProductsReq) (r *UserSearchProductsResp, err error)
+func (p *ProductServiceSubscribeRestockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ProductServiceSubscribeRestockResult(%+v)", *p)
+}
 
-	AdminSearchProducts(ctx context.Context, req *AdminSearchProductsReq) (r *AdminSearchProductsResp, err error)
+var fieldIDToName_ProductServiceSubscribeRestockResult = map[int16]string{
+	0: "success",
 }
 
-type ProductServiceCreateProductArgs struct {
-	Req *CreateProductReq `thrift:"req,1" frugal:"1,default,CreateProductReq" json:"req"`
+type ProductServiceUnsubscribeRestockArgs struct {
+	Req *UnsubscribeRestockReq `thrift:"req,1" frugal:"1,default,UnsubscribeRestockReq" json:"req"`
 }
 
-func NewProductServiceCreateProductArgs() *ProductServiceCreateProductArgs {
-	return &ProductServiceCreateProductArgs{}
+func NewProductServiceUnsubscribeRestockArgs() *ProductServiceUnsubscribeRestockArgs {
+	return &ProductServiceUnsubscribeRestockArgs{}
 }
 
-func (p *ProductServiceCreateProductArgs) InitDefault() {
+func (p *ProductServiceUnsubscribeRestockArgs) InitDefault() {
 }
 
-var ProductServiceCreateProductArgs_Req_DEFAULT *CreateProductReq
+var ProductServiceUnsubscribeRestockArgs_Req_DEFAULT *UnsubscribeRestockReq
 
-func (p *ProductServiceCreateProductArgs) GetReq() (v *CreateProductReq) {
+func (p *ProductServiceUnsubscribeRestockArgs) GetReq() (v *UnsubscribeRestockReq) {
 	if !p.IsSetReq() {
-		return ProductServiceCreateProductArgs_Req_DEFAULT
+		return ProductServiceUnsubscribeRestockArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceCreateProductArgs) SetReq(val *CreateProductReq) {
+func (p *ProductServiceUnsubscribeRestockArgs) SetReq(val *UnsubscribeRestockReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceCreateProductArgs) IsSetReq() bool {
+func (p *ProductServiceUnsubscribeRestockArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceCreateProductArgs) String() string {
+func (p *ProductServiceUnsubscribeRestockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceCreateProductArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceUnsubscribeRestockArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceCreateProductArgs = map[int16]string{
+var fieldIDToName_ProductServiceUnsubscribeRestockArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceCreateProductResult struct {
-	Success *CreateProductResp `thrift:"success,0,optional" frugal:"0,optional,CreateProductResp" json:"success,omitempty"`
+type ProductServiceUnsubscribeRestockResult struct {
+	Success *UnsubscribeRestockResp `thrift:"success,0,optional" frugal:"0,optional,UnsubscribeRestockResp" json:"success,omitempty"`
 }
 
-func NewProductServiceCreateProductResult() *ProductServiceCreateProductResult {
-	return &ProductServiceCreateProductResult{}
+func NewProductServiceUnsubscribeRestockResult() *ProductServiceUnsubscribeRestockResult {
+	return &ProductServiceUnsubscribeRestockResult{}
 }
 
-func (p *ProductServiceCreateProductResult) InitDefault() {
+func (p *ProductServiceUnsubscribeRestockResult) InitDefault() {
 }
 
-var ProductServiceCreateProductResult_Success_DEFAULT *CreateProductResp
+var ProductServiceUnsubscribeRestockResult_Success_DEFAULT *UnsubscribeRestockResp
 
-func (p *ProductServiceCreateProductResult) GetSuccess() (v *CreateProductResp) {
+func (p *ProductServiceUnsubscribeRestockResult) GetSuccess() (v *UnsubscribeRestockResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceCreateProductResult_Success_DEFAULT
+		return ProductServiceUnsubscribeRestockResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceCreateProductResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateProductResp)
+func (p *ProductServiceUnsubscribeRestockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnsubscribeRestockResp)
 }
 
-func (p *ProductServiceCreateProductResult) IsSetSuccess() bool {
+func (p *ProductServiceUnsubscribeRestockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceCreateProductResult) String() string {
+func (p *ProductServiceUnsubscribeRestockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceCreateProductResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceUnsubscribeRestockResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceCreateProductResult = map[int16]string{
+var fieldIDToName_ProductServiceUnsubscribeRestockResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceGetProductArgs struct {
-	Req *GetProductReq `thrift:"req,1" frugal:"1,default,GetProductReq" json:"req"`
+type ProductServiceListRestockSubscriptionsArgs struct {
+	Req *ListRestockSubscriptionsReq `thrift:"req,1" frugal:"1,default,ListRestockSubscriptionsReq" json:"req"`
 }
 
-func NewProductServiceGetProductArgs() *ProductServiceGetProductArgs {
-	return &ProductServiceGetProductArgs{}
+func NewProductServiceListRestockSubscriptionsArgs() *ProductServiceListRestockSubscriptionsArgs {
+	return &ProductServiceListRestockSubscriptionsArgs{}
 }
 
-func (p *ProductServiceGetProductArgs) InitDefault() {
+func (p *ProductServiceListRestockSubscriptionsArgs) InitDefault() {
 }
 
-var ProductServiceGetProductArgs_Req_DEFAULT *GetProductReq
+var ProductServiceListRestockSubscriptionsArgs_Req_DEFAULT *ListRestockSubscriptionsReq
 
-func (p *ProductServiceGetProductArgs) GetReq() (v *GetProductReq) {
+func (p *ProductServiceListRestockSubscriptionsArgs) GetReq() (v *ListRestockSubscriptionsReq) {
 	if !p.IsSetReq() {
-		return ProductServiceGetProductArgs_Req_DEFAULT
+		return ProductServiceListRestockSubscriptionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceGetProductArgs) SetReq(val *GetProductReq) {
+func (p *ProductServiceListRestockSubscriptionsArgs) SetReq(val *ListRestockSubscriptionsReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceGetProductArgs) IsSetReq() bool {
+func (p *ProductServiceListRestockSubscriptionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceGetProductArgs) String() string {
+func (p *ProductServiceListRestockSubscriptionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceGetProductArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListRestockSubscriptionsArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceGetProductArgs = map[int16]string{
+var fieldIDToName_ProductServiceListRestockSubscriptionsArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceGetProductResult struct {
-	Success *GetProductResp `thrift:"success,0,optional" frugal:"0,optional,GetProductResp" json:"success,omitempty"`
+type ProductServiceListRestockSubscriptionsResult struct {
+	Success *ListRestockSubscriptionsResp `thrift:"success,0,optional" frugal:"0,optional,ListRestockSubscriptionsResp" json:"success,omitempty"`
 }
 
-func NewProductServiceGetProductResult() *ProductServiceGetProductResult {
-	return &ProductServiceGetProductResult{}
+func NewProductServiceListRestockSubscriptionsResult() *ProductServiceListRestockSubscriptionsResult {
+	return &ProductServiceListRestockSubscriptionsResult{}
 }
 
-func (p *ProductServiceGetProductResult) InitDefault() {
+func (p *ProductServiceListRestockSubscriptionsResult) InitDefault() {
 }
 
-var ProductServiceGetProductResult_Success_DEFAULT *GetProductResp
+var ProductServiceListRestockSubscriptionsResult_Success_DEFAULT *ListRestockSubscriptionsResp
 
-func (p *ProductServiceGetProductResult) GetSuccess() (v *GetProductResp) {
+func (p *ProductServiceListRestockSubscriptionsResult) GetSuccess() (v *ListRestockSubscriptionsResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceGetProductResult_Success_DEFAULT
+		return ProductServiceListRestockSubscriptionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceGetProductResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetProductResp)
+func (p *ProductServiceListRestockSubscriptionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListRestockSubscriptionsResp)
 }
 
-func (p *ProductServiceGetProductResult) IsSetSuccess() bool {
+func (p *ProductServiceListRestockSubscriptionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceGetProductResult) String() string {
+func (p *ProductServiceListRestockSubscriptionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceGetProductResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListRestockSubscriptionsResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceGetProductResult = map[int16]string{
+var fieldIDToName_ProductServiceListRestockSubscriptionsResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceUpdateProductArgs struct {
-	Req *UpdateProductReq `thrift:"req,1" frugal:"1,default,UpdateProductReq" json:"req"`
+type ProductServiceSetProductPriceTiersArgs struct {
+	Req *SetProductPriceTiersReq `thrift:"req,1" frugal:"1,default,SetProductPriceTiersReq" json:"req"`
 }
 
-func NewProductServiceUpdateProductArgs() *ProductServiceUpdateProductArgs {
-	return &ProductServiceUpdateProductArgs{}
+func NewProductServiceSetProductPriceTiersArgs() *ProductServiceSetProductPriceTiersArgs {
+	return &ProductServiceSetProductPriceTiersArgs{}
 }
 
-func (p *ProductServiceUpdateProductArgs) InitDefault() {
+func (p *ProductServiceSetProductPriceTiersArgs) InitDefault() {
 }
 
-var ProductServiceUpdateProductArgs_Req_DEFAULT *UpdateProductReq
+var ProductServiceSetProductPriceTiersArgs_Req_DEFAULT *SetProductPriceTiersReq
 
-func (p *ProductServiceUpdateProductArgs) GetReq() (v *UpdateProductReq) {
+func (p *ProductServiceSetProductPriceTiersArgs) GetReq() (v *SetProductPriceTiersReq) {
 	if !p.IsSetReq() {
-		return ProductServiceUpdateProductArgs_Req_DEFAULT
+		return ProductServiceSetProductPriceTiersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceUpdateProductArgs) SetReq(val *UpdateProductReq) {
+func (p *ProductServiceSetProductPriceTiersArgs) SetReq(val *SetProductPriceTiersReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceUpdateProductArgs) IsSetReq() bool {
+func (p *ProductServiceSetProductPriceTiersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceUpdateProductArgs) String() string {
+func (p *ProductServiceSetProductPriceTiersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceUpdateProductArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetProductPriceTiersArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceUpdateProductArgs = map[int16]string{
+var fieldIDToName_ProductServiceSetProductPriceTiersArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceUpdateProductResult struct {
-	Success *UpdateProductResp `thrift:"success,0,optional" frugal:"0,optional,UpdateProductResp" json:"success,omitempty"`
+type ProductServiceSetProductPriceTiersResult struct {
+	Success *SetProductPriceTiersResp `thrift:"success,0,optional" frugal:"0,optional,SetProductPriceTiersResp" json:"success,omitempty"`
 }
 
-func NewProductServiceUpdateProductResult() *ProductServiceUpdateProductResult {
-	return &ProductServiceUpdateProductResult{}
+func NewProductServiceSetProductPriceTiersResult() *ProductServiceSetProductPriceTiersResult {
+	return &ProductServiceSetProductPriceTiersResult{}
 }
 
-func (p *ProductServiceUpdateProductResult) InitDefault() {
+func (p *ProductServiceSetProductPriceTiersResult) InitDefault() {
 }
 
-var ProductServiceUpdateProductResult_Success_DEFAULT *UpdateProductResp
+var ProductServiceSetProductPriceTiersResult_Success_DEFAULT *SetProductPriceTiersResp
 
-func (p *ProductServiceUpdateProductResult) GetSuccess() (v *UpdateProductResp) {
+func (p *ProductServiceSetProductPriceTiersResult) GetSuccess() (v *SetProductPriceTiersResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceUpdateProductResult_Success_DEFAULT
+		return ProductServiceSetProductPriceTiersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceUpdateProductResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateProductResp)
+func (p *ProductServiceSetProductPriceTiersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetProductPriceTiersResp)
 }
 
-func (p *ProductServiceUpdateProductResult) IsSetSuccess() bool {
+func (p *ProductServiceSetProductPriceTiersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceUpdateProductResult) String() string {
+func (p *ProductServiceSetProductPriceTiersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceUpdateProductResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetProductPriceTiersResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceUpdateProductResult = map[int16]string{
+var fieldIDToName_ProductServiceSetProductPriceTiersResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceOnlineProductArgs struct {
-	Req *OnlineProductReq `thrift:"req,1" frugal:"1,default,OnlineProductReq" json:"req"`
+type ProductServiceGetProductPriceTiersArgs struct {
+	Req *GetProductPriceTiersReq `thrift:"req,1" frugal:"1,default,GetProductPriceTiersReq" json:"req"`
 }
 
-func NewProductServiceOnlineProductArgs() *ProductServiceOnlineProductArgs {
-	return &ProductServiceOnlineProductArgs{}
+func NewProductServiceGetProductPriceTiersArgs() *ProductServiceGetProductPriceTiersArgs {
+	return &ProductServiceGetProductPriceTiersArgs{}
 }
 
-func (p *ProductServiceOnlineProductArgs) InitDefault() {
+func (p *ProductServiceGetProductPriceTiersArgs) InitDefault() {
 }
 
-var ProductServiceOnlineProductArgs_Req_DEFAULT *OnlineProductReq
+var ProductServiceGetProductPriceTiersArgs_Req_DEFAULT *GetProductPriceTiersReq
 
-func (p *ProductServiceOnlineProductArgs) GetReq() (v *OnlineProductReq) {
+func (p *ProductServiceGetProductPriceTiersArgs) GetReq() (v *GetProductPriceTiersReq) {
 	if !p.IsSetReq() {
-		return ProductServiceOnlineProductArgs_Req_DEFAULT
+		return ProductServiceGetProductPriceTiersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceOnlineProductArgs) SetReq(val *OnlineProductReq) {
+func (p *ProductServiceGetProductPriceTiersArgs) SetReq(val *GetProductPriceTiersReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceOnlineProductArgs) IsSetReq() bool {
+func (p *ProductServiceGetProductPriceTiersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceOnlineProductArgs) String() string {
+func (p *ProductServiceGetProductPriceTiersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceOnlineProductArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceGetProductPriceTiersArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceOnlineProductArgs = map[int16]string{
+var fieldIDToName_ProductServiceGetProductPriceTiersArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceOnlineProductResult struct {
-	Success *OnlineProductResp `thrift:"success,0,optional" frugal:"0,optional,OnlineProductResp" json:"success,omitempty"`
+type ProductServiceGetProductPriceTiersResult struct {
+	Success *GetProductPriceTiersResp `thrift:"success,0,optional" frugal:"0,optional,GetProductPriceTiersResp" json:"success,omitempty"`
 }
 
-func NewProductServiceOnlineProductResult() *ProductServiceOnlineProductResult {
-	return &ProductServiceOnlineProductResult{}
+func NewProductServiceGetProductPriceTiersResult() *ProductServiceGetProductPriceTiersResult {
+	return &ProductServiceGetProductPriceTiersResult{}
 }
 
-func (p *ProductServiceOnlineProductResult) InitDefault() {
+func (p *ProductServiceGetProductPriceTiersResult) InitDefault() {
 }
 
-var ProductServiceOnlineProductResult_Success_DEFAULT *OnlineProductResp
+var ProductServiceGetProductPriceTiersResult_Success_DEFAULT *GetProductPriceTiersResp
 
-func (p *ProductServiceOnlineProductResult) GetSuccess() (v *OnlineProductResp) {
+func (p *ProductServiceGetProductPriceTiersResult) GetSuccess() (v *GetProductPriceTiersResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceOnlineProductResult_Success_DEFAULT
+		return ProductServiceGetProductPriceTiersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceOnlineProductResult) SetSuccess(x interface{}) {
-	p.Success = x.(*OnlineProductResp)
+func (p *ProductServiceGetProductPriceTiersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetProductPriceTiersResp)
 }
 
-func (p *ProductServiceOnlineProductResult) IsSetSuccess() bool {
+func (p *ProductServiceGetProductPriceTiersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceOnlineProductResult) String() string {
+func (p *ProductServiceGetProductPriceTiersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceOnlineProductResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceGetProductPriceTiersResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceOnlineProductResult = map[int16]string{
+var fieldIDToName_ProductServiceGetProductPriceTiersResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceOfflineProductArgs struct {
-	Req *OfflineProductReq `thrift:"req,1" frugal:"1,default,OfflineProductReq" json:"req"`
+type ProductServiceSetUserPriceGroupArgs struct {
+	Req *SetUserPriceGroupReq `thrift:"req,1" frugal:"1,default,SetUserPriceGroupReq" json:"req"`
 }
 
-func NewProductServiceOfflineProductArgs() *ProductServiceOfflineProductArgs {
-	return &ProductServiceOfflineProductArgs{}
+func NewProductServiceSetUserPriceGroupArgs() *ProductServiceSetUserPriceGroupArgs {
+	return &ProductServiceSetUserPriceGroupArgs{}
 }
 
-func (p *ProductServiceOfflineProductArgs) InitDefault() {
+func (p *ProductServiceSetUserPriceGroupArgs) InitDefault() {
 }
 
-var ProductServiceOfflineProductArgs_Req_DEFAULT *OfflineProductReq
+var ProductServiceSetUserPriceGroupArgs_Req_DEFAULT *SetUserPriceGroupReq
 
-func (p *ProductServiceOfflineProductArgs) GetReq() (v *OfflineProductReq) {
+func (p *ProductServiceSetUserPriceGroupArgs) GetReq() (v *SetUserPriceGroupReq) {
 	if !p.IsSetReq() {
-		return ProductServiceOfflineProductArgs_Req_DEFAULT
+		return ProductServiceSetUserPriceGroupArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceOfflineProductArgs) SetReq(val *OfflineProductReq) {
+func (p *ProductServiceSetUserPriceGroupArgs) SetReq(val *SetUserPriceGroupReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceOfflineProductArgs) IsSetReq() bool {
+func (p *ProductServiceSetUserPriceGroupArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceOfflineProductArgs) String() string {
+func (p *ProductServiceSetUserPriceGroupArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceOfflineProductArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetUserPriceGroupArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceOfflineProductArgs = map[int16]string{
+var fieldIDToName_ProductServiceSetUserPriceGroupArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceOfflineProductResult struct {
-	Success *OfflineProductResp `thrift:"success,0,optional" frugal:"0,optional,OfflineProductResp" json:"success,omitempty"`
+type ProductServiceSetUserPriceGroupResult struct {
+	Success *SetUserPriceGroupResp `thrift:"success,0,optional" frugal:"0,optional,SetUserPriceGroupResp" json:"success,omitempty"`
 }
 
-func NewProductServiceOfflineProductResult() *ProductServiceOfflineProductResult {
-	return &ProductServiceOfflineProductResult{}
+func NewProductServiceSetUserPriceGroupResult() *ProductServiceSetUserPriceGroupResult {
+	return &ProductServiceSetUserPriceGroupResult{}
 }
 
-func (p *ProductServiceOfflineProductResult) InitDefault() {
+func (p *ProductServiceSetUserPriceGroupResult) InitDefault() {
 }
 
-var ProductServiceOfflineProductResult_Success_DEFAULT *OfflineProductResp
+var ProductServiceSetUserPriceGroupResult_Success_DEFAULT *SetUserPriceGroupResp
 
-func (p *ProductServiceOfflineProductResult) GetSuccess() (v *OfflineProductResp) {
+func (p *ProductServiceSetUserPriceGroupResult) GetSuccess() (v *SetUserPriceGroupResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceOfflineProductResult_Success_DEFAULT
+		return ProductServiceSetUserPriceGroupResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceOfflineProductResult) SetSuccess(x interface{}) {
-	p.Success = x.(*OfflineProductResp)
+func (p *ProductServiceSetUserPriceGroupResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetUserPriceGroupResp)
 }
 
-func (p *ProductServiceOfflineProductResult) IsSetSuccess() bool {
+func (p *ProductServiceSetUserPriceGroupResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceOfflineProductResult) String() string {
+func (p *ProductServiceSetUserPriceGroupResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceOfflineProductResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetUserPriceGroupResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceOfflineProductResult = map[int16]string{
+var fieldIDToName_ProductServiceSetUserPriceGroupResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceDeleteProductArgs struct {
-	Req *DeleteProductReq `thrift:"req,1" frugal:"1,default,DeleteProductReq" json:"req"`
+type ProductServiceListUserPriceGroupsArgs struct {
+	Req *ListUserPriceGroupsReq `thrift:"req,1" frugal:"1,default,ListUserPriceGroupsReq" json:"req"`
 }
 
-func NewProductServiceDeleteProductArgs() *ProductServiceDeleteProductArgs {
-	return &ProductServiceDeleteProductArgs{}
+func NewProductServiceListUserPriceGroupsArgs() *ProductServiceListUserPriceGroupsArgs {
+	return &ProductServiceListUserPriceGroupsArgs{}
 }
 
-func (p *ProductServiceDeleteProductArgs) InitDefault() {
+func (p *ProductServiceListUserPriceGroupsArgs) InitDefault() {
 }
 
-var ProductServiceDeleteProductArgs_Req_DEFAULT *DeleteProductReq
+var ProductServiceListUserPriceGroupsArgs_Req_DEFAULT *ListUserPriceGroupsReq
 
-func (p *ProductServiceDeleteProductArgs) GetReq() (v *DeleteProductReq) {
+func (p *ProductServiceListUserPriceGroupsArgs) GetReq() (v *ListUserPriceGroupsReq) {
 	if !p.IsSetReq() {
-		return ProductServiceDeleteProductArgs_Req_DEFAULT
+		return ProductServiceListUserPriceGroupsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceDeleteProductArgs) SetReq(val *DeleteProductReq) {
+func (p *ProductServiceListUserPriceGroupsArgs) SetReq(val *ListUserPriceGroupsReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceDeleteProductArgs) IsSetReq() bool {
+func (p *ProductServiceListUserPriceGroupsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceDeleteProductArgs) String() string {
+func (p *ProductServiceListUserPriceGroupsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceDeleteProductArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListUserPriceGroupsArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceDeleteProductArgs = map[int16]string{
+var fieldIDToName_ProductServiceListUserPriceGroupsArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceDeleteProductResult struct {
-	Success *DeleteProductResp `thrift:"success,0,optional" frugal:"0,optional,DeleteProductResp" json:"success,omitempty"`
+type ProductServiceListUserPriceGroupsResult struct {
+	Success *ListUserPriceGroupsResp `thrift:"success,0,optional" frugal:"0,optional,ListUserPriceGroupsResp" json:"success,omitempty"`
 }
 
-func NewProductServiceDeleteProductResult() *ProductServiceDeleteProductResult {
-	return &ProductServiceDeleteProductResult{}
+func NewProductServiceListUserPriceGroupsResult() *ProductServiceListUserPriceGroupsResult {
+	return &ProductServiceListUserPriceGroupsResult{}
 }
 
-func (p *ProductServiceDeleteProductResult) InitDefault() {
+func (p *ProductServiceListUserPriceGroupsResult) InitDefault() {
 }
 
-var ProductServiceDeleteProductResult_Success_DEFAULT *DeleteProductResp
+var ProductServiceListUserPriceGroupsResult_Success_DEFAULT *ListUserPriceGroupsResp
 
-func (p *ProductServiceDeleteProductResult) GetSuccess() (v *DeleteProductResp) {
+func (p *ProductServiceListUserPriceGroupsResult) GetSuccess() (v *ListUserPriceGroupsResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceDeleteProductResult_Success_DEFAULT
+		return ProductServiceListUserPriceGroupsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceDeleteProductResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteProductResp)
+func (p *ProductServiceListUserPriceGroupsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListUserPriceGroupsResp)
 }
 
-func (p *ProductServiceDeleteProductResult) IsSetSuccess() bool {
+func (p *ProductServiceListUserPriceGroupsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceDeleteProductResult) String() string {
+func (p *ProductServiceListUserPriceGroupsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceDeleteProductResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceListUserPriceGroupsResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceDeleteProductResult = map[int16]string{
+var fieldIDToName_ProductServiceListUserPriceGroupsResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceUserSearchProductsArgs struct {
-	Req *UserSearchProductsReq `thrift:"req,1" frugal:"1,default,UserSearchProductsReq" json:"req"`
+type ProductServiceQuotePricesArgs struct {
+	Req *QuotePricesReq `thrift:"req,1" frugal:"1,default,QuotePricesReq" json:"req"`
 }
 
-func NewProductServiceUserSearchProductsArgs() *ProductServiceUserSearchProductsArgs {
-	return &ProductServiceUserSearchProductsArgs{}
+func NewProductServiceQuotePricesArgs() *ProductServiceQuotePricesArgs {
+	return &ProductServiceQuotePricesArgs{}
 }
 
-func (p *ProductServiceUserSearchProductsArgs) InitDefault() {
+func (p *ProductServiceQuotePricesArgs) InitDefault() {
 }
 
-var ProductServiceUserSearchProductsArgs_Req_DEFAULT *UserSearchProductsReq
+var ProductServiceQuotePricesArgs_Req_DEFAULT *QuotePricesReq
 
-func (p *ProductServiceUserSearchProductsArgs) GetReq() (v *UserSearchProductsReq) {
+func (p *ProductServiceQuotePricesArgs) GetReq() (v *QuotePricesReq) {
 	if !p.IsSetReq() {
-		return ProductServiceUserSearchProductsArgs_Req_DEFAULT
+		return ProductServiceQuotePricesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceUserSearchProductsArgs) SetReq(val *UserSearchProductsReq) {
+func (p *ProductServiceQuotePricesArgs) SetReq(val *QuotePricesReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceUserSearchProductsArgs) IsSetReq() bool {
+func (p *ProductServiceQuotePricesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceUserSearchProductsArgs) String() string {
+func (p *ProductServiceQuotePricesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceUserSearchProductsArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceQuotePricesArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceUserSearchProductsArgs = map[int16]string{
+var fieldIDToName_ProductServiceQuotePricesArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceUserSearchProductsResult struct {
-	Success *UserSearchProductsResp `thrift:"success,0,optional" frugal:"0,optional,UserSearchProductsResp" json:"success,omitempty"`
+type ProductServiceQuotePricesResult struct {
+	Success *QuotePricesResp `thrift:"success,0,optional" frugal:"0,optional,QuotePricesResp" json:"success,omitempty"`
 }
 
-func NewProductServiceUserSearchProductsResult() *ProductServiceUserSearchProductsResult {
-	return &ProductServiceUserSearchProductsResult{}
+func NewProductServiceQuotePricesResult() *ProductServiceQuotePricesResult {
+	return &ProductServiceQuotePricesResult{}
 }
 
-func (p *ProductServiceUserSearchProductsResult) InitDefault() {
+func (p *ProductServiceQuotePricesResult) InitDefault() {
 }
 
-var ProductServiceUserSearchProductsResult_Success_DEFAULT *UserSearchProductsResp
+var ProductServiceQuotePricesResult_Success_DEFAULT *QuotePricesResp
 
-func (p *ProductServiceUserSearchProductsResult) GetSuccess() (v *UserSearchProductsResp) {
+func (p *ProductServiceQuotePricesResult) GetSuccess() (v *QuotePricesResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceUserSearchProductsResult_Success_DEFAULT
+		return ProductServiceQuotePricesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceUserSearchProductsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserSearchProductsResp)
+func (p *ProductServiceQuotePricesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QuotePricesResp)
 }
 
-func (p *ProductServiceUserSearchProductsResult) IsSetSuccess() bool {
+func (p *ProductServiceQuotePricesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceUserSearchProductsResult) String() string {
+func (p *ProductServiceQuotePricesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceUserSearchProductsResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceQuotePricesResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceUserSearchProductsResult = map[int16]string{
+var fieldIDToName_ProductServiceQuotePricesResult = map[int16]string{
 	0: "success",
 }
 
-type ProductServiceAdminSearchProductsArgs struct {
-	Req *AdminSearchProductsReq `thrift:"req,1" frugal:"1,default,AdminSearchProductsReq" json:"req"`
+type ProductServiceSetProductPurchaseLimitArgs struct {
+	Req *SetProductPurchaseLimitReq `thrift:"req,1" frugal:"1,default,SetProductPurchaseLimitReq" json:"req"`
 }
 
-func NewProductServiceAdminSearchProductsArgs() *ProductServiceAdminSearchProductsArgs {
-	return &ProductServiceAdminSearchProductsArgs{}
+func NewProductServiceSetProductPurchaseLimitArgs() *ProductServiceSetProductPurchaseLimitArgs {
+	return &ProductServiceSetProductPurchaseLimitArgs{}
 }
 
-func (p *ProductServiceAdminSearchProductsArgs) InitDefault() {
+func (p *ProductServiceSetProductPurchaseLimitArgs) InitDefault() {
 }
 
-var ProductServiceAdminSearchProductsArgs_Req_DEFAULT *AdminSearchProductsReq
+var ProductServiceSetProductPurchaseLimitArgs_Req_DEFAULT *SetProductPurchaseLimitReq
 
-func (p *ProductServiceAdminSearchProductsArgs) GetReq() (v *AdminSearchProductsReq) {
+func (p *ProductServiceSetProductPurchaseLimitArgs) GetReq() (v *SetProductPurchaseLimitReq) {
 	if !p.IsSetReq() {
-		return ProductServiceAdminSearchProductsArgs_Req_DEFAULT
+		return ProductServiceSetProductPurchaseLimitArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ProductServiceAdminSearchProductsArgs) SetReq(val *AdminSearchProductsReq) {
+func (p *ProductServiceSetProductPurchaseLimitArgs) SetReq(val *SetProductPurchaseLimitReq) {
 	p.Req = val
 }
 
-func (p *ProductServiceAdminSearchProductsArgs) IsSetReq() bool {
+func (p *ProductServiceSetProductPurchaseLimitArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ProductServiceAdminSearchProductsArgs) String() string {
+func (p *ProductServiceSetProductPurchaseLimitArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceAdminSearchProductsArgs(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetProductPurchaseLimitArgs(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceAdminSearchProductsArgs = map[int16]string{
+var fieldIDToName_ProductServiceSetProductPurchaseLimitArgs = map[int16]string{
 	1: "req",
 }
 
-type ProductServiceAdminSearchProductsResult struct {
-	Success *AdminSearchProductsResp `thrift:"success,0,optional" frugal:"0,optional,AdminSearchProductsResp" json:"success,omitempty"`
+type ProductServiceSetProductPurchaseLimitResult struct {
+	Success *SetProductPurchaseLimitResp `thrift:"success,0,optional" frugal:"0,optional,SetProductPurchaseLimitResp" json:"success,omitempty"`
 }
 
-func NewProductServiceAdminSearchProductsResult() *ProductServiceAdminSearchProductsResult {
-	return &ProductServiceAdminSearchProductsResult{}
+func NewProductServiceSetProductPurchaseLimitResult() *ProductServiceSetProductPurchaseLimitResult {
+	return &ProductServiceSetProductPurchaseLimitResult{}
 }
 
-func (p *ProductServiceAdminSearchProductsResult) InitDefault() {
+func (p *ProductServiceSetProductPurchaseLimitResult) InitDefault() {
 }
 
-var ProductServiceAdminSearchProductsResult_Success_DEFAULT *AdminSearchProductsResp
+var ProductServiceSetProductPurchaseLimitResult_Success_DEFAULT *SetProductPurchaseLimitResp
 
-func (p *ProductServiceAdminSearchProductsResult) GetSuccess() (v *AdminSearchProductsResp) {
+func (p *ProductServiceSetProductPurchaseLimitResult) GetSuccess() (v *SetProductPurchaseLimitResp) {
 	if !p.IsSetSuccess() {
-		return ProductServiceAdminSearchProductsResult_Success_DEFAULT
+		return ProductServiceSetProductPurchaseLimitResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ProductServiceAdminSearchProductsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*AdminSearchProductsResp)
+func (p *ProductServiceSetProductPurchaseLimitResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetProductPurchaseLimitResp)
 }
 
-func (p *ProductServiceAdminSearchProductsResult) IsSetSuccess() bool {
+func (p *ProductServiceSetProductPurchaseLimitResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ProductServiceAdminSearchProductsResult) String() string {
+func (p *ProductServiceSetProductPurchaseLimitResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ProductServiceAdminSearchProductsResult(%+v)", *p)
+	return fmt.Sprintf("ProductServiceSetProductPurchaseLimitResult(%+v)", *p)
 }
 
-var fieldIDToName_ProductServiceAdminSearchProductsResult = map[int16]string{
+var fieldIDToName_ProductServiceSetProductPurchaseLimitResult = map[int16]string{
 	0: "success",
 }
@@ -19,6 +19,67 @@ type Client interface {
 	DeleteProduct(ctx context.Context, req *api.DeleteProductReq, callOptions ...callopt.Option) (r *api.DeleteProductResp, err error)
 	UserSearchProducts(ctx context.Context, req *api.UserSearchProductsReq, callOptions ...callopt.Option) (r *api.UserSearchProductsResp, err error)
 	AdminSearchProducts(ctx context.Context, req *api.AdminSearchProductsReq, callOptions ...callopt.Option) (r *api.AdminSearchProductsResp, err error)
+	BatchGetProducts(ctx context.Context, req *api.BatchGetProductsReq, callOptions ...callopt.Option) (r *api.BatchGetProductsResp, err error)
+	ListProductEvents(ctx context.Context, req *api.ListProductEventsReq, callOptions ...callopt.Option) (r *api.ListProductEventsResp, err error)
+	AdjustStock(ctx context.Context, req *api.AdjustStockReq, callOptions ...callopt.Option) (r *api.AdjustStockResp, err error)
+	ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq, callOptions ...callopt.Option) (r *api.ListStockMovementsResp, err error)
+	ReconcileStock(ctx context.Context, req *api.ReconcileStockReq, callOptions ...callopt.Option) (r *api.ReconcileStockResp, err error)
+	CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq, callOptions ...callopt.Option) (r *api.CreateWarehouseResp, err error)
+	UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq, callOptions ...callopt.Option) (r *api.UpdateWarehouseResp, err error)
+	ListWarehouses(ctx context.Context, req *api.ListWarehousesReq, callOptions ...callopt.Option) (r *api.ListWarehousesResp, err error)
+	GetWarehouseStocks(ctx context.Context, req *api.GetWarehouseStocksReq, callOptions ...callopt.Option) (r *api.GetWarehouseStocksResp, err error)
+	ListLowStockProducts(ctx context.Context, req *api.ListLowStockProductsReq, callOptions ...callopt.Option) (r *api.ListLowStockProductsResp, err error)
+	CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq, callOptions ...callopt.Option) (r *api.CreatePriceScheduleResp, err error)
+	ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq, callOptions ...callopt.Option) (r *api.ListPriceSchedulesResp, err error)
+	CancelPriceSchedule(ctx context.Context, req *api.CancelPriceScheduleReq, callOptions ...callopt.Option) (r *api.CancelPriceScheduleResp, err error)
+	GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq, callOptions ...callopt.Option) (r *api.GetPriceHistoryResp, err error)
+	ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq, callOptions ...callopt.Option) (r *api.ListProductStatusSchedulesResp, err error)
+	CancelProductStatusSchedule(ctx context.Context, req *api.CancelProductStatusScheduleReq, callOptions ...callopt.Option) (r *api.CancelProductStatusScheduleResp, err error)
+	ImportProducts(ctx context.Context, req *api.ImportProductsReq, callOptions ...callopt.Option) (r *api.ImportProductsResp, err error)
+	CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq, callOptions ...callopt.Option) (r *api.CreateAttributeDefinitionResp, err error)
+	UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq, callOptions ...callopt.Option) (r *api.UpdateAttributeDefinitionResp, err error)
+	ListAttributeDefinitions(ctx context.Context, req *api.ListAttributeDefinitionsReq, callOptions ...callopt.Option) (r *api.ListAttributeDefinitionsResp, err error)
+	DeleteAttributeDefinition(ctx context.Context, req *api.DeleteAttributeDefinitionReq, callOptions ...callopt.Option) (r *api.DeleteAttributeDefinitionResp, err error)
+	UpdateProductRating(ctx context.Context, req *api.UpdateProductRatingReq, callOptions ...callopt.Option) (r *api.UpdateProductRatingResp, err error)
+	ListProductAudit(ctx context.Context, req *api.ListProductAuditReq, callOptions ...callopt.Option) (r *api.ListProductAuditResp, err error)
+	SyncPurchaseStats(ctx context.Context, req *api.SyncPurchaseStatsReq, callOptions ...callopt.Option) (r *api.SyncPurchaseStatsResp, err error)
+	GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq, callOptions ...callopt.Option) (r *api.GetRelatedProductsResp, err error)
+	GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq, callOptions ...callopt.Option) (r *api.GetFrequentlyBoughtTogetherResp, err error)
+	CreateBrand(ctx context.Context, req *api.CreateBrandReq, callOptions ...callopt.Option) (r *api.CreateBrandResp, err error)
+	UpdateBrand(ctx context.Context, req *api.UpdateBrandReq, callOptions ...callopt.Option) (r *api.UpdateBrandResp, err error)
+	GetBrand(ctx context.Context, req *api.GetBrandReq, callOptions ...callopt.Option) (r *api.GetBrandResp, err error)
+	ListBrands(ctx context.Context, req *api.ListBrandsReq, callOptions ...callopt.Option) (r *api.ListBrandsResp, err error)
+	DeleteBrand(ctx context.Context, req *api.DeleteBrandReq, callOptions ...callopt.Option) (r *api.DeleteBrandResp, err error)
+	SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq, callOptions ...callopt.Option) (r *api.SubmitProductApprovalResp, err error)
+	ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq, callOptions ...callopt.Option) (r *api.ReviewProductApprovalResp, err error)
+	ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq, callOptions ...callopt.Option) (r *api.ListProductApprovalsResp, err error)
+	CreateMerchant(ctx context.Context, req *api.CreateMerchantReq, callOptions ...callopt.Option) (r *api.CreateMerchantResp, err error)
+	UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq, callOptions ...callopt.Option) (r *api.UpdateMerchantResp, err error)
+	GetMerchant(ctx context.Context, req *api.GetMerchantReq, callOptions ...callopt.Option) (r *api.GetMerchantResp, err error)
+	ListMerchants(ctx context.Context, req *api.ListMerchantsReq, callOptions ...callopt.Option) (r *api.ListMerchantsResp, err error)
+	SuggestProducts(ctx context.Context, req *api.SuggestProductsReq, callOptions ...callopt.Option) (r *api.SuggestProductsResp, err error)
+	ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq, callOptions ...callopt.Option) (r *api.ListSearchQueriesResp, err error)
+	RecordProductSales(ctx context.Context, req *api.RecordProductSalesReq, callOptions ...callopt.Option) (r *api.RecordProductSalesResp, err error)
+	GetTopProducts(ctx context.Context, req *api.GetTopProductsReq, callOptions ...callopt.Option) (r *api.GetTopProductsResp, err error)
+	UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq, callOptions ...callopt.Option) (r *api.UpdateBundleComponentsResp, err error)
+	SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq, callOptions ...callopt.Option) (r *api.SetProductTranslationResp, err error)
+	DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq, callOptions ...callopt.Option) (r *api.DeleteProductTranslationResp, err error)
+	ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq, callOptions ...callopt.Option) (r *api.ListProductTranslationsResp, err error)
+	SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq, callOptions ...callopt.Option) (r *api.SetCategoryTranslationResp, err error)
+	DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq, callOptions ...callopt.Option) (r *api.DeleteCategoryTranslationResp, err error)
+	ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq, callOptions ...callopt.Option) (r *api.ListCategoryTranslationsResp, err error)
+	AddFavorite(ctx context.Context, req *api.AddFavoriteReq, callOptions ...callopt.Option) (r *api.AddFavoriteResp, err error)
+	RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq, callOptions ...callopt.Option) (r *api.RemoveFavoriteResp, err error)
+	ListFavorites(ctx context.Context, req *api.ListFavoritesReq, callOptions ...callopt.Option) (r *api.ListFavoritesResp, err error)
+	SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq, callOptions ...callopt.Option) (r *api.SubscribeRestockResp, err error)
+	UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq, callOptions ...callopt.Option) (r *api.UnsubscribeRestockResp, err error)
+	ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq, callOptions ...callopt.Option) (r *api.ListRestockSubscriptionsResp, err error)
+	SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq, callOptions ...callopt.Option) (r *api.SetProductPriceTiersResp, err error)
+	GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq, callOptions ...callopt.Option) (r *api.GetProductPriceTiersResp, err error)
+	SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq, callOptions ...callopt.Option) (r *api.SetUserPriceGroupResp, err error)
+	ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq, callOptions ...callopt.Option) (r *api.ListUserPriceGroupsResp, err error)
+	QuotePrices(ctx context.Context, req *api.QuotePricesReq, callOptions ...callopt.Option) (r *api.QuotePricesResp, err error)
+	SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq, callOptions ...callopt.Option) (r *api.SetProductPurchaseLimitResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -89,3 +150,308 @@ func (p *kProductServiceClient) AdminSearchProducts(ctx context.Context, req *ap
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.AdminSearchProducts(ctx, req)
 }
+
+func (p *kProductServiceClient) BatchGetProducts(ctx context.Context, req *api.BatchGetProductsReq, callOptions ...callopt.Option) (r *api.BatchGetProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchGetProducts(ctx, req)
+}
+
+func (p *kProductServiceClient) ListProductEvents(ctx context.Context, req *api.ListProductEventsReq, callOptions ...callopt.Option) (r *api.ListProductEventsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductEvents(ctx, req)
+}
+
+func (p *kProductServiceClient) AdjustStock(ctx context.Context, req *api.AdjustStockReq, callOptions ...callopt.Option) (r *api.AdjustStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AdjustStock(ctx, req)
+}
+
+func (p *kProductServiceClient) ListStockMovements(ctx context.Context, req *api.ListStockMovementsReq, callOptions ...callopt.Option) (r *api.ListStockMovementsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListStockMovements(ctx, req)
+}
+
+func (p *kProductServiceClient) ReconcileStock(ctx context.Context, req *api.ReconcileStockReq, callOptions ...callopt.Option) (r *api.ReconcileStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReconcileStock(ctx, req)
+}
+
+func (p *kProductServiceClient) CreateWarehouse(ctx context.Context, req *api.CreateWarehouseReq, callOptions ...callopt.Option) (r *api.CreateWarehouseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateWarehouse(ctx, req)
+}
+
+func (p *kProductServiceClient) UpdateWarehouse(ctx context.Context, req *api.UpdateWarehouseReq, callOptions ...callopt.Option) (r *api.UpdateWarehouseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateWarehouse(ctx, req)
+}
+
+func (p *kProductServiceClient) ListWarehouses(ctx context.Context, req *api.ListWarehousesReq, callOptions ...callopt.Option) (r *api.ListWarehousesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListWarehouses(ctx, req)
+}
+
+func (p *kProductServiceClient) GetWarehouseStocks(ctx context.Context, req *api.GetWarehouseStocksReq, callOptions ...callopt.Option) (r *api.GetWarehouseStocksResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetWarehouseStocks(ctx, req)
+}
+
+func (p *kProductServiceClient) ListLowStockProducts(ctx context.Context, req *api.ListLowStockProductsReq, callOptions ...callopt.Option) (r *api.ListLowStockProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListLowStockProducts(ctx, req)
+}
+
+func (p *kProductServiceClient) CreatePriceSchedule(ctx context.Context, req *api.CreatePriceScheduleReq, callOptions ...callopt.Option) (r *api.CreatePriceScheduleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreatePriceSchedule(ctx, req)
+}
+
+func (p *kProductServiceClient) ListPriceSchedules(ctx context.Context, req *api.ListPriceSchedulesReq, callOptions ...callopt.Option) (r *api.ListPriceSchedulesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPriceSchedules(ctx, req)
+}
+
+func (p *kProductServiceClient) CancelPriceSchedule(ctx context.Context, req *api.CancelPriceScheduleReq, callOptions ...callopt.Option) (r *api.CancelPriceScheduleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelPriceSchedule(ctx, req)
+}
+
+func (p *kProductServiceClient) GetPriceHistory(ctx context.Context, req *api.GetPriceHistoryReq, callOptions ...callopt.Option) (r *api.GetPriceHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPriceHistory(ctx, req)
+}
+
+func (p *kProductServiceClient) ListProductStatusSchedules(ctx context.Context, req *api.ListProductStatusSchedulesReq, callOptions ...callopt.Option) (r *api.ListProductStatusSchedulesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductStatusSchedules(ctx, req)
+}
+
+func (p *kProductServiceClient) CancelProductStatusSchedule(ctx context.Context, req *api.CancelProductStatusScheduleReq, callOptions ...callopt.Option) (r *api.CancelProductStatusScheduleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelProductStatusSchedule(ctx, req)
+}
+
+func (p *kProductServiceClient) ImportProducts(ctx context.Context, req *api.ImportProductsReq, callOptions ...callopt.Option) (r *api.ImportProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ImportProducts(ctx, req)
+}
+
+func (p *kProductServiceClient) CreateAttributeDefinition(ctx context.Context, req *api.CreateAttributeDefinitionReq, callOptions ...callopt.Option) (r *api.CreateAttributeDefinitionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAttributeDefinition(ctx, req)
+}
+
+func (p *kProductServiceClient) UpdateAttributeDefinition(ctx context.Context, req *api.UpdateAttributeDefinitionReq, callOptions ...callopt.Option) (r *api.UpdateAttributeDefinitionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAttributeDefinition(ctx, req)
+}
+
+func (p *kProductServiceClient) ListAttributeDefinitions(ctx context.Context, req *api.ListAttributeDefinitionsReq, callOptions ...callopt.Option) (r *api.ListAttributeDefinitionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAttributeDefinitions(ctx, req)
+}
+
+func (p *kProductServiceClient) DeleteAttributeDefinition(ctx context.Context, req *api.DeleteAttributeDefinitionReq, callOptions ...callopt.Option) (r *api.DeleteAttributeDefinitionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAttributeDefinition(ctx, req)
+}
+
+func (p *kProductServiceClient) UpdateProductRating(ctx context.Context, req *api.UpdateProductRatingReq, callOptions ...callopt.Option) (r *api.UpdateProductRatingResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProductRating(ctx, req)
+}
+
+func (p *kProductServiceClient) ListProductAudit(ctx context.Context, req *api.ListProductAuditReq, callOptions ...callopt.Option) (r *api.ListProductAuditResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductAudit(ctx, req)
+}
+
+func (p *kProductServiceClient) SyncPurchaseStats(ctx context.Context, req *api.SyncPurchaseStatsReq, callOptions ...callopt.Option) (r *api.SyncPurchaseStatsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SyncPurchaseStats(ctx, req)
+}
+
+func (p *kProductServiceClient) GetRelatedProducts(ctx context.Context, req *api.GetRelatedProductsReq, callOptions ...callopt.Option) (r *api.GetRelatedProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRelatedProducts(ctx, req)
+}
+
+func (p *kProductServiceClient) GetFrequentlyBoughtTogether(ctx context.Context, req *api.GetFrequentlyBoughtTogetherReq, callOptions ...callopt.Option) (r *api.GetFrequentlyBoughtTogetherResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFrequentlyBoughtTogether(ctx, req)
+}
+
+func (p *kProductServiceClient) CreateBrand(ctx context.Context, req *api.CreateBrandReq, callOptions ...callopt.Option) (r *api.CreateBrandResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateBrand(ctx, req)
+}
+
+func (p *kProductServiceClient) UpdateBrand(ctx context.Context, req *api.UpdateBrandReq, callOptions ...callopt.Option) (r *api.UpdateBrandResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateBrand(ctx, req)
+}
+
+func (p *kProductServiceClient) GetBrand(ctx context.Context, req *api.GetBrandReq, callOptions ...callopt.Option) (r *api.GetBrandResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBrand(ctx, req)
+}
+
+func (p *kProductServiceClient) ListBrands(ctx context.Context, req *api.ListBrandsReq, callOptions ...callopt.Option) (r *api.ListBrandsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListBrands(ctx, req)
+}
+
+func (p *kProductServiceClient) DeleteBrand(ctx context.Context, req *api.DeleteBrandReq, callOptions ...callopt.Option) (r *api.DeleteBrandResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteBrand(ctx, req)
+}
+
+func (p *kProductServiceClient) SubmitProductApproval(ctx context.Context, req *api.SubmitProductApprovalReq, callOptions ...callopt.Option) (r *api.SubmitProductApprovalResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitProductApproval(ctx, req)
+}
+
+func (p *kProductServiceClient) ReviewProductApproval(ctx context.Context, req *api.ReviewProductApprovalReq, callOptions ...callopt.Option) (r *api.ReviewProductApprovalResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewProductApproval(ctx, req)
+}
+
+func (p *kProductServiceClient) ListProductApprovals(ctx context.Context, req *api.ListProductApprovalsReq, callOptions ...callopt.Option) (r *api.ListProductApprovalsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductApprovals(ctx, req)
+}
+
+func (p *kProductServiceClient) CreateMerchant(ctx context.Context, req *api.CreateMerchantReq, callOptions ...callopt.Option) (r *api.CreateMerchantResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateMerchant(ctx, req)
+}
+
+func (p *kProductServiceClient) UpdateMerchant(ctx context.Context, req *api.UpdateMerchantReq, callOptions ...callopt.Option) (r *api.UpdateMerchantResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateMerchant(ctx, req)
+}
+
+func (p *kProductServiceClient) GetMerchant(ctx context.Context, req *api.GetMerchantReq, callOptions ...callopt.Option) (r *api.GetMerchantResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMerchant(ctx, req)
+}
+
+func (p *kProductServiceClient) ListMerchants(ctx context.Context, req *api.ListMerchantsReq, callOptions ...callopt.Option) (r *api.ListMerchantsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListMerchants(ctx, req)
+}
+
+func (p *kProductServiceClient) SuggestProducts(ctx context.Context, req *api.SuggestProductsReq, callOptions ...callopt.Option) (r *api.SuggestProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SuggestProducts(ctx, req)
+}
+
+func (p *kProductServiceClient) ListSearchQueries(ctx context.Context, req *api.ListSearchQueriesReq, callOptions ...callopt.Option) (r *api.ListSearchQueriesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSearchQueries(ctx, req)
+}
+
+func (p *kProductServiceClient) RecordProductSales(ctx context.Context, req *api.RecordProductSalesReq, callOptions ...callopt.Option) (r *api.RecordProductSalesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RecordProductSales(ctx, req)
+}
+
+func (p *kProductServiceClient) GetTopProducts(ctx context.Context, req *api.GetTopProductsReq, callOptions ...callopt.Option) (r *api.GetTopProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTopProducts(ctx, req)
+}
+
+func (p *kProductServiceClient) UpdateBundleComponents(ctx context.Context, req *api.UpdateBundleComponentsReq, callOptions ...callopt.Option) (r *api.UpdateBundleComponentsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateBundleComponents(ctx, req)
+}
+
+func (p *kProductServiceClient) SetProductTranslation(ctx context.Context, req *api.SetProductTranslationReq, callOptions ...callopt.Option) (r *api.SetProductTranslationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetProductTranslation(ctx, req)
+}
+
+func (p *kProductServiceClient) DeleteProductTranslation(ctx context.Context, req *api.DeleteProductTranslationReq, callOptions ...callopt.Option) (r *api.DeleteProductTranslationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteProductTranslation(ctx, req)
+}
+
+func (p *kProductServiceClient) ListProductTranslations(ctx context.Context, req *api.ListProductTranslationsReq, callOptions ...callopt.Option) (r *api.ListProductTranslationsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductTranslations(ctx, req)
+}
+
+func (p *kProductServiceClient) SetCategoryTranslation(ctx context.Context, req *api.SetCategoryTranslationReq, callOptions ...callopt.Option) (r *api.SetCategoryTranslationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetCategoryTranslation(ctx, req)
+}
+
+func (p *kProductServiceClient) DeleteCategoryTranslation(ctx context.Context, req *api.DeleteCategoryTranslationReq, callOptions ...callopt.Option) (r *api.DeleteCategoryTranslationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCategoryTranslation(ctx, req)
+}
+
+func (p *kProductServiceClient) ListCategoryTranslations(ctx context.Context, req *api.ListCategoryTranslationsReq, callOptions ...callopt.Option) (r *api.ListCategoryTranslationsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCategoryTranslations(ctx, req)
+}
+
+func (p *kProductServiceClient) AddFavorite(ctx context.Context, req *api.AddFavoriteReq, callOptions ...callopt.Option) (r *api.AddFavoriteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddFavorite(ctx, req)
+}
+
+func (p *kProductServiceClient) RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteReq, callOptions ...callopt.Option) (r *api.RemoveFavoriteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveFavorite(ctx, req)
+}
+
+func (p *kProductServiceClient) ListFavorites(ctx context.Context, req *api.ListFavoritesReq, callOptions ...callopt.Option) (r *api.ListFavoritesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListFavorites(ctx, req)
+}
+
+func (p *kProductServiceClient) SubscribeRestock(ctx context.Context, req *api.SubscribeRestockReq, callOptions ...callopt.Option) (r *api.SubscribeRestockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeRestock(ctx, req)
+}
+
+func (p *kProductServiceClient) UnsubscribeRestock(ctx context.Context, req *api.UnsubscribeRestockReq, callOptions ...callopt.Option) (r *api.UnsubscribeRestockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnsubscribeRestock(ctx, req)
+}
+
+func (p *kProductServiceClient) ListRestockSubscriptions(ctx context.Context, req *api.ListRestockSubscriptionsReq, callOptions ...callopt.Option) (r *api.ListRestockSubscriptionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListRestockSubscriptions(ctx, req)
+}
+
+func (p *kProductServiceClient) SetProductPriceTiers(ctx context.Context, req *api.SetProductPriceTiersReq, callOptions ...callopt.Option) (r *api.SetProductPriceTiersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetProductPriceTiers(ctx, req)
+}
+
+func (p *kProductServiceClient) GetProductPriceTiers(ctx context.Context, req *api.GetProductPriceTiersReq, callOptions ...callopt.Option) (r *api.GetProductPriceTiersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetProductPriceTiers(ctx, req)
+}
+
+func (p *kProductServiceClient) SetUserPriceGroup(ctx context.Context, req *api.SetUserPriceGroupReq, callOptions ...callopt.Option) (r *api.SetUserPriceGroupResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetUserPriceGroup(ctx, req)
+}
+
+func (p *kProductServiceClient) ListUserPriceGroups(ctx context.Context, req *api.ListUserPriceGroupsReq, callOptions ...callopt.Option) (r *api.ListUserPriceGroupsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUserPriceGroups(ctx, req)
+}
+
+func (p *kProductServiceClient) QuotePrices(ctx context.Context, req *api.QuotePricesReq, callOptions ...callopt.Option) (r *api.QuotePricesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QuotePrices(ctx, req)
+}
+
+func (p *kProductServiceClient) SetProductPurchaseLimit(ctx context.Context, req *api.SetProductPurchaseLimitReq, callOptions ...callopt.Option) (r *api.SetProductPurchaseLimitResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetProductPurchaseLimit(ctx, req)
+}
@@ -10,8 +10,11 @@ import (
 	"syscall"
 	"time"
 
+	"ecommerce/product-service/internal/cache"
 	"ecommerce/product-service/internal/handler"
+	"ecommerce/product-service/internal/notifier"
 	"ecommerce/product-service/internal/repository"
+	"ecommerce/product-service/internal/scheduler"
 	"ecommerce/product-service/internal/service"
 	api "ecommerce/product-service/kitex_gen/api/productservice"
 	"ecommerce/product-service/pkg/config"
@@ -49,7 +52,30 @@ func main() {
 
 	//初始化依赖
 	productRepo := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepo)
+	eventRepo := repository.NewEventRepository(db)
+	movementRepo := repository.NewStockMovementRepository(db)
+	warehouseRepo := repository.NewWarehouseRepository(db)
+	priceRepo := repository.NewPriceRepository(db)
+	scheduleRepo := repository.NewStatusScheduleRepository(db)
+	attributeRepo := repository.NewAttributeRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	recommendRepo := repository.NewRecommendationRepository(db)
+	brandRepo := repository.NewBrandRepository(db)
+	approvalRepo := repository.NewApprovalRepository(db)
+	merchantRepo := repository.NewMerchantRepository(db)
+	suggestionRepo := repository.NewSuggestionRepository(db)
+	popularityRepo := repository.NewPopularityRepository(db)
+	bundleRepo := repository.NewBundleRepository(db)
+	translationRepo := repository.NewTranslationRepository(db)
+	favoriteRepo := repository.NewFavoriteRepository(db)
+	restockRepo := repository.NewRestockRepository(db)
+	pricingRepo := repository.NewPricingRepository(db)
+	alertNotifier := notifier.New(&cfg.Alert)
+	restockNotifier := notifier.NewRestockNotifier(&cfg.Restock)
+	productCache := cache.New(&cfg.Cache, &cfg.Redis)
+	productService := service.NewProductService(productRepo, eventRepo, movementRepo, warehouseRepo, priceRepo, scheduleRepo, attributeRepo, auditRepo, recommendRepo, brandRepo, approvalRepo, merchantRepo, suggestionRepo, popularityRepo, bundleRepo, translationRepo, favoriteRepo, restockRepo, pricingRepo, alertNotifier, restockNotifier, productCache)
+	productService.SetDefaultLocale(cfg.I18n.DefaultLocale)
+	productService.SetRestockLimit(cfg.Restock.MaxPerUserHour)
 
 	//创建信号通道用于关闭
 	quit := make(chan os.Signal, 1)
@@ -58,6 +84,9 @@ func main() {
 	//启动Hertz
 	httpServer := startHTTPServer(cfg.Hertz.Port, productService)
 
+	//启动定时任务
+	jobScheduler := startScheduler(productService)
+
 	//启动Kitex
 	kitexPort := 50051
 	if cfg.Kitex.Port > 0 {
@@ -75,9 +104,61 @@ func main() {
 	log.Println("💖收到关闭信号，开始关闭...💖")
 
 	//关闭
+	jobScheduler.Stop()
+	//写入尚未落库的浏览和销量计数
+	if err := productService.FlushPopularityCounters(context.Background()); err != nil {
+		log.Printf("写入商品热度计数失败: %v", err)
+	}
 	gracefulShutdown(httpServer, kitexServer, db)
 }
 
+// 启动定时任务
+func startScheduler(productService service.ProductService) *scheduler.Scheduler {
+	s := scheduler.New()
+	s.Register(scheduler.Job{
+		Name:     "price-schedule",
+		Interval: 10 * time.Second,
+		Run:      productService.ProcessPriceSchedules,
+	})
+	s.Register(scheduler.Job{
+		Name:     "status-schedule",
+		Interval: 10 * time.Second,
+		Run:      productService.ProcessStatusSchedules,
+	})
+	s.Register(scheduler.Job{
+		Name:     "recommendation-refresh",
+		Interval: 30 * time.Minute,
+		Run:      productService.RefreshRecommendations,
+	})
+	s.Register(scheduler.Job{
+		Name:     "suggestion-refresh",
+		Interval: 10 * time.Minute,
+		Run:      productService.RefreshSuggestions,
+	})
+	s.Register(scheduler.Job{
+		Name:     "popularity-flush",
+		Interval: 30 * time.Second,
+		Run:      productService.FlushPopularityCounters,
+	})
+	s.Register(scheduler.Job{
+		Name:     "popularity-refresh",
+		Interval: 10 * time.Minute,
+		Run:      productService.RefreshPopularity,
+	})
+	s.Register(scheduler.Job{
+		Name:     "restock-notify",
+		Interval: time.Minute,
+		Run:      productService.ProcessRestockNotifications,
+	})
+	s.Register(scheduler.Job{
+		Name:     "event-prune",
+		Interval: time.Hour,
+		Run:      productService.PruneProductEvents,
+	})
+	s.Start()
+	return s
+}
+
 // 启动Hertz
 func startHTTPServer(port int, productService service.ProductService) *server.Hertz {
 	h := server.New(
@@ -153,6 +234,7 @@ func registerHTTPRoutes(h *server.Hertz, httpHandler *handler.ProductHTTPHandler
 				{"method": "PUT", "path": "/api/v1/products/:id", "desc": "更新产品"},
 				{"method": "DELETE", "path": "/api/v1/products/:id", "desc": "删除产品"},
 				{"method": "GET", "path": "/api/v1/products", "desc": "用户搜索产品"},
+				{"method": "POST", "path": "/api/v1/products/batch", "desc": "批量获取产品"},
 				{"method": "GET", "path": "/api/v1/admin/products", "desc": "管理员搜索产品"},
 				{"method": "POST", "path": "/api/v1/products/:id/online", "desc": "上架产品"},
 				{"method": "POST", "path": "/api/v1/products/:id/offline", "desc": "下架产品"},
@@ -160,15 +242,17 @@ func registerHTTPRoutes(h *server.Hertz, httpHandler *handler.ProductHTTPHandler
 		})
 	})
 
-	//产品相关 API
-	h.POST("/api/v1/products", httpHandler.CreateProduct)
-	h.GET("/api/v1/products/:id", httpHandler.GetProduct)
-	h.PUT("/api/v1/products/:id", httpHandler.UpdateProduct)
-	h.DELETE("/api/v1/products/:id", httpHandler.DeleteProduct)
-	h.GET("/api/v1/products", httpHandler.SearchProducts)
-	h.GET("/api/v1/admin/products", httpHandler.AdminSearchProducts)
-	h.POST("/api/v1/products/:id/online", httpHandler.OnlineProduct)
-	h.POST("/api/v1/products/:id/offline", httpHandler.OfflineProduct)
+	//产品相关 API，经此变更的商品在审计日志中记为 admin_http 来源
+	v1 := h.Group("/api/v1", handler.AdminAuditSource)
+	v1.POST("/products", httpHandler.CreateProduct)
+	v1.GET("/products/:id", httpHandler.GetProduct)
+	v1.PUT("/products/:id", httpHandler.UpdateProduct)
+	v1.DELETE("/products/:id", httpHandler.DeleteProduct)
+	v1.GET("/products", httpHandler.SearchProducts)
+	v1.POST("/products/batch", httpHandler.BatchGetProducts)
+	v1.GET("/admin/products", httpHandler.AdminSearchProducts)
+	v1.POST("/products/:id/online", httpHandler.OnlineProduct)
+	v1.POST("/products/:id/offline", httpHandler.OfflineProduct)
 }
 
 // 关闭
@@ -13,9 +13,13 @@ type Config struct {
 	Log      LogConfig      `mapstructure:"log"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Redis    RedisConfig    `mapstructure:"redis"`
+	Cache    CacheConfig    `mapstructure:"cache"`
 	Kafka    KafkaConfig    `mapstructure:"kafka"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	Kitex    KitexConfig    `mapstructure:"kitex"`
+	Alert    AlertConfig    `mapstructure:"alert"`
+	I18n     I18nConfig     `mapstructure:"i18n"`
+	Restock  RestockConfig  `mapstructure:"restock"`
 }
 
 // Hertz配置
@@ -58,6 +62,12 @@ type RedisConfig struct {
 	PoolSize int    `mapstructure:"pool_size"`
 }
 
+// 缓存配置
+type CacheConfig struct {
+	Driver   string `mapstructure:"driver"`   //memory 或 redis
+	Capacity int    `mapstructure:"capacity"` //内存缓存最大条目数
+}
+
 // Kafka配置
 type KafkaConfig struct {
 	Brokers []string `mapstructure:"brokers"`
@@ -77,6 +87,23 @@ type KitexConfig struct {
 	ServerTimeout int `mapstructure:"server_timeout"`
 }
 
+// 库存预警配置
+type AlertConfig struct {
+	WebhookURL string        `mapstructure:"webhook_url"`
+	Timeout    time.Duration `mapstructure:"timeout"`
+}
+
+// 多语言配置
+type I18nConfig struct {
+	DefaultLocale string `mapstructure:"default_locale"` //商品表中内容所用的语言
+}
+
+// 到货通知配置
+type RestockConfig struct {
+	LogFile        string `mapstructure:"log_file"`          //通知写入的本地文件，为空时只写日志
+	MaxPerUserHour int    `mapstructure:"max_per_user_hour"` //每个用户每小时最多收到的到货通知数，0表示不限制
+}
+
 // LoadConfig
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
@@ -136,6 +163,10 @@ func setDefaults() {
 	viper.SetDefault("redis.db", 0)
 	viper.SetDefault("redis.pool_size", 10)
 
+	// 缓存默认值
+	viper.SetDefault("cache.driver", "memory")
+	viper.SetDefault("cache.capacity", 10000)
+
 	// Kafka默认值
 	viper.SetDefault("kafka.brokers", []string{"localhost:9092"})
 	viper.SetDefault("kafka.version", "2.8.0")
@@ -148,4 +179,15 @@ func setDefaults() {
 	viper.SetDefault("kitex.port", 50051)
 	viper.SetDefault("kitex.client_timeout", 3000)
 	viper.SetDefault("kitex.server_timeout", 5000)
+
+	// 库存预警默认值
+	viper.SetDefault("alert.webhook_url", "")
+	viper.SetDefault("alert.timeout", "3s")
+
+	// 多语言默认值
+	viper.SetDefault("i18n.default_locale", "zh-CN")
+
+	// 到货通知默认值
+	viper.SetDefault("restock.log_file", "")
+	viper.SetDefault("restock.max_per_user_hour", 10)
 }
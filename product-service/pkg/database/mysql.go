@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"ecommerce/product-service/internal/model"
@@ -108,10 +109,195 @@ func trySQLite() (*gorm.DB, error) {
 
 // 自动建表
 func autoMigrate(db *gorm.DB) error {
+	//审核字段新增前已上过架的商品视为审核通过
+	backfillApproval := !db.Migrator().HasColumn(&model.Product{}, "approval_status")
 	err := db.AutoMigrate(&model.Product{})
 	if err != nil {
 		return fmt.Errorf("迁移Product表失败: %v", err)
 	}
+	err = db.AutoMigrate(&model.ProductEvent{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductEvent表失败: %v", err)
+	}
+	//单号唯一索引只约束非空单号，建索引前把历史空单号改为NULL
+	if db.Migrator().HasTable(&model.StockMovement{}) {
+		err = db.Model(&model.StockMovement{}).Where("reference_id = ?", "").Update("reference_id", nil).Error
+		if err != nil {
+			return fmt.Errorf("迁移StockMovement表失败: %v", err)
+		}
+	}
+	err = db.AutoMigrate(&model.StockMovement{})
+	if err != nil {
+		return fmt.Errorf("迁移StockMovement表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.Warehouse{}, &model.WarehouseStock{})
+	if err != nil {
+		return fmt.Errorf("迁移Warehouse表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.PriceSchedule{}, &model.PriceHistory{})
+	if err != nil {
+		return fmt.Errorf("迁移PriceSchedule表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductStatusSchedule{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductStatusSchedule表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.AttributeDefinition{}, &model.ProductAttributeValue{})
+	if err != nil {
+		return fmt.Errorf("迁移AttributeDefinition表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductAudit{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductAudit表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductCoPurchase{}, &model.ProductSalesStat{}, &model.ProductRecommendation{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductRecommendation表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.Brand{}, &model.BrandAlias{})
+	if err != nil {
+		return fmt.Errorf("迁移Brand表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductApproval{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductApproval表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.Merchant{})
+	if err != nil {
+		return fmt.Errorf("迁移Merchant表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.SearchSuggestion{}, &model.SearchQueryStat{})
+	if err != nil {
+		return fmt.Errorf("迁移搜索联想表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductPopularityBucket{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductPopularityBucket表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductBundleItem{})
+	if err != nil {
+		return fmt.Errorf("迁移ProductBundleItem表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductTranslation{}, &model.CategoryTranslation{})
+	if err != nil {
+		return fmt.Errorf("迁移多语言翻译表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.Favorite{})
+	if err != nil {
+		return fmt.Errorf("迁移Favorite表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.RestockSubscription{})
+	if err != nil {
+		return fmt.Errorf("迁移RestockSubscription表失败: %v", err)
+	}
+	err = db.AutoMigrate(&model.ProductPriceTier{}, &model.UserPriceGroup{})
+	if err != nil {
+		return fmt.Errorf("迁移阶梯价表失败: %v", err)
+	}
+	if backfillApproval {
+		err := db.Model(&model.Product{}).
+			Where("status IN ?", []model.ProductStatus{model.ProductStatusONLINE, model.ProductStatusOFFLINE, model.ProductStatusSOLDOUT}).
+			Update("approval_status", model.ApprovalStatusAPPROVED).Error
+		if err != nil {
+			return fmt.Errorf("补录商品审核状态失败: %v", err)
+		}
+	}
+	if err := backfillStockMovements(db); err != nil {
+		return fmt.Errorf("补录初始库存流水失败: %v", err)
+	}
+	if err := backfillBrands(db); err != nil {
+		return fmt.Errorf("迁移存量品牌失败: %v", err)
+	}
 	log.Println("数据库表迁移成功")
 	return nil
 }
+
+// 为没有任何流水的存量商品补一条初始库存记录，保证对账起点一致
+func backfillStockMovements(db *gorm.DB) error {
+	var products []model.Product
+	err := db.Model(&model.Product{}).
+		Where("stock != 0").
+		Where("NOT EXISTS (SELECT 1 FROM stock_movements WHERE stock_movements.product_id = products.id)").
+		Find(&products).Error
+	if err != nil {
+		return err
+	}
+	if len(products) == 0 {
+		return nil
+	}
+	now := time.Now().Unix()
+	movements := make([]model.StockMovement, 0, len(products))
+	for _, p := range products {
+		movements = append(movements, model.StockMovement{
+			ProductID: p.ID,
+			Delta:     p.Stock,
+			Balance:   p.Stock,
+			Reason:    model.StockChangeInitial,
+			Operator:  "migration",
+			CreatedAt: now,
+		})
+	}
+	if err := db.CreateInBatches(movements, 100).Error; err != nil {
+		return err
+	}
+	log.Printf("已补录%d条初始库存流水", len(movements))
+	return nil
+}
+
+// 把存量商品的品牌文本迁移为品牌记录，归一化后相同的文本合并为同一品牌
+func backfillBrands(db *gorm.DB) error {
+	var names []string
+	err := db.Model(&model.Product{}).
+		Where("brand_id = 0 AND brand != ''").
+		Distinct().
+		Pluck("brand", &names).Error
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	now := time.Now().Unix()
+	created := 0
+	for _, name := range names {
+		key := model.NormalizeBrandKey(name)
+		if key == "" {
+			continue
+		}
+		err := db.Transaction(func(tx *gorm.DB) error {
+			var aliases []model.BrandAlias
+			var brand model.Brand
+			if err := tx.Where("alias_key = ?", key).Limit(1).Find(&aliases).Error; err != nil {
+				return err
+			}
+			if len(aliases) > 0 {
+				if err := tx.First(&brand, aliases[0].BrandID).Error; err != nil {
+					return err
+				}
+			} else {
+				brand = model.Brand{
+					Name:      strings.TrimSpace(name),
+					Status:    model.BrandStatusACTIVE,
+					CreatedAt: now,
+					UpdatedAt: now,
+				}
+				if err := tx.Create(&brand).Error; err != nil {
+					return err
+				}
+				alias := model.BrandAlias{BrandID: brand.ID, Alias: brand.Name, Key: key}
+				if err := tx.Create(&alias).Error; err != nil {
+					return err
+				}
+				created++
+			}
+			return tx.Model(&model.Product{}).
+				Where("brand_id = 0 AND brand = ?", name).
+				Updates(map[string]interface{}{"brand_id": brand.ID, "brand": brand.Name}).Error
+		})
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("已迁移%d个品牌文本，新建品牌%d个", len(names), created)
+	return nil
+}